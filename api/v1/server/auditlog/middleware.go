@@ -0,0 +1,201 @@
+package auditlog
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// notAudited are tenant operations which change state but are too high-volume to record in the audit log.
+var notAudited = []string{
+	"EventCreate",
+	"EventCreateBulk",
+}
+
+const (
+	resourceKey = "audit-log-resource"
+	metadataKey = "audit-log-metadata"
+)
+
+type resource struct {
+	resourceType string
+	resourceId   string
+}
+
+// AuditLog records successful, state-changing tenant operations in the tenant's audit log. Entries are
+// buffered and written in the background, so the database write isn't part of the response.
+type AuditLog struct {
+	repo repository.AuditLogRepository
+
+	l *zerolog.Logger
+}
+
+func NewAuditLog(config *server.ServerConfig) *AuditLog {
+	return &AuditLog{
+		repo: config.APIRepository.AuditLog(),
+		l:    config.Logger,
+	}
+}
+
+func (a *AuditLog) Middleware(r *middleware.RouteInfo) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if c.Request().Method == http.MethodGet || slices.Contains(notAudited, r.OperationID) {
+			return nil
+		}
+
+		tenant, ok := c.Get("tenant").(*db.TenantModel)
+
+		if !ok {
+			return nil
+		}
+
+		// the entry is written once the handler has committed to a status code, and only if the
+		// operation succeeded
+		c.Response().Before(func() {
+			if c.Response().Status >= http.StatusBadRequest {
+				return
+			}
+
+			a.record(c, r, tenant.ID)
+		})
+
+		return nil
+	}
+}
+
+// SetResource overrides the resource which is recorded for the operation. Handlers which create a resource
+// should call this, since the id of the new resource isn't part of the request path.
+func SetResource(c echo.Context, resourceType, resourceId string) {
+	c.Set(resourceKey, &resource{
+		resourceType: resourceType,
+		resourceId:   resourceId,
+	})
+}
+
+// AddMetadata adds a key to the metadata which is recorded for the operation.
+func AddMetadata(c echo.Context, key string, value interface{}) {
+	metadata, ok := c.Get(metadataKey).(map[string]interface{})
+
+	if !ok {
+		metadata = make(map[string]interface{})
+		c.Set(metadataKey, metadata)
+	}
+
+	metadata[key] = value
+}
+
+func (a *AuditLog) record(c echo.Context, r *middleware.RouteInfo, tenantId string) {
+	opts := entryOpts(c, r, tenantId)
+
+	if opts == nil {
+		return
+	}
+
+	if err := a.repo.BufferAuditLog(tenantId, opts); err != nil {
+		a.l.Error().Err(err).Str("operation", r.OperationID).Msg("could not buffer audit log entry")
+	}
+}
+
+func entryOpts(c echo.Context, r *middleware.RouteInfo, tenantId string) *repository.CreateAuditLogOpts {
+	opts := &repository.CreateAuditLogOpts{
+		Source: dbsqlc.AuditLogSourceAPI,
+		Action: r.OperationID,
+	}
+
+	switch c.Get("auth_strategy") {
+	case "cookie":
+		user, ok := c.Get("user").(*db.UserModel)
+
+		if !ok {
+			return nil
+		}
+
+		opts.ActorKind = dbsqlc.AuditLogActorKindUSER
+		opts.ActorId = user.ID
+		opts.ActorName = &user.Email
+	case "bearer":
+		tokenId, ok := c.Get("api_token_id").(string)
+
+		if !ok {
+			return nil
+		}
+
+		opts.ActorKind = dbsqlc.AuditLogActorKindAPITOKEN
+		opts.ActorId = tokenId
+
+		// the name is set by the authn middleware when it validates the token
+		if tokenName, ok := c.Get("api_token_name").(string); ok && tokenName != "" {
+			opts.ActorName = &tokenName
+		}
+	default:
+		return nil
+	}
+
+	res := resourceFromRequest(c, tenantId)
+
+	opts.ResourceType = res.resourceType
+	opts.ResourceId = &res.resourceId
+
+	ip := c.RealIP()
+	opts.IpAddress = &ip
+
+	if userAgent := c.Request().UserAgent(); userAgent != "" {
+		opts.UserAgent = &userAgent
+	}
+
+	metadata := map[string]interface{}{
+		"method": c.Request().Method,
+		"path":   c.Request().URL.Path,
+		"status": c.Response().Status,
+	}
+
+	if requestId := c.Request().Header.Get(echo.HeaderXRequestID); requestId != "" {
+		metadata["requestId"] = requestId
+	}
+
+	if extra, ok := c.Get(metadataKey).(map[string]interface{}); ok {
+		for k, v := range extra {
+			metadata[k] = v
+		}
+	}
+
+	opts.Metadata = metadata
+
+	return opts
+}
+
+// resourceFromRequest returns the resource which the operation acted on. This is the resource set by the
+// handler if there is one, otherwise the innermost resource in the request path, falling back to the tenant.
+func resourceFromRequest(c echo.Context, tenantId string) *resource {
+	if res, ok := c.Get(resourceKey).(*resource); ok {
+		return res
+	}
+
+	names := c.ParamNames()
+
+	for i := len(names) - 1; i >= 0; i-- {
+		if names[i] == "tenant" {
+			continue
+		}
+
+		if id := c.Param(names[i]); id != "" {
+			return &resource{
+				resourceType: names[i],
+				resourceId:   id,
+			}
+		}
+	}
+
+	return &resource{
+		resourceType: "tenant",
+		resourceId:   tenantId,
+	}
+}
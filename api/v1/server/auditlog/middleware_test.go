@@ -0,0 +1,103 @@
+package auditlog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type bufferedEntry struct {
+	tenantId string
+	opts     *repository.CreateAuditLogOpts
+}
+
+type fakeAuditLogRepository struct {
+	repository.AuditLogRepository
+
+	buffered []bufferedEntry
+}
+
+func (f *fakeAuditLogRepository) BufferAuditLog(tenantId string, opts *repository.CreateAuditLogOpts) error {
+	f.buffered = append(f.buffered, bufferedEntry{tenantId: tenantId, opts: opts})
+	return nil
+}
+
+func newContext(names, values []string) echo.Context {
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/", nil), httptest.NewRecorder())
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+
+	return c
+}
+
+func TestResourceFromRequest(t *testing.T) {
+	tenantId := "707d0855-80ab-4e1f-a156-f1c4546cbf52"
+	tokenId := "b2f0a9b1-1f3c-4a4c-9f53-6b1c1c0f3a11"
+
+	// the innermost path parameter is the resource
+	c := newContext([]string{"tenant", "api-token"}, []string{tenantId, tokenId})
+	assert.Equal(t, &resource{resourceType: "api-token", resourceId: tokenId}, resourceFromRequest(c, tenantId))
+
+	// operations on the tenant itself fall back to the tenant
+	c = newContext([]string{"tenant"}, []string{tenantId})
+	assert.Equal(t, &resource{resourceType: "tenant", resourceId: tenantId}, resourceFromRequest(c, tenantId))
+
+	// a resource set by the handler takes precedence
+	SetResource(c, "tenant-invite", tokenId)
+	assert.Equal(t, &resource{resourceType: "tenant-invite", resourceId: tokenId}, resourceFromRequest(c, tenantId))
+}
+
+func TestMiddlewareBuffersSuccessfulOperations(t *testing.T) {
+	tenantId := "707d0855-80ab-4e1f-a156-f1c4546cbf52"
+	tokenId := "b2f0a9b1-1f3c-4a4c-9f53-6b1c1c0f3a11"
+
+	l := zerolog.Nop()
+	repo := &fakeAuditLogRepository{}
+	a := &AuditLog{repo: repo, l: &l}
+
+	route := &middleware.RouteInfo{OperationID: "ApiTokenRevoke"}
+
+	serve := func(status int) {
+		c := newContext([]string{"tenant", "api-token"}, []string{tenantId, tokenId})
+
+		tenant := &db.TenantModel{}
+		tenant.ID = tenantId
+
+		c.Set("tenant", tenant)
+		c.Set("auth_strategy", "bearer")
+		c.Set("api_token_id", tokenId)
+		c.Set("api_token_name", "ci")
+
+		require.NoError(t, a.Middleware(route)(c))
+		require.NoError(t, c.NoContent(status))
+	}
+
+	// failed operations are not recorded
+	serve(http.StatusForbidden)
+	assert.Empty(t, repo.buffered)
+
+	// the entry is buffered when the response is written, without looking up the token
+	serve(http.StatusNoContent)
+	require.Len(t, repo.buffered, 1)
+
+	entry := repo.buffered[0]
+
+	assert.Equal(t, tenantId, entry.tenantId)
+	assert.Equal(t, dbsqlc.AuditLogActorKindAPITOKEN, entry.opts.ActorKind)
+	assert.Equal(t, tokenId, entry.opts.ActorId)
+	assert.Equal(t, "ci", *entry.opts.ActorName)
+	assert.Equal(t, "ApiTokenRevoke", entry.opts.Action)
+	assert.Equal(t, "api-token", entry.opts.ResourceType)
+	assert.Equal(t, tokenId, *entry.opts.ResourceId)
+	assert.Equal(t, http.StatusNoContent, entry.opts.Metadata["status"])
+}
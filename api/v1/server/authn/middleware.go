@@ -184,7 +184,7 @@ func (a *AuthN) handleBearerAuth(c echo.Context) error {
 	}
 
 	// Validate the token.
-	tenantId, _, err := a.config.Auth.JWTManager.ValidateTenantToken(c.Request().Context(), token)
+	validated, err := a.config.Auth.JWTManager.ValidateTenantToken(c.Request().Context(), token)
 
 	if err != nil {
 		a.l.Debug().Err(err).Msg("error validating tenant token")
@@ -194,12 +194,17 @@ func (a *AuthN) handleBearerAuth(c echo.Context) error {
 
 	// Verify that the tenant id which exists in the context is the same as the tenant id
 	// in the token.
-	if queriedTenant.ID != tenantId {
+	if queriedTenant.ID != validated.TenantId {
 		a.l.Debug().Msgf("tenant id in token does not match tenant id in context")
 
 		return forbidden
 	}
 
+	// the scopes are checked against the operation in the authz step
+	c.Set("token_scopes", validated.Scopes)
+	c.Set("api_token_id", validated.TokenId)
+	c.Set("api_token_name", validated.Name)
+
 	return nil
 }
 
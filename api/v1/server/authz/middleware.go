@@ -1,6 +1,7 @@
 package authz
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/pkg/auth/token"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 )
@@ -92,9 +94,16 @@ func (a *AuthZ) handleCookieAuth(c echo.Context, r *middleware.RouteInfo) error
 		c.Set("tenant-member", tenantMember)
 
 		// authorize tenant operations
-		if err := a.authorizeTenantOperations(tenant, tenantMember, r); err != nil {
+		if err := a.authorizeTenantOperations(c, tenantMember, r); err != nil {
 			a.l.Debug().Err(err).Msgf("error authorizing tenant operations")
 
+			// surface permission errors from custom roles, so members know that they're authenticated
+			var httpErr *echo.HTTPError
+
+			if errors.As(err, &httpErr) && httpErr.Code == http.StatusForbidden {
+				return httpErr
+			}
+
 			return unauthorized
 		}
 	}
@@ -107,18 +116,81 @@ var restrictedWithBearerToken = []string{
 	"ApiTokenList",
 	"ApiTokenCreate",
 	"ApiTokenUpdateRevoke",
+	// replaying or purging dead letters affects the processing of the whole tenant
+	"MessageQueueDeadLetterReplay",
+	"MessageQueueDeadLetterPurge",
 }
 
-// At the moment, there's no further bearer auth because bearer tokens are admin-scoped
-// and we check that the bearer token has access to the tenant in the authn step.
+// operationScopes maps operations to the scope which a bearer token needs in order to perform them.
+// Operations which aren't listed require the admin scope.
+var operationScopes = map[token.Scope][]string{
+	token.ScopeWorkflowsTrigger: {
+		"WorkflowRunCreate",
+		"ScheduledWorkflowRunCreate",
+	},
+	token.ScopeEventsPush: {
+		"EventCreate",
+		"EventCreateBulk",
+	},
+	token.ScopeRunsRead: {
+		"WorkflowRunGet",
+		"WorkflowRunGetInput",
+		"WorkflowRunGetShape",
+		"WorkflowRunGetMetrics",
+		"WorkflowRunList",
+		"WorkflowRunListStepRunEvents",
+		"StepRunGet",
+		"StepRunGetSchema",
+		"StepRunListArchives",
+		"StepRunListEvents",
+		"LogLineList",
+		"V2TaskGet",
+		"V2TaskGetPointMetrics",
+		"V2TaskGetQueueDiagnosis",
+		"V2TaskList",
+		"V2TaskListStatusMetrics",
+		"V2TaskEventList",
+		"V2DagListTasks",
+		"V2WorkflowRunGet",
+		"V2WorkflowRunList",
+		"V2WorkflowRunTaskEventsList",
+	},
+	token.ScopeRunsCancel: {
+		"WorkflowRunCancel",
+		"StepRunUpdateCancel",
+		"V2TaskCancel",
+		"V2WorkflowRunCancel",
+	},
+}
+
+// We check that the bearer token has access to the tenant in the authn step, so bearer auth only needs
+// to check that the token's scopes permit the operation.
 func (a *AuthZ) handleBearerAuth(c echo.Context, r *middleware.RouteInfo) error {
 	if operationIn(r.OperationID, restrictedWithBearerToken) {
 		return echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to perform this operation")
 	}
 
+	scopes, _ := c.Get("token_scopes").([]string)
+
+	if !token.HasScope(scopes, requiredScope(r.OperationID)) {
+		a.l.Debug().Msgf("token does not have the scope required for %s", r.OperationID)
+
+		return echo.NewHTTPError(http.StatusForbidden, "API token is not permitted to perform this operation")
+	}
+
 	return nil
 }
 
+func requiredScope(operationId string) token.Scope {
+	for scope, operationIds := range operationScopes {
+		if operationIn(operationId, operationIds) {
+			return scope
+		}
+	}
+
+	return token.ScopeAdmin
+}
+
 var permittedWithUnverifiedEmail = []string{
 	"UserGetCurrent",
 	"UserUpdateLogout",
@@ -152,9 +224,20 @@ var adminAndOwnerOnly = []string{
 	"ApiTokenList",
 	"ApiTokenCreate",
 	"ApiTokenUpdateRevoke",
+	// replaying or purging dead letters affects the processing of the whole tenant
+	"MessageQueueDeadLetterReplay",
+	"MessageQueueDeadLetterPurge",
+	// members cannot manage roles, because they could grant themselves permissions
+	"TenantMemberUpdate",
+	"TenantRoleList",
+	"TenantRoleCreate",
+	"TenantRoleUpdate",
+	"TenantRoleDelete",
+	// the audit log includes the actions of every member of the tenant
+	"AuditLogList",
 }
 
-func (a *AuthZ) authorizeTenantOperations(tenant *db.TenantModel, tenantMember *db.TenantMemberModel, r *middleware.RouteInfo) error {
+func (a *AuthZ) authorizeTenantOperations(c echo.Context, tenantMember *db.TenantMemberModel, r *middleware.RouteInfo) error {
 	// if the user is an owner, they can do anything
 	if tenantMember.Role == db.TenantMemberRoleOwner {
 		return nil
@@ -166,11 +249,21 @@ func (a *AuthZ) authorizeTenantOperations(tenant *db.TenantModel, tenantMember *
 		return nil
 	}
 
-	// at the moment, tenant members are only restricted from creating other tenant users.
 	if operationIn(r.OperationID, adminAndOwnerOnly) {
 		return echo.NewHTTPError(http.StatusUnauthorized, "Not authorized to perform this operation")
 	}
 
+	// members with a custom role are restricted to the permissions of that role
+	role, err := a.config.APIRepository.TenantRole().GetTenantRoleForMember(c.Request().Context(), tenantMember.ID)
+
+	if err != nil {
+		return err
+	}
+
+	if role != nil {
+		return a.authorizeCustomRole(c, role, r)
+	}
+
 	// NOTE(abelanger5): this should be default-deny, but there's not a strong use-case for restricting member
 	// operations at the moment. If there is, we should modify this logic.
 	return nil
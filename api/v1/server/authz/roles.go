@@ -0,0 +1,286 @@
+package authz
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/olapv2"
+)
+
+// operationPermissions maps operations to the permission which a custom tenant role needs in order to
+// perform them. Custom roles are default-deny, so operations which aren't listed can only be performed by
+// members without a custom role.
+var operationPermissions = map[rbac.Permission][]string{
+	rbac.PermissionWorkflowsRead: {
+		"WorkflowList",
+		"WorkflowGet",
+		"WorkflowVersionGet",
+		"WorkflowGetMetrics",
+		"WorkflowGetWorkersCount",
+		"CronWorkflowList",
+		"WorkflowCronGet",
+		"WorkflowScheduledList",
+		"WorkflowScheduledGet",
+	},
+	rbac.PermissionWorkflowsWrite: {
+		"WorkflowUpdate",
+		"WorkflowDelete",
+		"CronWorkflowTriggerCreate",
+		"WorkflowCronUpdate",
+		"WorkflowCronDelete",
+		"WorkflowScheduledDelete",
+	},
+	rbac.PermissionWorkflowsTrigger: {
+		"WorkflowRunCreate",
+		"ScheduledWorkflowRunCreate",
+	},
+	rbac.PermissionRunsRead: {
+		"WorkflowRunGet",
+		"WorkflowRunGetInput",
+		"WorkflowRunGetShape",
+		"WorkflowRunGetMetrics",
+		"WorkflowRunList",
+		"WorkflowRunListStepRunEvents",
+		"StepRunGet",
+		"StepRunGetSchema",
+		"StepRunListArchives",
+		"StepRunListEvents",
+		"LogLineList",
+		"V2TaskGet",
+		"V2TaskGetPointMetrics",
+		"V2TaskGetQueueDiagnosis",
+		"V2TaskList",
+		"V2TaskListStatusMetrics",
+		"V2TaskEventList",
+		"V2DagListTasks",
+		"V2WorkflowRunGet",
+		"V2WorkflowRunList",
+		"V2WorkflowRunTaskEventsList",
+	},
+	rbac.PermissionRunsCancel: {
+		"WorkflowRunCancel",
+		"StepRunUpdateCancel",
+		"V2TaskCancel",
+		"V2WorkflowRunCancel",
+	},
+	rbac.PermissionRunsReplay: {
+		"WorkflowRunUpdateReplay",
+		"StepRunUpdateRerun",
+		"V2TaskReplay",
+		"V2WorkflowRunReplay",
+		"V2WorkflowRunReplayFromTask",
+	},
+	rbac.PermissionEventsRead: {
+		"EventList",
+		"EventGet",
+		"EventDataGet",
+		"EventKeyList",
+	},
+	rbac.PermissionEventsWrite: {
+		"EventCreate",
+		"EventCreateBulk",
+		"EventUpdateCancel",
+		"EventUpdateReplay",
+	},
+	rbac.PermissionWorkersRead: {
+		"WorkerList",
+		"WorkerGet",
+		"WebhookList",
+		"WebhookRequestsList",
+	},
+	rbac.PermissionWorkersWrite: {
+		"WorkerUpdate",
+		"WebhookCreate",
+		"WebhookDelete",
+	},
+	rbac.PermissionQueuesRead: {
+		"RateLimitList",
+		"V2RateLimitList",
+		"V2ConcurrencyList",
+		"TenantGetQueueMetrics",
+		"TenantGetStepRunQueueMetrics",
+		"MessageQueueDeadLetterList",
+	},
+	rbac.PermissionSettingsRead: {
+		"TenantAlertingSettingsGet",
+		"TenantResourcePolicyGet",
+		"AlertEmailGroupList",
+		"SlackWebhookList",
+		"SnsList",
+		"WebhookSubscriptionList",
+		"WebhookDeliveryList",
+	},
+	rbac.PermissionSettingsWrite: {
+		"TenantUpdate",
+		"AlertEmailGroupCreate",
+		"AlertEmailGroupUpdate",
+		"AlertEmailGroupDelete",
+		"SlackWebhookDelete",
+		"UserUpdateSlackOauthStart",
+		"SnsCreate",
+		"SnsDelete",
+		"WebhookSubscriptionCreate",
+		"WebhookSubscriptionUpdate",
+		"WebhookSubscriptionDelete",
+		"MonitoringPostRunProbe",
+	},
+}
+
+// filteredByWorkflow are operations which don't act on a single workflow, but whose handlers restrict
+// their results to the workflows returned by AllowedWorkflowIds. Other operations which can't be resolved
+// to a single workflow are denied to roles which are restricted to specific workflows.
+var filteredByWorkflow = []string{
+	"V2TaskList",
+	"V2TaskListStatusMetrics",
+	"V2TaskCancel",
+	"V2TaskReplay",
+	"V2WorkflowRunList",
+	"V2WorkflowRunCancel",
+	"V2WorkflowRunReplay",
+}
+
+// ownerOnly are operations whose handlers only permit tenant owners, which respond with a 403 to other
+// members. They aren't mapped to a permission, so members with a custom role are denied them as well.
+var ownerOnly = []string{
+	"TenantMemberDelete",
+}
+
+const allowedWorkflowIdsKey = "allowed-workflow-ids"
+
+func requiredPermission(operationId string) (rbac.Permission, bool) {
+	for permission, operationIds := range operationPermissions {
+		if operationIn(operationId, operationIds) {
+			return permission, true
+		}
+	}
+
+	return "", false
+}
+
+func (a *AuthZ) authorizeCustomRole(c echo.Context, role *dbsqlc.TenantRole, r *middleware.RouteInfo) error {
+	forbidden := echo.NewHTTPError(http.StatusForbidden, "Your role does not permit this operation")
+
+	permission, ok := requiredPermission(r.OperationID)
+
+	if !ok {
+		return forbidden
+	}
+
+	rbacRole := &rbac.Role{
+		Permissions: role.Permissions,
+		WorkflowIds: make([]string, 0, len(role.WorkflowIds)),
+	}
+
+	for _, id := range role.WorkflowIds {
+		rbacRole.WorkflowIds = append(rbacRole.WorkflowIds, sqlchelpers.UUIDToStr(id))
+	}
+
+	if !rbacRole.HasPermission(permission) {
+		return forbidden
+	}
+
+	if !rbacRole.RestrictsWorkflows(permission) {
+		return nil
+	}
+
+	if workflowId, ok := workflowIdFromContext(c); ok {
+		if !rbacRole.Allows(permission, workflowId) {
+			return forbidden
+		}
+
+		return nil
+	}
+
+	if !operationIn(r.OperationID, filteredByWorkflow) {
+		return forbidden
+	}
+
+	allowed := make([]uuid.UUID, 0, len(rbacRole.WorkflowIds))
+
+	for _, id := range rbacRole.WorkflowIds {
+		allowed = append(allowed, uuid.MustParse(id))
+	}
+
+	c.Set(allowedWorkflowIdsKey, allowed)
+
+	return nil
+}
+
+// workflowIdFromContext returns the id of the workflow which the populated resources belong to.
+func workflowIdFromContext(c echo.Context) (string, bool) {
+	if workflow, ok := c.Get("workflow").(*dbsqlc.GetWorkflowByIdRow); ok {
+		return sqlchelpers.UUIDToStr(workflow.Workflow.ID), true
+	}
+
+	if workflowRun, ok := c.Get("workflow-run").(*dbsqlc.GetWorkflowRunByIdRow); ok {
+		return sqlchelpers.UUIDToStr(workflowRun.Workflow.ID), true
+	}
+
+	if workflowRun, ok := c.Get("v2-workflow-run").(*repository.V2WorkflowRunPopulator); ok {
+		return sqlchelpers.UUIDToStr(workflowRun.WorkflowRun.WorkflowID), true
+	}
+
+	if scheduled, ok := c.Get("scheduled-workflow-run").(*dbsqlc.ListScheduledWorkflowsRow); ok {
+		return sqlchelpers.UUIDToStr(scheduled.WorkflowId), true
+	}
+
+	if cron, ok := c.Get("cron-workflow").(*dbsqlc.ListCronWorkflowsRow); ok {
+		return sqlchelpers.UUIDToStr(cron.WorkflowId), true
+	}
+
+	if task, ok := c.Get("task").(*olapv2.V2TasksOlap); ok {
+		return sqlchelpers.UUIDToStr(task.WorkflowID), true
+	}
+
+	return "", false
+}
+
+// RestrictWorkflowIds narrows a workflow id filter to the workflows which the tenant member's role permits
+// the operation on. An empty filter matches every workflow. It returns false if none of the workflows in
+// the filter are permitted.
+func RestrictWorkflowIds(c echo.Context, workflowIds []uuid.UUID) ([]uuid.UUID, bool) {
+	allowed, ok := c.Get(allowedWorkflowIdsKey).([]uuid.UUID)
+
+	if !ok {
+		return workflowIds, true
+	}
+
+	if len(workflowIds) == 0 {
+		return allowed, true
+	}
+
+	res := make([]uuid.UUID, 0, len(workflowIds))
+
+	for _, id := range workflowIds {
+		if slices.Contains(allowed, id) {
+			res = append(res, id)
+		}
+	}
+
+	return res, len(res) > 0
+}
+
+// WorkflowPermitted returns true if the tenant member's role permits the operation on the workflow.
+func WorkflowPermitted(c echo.Context, workflowId string) bool {
+	allowed, ok := c.Get(allowedWorkflowIdsKey).([]uuid.UUID)
+
+	if !ok {
+		return true
+	}
+
+	id, err := uuid.Parse(workflowId)
+
+	if err != nil {
+		return false
+	}
+
+	return slices.Contains(allowed, id)
+}
@@ -0,0 +1,53 @@
+package authz
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
+)
+
+// TestTenantOperationsHavePermissions checks that custom roles don't deny new tenant operations by omission
+func TestTenantOperationsHavePermissions(t *testing.T) {
+	swagger, err := gen.GetSwagger()
+	require.NoError(t, err)
+
+	seen := make(map[string]bool)
+
+	for _, operationIds := range operationPermissions {
+		for _, operationId := range operationIds {
+			assert.False(t, seen[operationId], "%s is mapped to more than one permission", operationId)
+			seen[operationId] = true
+		}
+	}
+
+	for _, item := range swagger.Paths.Map() {
+		for _, op := range item.Operations() {
+			resources, _ := op.Extensions["x-resources"].([]interface{})
+
+			if !slices.Contains(resources, interface{}("tenant")) {
+				continue
+			}
+
+			if operationIn(op.OperationID, adminAndOwnerOnly) || operationIn(op.OperationID, ownerOnly) {
+				continue
+			}
+
+			_, ok := requiredPermission(op.OperationID)
+			assert.True(t, ok, "%s is not mapped to a permission", op.OperationID)
+		}
+	}
+}
+
+func TestFilteredOperationsAreWorkflowScoped(t *testing.T) {
+	for _, operationId := range filteredByWorkflow {
+		permission, ok := requiredPermission(operationId)
+
+		require.True(t, ok, operationId)
+		assert.True(t, rbac.IsWorkflowScoped(permission), operationId)
+	}
+}
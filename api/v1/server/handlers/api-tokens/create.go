@@ -5,8 +5,10 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/auditlog"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/auth/token"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 )
 
@@ -34,14 +36,28 @@ func (a *APITokenService) ApiTokenCreate(ctx echo.Context, request gen.ApiTokenC
 		expiresAt = &e
 	}
 
-	token, err := a.config.Auth.JWTManager.GenerateTenantToken(ctx.Request().Context(), tenant.ID, request.Body.Name, false, expiresAt)
+	scopes := make([]string, 0)
+
+	if request.Body.Scopes != nil {
+		for _, scope := range *request.Body.Scopes {
+			scopes = append(scopes, string(scope))
+		}
+	}
+
+	if err := token.ValidateScopes(scopes); err != nil {
+		return gen.ApiTokenCreate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	tok, err := a.config.Auth.JWTManager.GenerateScopedTenantToken(ctx.Request().Context(), tenant.ID, request.Body.Name, scopes, expiresAt)
 
 	if err != nil {
 		return nil, err
 	}
 
+	auditlog.SetResource(ctx, "api-token", tok.TokenId)
+
 	// This is the only time the token is sent over the API
 	return gen.ApiTokenCreate200JSONResponse{
-		Token: token.Token,
+		Token: tok.Token,
 	}, nil
 }
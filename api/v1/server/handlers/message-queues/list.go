@@ -0,0 +1,79 @@
+package messagequeues
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *MessageQueueService) MessageQueueDeadLetterList(ctx echo.Context, request gen.MessageQueueDeadLetterListRequestObject) (gen.MessageQueueDeadLetterListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if _, ok := msgqueue.GetStaticQueue(request.MessageQueue); !ok {
+		return gen.MessageQueueDeadLetterList400JSONResponse(
+			apierrors.NewAPIErrors("unknown message queue"),
+		), nil
+	}
+
+	limit := 50
+	offset := 0
+
+	listOpts := &repository.ListDeadLettersOpts{
+		Limit:  &limit,
+		Offset: &offset,
+	}
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+		listOpts.Limit = &limit
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+		listOpts.Offset = &offset
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	listRes, err := t.config.EngineRepository.MessageQueue().ListDeadLetters(dbCtx, &tenant.ID, request.MessageQueue, listOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.DeadLetter, len(listRes.Rows))
+
+	for i, deadLetter := range listRes.Rows {
+		rows[i] = *transformers.ToDeadLetter(deadLetter)
+	}
+
+	// use the total rows and limit to calculate the total pages
+	totalPages := int64(math.Ceil(float64(listRes.Count) / float64(limit)))
+	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
+	nextPage := currPage + 1
+
+	if currPage == totalPages {
+		nextPage = currPage
+	}
+
+	return gen.MessageQueueDeadLetterList200JSONResponse(
+		gen.DeadLetterList{
+			Rows: &rows,
+			Pagination: &gen.PaginationResponse{
+				NumPages:    &totalPages,
+				NextPage:    &nextPage,
+				CurrentPage: &currPage,
+			},
+		},
+	), nil
+}
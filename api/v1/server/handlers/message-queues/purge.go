@@ -0,0 +1,44 @@
+package messagequeues
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *MessageQueueService) MessageQueueDeadLetterPurge(ctx echo.Context, request gen.MessageQueueDeadLetterPurgeRequestObject) (gen.MessageQueueDeadLetterPurgeResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if _, ok := msgqueue.GetStaticQueue(request.MessageQueue); !ok {
+		return gen.MessageQueueDeadLetterPurge400JSONResponse(
+			apierrors.NewAPIErrors("unknown message queue"),
+		), nil
+	}
+
+	var ids []int64
+
+	if request.Body != nil && request.Body.Ids != nil {
+		ids = *request.Body.Ids
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	count, err := t.config.EngineRepository.MessageQueue().DeleteDeadLetters(dbCtx, &tenant.ID, request.MessageQueue, ids)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.MessageQueueDeadLetterPurge200JSONResponse(
+		gen.PurgeDeadLettersResponse{
+			Count: int(count),
+		},
+	), nil
+}
@@ -0,0 +1,54 @@
+package messagequeues
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+// maxReplayDeadLetters is the maximum number of dead letters which are replayed in a single request
+const maxReplayDeadLetters = 1000
+
+func (t *MessageQueueService) MessageQueueDeadLetterReplay(ctx echo.Context, request gen.MessageQueueDeadLetterReplayRequestObject) (gen.MessageQueueDeadLetterReplayResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	q, ok := msgqueue.GetStaticQueue(request.MessageQueue)
+
+	if !ok {
+		return gen.MessageQueueDeadLetterReplay400JSONResponse(
+			apierrors.NewAPIErrors("unknown message queue"),
+		), nil
+	}
+
+	var ids []int64
+
+	if request.Body != nil && request.Body.Ids != nil {
+		ids = *request.Body.Ids
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	replayed, err := msgqueue.ReplayDeadLetters(dbCtx, t.config.EngineRepository.MessageQueue(), t.config.MessageQueue, q, &tenant.ID, ids, maxReplayDeadLetters)
+
+	if err != nil {
+		// dead letters which couldn't be replayed are kept, so they can be replayed again
+		if replayed == nil {
+			return nil, err
+		}
+
+		t.config.Logger.Error().Err(err).Msg("could not replay some dead letters")
+	}
+
+	return gen.MessageQueueDeadLetterReplay200JSONResponse(
+		gen.ReplayDeadLettersResponse{
+			Count: len(replayed),
+		},
+	), nil
+}
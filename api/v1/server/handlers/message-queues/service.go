@@ -0,0 +1,15 @@
+package messagequeues
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type MessageQueueService struct {
+	config *server.ServerConfig
+}
+
+func NewMessageQueueService(config *server.ServerConfig) *MessageQueueService {
+	return &MessageQueueService{
+		config: config,
+	}
+}
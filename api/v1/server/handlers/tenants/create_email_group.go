@@ -3,6 +3,7 @@ package tenants
 import (
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/auditlog"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
@@ -30,6 +31,8 @@ func (t *TenantService) AlertEmailGroupCreate(ctx echo.Context, request gen.Aler
 		return nil, err
 	}
 
+	auditlog.SetResource(ctx, "alert-email-group", emailGroup.ID)
+
 	return gen.AlertEmailGroupCreate201JSONResponse(
 		*transformers.ToTenantAlertEmailGroup(emailGroup),
 	), nil
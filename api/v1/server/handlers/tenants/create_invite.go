@@ -6,6 +6,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/auditlog"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
@@ -89,6 +90,10 @@ func (t *TenantService) TenantInviteCreate(ctx echo.Context, request gen.TenantI
 		}
 	}()
 
+	auditlog.SetResource(ctx, "tenant-invite", invite.ID)
+	auditlog.AddMetadata(ctx, "inviteeEmail", invite.InviteeEmail)
+	auditlog.AddMetadata(ctx, "role", string(invite.Role))
+
 	t.config.Analytics.Enqueue("user-invite:create",
 		user.ID,
 		&invite.TenantID,
@@ -0,0 +1,69 @@
+package tenants
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/auditlog"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *TenantService) TenantRoleCreate(ctx echo.Context, request gen.TenantRoleCreateRequestObject) (gen.TenantRoleCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.TenantRoleCreate400JSONResponse(*apiErrors), nil
+	}
+
+	if taken, err := t.roleNameTaken(ctx, tenant.ID, request.Body.Name, ""); err != nil {
+		return nil, err
+	} else if taken {
+		return gen.TenantRoleCreate400JSONResponse(apierrors.NewAPIErrors("a role with this name already exists")), nil
+	}
+
+	permissions := permissionsToStrs(request.Body.Permissions)
+
+	if err := rbac.ValidatePermissions(permissions); err != nil {
+		return gen.TenantRoleCreate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	createOpts := &repository.CreateTenantRoleOpts{
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+		Permissions: permissions,
+	}
+
+	if request.Body.WorkflowIds != nil {
+		workflowIds, err := t.tenantWorkflowIds(ctx, tenant.ID, *request.Body.WorkflowIds)
+
+		if errors.Is(err, errWorkflowNotFound) {
+			return gen.TenantRoleCreate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		createOpts.WorkflowIds = workflowIds
+	}
+
+	role, err := t.config.APIRepository.TenantRole().CreateTenantRole(ctx.Request().Context(), tenant.ID, createOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	auditlog.SetResource(ctx, "tenant-role", sqlchelpers.UUIDToStr(role.ID))
+
+	return gen.TenantRoleCreate201JSONResponse(
+		*transformers.ToTenantRole(role),
+	), nil
+}
@@ -0,0 +1,101 @@
+package tenants
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *TenantService) AuditLogList(ctx echo.Context, request gen.AuditLogListRequestObject) (gen.AuditLogListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	limit := 50
+	offset := 0
+
+	listOpts := &repository.ListAuditLogsOpts{
+		Limit:  &limit,
+		Offset: &offset,
+	}
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+		listOpts.Limit = &limit
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+		listOpts.Offset = &offset
+	}
+
+	if request.Params.Actions != nil {
+		listOpts.Actions = *request.Params.Actions
+	}
+
+	if request.Params.ActorId != nil {
+		listOpts.ActorId = repository.StringPtr(request.Params.ActorId.String())
+	}
+
+	if request.Params.ResourceType != nil {
+		listOpts.ResourceType = request.Params.ResourceType
+	}
+
+	if request.Params.ResourceId != nil {
+		listOpts.ResourceId = request.Params.ResourceId
+	}
+
+	if request.Params.Since != nil {
+		listOpts.Since = request.Params.Since
+	}
+
+	if request.Params.Until != nil {
+		listOpts.Until = request.Params.Until
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	listRes, err := t.config.APIRepository.AuditLog().ListAuditLogs(dbCtx, tenant.ID, listOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.AuditLog, len(listRes.Rows))
+
+	for i, entry := range listRes.Rows {
+		auditLog, err := transformers.ToAuditLog(entry)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rows[i] = *auditLog
+	}
+
+	// use the total rows and limit to calculate the total pages
+	totalPages := int64(math.Ceil(float64(listRes.Count) / float64(limit)))
+	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
+	nextPage := currPage + 1
+
+	if currPage >= totalPages {
+		nextPage = currPage
+	}
+
+	return gen.AuditLogList200JSONResponse(
+		gen.AuditLogList{
+			Rows: &rows,
+			Pagination: &gen.PaginationResponse{
+				NumPages:    &totalPages,
+				NextPage:    &nextPage,
+				CurrentPage: &currPage,
+			},
+		},
+	), nil
+}
@@ -1,29 +1,58 @@
 package tenants
 
 import (
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
 func (t *TenantService) TenantMemberList(ctx echo.Context, request gen.TenantMemberListRequestObject) (gen.TenantMemberListResponseObject, error) {
 	tenant := ctx.Get("tenant").(*db.TenantModel)
 
-	members, err := t.config.APIRepository.Tenant().ListTenantMembers(tenant.ID)
+	rows, err := t.listTenantMembers(ctx, tenant.ID)
 
 	if err != nil {
 		return nil, err
 	}
 
+	return gen.TenantMemberList200JSONResponse{
+		Rows: &rows,
+	}, nil
+}
+
+// listTenantMembers lists the members of the tenant, along with their custom roles
+func (t *TenantService) listTenantMembers(ctx echo.Context, tenantId string) ([]gen.TenantMember, error) {
+	members, err := t.config.APIRepository.Tenant().ListTenantMembers(tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	customRoles, err := t.config.APIRepository.TenantRole().ListTenantMemberCustomRoles(ctx.Request().Context(), tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	memberToRoleId := make(map[string]uuid.UUID, len(customRoles))
+
+	for _, customRole := range customRoles {
+		memberToRoleId[sqlchelpers.UUIDToStr(customRole.MemberId)] = uuid.MustParse(sqlchelpers.UUIDToStr(customRole.RoleId))
+	}
+
 	rows := make([]gen.TenantMember, len(members))
 
 	for i := range members {
 		rows[i] = *transformers.ToTenantMember(&members[i])
+
+		if roleId, ok := memberToRoleId[members[i].ID]; ok {
+			rows[i].CustomRoleId = &roleId
+		}
 	}
 
-	return gen.TenantMemberList200JSONResponse{
-		Rows: &rows,
-	}, nil
+	return rows, nil
 }
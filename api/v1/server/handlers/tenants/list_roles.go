@@ -0,0 +1,29 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *TenantService) TenantRoleList(ctx echo.Context, request gen.TenantRoleListRequestObject) (gen.TenantRoleListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	roles, err := t.config.APIRepository.TenantRole().ListTenantRoles(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.TenantRole, len(roles))
+
+	for i := range roles {
+		rows[i] = *transformers.ToTenantRole(roles[i])
+	}
+
+	return gen.TenantRoleList200JSONResponse{
+		Rows: &rows,
+	}, nil
+}
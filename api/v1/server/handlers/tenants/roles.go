@@ -0,0 +1,64 @@
+package tenants
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// errWorkflowNotFound wraps errors which are caused by a workflow id in the request, and should be returned as a 400
+var errWorkflowNotFound = errors.New("workflow not found")
+
+func permissionsToStrs(permissions []gen.TenantPermission) []string {
+	res := make([]string, len(permissions))
+
+	for i, permission := range permissions {
+		res[i] = string(permission)
+	}
+
+	return res
+}
+
+// tenantWorkflowIds checks that each of the workflows exists in the tenant, and returns the ids as strings
+func (t *TenantService) tenantWorkflowIds(ctx echo.Context, tenantId string, workflowIds []uuid.UUID) ([]string, error) {
+	res := make([]string, 0, len(workflowIds))
+
+	for _, id := range workflowIds {
+		workflow, err := t.config.APIRepository.Workflow().GetWorkflowById(ctx.Request().Context(), id.String())
+
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+
+		if err != nil || sqlchelpers.UUIDToStr(workflow.Workflow.TenantId) != tenantId {
+			return nil, fmt.Errorf("%w: %s", errWorkflowNotFound, id.String())
+		}
+
+		res = append(res, id.String())
+	}
+
+	return res, nil
+}
+
+// roleNameTaken returns true if another role in the tenant has the name
+func (t *TenantService) roleNameTaken(ctx echo.Context, tenantId, name string, exceptId string) (bool, error) {
+	roles, err := t.config.APIRepository.TenantRole().ListTenantRoles(ctx.Request().Context(), tenantId)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, role := range roles {
+		if role.Name == name && sqlchelpers.UUIDToStr(role.ID) != exceptId {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,81 @@
+package tenants
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *TenantService) TenantMemberUpdate(ctx echo.Context, request gen.TenantMemberUpdateRequestObject) (gen.TenantMemberUpdateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if request.Body == nil {
+		return gen.TenantMemberUpdate400JSONResponse(apierrors.NewAPIErrors("request body is required")), nil
+	}
+
+	memberToUpdate, err := t.config.APIRepository.Tenant().GetTenantMemberByID(request.Member.String())
+
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, err
+	}
+
+	if err != nil || memberToUpdate.TenantID != tenant.ID {
+		return gen.TenantMemberUpdate404JSONResponse(
+			apierrors.NewAPIErrors("Member not found"),
+		), nil
+	}
+
+	var roleId *string
+
+	if request.Body.CustomRoleId != nil {
+		// owners and admins can already perform every operation, so a custom role would have no effect
+		if memberToUpdate.Role != db.TenantMemberRoleMember {
+			return gen.TenantMemberUpdate400JSONResponse(
+				apierrors.NewAPIErrors("custom roles can only be assigned to members with the MEMBER role"),
+			), nil
+		}
+
+		role, err := t.config.APIRepository.TenantRole().GetTenantRoleById(ctx.Request().Context(), request.Body.CustomRoleId.String())
+
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+
+		if err != nil || sqlchelpers.UUIDToStr(role.TenantId) != tenant.ID {
+			return gen.TenantMemberUpdate400JSONResponse(
+				apierrors.NewAPIErrors("role not found"),
+			), nil
+		}
+
+		id := request.Body.CustomRoleId.String()
+		roleId = &id
+	}
+
+	err = t.config.APIRepository.TenantRole().SetTenantMemberCustomRole(ctx.Request().Context(), memberToUpdate.ID, roleId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := t.listTenantMembers(ctx, tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		if member.Metadata.Id == memberToUpdate.ID {
+			return gen.TenantMemberUpdate200JSONResponse(member), nil
+		}
+	}
+
+	return gen.TenantMemberUpdate404JSONResponse(
+		apierrors.NewAPIErrors("Member not found"),
+	), nil
+}
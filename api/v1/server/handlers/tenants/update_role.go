@@ -0,0 +1,73 @@
+package tenants
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/auth/rbac"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *TenantService) TenantRoleUpdate(ctx echo.Context, request gen.TenantRoleUpdateRequestObject) (gen.TenantRoleUpdateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	role := ctx.Get("tenant-role").(*dbsqlc.TenantRole)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.TenantRoleUpdate400JSONResponse(*apiErrors), nil
+	}
+
+	updateOpts := &repository.UpdateTenantRoleOpts{
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+	}
+
+	if request.Body.Name != nil {
+		if taken, err := t.roleNameTaken(ctx, tenant.ID, *request.Body.Name, sqlchelpers.UUIDToStr(role.ID)); err != nil {
+			return nil, err
+		} else if taken {
+			return gen.TenantRoleUpdate400JSONResponse(apierrors.NewAPIErrors("a role with this name already exists")), nil
+		}
+	}
+
+	if request.Body.Permissions != nil {
+		permissions := permissionsToStrs(*request.Body.Permissions)
+
+		if err := rbac.ValidatePermissions(permissions); err != nil {
+			return gen.TenantRoleUpdate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+		}
+
+		updateOpts.Permissions = permissions
+	}
+
+	if request.Body.WorkflowIds != nil {
+		workflowIds, err := t.tenantWorkflowIds(ctx, tenant.ID, *request.Body.WorkflowIds)
+
+		if errors.Is(err, errWorkflowNotFound) {
+			return gen.TenantRoleUpdate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		updateOpts.WorkflowIds = &workflowIds
+	}
+
+	role, err := t.config.APIRepository.TenantRole().UpdateTenantRole(ctx.Request().Context(), sqlchelpers.UUIDToStr(role.ID), updateOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.TenantRoleUpdate200JSONResponse(
+		*transformers.ToTenantRole(role),
+	), nil
+}
@@ -0,0 +1,199 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/oauth2"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/auth/oauth"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+var ErrOIDCNotVerified = fmt.Errorf("Please verify your email with your identity provider")
+var ErrOIDCNoEmail = fmt.Errorf("OIDC user must have an email")
+var ErrOIDCMembership = fmt.Errorf("could not add OIDC user to the tenants granted by their roles")
+
+// Note: we want all errors to redirect, otherwise the user will be greeted with raw JSON in the middle of the login flow.
+func (u *UserService) UserUpdateOidcOauthCallback(ctx echo.Context, _ gen.UserUpdateOidcOauthCallbackRequestObject) (gen.UserUpdateOidcOauthCallbackResponseObject, error) {
+	if u.config.Auth.OIDCOAuthConfig == nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, nil, "Single sign-on is not enabled.")
+	}
+
+	sessionHelpers := authn.NewSessionHelpers(u.config)
+
+	isValid, _, err := sessionHelpers.ValidateOAuthState(ctx, "oidc")
+
+	if err != nil || !isValid {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not log in. Please try again and make sure cookies are enabled.")
+	}
+
+	verifier, err := sessionHelpers.GetKey(ctx, oidcVerifierKey)
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not log in. Please try again and make sure cookies are enabled.")
+	}
+
+	if err := sessionHelpers.RemoveKey(ctx, oidcVerifierKey); err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Internal error.")
+	}
+
+	token, err := u.config.Auth.OIDCOAuthConfig.Exchange(context.Background(), ctx.Request().URL.Query().Get("code"), oauth2.VerifierOption(verifier))
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Forbidden")
+	}
+
+	if !token.Valid() {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, fmt.Errorf("invalid token"), "Forbidden")
+	}
+
+	user, err := u.upsertOIDCUserFromToken(u.config, token)
+
+	if err != nil {
+		if errors.Is(err, ErrNotInRestrictedDomain) {
+			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Email is not in the restricted domain group.")
+		}
+
+		if errors.Is(err, ErrOIDCNotVerified) {
+			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Please verify your email with your identity provider.")
+		}
+
+		if errors.Is(err, ErrOIDCNoEmail) {
+			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Your identity provider did not return an email.")
+		}
+
+		if errors.Is(err, ErrOIDCMembership) {
+			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not add you to the tenants granted by your roles. Please contact your administrator.")
+		}
+
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Internal error.")
+	}
+
+	err = sessionHelpers.SaveAuthenticated(ctx, user)
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Internal error.")
+	}
+
+	return gen.UserUpdateOidcOauthCallback302Response{
+		Headers: gen.UserUpdateOidcOauthCallback302ResponseHeaders{
+			Location: u.config.Runtime.ServerURL,
+		},
+	}, nil
+}
+
+func (u *UserService) upsertOIDCUserFromToken(config *server.ServerConfig, tok *oauth2.Token) (*db.UserModel, error) {
+	info, err := config.Auth.OIDCProvider.GetUserInfo(context.Background(), tok)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Email == "" {
+		return nil, ErrOIDCNoEmail
+	}
+
+	if !info.EmailVerified {
+		return nil, ErrOIDCNotVerified
+	}
+
+	if err := u.checkUserRestrictionsForEmail(config, info.Email); err != nil {
+		return nil, err
+	}
+
+	expiresAt := tok.Expiry
+
+	// use the encryption service to encrypt the access and refresh token
+	accessTokenEncrypted, err := config.Encryption.Encrypt([]byte(tok.AccessToken), "oidc_access_token")
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt access token: %s", err.Error())
+	}
+
+	refreshTokenEncrypted, err := config.Encryption.Encrypt([]byte(tok.RefreshToken), "oidc_refresh_token")
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt refresh token: %s", err.Error())
+	}
+
+	oauthOpts := &repository.OAuthOpts{
+		Provider:       "oidc",
+		ProviderUserId: info.Sub,
+		AccessToken:    accessTokenEncrypted,
+		RefreshToken:   &refreshTokenEncrypted,
+		ExpiresAt:      &expiresAt,
+	}
+
+	user, err := u.config.APIRepository.User().GetUserByEmail(info.Email)
+
+	switch err {
+	case nil:
+		user, err = u.config.APIRepository.User().UpdateUser(user.ID, &repository.UpdateUserOpts{
+			EmailVerified: repository.BoolPtr(info.EmailVerified),
+			Name:          repository.StringPtr(info.Name),
+			OAuth:         oauthOpts,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to update user: %s", err.Error())
+		}
+	case db.ErrNotFound:
+		user, err = u.config.APIRepository.User().CreateUser(&repository.CreateUserOpts{
+			Email:         info.Email,
+			EmailVerified: repository.BoolPtr(info.EmailVerified),
+			Name:          repository.StringPtr(info.Name),
+			OAuth:         oauthOpts,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create user: %s", err.Error())
+		}
+	default:
+		return nil, fmt.Errorf("failed to get user: %s", err.Error())
+	}
+
+	if err := u.provisionOIDCMemberships(config, user, info); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrOIDCMembership, err.Error())
+	}
+
+	return user, nil
+}
+
+// provisionOIDCMemberships adds the user to the tenants granted by the role mappings. Existing memberships
+// are left unchanged, so roles which were changed in Hatchet aren't overwritten on every login.
+//
+// Memberships are never revoked here: a user who loses a claim keeps the memberships which it granted, and
+// must be removed from the tenant by an owner. Since memberships aren't marked with how they were created,
+// one granted by a claim can't be told apart from one added in Hatchet.
+func (u *UserService) provisionOIDCMemberships(config *server.ServerConfig, user *db.UserModel, info *oauth.OIDCUserInfo) error {
+	for tenantId, role := range config.Auth.OIDCProvider.TenantRoles(info) {
+		_, err := config.APIRepository.Tenant().GetTenantMemberByUserID(tenantId, user.ID)
+
+		if err == nil {
+			continue
+		}
+
+		if !errors.Is(err, db.ErrNotFound) {
+			return fmt.Errorf("could not get tenant member for tenant %s: %w", tenantId, err)
+		}
+
+		_, err = config.APIRepository.Tenant().CreateTenantMember(tenantId, &repository.CreateTenantMemberOpts{
+			Role:   role,
+			UserId: user.ID,
+		})
+
+		if err != nil {
+			return fmt.Errorf("could not add user to tenant %s: %w", tenantId, err)
+		}
+	}
+
+	return nil
+}
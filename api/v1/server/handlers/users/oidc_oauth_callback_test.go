@@ -0,0 +1,235 @@
+//go:build integration
+
+package users_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/users"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+// testTenantId is the tenant which is created by testutils.Prepare
+const testTenantId = "707d0855-80ab-4e1f-a156-f1c4546cbf52"
+
+// testIdentityProvider is a local OpenID Connect provider which issues a fixed access token for the
+// code "test-code", as long as the PKCE verifier matches the challenge of the authorization request.
+type testIdentityProvider struct {
+	*httptest.Server
+
+	challenge string
+	userInfo  map[string]interface{}
+}
+
+func newTestIdentityProvider(t *testing.T, userInfo map[string]interface{}) *testIdentityProvider {
+	mux := http.NewServeMux()
+
+	idp := &testIdentityProvider{
+		Server:   httptest.NewServer(mux),
+		userInfo: userInfo,
+	}
+
+	t.Cleanup(idp.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.URL,
+			"authorization_endpoint": idp.URL + "/authorize",
+			"token_endpoint":         idp.URL + "/token",
+			"userinfo_endpoint":      idp.URL + "/userinfo",
+		})
+	})
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		verifier := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+
+		if r.FormValue("code") != "test-code" || base64.RawURLEncoding.EncodeToString(verifier[:]) != idp.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  "test-access-token",
+			"refresh_token": "test-refresh-token",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+		})
+	})
+
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(idp.userInfo)
+	})
+
+	return idp
+}
+
+// newOIDCServerConfig loads a server config which uses the identity provider for OIDC login.
+func newOIDCServerConfig(t *testing.T, idp *testIdentityProvider, roleMappings []string) *server.ServerConfig {
+	_, b, _, _ := runtime.Caller(0)
+	testPath := filepath.Dir(b)
+
+	configLoader := loader.NewConfigLoader(path.Join(testPath, "../../../../../generated"))
+
+	cleanup, sc, err := configLoader.CreateServerFromConfig("", func(scf *server.ServerConfigFile) {
+		// disable security checks since we're not running the server
+		scf.SecurityCheck.Enabled = false
+
+		scf.Auth.OIDC.Enabled = true
+		scf.Auth.OIDC.IssuerURL = idp.URL
+		scf.Auth.OIDC.ClientID = "client"
+		scf.Auth.OIDC.ClientSecret = "secret"
+		scf.Auth.OIDC.RolesClaim = "groups"
+		scf.Auth.OIDC.RoleMappings = roleMappings
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = sc.Disconnect()
+		_ = cleanup()
+	})
+
+	return sc
+}
+
+// login runs the OIDC login flow from the start endpoint through the callback, and returns the recorder
+// of the callback request.
+func login(t *testing.T, sc *server.ServerConfig, idp *testIdentityProvider) *httptest.ResponseRecorder {
+	u := users.NewUserService(sc)
+	e := echo.New()
+
+	startRec := httptest.NewRecorder()
+	startCtx := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/users/oidc/start", nil), startRec)
+
+	startResp, err := u.UserUpdateOidcOauthStart(startCtx, gen.UserUpdateOidcOauthStartRequestObject{})
+	require.NoError(t, err)
+
+	authURL, err := url.Parse(startResp.(gen.UserUpdateOidcOauthStart302Response).Headers.Location)
+	require.NoError(t, err)
+
+	require.Equal(t, "S256", authURL.Query().Get("code_challenge_method"))
+	idp.challenge = authURL.Query().Get("code_challenge")
+
+	callbackReq := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/users/oidc/callback?code=test-code&state="+url.QueryEscape(authURL.Query().Get("state")),
+		nil,
+	)
+
+	for _, cookie := range startRec.Result().Cookies() {
+		callbackReq.AddCookie(cookie)
+	}
+
+	callbackRec := httptest.NewRecorder()
+	callbackCtx := e.NewContext(callbackReq, callbackRec)
+
+	callbackResp, err := u.UserUpdateOidcOauthCallback(callbackCtx, gen.UserUpdateOidcOauthCallbackRequestObject{})
+
+	if err != nil {
+		require.ErrorIs(t, err, redirect.ErrRedirect)
+		return callbackRec
+	}
+
+	require.NoError(t, callbackResp.VisitUserUpdateOidcOauthCallbackResponse(callbackRec))
+
+	return callbackRec
+}
+
+func newUserInfo(email string, groups ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"sub":            uuid.New().String(),
+		"email":          email,
+		"email_verified": true,
+		"name":           "Test User",
+		"groups":         groups,
+	}
+}
+
+func TestOIDCCallbackProvisionsMemberships(t *testing.T) {
+	testutils.Prepare(t)
+
+	email := uuid.New().String() + "@example.com"
+
+	idp := newTestIdentityProvider(t, newUserInfo(email, "engineering", "admins"))
+
+	sc := newOIDCServerConfig(t, idp, []string{
+		"engineering:" + testTenantId + ":MEMBER",
+		"admins:" + testTenantId + ":ADMIN",
+	})
+
+	rec := login(t, sc, idp)
+
+	assert.Equal(t, http.StatusFound, rec.Code)
+	assert.Equal(t, sc.Runtime.ServerURL, rec.Header().Get("Location"))
+
+	user, err := sc.APIRepository.User().GetUserByEmail(email)
+	require.NoError(t, err)
+
+	// the most privileged role which the claims grant wins
+	member, err := sc.APIRepository.Tenant().GetTenantMemberByUserID(testTenantId, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, db.TenantMemberRoleAdmin, member.Role)
+
+	// later logins leave the membership unchanged, even when the claim which granted it is removed
+	idp.userInfo["groups"] = []interface{}{}
+
+	rec = login(t, sc, idp)
+
+	assert.Equal(t, sc.Runtime.ServerURL, rec.Header().Get("Location"))
+
+	member, err = sc.APIRepository.Tenant().GetTenantMemberByUserID(testTenantId, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, db.TenantMemberRoleAdmin, member.Role)
+}
+
+func TestOIDCCallbackFailsWhenMembershipCannotBeCreated(t *testing.T) {
+	testutils.Prepare(t)
+
+	email := uuid.New().String() + "@example.com"
+	missingTenantId := uuid.New().String()
+
+	idp := newTestIdentityProvider(t, newUserInfo(email, "engineering"))
+
+	sc := newOIDCServerConfig(t, idp, []string{
+		"engineering:" + missingTenantId + ":MEMBER",
+	})
+
+	rec := login(t, sc, idp)
+
+	// the user is sent back to the login page instead of being logged in without their memberships
+	assert.Equal(t, http.StatusFound, rec.Code)
+	assert.True(t, strings.HasPrefix(rec.Header().Get("Location"), "/auth/login?error="))
+
+	user, err := sc.APIRepository.User().GetUserByEmail(email)
+	require.NoError(t, err)
+
+	_, err = sc.APIRepository.Tenant().GetTenantMemberByUserID(missingTenantId, user.ID)
+	assert.True(t, errors.Is(err, db.ErrNotFound))
+}
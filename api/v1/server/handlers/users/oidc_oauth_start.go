@@ -0,0 +1,46 @@
+package users
+
+import (
+	"github.com/labstack/echo/v4"
+	"golang.org/x/oauth2"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+)
+
+const oidcVerifierKey = "oidc_pkce_verifier"
+
+// Note: we want all errors to redirect, otherwise the user will be greeted with raw JSON in the middle of the login flow.
+func (u *UserService) UserUpdateOidcOauthStart(ctx echo.Context, _ gen.UserUpdateOidcOauthStartRequestObject) (gen.UserUpdateOidcOauthStartResponseObject, error) {
+	if !u.config.Runtime.AllowSignup {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, nil, "User signup is disabled.")
+	}
+
+	if u.config.Auth.OIDCOAuthConfig == nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, nil, "Single sign-on is not enabled.")
+	}
+
+	sessionHelpers := authn.NewSessionHelpers(u.config)
+
+	state, err := sessionHelpers.SaveOAuthState(ctx, "oidc")
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not get cookie. Please make sure cookies are enabled.")
+	}
+
+	// the PKCE verifier is stored in the session, so that an intercepted code can't be exchanged by anyone else
+	verifier := oauth2.GenerateVerifier()
+
+	if err := sessionHelpers.SaveKV(ctx, oidcVerifierKey, verifier); err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not get cookie. Please make sure cookies are enabled.")
+	}
+
+	url := u.config.Auth.OIDCOAuthConfig.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier))
+
+	return gen.UserUpdateOidcOauthStart302Response{
+		Headers: gen.UserUpdateOidcOauthStart302ResponseHeaders{
+			Location: url,
+		},
+	}, nil
+}
@@ -0,0 +1,49 @@
+package concurrency
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *V2ConcurrencyService) V2ConcurrencyList(ctx echo.Context, request gen.V2ConcurrencyListRequestObject) (gen.V2ConcurrencyListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	keyLimit := 50
+
+	if request.Params.KeyLimit != nil {
+		keyLimit = int(*request.Params.KeyLimit)
+	}
+
+	if keyLimit < 0 || keyLimit > 1000 {
+		return gen.V2ConcurrencyList400JSONResponse(apierrors.NewAPIErrors("key_limit must be between 0 and 1000")), nil
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	strategies, err := t.config.V2.Scheduler().Concurrency().ListConcurrencyUsage(dbCtx, sqlchelpers.UUIDFromStr(tenant.ID), keyLimit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.V2ConcurrencyStrategyUsage, len(strategies))
+
+	for i, strategy := range strategies {
+		rows[i] = transformers.ToConcurrencyStrategyUsage(strategy)
+	}
+
+	return gen.V2ConcurrencyList200JSONResponse(
+		gen.V2ConcurrencyStrategyUsageList{
+			Rows: rows,
+		},
+	), nil
+}
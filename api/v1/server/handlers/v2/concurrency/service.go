@@ -0,0 +1,15 @@
+package concurrency
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type V2ConcurrencyService struct {
+	config *server.ServerConfig
+}
+
+func NewV2ConcurrencyService(config *server.ServerConfig) *V2ConcurrencyService {
+	return &V2ConcurrencyService{
+		config: config,
+	}
+}
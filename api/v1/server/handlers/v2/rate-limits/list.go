@@ -0,0 +1,74 @@
+package ratelimits
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+)
+
+func (t *V2RateLimitsService) V2RateLimitList(ctx echo.Context, request gen.V2RateLimitListRequestObject) (gen.V2RateLimitListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	limit := 50
+	offset := 0
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+	}
+
+	if limit < 1 || limit > 1000 || offset < 0 {
+		return gen.V2RateLimitList400JSONResponse(apierrors.NewAPIErrors("limit must be between 1 and 1000, and offset must not be negative")), nil
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	listRes, err := t.config.V2.Scheduler().RateLimit().ListRateLimitUsage(dbCtx, sqlchelpers.UUIDFromStr(tenant.ID), &v2.ListRateLimitUsageOpts{
+		Search: request.Params.Search,
+		Limit:  &limit,
+		Offset: &offset,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.V2RateLimitUsage, len(listRes.Rows))
+
+	for i, usage := range listRes.Rows {
+		rows[i] = transformers.ToRateLimitUsage(usage)
+	}
+
+	// use the total rows and limit to calculate the total pages
+	totalPages := int64(math.Ceil(float64(listRes.Count) / float64(limit)))
+	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
+	nextPage := currPage + 1
+
+	if currPage >= totalPages {
+		nextPage = currPage
+	}
+
+	return gen.V2RateLimitList200JSONResponse(
+		gen.V2RateLimitUsageList{
+			Rows: rows,
+			Pagination: gen.PaginationResponse{
+				NumPages:    &totalPages,
+				NextPage:    &nextPage,
+				CurrentPage: &currPage,
+			},
+		},
+	), nil
+}
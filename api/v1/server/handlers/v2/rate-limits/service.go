@@ -0,0 +1,15 @@
+package ratelimits
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type V2RateLimitsService struct {
+	config *server.ServerConfig
+}
+
+func NewV2RateLimitsService(config *server.ServerConfig) *V2RateLimitsService {
+	return &V2RateLimitsService{
+		config: config,
+	}
+}
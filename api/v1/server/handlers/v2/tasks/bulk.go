@@ -0,0 +1,155 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authz"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/taskactions"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// errInvalidBulkRequest wraps errors which are caused by the request, and should be returned as a 400
+var errInvalidBulkRequest = errors.New("invalid request")
+
+// resolveTasks returns the tasks selected by either a list of external ids or a filter
+func (t *TasksService) resolveTasks(ctx echo.Context, tenantId string, externalIds *[]uuid.UUID, filter *gen.V2TaskFilter) ([]*taskactions.Task, error) {
+	if (externalIds == nil) == (filter == nil) {
+		return nil, fmt.Errorf("%w: exactly one of externalIds and filter must be set", errInvalidBulkRequest)
+	}
+
+	if externalIds != nil {
+		if len(*externalIds) > taskactions.MaxExternalIds {
+			return nil, fmt.Errorf("%w: at most %d external ids can be passed", errInvalidBulkRequest, taskactions.MaxExternalIds)
+		}
+
+		ids := make([]string, len(*externalIds))
+
+		for i, id := range *externalIds {
+			ids[i] = id.String()
+		}
+
+		tasks, err := t.bulk.TasksByExternalIds(ctx.Request().Context(), tenantId, ids)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// tasks of workflows which the member's role doesn't permit are skipped, like tasks of other tenants
+		res := make([]*taskactions.Task, 0, len(tasks))
+
+		for _, task := range tasks {
+			if authz.WorkflowPermitted(ctx, task.WorkflowID) {
+				res = append(res, task)
+			}
+		}
+
+		return res, nil
+	}
+
+	opts, err := taskFilterToOpts(filter)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidBulkRequest, err.Error())
+	}
+
+	workflowIds, ok := authz.RestrictWorkflowIds(ctx, opts.WorkflowIds)
+
+	if !ok {
+		return []*taskactions.Task{}, nil
+	}
+
+	opts.WorkflowIds = workflowIds
+
+	tasks, err := t.bulk.TasksByFilter(ctx.Request().Context(), tenantId, opts)
+
+	if errors.Is(err, taskactions.ErrTooManyResults) {
+		return nil, fmt.Errorf("%w: %s", errInvalidBulkRequest, err.Error())
+	}
+
+	return tasks, err
+}
+
+func taskFilterToOpts(filter *gen.V2TaskFilter) (repository.ListTaskRunOpts, error) {
+	opts := repository.ListTaskRunOpts{
+		CreatedAfter: filter.Since,
+		Statuses: []gen.V2TaskStatus{
+			gen.V2TaskStatusCANCELLED,
+			gen.V2TaskStatusCOMPLETED,
+			gen.V2TaskStatusFAILED,
+			gen.V2TaskStatusQUEUED,
+			gen.V2TaskStatusRUNNING,
+		},
+		WorkflowIds:    []uuid.UUID{},
+		WorkerId:       filter.WorkerId,
+		FinishedBefore: filter.Until,
+		DisplayName:    filter.DisplayName,
+		ErrorContains:  filter.ErrorContains,
+	}
+
+	if filter.Statuses != nil && len(*filter.Statuses) > 0 {
+		opts.Statuses = *filter.Statuses
+	}
+
+	if filter.WorkflowIds != nil {
+		opts.WorkflowIds = *filter.WorkflowIds
+	}
+
+	if filter.AdditionalMetadata != nil {
+		additionalMetadataFilters := make(map[string]interface{})
+
+		for _, v := range *filter.AdditionalMetadata {
+			kv_pairs := strings.Split(v, ":")
+			if len(kv_pairs) == 2 {
+				additionalMetadataFilters[kv_pairs[0]] = kv_pairs[1]
+			}
+		}
+
+		opts.AdditionalMetadata = additionalMetadataFilters
+	}
+
+	if filter.ErrorRegex != nil {
+		if err := repository.ValidateErrorRegex(*filter.ErrorRegex); err != nil {
+			return opts, err
+		}
+
+		opts.ErrorRegex = filter.ErrorRegex
+	}
+
+	if filter.InputFilters != nil {
+		filters, err := repository.ParseJSONFilters(*filter.InputFilters)
+
+		if err != nil {
+			return opts, err
+		}
+
+		opts.InputFilters = filters
+	}
+
+	if filter.OutputFilters != nil {
+		filters, err := repository.ParseJSONFilters(*filter.OutputFilters)
+
+		if err != nil {
+			return opts, err
+		}
+
+		opts.OutputFilters = filters
+	}
+
+	return opts, nil
+}
+
+func externalIdsFromTasks(tasks []*taskactions.Task) []uuid.UUID {
+	res := make([]uuid.UUID, 0, len(tasks))
+
+	for _, task := range tasks {
+		res = append(res, uuid.MustParse(task.ExternalID))
+	}
+
+	return res
+}
@@ -0,0 +1,44 @@
+package tasks
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/auditlog"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *TasksService) V2TaskCancel(ctx echo.Context, request gen.V2TaskCancelRequestObject) (gen.V2TaskCancelResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if request.Body == nil {
+		return gen.V2TaskCancel400JSONResponse(apierrors.NewAPIErrors("request body is required")), nil
+	}
+
+	tasks, err := t.resolveTasks(ctx, tenant.ID, request.Body.ExternalIds, request.Body.Filter)
+
+	if errors.Is(err, errInvalidBulkRequest) {
+		return gen.V2TaskCancel400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	cancelled, err := t.bulk.CancelTasks(ctx.Request().Context(), tenant.ID, tasks)
+
+	if err != nil {
+		return nil, err
+	}
+
+	externalIds := externalIdsFromTasks(cancelled)
+
+	auditlog.AddMetadata(ctx, "externalIds", externalIds)
+
+	return gen.V2TaskCancel200JSONResponse(
+		gen.V2CancelTasksResponse{
+			ExternalIds: externalIds,
+		},
+	), nil
+}
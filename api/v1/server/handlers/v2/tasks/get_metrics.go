@@ -2,6 +2,8 @@ package tasks
 
 import (
 	"github.com/google/uuid"
+	"github.com/hatchet-dev/hatchet/api/v1/server/authz"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v2"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
@@ -18,6 +20,12 @@ func (t *TasksService) V2TaskListStatusMetrics(ctx echo.Context, request gen.V2T
 		workflowIds = *request.Params.WorkflowIds
 	}
 
+	workflowIds, ok := authz.RestrictWorkflowIds(ctx, workflowIds)
+
+	if !ok {
+		return gen.V2TaskListStatusMetrics403JSONResponse(apierrors.NewAPIErrors("your role does not permit reading metrics of these workflows")), nil
+	}
+
 	metrics, err := t.config.EngineRepository.OLAP().ReadTaskRunMetrics(ctx.Request().Context(), tenant.ID, repository.ReadTaskRunMetricsOpts{
 		CreatedAfter: request.Params.Since,
 		WorkflowIds:  workflowIds,
@@ -0,0 +1,33 @@
+package tasks
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/olapv2"
+	schedulingv2 "github.com/hatchet-dev/hatchet/pkg/scheduling/v2"
+)
+
+func (t *TasksService) V2TaskGetQueueDiagnosis(ctx echo.Context, request gen.V2TaskGetQueueDiagnosisRequestObject) (gen.V2TaskGetQueueDiagnosisResponseObject, error) {
+	task := ctx.Get("task").(*olapv2.V2TasksOlap)
+
+	input, err := schedulingv2.LoadQueuedTaskDiagnosisInput(
+		ctx.Request().Context(),
+		t.config.V2,
+		sqlchelpers.UUIDToStr(task.TenantID),
+		task.ID,
+		task.InsertedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := transformers.ToTaskQueueDiagnosis(schedulingv2.DiagnoseQueuedTask(input))
+
+	return gen.V2TaskGetQueueDiagnosis200JSONResponse(
+		result,
+	), nil
+}
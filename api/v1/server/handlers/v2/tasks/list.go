@@ -6,6 +6,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/authz"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v2"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
@@ -48,6 +50,12 @@ func (t *TasksService) V2TaskList(ctx echo.Context, request gen.V2TaskListReques
 		workflowIds = *request.Params.WorkflowIds
 	}
 
+	workflowIds, ok := authz.RestrictWorkflowIds(ctx, workflowIds)
+
+	if !ok {
+		return gen.V2TaskList403JSONResponse(apierrors.NewAPIErrors("your role does not permit listing runs of these workflows")), nil
+	}
+
 	if request.Params.WorkerId != nil {
 		workerId = request.Params.WorkerId
 	}
@@ -78,6 +86,52 @@ func (t *TasksService) V2TaskList(ctx echo.Context, request gen.V2TaskListReques
 		opts.FinishedBefore = request.Params.Until
 	}
 
+	if request.Params.DisplayName != nil {
+		opts.DisplayName = request.Params.DisplayName
+	}
+
+	if request.Params.ErrorContains != nil {
+		opts.ErrorContains = request.Params.ErrorContains
+	}
+
+	if request.Params.ErrorRegex != nil {
+		if err := repository.ValidateErrorRegex(*request.Params.ErrorRegex); err != nil {
+			return gen.V2TaskList400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+		}
+
+		opts.ErrorRegex = request.Params.ErrorRegex
+	}
+
+	if request.Params.InputFilters != nil {
+		filters, err := repository.ParseJSONFilters(*request.Params.InputFilters)
+
+		if err != nil {
+			return gen.V2TaskList400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+		}
+
+		opts.InputFilters = filters
+	}
+
+	if request.Params.OutputFilters != nil {
+		filters, err := repository.ParseJSONFilters(*request.Params.OutputFilters)
+
+		if err != nil {
+			return gen.V2TaskList400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+		}
+
+		opts.OutputFilters = filters
+	}
+
+	if request.Params.Cursor != nil {
+		cursor, err := repository.DecodeListCursor(*request.Params.Cursor)
+
+		if err != nil {
+			return gen.V2TaskList400JSONResponse(apierrors.NewAPIErrors("invalid cursor")), nil
+		}
+
+		opts.Cursor = cursor
+	}
+
 	tasks, total, err := t.config.EngineRepository.OLAP().ListTasks(
 		ctx.Request().Context(),
 		tenant.ID,
@@ -88,7 +142,7 @@ func (t *TasksService) V2TaskList(ctx echo.Context, request gen.V2TaskListReques
 		return nil, err
 	}
 
-	result := transformers.ToTaskSummaryMany(tasks, total, limit, offset)
+	result := transformers.ToTaskSummaryMany(tasks, total, limit, offset, opts.Cursor)
 
 	// Search for api errors to see how we handle errors in other cases
 	return gen.V2TaskList200JSONResponse(
@@ -0,0 +1,44 @@
+package tasks
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/auditlog"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *TasksService) V2TaskReplay(ctx echo.Context, request gen.V2TaskReplayRequestObject) (gen.V2TaskReplayResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if request.Body == nil {
+		return gen.V2TaskReplay400JSONResponse(apierrors.NewAPIErrors("request body is required")), nil
+	}
+
+	tasks, err := t.resolveTasks(ctx, tenant.ID, request.Body.ExternalIds, request.Body.Filter)
+
+	if errors.Is(err, errInvalidBulkRequest) {
+		return gen.V2TaskReplay400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	replayed, err := t.bulk.ReplayTasks(ctx.Request().Context(), tenant.ID, tasks)
+
+	if err != nil {
+		return nil, err
+	}
+
+	externalIds := externalIdsFromTasks(replayed)
+
+	auditlog.AddMetadata(ctx, "externalIds", externalIds)
+
+	return gen.V2TaskReplay200JSONResponse(
+		gen.V2ReplayTasksResponse{
+			ExternalIds: externalIds,
+		},
+	), nil
+}
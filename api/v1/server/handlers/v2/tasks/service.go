@@ -1,15 +1,18 @@
 package tasks
 
 import (
+	"github.com/hatchet-dev/hatchet/internal/services/shared/taskactions"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 )
 
 type TasksService struct {
 	config *server.ServerConfig
+	bulk   *taskactions.BulkActions
 }
 
 func NewTasksService(config *server.ServerConfig) *TasksService {
 	return &TasksService{
 		config: config,
+		bulk:   taskactions.NewBulkActions(config.EngineRepository.OLAP(), config.V2, config.MessageQueue),
 	}
 }
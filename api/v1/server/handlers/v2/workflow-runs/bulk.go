@@ -0,0 +1,126 @@
+package workflowruns
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authz"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/taskactions"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// errInvalidBulkRequest wraps errors which are caused by the request, and should be returned as a 400
+var errInvalidBulkRequest = errors.New("invalid request")
+
+// resolveWorkflowRuns returns the workflow runs selected by either a list of external ids or a filter
+func (t *V2WorkflowRunsService) resolveWorkflowRuns(ctx echo.Context, tenantId string, externalIds *[]uuid.UUID, filter *gen.V2WorkflowRunFilter) ([]*taskactions.WorkflowRun, error) {
+	if (externalIds == nil) == (filter == nil) {
+		return nil, fmt.Errorf("%w: exactly one of externalIds and filter must be set", errInvalidBulkRequest)
+	}
+
+	if externalIds != nil {
+		if len(*externalIds) > taskactions.MaxExternalIds {
+			return nil, fmt.Errorf("%w: at most %d external ids can be passed", errInvalidBulkRequest, taskactions.MaxExternalIds)
+		}
+
+		ids := make([]string, len(*externalIds))
+
+		for i, id := range *externalIds {
+			ids[i] = id.String()
+		}
+
+		workflowRuns, err := t.bulk.WorkflowRunsByExternalIds(ctx.Request().Context(), tenantId, ids)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// workflow runs which the member's role doesn't permit are skipped, like workflow runs of other tenants
+		res := make([]*taskactions.WorkflowRun, 0, len(workflowRuns))
+
+		for _, workflowRun := range workflowRuns {
+			if authz.WorkflowPermitted(ctx, workflowRun.WorkflowID) {
+				res = append(res, workflowRun)
+			}
+		}
+
+		return res, nil
+	}
+
+	opts := repository.ListWorkflowRunOpts{
+		CreatedAfter: filter.Since,
+		Statuses: []gen.V2TaskStatus{
+			gen.V2TaskStatusCANCELLED,
+			gen.V2TaskStatusCOMPLETED,
+			gen.V2TaskStatusFAILED,
+			gen.V2TaskStatusQUEUED,
+			gen.V2TaskStatusRUNNING,
+		},
+		WorkflowIds:    []uuid.UUID{},
+		FinishedBefore: filter.Until,
+	}
+
+	if filter.Statuses != nil && len(*filter.Statuses) > 0 {
+		opts.Statuses = *filter.Statuses
+	}
+
+	if filter.WorkflowIds != nil {
+		opts.WorkflowIds = *filter.WorkflowIds
+	}
+
+	if filter.AdditionalMetadata != nil {
+		additionalMetadataFilters := make(map[string]interface{})
+
+		for _, v := range *filter.AdditionalMetadata {
+			kv_pairs := strings.Split(v, ":")
+			if len(kv_pairs) == 2 {
+				additionalMetadataFilters[kv_pairs[0]] = kv_pairs[1]
+			}
+		}
+
+		opts.AdditionalMetadata = additionalMetadataFilters
+	}
+
+	workflowIds, ok := authz.RestrictWorkflowIds(ctx, opts.WorkflowIds)
+
+	if !ok {
+		return []*taskactions.WorkflowRun{}, nil
+	}
+
+	opts.WorkflowIds = workflowIds
+
+	workflowRuns, err := t.bulk.WorkflowRunsByFilter(ctx.Request().Context(), tenantId, opts)
+
+	if errors.Is(err, taskactions.ErrTooManyResults) {
+		return nil, fmt.Errorf("%w: %s", errInvalidBulkRequest, err.Error())
+	}
+
+	return workflowRuns, err
+}
+
+// affectedWorkflowRuns returns the external ids of the workflow runs which contain at least one of the tasks
+func affectedWorkflowRuns(workflowRuns []*taskactions.WorkflowRun, tasks []*taskactions.Task) []uuid.UUID {
+	taskIds := make(map[int64]struct{}, len(tasks))
+
+	for _, task := range tasks {
+		taskIds[task.ID] = struct{}{}
+	}
+
+	res := make([]uuid.UUID, 0)
+
+	for _, workflowRun := range workflowRuns {
+		for _, task := range workflowRun.Tasks {
+			if _, ok := taskIds[task.ID]; ok {
+				res = append(res, uuid.MustParse(workflowRun.ExternalID))
+				break
+			}
+		}
+	}
+
+	return res
+}
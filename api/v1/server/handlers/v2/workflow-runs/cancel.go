@@ -0,0 +1,51 @@
+package workflowruns
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/auditlog"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/taskactions"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *V2WorkflowRunsService) V2WorkflowRunCancel(ctx echo.Context, request gen.V2WorkflowRunCancelRequestObject) (gen.V2WorkflowRunCancelResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if request.Body == nil {
+		return gen.V2WorkflowRunCancel400JSONResponse(apierrors.NewAPIErrors("request body is required")), nil
+	}
+
+	workflowRuns, err := t.resolveWorkflowRuns(ctx, tenant.ID, request.Body.ExternalIds, request.Body.Filter)
+
+	if errors.Is(err, errInvalidBulkRequest) {
+		return gen.V2WorkflowRunCancel400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*taskactions.Task, 0)
+
+	for _, workflowRun := range workflowRuns {
+		tasks = append(tasks, workflowRun.Tasks...)
+	}
+
+	cancelled, err := t.bulk.CancelTasks(ctx.Request().Context(), tenant.ID, tasks)
+
+	if err != nil {
+		return nil, err
+	}
+
+	externalIds := affectedWorkflowRuns(workflowRuns, cancelled)
+
+	auditlog.AddMetadata(ctx, "externalIds", externalIds)
+
+	return gen.V2WorkflowRunCancel200JSONResponse(
+		gen.V2CancelWorkflowRunsResponse{
+			ExternalIds: externalIds,
+		},
+	), nil
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/authz"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v2"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
@@ -47,6 +49,12 @@ func (t *V2WorkflowRunsService) V2WorkflowRunList(ctx echo.Context, request gen.
 		workflowIds = *request.Params.WorkflowIds
 	}
 
+	workflowIds, ok := authz.RestrictWorkflowIds(ctx, workflowIds)
+
+	if !ok {
+		return gen.V2WorkflowRunList403JSONResponse(apierrors.NewAPIErrors("your role does not permit listing runs of these workflows")), nil
+	}
+
 	opts := repository.ListWorkflowRunOpts{
 		CreatedAfter: since,
 		Statuses:     statuses,
@@ -72,6 +80,16 @@ func (t *V2WorkflowRunsService) V2WorkflowRunList(ctx echo.Context, request gen.
 		opts.FinishedBefore = request.Params.Until
 	}
 
+	if request.Params.Cursor != nil {
+		cursor, err := repository.DecodeListCursor(*request.Params.Cursor)
+
+		if err != nil {
+			return gen.V2WorkflowRunList400JSONResponse(apierrors.NewAPIErrors("invalid cursor")), nil
+		}
+
+		opts.Cursor = cursor
+	}
+
 	tasks, total, err := t.config.EngineRepository.OLAP().ListWorkflowRuns(
 		ctx.Request().Context(),
 		tenant.ID,
@@ -82,7 +100,7 @@ func (t *V2WorkflowRunsService) V2WorkflowRunList(ctx echo.Context, request gen.
 		return nil, err
 	}
 
-	result := transformers.ToWorkflowRunMany(tasks, total, limit, offset)
+	result := transformers.ToWorkflowRunMany(tasks, total, limit, offset, opts.Cursor)
 
 	// Search for api errors to see how we handle errors in other cases
 	return gen.V2WorkflowRunList200JSONResponse(
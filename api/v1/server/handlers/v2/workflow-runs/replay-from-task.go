@@ -0,0 +1,99 @@
+package workflowruns
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/olapv2"
+)
+
+func (t *V2WorkflowRunsService) V2WorkflowRunReplayFromTask(ctx echo.Context, request gen.V2WorkflowRunReplayFromTaskRequestObject) (gen.V2WorkflowRunReplayFromTaskResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	rawWorkflowRun := ctx.Get("v2-workflow-run").(*repository.V2WorkflowRunPopulator)
+
+	if request.Body == nil {
+		return gen.V2WorkflowRunReplayFromTask400JSONResponse(apierrors.NewAPIErrors("request body is required")), nil
+	}
+
+	if rawWorkflowRun.WorkflowRun.Kind != olapv2.V2RunKindDAG {
+		return gen.V2WorkflowRunReplayFromTask400JSONResponse(apierrors.NewAPIErrors("only DAG workflow runs can be replayed from a task")), nil
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	task, err := t.config.EngineRepository.OLAP().ReadTaskRun(reqCtx, request.Body.TaskExternalId.String())
+
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && task == nil) {
+		return gen.V2WorkflowRunReplayFromTask404JSONResponse(apierrors.NewAPIErrors("task not found")), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	isInWorkflowRun := false
+
+	for _, taskMetadata := range rawWorkflowRun.TaskMetadata {
+		if taskMetadata.TaskID == task.ID {
+			isInWorkflowRun = true
+			break
+		}
+	}
+
+	if !isInWorkflowRun || sqlchelpers.UUIDToStr(task.TenantID) != tenant.ID {
+		return gen.V2WorkflowRunReplayFromTask404JSONResponse(apierrors.NewAPIErrors("task not found in workflow run")), nil
+	}
+
+	res, err := t.config.V2.Tasks().ReplayDAGFromTask(reqCtx, tenant.ID, task.ID)
+
+	if errors.Is(err, v2.ErrInvalidDAGReplay) {
+		return gen.V2WorkflowRunReplayFromTask400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	payload := tasktypes.ReplayedDAGPayload{
+		TaskId:     res.ReplayedTask.ID,
+		RetryCount: res.ReplayedTask.RetryCount,
+		ResetTasks: make([]tasktypes.ResetTaskPayload, 0, len(res.ResetTasks)),
+	}
+
+	externalIds := []uuid.UUID{uuid.UUID(res.ReplayedTask.ExternalID.Bytes)}
+
+	for _, resetTask := range res.ResetTasks {
+		payload.ResetTasks = append(payload.ResetTasks, tasktypes.ResetTaskPayload{
+			TaskId:     resetTask.ID,
+			RetryCount: resetTask.RetryCount,
+		})
+
+		externalIds = append(externalIds, uuid.UUID(resetTask.ExternalID.Bytes))
+	}
+
+	msg, err := tasktypes.ReplayedDAGMessage(tenant.ID, payload)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create dag replayed message: %w", err)
+	}
+
+	err = t.config.MessageQueue.SendMessage(reqCtx, msgqueue.TASK_PROCESSING_QUEUE, msg)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not send dag replayed message: %w", err)
+	}
+
+	return gen.V2WorkflowRunReplayFromTask200JSONResponse(
+		gen.V2ReplayWorkflowRunFromTaskResponse{
+			ExternalIds: externalIds,
+		},
+	), nil
+}
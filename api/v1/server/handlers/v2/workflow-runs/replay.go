@@ -0,0 +1,74 @@
+package workflowruns
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/auditlog"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/taskactions"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/olapv2"
+)
+
+func (t *V2WorkflowRunsService) V2WorkflowRunReplay(ctx echo.Context, request gen.V2WorkflowRunReplayRequestObject) (gen.V2WorkflowRunReplayResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if request.Body == nil {
+		return gen.V2WorkflowRunReplay400JSONResponse(apierrors.NewAPIErrors("request body is required")), nil
+	}
+
+	workflowRuns, err := t.resolveWorkflowRuns(ctx, tenant.ID, request.Body.ExternalIds, request.Body.Filter)
+
+	if errors.Is(err, errInvalidBulkRequest) {
+		return gen.V2WorkflowRunReplay400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	reqCtx := ctx.Request().Context()
+	tasks := make([]*taskactions.Task, 0)
+
+	for _, workflowRun := range workflowRuns {
+		if workflowRun.Kind == olapv2.V2RunKindTASK {
+			tasks = append(tasks, workflowRun.Tasks...)
+		}
+	}
+
+	replayed, err := t.bulk.ReplayTasks(reqCtx, tenant.ID, tasks)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// DAGs are replayed from their failed or cancelled tasks, since replaying each of their tasks independently
+	// would run child tasks without their parents
+	replayedFromDAGs, skipped, err := t.bulk.ReplayDAGs(reqCtx, tenant.ID, workflowRuns)
+
+	if err != nil {
+		return nil, err
+	}
+
+	externalIds := affectedWorkflowRuns(workflowRuns, append(replayed, replayedFromDAGs...))
+
+	skippedRuns := make([]gen.V2SkippedWorkflowRun, 0, len(skipped))
+
+	for _, workflowRun := range skipped {
+		skippedRuns = append(skippedRuns, gen.V2SkippedWorkflowRun{
+			ExternalId: uuid.MustParse(workflowRun.ExternalID),
+			Reason:     workflowRun.Reason,
+		})
+	}
+
+	auditlog.AddMetadata(ctx, "externalIds", externalIds)
+
+	return gen.V2WorkflowRunReplay200JSONResponse(
+		gen.V2ReplayWorkflowRunsResponse{
+			ExternalIds: externalIds,
+			Skipped:     skippedRuns,
+		},
+	), nil
+}
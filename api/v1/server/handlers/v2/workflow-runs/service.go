@@ -1,15 +1,18 @@
 package workflowruns
 
 import (
+	"github.com/hatchet-dev/hatchet/internal/services/shared/taskactions"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 )
 
 type V2WorkflowRunsService struct {
 	config *server.ServerConfig
+	bulk   *taskactions.BulkActions
 }
 
 func NewV2WorkflowRunsService(config *server.ServerConfig) *V2WorkflowRunsService {
 	return &V2WorkflowRunsService{
 		config: config,
+		bulk:   taskactions.NewBulkActions(config.EngineRepository.OLAP(), config.V2, config.MessageQueue),
 	}
 }
@@ -0,0 +1,100 @@
+package webhooksubscriptions
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/auditlog"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/integrations/webhooks"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (w *WebhookSubscriptionsService) WebhookSubscriptionCreate(ctx echo.Context, request gen.WebhookSubscriptionCreateRequestObject) (gen.WebhookSubscriptionCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := w.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.WebhookSubscriptionCreate400JSONResponse(*apiErrors), nil
+	}
+
+	var secret string
+
+	if request.Body.Secret == nil {
+		s, err := random.GenerateWebhookSecret()
+
+		if err != nil {
+			return nil, err
+		}
+
+		secret = s
+	} else {
+		secret = *request.Body.Secret
+	}
+
+	encSecret, err := w.config.Encryption.EncryptString(secret, tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	createOpts := &repository.CreateWebhookSubscriptionOpts{
+		Name:    request.Body.Name,
+		URL:     request.Body.Url,
+		Secret:  encSecret,
+		Enabled: request.Body.Enabled,
+	}
+
+	if request.Body.EventTypes != nil {
+		createOpts.EventTypes = eventTypesToStrs(*request.Body.EventTypes)
+	}
+
+	if err := webhooks.ValidateEventTypes(createOpts.EventTypes, w.config.EngineRepository.OLAP().ReportsFinishedWorkflowRuns()); err != nil {
+		return gen.WebhookSubscriptionCreate400JSONResponse(apierrors.NewAPIErrors(err.Error(), "eventTypes")), nil
+	}
+
+	if request.Body.Statuses != nil {
+		createOpts.Statuses = statusesToStrs(*request.Body.Statuses)
+	}
+
+	if request.Body.WorkflowIds != nil {
+		workflowIds, err := w.tenantWorkflowIds(ctx, tenant.ID, *request.Body.WorkflowIds)
+
+		if errors.Is(err, errWorkflowNotFound) {
+			return gen.WebhookSubscriptionCreate400JSONResponse(apierrors.NewAPIErrors(err.Error(), "workflowIds")), nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		createOpts.WorkflowIds = workflowIds
+	}
+
+	subscription, err := w.config.APIRepository.WebhookSubscription().CreateWebhookSubscription(ctx.Request().Context(), tenant.ID, createOpts)
+
+	if errors.Is(err, repository.ErrDuplicateKey) {
+		return gen.WebhookSubscriptionCreate400JSONResponse(
+			apierrors.NewAPIErrors("a webhook subscription with this name already exists", "name"),
+		), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	auditlog.SetResource(ctx, "webhook-subscription", sqlchelpers.UUIDToStr(subscription.ID))
+
+	res := transformers.ToWebhookSubscription(subscription)
+
+	// the secret is only returned when the subscription is created, since it's stored encrypted
+	res.Secret = &secret
+
+	return gen.WebhookSubscriptionCreate201JSONResponse(*res), nil
+}
@@ -0,0 +1,24 @@
+package webhooksubscriptions
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (w *WebhookSubscriptionsService) WebhookSubscriptionDelete(ctx echo.Context, request gen.WebhookSubscriptionDeleteRequestObject) (gen.WebhookSubscriptionDeleteResponseObject, error) {
+	subscription := ctx.Get("webhook-subscription").(*dbsqlc.WebhookSubscription)
+
+	err := w.config.APIRepository.WebhookSubscription().DeleteWebhookSubscription(ctx.Request().Context(), sqlchelpers.UUIDToStr(subscription.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WebhookSubscriptionDelete200JSONResponse(
+		*transformers.ToWebhookSubscription(subscription),
+	), nil
+}
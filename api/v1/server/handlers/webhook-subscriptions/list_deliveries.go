@@ -0,0 +1,88 @@
+package webhooksubscriptions
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (w *WebhookSubscriptionsService) WebhookDeliveryList(ctx echo.Context, request gen.WebhookDeliveryListRequestObject) (gen.WebhookDeliveryListResponseObject, error) {
+	subscription := ctx.Get("webhook-subscription").(*dbsqlc.WebhookSubscription)
+
+	limit := 50
+	offset := 0
+
+	listOpts := &repository.ListWebhookDeliveriesOpts{
+		Limit:  &limit,
+		Offset: &offset,
+	}
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+		listOpts.Limit = &limit
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+		listOpts.Offset = &offset
+	}
+
+	if request.Params.Statuses != nil {
+		statuses := make([]string, len(*request.Params.Statuses))
+
+		for i, status := range *request.Params.Statuses {
+			statuses[i] = string(status)
+		}
+
+		listOpts.Statuses = statuses
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	listRes, err := w.config.APIRepository.WebhookSubscription().ListWebhookDeliveries(dbCtx, sqlchelpers.UUIDToStr(subscription.ID), listOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.WebhookDelivery, len(listRes.Rows))
+
+	for i, row := range listRes.Rows {
+		delivery, err := transformers.ToWebhookDelivery(row, listRes.Attempts[sqlchelpers.UUIDToStr(row.ID)])
+
+		if err != nil {
+			return nil, err
+		}
+
+		rows[i] = *delivery
+	}
+
+	// use the total rows and limit to calculate the total pages
+	totalPages := int64(math.Ceil(float64(listRes.Count) / float64(limit)))
+	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
+	nextPage := currPage + 1
+
+	if currPage >= totalPages {
+		nextPage = currPage
+	}
+
+	return gen.WebhookDeliveryList200JSONResponse(
+		gen.WebhookDeliveryList{
+			Rows: &rows,
+			Pagination: &gen.PaginationResponse{
+				NumPages:    &totalPages,
+				NextPage:    &nextPage,
+				CurrentPage: &currPage,
+			},
+		},
+	), nil
+}
@@ -0,0 +1,68 @@
+package webhooksubscriptions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type WebhookSubscriptionsService struct {
+	config *server.ServerConfig
+}
+
+func NewWebhookSubscriptionsService(config *server.ServerConfig) *WebhookSubscriptionsService {
+	return &WebhookSubscriptionsService{
+		config: config,
+	}
+}
+
+// errWorkflowNotFound wraps errors which are caused by a workflow id in the request, and should be returned as a 400
+var errWorkflowNotFound = errors.New("workflow not found")
+
+// tenantWorkflowIds checks that each of the workflows exists in the tenant, and returns the ids as strings
+func (w *WebhookSubscriptionsService) tenantWorkflowIds(ctx echo.Context, tenantId string, workflowIds []uuid.UUID) ([]string, error) {
+	res := make([]string, 0, len(workflowIds))
+
+	for _, id := range workflowIds {
+		workflow, err := w.config.APIRepository.Workflow().GetWorkflowById(ctx.Request().Context(), id.String())
+
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+
+		if err != nil || sqlchelpers.UUIDToStr(workflow.Workflow.TenantId) != tenantId {
+			return nil, fmt.Errorf("%w: %s", errWorkflowNotFound, id.String())
+		}
+
+		res = append(res, id.String())
+	}
+
+	return res, nil
+}
+
+func eventTypesToStrs(eventTypes []gen.WebhookSubscriptionEventType) []string {
+	res := make([]string, len(eventTypes))
+
+	for i, eventType := range eventTypes {
+		res[i] = string(eventType)
+	}
+
+	return res
+}
+
+func statusesToStrs(statuses []gen.WebhookSubscriptionRunStatus) []string {
+	res := make([]string, len(statuses))
+
+	for i, status := range statuses {
+		res[i] = string(status)
+	}
+
+	return res
+}
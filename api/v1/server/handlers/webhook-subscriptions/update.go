@@ -0,0 +1,77 @@
+package webhooksubscriptions
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/integrations/webhooks"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (w *WebhookSubscriptionsService) WebhookSubscriptionUpdate(ctx echo.Context, request gen.WebhookSubscriptionUpdateRequestObject) (gen.WebhookSubscriptionUpdateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	subscription := ctx.Get("webhook-subscription").(*dbsqlc.WebhookSubscription)
+
+	// validate the request
+	if apiErrors, err := w.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.WebhookSubscriptionUpdate400JSONResponse(*apiErrors), nil
+	}
+
+	updateOpts := &repository.UpdateWebhookSubscriptionOpts{
+		Name:    request.Body.Name,
+		URL:     request.Body.Url,
+		Enabled: request.Body.Enabled,
+	}
+
+	if request.Body.EventTypes != nil {
+		eventTypes := eventTypesToStrs(*request.Body.EventTypes)
+
+		if err := webhooks.ValidateEventTypes(eventTypes, w.config.EngineRepository.OLAP().ReportsFinishedWorkflowRuns()); err != nil {
+			return gen.WebhookSubscriptionUpdate400JSONResponse(apierrors.NewAPIErrors(err.Error(), "eventTypes")), nil
+		}
+
+		updateOpts.EventTypes = &eventTypes
+	}
+
+	if request.Body.Statuses != nil {
+		statuses := statusesToStrs(*request.Body.Statuses)
+		updateOpts.Statuses = &statuses
+	}
+
+	if request.Body.WorkflowIds != nil {
+		workflowIds, err := w.tenantWorkflowIds(ctx, tenant.ID, *request.Body.WorkflowIds)
+
+		if errors.Is(err, errWorkflowNotFound) {
+			return gen.WebhookSubscriptionUpdate400JSONResponse(apierrors.NewAPIErrors(err.Error(), "workflowIds")), nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		updateOpts.WorkflowIds = &workflowIds
+	}
+
+	subscription, err := w.config.APIRepository.WebhookSubscription().UpdateWebhookSubscription(ctx.Request().Context(), sqlchelpers.UUIDToStr(subscription.ID), updateOpts)
+
+	if errors.Is(err, repository.ErrDuplicateKey) {
+		return gen.WebhookSubscriptionUpdate400JSONResponse(
+			apierrors.NewAPIErrors("a webhook subscription with this name already exists", "name"),
+		), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WebhookSubscriptionUpdate200JSONResponse(
+		*transformers.ToWebhookSubscription(subscription),
+	), nil
+}
@@ -2,6 +2,7 @@ package workflows
 
 import (
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
@@ -20,20 +21,49 @@ func (t *WorkflowService) CronWorkflowTriggerCreate(ctx echo.Context, request ge
 		return gen.CronWorkflowTriggerCreate400JSONResponse(apierrors.NewAPIErrors("cron name is required")), nil
 	}
 
+	if request.Body.MissedFirePolicy != nil && *request.Body.MissedFirePolicy == gen.CATCHUP && (request.Body.CatchUpLimit == nil || *request.Body.CatchUpLimit < 1) {
+		return gen.CronWorkflowTriggerCreate400JSONResponse(apierrors.NewAPIErrors("catch up limit must be at least 1 for the CATCH_UP missed fire policy")), nil
+	}
+
+	if request.Body.Timezone != nil {
+		if _, err := time.LoadLocation(*request.Body.Timezone); err != nil {
+			return gen.CronWorkflowTriggerCreate400JSONResponse(apierrors.NewAPIErrors("invalid timezone")), nil
+		}
+	}
+
 	workflow, err := t.config.EngineRepository.Workflow().GetWorkflowByName(ctx.Request().Context(), tenant.ID, request.Workflow)
 
 	if err != nil {
 		return gen.CronWorkflowTriggerCreate400JSONResponse(apierrors.NewAPIErrors("workflow not found")), nil
 	}
 
+	opts := &repository.CreateCronWorkflowTriggerOpts{
+		Name:               request.Body.CronName,
+		Cron:               request.Body.CronExpression,
+		Input:              request.Body.Input,
+		AdditionalMetadata: request.Body.AdditionalMetadata,
+		WorkflowId:         sqlchelpers.UUIDToStr(workflow.ID),
+	}
+
+	if request.Body.MissedFirePolicy != nil {
+		policy := string(*request.Body.MissedFirePolicy)
+		opts.MissedFirePolicy = &policy
+	}
+
+	if request.Body.CatchUpLimit != nil {
+		catchUpLimit := int32(*request.Body.CatchUpLimit) // nolint: gosec
+		opts.CatchUpLimit = &catchUpLimit
+	}
+
+	opts.Timezone = request.Body.Timezone
+
+	if request.Body.OverlapPolicy != nil {
+		overlapPolicy := string(*request.Body.OverlapPolicy)
+		opts.OverlapPolicy = &overlapPolicy
+	}
+
 	cronTrigger, err := t.config.APIRepository.Workflow().CreateCronWorkflow(
-		ctx.Request().Context(), tenant.ID, &repository.CreateCronWorkflowTriggerOpts{
-			Name:               request.Body.CronName,
-			Cron:               request.Body.CronExpression,
-			Input:              request.Body.Input,
-			AdditionalMetadata: request.Body.AdditionalMetadata,
-			WorkflowId:         sqlchelpers.UUIDToStr(workflow.ID),
-		},
+		ctx.Request().Context(), tenant.ID, opts,
 	)
 
 	if err != nil {
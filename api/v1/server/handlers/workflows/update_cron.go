@@ -0,0 +1,70 @@
+package workflows
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *WorkflowService) WorkflowCronUpdate(ctx echo.Context, request gen.WorkflowCronUpdateRequestObject) (gen.WorkflowCronUpdateResponseObject, error) {
+	cron := ctx.Get("cron-workflow").(*dbsqlc.ListCronWorkflowsRow)
+
+	if request.Body == nil {
+		return gen.WorkflowCronUpdate400JSONResponse(apierrors.NewAPIErrors("request body is required")), nil
+	}
+
+	opts := &repository.UpdateCronWorkflowTriggerOpts{}
+
+	// check the resulting policy against the existing cron, since either field can be updated on its own
+	policy := string(cron.MissedFirePolicy)
+	catchUpLimit := cron.CatchUpLimit
+
+	if request.Body.MissedFirePolicy != nil {
+		policy = string(*request.Body.MissedFirePolicy)
+		opts.MissedFirePolicy = &policy
+	}
+
+	if request.Body.CatchUpLimit != nil {
+		catchUpLimit = int32(*request.Body.CatchUpLimit) // nolint: gosec
+		opts.CatchUpLimit = &catchUpLimit
+	}
+
+	if request.Body.Timezone != nil {
+		if _, err := time.LoadLocation(*request.Body.Timezone); err != nil {
+			return gen.WorkflowCronUpdate400JSONResponse(apierrors.NewAPIErrors("invalid timezone")), nil
+		}
+
+		opts.Timezone = request.Body.Timezone
+	}
+
+	if request.Body.OverlapPolicy != nil {
+		overlapPolicy := string(*request.Body.OverlapPolicy)
+		opts.OverlapPolicy = &overlapPolicy
+	}
+
+	if policy == string(gen.CATCHUP) && catchUpLimit < 1 {
+		return gen.WorkflowCronUpdate400JSONResponse(apierrors.NewAPIErrors("catch up limit must be at least 1 for the CATCH_UP missed fire policy")), nil
+	}
+
+	updated, err := t.config.APIRepository.Workflow().UpdateCronWorkflow(
+		ctx.Request().Context(),
+		sqlchelpers.UUIDToStr(cron.TenantId),
+		sqlchelpers.UUIDToStr(cron.CronId),
+		opts,
+	)
+
+	if err != nil {
+		return gen.WorkflowCronUpdate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+	}
+
+	return gen.WorkflowCronUpdate200JSONResponse(
+		*transformers.ToCronWorkflowsFromSQLC(updated),
+	), nil
+}
@@ -26,12 +26,34 @@ const (
 	CookieAuthScopes = "cookieAuth.Scopes"
 )
 
+// Defines values for APITokenScope.
+const (
+	APITokenScopeAdmin            APITokenScope = "admin"
+	APITokenScopeEventsPush       APITokenScope = "events:push"
+	APITokenScopeRunsCancel       APITokenScope = "runs:cancel"
+	APITokenScopeRunsRead         APITokenScope = "runs:read"
+	APITokenScopeWorkersRegister  APITokenScope = "workers:register"
+	APITokenScopeWorkflowsTrigger APITokenScope = "workflows:trigger"
+)
+
+// Defines values for AuditLogActorKind.
+const (
+	APITOKEN AuditLogActorKind = "API_TOKEN"
+	USER     AuditLogActorKind = "USER"
+)
+
+// Defines values for AuditLogSource.
+const (
+	AuditLogSourceAPI  AuditLogSource = "API"
+	AuditLogSourceGRPC AuditLogSource = "GRPC"
+)
+
 // Defines values for ConcurrencyLimitStrategy.
 const (
-	CANCELINPROGRESS ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
-	DROPNEWEST       ConcurrencyLimitStrategy = "DROP_NEWEST"
-	GROUPROUNDROBIN  ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
-	QUEUENEWEST      ConcurrencyLimitStrategy = "QUEUE_NEWEST"
+	ConcurrencyLimitStrategyCANCELINPROGRESS ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
+	ConcurrencyLimitStrategyDROPNEWEST       ConcurrencyLimitStrategy = "DROP_NEWEST"
+	ConcurrencyLimitStrategyGROUPROUNDROBIN  ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
+	ConcurrencyLimitStrategyQUEUENEWEST      ConcurrencyLimitStrategy = "QUEUE_NEWEST"
 )
 
 // Defines values for CronWorkflowsMethod.
@@ -40,12 +62,27 @@ const (
 	CronWorkflowsMethodDEFAULT CronWorkflowsMethod = "DEFAULT"
 )
 
+// Defines values for CronWorkflowsMissedFirePolicy.
+const (
+	CATCHUP  CronWorkflowsMissedFirePolicy = "CATCH_UP"
+	FIREONCE CronWorkflowsMissedFirePolicy = "FIRE_ONCE"
+	SKIP     CronWorkflowsMissedFirePolicy = "SKIP"
+)
+
 // Defines values for CronWorkflowsOrderByField.
 const (
 	CronWorkflowsOrderByFieldCreatedAt CronWorkflowsOrderByField = "createdAt"
 	CronWorkflowsOrderByFieldName      CronWorkflowsOrderByField = "name"
 )
 
+// Defines values for CronWorkflowsOverlapPolicy.
+const (
+	ALLOW          CronWorkflowsOverlapPolicy = "ALLOW"
+	CANCELPREVIOUS CronWorkflowsOverlapPolicy = "CANCEL_PREVIOUS"
+	QUEUEONE       CronWorkflowsOverlapPolicy = "QUEUE_ONE"
+	SKIPIFRUNNING  CronWorkflowsOverlapPolicy = "SKIP_IF_RUNNING"
+)
+
 // Defines values for EventOrderByDirection.
 const (
 	EventOrderByDirectionAsc  EventOrderByDirection = "asc"
@@ -111,8 +148,8 @@ const (
 
 // Defines values for ScheduledWorkflowsMethod.
 const (
-	ScheduledWorkflowsMethodAPI     ScheduledWorkflowsMethod = "API"
-	ScheduledWorkflowsMethodDEFAULT ScheduledWorkflowsMethod = "DEFAULT"
+	API     ScheduledWorkflowsMethod = "API"
+	DEFAULT ScheduledWorkflowsMethod = "DEFAULT"
 )
 
 // Defines values for ScheduledWorkflowsOrderByField.
@@ -168,6 +205,23 @@ const (
 	OWNER  TenantMemberRole = "OWNER"
 )
 
+// Defines values for TenantPermission.
+const (
+	TenantPermissionEventsRead       TenantPermission = "events:read"
+	TenantPermissionEventsWrite      TenantPermission = "events:write"
+	TenantPermissionQueuesRead       TenantPermission = "queues:read"
+	TenantPermissionRunsCancel       TenantPermission = "runs:cancel"
+	TenantPermissionRunsRead         TenantPermission = "runs:read"
+	TenantPermissionRunsReplay       TenantPermission = "runs:replay"
+	TenantPermissionSettingsRead     TenantPermission = "settings:read"
+	TenantPermissionSettingsWrite    TenantPermission = "settings:write"
+	TenantPermissionWorkersRead      TenantPermission = "workers:read"
+	TenantPermissionWorkersWrite     TenantPermission = "workers:write"
+	TenantPermissionWorkflowsRead    TenantPermission = "workflows:read"
+	TenantPermissionWorkflowsTrigger TenantPermission = "workflows:trigger"
+	TenantPermissionWorkflowsWrite   TenantPermission = "workflows:write"
+)
+
 // Defines values for TenantResource.
 const (
 	CRON        TenantResource = "CRON"
@@ -177,6 +231,22 @@ const (
 	WORKFLOWRUN TenantResource = "WORKFLOW_RUN"
 )
 
+// Defines values for V2ConcurrencyStrategy.
+const (
+	V2ConcurrencyStrategyCANCELINPROGRESS   V2ConcurrencyStrategy = "CANCEL_IN_PROGRESS"
+	V2ConcurrencyStrategyCANCELNEWEST       V2ConcurrencyStrategy = "CANCEL_NEWEST"
+	V2ConcurrencyStrategyGROUPROUNDROBIN    V2ConcurrencyStrategy = "GROUP_ROUND_ROBIN"
+	V2ConcurrencyStrategyNONE               V2ConcurrencyStrategy = "NONE"
+	V2ConcurrencyStrategyWEIGHTEDROUNDROBIN V2ConcurrencyStrategy = "WEIGHTED_ROUND_ROBIN"
+)
+
+// Defines values for V2RateLimitAlgorithm.
+const (
+	FIXEDWINDOW   V2RateLimitAlgorithm = "FIXED_WINDOW"
+	SLIDINGWINDOW V2RateLimitAlgorithm = "SLIDING_WINDOW"
+	TOKENBUCKET   V2RateLimitAlgorithm = "TOKEN_BUCKET"
+)
+
 // Defines values for V2TaskEventType.
 const (
 	V2TaskEventTypeACKNOWLEDGED       V2TaskEventType = "ACKNOWLEDGED"
@@ -188,8 +258,10 @@ const (
 	V2TaskEventTypeQUEUED             V2TaskEventType = "QUEUED"
 	V2TaskEventTypeRATELIMITERROR     V2TaskEventType = "RATE_LIMIT_ERROR"
 	V2TaskEventTypeREASSIGNED         V2TaskEventType = "REASSIGNED"
+	V2TaskEventTypeREPLAYED           V2TaskEventType = "REPLAYED"
 	V2TaskEventTypeREQUEUEDNOWORKER   V2TaskEventType = "REQUEUED_NO_WORKER"
 	V2TaskEventTypeREQUEUEDRATELIMIT  V2TaskEventType = "REQUEUED_RATE_LIMIT"
+	V2TaskEventTypeRESETBYREPLAY      V2TaskEventType = "RESET_BY_REPLAY"
 	V2TaskEventTypeRETRIEDBYUSER      V2TaskEventType = "RETRIED_BY_USER"
 	V2TaskEventTypeRETRYING           V2TaskEventType = "RETRYING"
 	V2TaskEventTypeSCHEDULINGTIMEDOUT V2TaskEventType = "SCHEDULING_TIMED_OUT"
@@ -201,6 +273,20 @@ const (
 	V2TaskEventTypeTIMEOUTREFRESHED   V2TaskEventType = "TIMEOUT_REFRESHED"
 )
 
+// Defines values for V2TaskQueueReason.
+const (
+	ASSIGNABLE              V2TaskQueueReason = "ASSIGNABLE"
+	CONCURRENCYLIMITED      V2TaskQueueReason = "CONCURRENCY_LIMITED"
+	LABELSNOTMATCHED        V2TaskQueueReason = "LABELS_NOT_MATCHED"
+	NOAVAILABLESLOTS        V2TaskQueueReason = "NO_AVAILABLE_SLOTS"
+	NOTQUEUED               V2TaskQueueReason = "NOT_QUEUED"
+	NOWORKERFORACTION       V2TaskQueueReason = "NO_WORKER_FOR_ACTION"
+	RATELIMITED             V2TaskQueueReason = "RATE_LIMITED"
+	SCHEDULETIMEDOUT        V2TaskQueueReason = "SCHEDULE_TIMED_OUT"
+	STICKYWORKERUNAVAILABLE V2TaskQueueReason = "STICKY_WORKER_UNAVAILABLE"
+	WAITINGFORRETRY         V2TaskQueueReason = "WAITING_FOR_RETRY"
+)
+
 // Defines values for V2TaskStatus.
 const (
 	V2TaskStatusCANCELLED V2TaskStatus = "CANCELLED"
@@ -210,6 +296,31 @@ const (
 	V2TaskStatusRUNNING   V2TaskStatus = "RUNNING"
 )
 
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSUCCEEDED WebhookDeliveryStatus = "SUCCEEDED"
+)
+
+// Defines values for WebhookSubscriptionEventType.
+const (
+	TASKCANCELLED        WebhookSubscriptionEventType = "TASK_CANCELLED"
+	TASKCOMPLETED        WebhookSubscriptionEventType = "TASK_COMPLETED"
+	TASKFAILED           WebhookSubscriptionEventType = "TASK_FAILED"
+	TASKTIMEDOUT         WebhookSubscriptionEventType = "TASK_TIMED_OUT"
+	WORKFLOWRUNCANCELLED WebhookSubscriptionEventType = "WORKFLOW_RUN_CANCELLED"
+	WORKFLOWRUNCOMPLETED WebhookSubscriptionEventType = "WORKFLOW_RUN_COMPLETED"
+	WORKFLOWRUNFAILED    WebhookSubscriptionEventType = "WORKFLOW_RUN_FAILED"
+)
+
+// Defines values for WebhookSubscriptionRunStatus.
+const (
+	WebhookSubscriptionRunStatusCANCELLED WebhookSubscriptionRunStatus = "CANCELLED"
+	WebhookSubscriptionRunStatusCOMPLETED WebhookSubscriptionRunStatus = "COMPLETED"
+	WebhookSubscriptionRunStatusFAILED    WebhookSubscriptionRunStatus = "FAILED"
+)
+
 // Defines values for WorkerStatus.
 const (
 	ACTIVE   WorkerStatus = "ACTIVE"
@@ -346,13 +457,61 @@ type APIToken struct {
 
 	// Name The name of the API token.
 	Name string `json:"name"`
+
+	// Scopes The scopes granted to the API token.
+	Scopes []APITokenScope `json:"scopes"`
 }
 
+// APITokenScope defines model for APITokenScope.
+type APITokenScope string
+
 // AcceptInviteRequest defines model for AcceptInviteRequest.
 type AcceptInviteRequest struct {
 	Invite string `json:"invite" validate:"required,uuid"`
 }
 
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	// Action The action which was performed.
+	Action string `json:"action"`
+
+	// ActorId The id of the user or API token which performed the action.
+	ActorId   openapi_types.UUID `json:"actorId"`
+	ActorKind AuditLogActorKind  `json:"actorKind"`
+
+	// ActorName The email of the user or the name of the API token which performed the action.
+	ActorName *string `json:"actorName,omitempty"`
+
+	// IpAddress The IP address which the request was made from.
+	IpAddress *string         `json:"ipAddress,omitempty"`
+	Metadata  APIResourceMeta `json:"metadata"`
+
+	// RequestMetadata Additional metadata about the request.
+	RequestMetadata map[string]interface{} `json:"requestMetadata"`
+
+	// ResourceId The id of the resource which the action was performed on.
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// ResourceType The type of the resource which the action was performed on.
+	ResourceType string         `json:"resourceType"`
+	Source       AuditLogSource `json:"source"`
+
+	// UserAgent The user agent of the request.
+	UserAgent *string `json:"userAgent,omitempty"`
+}
+
+// AuditLogActorKind defines model for AuditLogActorKind.
+type AuditLogActorKind string
+
+// AuditLogList defines model for AuditLogList.
+type AuditLogList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]AuditLog         `json:"rows,omitempty"`
+}
+
+// AuditLogSource defines model for AuditLogSource.
+type AuditLogSource string
+
 // BulkCreateEventRequest defines model for BulkCreateEventRequest.
 type BulkCreateEventRequest struct {
 	Events []CreateEventRequest `json:"events"`
@@ -373,6 +532,9 @@ type CreateAPITokenRequest struct {
 
 	// Name A name for the API token.
 	Name string `json:"name"`
+
+	// Scopes The scopes to grant to the API token. If not set, the token has the admin scope.
+	Scopes *[]APITokenScope `json:"scopes,omitempty"`
 }
 
 // CreateAPITokenResponse defines model for CreateAPITokenResponse.
@@ -384,9 +546,19 @@ type CreateAPITokenResponse struct {
 // CreateCronWorkflowTriggerRequest defines model for CreateCronWorkflowTriggerRequest.
 type CreateCronWorkflowTriggerRequest struct {
 	AdditionalMetadata map[string]interface{} `json:"additionalMetadata"`
+	CatchUpLimit       *int                   `json:"catchUpLimit,omitempty"`
 	CronExpression     string                 `json:"cronExpression"`
 	CronName           string                 `json:"cronName"`
 	Input              map[string]interface{} `json:"input"`
+
+	// MissedFirePolicy How fires which were missed while the engine was unavailable are handled. SKIP drops missed fires, FIRE_ONCE runs the most recent missed fire and CATCH_UP runs up to catchUpLimit of the most recent missed fires.
+	MissedFirePolicy *CronWorkflowsMissedFirePolicy `json:"missedFirePolicy,omitempty"`
+
+	// OverlapPolicy How a fire is handled while the previous run of the cron is still running. ALLOW starts a new run, SKIP_IF_RUNNING drops the fire, CANCEL_PREVIOUS cancels the previous run and QUEUE_ONE starts a single run once the previous run finishes.
+	OverlapPolicy *CronWorkflowsOverlapPolicy `json:"overlapPolicy,omitempty"`
+
+	// Timezone The IANA timezone which the cron expression is evaluated in, for example America/New_York.
+	Timezone *string `json:"timezone,omitempty"`
 }
 
 // CreateEventRequest defines model for CreateEventRequest.
@@ -429,33 +601,126 @@ type CreateTenantRequest struct {
 	Slug string `json:"slug" validate:"required,hatchetName"`
 }
 
+// CreateTenantRoleRequest defines model for CreateTenantRoleRequest.
+type CreateTenantRoleRequest struct {
+	// Description A description of the role.
+	Description *string `json:"description,omitempty" validate:"omitnil,max=255"`
+
+	// Name The name of the role.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// Permissions The permissions granted by the role.
+	Permissions []TenantPermission `json:"permissions" validate:"required,min=1"`
+
+	// WorkflowIds The workflows which workflow and run permissions are restricted to. If omitted, the permissions apply to every workflow.
+	WorkflowIds *[]openapi_types.UUID `json:"workflowIds,omitempty"`
+}
+
+// CreateWebhookSubscriptionRequest defines model for CreateWebhookSubscriptionRequest.
+type CreateWebhookSubscriptionRequest struct {
+	// Enabled Whether events are delivered to the webhook subscription. Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// EventTypes The event types to deliver. If empty, every event type is delivered.
+	EventTypes *[]WebhookSubscriptionEventType `json:"eventTypes,omitempty"`
+
+	// Name The name of the webhook subscription.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// Secret The secret which deliveries are signed with. If not provided, a random secret will be generated.
+	Secret *string `json:"secret,omitempty" validate:"omitnil,min=32"`
+
+	// Statuses The run statuses to deliver events for. If empty, events for every status are delivered.
+	Statuses *[]WebhookSubscriptionRunStatus `json:"statuses,omitempty"`
+
+	// Url The https url which events are delivered to.
+	Url string `json:"url" validate:"required,url,startswith=https://"`
+
+	// WorkflowIds The workflows to deliver events for. If empty, events for every workflow are delivered.
+	WorkflowIds *[]openapi_types.UUID `json:"workflowIds,omitempty"`
+}
+
 // CronWorkflows defines model for CronWorkflows.
 type CronWorkflows struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
-	Cron               string                  `json:"cron"`
-	Enabled            bool                    `json:"enabled"`
-	Input              *map[string]interface{} `json:"input,omitempty"`
-	Metadata           APIResourceMeta         `json:"metadata"`
-	Method             CronWorkflowsMethod     `json:"method"`
-	Name               *string                 `json:"name,omitempty"`
-	TenantId           string                  `json:"tenantId"`
-	WorkflowId         string                  `json:"workflowId"`
-	WorkflowName       string                  `json:"workflowName"`
-	WorkflowVersionId  string                  `json:"workflowVersionId"`
+
+	// CatchUpLimit The maximum number of missed fires which are run with the CATCH_UP policy
+	CatchUpLimit int                     `json:"catchUpLimit"`
+	Cron         string                  `json:"cron"`
+	Enabled      bool                    `json:"enabled"`
+	Input        *map[string]interface{} `json:"input,omitempty"`
+	Metadata     APIResourceMeta         `json:"metadata"`
+	Method       CronWorkflowsMethod     `json:"method"`
+
+	// MissedFirePolicy How fires which were missed while the engine was unavailable are handled. SKIP drops missed fires, FIRE_ONCE runs the most recent missed fire and CATCH_UP runs up to catchUpLimit of the most recent missed fires.
+	MissedFirePolicy CronWorkflowsMissedFirePolicy `json:"missedFirePolicy"`
+	Name             *string                       `json:"name,omitempty"`
+
+	// NextFireAt The next time the cron is due to fire
+	NextFireAt *time.Time `json:"nextFireAt,omitempty"`
+
+	// OverlapPolicy How a fire is handled while the previous run of the cron is still running. ALLOW starts a new run, SKIP_IF_RUNNING drops the fire, CANCEL_PREVIOUS cancels the previous run and QUEUE_ONE starts a single run once the previous run finishes.
+	OverlapPolicy CronWorkflowsOverlapPolicy `json:"overlapPolicy"`
+	TenantId      string                     `json:"tenantId"`
+
+	// Timezone The IANA timezone which the cron expression is evaluated in, or UTC if unset
+	Timezone          *string `json:"timezone,omitempty"`
+	WorkflowId        string  `json:"workflowId"`
+	WorkflowName      string  `json:"workflowName"`
+	WorkflowVersionId string  `json:"workflowVersionId"`
 }
 
+// CronWorkflowsMethod defines model for CronWorkflows.Method.
+type CronWorkflowsMethod string
+
 // CronWorkflowsList defines model for CronWorkflowsList.
 type CronWorkflowsList struct {
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
 	Rows       *[]CronWorkflows    `json:"rows,omitempty"`
 }
 
-// CronWorkflowsMethod defines model for CronWorkflowsMethod.
-type CronWorkflowsMethod string
+// CronWorkflowsMissedFirePolicy How fires which were missed while the engine was unavailable are handled. SKIP drops missed fires, FIRE_ONCE runs the most recent missed fire and CATCH_UP runs up to catchUpLimit of the most recent missed fires.
+type CronWorkflowsMissedFirePolicy string
 
 // CronWorkflowsOrderByField defines model for CronWorkflowsOrderByField.
 type CronWorkflowsOrderByField string
 
+// CronWorkflowsOverlapPolicy How a fire is handled while the previous run of the cron is still running. ALLOW starts a new run, SKIP_IF_RUNNING drops the fire, CANCEL_PREVIOUS cancels the previous run and QUEUE_ONE starts a single run once the previous run finishes.
+type CronWorkflowsOverlapPolicy string
+
+// DeadLetter defines model for DeadLetter.
+type DeadLetter struct {
+	// CreatedAt When the message was moved to the dead letters.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Error The error returned by the last attempt to process the message.
+	Error *string `json:"error,omitempty"`
+
+	// Id The id of the dead letter.
+	Id int64 `json:"id"`
+
+	// MessageId The id of the message, which identifies the type of message.
+	MessageId string `json:"messageId"`
+
+	// PayloadPreview A preview of the message payloads, truncated to 1000 characters.
+	PayloadPreview string `json:"payloadPreview"`
+
+	// Queue The name of the message queue the message was read from.
+	Queue string `json:"queue"`
+
+	// RetryCount The number of times the message was retried.
+	RetryCount int `json:"retryCount"`
+
+	// TenantId The tenant of the message.
+	TenantId *openapi_types.UUID `json:"tenantId,omitempty"`
+}
+
+// DeadLetterList defines model for DeadLetterList.
+type DeadLetterList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]DeadLetter       `json:"rows,omitempty"`
+}
+
 // Event defines model for Event.
 type Event struct {
 	// AdditionalMetadata Additional metadata for the event.
@@ -622,6 +887,9 @@ type PaginationResponse struct {
 	// CurrentPage the current page
 	CurrentPage *int64 `json:"current_page,omitempty"`
 
+	// NextCursor an opaque cursor for the next page of results, only set for endpoints which support cursor pagination
+	NextCursor *string `json:"next_cursor,omitempty"`
+
 	// NextPage the next page
 	NextPage *int64 `json:"next_page,omitempty"`
 
@@ -629,6 +897,18 @@ type PaginationResponse struct {
 	NumPages *int64 `json:"num_pages,omitempty"`
 }
 
+// PurgeDeadLettersRequest defines model for PurgeDeadLettersRequest.
+type PurgeDeadLettersRequest struct {
+	// Ids The ids of the dead letters to purge. If empty, all dead letters on the queue are purged.
+	Ids *[]int64 `json:"ids,omitempty"`
+}
+
+// PurgeDeadLettersResponse defines model for PurgeDeadLettersResponse.
+type PurgeDeadLettersResponse struct {
+	// Count The number of purged dead letters.
+	Count int `json:"count"`
+}
+
 // QueueMetrics defines model for QueueMetrics.
 type QueueMetrics struct {
 	// NumPending The number of items pending.
@@ -691,6 +971,18 @@ type RejectInviteRequest struct {
 	Invite string `json:"invite" validate:"required,uuid"`
 }
 
+// ReplayDeadLettersRequest defines model for ReplayDeadLettersRequest.
+type ReplayDeadLettersRequest struct {
+	// Ids The ids of the dead letters to replay. If empty, the oldest dead letters on the queue are replayed, up to 1000.
+	Ids *[]int64 `json:"ids,omitempty"`
+}
+
+// ReplayDeadLettersResponse defines model for ReplayDeadLettersResponse.
+type ReplayDeadLettersResponse struct {
+	// Count The number of replayed dead letters.
+	Count int `json:"count"`
+}
+
 // ReplayEventRequest defines model for ReplayEventRequest.
 type ReplayEventRequest struct {
 	EventIds []openapi_types.UUID `json:"eventIds"`
@@ -979,10 +1271,12 @@ type TenantInviteList struct {
 
 // TenantMember defines model for TenantMember.
 type TenantMember struct {
-	Metadata APIResourceMeta  `json:"metadata"`
-	Role     TenantMemberRole `json:"role"`
-	Tenant   *Tenant          `json:"tenant,omitempty"`
-	User     UserTenantPublic `json:"user"`
+	// CustomRoleId The id of the custom role assigned to the member, which restricts the member to the permissions of the role.
+	CustomRoleId *openapi_types.UUID `json:"customRoleId,omitempty"`
+	Metadata     APIResourceMeta     `json:"metadata"`
+	Role         TenantMemberRole    `json:"role"`
+	Tenant       *Tenant             `json:"tenant,omitempty"`
+	User         UserTenantPublic    `json:"user"`
 }
 
 // TenantMemberList defines model for TenantMemberList.
@@ -994,6 +1288,9 @@ type TenantMemberList struct {
 // TenantMemberRole defines model for TenantMemberRole.
 type TenantMemberRole string
 
+// TenantPermission defines model for TenantPermission.
+type TenantPermission string
+
 // TenantQueueMetrics defines model for TenantQueueMetrics.
 type TenantQueueMetrics struct {
 	Queues   *map[string]int          `json:"queues,omitempty"`
@@ -1030,6 +1327,27 @@ type TenantResourcePolicy struct {
 	Limits []TenantResourceLimit `json:"limits"`
 }
 
+// TenantRole defines model for TenantRole.
+type TenantRole struct {
+	// Description A description of the role.
+	Description *string         `json:"description,omitempty"`
+	Metadata    APIResourceMeta `json:"metadata"`
+
+	// Name The name of the role.
+	Name string `json:"name"`
+
+	// Permissions The permissions granted by the role.
+	Permissions []TenantPermission `json:"permissions"`
+
+	// WorkflowIds The workflows which workflow and run permissions are restricted to. If empty, the permissions apply to every workflow.
+	WorkflowIds []openapi_types.UUID `json:"workflowIds"`
+}
+
+// TenantRoleList defines model for TenantRoleList.
+type TenantRoleList struct {
+	Rows *[]TenantRole `json:"rows,omitempty"`
+}
+
 // TenantStepRunQueueMetrics defines model for TenantStepRunQueueMetrics.
 type TenantStepRunQueueMetrics struct {
 	Queues *map[string]int `json:"queues,omitempty"`
@@ -1041,6 +1359,20 @@ type TriggerWorkflowRunRequest struct {
 	Input              map[string]interface{}  `json:"input"`
 }
 
+// UpdateCronWorkflowTriggerRequest defines model for UpdateCronWorkflowTriggerRequest.
+type UpdateCronWorkflowTriggerRequest struct {
+	CatchUpLimit *int `json:"catchUpLimit,omitempty"`
+
+	// MissedFirePolicy How fires which were missed while the engine was unavailable are handled. SKIP drops missed fires, FIRE_ONCE runs the most recent missed fire and CATCH_UP runs up to catchUpLimit of the most recent missed fires.
+	MissedFirePolicy *CronWorkflowsMissedFirePolicy `json:"missedFirePolicy,omitempty"`
+
+	// OverlapPolicy How a fire is handled while the previous run of the cron is still running. ALLOW starts a new run, SKIP_IF_RUNNING drops the fire, CANCEL_PREVIOUS cancels the previous run and QUEUE_ONE starts a single run once the previous run finishes.
+	OverlapPolicy *CronWorkflowsOverlapPolicy `json:"overlapPolicy,omitempty"`
+
+	// Timezone The IANA timezone which the cron expression is evaluated in, for example America/New_York. An empty string resets the timezone to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
 // UpdateTenantAlertEmailGroupRequest defines model for UpdateTenantAlertEmailGroupRequest.
 type UpdateTenantAlertEmailGroupRequest struct {
 	// Emails A list of emails for users
@@ -1052,6 +1384,12 @@ type UpdateTenantInviteRequest struct {
 	Role TenantMemberRole `json:"role"`
 }
 
+// UpdateTenantMemberRequest defines model for UpdateTenantMemberRequest.
+type UpdateTenantMemberRequest struct {
+	// CustomRoleId The id of the custom role to assign to the member. If omitted, the member's custom role is removed.
+	CustomRoleId *openapi_types.UUID `json:"customRoleId,omitempty"`
+}
+
 // UpdateTenantRequest defines model for UpdateTenantRequest.
 type UpdateTenantRequest struct {
 	// AlertMemberEmails Whether to alert tenant members.
@@ -1076,6 +1414,42 @@ type UpdateTenantRequest struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// UpdateTenantRoleRequest defines model for UpdateTenantRoleRequest.
+type UpdateTenantRoleRequest struct {
+	// Description A description of the role.
+	Description *string `json:"description,omitempty" validate:"omitnil,max=255"`
+
+	// Name The name of the role.
+	Name *string `json:"name,omitempty" validate:"omitnil,hatchetName"`
+
+	// Permissions The permissions granted by the role.
+	Permissions *[]TenantPermission `json:"permissions,omitempty" validate:"omitnil,min=1"`
+
+	// WorkflowIds The workflows which workflow and run permissions are restricted to. An empty list removes the restriction.
+	WorkflowIds *[]openapi_types.UUID `json:"workflowIds,omitempty"`
+}
+
+// UpdateWebhookSubscriptionRequest defines model for UpdateWebhookSubscriptionRequest.
+type UpdateWebhookSubscriptionRequest struct {
+	// Enabled Whether events are delivered to the webhook subscription.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// EventTypes The event types to deliver. An empty list delivers every event type.
+	EventTypes *[]WebhookSubscriptionEventType `json:"eventTypes,omitempty"`
+
+	// Name The name of the webhook subscription.
+	Name *string `json:"name,omitempty" validate:"omitnil,hatchetName"`
+
+	// Statuses The run statuses to deliver events for. An empty list delivers events for every status.
+	Statuses *[]WebhookSubscriptionRunStatus `json:"statuses,omitempty"`
+
+	// Url The https url which events are delivered to.
+	Url *string `json:"url,omitempty" validate:"omitnil,url,startswith=https://"`
+
+	// WorkflowIds The workflows to deliver events for. An empty list removes the restriction.
+	WorkflowIds *[]openapi_types.UUID `json:"workflowIds,omitempty"`
+}
+
 // UpdateWorkerRequest defines model for UpdateWorkerRequest.
 type UpdateWorkerRequest struct {
 	// IsPaused Whether the worker is paused and cannot accept new runs.
@@ -1146,12 +1520,182 @@ type UserTenantPublic struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// V2CancelTasksRequest Selects the tasks to cancel, either by external id or by a filter. Exactly one of externalIds and filter must be set. A filter can match at most 10000 tasks.
+type V2CancelTasksRequest struct {
+	// ExternalIds The external ids of the tasks to cancel. At most 1000 ids can be passed.
+	ExternalIds *[]openapi_types.UUID `json:"externalIds,omitempty"`
+	Filter      *V2TaskFilter         `json:"filter,omitempty"`
+}
+
+// V2CancelTasksResponse defines model for V2CancelTasksResponse.
+type V2CancelTasksResponse struct {
+	// ExternalIds The external ids of the tasks which were cancelled
+	ExternalIds []openapi_types.UUID `json:"externalIds"`
+}
+
+// V2CancelWorkflowRunsRequest Selects the workflow runs to cancel, either by external id or by a filter. Exactly one of externalIds and filter must be set. A filter can match at most 10000 workflow runs.
+type V2CancelWorkflowRunsRequest struct {
+	// ExternalIds The external ids of the workflow runs to cancel. At most 1000 ids can be passed.
+	ExternalIds *[]openapi_types.UUID `json:"externalIds,omitempty"`
+	Filter      *V2WorkflowRunFilter  `json:"filter,omitempty"`
+}
+
+// V2CancelWorkflowRunsResponse defines model for V2CancelWorkflowRunsResponse.
+type V2CancelWorkflowRunsResponse struct {
+	// ExternalIds The external ids of the workflow runs which were cancelled
+	ExternalIds []openapi_types.UUID `json:"externalIds"`
+}
+
+// V2ConcurrencyKeyUsage defines model for V2ConcurrencyKeyUsage.
+type V2ConcurrencyKeyUsage struct {
+	// Key The concurrency key.
+	Key string `json:"key"`
+
+	// Queued The number of tasks which are waiting for a concurrency slot for the key.
+	Queued int `json:"queued"`
+
+	// Running The number of tasks which have been assigned a concurrency slot for the key.
+	Running int `json:"running"`
+
+	// Saturated Whether the key has no free concurrency slots.
+	Saturated bool `json:"saturated"`
+}
+
+// V2ConcurrencyStrategy defines model for V2ConcurrencyStrategy.
+type V2ConcurrencyStrategy string
+
+// V2ConcurrencyStrategyUsage defines model for V2ConcurrencyStrategyUsage.
+type V2ConcurrencyStrategyUsage struct {
+	// Expression The CEL expression which evaluates to the concurrency key of a task.
+	Expression string `json:"expression"`
+
+	// Id The ID of the concurrency strategy.
+	Id int64 `json:"id"`
+
+	// KeyCount The number of distinct concurrency keys with running or queued tasks.
+	KeyCount int `json:"keyCount"`
+
+	// Keys The keys with the most queued tasks.
+	Keys []V2ConcurrencyKeyUsage `json:"keys"`
+
+	// MaxConcurrency The maximum number of tasks which can run concurrently for each key.
+	MaxConcurrency int `json:"maxConcurrency"`
+
+	// Queued The number of tasks which are waiting for a concurrency slot.
+	Queued int `json:"queued"`
+
+	// Running The number of tasks which have been assigned a concurrency slot.
+	Running           int                   `json:"running"`
+	StepId            openapi_types.UUID    `json:"stepId"`
+	StepReadableId    *string               `json:"stepReadableId,omitempty"`
+	Strategy          V2ConcurrencyStrategy `json:"strategy"`
+	WorkflowId        openapi_types.UUID    `json:"workflowId"`
+	WorkflowName      string                `json:"workflowName"`
+	WorkflowVersionId openapi_types.UUID    `json:"workflowVersionId"`
+}
+
+// V2ConcurrencyStrategyUsageList defines model for V2ConcurrencyStrategyUsageList.
+type V2ConcurrencyStrategyUsageList struct {
+	Rows []V2ConcurrencyStrategyUsage `json:"rows"`
+}
+
 // V2DagChildren defines model for V2DagChildren.
 type V2DagChildren struct {
 	Children *[]V2TaskSummary    `json:"children,omitempty"`
 	DagId    *openapi_types.UUID `json:"dagId,omitempty"`
 }
 
+// V2RateLimitAlgorithm defines model for V2RateLimitAlgorithm.
+type V2RateLimitAlgorithm string
+
+// V2RateLimitUsage defines model for V2RateLimitUsage.
+type V2RateLimitUsage struct {
+	Algorithm V2RateLimitAlgorithm `json:"algorithm"`
+
+	// Available The number of units which can currently be used.
+	Available int `json:"available"`
+
+	// Burst The maximum number of units which can accumulate in a token bucket.
+	Burst int `json:"burst"`
+
+	// Dynamic Whether the rate limit was created from a dynamic rate limit key.
+	Dynamic bool `json:"dynamic"`
+
+	// Key The key for the rate limit.
+	Key string `json:"key"`
+
+	// LastRefill The last time the rate limit was refilled.
+	LastRefill time.Time `json:"lastRefill"`
+
+	// LastUsedAt The last time that units of the rate limit were used.
+	LastUsedAt time.Time `json:"lastUsedAt"`
+
+	// LimitValue The number of units which are added to the rate limit in each window.
+	LimitValue int `json:"limitValue"`
+
+	// NextRefillAt The next time that units are added to the rate limit. Not set for token buckets which are full.
+	NextRefillAt *time.Time `json:"nextRefillAt,omitempty"`
+
+	// Window The window of time in which the limitValue is enforced.
+	Window string `json:"window"`
+}
+
+// V2RateLimitUsageList defines model for V2RateLimitUsageList.
+type V2RateLimitUsageList struct {
+	Pagination PaginationResponse `json:"pagination"`
+	Rows       []V2RateLimitUsage `json:"rows"`
+}
+
+// V2ReplayTasksRequest Selects the tasks to replay, either by external id or by a filter. Exactly one of externalIds and filter must be set. A filter can match at most 10000 tasks.
+type V2ReplayTasksRequest struct {
+	// ExternalIds The external ids of the tasks to replay. At most 1000 ids can be passed.
+	ExternalIds *[]openapi_types.UUID `json:"externalIds,omitempty"`
+	Filter      *V2TaskFilter         `json:"filter,omitempty"`
+}
+
+// V2ReplayTasksResponse defines model for V2ReplayTasksResponse.
+type V2ReplayTasksResponse struct {
+	// ExternalIds The external ids of the tasks which were replayed
+	ExternalIds []openapi_types.UUID `json:"externalIds"`
+}
+
+// V2ReplayWorkflowRunFromTaskRequest defines model for V2ReplayWorkflowRunFromTaskRequest.
+type V2ReplayWorkflowRunFromTaskRequest struct {
+	// TaskExternalId The external id of the task to replay the workflow run from
+	TaskExternalId openapi_types.UUID `json:"taskExternalId"`
+}
+
+// V2ReplayWorkflowRunFromTaskResponse defines model for V2ReplayWorkflowRunFromTaskResponse.
+type V2ReplayWorkflowRunFromTaskResponse struct {
+	// ExternalIds The external ids of the tasks which will run again, starting with the task which the workflow run was replayed from
+	ExternalIds []openapi_types.UUID `json:"externalIds"`
+}
+
+// V2ReplayWorkflowRunsRequest Selects the workflow runs to replay, either by external id or by a filter. Exactly one of externalIds and filter must be set. A filter can match at most 10000 workflow runs.
+type V2ReplayWorkflowRunsRequest struct {
+	// ExternalIds The external ids of the workflow runs to replay. At most 1000 ids can be passed.
+	ExternalIds *[]openapi_types.UUID `json:"externalIds,omitempty"`
+	Filter      *V2WorkflowRunFilter  `json:"filter,omitempty"`
+}
+
+// V2ReplayWorkflowRunsResponse defines model for V2ReplayWorkflowRunsResponse.
+type V2ReplayWorkflowRunsResponse struct {
+	// ExternalIds The external ids of the workflow runs which were replayed
+	ExternalIds []openapi_types.UUID `json:"externalIds"`
+
+	// Skipped The DAG workflow runs which could not be replayed
+	Skipped []V2SkippedWorkflowRun `json:"skipped"`
+}
+
+// V2SkippedWorkflowRun defines model for V2SkippedWorkflowRun.
+type V2SkippedWorkflowRun struct {
+	// ExternalId The external id of the workflow run
+	ExternalId openapi_types.UUID `json:"externalId"`
+
+	// Reason Why the workflow run could not be replayed
+	Reason string `json:"reason"`
+}
+
 // V2Task defines model for V2Task.
 type V2Task struct {
 	// AdditionalMetadata Additional metadata for the task run.
@@ -1216,6 +1760,42 @@ type V2TaskEventList struct {
 // V2TaskEventType defines model for V2TaskEventType.
 type V2TaskEventType string
 
+// V2TaskFilter defines model for V2TaskFilter.
+type V2TaskFilter struct {
+	// AdditionalMetadata Additional metadata k-v pairs to filter by, of the form `key:value`
+	AdditionalMetadata *[]string `json:"additionalMetadata,omitempty"`
+
+	// DisplayName A case-insensitive substring of the task display name to filter by
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// ErrorContains A case-insensitive substring of task error messages to filter by
+	ErrorContains *string `json:"errorContains,omitempty"`
+
+	// ErrorRegex A regular expression matched against task error messages to filter by. Only syntax shared by POSIX and RE2 regular expressions is supported, so flags, lookarounds, backreferences and escapes other than \d, \s, \w, \n, \r and \t are rejected.
+	ErrorRegex *string `json:"errorRegex,omitempty"`
+
+	// InputFilters Filters on the task input, of the form `path:value` where path is a dot-separated list of keys. All filters must match. Filters on the same or overlapping paths, such as `a:1` and `a.b:2`, are rejected.
+	InputFilters *[]string `json:"inputFilters,omitempty"`
+
+	// OutputFilters Filters on the task output, of the form `path:value` where path is a dot-separated list of keys. All filters must match. Filters on the same or overlapping paths, such as `a:1` and `a.b:2`, are rejected.
+	OutputFilters *[]string `json:"outputFilters,omitempty"`
+
+	// Since The earliest date to filter by
+	Since time.Time `json:"since"`
+
+	// Statuses A list of statuses to filter by
+	Statuses *[]V2TaskStatus `json:"statuses,omitempty"`
+
+	// Until The latest date to filter by
+	Until *time.Time `json:"until,omitempty"`
+
+	// WorkerId The worker id to filter by
+	WorkerId *openapi_types.UUID `json:"workerId,omitempty"`
+
+	// WorkflowIds The workflow ids to filter by
+	WorkflowIds *[]openapi_types.UUID `json:"workflowIds,omitempty"`
+}
+
 // V2TaskPointMetric defines model for V2TaskPointMetric.
 type V2TaskPointMetric struct {
 	FAILED    int       `json:"FAILED"`
@@ -1228,6 +1808,75 @@ type V2TaskPointMetrics struct {
 	Results *[]V2TaskPointMetric `json:"results,omitempty"`
 }
 
+// V2TaskQueueConcurrencyDiagnosis defines model for V2TaskQueueConcurrencyDiagnosis.
+type V2TaskQueueConcurrencyDiagnosis struct {
+	Expression string `json:"expression"`
+
+	// FilledSlots The number of filled concurrency slots for the key.
+	FilledSlots int `json:"filledSlots"`
+
+	// IsFilled Whether the task holds a concurrency slot.
+	IsFilled bool `json:"isFilled"`
+
+	// Key The concurrency key of the task.
+	Key            string                `json:"key"`
+	MaxConcurrency int                   `json:"maxConcurrency"`
+	Strategy       V2ConcurrencyStrategy `json:"strategy"`
+
+	// StrategyId The ID of the concurrency strategy.
+	StrategyId int64 `json:"strategyId"`
+}
+
+// V2TaskQueueDiagnosis defines model for V2TaskQueueDiagnosis.
+type V2TaskQueueDiagnosis struct {
+	Concurrency []V2TaskQueueConcurrencyDiagnosis `json:"concurrency"`
+	RateLimits  []V2TaskQueueRateLimitDiagnosis   `json:"rateLimits"`
+
+	// Reasons The reasons that the task hasn't been assigned to a worker.
+	Reasons []V2TaskQueueDiagnosisReason `json:"reasons"`
+	Workers []V2TaskQueueWorkerDiagnosis `json:"workers"`
+}
+
+// V2TaskQueueDiagnosisReason defines model for V2TaskQueueDiagnosisReason.
+type V2TaskQueueDiagnosisReason struct {
+	// Message A human-readable explanation of the reason.
+	Message string            `json:"message"`
+	Reason  V2TaskQueueReason `json:"reason"`
+}
+
+// V2TaskQueueRateLimitDiagnosis defines model for V2TaskQueueRateLimitDiagnosis.
+type V2TaskQueueRateLimitDiagnosis struct {
+	// Available The number of units which are currently available. Not set if the rate limit doesn't exist.
+	Available *int `json:"available,omitempty"`
+
+	// Exceeded Whether the rate limit is preventing the task from being assigned.
+	Exceeded bool   `json:"exceeded"`
+	Key      string `json:"key"`
+
+	// NextRefillAt The next time that units are added to the rate limit.
+	NextRefillAt *time.Time `json:"nextRefillAt,omitempty"`
+
+	// Units The number of units that the task consumes.
+	Units int `json:"units"`
+}
+
+// V2TaskQueueReason defines model for V2TaskQueueReason.
+type V2TaskQueueReason string
+
+// V2TaskQueueWorkerDiagnosis defines model for V2TaskQueueWorkerDiagnosis.
+type V2TaskQueueWorkerDiagnosis struct {
+	// AvailableSlots The number of free slots on the worker.
+	AvailableSlots int                `json:"availableSlots"`
+	ExcludedReason *V2TaskQueueReason `json:"excludedReason,omitempty"`
+
+	// HasAction Whether the worker has registered the task's action.
+	HasAction bool `json:"hasAction"`
+
+	// UnmatchedLabels The required affinity labels which the worker doesn't match.
+	UnmatchedLabels []string           `json:"unmatchedLabels"`
+	WorkerId        openapi_types.UUID `json:"workerId"`
+}
+
 // V2TaskRunMetric defines model for V2TaskRunMetric.
 type V2TaskRunMetric struct {
 	Count  int          `json:"count"`
@@ -1336,6 +1985,24 @@ type V2WorkflowRunDetails struct {
 	Tasks      []V2TaskSummary `json:"tasks"`
 }
 
+// V2WorkflowRunFilter defines model for V2WorkflowRunFilter.
+type V2WorkflowRunFilter struct {
+	// AdditionalMetadata Additional metadata k-v pairs to filter by, of the form `key:value`
+	AdditionalMetadata *[]string `json:"additionalMetadata,omitempty"`
+
+	// Since The earliest date to filter by
+	Since time.Time `json:"since"`
+
+	// Statuses A list of statuses to filter by
+	Statuses *[]V2TaskStatus `json:"statuses,omitempty"`
+
+	// Until The latest date to filter by
+	Until *time.Time `json:"until,omitempty"`
+
+	// WorkflowIds The workflow ids to filter by
+	WorkflowIds *[]openapi_types.UUID `json:"workflowIds,omitempty"`
+}
+
 // V2WorkflowRunList defines model for V2WorkflowRunList.
 type V2WorkflowRunList struct {
 	Pagination PaginationResponse `json:"pagination"`
@@ -1344,19 +2011,100 @@ type V2WorkflowRunList struct {
 	Rows []V2WorkflowRun `json:"rows"`
 }
 
-// WebhookWorker defines model for WebhookWorker.
-type WebhookWorker struct {
-	Metadata APIResourceMeta `json:"metadata"`
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts The attempts to deliver the event, in order.
+	Attempts  []WebhookDeliveryAttempt     `json:"attempts"`
+	EventType WebhookSubscriptionEventType `json:"eventType"`
+	Metadata  APIResourceMeta              `json:"metadata"`
 
-	// Name The name of the webhook worker.
-	Name string `json:"name"`
+	// NextAttemptAt When the delivery will next be attempted, if it is pending.
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
 
-	// Url The webhook url.
-	Url string `json:"url"`
+	// Payload The JSON body which is delivered.
+	Payload map[string]interface{} `json:"payload"`
+	Status  WebhookDeliveryStatus  `json:"status"`
 }
 
-// WebhookWorkerCreateRequest defines model for WebhookWorkerCreateRequest.
-type WebhookWorkerCreateRequest struct {
+// WebhookDeliveryAttempt defines model for WebhookDeliveryAttempt.
+type WebhookDeliveryAttempt struct {
+	// Attempt The number of the attempt, starting at 1.
+	Attempt int `json:"attempt"`
+
+	// CreatedAt When the attempt was made.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DurationMs How long the request took, in milliseconds.
+	DurationMs int `json:"durationMs"`
+
+	// Error The error which caused the attempt to fail.
+	Error *string `json:"error,omitempty"`
+
+	// ResponseBody The start of the response body.
+	ResponseBody *string `json:"responseBody,omitempty"`
+
+	// ResponseStatusCode The status code of the response, if a response was received.
+	ResponseStatusCode *int `json:"responseStatusCode,omitempty"`
+}
+
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]WebhookDelivery  `json:"rows,omitempty"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	// Enabled Whether events are delivered to the webhook subscription.
+	Enabled bool `json:"enabled"`
+
+	// EventTypes The event types which are delivered. If empty, every event type is delivered.
+	EventTypes []WebhookSubscriptionEventType `json:"eventTypes"`
+	Metadata   APIResourceMeta                `json:"metadata"`
+
+	// Name The name of the webhook subscription.
+	Name string `json:"name"`
+
+	// Secret The secret which deliveries are signed with. Only returned when the webhook subscription is created.
+	Secret *string `json:"secret,omitempty"`
+
+	// Statuses The run statuses which events are delivered for. If empty, events for every status are delivered.
+	Statuses []WebhookSubscriptionRunStatus `json:"statuses"`
+
+	// Url The https url which events are delivered to.
+	Url string `json:"url"`
+
+	// WorkflowIds The workflows which events are delivered for. If empty, events for every workflow are delivered.
+	WorkflowIds []openapi_types.UUID `json:"workflowIds"`
+}
+
+// WebhookSubscriptionEventType defines model for WebhookSubscriptionEventType.
+type WebhookSubscriptionEventType string
+
+// WebhookSubscriptionList defines model for WebhookSubscriptionList.
+type WebhookSubscriptionList struct {
+	Rows *[]WebhookSubscription `json:"rows,omitempty"`
+}
+
+// WebhookSubscriptionRunStatus defines model for WebhookSubscriptionRunStatus.
+type WebhookSubscriptionRunStatus string
+
+// WebhookWorker defines model for WebhookWorker.
+type WebhookWorker struct {
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// Name The name of the webhook worker.
+	Name string `json:"name"`
+
+	// Url The webhook url.
+	Url string `json:"url"`
+}
+
+// WebhookWorkerCreateRequest defines model for WebhookWorkerCreateRequest.
+type WebhookWorkerCreateRequest struct {
 	// Name The name of the webhook worker.
 	Name string `json:"name"`
 
@@ -1751,6 +2499,33 @@ type LogLineListParams struct {
 	OrderByDirection *LogLineOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
+// AuditLogListParams defines parameters for AuditLogList.
+type AuditLogListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Actions A list of actions to filter by
+	Actions *[]string `form:"actions,omitempty" json:"actions,omitempty"`
+
+	// ActorId The id of the user or API token to filter by
+	ActorId *openapi_types.UUID `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// ResourceType The resource type to filter by
+	ResourceType *string `form:"resourceType,omitempty" json:"resourceType,omitempty"`
+
+	// ResourceId The resource id to filter by
+	ResourceId *string `form:"resourceId,omitempty" json:"resourceId,omitempty"`
+
+	// Since Only return entries created at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return entries created at or before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
 // EventListParams defines parameters for EventList.
 type EventListParams struct {
 	// Offset The number to skip
@@ -1784,6 +2559,15 @@ type EventListParams struct {
 	EventIds *[]openapi_types.UUID `form:"eventIds,omitempty" json:"eventIds,omitempty"`
 }
 
+// MessageQueueDeadLetterListParams defines parameters for MessageQueueDeadLetterList.
+type MessageQueueDeadLetterListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // TenantGetQueueMetricsParams defines parameters for TenantGetQueueMetrics.
 type TenantGetQueueMetricsParams struct {
 	// Workflows A list of workflow IDs to filter by
@@ -1811,6 +2595,18 @@ type RateLimitListParams struct {
 	OrderByDirection *RateLimitOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
+// WebhookDeliveryListParams defines parameters for WebhookDeliveryList.
+type WebhookDeliveryListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Statuses A list of delivery statuses to filter by
+	Statuses *[]WebhookDeliveryStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
+}
+
 // WorkflowRunListStepRunEventsParams defines parameters for WorkflowRunListStepRunEvents.
 type WorkflowRunListStepRunEventsParams struct {
 	// LastId Last ID of the last event
@@ -1991,6 +2787,24 @@ type V2TaskEventListParams struct {
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// V2ConcurrencyListParams defines parameters for V2ConcurrencyList.
+type V2ConcurrencyListParams struct {
+	// KeyLimit The maximum number of keys to return for each strategy, defaults to 50
+	KeyLimit *int64 `form:"key_limit,omitempty" json:"key_limit,omitempty"`
+}
+
+// V2RateLimitListParams defines parameters for V2RateLimitList.
+type V2RateLimitListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Search A substring of the rate limit key to filter by
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+}
+
 // V2TaskListStatusMetricsParams defines parameters for V2TaskListStatusMetrics.
 type V2TaskListStatusMetricsParams struct {
 	// Since The start time to get metrics for
@@ -2017,6 +2831,9 @@ type V2TaskListParams struct {
 	// Limit The number to limit by
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 
+	// Cursor An opaque cursor returned by a previous request, used to fetch the next page of results. When set, offset is ignored and the total count is not computed.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// Statuses A list of task statuses to filter by
 	Statuses *[]V2TaskStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
 
@@ -2034,6 +2851,21 @@ type V2TaskListParams struct {
 
 	// WorkerId The worker id to filter by
 	WorkerId *openapi_types.UUID `form:"worker_id,omitempty" json:"worker_id,omitempty"`
+
+	// DisplayName A case-insensitive substring of the task display name to filter by
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty"`
+
+	// ErrorContains A case-insensitive substring of a task error message to filter by
+	ErrorContains *string `form:"error_contains,omitempty" json:"error_contains,omitempty"`
+
+	// ErrorRegex A regular expression matched against task error messages to filter by. Only syntax shared by POSIX and RE2 regular expressions is supported, so flags, lookarounds, backreferences and escapes other than \d, \s, \w, \n, \r and \t are rejected.
+	ErrorRegex *string `form:"error_regex,omitempty" json:"error_regex,omitempty"`
+
+	// InputFilters Filters on the task input, of the form `path:value` where path is a dot-separated list of keys. All filters must match. Filters on the same or overlapping paths, such as `a:1` and `a.b:2`, are rejected.
+	InputFilters *[]string `form:"input_filters,omitempty" json:"input_filters,omitempty"`
+
+	// OutputFilters Filters on the task output, of the form `path:value` where path is a dot-separated list of keys. All filters must match. Filters on the same or overlapping paths, such as `a:1` and `a.b:2`, are rejected.
+	OutputFilters *[]string `form:"output_filters,omitempty" json:"output_filters,omitempty"`
 }
 
 // V2WorkflowRunListParams defines parameters for V2WorkflowRunList.
@@ -2044,6 +2876,9 @@ type V2WorkflowRunListParams struct {
 	// Limit The number to limit by
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 
+	// Cursor An opaque cursor returned by a previous request, used to fetch the next page of results. When set, offset is ignored and the total count is not computed.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// Statuses A list of statuses to filter by
 	Statuses *[]V2TaskStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
 
@@ -2102,12 +2937,33 @@ type TenantInviteCreateJSONRequestBody = CreateTenantInviteRequest
 // TenantInviteUpdateJSONRequestBody defines body for TenantInviteUpdate for application/json ContentType.
 type TenantInviteUpdateJSONRequestBody = UpdateTenantInviteRequest
 
+// TenantMemberUpdateJSONRequestBody defines body for TenantMemberUpdate for application/json ContentType.
+type TenantMemberUpdateJSONRequestBody = UpdateTenantMemberRequest
+
+// MessageQueueDeadLetterPurgeJSONRequestBody defines body for MessageQueueDeadLetterPurge for application/json ContentType.
+type MessageQueueDeadLetterPurgeJSONRequestBody = PurgeDeadLettersRequest
+
+// MessageQueueDeadLetterReplayJSONRequestBody defines body for MessageQueueDeadLetterReplay for application/json ContentType.
+type MessageQueueDeadLetterReplayJSONRequestBody = ReplayDeadLettersRequest
+
+// TenantRoleCreateJSONRequestBody defines body for TenantRoleCreate for application/json ContentType.
+type TenantRoleCreateJSONRequestBody = CreateTenantRoleRequest
+
+// TenantRoleUpdateJSONRequestBody defines body for TenantRoleUpdate for application/json ContentType.
+type TenantRoleUpdateJSONRequestBody = UpdateTenantRoleRequest
+
 // SnsCreateJSONRequestBody defines body for SnsCreate for application/json ContentType.
 type SnsCreateJSONRequestBody = CreateSNSIntegrationRequest
 
 // StepRunUpdateRerunJSONRequestBody defines body for StepRunUpdateRerun for application/json ContentType.
 type StepRunUpdateRerunJSONRequestBody = RerunStepRunRequest
 
+// WebhookSubscriptionCreateJSONRequestBody defines body for WebhookSubscriptionCreate for application/json ContentType.
+type WebhookSubscriptionCreateJSONRequestBody = CreateWebhookSubscriptionRequest
+
+// WebhookSubscriptionUpdateJSONRequestBody defines body for WebhookSubscriptionUpdate for application/json ContentType.
+type WebhookSubscriptionUpdateJSONRequestBody = UpdateWebhookSubscriptionRequest
+
 // WebhookCreateJSONRequestBody defines body for WebhookCreate for application/json ContentType.
 type WebhookCreateJSONRequestBody = WebhookWorkerCreateRequest
 
@@ -2117,6 +2973,9 @@ type WorkflowRunUpdateReplayJSONRequestBody = ReplayWorkflowRunsRequest
 // WorkflowRunCancelJSONRequestBody defines body for WorkflowRunCancel for application/json ContentType.
 type WorkflowRunCancelJSONRequestBody = WorkflowRunsCancelRequest
 
+// WorkflowCronUpdateJSONRequestBody defines body for WorkflowCronUpdate for application/json ContentType.
+type WorkflowCronUpdateJSONRequestBody = UpdateCronWorkflowTriggerRequest
+
 // CronWorkflowTriggerCreateJSONRequestBody defines body for CronWorkflowTriggerCreate for application/json ContentType.
 type CronWorkflowTriggerCreateJSONRequestBody = CreateCronWorkflowTriggerRequest
 
@@ -2147,6 +3006,21 @@ type WorkflowUpdateJSONRequestBody = WorkflowUpdateRequest
 // WorkflowRunCreateJSONRequestBody defines body for WorkflowRunCreate for application/json ContentType.
 type WorkflowRunCreateJSONRequestBody = TriggerWorkflowRunRequest
 
+// V2TaskCancelJSONRequestBody defines body for V2TaskCancel for application/json ContentType.
+type V2TaskCancelJSONRequestBody = V2CancelTasksRequest
+
+// V2TaskReplayJSONRequestBody defines body for V2TaskReplay for application/json ContentType.
+type V2TaskReplayJSONRequestBody = V2ReplayTasksRequest
+
+// V2WorkflowRunCancelJSONRequestBody defines body for V2WorkflowRunCancel for application/json ContentType.
+type V2WorkflowRunCancelJSONRequestBody = V2CancelWorkflowRunsRequest
+
+// V2WorkflowRunReplayJSONRequestBody defines body for V2WorkflowRunReplay for application/json ContentType.
+type V2WorkflowRunReplayJSONRequestBody = V2ReplayWorkflowRunsRequest
+
+// V2WorkflowRunReplayFromTaskJSONRequestBody defines body for V2WorkflowRunReplayFromTask for application/json ContentType.
+type V2WorkflowRunReplayFromTaskJSONRequestBody = V2ReplayWorkflowRunFromTaskRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get liveness
@@ -2221,6 +3095,9 @@ type ServerInterface interface {
 	// Create API Token
 	// (POST /api/v1/tenants/{tenant}/api-tokens)
 	ApiTokenCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List audit logs
+	// (GET /api/v1/tenants/{tenant}/audit-logs)
+	AuditLogList(ctx echo.Context, tenant openapi_types.UUID, params AuditLogListParams) error
 	// List events
 	// (GET /api/v1/tenants/{tenant}/events)
 	EventList(ctx echo.Context, tenant openapi_types.UUID, params EventListParams) error
@@ -2257,6 +3134,18 @@ type ServerInterface interface {
 	// Delete a tenant member
 	// (DELETE /api/v1/tenants/{tenant}/members/{member})
 	TenantMemberDelete(ctx echo.Context, tenant openapi_types.UUID, member openapi_types.UUID) error
+	// Update a tenant member
+	// (PATCH /api/v1/tenants/{tenant}/members/{member})
+	TenantMemberUpdate(ctx echo.Context, tenant openapi_types.UUID, member openapi_types.UUID) error
+	// List dead letters
+	// (GET /api/v1/tenants/{tenant}/message-queues/{message-queue}/dead-letters)
+	MessageQueueDeadLetterList(ctx echo.Context, tenant openapi_types.UUID, messageQueue string, params MessageQueueDeadLetterListParams) error
+	// Purge dead letters
+	// (POST /api/v1/tenants/{tenant}/message-queues/{message-queue}/dead-letters/purge)
+	MessageQueueDeadLetterPurge(ctx echo.Context, tenant openapi_types.UUID, messageQueue string) error
+	// Replay dead letters
+	// (POST /api/v1/tenants/{tenant}/message-queues/{message-queue}/dead-letters/replay)
+	MessageQueueDeadLetterReplay(ctx echo.Context, tenant openapi_types.UUID, messageQueue string) error
 	// Get workflow metrics
 	// (GET /api/v1/tenants/{tenant}/queue-metrics)
 	TenantGetQueueMetrics(ctx echo.Context, tenant openapi_types.UUID, params TenantGetQueueMetricsParams) error
@@ -2266,6 +3155,18 @@ type ServerInterface interface {
 	// Create tenant alert email group
 	// (GET /api/v1/tenants/{tenant}/resource-policy)
 	TenantResourcePolicyGet(ctx echo.Context, tenant openapi_types.UUID) error
+	// List tenant roles
+	// (GET /api/v1/tenants/{tenant}/roles)
+	TenantRoleList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create tenant role
+	// (POST /api/v1/tenants/{tenant}/roles)
+	TenantRoleCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// Delete tenant role
+	// (DELETE /api/v1/tenants/{tenant}/roles/{tenant-role})
+	TenantRoleDelete(ctx echo.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID) error
+	// Update tenant role
+	// (PATCH /api/v1/tenants/{tenant}/roles/{tenant-role})
+	TenantRoleUpdate(ctx echo.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID) error
 	// List Slack integrations
 	// (GET /api/v1/tenants/{tenant}/slack)
 	SlackWebhookList(ctx echo.Context, tenant openapi_types.UUID) error
@@ -2293,6 +3194,21 @@ type ServerInterface interface {
 	// Get step run schema
 	// (GET /api/v1/tenants/{tenant}/step-runs/{step-run}/schema)
 	StepRunGetSchema(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error
+	// List webhook subscriptions
+	// (GET /api/v1/tenants/{tenant}/webhook-subscriptions)
+	WebhookSubscriptionList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create webhook subscription
+	// (POST /api/v1/tenants/{tenant}/webhook-subscriptions)
+	WebhookSubscriptionCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// Delete webhook subscription
+	// (DELETE /api/v1/tenants/{tenant}/webhook-subscriptions/{webhook-subscription})
+	WebhookSubscriptionDelete(ctx echo.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID) error
+	// Update webhook subscription
+	// (PATCH /api/v1/tenants/{tenant}/webhook-subscriptions/{webhook-subscription})
+	WebhookSubscriptionUpdate(ctx echo.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID) error
+	// List webhook deliveries
+	// (GET /api/v1/tenants/{tenant}/webhook-subscriptions/{webhook-subscription}/deliveries)
+	WebhookDeliveryList(ctx echo.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, params WebhookDeliveryListParams) error
 	// List webhooks
 	// (GET /api/v1/tenants/{tenant}/webhook-workers)
 	WebhookList(ctx echo.Context, tenant openapi_types.UUID) error
@@ -2332,6 +3248,9 @@ type ServerInterface interface {
 	// Get cron job workflow run
 	// (GET /api/v1/tenants/{tenant}/workflows/crons/{cron-workflow})
 	WorkflowCronGet(ctx echo.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) error
+	// Update cron job workflow trigger
+	// (PATCH /api/v1/tenants/{tenant}/workflows/crons/{cron-workflow})
+	WorkflowCronUpdate(ctx echo.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) error
 	// Get workflow runs
 	// (GET /api/v1/tenants/{tenant}/workflows/runs)
 	WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunListParams) error
@@ -2389,6 +3308,12 @@ type ServerInterface interface {
 	// List tenant memberships
 	// (GET /api/v1/users/memberships)
 	TenantMembershipsList(ctx echo.Context) error
+	// Complete OAuth flow
+	// (GET /api/v1/users/oidc/callback)
+	UserUpdateOidcOauthCallback(ctx echo.Context) error
+	// Start OAuth flow
+	// (GET /api/v1/users/oidc/start)
+	UserUpdateOidcOauthStart(ctx echo.Context) error
 	// Change user password
 	// (POST /api/v1/users/password)
 	UserUpdatePassword(ctx echo.Context) error
@@ -2437,9 +3362,18 @@ type ServerInterface interface {
 	// Get a task
 	// (GET /api/v2/tasks/{task})
 	V2TaskGet(ctx echo.Context, task openapi_types.UUID) error
+	// Get the queue diagnosis for a task
+	// (GET /api/v2/tasks/{task}/queue-diagnosis)
+	V2TaskGetQueueDiagnosis(ctx echo.Context, task openapi_types.UUID) error
 	// List events for a task
 	// (GET /api/v2/tasks/{task}/task-events)
 	V2TaskEventList(ctx echo.Context, task openapi_types.UUID, params V2TaskEventListParams) error
+	// List concurrency usage
+	// (GET /api/v2/tenants/{tenant}/concurrency)
+	V2ConcurrencyList(ctx echo.Context, tenant openapi_types.UUID, params V2ConcurrencyListParams) error
+	// List rate limit usage
+	// (GET /api/v2/tenants/{tenant}/rate-limits)
+	V2RateLimitList(ctx echo.Context, tenant openapi_types.UUID, params V2RateLimitListParams) error
 	// Get task metrics
 	// (GET /api/v2/tenants/{tenant}/task-metrics)
 	V2TaskListStatusMetrics(ctx echo.Context, tenant openapi_types.UUID, params V2TaskListStatusMetricsParams) error
@@ -2449,12 +3383,27 @@ type ServerInterface interface {
 	// List tasks
 	// (GET /api/v2/tenants/{tenant}/tasks)
 	V2TaskList(ctx echo.Context, tenant openapi_types.UUID, params V2TaskListParams) error
+	// Cancel tasks
+	// (POST /api/v2/tenants/{tenant}/tasks/cancel)
+	V2TaskCancel(ctx echo.Context, tenant openapi_types.UUID) error
+	// Replay tasks
+	// (POST /api/v2/tenants/{tenant}/tasks/replay)
+	V2TaskReplay(ctx echo.Context, tenant openapi_types.UUID) error
 	// List workflow runs
 	// (GET /api/v2/tenants/{tenant}/workflow-runs)
 	V2WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params V2WorkflowRunListParams) error
+	// Cancel workflow runs
+	// (POST /api/v2/tenants/{tenant}/workflow-runs/cancel)
+	V2WorkflowRunCancel(ctx echo.Context, tenant openapi_types.UUID) error
+	// Replay workflow runs
+	// (POST /api/v2/tenants/{tenant}/workflow-runs/replay)
+	V2WorkflowRunReplay(ctx echo.Context, tenant openapi_types.UUID) error
 	// List tasks
 	// (GET /api/v2/workflow-runs/{v2-workflow-run})
 	V2WorkflowRunGet(ctx echo.Context, v2WorkflowRun openapi_types.UUID) error
+	// Replay workflow run from task
+	// (POST /api/v2/workflow-runs/{v2-workflow-run}/replay-from-task)
+	V2WorkflowRunReplayFromTask(ctx echo.Context, v2WorkflowRun openapi_types.UUID) error
 	// List tasks
 	// (GET /api/v2/workflow-runs/{v2-workflow-run}/task-events)
 	V2WorkflowRunTaskEventsList(ctx echo.Context, v2WorkflowRun openapi_types.UUID, params V2WorkflowRunTaskEventsListParams) error
@@ -2967,6 +3916,84 @@ func (w *ServerInterfaceWrapper) ApiTokenCreate(ctx echo.Context) error {
 	return err
 }
 
+// AuditLogList converts echo context to params.
+func (w *ServerInterfaceWrapper) AuditLogList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuditLogListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "actions" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actions", ctx.QueryParams(), &params.Actions)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actions: %s", err))
+	}
+
+	// ------------- Optional query parameter "actorId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actorId", ctx.QueryParams(), &params.ActorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actorId: %s", err))
+	}
+
+	// ------------- Optional query parameter "resourceType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceType", ctx.QueryParams(), &params.ResourceType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceType: %s", err))
+	}
+
+	// ------------- Optional query parameter "resourceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceId", ctx.QueryParams(), &params.ResourceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AuditLogList(ctx, tenant, params)
+	return err
+}
+
 // EventList converts echo context to params.
 func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
 	var err error
@@ -3303,8 +4330,8 @@ func (w *ServerInterfaceWrapper) TenantMemberDelete(ctx echo.Context) error {
 	return err
 }
 
-// TenantGetQueueMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantGetQueueMetrics(ctx echo.Context) error {
+// TenantMemberUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantMemberUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3314,33 +4341,25 @@ func (w *ServerInterfaceWrapper) TenantGetQueueMetrics(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	ctx.Set(CookieAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params TenantGetQueueMetricsParams
-	// ------------- Optional query parameter "workflows" -------------
+	// ------------- Path parameter "member" -------------
+	var member openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "member", runtime.ParamLocationPath, ctx.Param("member"), &member)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member: %s", err))
 	}
 
-	// ------------- Optional query parameter "additionalMetadata" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantGetQueueMetrics(ctx, tenant, params)
+	err = w.Handler.TenantMemberUpdate(ctx, tenant, member)
 	return err
 }
 
-// RateLimitList converts echo context to params.
-func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
+// MessageQueueDeadLetterList converts echo context to params.
+func (w *ServerInterfaceWrapper) MessageQueueDeadLetterList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3350,12 +4369,20 @@ func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "message-queue" -------------
+	var messageQueue string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "message-queue", runtime.ParamLocationPath, ctx.Param("message-queue"), &messageQueue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter message-queue: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RateLimitListParams
+	var params MessageQueueDeadLetterListParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -3370,34 +4397,41 @@ func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "search" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MessageQueueDeadLetterList(ctx, tenant, messageQueue, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+// MessageQueueDeadLetterPurge converts echo context to params.
+func (w *ServerInterfaceWrapper) MessageQueueDeadLetterPurge(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByField" -------------
+	// ------------- Path parameter "message-queue" -------------
+	var messageQueue string
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "message-queue", runtime.ParamLocationPath, ctx.Param("message-queue"), &messageQueue)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter message-queue: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByDirection" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RateLimitList(ctx, tenant, params)
+	err = w.Handler.MessageQueueDeadLetterPurge(ctx, tenant, messageQueue)
 	return err
 }
 
-// TenantResourcePolicyGet converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantResourcePolicyGet(ctx echo.Context) error {
+// MessageQueueDeadLetterReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) MessageQueueDeadLetterReplay(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3407,17 +4441,25 @@ func (w *ServerInterfaceWrapper) TenantResourcePolicyGet(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "message-queue" -------------
+	var messageQueue string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "message-queue", runtime.ParamLocationPath, ctx.Param("message-queue"), &messageQueue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter message-queue: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantResourcePolicyGet(ctx, tenant)
+	err = w.Handler.MessageQueueDeadLetterReplay(ctx, tenant, messageQueue)
 	return err
 }
 
-// SlackWebhookList converts echo context to params.
-func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
+// TenantGetQueueMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantGetQueueMetrics(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3431,13 +4473,29 @@ func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TenantGetQueueMetricsParams
+	// ------------- Optional query parameter "workflows" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
+	}
+
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SlackWebhookList(ctx, tenant)
+	err = w.Handler.TenantGetQueueMetrics(ctx, tenant, params)
 	return err
 }
 
-// UserUpdateSlackOauthStart converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) error {
+// RateLimitList converts echo context to params.
+func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3447,35 +4505,54 @@ func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateSlackOauthStart(ctx, tenant)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RateLimitListParams
+	// ------------- Optional query parameter "offset" -------------
 
-// SnsList converts echo context to params.
-func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "search" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByField" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByDirection" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SnsList(ctx, tenant)
+	err = w.Handler.RateLimitList(ctx, tenant, params)
 	return err
 }
 
-// SnsCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
+// TenantResourcePolicyGet converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantResourcePolicyGet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3490,12 +4567,12 @@ func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SnsCreate(ctx, tenant)
+	err = w.Handler.TenantResourcePolicyGet(ctx, tenant)
 	return err
 }
 
-// TenantGetStepRunQueueMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantGetStepRunQueueMetrics(ctx echo.Context) error {
+// TenantRoleList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantRoleList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3510,12 +4587,12 @@ func (w *ServerInterfaceWrapper) TenantGetStepRunQueueMetrics(ctx echo.Context)
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantGetStepRunQueueMetrics(ctx, tenant)
+	err = w.Handler.TenantRoleList(ctx, tenant)
 	return err
 }
 
-// StepRunGet converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
+// TenantRoleCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantRoleCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3525,25 +4602,17 @@ func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunGet(ctx, tenant, stepRun)
+	err = w.Handler.TenantRoleCreate(ctx, tenant)
 	return err
 }
 
-// StepRunUpdateCancel converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunUpdateCancel(ctx echo.Context) error {
+// TenantRoleDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantRoleDelete(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3553,12 +4622,12 @@ func (w *ServerInterfaceWrapper) StepRunUpdateCancel(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
+	// ------------- Path parameter "tenant-role" -------------
+	var tenantRole openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-role", runtime.ParamLocationPath, ctx.Param("tenant-role"), &tenantRole)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-role: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3566,12 +4635,12 @@ func (w *ServerInterfaceWrapper) StepRunUpdateCancel(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunUpdateCancel(ctx, tenant, stepRun)
+	err = w.Handler.TenantRoleDelete(ctx, tenant, tenantRole)
 	return err
 }
 
-// StepRunUpdateRerun converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
+// TenantRoleUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantRoleUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3581,12 +4650,12 @@ func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
+	// ------------- Path parameter "tenant-role" -------------
+	var tenantRole openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-role", runtime.ParamLocationPath, ctx.Param("tenant-role"), &tenantRole)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-role: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3594,12 +4663,12 @@ func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunUpdateRerun(ctx, tenant, stepRun)
+	err = w.Handler.TenantRoleUpdate(ctx, tenant, tenantRole)
 	return err
 }
 
-// StepRunGetSchema converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
+// SlackWebhookList converts echo context to params.
+func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3609,25 +4678,17 @@ func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunGetSchema(ctx, tenant, stepRun)
+	err = w.Handler.SlackWebhookList(ctx, tenant)
 	return err
 }
 
-// WebhookList converts echo context to params.
-func (w *ServerInterfaceWrapper) WebhookList(ctx echo.Context) error {
+// UserUpdateSlackOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3637,17 +4698,15 @@ func (w *ServerInterfaceWrapper) WebhookList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WebhookList(ctx, tenant)
+	err = w.Handler.UserUpdateSlackOauthStart(ctx, tenant)
 	return err
 }
 
-// WebhookCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) WebhookCreate(ctx echo.Context) error {
+// SnsList converts echo context to params.
+func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3662,12 +4721,12 @@ func (w *ServerInterfaceWrapper) WebhookCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WebhookCreate(ctx, tenant)
+	err = w.Handler.SnsList(ctx, tenant)
 	return err
 }
 
-// WorkerList converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkerList(ctx echo.Context) error {
+// SnsCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3682,12 +4741,12 @@ func (w *ServerInterfaceWrapper) WorkerList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkerList(ctx, tenant)
+	err = w.Handler.SnsCreate(ctx, tenant)
 	return err
 }
 
-// WorkflowRunUpdateReplay converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunUpdateReplay(ctx echo.Context) error {
+// TenantGetStepRunQueueMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantGetStepRunQueueMetrics(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3702,12 +4761,12 @@ func (w *ServerInterfaceWrapper) WorkflowRunUpdateReplay(ctx echo.Context) error
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunUpdateReplay(ctx, tenant)
+	err = w.Handler.TenantGetStepRunQueueMetrics(ctx, tenant)
 	return err
 }
 
-// WorkflowRunGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunGet(ctx echo.Context) error {
+// StepRunGet converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3717,12 +4776,12 @@ func (w *ServerInterfaceWrapper) WorkflowRunGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3730,12 +4789,12 @@ func (w *ServerInterfaceWrapper) WorkflowRunGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunGet(ctx, tenant, workflowRun)
+	err = w.Handler.StepRunGet(ctx, tenant, stepRun)
 	return err
 }
 
-// WorkflowRunGetInput converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunGetInput(ctx echo.Context) error {
+// StepRunUpdateCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunUpdateCancel(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3745,12 +4804,12 @@ func (w *ServerInterfaceWrapper) WorkflowRunGetInput(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3758,12 +4817,12 @@ func (w *ServerInterfaceWrapper) WorkflowRunGetInput(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunGetInput(ctx, tenant, workflowRun)
+	err = w.Handler.StepRunUpdateCancel(ctx, tenant, stepRun)
 	return err
 }
 
-// WorkflowRunGetShape converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunGetShape(ctx echo.Context) error {
+// StepRunUpdateRerun converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3773,12 +4832,12 @@ func (w *ServerInterfaceWrapper) WorkflowRunGetShape(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3786,12 +4845,12 @@ func (w *ServerInterfaceWrapper) WorkflowRunGetShape(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunGetShape(ctx, tenant, workflowRun)
+	err = w.Handler.StepRunUpdateRerun(ctx, tenant, stepRun)
 	return err
 }
 
-// WorkflowRunListStepRunEvents converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunListStepRunEvents(ctx echo.Context) error {
+// StepRunGetSchema converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3801,34 +4860,45 @@ func (w *ServerInterfaceWrapper) WorkflowRunListStepRunEvents(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowRunListStepRunEventsParams
-	// ------------- Optional query parameter "lastId" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunGetSchema(ctx, tenant, stepRun)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "lastId", ctx.QueryParams(), &params.LastId)
+// WebhookSubscriptionList converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookSubscriptionList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunListStepRunEvents(ctx, tenant, workflowRun, params)
+	err = w.Handler.WebhookSubscriptionList(ctx, tenant)
 	return err
 }
 
-// WorkflowList converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowList(ctx echo.Context) error {
+// WebhookSubscriptionCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookSubscriptionCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3842,36 +4912,41 @@ func (w *ServerInterfaceWrapper) WorkflowList(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowListParams
-	// ------------- Optional query parameter "offset" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookSubscriptionCreate(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+// WebhookSubscriptionDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookSubscriptionDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "webhook-subscription" -------------
+	var webhookSubscription openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook-subscription", runtime.ParamLocationPath, ctx.Param("webhook-subscription"), &webhookSubscription)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook-subscription: %s", err))
 	}
 
-	// ------------- Optional query parameter "name" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowList(ctx, tenant, params)
+	err = w.Handler.WebhookSubscriptionDelete(ctx, tenant, webhookSubscription)
 	return err
 }
 
-// WorkflowRunCancel converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunCancel(ctx echo.Context) error {
+// WebhookSubscriptionUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookSubscriptionUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3881,17 +4956,25 @@ func (w *ServerInterfaceWrapper) WorkflowRunCancel(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "webhook-subscription" -------------
+	var webhookSubscription openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook-subscription", runtime.ParamLocationPath, ctx.Param("webhook-subscription"), &webhookSubscription)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook-subscription: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunCancel(ctx, tenant)
+	err = w.Handler.WebhookSubscriptionUpdate(ctx, tenant, webhookSubscription)
 	return err
 }
 
-// CronWorkflowList converts echo context to params.
-func (w *ServerInterfaceWrapper) CronWorkflowList(ctx echo.Context) error {
+// WebhookDeliveryList converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookDeliveryList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3901,12 +4984,20 @@ func (w *ServerInterfaceWrapper) CronWorkflowList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "webhook-subscription" -------------
+	var webhookSubscription openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook-subscription", runtime.ParamLocationPath, ctx.Param("webhook-subscription"), &webhookSubscription)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook-subscription: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CronWorkflowListParams
+	var params WebhookDeliveryListParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -3921,41 +5012,376 @@ func (w *ServerInterfaceWrapper) CronWorkflowList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflowId" -------------
+	// ------------- Optional query parameter "statuses" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
 	}
 
-	// ------------- Optional query parameter "additionalMetadata" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookDeliveryList(ctx, tenant, webhookSubscription, params)
+	return err
+}
 
-	// ------------- Optional query parameter "orderByField" -------------
+// WebhookList converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookList(ctx, tenant)
+	return err
+}
+
+// WebhookCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookCreate(ctx, tenant)
+	return err
+}
+
+// WorkerList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkerList(ctx, tenant)
+	return err
+}
+
+// WorkflowRunUpdateReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunUpdateReplay(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunUpdateReplay(ctx, tenant)
+	return err
+}
+
+// WorkflowRunGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGet(ctx, tenant, workflowRun)
+	return err
+}
+
+// WorkflowRunGetInput converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetInput(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGetInput(ctx, tenant, workflowRun)
+	return err
+}
+
+// WorkflowRunGetShape converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetShape(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGetShape(ctx, tenant, workflowRun)
+	return err
+}
+
+// WorkflowRunListStepRunEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunListStepRunEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunListStepRunEventsParams
+	// ------------- Optional query parameter "lastId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastId", ctx.QueryParams(), &params.LastId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunListStepRunEvents(ctx, tenant, workflowRun, params)
+	return err
+}
+
+// WorkflowList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowList(ctx, tenant, params)
+	return err
+}
+
+// WorkflowRunCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunCancel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunCancel(ctx, tenant)
+	return err
+}
+
+// CronWorkflowList converts echo context to params.
+func (w *ServerInterfaceWrapper) CronWorkflowList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CronWorkflowListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+	}
+
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByField" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderByDirection" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CronWorkflowList(ctx, tenant, params)
+	return err
+}
+
+// WorkflowCronDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowCronDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "cron-workflow" -------------
+	var cronWorkflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, ctx.Param("cron-workflow"), &cronWorkflow)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cron-workflow: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CronWorkflowList(ctx, tenant, params)
+	err = w.Handler.WorkflowCronDelete(ctx, tenant, cronWorkflow)
 	return err
 }
 
-// WorkflowCronDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowCronDelete(ctx echo.Context) error {
+// WorkflowCronGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowCronGet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3978,12 +5404,12 @@ func (w *ServerInterfaceWrapper) WorkflowCronDelete(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowCronDelete(ctx, tenant, cronWorkflow)
+	err = w.Handler.WorkflowCronGet(ctx, tenant, cronWorkflow)
 	return err
 }
 
-// WorkflowCronGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowCronGet(ctx echo.Context) error {
+// WorkflowCronUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowCronUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4006,7 +5432,7 @@ func (w *ServerInterfaceWrapper) WorkflowCronGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowCronGet(ctx, tenant, cronWorkflow)
+	err = w.Handler.WorkflowCronUpdate(ctx, tenant, cronWorkflow)
 	return err
 }
 
@@ -4548,6 +5974,24 @@ func (w *ServerInterfaceWrapper) TenantMembershipsList(ctx echo.Context) error {
 	return err
 }
 
+// UserUpdateOidcOauthCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateOidcOauthCallback(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateOidcOauthCallback(ctx)
+	return err
+}
+
+// UserUpdateOidcOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateOidcOauthStart(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateOidcOauthStart(ctx)
+	return err
+}
+
 // UserUpdatePassword converts echo context to params.
 func (w *ServerInterfaceWrapper) UserUpdatePassword(ctx echo.Context) error {
 	var err error
@@ -4871,6 +6315,26 @@ func (w *ServerInterfaceWrapper) V2TaskGet(ctx echo.Context) error {
 	return err
 }
 
+// V2TaskGetQueueDiagnosis converts echo context to params.
+func (w *ServerInterfaceWrapper) V2TaskGetQueueDiagnosis(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "task" -------------
+	var task openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "task", runtime.ParamLocationPath, ctx.Param("task"), &task)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V2TaskGetQueueDiagnosis(ctx, task)
+	return err
+}
+
 // V2TaskEventList converts echo context to params.
 func (w *ServerInterfaceWrapper) V2TaskEventList(ctx echo.Context) error {
 	var err error
@@ -4907,6 +6371,78 @@ func (w *ServerInterfaceWrapper) V2TaskEventList(ctx echo.Context) error {
 	return err
 }
 
+// V2ConcurrencyList converts echo context to params.
+func (w *ServerInterfaceWrapper) V2ConcurrencyList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V2ConcurrencyListParams
+	// ------------- Optional query parameter "key_limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "key_limit", ctx.QueryParams(), &params.KeyLimit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key_limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V2ConcurrencyList(ctx, tenant, params)
+	return err
+}
+
+// V2RateLimitList converts echo context to params.
+func (w *ServerInterfaceWrapper) V2RateLimitList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V2RateLimitListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V2RateLimitList(ctx, tenant, params)
+	return err
+}
+
 // V2TaskListStatusMetrics converts echo context to params.
 func (w *ServerInterfaceWrapper) V2TaskListStatusMetrics(ctx echo.Context) error {
 	var err error
@@ -5010,6 +6546,13 @@ func (w *ServerInterfaceWrapper) V2TaskList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// ------------- Optional query parameter "statuses" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
@@ -5052,11 +6595,86 @@ func (w *ServerInterfaceWrapper) V2TaskList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
 	}
 
+	// ------------- Optional query parameter "display_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "display_name", ctx.QueryParams(), &params.DisplayName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter display_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "error_contains" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error_contains", ctx.QueryParams(), &params.ErrorContains)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter error_contains: %s", err))
+	}
+
+	// ------------- Optional query parameter "error_regex" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error_regex", ctx.QueryParams(), &params.ErrorRegex)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter error_regex: %s", err))
+	}
+
+	// ------------- Optional query parameter "input_filters" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "input_filters", ctx.QueryParams(), &params.InputFilters)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter input_filters: %s", err))
+	}
+
+	// ------------- Optional query parameter "output_filters" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "output_filters", ctx.QueryParams(), &params.OutputFilters)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter output_filters: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.V2TaskList(ctx, tenant, params)
 	return err
 }
 
+// V2TaskCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) V2TaskCancel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V2TaskCancel(ctx, tenant)
+	return err
+}
+
+// V2TaskReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) V2TaskReplay(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V2TaskReplay(ctx, tenant)
+	return err
+}
+
 // V2WorkflowRunList converts echo context to params.
 func (w *ServerInterfaceWrapper) V2WorkflowRunList(ctx echo.Context) error {
 	var err error
@@ -5088,6 +6706,13 @@ func (w *ServerInterfaceWrapper) V2WorkflowRunList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// ------------- Optional query parameter "statuses" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
@@ -5128,6 +6753,46 @@ func (w *ServerInterfaceWrapper) V2WorkflowRunList(ctx echo.Context) error {
 	return err
 }
 
+// V2WorkflowRunCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) V2WorkflowRunCancel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V2WorkflowRunCancel(ctx, tenant)
+	return err
+}
+
+// V2WorkflowRunReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) V2WorkflowRunReplay(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V2WorkflowRunReplay(ctx, tenant)
+	return err
+}
+
 // V2WorkflowRunGet converts echo context to params.
 func (w *ServerInterfaceWrapper) V2WorkflowRunGet(ctx echo.Context) error {
 	var err error
@@ -5148,6 +6813,26 @@ func (w *ServerInterfaceWrapper) V2WorkflowRunGet(ctx echo.Context) error {
 	return err
 }
 
+// V2WorkflowRunReplayFromTask converts echo context to params.
+func (w *ServerInterfaceWrapper) V2WorkflowRunReplayFromTask(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "v2-workflow-run" -------------
+	var v2WorkflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v2-workflow-run", runtime.ParamLocationPath, ctx.Param("v2-workflow-run"), &v2WorkflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v2-workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V2WorkflowRunReplayFromTask(ctx, v2WorkflowRun)
+	return err
+}
+
 // V2WorkflowRunTaskEventsList converts echo context to params.
 func (w *ServerInterfaceWrapper) V2WorkflowRunTaskEventsList(ctx echo.Context) error {
 	var err error
@@ -5236,6 +6921,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/tenants/:tenant/alerting/settings", wrapper.TenantAlertingSettingsGet)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/audit-logs", wrapper.AuditLogList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/events", wrapper.EventList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/events", wrapper.EventCreate)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/events/bulk", wrapper.EventCreateBulk)
@@ -5248,9 +6934,17 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PATCH(baseURL+"/api/v1/tenants/:tenant/invites/:tenant-invite", wrapper.TenantInviteUpdate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/members", wrapper.TenantMemberList)
 	router.DELETE(baseURL+"/api/v1/tenants/:tenant/members/:member", wrapper.TenantMemberDelete)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant/members/:member", wrapper.TenantMemberUpdate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/message-queues/:message-queue/dead-letters", wrapper.MessageQueueDeadLetterList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/message-queues/:message-queue/dead-letters/purge", wrapper.MessageQueueDeadLetterPurge)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/message-queues/:message-queue/dead-letters/replay", wrapper.MessageQueueDeadLetterReplay)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/queue-metrics", wrapper.TenantGetQueueMetrics)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/rate-limits", wrapper.RateLimitList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/resource-policy", wrapper.TenantResourcePolicyGet)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/roles", wrapper.TenantRoleList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/roles", wrapper.TenantRoleCreate)
+	router.DELETE(baseURL+"/api/v1/tenants/:tenant/roles/:tenant-role", wrapper.TenantRoleDelete)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant/roles/:tenant-role", wrapper.TenantRoleUpdate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/slack", wrapper.SlackWebhookList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/slack/start", wrapper.UserUpdateSlackOauthStart)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/sns", wrapper.SnsList)
@@ -5260,6 +6954,11 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/cancel", wrapper.StepRunUpdateCancel)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/rerun", wrapper.StepRunUpdateRerun)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/schema", wrapper.StepRunGetSchema)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/webhook-subscriptions", wrapper.WebhookSubscriptionList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/webhook-subscriptions", wrapper.WebhookSubscriptionCreate)
+	router.DELETE(baseURL+"/api/v1/tenants/:tenant/webhook-subscriptions/:webhook-subscription", wrapper.WebhookSubscriptionDelete)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant/webhook-subscriptions/:webhook-subscription", wrapper.WebhookSubscriptionUpdate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/webhook-subscriptions/:webhook-subscription/deliveries", wrapper.WebhookDeliveryList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/webhook-workers", wrapper.WebhookList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/webhook-workers", wrapper.WebhookCreate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/worker", wrapper.WorkerList)
@@ -5273,6 +6972,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/crons", wrapper.CronWorkflowList)
 	router.DELETE(baseURL+"/api/v1/tenants/:tenant/workflows/crons/:cron-workflow", wrapper.WorkflowCronDelete)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/crons/:cron-workflow", wrapper.WorkflowCronGet)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant/workflows/crons/:cron-workflow", wrapper.WorkflowCronUpdate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/runs", wrapper.WorkflowRunList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/runs/metrics", wrapper.WorkflowRunGetMetrics)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/scheduled", wrapper.WorkflowScheduledList)
@@ -5292,6 +6992,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/api/v1/users/login", wrapper.UserUpdateLogin)
 	router.POST(baseURL+"/api/v1/users/logout", wrapper.UserUpdateLogout)
 	router.GET(baseURL+"/api/v1/users/memberships", wrapper.TenantMembershipsList)
+	router.GET(baseURL+"/api/v1/users/oidc/callback", wrapper.UserUpdateOidcOauthCallback)
+	router.GET(baseURL+"/api/v1/users/oidc/start", wrapper.UserUpdateOidcOauthStart)
 	router.POST(baseURL+"/api/v1/users/password", wrapper.UserUpdatePassword)
 	router.POST(baseURL+"/api/v1/users/register", wrapper.UserCreate)
 	router.GET(baseURL+"/api/v1/users/slack/callback", wrapper.UserUpdateSlackOauthCallback)
@@ -5308,12 +7010,20 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/workflows/:workflow/versions", wrapper.WorkflowVersionGet)
 	router.GET(baseURL+"/api/v2/dags/tasks", wrapper.V2DagListTasks)
 	router.GET(baseURL+"/api/v2/tasks/:task", wrapper.V2TaskGet)
+	router.GET(baseURL+"/api/v2/tasks/:task/queue-diagnosis", wrapper.V2TaskGetQueueDiagnosis)
 	router.GET(baseURL+"/api/v2/tasks/:task/task-events", wrapper.V2TaskEventList)
+	router.GET(baseURL+"/api/v2/tenants/:tenant/concurrency", wrapper.V2ConcurrencyList)
+	router.GET(baseURL+"/api/v2/tenants/:tenant/rate-limits", wrapper.V2RateLimitList)
 	router.GET(baseURL+"/api/v2/tenants/:tenant/task-metrics", wrapper.V2TaskListStatusMetrics)
 	router.GET(baseURL+"/api/v2/tenants/:tenant/task-point-metrics", wrapper.V2TaskGetPointMetrics)
 	router.GET(baseURL+"/api/v2/tenants/:tenant/tasks", wrapper.V2TaskList)
+	router.POST(baseURL+"/api/v2/tenants/:tenant/tasks/cancel", wrapper.V2TaskCancel)
+	router.POST(baseURL+"/api/v2/tenants/:tenant/tasks/replay", wrapper.V2TaskReplay)
 	router.GET(baseURL+"/api/v2/tenants/:tenant/workflow-runs", wrapper.V2WorkflowRunList)
+	router.POST(baseURL+"/api/v2/tenants/:tenant/workflow-runs/cancel", wrapper.V2WorkflowRunCancel)
+	router.POST(baseURL+"/api/v2/tenants/:tenant/workflow-runs/replay", wrapper.V2WorkflowRunReplay)
 	router.GET(baseURL+"/api/v2/workflow-runs/:v2-workflow-run", wrapper.V2WorkflowRunGet)
+	router.POST(baseURL+"/api/v2/workflow-runs/:v2-workflow-run/replay-from-task", wrapper.V2WorkflowRunReplayFromTask)
 	router.GET(baseURL+"/api/v2/workflow-runs/:v2-workflow-run/task-events", wrapper.V2WorkflowRunTaskEventsList)
 
 }
@@ -6124,18 +7834,54 @@ func (response ApiTokenCreate200JSONResponse) VisitApiTokenCreateResponse(w http
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenCreate400JSONResponse APIErrors
+type ApiTokenCreate400JSONResponse APIErrors
+
+func (response ApiTokenCreate400JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenCreate403JSONResponse APIErrors
+
+func (response ApiTokenCreate403JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuditLogListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params AuditLogListParams
+}
+
+type AuditLogListResponseObject interface {
+	VisitAuditLogListResponse(w http.ResponseWriter) error
+}
+
+type AuditLogList200JSONResponse AuditLogList
+
+func (response AuditLogList200JSONResponse) VisitAuditLogListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuditLogList400JSONResponse APIErrors
 
-func (response ApiTokenCreate400JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+func (response AuditLogList400JSONResponse) VisitAuditLogListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenCreate403JSONResponse APIErrors
+type AuditLogList403JSONResponse APIErrors
 
-func (response ApiTokenCreate403JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+func (response AuditLogList403JSONResponse) VisitAuditLogListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
@@ -6601,122 +8347,441 @@ func (response TenantMemberDelete404JSONResponse) VisitTenantMemberDeleteRespons
 	return json.NewEncoder(w).Encode(response)
 }
 
+type TenantMemberUpdateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Member openapi_types.UUID `json:"member"`
+	Body   *TenantMemberUpdateJSONRequestBody
+}
+
+type TenantMemberUpdateResponseObject interface {
+	VisitTenantMemberUpdateResponse(w http.ResponseWriter) error
+}
+
+type TenantMemberUpdate200JSONResponse TenantMember
+
+func (response TenantMemberUpdate200JSONResponse) VisitTenantMemberUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberUpdate400JSONResponse APIErrors
+
+func (response TenantMemberUpdate400JSONResponse) VisitTenantMemberUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberUpdate403JSONResponse APIErrors
+
+func (response TenantMemberUpdate403JSONResponse) VisitTenantMemberUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberUpdate404JSONResponse APIErrors
+
+func (response TenantMemberUpdate404JSONResponse) VisitTenantMemberUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MessageQueueDeadLetterListRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	MessageQueue string             `json:"message-queue"`
+	Params       MessageQueueDeadLetterListParams
+}
+
+type MessageQueueDeadLetterListResponseObject interface {
+	VisitMessageQueueDeadLetterListResponse(w http.ResponseWriter) error
+}
+
+type MessageQueueDeadLetterList200JSONResponse DeadLetterList
+
+func (response MessageQueueDeadLetterList200JSONResponse) VisitMessageQueueDeadLetterListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MessageQueueDeadLetterList400JSONResponse APIErrors
+
+func (response MessageQueueDeadLetterList400JSONResponse) VisitMessageQueueDeadLetterListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MessageQueueDeadLetterList403JSONResponse APIErrors
+
+func (response MessageQueueDeadLetterList403JSONResponse) VisitMessageQueueDeadLetterListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MessageQueueDeadLetterPurgeRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	MessageQueue string             `json:"message-queue"`
+	Body         *MessageQueueDeadLetterPurgeJSONRequestBody
+}
+
+type MessageQueueDeadLetterPurgeResponseObject interface {
+	VisitMessageQueueDeadLetterPurgeResponse(w http.ResponseWriter) error
+}
+
+type MessageQueueDeadLetterPurge200JSONResponse PurgeDeadLettersResponse
+
+func (response MessageQueueDeadLetterPurge200JSONResponse) VisitMessageQueueDeadLetterPurgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MessageQueueDeadLetterPurge400JSONResponse APIErrors
+
+func (response MessageQueueDeadLetterPurge400JSONResponse) VisitMessageQueueDeadLetterPurgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MessageQueueDeadLetterPurge403JSONResponse APIErrors
+
+func (response MessageQueueDeadLetterPurge403JSONResponse) VisitMessageQueueDeadLetterPurgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MessageQueueDeadLetterReplayRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	MessageQueue string             `json:"message-queue"`
+	Body         *MessageQueueDeadLetterReplayJSONRequestBody
+}
+
+type MessageQueueDeadLetterReplayResponseObject interface {
+	VisitMessageQueueDeadLetterReplayResponse(w http.ResponseWriter) error
+}
+
+type MessageQueueDeadLetterReplay200JSONResponse ReplayDeadLettersResponse
+
+func (response MessageQueueDeadLetterReplay200JSONResponse) VisitMessageQueueDeadLetterReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MessageQueueDeadLetterReplay400JSONResponse APIErrors
+
+func (response MessageQueueDeadLetterReplay400JSONResponse) VisitMessageQueueDeadLetterReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MessageQueueDeadLetterReplay403JSONResponse APIErrors
+
+func (response MessageQueueDeadLetterReplay403JSONResponse) VisitMessageQueueDeadLetterReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type TenantGetQueueMetricsRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 	Params TenantGetQueueMetricsParams
 }
 
-type TenantGetQueueMetricsResponseObject interface {
-	VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error
+type TenantGetQueueMetricsResponseObject interface {
+	VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error
+}
+
+type TenantGetQueueMetrics200JSONResponse TenantQueueMetrics
+
+func (response TenantGetQueueMetrics200JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetQueueMetrics400JSONResponse APIErrors
+
+func (response TenantGetQueueMetrics400JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetQueueMetrics403JSONResponse APIErrors
+
+func (response TenantGetQueueMetrics403JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetQueueMetrics404JSONResponse APIErrors
+
+func (response TenantGetQueueMetrics404JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateLimitListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params RateLimitListParams
+}
+
+type RateLimitListResponseObject interface {
+	VisitRateLimitListResponse(w http.ResponseWriter) error
+}
+
+type RateLimitList200JSONResponse RateLimitList
+
+func (response RateLimitList200JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateLimitList400JSONResponse APIErrors
+
+func (response RateLimitList400JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateLimitList403JSONResponse APIErrors
+
+func (response RateLimitList403JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantResourcePolicyGetRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type TenantResourcePolicyGetResponseObject interface {
+	VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error
+}
+
+type TenantResourcePolicyGet200JSONResponse TenantResourcePolicy
+
+func (response TenantResourcePolicyGet200JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantResourcePolicyGet400JSONResponse APIErrors
+
+func (response TenantResourcePolicyGet400JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantResourcePolicyGet403JSONResponse APIError
+
+func (response TenantResourcePolicyGet403JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type TenantRoleListResponseObject interface {
+	VisitTenantRoleListResponse(w http.ResponseWriter) error
+}
+
+type TenantRoleList200JSONResponse TenantRoleList
+
+func (response TenantRoleList200JSONResponse) VisitTenantRoleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleList400JSONResponse APIErrors
+
+func (response TenantRoleList400JSONResponse) VisitTenantRoleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleList403JSONResponse APIErrors
+
+func (response TenantRoleList403JSONResponse) VisitTenantRoleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantRoleCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *TenantRoleCreateJSONRequestBody
+}
+
+type TenantRoleCreateResponseObject interface {
+	VisitTenantRoleCreateResponse(w http.ResponseWriter) error
 }
 
-type TenantGetQueueMetrics200JSONResponse TenantQueueMetrics
+type TenantRoleCreate201JSONResponse TenantRole
 
-func (response TenantGetQueueMetrics200JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response TenantRoleCreate201JSONResponse) VisitTenantRoleCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics400JSONResponse APIErrors
+type TenantRoleCreate400JSONResponse APIErrors
 
-func (response TenantGetQueueMetrics400JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response TenantRoleCreate400JSONResponse) VisitTenantRoleCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics403JSONResponse APIErrors
+type TenantRoleCreate403JSONResponse APIErrors
 
-func (response TenantGetQueueMetrics403JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response TenantRoleCreate403JSONResponse) VisitTenantRoleCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics404JSONResponse APIErrors
-
-func (response TenantGetQueueMetrics404JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type RateLimitListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params RateLimitListParams
+type TenantRoleDeleteRequestObject struct {
+	Tenant     openapi_types.UUID `json:"tenant"`
+	TenantRole openapi_types.UUID `json:"tenant-role"`
 }
 
-type RateLimitListResponseObject interface {
-	VisitRateLimitListResponse(w http.ResponseWriter) error
+type TenantRoleDeleteResponseObject interface {
+	VisitTenantRoleDeleteResponse(w http.ResponseWriter) error
 }
 
-type RateLimitList200JSONResponse RateLimitList
+type TenantRoleDelete200JSONResponse TenantRole
 
-func (response RateLimitList200JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response TenantRoleDelete200JSONResponse) VisitTenantRoleDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitList400JSONResponse APIErrors
+type TenantRoleDelete400JSONResponse APIErrors
 
-func (response RateLimitList400JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response TenantRoleDelete400JSONResponse) VisitTenantRoleDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitList403JSONResponse APIErrors
+type TenantRoleDelete403JSONResponse APIErrors
 
-func (response RateLimitList403JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response TenantRoleDelete403JSONResponse) VisitTenantRoleDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGetRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type TenantRoleDelete404JSONResponse APIErrors
+
+func (response TenantRoleDelete404JSONResponse) VisitTenantRoleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGetResponseObject interface {
-	VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error
+type TenantRoleUpdateRequestObject struct {
+	Tenant     openapi_types.UUID `json:"tenant"`
+	TenantRole openapi_types.UUID `json:"tenant-role"`
+	Body       *TenantRoleUpdateJSONRequestBody
 }
 
-type TenantResourcePolicyGet200JSONResponse TenantResourcePolicy
+type TenantRoleUpdateResponseObject interface {
+	VisitTenantRoleUpdateResponse(w http.ResponseWriter) error
+}
 
-func (response TenantResourcePolicyGet200JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+type TenantRoleUpdate200JSONResponse TenantRole
+
+func (response TenantRoleUpdate200JSONResponse) VisitTenantRoleUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGet400JSONResponse APIErrors
+type TenantRoleUpdate400JSONResponse APIErrors
 
-func (response TenantResourcePolicyGet400JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+func (response TenantRoleUpdate400JSONResponse) VisitTenantRoleUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGet403JSONResponse APIError
+type TenantRoleUpdate403JSONResponse APIErrors
 
-func (response TenantResourcePolicyGet403JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+func (response TenantRoleUpdate403JSONResponse) VisitTenantRoleUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
+type TenantRoleUpdate404JSONResponse APIErrors
+
+func (response TenantRoleUpdate404JSONResponse) VisitTenantRoleUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type SlackWebhookListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 }
@@ -7079,6 +9144,214 @@ func (response StepRunGetSchema404JSONResponse) VisitStepRunGetSchemaResponse(w
 	return json.NewEncoder(w).Encode(response)
 }
 
+type WebhookSubscriptionListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type WebhookSubscriptionListResponseObject interface {
+	VisitWebhookSubscriptionListResponse(w http.ResponseWriter) error
+}
+
+type WebhookSubscriptionList200JSONResponse WebhookSubscriptionList
+
+func (response WebhookSubscriptionList200JSONResponse) VisitWebhookSubscriptionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionList400JSONResponse APIErrors
+
+func (response WebhookSubscriptionList400JSONResponse) VisitWebhookSubscriptionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionList403JSONResponse APIErrors
+
+func (response WebhookSubscriptionList403JSONResponse) VisitWebhookSubscriptionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *WebhookSubscriptionCreateJSONRequestBody
+}
+
+type WebhookSubscriptionCreateResponseObject interface {
+	VisitWebhookSubscriptionCreateResponse(w http.ResponseWriter) error
+}
+
+type WebhookSubscriptionCreate201JSONResponse WebhookSubscription
+
+func (response WebhookSubscriptionCreate201JSONResponse) VisitWebhookSubscriptionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionCreate400JSONResponse APIErrors
+
+func (response WebhookSubscriptionCreate400JSONResponse) VisitWebhookSubscriptionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionCreate403JSONResponse APIErrors
+
+func (response WebhookSubscriptionCreate403JSONResponse) VisitWebhookSubscriptionCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionDeleteRequestObject struct {
+	Tenant              openapi_types.UUID `json:"tenant"`
+	WebhookSubscription openapi_types.UUID `json:"webhook-subscription"`
+}
+
+type WebhookSubscriptionDeleteResponseObject interface {
+	VisitWebhookSubscriptionDeleteResponse(w http.ResponseWriter) error
+}
+
+type WebhookSubscriptionDelete200JSONResponse WebhookSubscription
+
+func (response WebhookSubscriptionDelete200JSONResponse) VisitWebhookSubscriptionDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionDelete400JSONResponse APIErrors
+
+func (response WebhookSubscriptionDelete400JSONResponse) VisitWebhookSubscriptionDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionDelete403JSONResponse APIErrors
+
+func (response WebhookSubscriptionDelete403JSONResponse) VisitWebhookSubscriptionDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionDelete404JSONResponse APIErrors
+
+func (response WebhookSubscriptionDelete404JSONResponse) VisitWebhookSubscriptionDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionUpdateRequestObject struct {
+	Tenant              openapi_types.UUID `json:"tenant"`
+	WebhookSubscription openapi_types.UUID `json:"webhook-subscription"`
+	Body                *WebhookSubscriptionUpdateJSONRequestBody
+}
+
+type WebhookSubscriptionUpdateResponseObject interface {
+	VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error
+}
+
+type WebhookSubscriptionUpdate200JSONResponse WebhookSubscription
+
+func (response WebhookSubscriptionUpdate200JSONResponse) VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionUpdate400JSONResponse APIErrors
+
+func (response WebhookSubscriptionUpdate400JSONResponse) VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionUpdate403JSONResponse APIErrors
+
+func (response WebhookSubscriptionUpdate403JSONResponse) VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookSubscriptionUpdate404JSONResponse APIErrors
+
+func (response WebhookSubscriptionUpdate404JSONResponse) VisitWebhookSubscriptionUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryListRequestObject struct {
+	Tenant              openapi_types.UUID `json:"tenant"`
+	WebhookSubscription openapi_types.UUID `json:"webhook-subscription"`
+	Params              WebhookDeliveryListParams
+}
+
+type WebhookDeliveryListResponseObject interface {
+	VisitWebhookDeliveryListResponse(w http.ResponseWriter) error
+}
+
+type WebhookDeliveryList200JSONResponse WebhookDeliveryList
+
+func (response WebhookDeliveryList200JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryList400JSONResponse APIErrors
+
+func (response WebhookDeliveryList400JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryList403JSONResponse APIErrors
+
+func (response WebhookDeliveryList403JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryList404JSONResponse APIErrors
+
+func (response WebhookDeliveryList404JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type WebhookListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 }
@@ -7601,6 +9874,43 @@ func (response WorkflowCronGet404JSONResponse) VisitWorkflowCronGetResponse(w ht
 	return json.NewEncoder(w).Encode(response)
 }
 
+type WorkflowCronUpdateRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
+	Body         *WorkflowCronUpdateJSONRequestBody
+}
+
+type WorkflowCronUpdateResponseObject interface {
+	VisitWorkflowCronUpdateResponse(w http.ResponseWriter) error
+}
+
+type WorkflowCronUpdate200JSONResponse CronWorkflows
+
+func (response WorkflowCronUpdate200JSONResponse) VisitWorkflowCronUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowCronUpdate400JSONResponse APIErrors
+
+func (response WorkflowCronUpdate400JSONResponse) VisitWorkflowCronUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkflowCronUpdate403JSONResponse APIError
+
+func (response WorkflowCronUpdate403JSONResponse) VisitWorkflowCronUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type WorkflowRunListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 	Params WorkflowRunListParams
@@ -8264,25 +10574,67 @@ func (response TenantMembershipsList200JSONResponse) VisitTenantMembershipsListR
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMembershipsList400JSONResponse APIErrors
+
+func (response TenantMembershipsList400JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMembershipsList403JSONResponse APIErrors
+
+func (response TenantMembershipsList403JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateOidcOauthCallbackRequestObject struct {
+}
+
+type UserUpdateOidcOauthCallbackResponseObject interface {
+	VisitUserUpdateOidcOauthCallbackResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateOidcOauthCallback302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateOidcOauthCallback302Response struct {
+	Headers UserUpdateOidcOauthCallback302ResponseHeaders
 }
 
-type TenantMembershipsList400JSONResponse APIErrors
+func (response UserUpdateOidcOauthCallback302Response) VisitUserUpdateOidcOauthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
 
-func (response TenantMembershipsList400JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type UserUpdateOidcOauthStartRequestObject struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+type UserUpdateOidcOauthStartResponseObject interface {
+	VisitUserUpdateOidcOauthStartResponse(w http.ResponseWriter) error
 }
 
-type TenantMembershipsList403JSONResponse APIErrors
+type UserUpdateOidcOauthStart302ResponseHeaders struct {
+	Location string
+}
 
-func (response TenantMembershipsList403JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+type UserUpdateOidcOauthStart302Response struct {
+	Headers UserUpdateOidcOauthStart302ResponseHeaders
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response UserUpdateOidcOauthStart302Response) VisitUserUpdateOidcOauthStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
 }
 
 type UserUpdatePasswordRequestObject struct {
@@ -8917,6 +11269,50 @@ func (response V2TaskGet404JSONResponse) VisitV2TaskGetResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response)
 }
 
+type V2TaskGetQueueDiagnosisRequestObject struct {
+	Task openapi_types.UUID `json:"task"`
+}
+
+type V2TaskGetQueueDiagnosisResponseObject interface {
+	VisitV2TaskGetQueueDiagnosisResponse(w http.ResponseWriter) error
+}
+
+type V2TaskGetQueueDiagnosis200JSONResponse V2TaskQueueDiagnosis
+
+func (response V2TaskGetQueueDiagnosis200JSONResponse) VisitV2TaskGetQueueDiagnosisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2TaskGetQueueDiagnosis400JSONResponse APIErrors
+
+func (response V2TaskGetQueueDiagnosis400JSONResponse) VisitV2TaskGetQueueDiagnosisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2TaskGetQueueDiagnosis403JSONResponse APIErrors
+
+func (response V2TaskGetQueueDiagnosis403JSONResponse) VisitV2TaskGetQueueDiagnosisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2TaskGetQueueDiagnosis404JSONResponse APIErrors
+
+func (response V2TaskGetQueueDiagnosis404JSONResponse) VisitV2TaskGetQueueDiagnosisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type V2TaskEventListRequestObject struct {
 	Task   openapi_types.UUID `json:"task"`
 	Params V2TaskEventListParams
@@ -8962,6 +11358,78 @@ func (response V2TaskEventList404JSONResponse) VisitV2TaskEventListResponse(w ht
 	return json.NewEncoder(w).Encode(response)
 }
 
+type V2ConcurrencyListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V2ConcurrencyListParams
+}
+
+type V2ConcurrencyListResponseObject interface {
+	VisitV2ConcurrencyListResponse(w http.ResponseWriter) error
+}
+
+type V2ConcurrencyList200JSONResponse V2ConcurrencyStrategyUsageList
+
+func (response V2ConcurrencyList200JSONResponse) VisitV2ConcurrencyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2ConcurrencyList400JSONResponse APIErrors
+
+func (response V2ConcurrencyList400JSONResponse) VisitV2ConcurrencyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2ConcurrencyList403JSONResponse APIErrors
+
+func (response V2ConcurrencyList403JSONResponse) VisitV2ConcurrencyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2RateLimitListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V2RateLimitListParams
+}
+
+type V2RateLimitListResponseObject interface {
+	VisitV2RateLimitListResponse(w http.ResponseWriter) error
+}
+
+type V2RateLimitList200JSONResponse V2RateLimitUsageList
+
+func (response V2RateLimitList200JSONResponse) VisitV2RateLimitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2RateLimitList400JSONResponse APIErrors
+
+func (response V2RateLimitList400JSONResponse) VisitV2RateLimitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2RateLimitList403JSONResponse APIErrors
+
+func (response V2RateLimitList403JSONResponse) VisitV2RateLimitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type V2TaskListStatusMetricsRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 	Params V2TaskListStatusMetricsParams
@@ -9070,6 +11538,78 @@ func (response V2TaskList403JSONResponse) VisitV2TaskListResponse(w http.Respons
 	return json.NewEncoder(w).Encode(response)
 }
 
+type V2TaskCancelRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V2TaskCancelJSONRequestBody
+}
+
+type V2TaskCancelResponseObject interface {
+	VisitV2TaskCancelResponse(w http.ResponseWriter) error
+}
+
+type V2TaskCancel200JSONResponse V2CancelTasksResponse
+
+func (response V2TaskCancel200JSONResponse) VisitV2TaskCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2TaskCancel400JSONResponse APIErrors
+
+func (response V2TaskCancel400JSONResponse) VisitV2TaskCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2TaskCancel403JSONResponse APIErrors
+
+func (response V2TaskCancel403JSONResponse) VisitV2TaskCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2TaskReplayRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V2TaskReplayJSONRequestBody
+}
+
+type V2TaskReplayResponseObject interface {
+	VisitV2TaskReplayResponse(w http.ResponseWriter) error
+}
+
+type V2TaskReplay200JSONResponse V2ReplayTasksResponse
+
+func (response V2TaskReplay200JSONResponse) VisitV2TaskReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2TaskReplay400JSONResponse APIErrors
+
+func (response V2TaskReplay400JSONResponse) VisitV2TaskReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2TaskReplay403JSONResponse APIErrors
+
+func (response V2TaskReplay403JSONResponse) VisitV2TaskReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type V2WorkflowRunListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 	Params V2WorkflowRunListParams
@@ -9106,6 +11646,78 @@ func (response V2WorkflowRunList403JSONResponse) VisitV2WorkflowRunListResponse(
 	return json.NewEncoder(w).Encode(response)
 }
 
+type V2WorkflowRunCancelRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V2WorkflowRunCancelJSONRequestBody
+}
+
+type V2WorkflowRunCancelResponseObject interface {
+	VisitV2WorkflowRunCancelResponse(w http.ResponseWriter) error
+}
+
+type V2WorkflowRunCancel200JSONResponse V2CancelWorkflowRunsResponse
+
+func (response V2WorkflowRunCancel200JSONResponse) VisitV2WorkflowRunCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2WorkflowRunCancel400JSONResponse APIErrors
+
+func (response V2WorkflowRunCancel400JSONResponse) VisitV2WorkflowRunCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2WorkflowRunCancel403JSONResponse APIErrors
+
+func (response V2WorkflowRunCancel403JSONResponse) VisitV2WorkflowRunCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2WorkflowRunReplayRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V2WorkflowRunReplayJSONRequestBody
+}
+
+type V2WorkflowRunReplayResponseObject interface {
+	VisitV2WorkflowRunReplayResponse(w http.ResponseWriter) error
+}
+
+type V2WorkflowRunReplay200JSONResponse V2ReplayWorkflowRunsResponse
+
+func (response V2WorkflowRunReplay200JSONResponse) VisitV2WorkflowRunReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2WorkflowRunReplay400JSONResponse APIErrors
+
+func (response V2WorkflowRunReplay400JSONResponse) VisitV2WorkflowRunReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2WorkflowRunReplay403JSONResponse APIErrors
+
+func (response V2WorkflowRunReplay403JSONResponse) VisitV2WorkflowRunReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type V2WorkflowRunGetRequestObject struct {
 	V2WorkflowRun openapi_types.UUID `json:"v2-workflow-run"`
 }
@@ -9141,6 +11753,51 @@ func (response V2WorkflowRunGet403JSONResponse) VisitV2WorkflowRunGetResponse(w
 	return json.NewEncoder(w).Encode(response)
 }
 
+type V2WorkflowRunReplayFromTaskRequestObject struct {
+	V2WorkflowRun openapi_types.UUID `json:"v2-workflow-run"`
+	Body          *V2WorkflowRunReplayFromTaskJSONRequestBody
+}
+
+type V2WorkflowRunReplayFromTaskResponseObject interface {
+	VisitV2WorkflowRunReplayFromTaskResponse(w http.ResponseWriter) error
+}
+
+type V2WorkflowRunReplayFromTask200JSONResponse V2ReplayWorkflowRunFromTaskResponse
+
+func (response V2WorkflowRunReplayFromTask200JSONResponse) VisitV2WorkflowRunReplayFromTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2WorkflowRunReplayFromTask400JSONResponse APIErrors
+
+func (response V2WorkflowRunReplayFromTask400JSONResponse) VisitV2WorkflowRunReplayFromTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2WorkflowRunReplayFromTask403JSONResponse APIErrors
+
+func (response V2WorkflowRunReplayFromTask403JSONResponse) VisitV2WorkflowRunReplayFromTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V2WorkflowRunReplayFromTask404JSONResponse APIErrors
+
+func (response V2WorkflowRunReplayFromTask404JSONResponse) VisitV2WorkflowRunReplayFromTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type V2WorkflowRunTaskEventsListRequestObject struct {
 	V2WorkflowRun openapi_types.UUID `json:"v2-workflow-run"`
 	Params        V2WorkflowRunTaskEventsListParams
@@ -9226,6 +11883,8 @@ type StrictServerInterface interface {
 
 	ApiTokenCreate(ctx echo.Context, request ApiTokenCreateRequestObject) (ApiTokenCreateResponseObject, error)
 
+	AuditLogList(ctx echo.Context, request AuditLogListRequestObject) (AuditLogListResponseObject, error)
+
 	EventList(ctx echo.Context, request EventListRequestObject) (EventListResponseObject, error)
 
 	EventCreate(ctx echo.Context, request EventCreateRequestObject) (EventCreateResponseObject, error)
@@ -9250,11 +11909,27 @@ type StrictServerInterface interface {
 
 	TenantMemberDelete(ctx echo.Context, request TenantMemberDeleteRequestObject) (TenantMemberDeleteResponseObject, error)
 
+	TenantMemberUpdate(ctx echo.Context, request TenantMemberUpdateRequestObject) (TenantMemberUpdateResponseObject, error)
+
+	MessageQueueDeadLetterList(ctx echo.Context, request MessageQueueDeadLetterListRequestObject) (MessageQueueDeadLetterListResponseObject, error)
+
+	MessageQueueDeadLetterPurge(ctx echo.Context, request MessageQueueDeadLetterPurgeRequestObject) (MessageQueueDeadLetterPurgeResponseObject, error)
+
+	MessageQueueDeadLetterReplay(ctx echo.Context, request MessageQueueDeadLetterReplayRequestObject) (MessageQueueDeadLetterReplayResponseObject, error)
+
 	TenantGetQueueMetrics(ctx echo.Context, request TenantGetQueueMetricsRequestObject) (TenantGetQueueMetricsResponseObject, error)
 
-	RateLimitList(ctx echo.Context, request RateLimitListRequestObject) (RateLimitListResponseObject, error)
+	RateLimitList(ctx echo.Context, request RateLimitListRequestObject) (RateLimitListResponseObject, error)
+
+	TenantResourcePolicyGet(ctx echo.Context, request TenantResourcePolicyGetRequestObject) (TenantResourcePolicyGetResponseObject, error)
+
+	TenantRoleList(ctx echo.Context, request TenantRoleListRequestObject) (TenantRoleListResponseObject, error)
+
+	TenantRoleCreate(ctx echo.Context, request TenantRoleCreateRequestObject) (TenantRoleCreateResponseObject, error)
+
+	TenantRoleDelete(ctx echo.Context, request TenantRoleDeleteRequestObject) (TenantRoleDeleteResponseObject, error)
 
-	TenantResourcePolicyGet(ctx echo.Context, request TenantResourcePolicyGetRequestObject) (TenantResourcePolicyGetResponseObject, error)
+	TenantRoleUpdate(ctx echo.Context, request TenantRoleUpdateRequestObject) (TenantRoleUpdateResponseObject, error)
 
 	SlackWebhookList(ctx echo.Context, request SlackWebhookListRequestObject) (SlackWebhookListResponseObject, error)
 
@@ -9274,6 +11949,16 @@ type StrictServerInterface interface {
 
 	StepRunGetSchema(ctx echo.Context, request StepRunGetSchemaRequestObject) (StepRunGetSchemaResponseObject, error)
 
+	WebhookSubscriptionList(ctx echo.Context, request WebhookSubscriptionListRequestObject) (WebhookSubscriptionListResponseObject, error)
+
+	WebhookSubscriptionCreate(ctx echo.Context, request WebhookSubscriptionCreateRequestObject) (WebhookSubscriptionCreateResponseObject, error)
+
+	WebhookSubscriptionDelete(ctx echo.Context, request WebhookSubscriptionDeleteRequestObject) (WebhookSubscriptionDeleteResponseObject, error)
+
+	WebhookSubscriptionUpdate(ctx echo.Context, request WebhookSubscriptionUpdateRequestObject) (WebhookSubscriptionUpdateResponseObject, error)
+
+	WebhookDeliveryList(ctx echo.Context, request WebhookDeliveryListRequestObject) (WebhookDeliveryListResponseObject, error)
+
 	WebhookList(ctx echo.Context, request WebhookListRequestObject) (WebhookListResponseObject, error)
 
 	WebhookCreate(ctx echo.Context, request WebhookCreateRequestObject) (WebhookCreateResponseObject, error)
@@ -9300,6 +11985,8 @@ type StrictServerInterface interface {
 
 	WorkflowCronGet(ctx echo.Context, request WorkflowCronGetRequestObject) (WorkflowCronGetResponseObject, error)
 
+	WorkflowCronUpdate(ctx echo.Context, request WorkflowCronUpdateRequestObject) (WorkflowCronUpdateResponseObject, error)
+
 	WorkflowRunList(ctx echo.Context, request WorkflowRunListRequestObject) (WorkflowRunListResponseObject, error)
 
 	WorkflowRunGetMetrics(ctx echo.Context, request WorkflowRunGetMetricsRequestObject) (WorkflowRunGetMetricsResponseObject, error)
@@ -9338,6 +12025,10 @@ type StrictServerInterface interface {
 
 	TenantMembershipsList(ctx echo.Context, request TenantMembershipsListRequestObject) (TenantMembershipsListResponseObject, error)
 
+	UserUpdateOidcOauthCallback(ctx echo.Context, request UserUpdateOidcOauthCallbackRequestObject) (UserUpdateOidcOauthCallbackResponseObject, error)
+
+	UserUpdateOidcOauthStart(ctx echo.Context, request UserUpdateOidcOauthStartRequestObject) (UserUpdateOidcOauthStartResponseObject, error)
+
 	UserUpdatePassword(ctx echo.Context, request UserUpdatePasswordRequestObject) (UserUpdatePasswordResponseObject, error)
 
 	UserCreate(ctx echo.Context, request UserCreateRequestObject) (UserCreateResponseObject, error)
@@ -9370,18 +12061,34 @@ type StrictServerInterface interface {
 
 	V2TaskGet(ctx echo.Context, request V2TaskGetRequestObject) (V2TaskGetResponseObject, error)
 
+	V2TaskGetQueueDiagnosis(ctx echo.Context, request V2TaskGetQueueDiagnosisRequestObject) (V2TaskGetQueueDiagnosisResponseObject, error)
+
 	V2TaskEventList(ctx echo.Context, request V2TaskEventListRequestObject) (V2TaskEventListResponseObject, error)
 
+	V2ConcurrencyList(ctx echo.Context, request V2ConcurrencyListRequestObject) (V2ConcurrencyListResponseObject, error)
+
+	V2RateLimitList(ctx echo.Context, request V2RateLimitListRequestObject) (V2RateLimitListResponseObject, error)
+
 	V2TaskListStatusMetrics(ctx echo.Context, request V2TaskListStatusMetricsRequestObject) (V2TaskListStatusMetricsResponseObject, error)
 
 	V2TaskGetPointMetrics(ctx echo.Context, request V2TaskGetPointMetricsRequestObject) (V2TaskGetPointMetricsResponseObject, error)
 
 	V2TaskList(ctx echo.Context, request V2TaskListRequestObject) (V2TaskListResponseObject, error)
 
+	V2TaskCancel(ctx echo.Context, request V2TaskCancelRequestObject) (V2TaskCancelResponseObject, error)
+
+	V2TaskReplay(ctx echo.Context, request V2TaskReplayRequestObject) (V2TaskReplayResponseObject, error)
+
 	V2WorkflowRunList(ctx echo.Context, request V2WorkflowRunListRequestObject) (V2WorkflowRunListResponseObject, error)
 
+	V2WorkflowRunCancel(ctx echo.Context, request V2WorkflowRunCancelRequestObject) (V2WorkflowRunCancelResponseObject, error)
+
+	V2WorkflowRunReplay(ctx echo.Context, request V2WorkflowRunReplayRequestObject) (V2WorkflowRunReplayResponseObject, error)
+
 	V2WorkflowRunGet(ctx echo.Context, request V2WorkflowRunGetRequestObject) (V2WorkflowRunGetResponseObject, error)
 
+	V2WorkflowRunReplayFromTask(ctx echo.Context, request V2WorkflowRunReplayFromTaskRequestObject) (V2WorkflowRunReplayFromTaskResponseObject, error)
+
 	V2WorkflowRunTaskEventsList(ctx echo.Context, request V2WorkflowRunTaskEventsListRequestObject) (V2WorkflowRunTaskEventsListResponseObject, error)
 }
 type StrictHandlerFunc func(ctx echo.Context, args interface{}) (interface{}, error)
@@ -10018,6 +12725,32 @@ func (sh *strictHandler) ApiTokenCreate(ctx echo.Context, tenant openapi_types.U
 	return nil
 }
 
+// AuditLogList operation middleware
+func (sh *strictHandler) AuditLogList(ctx echo.Context, tenant openapi_types.UUID, params AuditLogListParams) error {
+	var request AuditLogListRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AuditLogList(ctx, request.(AuditLogListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuditLogList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AuditLogListResponseObject); ok {
+		return validResponse.VisitAuditLogListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // EventList operation middleware
 func (sh *strictHandler) EventList(ctx echo.Context, tenant openapi_types.UUID, params EventListParams) error {
 	var request EventListRequestObject
@@ -10162,273 +12895,510 @@ func (sh *strictHandler) EventKeyList(ctx echo.Context, tenant openapi_types.UUI
 	return nil
 }
 
-// EventUpdateReplay operation middleware
-func (sh *strictHandler) EventUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request EventUpdateReplayRequestObject
+// EventUpdateReplay operation middleware
+func (sh *strictHandler) EventUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventUpdateReplayRequestObject
+
+	request.Tenant = tenant
+
+	var body EventUpdateReplayJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventUpdateReplay(ctx, request.(EventUpdateReplayRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventUpdateReplay")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventUpdateReplayResponseObject); ok {
+		return validResponse.VisitEventUpdateReplayResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantInviteList operation middleware
+func (sh *strictHandler) TenantInviteList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantInviteListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantInviteList(ctx, request.(TenantInviteListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantInviteList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantInviteListResponseObject); ok {
+		return validResponse.VisitTenantInviteListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantInviteCreate operation middleware
+func (sh *strictHandler) TenantInviteCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantInviteCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body TenantInviteCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantInviteCreate(ctx, request.(TenantInviteCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantInviteCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantInviteCreateResponseObject); ok {
+		return validResponse.VisitTenantInviteCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantInviteDelete operation middleware
+func (sh *strictHandler) TenantInviteDelete(ctx echo.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) error {
+	var request TenantInviteDeleteRequestObject
+
+	request.Tenant = tenant
+	request.TenantInvite = tenantInvite
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantInviteDelete(ctx, request.(TenantInviteDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantInviteDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantInviteDeleteResponseObject); ok {
+		return validResponse.VisitTenantInviteDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantInviteUpdate operation middleware
+func (sh *strictHandler) TenantInviteUpdate(ctx echo.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) error {
+	var request TenantInviteUpdateRequestObject
+
+	request.Tenant = tenant
+	request.TenantInvite = tenantInvite
+
+	var body TenantInviteUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantInviteUpdate(ctx, request.(TenantInviteUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantInviteUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantInviteUpdateResponseObject); ok {
+		return validResponse.VisitTenantInviteUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantMemberList operation middleware
+func (sh *strictHandler) TenantMemberList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantMemberListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantMemberList(ctx, request.(TenantMemberListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantMemberList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantMemberListResponseObject); ok {
+		return validResponse.VisitTenantMemberListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantMemberDelete operation middleware
+func (sh *strictHandler) TenantMemberDelete(ctx echo.Context, tenant openapi_types.UUID, member openapi_types.UUID) error {
+	var request TenantMemberDeleteRequestObject
+
+	request.Tenant = tenant
+	request.Member = member
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantMemberDelete(ctx, request.(TenantMemberDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantMemberDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantMemberDeleteResponseObject); ok {
+		return validResponse.VisitTenantMemberDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantMemberUpdate operation middleware
+func (sh *strictHandler) TenantMemberUpdate(ctx echo.Context, tenant openapi_types.UUID, member openapi_types.UUID) error {
+	var request TenantMemberUpdateRequestObject
+
+	request.Tenant = tenant
+	request.Member = member
+
+	var body TenantMemberUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantMemberUpdate(ctx, request.(TenantMemberUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantMemberUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantMemberUpdateResponseObject); ok {
+		return validResponse.VisitTenantMemberUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MessageQueueDeadLetterList operation middleware
+func (sh *strictHandler) MessageQueueDeadLetterList(ctx echo.Context, tenant openapi_types.UUID, messageQueue string, params MessageQueueDeadLetterListParams) error {
+	var request MessageQueueDeadLetterListRequestObject
 
 	request.Tenant = tenant
-
-	var body EventUpdateReplayJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.MessageQueue = messageQueue
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.EventUpdateReplay(ctx, request.(EventUpdateReplayRequestObject))
+		return sh.ssi.MessageQueueDeadLetterList(ctx, request.(MessageQueueDeadLetterListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "EventUpdateReplay")
+		handler = middleware(handler, "MessageQueueDeadLetterList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(EventUpdateReplayResponseObject); ok {
-		return validResponse.VisitEventUpdateReplayResponse(ctx.Response())
+	} else if validResponse, ok := response.(MessageQueueDeadLetterListResponseObject); ok {
+		return validResponse.VisitMessageQueueDeadLetterListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantInviteList operation middleware
-func (sh *strictHandler) TenantInviteList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request TenantInviteListRequestObject
+// MessageQueueDeadLetterPurge operation middleware
+func (sh *strictHandler) MessageQueueDeadLetterPurge(ctx echo.Context, tenant openapi_types.UUID, messageQueue string) error {
+	var request MessageQueueDeadLetterPurgeRequestObject
 
 	request.Tenant = tenant
+	request.MessageQueue = messageQueue
+
+	var body MessageQueueDeadLetterPurgeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantInviteList(ctx, request.(TenantInviteListRequestObject))
+		return sh.ssi.MessageQueueDeadLetterPurge(ctx, request.(MessageQueueDeadLetterPurgeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantInviteList")
+		handler = middleware(handler, "MessageQueueDeadLetterPurge")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantInviteListResponseObject); ok {
-		return validResponse.VisitTenantInviteListResponse(ctx.Response())
+	} else if validResponse, ok := response.(MessageQueueDeadLetterPurgeResponseObject); ok {
+		return validResponse.VisitMessageQueueDeadLetterPurgeResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantInviteCreate operation middleware
-func (sh *strictHandler) TenantInviteCreate(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request TenantInviteCreateRequestObject
+// MessageQueueDeadLetterReplay operation middleware
+func (sh *strictHandler) MessageQueueDeadLetterReplay(ctx echo.Context, tenant openapi_types.UUID, messageQueue string) error {
+	var request MessageQueueDeadLetterReplayRequestObject
 
 	request.Tenant = tenant
+	request.MessageQueue = messageQueue
 
-	var body TenantInviteCreateJSONRequestBody
+	var body MessageQueueDeadLetterReplayJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantInviteCreate(ctx, request.(TenantInviteCreateRequestObject))
+		return sh.ssi.MessageQueueDeadLetterReplay(ctx, request.(MessageQueueDeadLetterReplayRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantInviteCreate")
+		handler = middleware(handler, "MessageQueueDeadLetterReplay")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantInviteCreateResponseObject); ok {
-		return validResponse.VisitTenantInviteCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(MessageQueueDeadLetterReplayResponseObject); ok {
+		return validResponse.VisitMessageQueueDeadLetterReplayResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantInviteDelete operation middleware
-func (sh *strictHandler) TenantInviteDelete(ctx echo.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) error {
-	var request TenantInviteDeleteRequestObject
+// TenantGetQueueMetrics operation middleware
+func (sh *strictHandler) TenantGetQueueMetrics(ctx echo.Context, tenant openapi_types.UUID, params TenantGetQueueMetricsParams) error {
+	var request TenantGetQueueMetricsRequestObject
 
 	request.Tenant = tenant
-	request.TenantInvite = tenantInvite
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantInviteDelete(ctx, request.(TenantInviteDeleteRequestObject))
+		return sh.ssi.TenantGetQueueMetrics(ctx, request.(TenantGetQueueMetricsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantInviteDelete")
+		handler = middleware(handler, "TenantGetQueueMetrics")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantInviteDeleteResponseObject); ok {
-		return validResponse.VisitTenantInviteDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(TenantGetQueueMetricsResponseObject); ok {
+		return validResponse.VisitTenantGetQueueMetricsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantInviteUpdate operation middleware
-func (sh *strictHandler) TenantInviteUpdate(ctx echo.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) error {
-	var request TenantInviteUpdateRequestObject
+// RateLimitList operation middleware
+func (sh *strictHandler) RateLimitList(ctx echo.Context, tenant openapi_types.UUID, params RateLimitListParams) error {
+	var request RateLimitListRequestObject
 
 	request.Tenant = tenant
-	request.TenantInvite = tenantInvite
-
-	var body TenantInviteUpdateJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantInviteUpdate(ctx, request.(TenantInviteUpdateRequestObject))
+		return sh.ssi.RateLimitList(ctx, request.(RateLimitListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantInviteUpdate")
+		handler = middleware(handler, "RateLimitList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantInviteUpdateResponseObject); ok {
-		return validResponse.VisitTenantInviteUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(RateLimitListResponseObject); ok {
+		return validResponse.VisitRateLimitListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantMemberList operation middleware
-func (sh *strictHandler) TenantMemberList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request TenantMemberListRequestObject
+// TenantResourcePolicyGet operation middleware
+func (sh *strictHandler) TenantResourcePolicyGet(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantResourcePolicyGetRequestObject
 
 	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantMemberList(ctx, request.(TenantMemberListRequestObject))
+		return sh.ssi.TenantResourcePolicyGet(ctx, request.(TenantResourcePolicyGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantMemberList")
+		handler = middleware(handler, "TenantResourcePolicyGet")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantMemberListResponseObject); ok {
-		return validResponse.VisitTenantMemberListResponse(ctx.Response())
+	} else if validResponse, ok := response.(TenantResourcePolicyGetResponseObject); ok {
+		return validResponse.VisitTenantResourcePolicyGetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantMemberDelete operation middleware
-func (sh *strictHandler) TenantMemberDelete(ctx echo.Context, tenant openapi_types.UUID, member openapi_types.UUID) error {
-	var request TenantMemberDeleteRequestObject
+// TenantRoleList operation middleware
+func (sh *strictHandler) TenantRoleList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantRoleListRequestObject
 
 	request.Tenant = tenant
-	request.Member = member
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantMemberDelete(ctx, request.(TenantMemberDeleteRequestObject))
+		return sh.ssi.TenantRoleList(ctx, request.(TenantRoleListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantMemberDelete")
+		handler = middleware(handler, "TenantRoleList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantMemberDeleteResponseObject); ok {
-		return validResponse.VisitTenantMemberDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(TenantRoleListResponseObject); ok {
+		return validResponse.VisitTenantRoleListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantGetQueueMetrics operation middleware
-func (sh *strictHandler) TenantGetQueueMetrics(ctx echo.Context, tenant openapi_types.UUID, params TenantGetQueueMetricsParams) error {
-	var request TenantGetQueueMetricsRequestObject
+// TenantRoleCreate operation middleware
+func (sh *strictHandler) TenantRoleCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantRoleCreateRequestObject
 
 	request.Tenant = tenant
-	request.Params = params
+
+	var body TenantRoleCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantGetQueueMetrics(ctx, request.(TenantGetQueueMetricsRequestObject))
+		return sh.ssi.TenantRoleCreate(ctx, request.(TenantRoleCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantGetQueueMetrics")
+		handler = middleware(handler, "TenantRoleCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantGetQueueMetricsResponseObject); ok {
-		return validResponse.VisitTenantGetQueueMetricsResponse(ctx.Response())
+	} else if validResponse, ok := response.(TenantRoleCreateResponseObject); ok {
+		return validResponse.VisitTenantRoleCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// RateLimitList operation middleware
-func (sh *strictHandler) RateLimitList(ctx echo.Context, tenant openapi_types.UUID, params RateLimitListParams) error {
-	var request RateLimitListRequestObject
+// TenantRoleDelete operation middleware
+func (sh *strictHandler) TenantRoleDelete(ctx echo.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID) error {
+	var request TenantRoleDeleteRequestObject
 
 	request.Tenant = tenant
-	request.Params = params
+	request.TenantRole = tenantRole
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RateLimitList(ctx, request.(RateLimitListRequestObject))
+		return sh.ssi.TenantRoleDelete(ctx, request.(TenantRoleDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RateLimitList")
+		handler = middleware(handler, "TenantRoleDelete")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(RateLimitListResponseObject); ok {
-		return validResponse.VisitRateLimitListResponse(ctx.Response())
+	} else if validResponse, ok := response.(TenantRoleDeleteResponseObject); ok {
+		return validResponse.VisitTenantRoleDeleteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantResourcePolicyGet operation middleware
-func (sh *strictHandler) TenantResourcePolicyGet(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request TenantResourcePolicyGetRequestObject
+// TenantRoleUpdate operation middleware
+func (sh *strictHandler) TenantRoleUpdate(ctx echo.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID) error {
+	var request TenantRoleUpdateRequestObject
 
 	request.Tenant = tenant
+	request.TenantRole = tenantRole
+
+	var body TenantRoleUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantResourcePolicyGet(ctx, request.(TenantResourcePolicyGetRequestObject))
+		return sh.ssi.TenantRoleUpdate(ctx, request.(TenantRoleUpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantResourcePolicyGet")
+		handler = middleware(handler, "TenantRoleUpdate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantResourcePolicyGetResponseObject); ok {
-		return validResponse.VisitTenantResourcePolicyGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(TenantRoleUpdateResponseObject); ok {
+		return validResponse.VisitTenantRoleUpdateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -10577,99 +13547,240 @@ func (sh *strictHandler) StepRunGet(ctx echo.Context, tenant openapi_types.UUID,
 		return sh.ssi.StepRunGet(ctx, request.(StepRunGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunGet")
+		handler = middleware(handler, "StepRunGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunGetResponseObject); ok {
+		return validResponse.VisitStepRunGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StepRunUpdateCancel operation middleware
+func (sh *strictHandler) StepRunUpdateCancel(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
+	var request StepRunUpdateCancelRequestObject
+
+	request.Tenant = tenant
+	request.StepRun = stepRun
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunUpdateCancel(ctx, request.(StepRunUpdateCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunUpdateCancel")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunUpdateCancelResponseObject); ok {
+		return validResponse.VisitStepRunUpdateCancelResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StepRunUpdateRerun operation middleware
+func (sh *strictHandler) StepRunUpdateRerun(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
+	var request StepRunUpdateRerunRequestObject
+
+	request.Tenant = tenant
+	request.StepRun = stepRun
+
+	var body StepRunUpdateRerunJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunUpdateRerun(ctx, request.(StepRunUpdateRerunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunUpdateRerun")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunUpdateRerunResponseObject); ok {
+		return validResponse.VisitStepRunUpdateRerunResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StepRunGetSchema operation middleware
+func (sh *strictHandler) StepRunGetSchema(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
+	var request StepRunGetSchemaRequestObject
+
+	request.Tenant = tenant
+	request.StepRun = stepRun
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunGetSchema(ctx, request.(StepRunGetSchemaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunGetSchema")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunGetSchemaResponseObject); ok {
+		return validResponse.VisitStepRunGetSchemaResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WebhookSubscriptionList operation middleware
+func (sh *strictHandler) WebhookSubscriptionList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request WebhookSubscriptionListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhookSubscriptionList(ctx, request.(WebhookSubscriptionListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhookSubscriptionList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WebhookSubscriptionListResponseObject); ok {
+		return validResponse.VisitWebhookSubscriptionListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WebhookSubscriptionCreate operation middleware
+func (sh *strictHandler) WebhookSubscriptionCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request WebhookSubscriptionCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body WebhookSubscriptionCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhookSubscriptionCreate(ctx, request.(WebhookSubscriptionCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhookSubscriptionCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunGetResponseObject); ok {
-		return validResponse.VisitStepRunGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(WebhookSubscriptionCreateResponseObject); ok {
+		return validResponse.VisitWebhookSubscriptionCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// StepRunUpdateCancel operation middleware
-func (sh *strictHandler) StepRunUpdateCancel(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
-	var request StepRunUpdateCancelRequestObject
+// WebhookSubscriptionDelete operation middleware
+func (sh *strictHandler) WebhookSubscriptionDelete(ctx echo.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID) error {
+	var request WebhookSubscriptionDeleteRequestObject
 
 	request.Tenant = tenant
-	request.StepRun = stepRun
+	request.WebhookSubscription = webhookSubscription
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.StepRunUpdateCancel(ctx, request.(StepRunUpdateCancelRequestObject))
+		return sh.ssi.WebhookSubscriptionDelete(ctx, request.(WebhookSubscriptionDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunUpdateCancel")
+		handler = middleware(handler, "WebhookSubscriptionDelete")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunUpdateCancelResponseObject); ok {
-		return validResponse.VisitStepRunUpdateCancelResponse(ctx.Response())
+	} else if validResponse, ok := response.(WebhookSubscriptionDeleteResponseObject); ok {
+		return validResponse.VisitWebhookSubscriptionDeleteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// StepRunUpdateRerun operation middleware
-func (sh *strictHandler) StepRunUpdateRerun(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
-	var request StepRunUpdateRerunRequestObject
+// WebhookSubscriptionUpdate operation middleware
+func (sh *strictHandler) WebhookSubscriptionUpdate(ctx echo.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID) error {
+	var request WebhookSubscriptionUpdateRequestObject
 
 	request.Tenant = tenant
-	request.StepRun = stepRun
+	request.WebhookSubscription = webhookSubscription
 
-	var body StepRunUpdateRerunJSONRequestBody
+	var body WebhookSubscriptionUpdateJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.StepRunUpdateRerun(ctx, request.(StepRunUpdateRerunRequestObject))
+		return sh.ssi.WebhookSubscriptionUpdate(ctx, request.(WebhookSubscriptionUpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunUpdateRerun")
+		handler = middleware(handler, "WebhookSubscriptionUpdate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunUpdateRerunResponseObject); ok {
-		return validResponse.VisitStepRunUpdateRerunResponse(ctx.Response())
+	} else if validResponse, ok := response.(WebhookSubscriptionUpdateResponseObject); ok {
+		return validResponse.VisitWebhookSubscriptionUpdateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// StepRunGetSchema operation middleware
-func (sh *strictHandler) StepRunGetSchema(ctx echo.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID) error {
-	var request StepRunGetSchemaRequestObject
+// WebhookDeliveryList operation middleware
+func (sh *strictHandler) WebhookDeliveryList(ctx echo.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, params WebhookDeliveryListParams) error {
+	var request WebhookDeliveryListRequestObject
 
 	request.Tenant = tenant
-	request.StepRun = stepRun
+	request.WebhookSubscription = webhookSubscription
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.StepRunGetSchema(ctx, request.(StepRunGetSchemaRequestObject))
+		return sh.ssi.WebhookDeliveryList(ctx, request.(WebhookDeliveryListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunGetSchema")
+		handler = middleware(handler, "WebhookDeliveryList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunGetSchemaResponseObject); ok {
-		return validResponse.VisitStepRunGetSchemaResponse(ctx.Response())
+	} else if validResponse, ok := response.(WebhookDeliveryListResponseObject); ok {
+		return validResponse.VisitWebhookDeliveryListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -11028,6 +14139,38 @@ func (sh *strictHandler) WorkflowCronGet(ctx echo.Context, tenant openapi_types.
 	return nil
 }
 
+// WorkflowCronUpdate operation middleware
+func (sh *strictHandler) WorkflowCronUpdate(ctx echo.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) error {
+	var request WorkflowCronUpdateRequestObject
+
+	request.Tenant = tenant
+	request.CronWorkflow = cronWorkflow
+
+	var body WorkflowCronUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowCronUpdate(ctx, request.(WorkflowCronUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowCronUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowCronUpdateResponseObject); ok {
+		return validResponse.VisitWorkflowCronUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // WorkflowRunList operation middleware
 func (sh *strictHandler) WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params WorkflowRunListParams) error {
 	var request WorkflowRunListRequestObject
@@ -11519,6 +14662,52 @@ func (sh *strictHandler) TenantMembershipsList(ctx echo.Context) error {
 	return nil
 }
 
+// UserUpdateOidcOauthCallback operation middleware
+func (sh *strictHandler) UserUpdateOidcOauthCallback(ctx echo.Context) error {
+	var request UserUpdateOidcOauthCallbackRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UserUpdateOidcOauthCallback(ctx, request.(UserUpdateOidcOauthCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UserUpdateOidcOauthCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UserUpdateOidcOauthCallbackResponseObject); ok {
+		return validResponse.VisitUserUpdateOidcOauthCallbackResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UserUpdateOidcOauthStart operation middleware
+func (sh *strictHandler) UserUpdateOidcOauthStart(ctx echo.Context) error {
+	var request UserUpdateOidcOauthStartRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UserUpdateOidcOauthStart(ctx, request.(UserUpdateOidcOauthStartRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UserUpdateOidcOauthStart")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UserUpdateOidcOauthStartResponseObject); ok {
+		return validResponse.VisitUserUpdateOidcOauthStartResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // UserUpdatePassword operation middleware
 func (sh *strictHandler) UserUpdatePassword(ctx echo.Context) error {
 	var request UserUpdatePasswordRequestObject
@@ -11944,6 +15133,31 @@ func (sh *strictHandler) V2TaskGet(ctx echo.Context, task openapi_types.UUID) er
 	return nil
 }
 
+// V2TaskGetQueueDiagnosis operation middleware
+func (sh *strictHandler) V2TaskGetQueueDiagnosis(ctx echo.Context, task openapi_types.UUID) error {
+	var request V2TaskGetQueueDiagnosisRequestObject
+
+	request.Task = task
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V2TaskGetQueueDiagnosis(ctx, request.(V2TaskGetQueueDiagnosisRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "V2TaskGetQueueDiagnosis")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V2TaskGetQueueDiagnosisResponseObject); ok {
+		return validResponse.VisitV2TaskGetQueueDiagnosisResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V2TaskEventList operation middleware
 func (sh *strictHandler) V2TaskEventList(ctx echo.Context, task openapi_types.UUID, params V2TaskEventListParams) error {
 	var request V2TaskEventListRequestObject
@@ -11970,6 +15184,58 @@ func (sh *strictHandler) V2TaskEventList(ctx echo.Context, task openapi_types.UU
 	return nil
 }
 
+// V2ConcurrencyList operation middleware
+func (sh *strictHandler) V2ConcurrencyList(ctx echo.Context, tenant openapi_types.UUID, params V2ConcurrencyListParams) error {
+	var request V2ConcurrencyListRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V2ConcurrencyList(ctx, request.(V2ConcurrencyListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "V2ConcurrencyList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V2ConcurrencyListResponseObject); ok {
+		return validResponse.VisitV2ConcurrencyListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V2RateLimitList operation middleware
+func (sh *strictHandler) V2RateLimitList(ctx echo.Context, tenant openapi_types.UUID, params V2RateLimitListParams) error {
+	var request V2RateLimitListRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V2RateLimitList(ctx, request.(V2RateLimitListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "V2RateLimitList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V2RateLimitListResponseObject); ok {
+		return validResponse.VisitV2RateLimitListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V2TaskListStatusMetrics operation middleware
 func (sh *strictHandler) V2TaskListStatusMetrics(ctx echo.Context, tenant openapi_types.UUID, params V2TaskListStatusMetricsParams) error {
 	var request V2TaskListStatusMetricsRequestObject
@@ -12048,6 +15314,68 @@ func (sh *strictHandler) V2TaskList(ctx echo.Context, tenant openapi_types.UUID,
 	return nil
 }
 
+// V2TaskCancel operation middleware
+func (sh *strictHandler) V2TaskCancel(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V2TaskCancelRequestObject
+
+	request.Tenant = tenant
+
+	var body V2TaskCancelJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V2TaskCancel(ctx, request.(V2TaskCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "V2TaskCancel")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V2TaskCancelResponseObject); ok {
+		return validResponse.VisitV2TaskCancelResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V2TaskReplay operation middleware
+func (sh *strictHandler) V2TaskReplay(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V2TaskReplayRequestObject
+
+	request.Tenant = tenant
+
+	var body V2TaskReplayJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V2TaskReplay(ctx, request.(V2TaskReplayRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "V2TaskReplay")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V2TaskReplayResponseObject); ok {
+		return validResponse.VisitV2TaskReplayResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V2WorkflowRunList operation middleware
 func (sh *strictHandler) V2WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params V2WorkflowRunListParams) error {
 	var request V2WorkflowRunListRequestObject
@@ -12074,6 +15402,68 @@ func (sh *strictHandler) V2WorkflowRunList(ctx echo.Context, tenant openapi_type
 	return nil
 }
 
+// V2WorkflowRunCancel operation middleware
+func (sh *strictHandler) V2WorkflowRunCancel(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V2WorkflowRunCancelRequestObject
+
+	request.Tenant = tenant
+
+	var body V2WorkflowRunCancelJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V2WorkflowRunCancel(ctx, request.(V2WorkflowRunCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "V2WorkflowRunCancel")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V2WorkflowRunCancelResponseObject); ok {
+		return validResponse.VisitV2WorkflowRunCancelResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V2WorkflowRunReplay operation middleware
+func (sh *strictHandler) V2WorkflowRunReplay(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V2WorkflowRunReplayRequestObject
+
+	request.Tenant = tenant
+
+	var body V2WorkflowRunReplayJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V2WorkflowRunReplay(ctx, request.(V2WorkflowRunReplayRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "V2WorkflowRunReplay")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V2WorkflowRunReplayResponseObject); ok {
+		return validResponse.VisitV2WorkflowRunReplayResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V2WorkflowRunGet operation middleware
 func (sh *strictHandler) V2WorkflowRunGet(ctx echo.Context, v2WorkflowRun openapi_types.UUID) error {
 	var request V2WorkflowRunGetRequestObject
@@ -12099,6 +15489,37 @@ func (sh *strictHandler) V2WorkflowRunGet(ctx echo.Context, v2WorkflowRun openap
 	return nil
 }
 
+// V2WorkflowRunReplayFromTask operation middleware
+func (sh *strictHandler) V2WorkflowRunReplayFromTask(ctx echo.Context, v2WorkflowRun openapi_types.UUID) error {
+	var request V2WorkflowRunReplayFromTaskRequestObject
+
+	request.V2WorkflowRun = v2WorkflowRun
+
+	var body V2WorkflowRunReplayFromTaskJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V2WorkflowRunReplayFromTask(ctx, request.(V2WorkflowRunReplayFromTaskRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "V2WorkflowRunReplayFromTask")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V2WorkflowRunReplayFromTaskResponseObject); ok {
+		return validResponse.VisitV2WorkflowRunReplayFromTaskResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V2WorkflowRunTaskEventsList operation middleware
 func (sh *strictHandler) V2WorkflowRunTaskEventsList(ctx echo.Context, v2WorkflowRun openapi_types.UUID, params V2WorkflowRunTaskEventsListParams) error {
 	var request V2WorkflowRunTaskEventsListRequestObject
@@ -12128,229 +15549,349 @@ func (sh *strictHandler) V2WorkflowRunTaskEventsList(ctx echo.Context, v2Workflo
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+2/bOtLovyL4XuDuAs6z7dmzBb4f3MRtvU2TrJ002Hu+IKAl2uaJLOmIVB5fkf/9",
-	"A18SJZES5VfsRsBiT2rxMRzOi8PhzM+OG86jMIABwZ2PPzvYncE5YH/2Lgf9OA5j+ncUhxGMCYLsixt6",
-	"kP7Xg9iNUURQGHQ+doDjJpiEc+crIO4MEgfS3g5r3O3AJzCPfNj5ePT+8LDbmYTxHJDOx06CAvLb+063",
-	"Q54j2PnYQQGBUxh3Xrr54cuzKf92JmHskBnCfE51uk4va/gABUxziDGYwmxWTGIUTNmkoYvvfBTc66ak",
-	"vzskdMgMOl7oJnMYEKABoOugiYOIA58QJjgHzhSRWTLed8P5wYzjac+DD/JvHUQTBH2vDA2FgX1yyAwQ",
-	"ZXIHYQdgHLoIEOg5j4jMGDwginzkgrGf245OAOYaRLx0OzH8K0Ex9Dof/8hNfZs2Dsd/QpdQGCWt4DKx",
-	"wPR3ROCc/fF/YzjpfOz8n4OM9g4E4R2kVPeSTgPiGDyXQBLjGqD5DgkowwJ8P3w8mYFgCi8Bxo9hrEHs",
-	"4wySGYydMHaCkDgJhjF2XBA4LutINx/FTiT7K7gkcQJTcMZh6EMQUHj4tDEEBF7BAASkyaSsmxPAR4ew",
-	"vth6xkHwgAhfuOVkiPVwQvaV/8yoHWEHBZiAwIXWs4/QNEiiBpNjNA2cJMpYqdGUCZlZkBYlix5t+tLt",
-	"RCEms3Bq2etStKYdn/0w6EXRwMCVl/Q7ZTdncMpWk2DI+lCup1REHJxEURiTHCMeHb97/+G3f/y+R/8o",
-	"/B/9/Z+HR8daRjXRf0/gJM8DbF06qqCgC7ig59BBsRNOHIpZGBDkMkGnQvxHZwwwcjvdzjQMpz6kvJjy",
-	"eEmMlZjZBPaAaoAYSLFfkCYBFWAVXCsoJx2CSkPRyQkDJrkVuioTEhOHWtzQLxQhfIgMxrJ0rxWnQubK",
-	"xVTIsMuMSAuiLEJfQ0wMFBhi8jWcOr3LgTOjrVQYZ4RE+OPBgaD/ffGFEqdO/YAIfYPP9fPcw+fcNNHs",
-	"/i4jXTB2PTixJt8hxGESu1AvxrlM9HqG1RM0h4pSjMVYziPAQpzmpHbn+PD4eO/oeO/o3dXRh4+Hv318",
-	"//v+77///u7D73uHHz4eHnYUc8UDBO7RCXSoQgaBgDxONwowXQcFzvU1FxB0aBWg8fj46P3vh//YO37/",
-	"G9x7/w582APHH7y990f/+O3IO3Ink3/S+efg6QwGU8rk737TgJNE3qJo8gEmjui/DlwV+AHRSbJdVUE3",
-	"8MZVeA914uEpQjHEuiXfzCBnf0qshHZ3ROt96w2eQwI8wEmyRmfkKNgoV64KciWFbT+/v8cfPtThMIWt",
-	"m4qXFBlaJLoujAi3EYbwrwRyYZLHJzcIOGaXo845CszE2u087YUgQnv0sDCFwR58IjHYI2DKoHgAPqL7",
-	"0vmYrribJMjrvJQIicOrW++nxL/nNlj/AQbEuGT4IM9CVvaqZshay5XPcPvS7ZxQPeRbADTw8iA13o7s",
-	"wJUwbmuyPVYLohCyJYWBm8QxDNznMzRHZERiQOD0mWvvZE47nPTOT/pnd4Pzu8vhxZdhfzTqdDunw4vL",
-	"u/P+TX901el2/n3dv+5n//wyvLi+vBteXJ+f3g0vPg3OlT3OoOSbIcWDGaOcMQaBniG9JM4OdY8z5M4Y",
-	"b3KZgbDDyHG/szgRh3NEAuR35UQMoXoB0ePigdvES8kHNr6OMYpIw1EYYFjGGpEit4yxHFjVYPBRzHCc",
-	"xGFwE8b3Ez98vIrRdApj4z4Cz0MUCuB/VwRzaWA3DoP+UxRDjIVNWSIc2uRcbEBZrQdRQjQjl2QPbdbV",
-	"QaVMUALnNl16tRjQL7ZALWkbR6qDlHQYkyr7k+FHPxbjBLsB7nX2Ie1/D5+N3Q30wc1IBlKGmdH5SDkV",
-	"GFFEwgi5vdhEpHPwP2HgSMXs0O1w/tYbnv9dat/R+chhYyzD3KmGmqPgv466c/D0X8cffiurqhRYMy9w",
-	"Z0HPhzHpzwHyv8RhEpmlGm2CdSLER5jQNfIW8kga4471eW2B5XvoAXbZjOW1C1DrVl5jnPDBtXvNPslt",
-	"pWt1SCi8GyvZW7mubicOfVhnI/DVfIfzMYyHtL0WHx0xWB1WjPiwMzG5F2kVWGDLwH4y1U9Kv6x+0q7w",
-	"lDJh+mI4WDOg9HjMtAu2lbHZr5dK65wnKq9stPykeC7KXodUxTSaa4njyBySWejVG7cKur7zLoqpUpYZ",
-	"nG097cdHMVDNZ6Malg1+wJgqTu0w5jNRCppuoMLsOVjFlmYbmCKvlsDOkI5NIzBFQereqkL/ZdoytcqY",
-	"xHlscjxRCd7KDafbdMV2P+1/7l2fUZu8dzkwWOHKABexB+NPz5/lJYYcJpDGECwd9LORmEW0SVNoKUtm",
-	"KYYk6cVAvSYpsloZ3MFpXvIWL4TEdZFxIZL+h0kwSuZzED/XQca26qbcrYIluamXLuRWbvgp0Dn9mlip",
-	"zt/+Nbo4d8bPBOK/19ucqbXJpv+2HA3IMbaA+dPllPleArotUFaAKCTIKYqhK0GSUgRgt8Mvis3ywySB",
-	"LETPCILYnWm1kYneS7icAKS9sGB2WUJNQsqqvJUTJ0HeHWm+HY9g4FFYagYWzZqM/FcCk3qIeasm48ZJ",
-	"EFhALJo1GRknrguhVw902tB+9JQOcZWvUHMEYd/21TPWAlywhE4xC17FAfmvcKwRtVWBF0ziKqEXQs/8",
-	"GY731+QyL42JCYzs5cuIwEiH2EpjlaA5DBOiX774WLf0h2UN1QfFQJUnG7Z0neX5r3A8TDRXIi5zMfvy",
-	"HsjuoiPtlEYAmZsMIcCGM88EBQjPmk39J6fIqh2lRMtbGnZvCaKLIU58ovUjYgJi0mwxmACSYIv1UA3C",
-	"2wr6HiZBMxKnm9+cyt17GFezQJPlKmZjHciK6iz0XP5gxweRBJLugplrRuk2SePgsn9+Ojj/0ul2htfn",
-	"5/yv0fXJSb9/2j/tdDufe4Mz9ge/yqB/66wIal7pwxpsg6GKXTVbLCZh7nts9t9v1KhLr2i1dh2FOO/T",
-	"xa8Mbx6a2hsvBTYxkY642DJ94N7fwPEsDO9ffZEKLKtaYjg9QwFsFKNBVSj7TM0HKk+kIvXDqeOjADa5",
-	"kOeBnNo56HCiQa1pYurNW2h8BQVsqcELWXRpOsNthqoz+AD9vEPl0zUVL4PzzxedbuemNzzvdDv94fBi",
-	"qJcpyjjpocZq/3MQ6ASJ+P76Z0JJVnrpwT8ucS7Mj9DwZCg6V5wNNQhQr+x/dvgFObmLGO0edzsBfJL/",
-	"etftBMmc/QN3Ph4d0qNenrNynXWRPaKFE3EqTCc+tjpMKbBow+DgU3nkd3YjZ+vSBiSFBPjq0ZU2ZR4X",
-	"H2HCrwuyMPJDm7ObRmL9m55bv0MSI1cjj4Nkfml3sGZ0LI/X+6b1/tvqLM3HQjw+iR2sjQMO7Q7RfERx",
-	"lN7XoyZ3cZKCmpulqyJEJ/+HgEAW5lFGpZUvNabi36cDaEW0DzAZwgnyDfd8LE5NBLKpg7Egtph1hCxU",
-	"Yw3RfmyiH8BPDOpnDp7QPJmrng1+c4cdFiAtXLFi1x9R4IWP+m1fha+3BtEP5nVIaaJZxxx40HYR/Jt+",
-	"Cv6NLYPuJQqUsJsMzTyUdxLGLvRsAwmU04GyX3K9KVQ5SrtV6XoLlGHGY1p1mH5eQiEWxyipRI5NiTUF",
-	"ldrRoAsDMlJOsYX7GwaeiZ75V0cXYqW6HZqcSxfxQyzhQ1ibo0CgNPMUlI7NxTC/ah5JN6KrnqgFLMXR",
-	"teIf0r/eThDpEEY+eP6l4jX5khR3DDauLEcPr7s+pfmHw8Oa9RbgNq3a5DhRutsL7YJ/yxY+CV1MuZwx",
-	"ewVbNQhLpKMWfByaAacQk+vYYGtdD88cEjoYBh6LlBPHXOyQcD2X4SYFkQToL2oNeDAgaIJgnFqTwgAS",
-	"jxp4QJ/6FmgM/TCYSohrZGV3nfGEdg7NyhjBkTuDXuJDhdKWjZQ1kVS3Q3gorr1KaxIcmw1+q6zLW5Vj",
-	"VgST0z9GJ1/7p9cmb20683pDxLY02Ku8+iziq/oWoSltrC4WbJgEJ6qjsfE1BQdg09pLAcBmiSMr4/Cm",
-	"1OE1g+YyoqiMlysT3RYcuDRywCpyzshBjcLnyqOYDmUqjqt9liM4B9EsjOHID8mKT2S5047+spy7ILAf",
-	"cseM6GHv5l/wdCTuUU3Lop+dOJELqzcH1AvR+oUi35eRAvYrLYkmjbNENLEHvcDgGVq66gmweHsqb00p",
-	"+agXR+WrnhkIAuib4BWfHeTpPVOYDu488tH1Z34+wrkxvl1OweLcF5xkKXMVzE2rp9+WWDrtbl43G3yZ",
-	"RW+FoW1nCktEpOjO00VXIUOtoiEwMsk9fXzLDPleDPOX9TXn7DXFpEQgLr1JrYUkhsADYx+aNld+T1+n",
-	"c4FYSyZLhUoZZjBTgLKKHDnI0A6xgfzWqmLr1xAa1SP9KMzdACre7hUFUDEivDH5H2ppINcdn4RJQPTg",
-	"QiOUi7hOsz4VGCqeNXMRYBYBRCLeLW2/erYLE2ICcUGOZFd7vQmBsT0yVx6QxrtU7MwS1pZtLCZtaxIn",
-	"FrKmyYrTLhUrpqaPIQ7OSjmlFJiurDLoTKCuF7sz9AB3Ui41P3RvlYgJ6YlK36mC62NI4ucKKbo2flSO",
-	"MZthiYoTg4IEiUf96dNE79twwM8zoPZaVbQxPEFzzVRg9q56+g5KEJuG5CQPWqxH3EuxHpRu4AOMEXlu",
-	"0nsk+1jR3WcUYzKC3Ei2p70z0LRXw/BgfsrIAViYOcWsgiY1co/vbwUxb8vrqRyZ1hJyJtKlD2nY587x",
-	"u/OLu5uL4bf+sNPNfhz2rvp3Z4Pvg6vMeT44/3J3NfjeP727uGZ+rNFo8OWcu9evesMr9lfv5Nv5xc1Z",
-	"//QL98oPzgejr3kH/bB/NfwPd+Crvno69MX11d2w/3nYF32GfWUSde7R2QVtedbvjdIxB/3Tu0//ubse",
-	"saXQNX0+u7i5G16f3/EsMt/6/7lTrwwMTQSgWneajmMUpCqhnGKBw8HV4KR3VjVa1V2H+OuOo+F7/7yA",
-	"+AZ3IeJv2loHTJagspg6E8YihUHfkGjiRqbgCx3WWnoJ5qwX3tfm2wMB8J8JcvFFRC4SUjFq5naYAeyE",
-	"EYGeI46W6SD6OdaetsuU3mDp/Aj1Sb6MqQ60yUM2mzVkTa/XzMlDtGveAiGt3wtdkpVpuMdJrjNk1w4v",
-	"+VWhYDqChP4Hb45FeeKD/lOE6C6zZx0MmOrxeS8+DXYeWfY99kLFATF0QBTFIXBnKJjyNHwMwVXzy+Qn",
-	"nEhYsNqCUPAly3yHZXhYdFslLhSPzGeA/CSGFqCwwAkVENWRj9kLYP2cPsB8qeZLliwOFgRiZ9lFi3ik",
-	"bhnxBp4kkX1mvorAfTaGtjoT2cQBRIZrCqparX/dLAm0AJvlwiCNQ1tPHqGXNOVi5QWRTLgpki1vMgnl",
-	"YsmK6q4JBEOZLjnkZzPWeIuqaw42Qi4T3gIaM5dlKdsrNQVFDe1sjSoRpNxMg/A9LcP/agRln+2Esl5d",
-	"62sMY97jMhn7yK0iBTZeRb4tFeat2XSxf4ts+lDskzxZXNycs9NR7/T74LzT7Xzvf//UH1YcCKpfzTC/",
-	"NjaHNOm8HuUQs5AAvw4TOTgUx0DV3E3GK8ZSpgiQlK9iMT0v93/wE5l6kmSnvotzJeisAr05s0Zn2YF4",
-	"XvHUhH13WHS+XgbzRzEkdB5BzFI2lOwd3lv/dKPZKxz9A5zVvKnhY5uXqId/uXQA6bbXc2hKJHYvauo2",
-	"rPlDmjkkMJbPaaSq5GM5f0P7cN85cjzw3HWOnEcI7+l/52FAZn9f8FY+RY/2eY1ZskpEXYY+cjVJc7gJ",
-	"XnUqTdOV86Yau6CBZM2zX124tgDOvDrh0Fm7zGTSiceAbSAI2BhXfs2Stb/FZKXqymsewawkT6jRXlEB",
-	"Me//DrvwWh/E6/og1ugbWEvedGsP7YuRm25YUID5+Q2+BAnWve1WyZ1HFjgIOxFr7YDAc1wQBCFxAKvA",
-	"wEo7yWxkRcRrocO6Q1ytEwN4XgwxVp0ZObtMno7LPg364SvAM520ngE8U4f8f7gwnZDf3LThlZFGvMiQ",
-	"czIDxDjhDxijCapDL3PJUFnyIJqL6lw5GPQUPQPYXANMOwdIi345GJINXjV4CEc+eM4RtNy/xt6PPHZv",
-	"DQSWL5JmTjkNH81IZDwIHzOsSRtND/sCajstwvbCYrKqAEmBqMTfcjCUMuikJeJUPJlQfhZOUbB4svPF",
-	"+Hup3Odbh3G5xqgO10M4RZhUSPdtRLedpjMIhi3cLVmmyHbTVPMYz1CEd9UzV/JUblCbr0PL8Ml02/bj",
-	"+BRMT5RI+uLLEU2MfRW6fxxfAXyf5pYu3zd7YGqbCEEDLB189enGCcD31MLTF1/hWD9vtC2aEZUKtkn2",
-	"wLuiuFFhKFaQbo58H2HohoGH9c4nFsX5vSrrWq7CbnEW52+pUwoQiAn97e/1GTv0d2mYgHmUH152s/c4",
-	"pt6O8hzsU9UurjjKvAwB/7YwEi1e5mlwuKbXeYJ103BxOp9FSiOA7w1JkWj/AMOGK3wErLAsbLbEZhmY",
-	"9hep5riiJ8VW0i8XUNl/IjAOgPH9HhTflWWqXoP9ZWE3H1k46JxOShuu5KlRHNKqOC28SM4clpwUDZG9",
-	"RQlXPp7SflfsVxuK76fNFwwBrghIpyg5zSsQbRtLokjZpVlgrnw50eyZX2Fz06lVBGeIuTXq620JxFWJ",
-	"ShuHWySH9QfhNoy6lWPlom2LEbb68Nxi1O2of351d6UuJl3DHc9sWgoRPhn2e1eFbB3fBpeXhhBcjs3L",
-	"EFHrm8Q6I1qsWctwWZis/qIFcW5aINOJaKSJwzXTsLIMzU0RfwHWlBJV1FhlL+Ddhklgwqdb+fKlsQlQ",
-	"emMipLk59L4AYVOMZEvTHBxysCmsmRJjFmJ9cvH98qx/VYqsrggYz59b2hPGWzphbNPZwPB2dBvPBguY",
-	"pfKwsGzuvPZk8monk00dBgRnlUjttiis12tZ6iKcePgDhQvbRrHUuMUWyuz/4/gmX81jgyqrpniAQThR",
-	"BhI97fmn1Y5b7n8zo3wlufHerIr9VT1auUx3VStLnViizNZSmYqQt4QOUj1TitA9hURGaRUOhPXZUfLS",
-	"m1LJDNR7rNQUgrT95zDWwCMNtIrid6oWE5XwUsmvOg+bKThjjTyuLFd0iVSMuWNQKguWuJTTlvbttUyG",
-	"XCyXPWabZCq2Mx1EpjgeQLXS9yZ2V+Ai45kIvtIq6cSU5Vj2TWJ/kee4dNxalPCUpUvWcbdYJIZuDA3a",
-	"h39La3GIm3sqBp3BxAlC4kRx+IA86HUd4MQg8MK57MRSG46hM4UBjKW5pYrz47VhvDmave0kwMX2ZtOk",
-	"nMJZi2wq9Lak9lxe/Fj5PnNdjIwpDhd3gBgLUUMW4JlVpOFDLXY0sUvbrAM9S9zMTZKT0DNQ7derq0uH",
-	"N3Lc0EspOBbItygdpGAlhTk38a0lwqtJSBadqdGBguZla+uHIFoKWJh2ynl/v/SvOt3O5cWI/ef6ipkN",
-	"Jg3Jsxriqmy8mL8TEvHFLgicCMaUrvYb5WcADwD5YOxDmVywpjJzeVr4BN2EQMcNA/GuyX/Wn12pKQyI",
-	"O5P3heUKYFkqSoAxmgbQc7JO7IR8fT04dQT7bP5I4oMx9HH1oy7WhrEUVE8aXA1Yl46A8RkdR7dlPsDk",
-	"KwQxGUNgkYxYbBV7o4cpgMCZyd7rqowFODNT86CPCRj7zCmwhZDOwZOZ8DUFvJZjgPXbHWZ7Iy7VZNKl",
-	"hKVt0rzY+SNbAwIu1H/SpcJMArolg2AS2nHDUOnAsuqEJk2AZapznoabM+KCCymkTdcsJHO+6PKLM7Va",
-	"2hupEnonV4MffVb5M/3zsnc9Mtwhkme7AzyMZbSJUIbGROJCV3KJWgCy3t/Ce1/XWZ/XwzPN8E2NUdZe",
-	"a0gowrJZCUJZdJZ2XXVQX8XjX/7ot2ZyMz7okirw8PpxMEazOwVymGf+wstfEEwT4dy2Fguj02+YKx7e",
-	"WfgA9eFUesNISKT+E4mBtgH27s3DlhbHIFLNv4uzHs/k9p+rrywrwNV/Lvujk+Hg8krL7QonK8OM+mef",
-	"v16MeOzB9955j8fO3PQ/fb24+GYcSGZIyKM6R5va80z2S9FpqmUY+ydxzE2VPorTP6X6MxwbBCv9ogPI",
-	"ij7/FY5XmqisiW42Yk4+oNCYR2C6+Frl3l8BrfEvHN/Ni5oJRkjrH1S5DIuy3CS86Lgn0oTS5QSYQqJ8",
-	"T9PZFa4cA1mthD87nUKCGe7crKszpX1TpaT4P/eNOSlGJAYETmtzqSoQnuX6NTc2M3sy79nNaWcUkHfH",
-	"9Wd0OXVxNV0tVqu2aHCqewaaAjg41eJQ9v6Ggtyp+PP1+cnVgMnD0+th79MZtYFOe18qJRkdRCq6RmTL",
-	"Ztfwgfyu155LFW7YsOJlgt7OayFaG6MOGZN8g1m+a41sKhTsLvPYPXw2XGTL4SlZVkxROHtRngUOjqCL",
-	"JsjNJnH+FgGMoec8IOBMkE9g/HfLeuALRDk0qtvm1cRnq+ECSnVzpajl2uo0LFaIjie7t6fLrFDDCnUu",
-	"L8DwOtXb+NwjNTv2pkFYW4VhbRE5m+p/0Pv03GDwK6VX+fK+oR2y9kJ32cW+AvZttTDZkqNY1c1uFfhV",
-	"xcR7oxOqpvujk0o9nY1SqlyXBXiptJyTYopkrJlkJEMbWtndyu5Wdr+W7K6p5foLifaK2KgFRDMbbUDg",
-	"3BxtZTiv1Hc2vrjPQp7zMK+07LwukH8FsfkGcVx8h5WfuqtdujJg3Z6vtBJ1nUZjky10xs0zv5lwrvKs",
-	"Xwx0CINLRUpr6j+FgaxTa34ku65KiDfNqsGk89VsMT5hla+M4R+5IjRrZJsC+IVp6xZhPNCzd3VN6EgO",
-	"dcI71lmMheaNnmNKXtJ+FDyj/SZZr/kjz6rVXIGpDn8+t9+Wd5Qv7THWR8VxCKsIRHD9SUxPFRM941cU",
-	"PLxDBnarm1AUH9LMyATFnbioW/W0WL/C5mq6gDeNaIUPpYKQDQZO8bNaS5vbPnr0ZebQnbgHaI5mnihx",
-	"BSkS6++DqsBQTMsiy+buE2w2RL2CoCc8OAGJTy5jFMoiTzr2Z42cSLTSMXCtxz678Hqla6y0JqIFqFjo",
-	"/qus9q/m0ILc+2dTaAT95mBxD2F3R6bwdAPWwspNl+FGnn+0AkLNN2/rjK88IJkPLhLmrMqiMtBtPTuw",
-	"fV3lbUYTAnlTCOd39dk1Rh7jkxiy+KGKwqFz8FTTomEBRFMOBR54nlAhRc33OYdwDEEM415CWIpXhlEm",
-	"e9nP2abMCGFFq9wwvEdQNkd0V/lP8or3Y2fGQjaV7K4gQt+giAJBIvBDE43Muzm9ywHLCUOYWyb/a0pZ",
-	"naP9w/1DRpgRDECEOh877/aP9g9ZLkEyY0s7ABE68EWV3aku4P6LvCGmrQKIsZO6BOguMv8eRXnnTHz/",
-	"wtYlA6TZLMeHh+WBv0LgkxmTyh90389Dks6Z25nOxz9uux0sk0VQCLOGMlbgDzG+O4PufeeW9mdrjSHw",
-	"nusXS5uhqtUOZYNVLpcBx1JB89THJAaTiajUUrX6FNra5T8cHQCRp3qPpSXcY3eE+OAn+1n97YXD6EOi",
-	"scVP2e/YATJVN0+HzpMvsu4ljBVS3/MRGC3GgNVloGBXlDcqzeAgnraq85HRc8ZdpaV0VO7nrl8uF5c+",
-	"m77clvb+fRlbo8R1IcaTxPefHY5ST832XkbeS7fznlOJGwZEJOICUeQjl2H04E9RpzRbR422YgWtRYLN",
-	"YnjCHPgUC9BzwtgZA08+D+BgvFs5GDooPofxGHke5LZsRt+cTqrITFK8KId02+087aWZ45mri3/oagjj",
-	"lh2iiKtJ3s2N92VInI/wa5A4o4dPIZedKyEGi7IYGjKpxBYJnUTiPI+NF72IXslCDNUry7DnxAAHtBUD",
-	"lmKAU8v6xICqICO0x8tgHPxM/2baMAqxxmgYwofwnlWW7F0OeAENEYiTzlgQExFiFTqke4B2t5ES6fAG",
-	"mSBh3Sp1F7PlCTpn0P3aRI2bULUgHbqxV2LnJBlnv1VRcrrlOQp2/TDxDtSjrNnaLWWekccJNoiDAkxA",
-	"wCpY5Yn4hH6WkQNmI3j9uGWAOEmQPtXbGgKrsdo5gtWrWLH135ULmac9OcReGPE4BqHRlP3mztWDn+y/",
-	"L1X7TaUUa7Vf2lDmY+UbWSuJ2BBG44R93agQWt1mixwaNco7hiRG8EGINY4NtmOtbMuRuIKZjLw5iiuk",
-	"GqefWzOFH9SJNbYtqVSrofnTVIC9dbo/ZSTc0v520f4cLqzDjdp7c4pbFClvQlOpStwRRb4KFU7HOGAO",
-	"bb5L2LjjZwjTA5Dv5FqbNpi2HuQbrm236Vxix5UpG26+zFSRW902EUK69WwjCptQ3v/cJocBIiGV5gc/",
-	"Oce/HERxOIbmw6W8pXNAdhFMQof5dRm+8q+ozQyfTn0ZYjJMgks2r71vyqT0Usm1Ya1XQVAi4wCnJ4bf",
-	"/Y1qhfOQOCAhszBG/0OhCGXuEZ4bgT/AK7k5CUA+9Bzut3fY9jifhTwfZNuqVxw5MsM+cO8PfrL/WHjx",
-	"nRFtKB+klyiHfRVJXOyd9rkxjcTDQNxK73weJ9tk2hxtBozrICNhPvGHzUzMcwOxFGvA98NH6OlvBIpU",
-	"K0Uv+73KxOJEl+eYAB/8xAG24pbzkSr1y/wS4AZskh/MzChCc28dmxSQ0TLKFjJKiWBTVjkfVTJKgDVs",
-	"Ig0XxdukN13ovPJIXGKRxndjr2Z/dM2OgHv4vKgnQIHh+MOHHBBHq7CBojik/4Beq8O2iDVNh0hEZsnY",
-	"AVEkqb2s1nibAj8SGO3FCVNe4s+XAxC7M/QA6w6QopV8Mi5yWpVZlT8FY0c7ObAF08rxzApNwLtpxhUP",
-	"5kno4HsUSdj+SmD8nAEXTiaYOUY0oKCA/PZe+3a+ejqWWMIZPxumZJ8bzrhOf6DYd7Hn7KnNAo5B/Mad",
-	"gnTW95uZNcd1jwAz4TMJk8DTuS1y7K8wf2oZ0J+GSeXlY8rC9TIpi/43SyQlDbqdPErzjbfS6I1Io6yW",
-	"YSuLfh1ZpDD++iWRH06r5RB2/HDq+Cgo2Ubl68OzcHqGAq4dWzG0HWKoW86+Ja8UfPgAfUzn5SmQKiZm",
-	"LXMzV158CDqgvXguD8PKMaSK12GzKXBMwtgACO/QFJAR76UB4mYGCJ2YveAwrz9U85I0nDyX08SABz69",
-	"lyZPqYTiVGm2CCRZ//UqKVUa1OknSpKtcjLcnjOtkEphRRechdPmaoB/xmY/Fa+DgR3gBPDRFLPJo0p5",
-	"0856AqL54HwiuwhoEoqqCa8Q71xL4iKHkRLg3IYzpyTO9zojtrrgZR1Fp65Ynjir4hEDi4B6QpigYFpN",
-	"4Lvjlt3AqwQ7JsxeM77q+4OWH1f2vKDBY4JKvtQ/tasO5QJZbTjDUwdc9+zI9jiypYEd63uTs4DnwLwJ",
-	"Le/kzLUqarVnpm4DE635e7zUenuryk21MFf35M7aBD165Sd3ZQ3YPrmztVGXenJnpyUPMCT0v7j+eb7s",
-	"4sgu1Q/uFHJBwXQk+ljG/L8RNakgZgkdqe5Jy0q5KHEjmlbGR+m71eqLtvQZKbZ7ptrak2loO8MHzhJO",
-	"N+ITGb/d+vqKxmP61hU3ewBbZzAu8Ca7tREZAiStK2bhOl0YxUlb/loVfwlGWPCFebXCsYjqwOylUi60",
-	"g/c2vMXcFV3zlq9R7+Gz1SUqbZeb1SpxIyMDlgutnPfXDJNSYMkKtkxWNAZQqfS0GIhxEohXW9AKVtnW",
-	"+vpTnyn7la6k2X6+zoU0m3oLrqNVONTL6ApiSV/03sNnURIzAigu0UtanOEPym5HH1nTI14H85j/65iK",
-	"d916NAVAtMxQm47bvAz5Xt6KzkVOdANLrjaF+Nqf0rdRACs5GUAZ42n5gN7WhVyVD6I9AjAEiJzblW5h",
-	"zt+vE4Zgl6lF9fnyVzlvPgr0+J+bmVXmRxbmKXxyIfRKj9TEAUW+mLLm8/qDycE48e/NYT+fEv9ekAfO",
-	"ZAKuFAq0zxsWDHT5DYUDfk3pgJuLhzZKfMvkA2NTVUjgFUsJl1W1qQgPZN+5I0MpspozcU1Sg4eV8BHe",
-	"skHBEGBvUIgDQwwjHzyvXGy8WtWiYrL5GtHEkAa9jOhaIbWtQmrIKHU98om50Sx9rNw3Z+Fn/Qaf22u9",
-	"zNm40GmdIbs9setO7I7w/a6SD4Q2qEjDTL/jZqp5KFXMW1XNHAHboppX41bjwLVW/VtTmCh4QAQ2DbCW",
-	"vfRBYwP2tdWVMlZMwcdCUWIS221smC58OqPFNcVM8wkqab11fytR0hwldsHRHLevGhHNwV0kEFoQRsuW",
-	"+ujnlG9WE6op+Fz+sMf/3azilgUrN66xtV3xNHm+qoZtL0XHruvWWu7VFBDbMu7VZSFM98f0eju/j00K",
-	"c1lwwo6nG9xCTljv09vF9O6rPb615FxNza9t5lzxKLYx51Zpvjmcj0WR4gZnNNlLz+Lf2df2jCapUcHH",
-	"Qmc0ie3WGNSd0TJaXI0tKMY7+Mn/sElBDQQQziQO53XP3jg1/BqmoFi2CTb+efOJslfOu4vYgG+Da7co",
-	"y925IaldyqS5jVmZvPgrgQncm1PB7eLaIlistSNap7fIlQLjCyT/pr2+iyl2UWbs1MuAXQr2Xr/1kqO9",
-	"xV6AOaIIvqT7Via+tkyk4ijdnXkqWKRElJyzqEyMAYF77MLJJlSCtubXU3WxEkNA4Blt2L5L29Z3aat6",
-	"w1SLyXW+VErpbAteKxVh2VT6zDyvNQjGUdi5jcYpnFlV3GTilqLaOeO/LipxRY+9KPSR+1yfskV2cHgH",
-	"m4QtMpTgkvVo07Uc6NCymIunsButq2fjWY94FbLKRC25Cme4sjBf6/zkOVpUnDQ5PRRQ3dZK2qIyZgov",
-	"GKqt1pT8s2DEA0xATIzsOKJfuR676CVk5rDDSpEhrzGM+Z0JA+iCIpT13EXOfHd4XFNijKFMqJUcVmYQ",
-	"eOKOxw85weRppTj3S6E4FiW78B5BOihLfpyrlsVQmp9REgLdgYXpoC5vVqGOHtaVtWvlsJDD56Nc1ekG",
-	"kriI5VYWb50sLjOCVUXJ2nRdFqVV2+hEhoA8f1Vm6VodzeYntY4ybGvEbjFDGznPkqMrNaqox7G3iSsr",
-	"USJs126u1u8u0CGmmc8grVuV25n2UmUbLlXSvSlfqizpn9BUT6tk3axQmjN+5gylLd24I3687rZWcNtA",
-	"ncUF5UMrEbauwKIqIlZSVNFKTtTm1OgRAueRSA7D2lrUfN21ZBqtBKkKYEOYhfcLEcKJwN++A8IrX+LV",
-	"McqmGDqGtGPF23uWpMSWh1nzloW3MRtAnARiq2oeX6AgSlg8BL/c1S33ZSsslTYXQIV8YRv+GgIlW1Ol",
-	"L4A3sywK/wWSER+2FS2vZx00y3Jl8DSI4doDxTYfKOQurUVqiLv4vccwvq96MJaFdRoDJdoYiSxEnaPi",
-	"hiGVIqSq1gZFRhpGzzs6cjtaJ/623cop5L94qhAxiImF3vztW45/ODY2VCJHM7PXKNGH3NqWc7fv+k1l",
-	"vEWc9VwqV7vnqYbkwrs69jbTDW9eWWaYaCtRLX3UlE+A8m+nOY4XvaSSiObHy+YZItWaPJpEkUohnTZd",
-	"pJIuUsELrnET5aoevV7ySB3c1kXmFA9SjmDa4+lWJpXM71H5kWH1AbWJwPmp/rPudjzHCbUaWJDpLl+W",
-	"F1hfD5qKwR02E8R2Lfpeub08N78Wzvul618Kd/M0tTg/H7ArjloXNb8I4QytAr1fw9cDNnrL3K/P3Flu",
-	"hEulNASHcRlvdh5HbLtbh/aGHNo3Ku4Dm6wE2SY1NRlWJ3HwDERwTXbEiI3dypudMSb4hrUWxS9kUaQR",
-	"8Rals3NVs30/vXXDGlujivXZcyx+Qd6X6fZbGbByAM8AJs7glCWtnEHHB3IHTclPACYDz5j95N2xLvvJ",
-	"BiL3mpTZUCVPG1uzpTf2C8gS++t8O1mIrW4mWEs7i+ZNpmPy4AQkPul8POzmRMUmEjOlc39YZHJe/t0Z",
-	"PztsAv2k4pP5lfgmzK72smf19tYqE72lY1qW7XSAMwbEnZUue6ospjdfr1O9J+HIsA0GFjHq5auSN13E",
-	"029vj2qSLnGy2cTNDT5w4zCot0hoK+fPcJwBRWI0ndaGT5zEYfCmzZSdyRqZbizy6LRTSFKTeL8mObDp",
-	"4Lbq5MW7lBm4Ilfl+NmZiHyYK0uZqfIZtk+bOX5eX+ZMRW1uOHdmDhlL2LCtYtLYsSVNsCaDlqqlg5/0",
-	"P3vyV7tiEGVVZX01QAlnx0tDpKs3gZXD6OaLQ1hWcdBuYpuXs1hVQY+mZt78PEHcvnSrrtuWZK5dDuDZ",
-	"Ys5ak+ps1eYuuL4bKesVyAc7/c1owNbPrTrf62/v23PkNp8jZWF820Mka7/eE+RWH28pcBGIKdIMN7oF",
-	"sHjjG9XHtyH4NO+xtbCJu9NNuQVyaMMEkARDq+JGsu0iR9oR6ysOlzbA3aPAs4KKNWwM0jcUePXQ7LwH",
-	"haA5dMCEAlqKKXwEWD7xU5fQOT48Pto7pP+7Ojz8yP73/w24F917dAI98XqAwD0KRce2Vh+FeAwnYQzX",
-	"CfInNsMqYa7A8gQFCM8Wh1n23yieVwX0SjG9Po9g2f32Zv2BRduxPdasJYpwPY5AFjhokywXOAI0qujy",
-	"7K9mz7WMD97lco+tGd6a4Zs3w1vbsrUtX+VlAF6yPCoTQG0a73r9voZSpZmep6B6iU/VY43XMG25iP9w",
-	"JDu3XsRt9iKu71yUEsBOhUu0xlRrTO2MMZUtIxPVK/HNWtWdTxk89dJuuHB7WcK0XofVWiUGC2C9dsnB",
-	"z/TPvVKmk9qoJD3IDW2WHY9N0uDAmNlXi+qtDVfS724br1SMVzLgqVlAgoE2aiKXVsKAO12tZ6e4b53q",
-	"uFXFux7XtF45YmcYpMkMXrI3NJX1PIETwEfzSxr7hzRXvMPupB+uPr2qr2D12QsqQdtopVHNNjSpDGLc",
-	"/I2mf2wW5KlmTTbD34rFzZc/3LqUk0LQVVH5eh4xKrI450fWy2NpEQiJbG8PlkyJYRK0UniTUljugLIB",
-	"TeSv0W7YYKmm5uaoKoHf5EmzFb9W4lcYJHU28cpFLs9jvueGSUBqQnRYG5kVSibgBw8A+WDsQyZ9FXGj",
-	"P41/gYTnSccnbMadF711ybt2PHlfbrMWPHpzUuHk03rDDXf0OSQtltIvz/4JhjE+cJM4htWcjfnpgDd0",
-	"aLcS915jGH+B5EQMtka6ozM1pDMGcVsK5vVLwUA3iRF5ZmLcDcN7BHsJlV1/3FJRVXjclic3Se5s+zVk",
-	"PEVklowPXOD7Y+DeG8n5JJxHPiSQ0/QFnd/R6iM6ES+E8YUNfUFxeSKHLxD4u8PjmvsEV8zrleedQeCJ",
-	"qm9+yDdDW2UwFesvBWTmcCcXmJ/DEn2YgNgsCkb062KIY12bY43Bs36cMegaIiwMpz5cD72xoX9xeuPo",
-	"WzG9ZYj75egNBQ+IQJvSkNIa5h2Y0W2lvukIV6zvQMy1Ri2uTmQVP+EjLDcmv8DWXrRWqyw7agF7GeVd",
-	"aU6IOdo7AK4LI2L2vPXYd5x62MQkJWpTN5/36azHn8QH5xPVly6soD6+ch39tVEAWf1+hqTS3tvTVwxZ",
-	"nsGKmmb0ezP64n0666oQRgdfAX3xlbf0VVO/nSJpAfrywykKzGR1Fk6xgwIHMN24X2FgnLGB1kNLTAXT",
-	"8TdUY9XqHO2H0yn0HBS0x+etOj7n1TqlGttzsh9Ow4TUMEOYEDtuCJPX9/UIGg23rOJQS6Q1xiijHluy",
-	"ncP5GMZ4hqIGRyClk90xiKuQ71k38YxorQSun7T5eUhFUXsmWuRMpGKwniQjgPFjGFdEInAxKSSpI9tX",
-	"idRLOeb6bIyTGQim6UTbZGy4DDIvRVQrzndInHOyylO6BRPFcEoFWVx16OMtcKVFksbprIttJBjbxDAS",
-	"ee01107Y6ZKEbG0e7AP3fi03DCM68hZfMNSImoY3Dg8wxgKEyuK2op2MX8EwftDYiINgEn6B5IcYdKWl",
-	"PRRIs4wOR/uH+4e6nBFK2Mgfaddbi6odVxWLLYTKVZDzDXRiSJI4yCGvYGdTKZUEAQqm2RRPe3LIvTDi",
-	"T1Sz2eSmPcLxLAzv90QU0cFP8YPFezyqKUTrcpQR/93+qZ0YyBzFk0604SAey7drEr5WL7y+Xii+l1PJ",
-	"1Bi6I1rcWjHHgcCzzSFZNpVl8ao5Rtg92DaxxtbyzWqC3zj0PPZNoIZiZigmNEndNG+owE66XS17bhF7",
-	"Mp9AaYua8mjKm+yPF4tK1xprg1OY5cNUESFYFXCq0fG7E27aOPBPrLj1hpUiSkuvdajRXB1AysxqSoXE",
-	"nVX4uioJmbfaGVpegyuBISCnN0y6QmAgkSjb3CMWS17jkLWcpuc0wRDLMFtBmxRfZlhlJknDx61SITQ4",
-	"F23l84YmWT1SANvXVZt/XaU7DikUs+Djhm6dhWXPCQ1MrrfwymfBlz0tb702b6lPiJZhLBuzz567mtmB",
-	"W8Fg66s8zZFh+9CZW115Ltu0cWglEYrmYSsPjAbicsxZYyZapdenm5TPo58y3kN602HUlA3S6W8DP2tS",
-	"WvKElCuoN7R4tSE9YNM4TCKWJzQDQW6UERTW6Rt87tTmcFizkFgyd7e8VGrTd2+hNbFQvvBGgkvmlTHG",
-	"hsiUCE0zvSyU4GUrJdeVhl32ncGEebdxQqkDel3GVT4gEJOUpxB2JpC4M+iZsklngn/LDSlBBgtmjXm1",
-	"XDEKvI2SxLSpYdrUMGtIDdNINAvZgC1utXKa3Eosi9iaHXLB/Apyec1STgZMLWcKtvJuq0zAjBSXNQGP",
-	"DzwwxQcE4HurFx60nUNmgDhj6IfB1AEOjqCLJshNoyzoiCUh8+P4FEzZW3c2lYWAgU8ExgHwHeTJcLnT",
-	"3hcDc3pgeoc8XClr0kIHS3GtZdmrYm6sArxrT461rGwxB9EQsYFWdSPYtp/MkO/FnJEKyLN/bcNmbf1Y",
-	"hRc0Yi/Sx7gA3+fZm7U4+En/UxcLQ9s442dOtEXupSPbJmqn4xizwlEId/NShiOhoSZl622154a0Z0p+",
-	"jwAzm8+kSjm1lzjHrDxJJWexf+yxcp/VapSXEE3LqnEYdLzWp+2sy6m9Isf98qXU1i9Ssr1uJlsEvbXS",
-	"ZZuki5HLl5A0xYSxTNo0Lt/MQLeu3MxJk66G35Pseu1mlmOMF3cVZf0UNJjullDgQjtAG5eZLdQZnKDA",
-	"SwsN1tQZFCedtZ9s1i/5hkmw2F1VkZbbk0HevmH4Kd8UVcseC6EThSgglqJnjoKEQHqikH/FENx74WOQ",
-	"SqMGkugLJJd08l2XQ4WC2Kk+2f5C2Bmo8g3qosBOUIDwbDtrYHNqy5HaAqKJ8UkrnCqEUx5DqxNR9m5M",
-	"VejsV9g/bUHrbSxo3cs5JDdUBpcThbEArhYtEMQ+gpg4PIDQArw1Wp2NgUkCgvwVKJReWhdZKcO896Av",
-	"wFxdWPluvtrKygVrHG+rOd6tfLBlg0Pe+A55nV3yuI648micn6u9MbC+MVhM3aoVHuvUbq7eZ63qVWJ0",
-	"Wg28/Rq4Vb5W1CBCTlrVu3Oqd71KrijtWiW3pJLLF5fWR+RhS62XU3IHPx+Oc4WNX2wj8eIkcEDgOYjg",
-	"jAtJ6HgIRz54dkKez+m/Ox4kAPn/3XEiMIXVqrFppB6FgRuKU9ZToyYLy9vZm3oFS6cMobjlqlWbjpbc",
-	"1C0RVRP+sr9ZLzp2ClG5FXyU3sFap1n6pe06g8zQ6uVfU3o0u5RvBccGBUcxY+UYghjGacbKrjaHJUuB",
-	"yHk5if3Ox07n5fblfwMAAP//SLgHwXhLAgA=",
+	"H4sIAAAAAAACA+19aXPbSJLoX0HovYidiaBOt3t6/WI/0BJtayxLGlJqb++0Qw2SJRIjEODikMzp8H9/",
+	"mXWhAFQBBV6ibER0uCWhjqyszKysrDz+3BuFs3kYkCCJ9978uRePpmTm0h+71+e9KAoj/HkehXMSJR6h",
+	"X0bhmOD/xyQeRd488cJg782e64zSOAlnzgc3gVESh2Bvhzbu7JGv7mzuQ7fjn46OOnv3YTRzE+iVekHy",
+	"80/QIFnM4ese/EomJNr71skPX55N+d2B4Zxk6sVsTnW6vW7W8JFwmGYkjt0JyWaNk8gLJnTScBTf+V7w",
+	"oJsS/+4kIUxFHGiYzgBtrgaAjuPdOx5g4KsXA15VcCZeMk2HB4D1wynD0/6YPIqfdRDde8Qfl6FBGOgn",
+	"mNdNlMkd+MGN43DkuQkZO08wIYXHnc99b+QO/dx27AXuTIMImDci/5t6EYGp/5mb+otsHA7/RUYJwiho",
+	"JS4TC5F/9xIyoz/834jcQ/f/c5jR3iEnvENJdd/kNG4UuYsSSHxcAzSfSOKWYXF9P3w6nbrBhFwDip7C",
+	"SIPYJ9iHKYkcwGQQJk4akyh2Rm7gjGhH3Hwvcuaiv4LLJEqJBGcYhj5xA4SHTRsR2I8bErhB0mRS2s0J",
+	"yJOT0L6x9YznwSOgPG4wmUd7OCH9yv5MqR0oygvixA1GxHr2gTcJ0nmDyWPo4KTzjJUaTZkmUwvSQrLo",
+	"YlPoMg/jZBpOLHtd89bYceGHQXc+Pzdw5TV+R3Zzzs/oamCNtA9yPVJR4sTpfB5GSY4Rj09e/fT657/9",
+	"so8/FP7Bv//n0fGJllFN9N/lOMnzAF2XjioQdA4XiA0cNHZCEBswCiAEJAdtp0D8z72hG3sj+NMkDCfw",
+	"F+BFyeMlMVZiZhPY53gCRK4Q+wVpEqAAq+BaTjlyCJSGvJMDv+EiFboqExIVh1rc4BdECBsig7Es3WvF",
+	"KZe5YjEVMuw6I9KCKJt7H+CbgQLhy4dw4sAgzhRbqTBOk2Qevzk85PR/wL8gceqOH5joI1nUz/MAjdRp",
+	"5tOHu4x03eFoDDxmS759EodpNCJ6Mc5k4rhrWH3izYhyKEZ8LOfJjbk4zUntvZOjkxPgsv3jVzfHr98c",
+	"/fzmp18Ofvnll1evf9k/gt+P9hR1ZQy993ECHao8g0DwxoxuFGDgRA6c21smIHBoFaDh8OT4p1+O/rZ/",
+	"8tPPZP+nV+7rfffk9Xj/p+O//Xw8Ph7d3/8nzj9zv16QYIJM/upnDTjpfLwsmnw3BtHM+m8CVwV+8HCS",
+	"bFdV0A28cRM+EJ14+DqHMWPdkj+DFKO8i8SaYHeHtz6w3uAZkCM0cC3OjBwFG+XKTUGuSNgO8vt78vq1",
+	"Bpx4BGuP9aOybw4IqYCK87A8vq0+RnE9wPFqlTKJoI6UcXJHJLxVW8qmoYI+neGI7njmoYwFXevhHtSK",
+	"GFQAb4I3BBj7EUF9M0/jKfwWpUH8BmhoLH4eoZD3eV/QMuDjBPRx6PpFg8zuaETmCdOZ+rAkwoRrnr6Y",
+	"gsQobTVuhVWZmbez93U/BMG7j5enCQn2ydckcvcTd0KheHR9D+kUOgjkd9IUmOhbibEYvFqMp2MvudAe",
+	"LSP9lQvJin1znqbeaEolBfRD3iHjA+3hMUrCSKcn3ShikVAlEBXCjDXZBHJw2orNneNWumrTvB+9YFxL",
+	"3hwLXdlB9L40Mix09Pwi6ImJk2uWUj5E5t3xGDjGwNfn147LvvOBcbiI0SvdkJk7hothFM4O1izB+CSf",
+	"lBHy4AHcHv7o+o6YxnGHYZqoMCpQZbQozp56SslOKbl4QZIqMToG5IruN4u5YXOxy5omY51tKXDAWuO5",
+	"DSTVBa5P9BBSinPxewZnEbWGc1YRzxmHZFwqQe4IGVDAWJkIviiSpKsynZDft4Nef4/K97ubq4+9S73s",
+	"5SNceDqhO3cnXiDvA1W4vJYtgXjhY0zxGcGpYW9/EGKxfNQpgA7k1op1wgJhme/716faFb5N/QdmAejh",
+	"oWU8YNiRZg2tZshauwmbATfulB6QFgCdj/MgNT78igK7yWFotSCEkC4pDEZpFJFgtLjwZl4ygHMTrmoL",
+	"datOu5envYu788u76/7V+35vMACIzvpX13eXvc+9wQ389o/b3m0v+/V9/+r2+g7+uTyDf9+e66mYbYbQ",
+	"ZMwYZRrRueGEHadRZlLMBA87S0BPp4d/mdXtVYYQ0BJ4fkdMRBGqV0+77Ei75+fb2rVT0EqpglpWT53z",
+	"e2oeiknSUdY/BblLxTBqhWyUDWmyFCE6vam4y1zOlLY5ETeU8upzeKyW2GwUMxynURh85qrxDVOMjYTn",
+	"yvNZPcNLA4/QFH07p+yDDWCjvRlyzjEa7gHv7Lcjncl+BOD0vs5RPeHiukQW2EToVmXlJ5iniRaqmRfH",
+	"ZPwO8HId+t5oUS8ZM8TEn4qdYcDwkUS+O19itKtcTyQkuC7+G3oYNLbuZdcRTRSORkTgFZQjixqqgE1T",
+	"arX3gg5lOy5pne6MRN7IPbwkT3e/ASD1lMNQ2dHturIJpS37Ikmr+lzQE1O9QihkCZXaWmVQPxYVjXYD",
+	"POjMVdgfPhi7G7DIrFpS1WGYGVwOFCOlEUVJOPdG3cgkBGYuEIQjtGwHt8P5S7d/+Veh1sE0Dh1jFWkv",
+	"L4jAuP913AFm/q+T1z+Xb4oSWLOsYW8XXR9W2MMr0PsoTOfmYw6bxLozxQctD9fIWggLeRTvWZuPl1j+",
+	"2HskHTpjee0c1LqV19gG2OCWN0Y48NjdfC17K9aFuq5fe+Ngq/lEZkM4LLC9Fh97fLA6rBjxYWfxYo9a",
+	"68ACXUbspxODygFf1j9phz/cUmH6zWDnp0DV4hGQbcRl5VN4N/cULu6EMNw6tEQmMF5XKInFHV11ZgNq",
+	"O3uAD9QCYFaDVqk0kIbP4SIHlJWmyDbkWo62DvlDxS9dhrBk8ktVeRnS1CnMbPx3xw3GTpQGuXW6EbVT",
+	"AJJHzM5LVWfcPfiVqc655vO5v0DpA+dftJBD51BTa12rV5zzm2Um/c9kOA3Dh0E6lEgwy1fT099n/vTH",
+	"brYUI2Pig7yPMsP3E5vIiZWZDpwzcu+mfsJeuaNUJVvlSZCOi/YPw37R7/zBFAbic9N9ILN5suhwZGft",
+	"UNeTIFqTpQZZPQFaeVts2VWLmU2wb0xGETHYtNg3Tu8cM7DtdC/RL4D7schrIVDGozdGAncdYPRxOJND",
+	"eL7vDIkDMBK8+lP0qvaFkzXIRODlVydsUYmbwIFuoAxkVtFCIQ1BqcBoBSrhf+UEw7rmCXoVaumnwYAO",
+	"qaOWNDIoL/S92IHPfHsMbLYWmoFZOrDqKIlxu/9LPFU3lZvNUZ3JWCOy1yIWEct6cahcMG1vW9lfr5XW",
+	"ORcZ87W+jD9+0XeCFDVDlA/s0u3co72K7z49b4CscX+o/Djt3px+uLu9dubsPmwyC2i1ekWml+WuNAY0",
+	"WucKLxzQdRrmrNdnvXfd24sbZsDWGv3WbpcITLaRANgHm3YN24ffxaM+ty7gMZOi7YxuofUz9zpNI+zm",
+	"NNbf6TZjNwGmvr05RZfHNIj1PpWZLNECJj4b7VSiwa9wbQUYtMOY314kUnQDFWbPwco5KeMbSbMaQiyw",
+	"fHFba6XQDjzG5KWilRNZNX+VCO0DiHxVvD2B2BdiD/7iM2YC3cELmGdMGriPcDtG9FNZOAXtA3biwBl8",
+	"PL92xoCrOCc2O867837v7urytIdyk5mvZ2GcgNY+Qn1QaUwVfClPaet0jtyrbqTQ2wxjUG8WIb4QJvhV",
+	"QgA/i+ENLxgqL0djEr1dvBPux2LQQNgNSclFxzRSUZ6U98BlCABe5ghVsA+c/uiFoAjhucNXL8RbnKC+",
+	"Bx8CmPjA6V5cXH12mAYBY6LXLHzr0M25O39317+9vDy/fM/3iblQR6Tj8Ceh637v1/Or24HDXEfi8vS4",
+	"Q+xx6Oqyl00Uw+w+OxhD6Fnud+8FXjzN7w4FFn4vAEc3KQeOfJCCObXYPiPu+IIk6NrSxFlOukRxf3jm",
+	"PwBiQl6bxjCw49ORG7hJERE2oLkrUQ91UNTTKMhu59TdzIVZQDvDqWEFI/RwUGA7sHW4y/sLKAvIwW+O",
+	"OuDz1bsi8IYdLjngOhIk3j3eWhLFj6AK/Lm78EN3fI2EQp50hp05+1SY0uEdQbiAEhSMXO7hdXx0dIT+",
+	"xZE7EhtWmhMu16nFrVDMRJuXaAS9rMw+JrC70eI0TE0ODJl+iQQUa0aHoXIORcr2qAqFxnuDfi0s4mDV",
+	"B+g6b0WGU5V0Snubw4ru6M1YeAfOXUWeaN0gqNlhm29CKz3prHQnSGTARr3FsJ4+z8/yJuhioA4P4zEu",
+	"ROiCeJNPZzM3qlXP6VZ9LnerUE/Zm5dcyBex4Weuzhm7yXOd85e/D64uQeonJP5r/eObfHaj039cjQbE",
+	"GDvAXnI5ZubaFSgrQOT64RnslnQYlV67MYaF4FZp9RW1f0m/rFYsadcBcaPRVHszM9F7CZf3oMWTcd0J",
+	"xVpRXdxSf5iTYIyw1AzMmzUZmR4ztRCzVk3G5dpz3cC8WZOR43Q0ImRcD7RsaD+6pMO4yovOYK2Prc2o",
+	"Bi5Y4UwxC17FNe/v4bDhKyCVuOV3wH+Fw4MNhTKUPb4SMreXLwNorUNsrckoTA2KJf9Yt/THVY02j4qx",
+	"Rjzx0qXrFDvYSRBDmnsZvWL64mZmd7OSnWRktrlJn7ixwezKb6ONpv4Xo8iqHUWiZS0Nu7eS93mc+nrX",
+	"MHoLb7YY9rJisZ7ckwluMvyhGYnj5jen8tEDiapZoMlyFbWx9tlIaZrvubqRkw0iCETugplrBnKbhHJw",
+	"3bs8YzaSzFoyuD097fXOemdo6uqeX9AfmAkFf9ZpEahe6cNNbV1Ji101W8wnoX6isdlRdLvu7SJ0TqvX",
+	"IcR557b4meHNQ1P7yqbAxifSERddpu+OHvgb6bMvUoFlXUvEYIqANDIH3lC7KmHe5yhPxEHqhxNMfUGa",
+	"BEqyBBvaOXC4KrPYzGg8yHqzFhpbQQFbqpkmy/oxU8NXOKouQPvy829/b29RvJxfvruC/33u9i/hf71+",
+	"/6qvlynKOPJSY7X/OQh0goR/f/47oSArvfRgH1e4F+ZHaHgz5J0r7oYaBKjBLMAcNHQkuZtT2j1hb678",
+	"t1fwWzqjv8ToC49XvTxn5TrrIq55C2fOqFBOfGJ1maKwwBCxzrbugsI/d+Hu57AW0iBCX4VxQnqFo0pU",
+	"3HHCwF9gbAXzgAjG89BDfwhmyeZJIMRIOYGjfZWuWLKcXl3vK7v1ZtjWhq+HieurF2psSteD/sXM/yRL",
+	"OnRkc6PUyNHrNJqQzBwamyODTV4p8EHzGEF9VOY4uOqW4vp+vhHPWMGs8PjcSLsY/FIq3jTcr+esOT4R",
+	"6Hi3vE6TujKyMewzMEvPRxqM54Q1s47DX/+BC/6E7wAjzeEM81zbWVkokoSt5cBEZv+wMqywsTxlR4wD",
+	"9u0sKmxE8XhZj58M1NwsHRUhOmWgD1JT+v3kUWllWEc/OuAqGEB7XuPrXZ/ce77BgYy+7knHlGww/tiD",
+	"HRlJbyAlB53oV9c3vXmVfZ54hGvs0CxG3C7Pd/3JC8bMWbbpw5Sd4b8G0Y/mdYijRbMOGhBuuQj2zeBe",
+	"R7+JZztkhMwnJ0Mzy7cDmzPSpgTQhtcoV0Vlv8R6JVQ5Svui0vUOaEYZj2l1I/l5Be2oOEZJP2LYFFhT",
+	"UKkdjbqODBSThi4LhImeeRy8p0/7sJRtaxmj1AoGpY1ZjThKM7NRyYZS40la4BG5ER3VvMJhKY6uFf8E",
+	"f/pxMpv0ydx3F5tS2iI6uqq1YavQH2MCjmrljXVFr3Xm1oXK2PpVOc3yV9LlBNTLa3MMou8qzwBbkmIs",
+	"NRNZjkGfd31K89ca4smvtwC3adUm2lK625+iBeuzLXwCugjFLpW+FXJOH2GujZ7GUQsWSM2AcO9Mbk3R",
+	"E7f9C2T1GNRzGtDLjVCxNmJiHa4qphM7DTw0D0g3tcxEwDVSngqOxR2rGRSHxA+DiYC4Pgxic2HPds8N",
+	"laHMA8DfOPWJQmmrJkwwJy3gqcrsdYwmMfzZ4F+UdY3X9WzCfU7xh8Hph97ZrektRc682fiVZ4wGqdT2",
+	"Sqv/xPrVvvE1pY31RS0AiZyqzwCNHxEZANs+vRQAbJY4sNLWP5c6PGd4R0YUkv6qhNh4l4I1NHLAKmLD",
+	"yEGN4rDKo5huySqOq18UBrCu+TSMyMAPkzVfkXPXT70rC7MJxTA3tZTxHvaPcEteV7mXg2lZ+JmGNHhj",
+	"O3VAdVeoXyiGc/Au9istiSZzcKg96AUGz9DSUa/kRd8G4dOA5KM+65bvXlM3CIhvgpd/xngDrakwxsFF",
+	"8LbeCMNGMOexFFNQv/8lJ1lJXXVnZj9+d7bC0rG7ed108FUWvROKtp0qLBAh0Z2ni45ChtqDBn30KhLE",
+	"aojO88cRybvS1NyzN+QxNnejUi7FWkgwsgVj+0ybK74rcUAoGGrJZCVHRsMMZgpQVpEjB+F4JbN74vNs",
+	"xdZvwHGxm/TmYe59Xo0TX497IyXCzyb7Qy0N5LrHMpCpDC4xQrmMLTvrU4Gh4l0z559p4d7HvVFl+/Wz",
+	"HdCtCcQlOZIaULv3PLrRDplrdxdlXSp2ZgVty9ZTGtuaxImFrGmyYtmlYsUsrfrylyNJgXJllS6hHHXd",
+	"CPjzkbxIudT80r1TIibEG5W+UwXX58NBtYyzGX5UrjHbYYmKG4OCBIFH/e3TRO+7cMHPM6D2nZu3MQSI",
+	"jsxUYLaujvUdFBdTDckJHrRYD3+Xoj1omirMPZUsmvQeiD5WdPfOi2LowpRke9q7cJv2aui8z24ZOQAL",
+	"M0vMKmhS/WpHxvBmFVu7Q8oVIY4a4lBsSP0eM47fXV7dfb7qf6Q57uUf+92b3t3F+afzm8x4fn75/u7m",
+	"/BN8vbqldqzB4Pz9JTOv33T7N/Sn7unHy6vPF72z98wqf355PviQN9D3ezf939QsEezPODQMfNfvvev3",
+	"eJ9+T5lEnXtwcYUtL+C7HPMcvr797Y6n68c1vbu4+ow5Ke5Y9vOPvd/u1CcDQxMOqNacpuMYBamKozVf",
+	"YP/85vy0e1E1WtVbB//pjqHhU++ygPgGbyH8Z2ytAyYr61csOAg/s0yrPUM+XJG9MAkd2lpYCWa0V6xP",
+	"SegGrr9IvFF8NU+u0qRi1MzsgLnTwznaOvjVUg6in2PjxY5MWVhXTuNaX5XImJFVm+N4u8mNNxRbas5x",
+	"rF3zDghp/V7ocrFOwn1Gcnt9+uzwLb8qwPKAJPi/eHssylJ09bDYA0xMg64oMNXjs15sGvTHxwQ9NH6M",
+	"OhC5c4DdBfUrmLDiZW4hW2dpfpGjmREJ9R5cEgq2ZFEOpgwPdTesxIVikXkHiE4jYgEKdZxQAVEN+TGN",
+	"z9fPib6idHzzI0vmmOwGfGfpQwtPIWHpguh+FUT2jtoqgtHC6Gvs3IsmjivSnQqqWq993SwJtACb5cK5",
+	"dAzcTLrzb7JGXOUDkShTyEvUbrN033I51S1TFZkeOcRnM9ZYi6pnDjpCroLLEidmLhl8tldqgpga2tmZ",
+	"o4STcrMThO2p5i5Li53jXtenCuOF0RGFuNssoTFPscYOEZFFTKTyjpVvoqWax7uQa732FfbZyN8+cxIK",
+	"irrWt9CGZ2dPh743qiJcOl5FEQMV5p0hUU5ty5Bon++TuAddfb5kpdfOPp1j5Oqn3qe3vX7F9UVJeK8M",
+	"kxXe5LU1sz88RchO+tqc5mqc/At6kmY1PHlL/ps6MCvcKWfGX8Vn+jAgv8ZcxSv9zpqb110dbMYmMTue",
+	"6WxTZUdADFaso4AcHIr5pmruJuMVPV4lAgTHq9surRq9X9m9Wb3v07v51aXiGliB3pzyqdO/3WhWEaFF",
+	"vzs0qEV/UrJYMpCRT25E096UtFLWWx/x1Cx4TR+3tp5QNDa2eYkHhnSVq6RUsapQWSASu0C0ug1rHn8G",
+	"K4WzkEehCYWGjeX8xTsgB86xM3YXHfjfEyEP+P9ZGCTTvy7pOyHRo41KM58oAlFZuts8wbOLUpXtQNYd",
+	"ZU012luDEyXPfnVO9Ry4itXxU2Yz9Wm2YXYyzrw79WW2VCVGiV/abo2YskFOxX1+8dWkqFfcllC8pNZa",
+	"q3YZ4eEW6Y2rE/TgZorWFqIYjIExt/NxwwKUq1SWbOs/5us/Ot2A8a/DeA7Zm/Bro5wMePj25lR33Zf7",
+	"9yMW9VNXXhMWu5Z6esYrqAoI72/knSXNDWhdpBaHvL2hXCWM/f0/4lxvzElAaEZ4O4/UyhWaJdQLfiVr",
+	"zfzPa+bfoPl9IyW1rR9Ba7mprReZn/l7KBepVpjbUrVIqUfQE5xJe6ZGiIZc9qyg+xsI+XmrP6611mMe",
+	"i/zPcanw43dW69HEeqtXRTTjU1ci8bssiiiQu9maiM/J/tScb87UEF+7QCLjarWNvQmgojqnranYG7kB",
+	"Vgl1RyMyT0QVKK3SooUu1r331b53wz0br3Lqu3dOaRYPqeXnb/zwwY2nuqMa9NGpOiQo6Pnp+E2L2Vev",
+	"Fz4c6QOewPEU+NI44a9wobz36tBLX+9RJ37kzfGvXpSHQS9Kodc1XD1gg2zncGEPWQfMTblFr7SxF+Nz",
+	"VE5Wiv1r/FCex+4XA4HB3gQTIhBkLqJOnsxIZFUfnzKsCUOxHvYlLthiZKZQVQIigajE32owlFIh8y+d",
+	"HJ5MKL8IJ15QbdpYP38vsWBh0NhBjIs1zutw3ScTOE8qpPsuottOiTIIhh3cLW5bt9401RAVT715/FLd",
+	"IkpuIls8zTdxyrDJdNv268kp9au4ceMHNQVZfvYB8YnwKUqwJSsqih07DvHoSQw3X6A1EmFlNjQl0r9g",
+	"WU4fayc6va/uKPEXDpqV0czLm4LiSTUu1syZpaBEDgk9vp2u+CvM5MzwioBWF1q3FHPXHTFQcPWFvcnG",
+	"NuxQBqfcoMKyYHZlKtoQoRgyPqyp7N08q1pFSj6MuvOTetemX09wD9+xtlq9tLDXpsRrK6BPqYEr4xnX",
+	"iKjq9HoK2FWUbsi5Zyb4vCVxJwg/B9KaGMCwzJfICKoF2YIf7PIRrgGpO84eYcCcb0aLj2RxK4IjLfN9",
+	"j7LemPvbXDu2Nk+6KkrQHvLkemidp5cTNzcPzfgj7iz5SZuXp1OnnbqPBEicBJm37TIzx26CBv26OzKm",
+	"SsfraxDi+wIpTWQyPJQTYUcyo/v/ijzvGQy1uz5IsOEkF1N3iSWjO3ssPg/+uTyDf99Sf1BeZPr88u66",
+	"f/W+3xsMsj9e9j73BtTrr3f+/sMNxjQqfXW+flpIDESYPXrrNxUgUB/GhYWNvY3HwqhboFj6VkepoFGp",
+	"6Cwxe27b+AIs6xzC9Fb1jse0MsUoKcIeM+uNqLAIdMmrOEoNSTtnbEzdHwt3O14nvjicleqtFym6cDX3",
+	"q9LSNtG+yrB4JKFZWOIFT1xq5XXhq5FBNyGQtiuEDIJHJvpY8Uihwei5lEaaJpnUsKYGKWpKWSnXlOOx",
+	"NsFjLjXj2qt755IyGhI2qjkdlfwlHDEdVcqVWEQr6qUU4cxtLfApY67BE65ChtepJ8ZCZL+enLmTUyUr",
+	"WDELniZfWP01SVaxLgujsTuxzbKvAVbWWOj6kzACGTpTT9N35/8NR+Hn88uzq88YU3/1sXd59/b29GOP",
+	"hdWf03Bz/l1/SsoJDGejq85bjQgNrOj08uh6PvJ7naxKAy9R5W8meIfULjHWy6ZhGsWJrYwvzuGORuks",
+	"9bHMiRfgcY1OL84wHT0QgygcLwJ3xiw4Zg2sUFOG51UBbSycwSR8CLVV/kRRnhd2qh6OZVwBDHcb20S5",
+	"0ihKtiXC90KZHK81YtvXE9GgpwPqUTQeZ6/zChBAFPTEr6pRgznrGHpNK6ZFx4orrpj2wLmE64Aoh6bS",
+	"pArzfer7DVKSPkslnUx6FIIWGNeq0kFfVidjuBxhfakWlhUH0FaNxSXxup5imjAsDVdbwsjK4ty+OyOr",
+	"KILyPRpZc3u9WSOrKK2yO0akUoWPd3CGIi6MD3m4qJ4ctxYZKi4ySioZ2+jZvWrK+MLqC5A2RsDmaAGT",
+	"XuOi3YmLjv/U2wdvqPIST7GVHRI5TDGtgdfo4WjbVWpa0mL//FJ0Kxb7lytVrS32TSoIrddiv3FZC5f/",
+	"B28+N9mjzrrvtcCNwtQfO+iuNtQDWY35AZuzSeEkFa8Z0HoO1gxfsU/W0l9FxOqFQbJ0j8UrouZgMeG7",
+	"5gU+W6OcT48xPCtsg/AKzn6yjSxwnoX+4gEA4GsqnoPKzhwLLht5HmhGzDAqAxn0w/GvxaE6eJWZwWHm",
+	"xWQUBmOD5ZrmtP1UVSGethClsoqzOH+Rwd/4HJDg3/5aX1BSn1kIjtrZPD+86GZ/15Ohk5qgLPxUtYtr",
+	"zrldhoB9WxqJFnVKNDjcUK0SbvzL3LXhN4uKu/k3ITWwFvvD2dNwhahxebyb/RKbFQjO10PeboElK/tp",
+	"Lr2s9QUgW6YqlA/WrOkrXrkMdEYnpQ1XyqgqiR9UcVp4CsiinxkpGvIcFyVc2QNbxnJYUXwu9GOZhMgV",
+	"6bkRJWf5A0TbxpIoJLs0S1Ms8sg3K3pS2Fw5tYrgDDHm83pX0hKrRKXNSlwkh82nJG6Yg1iMlcs9XMw3",
+	"rE9WXMxBPOhd3tzdqIuRa7jr9ftX/XLC5FOY9qZQu/Dj+fU1n+H6ovsb/3HQu8Gp2N8MTzWKqWht+tzD",
+	"/iNc4zxWSpjfOYdws+VCEcnf+eOBLN7QLDN/NMoaW6kGduESGZN9PLaCGMB6JDQKjOUOUJWDnK6oAmks",
+	"T3AaBonrBfESk+KEOWUvtpuyD0Lvq26+iExS341U9xF6ncfHdzSu4FNIzaQHzlXgL5x4Aav66sRTN2Jx",
+	"oddXg/P/phaEfu9EM1GMBvuYhd5gUH0MY/ruJO44fhg+uFGYgkbccYbu6AFEAMFHVsIsErAGFwMKQ/6g",
+	"Bbf933+HEX7/PcZ/nvCfAP+JaPPff094ICdKMaKvL0dPKka9mo3hH0SJaooS2qNAiXM3mXJSxLDsCG0Q",
+	"yRQX6jrjMNmPydyl3kky/wO+XB84Xd/nCI2ZoYVuwoFTmDem95HI4bk45kgWOAEsO07RBhM7f7hvjv+g",
+	"y/7DPRi+OfmjU1q7PYewY7ARVliX7xstsReMTDcxN/I9WtkcX8sKvNlEtSeVWUPUcFB1hiYuAeYgziDx",
+	"jE+xyWqLU3UXffQlBieOjYOvqqjXhH1Sy5gJp5u0DTOSMmtc16GHUR0YYFo+XLmiodVys0z9+lRJHjv8",
+	"lii2zBtpSgFYLUOT64kVoWqq/qmosYqmZd1o2inFf+fMcydBGHtxnf+lxm6CDgiy5mnVuz5rWvZ4rfet",
+	"9eJ3tG9NUhYUwdPQR9N9le+cjQeHxl9UYx3I2cYLXo06R70VnefEAOebc08tVSWSM5rd1Zg3gdyjPE2U",
+	"UPOlmigrKHGUR3ADLjGTu66GnPAKiJeZRfoUVM9BjcKxsWwkfsxKfzLCduPgP5KCjyjmo+EHx0GzEzCP",
+	"7KyikS61INd/mo7OQvYr8FB0C+RIySbNbUYnRwC2ZJSV48kT08xkUu4603TmBvuyeCfQuu8GORs2g/Rg",
+	"r7NsNSmVYDjqtciwMkiY6K58D13G2w81xczbTw6RuUF5Je+wcUgorZKvoLEZzPpfR4SM6yS66uwVOyBy",
+	"0JaBCq7kCuq1NyT4N8EUlZK+HIG5fgcxa32QDmW3F3lZAIwQpzOifTLRunqxmRS011FTqYjV5dXNnbST",
+	"fO6e36BB6N1V/47ad9CccnV5etvv9y5Pf2N2F2ZR4Smfc7YjaXSiA3RPb85Zeuib89OPv4lPt5fdX0Gj",
+	"6r69wJgU+H/vYnCHUHzq3pwyMxCMI9vcod1okDP8sCpZ1LJERzEbbrTiysw+dtoORvcw/Ybf1DI5reUH",
+	"Px2rBSEbig+aQqM70r/DaXKgTKkjCIu7Z4k6KGn9R8xL0eu5KA24geTCHRLfeH4x8nPce3wew3QxtHXB",
+	"JwWAEIKC3WwbXUOr7MCrvAbIcVWEdop7X8aEmaH6aWC6u4wqC102fuMqKW/8ucJcaa8AYdNjPluaZody",
+	"sCmSREqRrKLa6dWn64veTamQWkV9uLxrf/uE/iM9oe/S47ehVPQuPn4v8e4q7rurWn/ap/dne3rf1ms3",
+	"56wSqX0pCuvNPp3qSmUwszF1om1oJjZFji0ZGVDpGbfRIytXTdxaOCnxUfb8056OO+5gZkZ5Ri/tEdv8",
+	"iP1eXbZy0dNVK5MPSY+sh10a+KrTadkzSHW9UoTuGQzPM8YXHl/SoJHfOqWSqVvvkqX0GWD7d2GkgUco",
+	"aDTVav0ppma21ThIr+49xMCJ1xVnXTRtUiiVBQtcimlL+/aSHGva9/GV3sdf7ku1Qq/PpeLmQkbsd7xJ",
+	"PIidqstTZ5+xHNI6+0ySYCZpw3rEVzUPdSISqlO9LozGDZ68CuB02fA68rb2ta3Lub5K6mG4iHMQu/o6",
+	"JMyezDGzYCGB9JliKHGHTmXevcNfTkgwBvK214Dm7sIPXcOB//fB1aUzDMcLbtCFCWQa8wOD2mahTxU2",
+	"yWDUVHMoK267UlEQkHcyErMgUEERJjqtTeWTEa0SkekmzrH+0lFxHZPby8ejl7CZOyYNbmD8RvRJw14f",
+	"QDr4IX9Ci1iAJbBZ+NDkulR1TxL5O2h+dXUdKK/zlVnUV1Mm5t4CVekHp0jN3l9Zc0qFlQMyKjoNx8Q4",
+	"LHx3MIFucXDKP242FwudHRHvUZ/tpECognLUzc5tjQVZ7oCXeVGSWzkZ6VlZeQO47l2eMdN/pQdVtp8a",
+	"cfsiyn5kb+iZiFSqKRZLfpRk6Ubqf2y8aqZd6RA8GQgwh0G6sm8cgxwpHi8yxd1fMOSde2BD0zSifxPy",
+	"UwcE4lcxaDVQr0u1SCpKedBaGblN1tUhKVDFy6lKskyhoaXwlFUnMmJq3aVFEVsdKUhyvK5QR33R0UqW",
+	"LFTOFoWy79Rn0NwHGUyTb66G03QHH3P96R9kP/a52D7zybAUuWtIG6cT5MtWUq1kBQXJKl4kSjJsVCye",
+	"+YTovMe2JEFLPiOK95CJsUVfaNCoJonCAhUkzVBySoWouRLJuhZpcUCIZGu83AEeNShUMGgfgHr0xngV",
+	"Aj3ODcbhTJ4qeGWC29KEBCQSx4FqGjjZGMabo3m8mwS43N5sm5QlnLXIRulmTjDyHFo3Fz9NdO6aOllc",
+	"+blzDftGjXcYKZSlHOR3xKXeAmFLpuG40Wo56J9YT6mTma9wH25urqvucRZ3NQUrEubcxF8sEV5NQhyV",
+	"dUY8TvOidVPNME8BS9POJ7l14hh9TxOXXl/RXN/XqDVgV8MJybwITfY99hG2CfRx7guIaYugP9JVM09A",
+	"6ZqHCYLqDDUse1JhWvKVjNKE5BJKGxKMevGcuv1po7iSXGFl6aSfdaI2ltvb8zOHs8/23wD9CsdNvnjm",
+	"rklZiqhPe00Mrkyg4ji6LcNkkR+IGyVD4Luq19zcVtHcpDEC6DpT0Tv/jnpydHKyfwz/vbo5fv3m6Oc3",
+	"P/1y8Msvv7x6/cv+Efx+1ChPKjIzqgc9wMTQp6/wOwgp7L+Z8MtJdldkgM3rHWZ9A41vQTLALOWpOXYG",
+	"2ziYYpsXgw6jZQi4n59LF8KDL2Ezch7ch3bc0Fc64LFmdiKPodd8GkbMf5wz4pILGYixmOOy7sFS3peM",
+	"5tHS3ogjAX33f0X//PNL+eN193ZguFUlC7sXcxIJ0xU/DI0xs/ysZBK1AGS9gwPrfVunfd72LzTDN1VG",
+	"aXutIqEIS/saLEpUIpXX604T9WhO1Uw/1U1uxgcuqQIPO2DzNqndEsh+nvnzsPpuMEm5N5m1WBicfYzZ",
+	"wcM6c6cbfYIevWLEJVIPiwDqqzeMH8zDlhZHIVLVv6uLLjXZX/9284EG7Nz8dt0bnPbPrw0GpIyTlWEG",
+	"vYt3H0CHpEaYT93LLsvG8rn39sPV1UfjQGhsa1i6nt5nysXrhelOnwnDuo4w80MQlYT1LwX/CocGwYpf",
+	"dABZ0effw+HzmPWrMCeqTmrUI/iy9FrF3t+4WuWfe5o1423FqU0goJGZ2CS8cNxCFHieXCckUb6/j8J0",
+	"rnHkCfj9iJvOJ4RnulWDuifYVx5KigPHgTH3/sAy/FyB8CLXr7mymemT5Zy4aij6q5P6O7qYuriajhar",
+	"VVt0fqbznpIAnp9pcSh6f/SC3K343e2lCGA8u+3zcMWz7vtKSYaDiIOuEdnS2TV8IL7rT88VJMPWD14q",
+	"6O2sFry1MaUGZZKPleW2kjBxfR3FSh6jyXi0dyExPJKlVUUveSFxnXhORt69N8omcf7CMjY7j57ITv1X",
+	"PVcYEdEgrCD767XSOolSUh/vVumfL2+4uRzROgeSGg/5hs7ujRYEp68QY7YHrtY3rrMaZ6FgZDebbVuB",
+	"2Nz8evs8IOR8+dfpl6+6XGud88uqS+RNJvi0/HbRYPAbpVfZW76hHmL0t7e+VSke8vqiY8KTXgH7S7Uw",
+	"2ZGrWJVrahX4V+gj+nZxBsiScfHScDE4xWMari+V53Q2yjuP+LlzX/Xqymg5J8UUyVgzyUDEErSyu5Xd",
+	"rex+LtltmOM7FO0VwUhLiGY6GtYGMYc3Ge4r9Z2NVSB7+Yofm6rTUY6cX0MwvEEcV9cg6miXrgxYt+cV",
+	"brhZLg6NQ24+KQfP31F3otHJlrrjVrozGphY4+gQBteKlC7Big0GmMAl9SvSrhs6r3x0KMtoJAxqtjhm",
+	"Re6N7h9P6rQbZBtNUh1l2rpFGC/0NJFNEzoSQ52yjnUaY6F5o1yjgpe0HznPaL8J1muewbRqNWgv1eDP",
+	"NwVvNDWUr2wx1nvFMQirCIRz/WmEt4p7PeNreZYx3p1nYLe6Can7rnZGKijuTAnmVpw21q+w+TFdwJsp",
+	"9m3pgSV+1qtpM91Hj75MHbrj7wDN0Xw7H1c5sq7zPagKDEW1rEx6arMh6hME3vDIvZv6yXXkYbXXhYn9",
+	"aSNnzlvpGLjWYp89eD3TMxaNCs0dZmZQY37238CFJTSUO4kTb/RgDEjDbzK7rt0bmcLTDVgrVl66DC/y",
+	"7KMVEE/Ka6qtMb7ygmS+uAiYxc7kBvpSzw50X9f5mtGEQH4ohLO3+uwZI49xzGWJ/kOn5nyFoC7WtHhq",
+	"pvKakhYyx/MUhRSq7zMG4ZDAMRt1U9RP/6S8TWNH2J+zTcGYJ6r8h+GDR0RzD3eV/Uk88UJT6rKZZH3d",
+	"ufeRcC8Qjzt+aLyRWTcHiI9WGUqoWSb/V0lZe8cHRwdHlDDncM7NPfjTqwP4Iw1kTqZ0aYfw90OMfeIv",
+	"yOV534sXYmwVkDh2pEkAd5Ha9xDlexf8+3u6LuEgTWc5OTrSBAsT10+mVCq/1n3HFMBiztzOwAbCzsUi",
+	"OyNCmDUUvgL/5OMDZkYPe1+wP10rZl5e1C8Wm3lVq+2LButcLgWO5t0ejQhGNUeY5XRUu3oJbe3yH48P",
+	"XZ/QCPJ9kAuev0/fCOPDP+mf1b99YzD6JNHo4mf075gLn+lQDu3u0O7s2bGEsS626GED+orORqC0GAFT",
+	"sDoo/9Q+oxpmcDxWCA2aIT1n3FVayp7K/cz0y+Ti6glev5T2/idNSeEU9jOO71PfXzgMpWMlXZMGebBf",
+	"PzEqAR0t4aXd3Pnc90YUo4f/4pl8s3XUnFY9NK/HTMIU3RNmro9YIKyCsTsW4QEMjFdrB0MHxbswGnrj",
+	"MWG6bEbfjE6qyExQ/A1tglL9637Ez2b6gfXFrA0lwvhCL1EgP8ubxpT3VUicjfB9kDilB5FBYS3EwLDD",
+	"Nq2AOBlfUiaTSmyB5EwFzvPY+KYX0WtZiHYJOthzYoAB2ooBSzHAqGVzYkA9IOfefhI+kABPRfEzPQ3n",
+	"oa5gfJ88QgvHDVADc2hr7ogjZyyIibl3g62EeQC720gJObxBJghYd+q4i+jyOJ1T6L5voo6bUDUnHdzY",
+	"G75zgoyzv1VRstzyHAWP/DAdH6pXWbO2W0r1Kq4TdBBMcZyg2b9ExKf4WXgOmJXgzeOWAuKkQVaAZFcI",
+	"rEZrZwhWn2L51n9SHmS+7osh9sM582PgJ5qy38y4evgn/f+3qv1GKUVbHZQ2lNpY2UbWSiKWVMaknNCv",
+	"WxVC69tsnrSy5vCO8CkHljnOMsc5dMda2ZYjcQUzGXkzFFdINUY/X8wUflgn1lgKOyHVamj+TAqwH53u",
+	"zygJt7S/W7Q/I0uf4cbTe3sHN7OON6IpeSS+kIN8HUc4jnFIDdpsl2LjjqPbC1yAfCfX2rTB2Po833Bj",
+	"u41z8R1Xpmy4+SJTRW51u0QIcuvpRhQ2obz/uU0OAy8JUZof/sk4/tvhPAqHxHy5FK90vBQjfQhOQofa",
+	"dVmJ5lwUtZnh5dTXME8/Da7pvPa2KdOhJyXXlk+9CoLiGQcYPVH8Hmz1VEBTvpsmU0D3v1kOSJ57hOVG",
+	"YAF4JTMneiRCa2a3d+j2OO+4PD/PtlV/cOTILPbd0cPhn/R/FlZ8Z4ANRUB6iXLo1yzppqXRPjemkXgo",
+	"iDtpnc/jZJdUm+PtgHEbZCTMJn69nYlZbiCaYg1OufAJp9e9CBSpVohe+vcqFYsRXZ5j0NYH/1hxy+VA",
+	"lfplfgniBmySH8zMKPzk3jk2KSCjZZQdZJQSwUpWuRxUMkoQa9hEKC6KtUmvuuC84kpcYpHGb2PPpn90",
+	"zIYAXpx8GUuAAsPJ69c5II7XoQOB2oO/YM7i9gzbGdY0XSK9ZJoOHQBGUHv5WGNtCvyYkPk+Bn7D4cV/",
+	"/HboRqOp90jqLpC8lQgZ5zmtyqzKQsHo1U4MbMG0Yjzzgcbh3Tbj8oB50MnjB28uYAPSjBYZcOH9fUwN",
+	"IxpQQJL+/JM2dr56OlZpfLgwTEk/N5xxk/ZAvu98z2mozRKGwfgHNwrirD9tZ9Yc12E+VRQ+92EajHVm",
+	"ixz7K8wvNQP8E4a2VqkHgoXrZVLm/W+WSEqGdjt5JAt8tdLoB5FGdMdbWfSdySKF8TcvifxwUi2HYgea",
+	"AH8EJd2o/Hx4EU4uoCGlyFYM7YYY6phrF/pAaX65tp9uYtoyN3PlwwenA+zFcnkYVh4TPHgdOpsCB6zK",
+	"AAjr0BSQAeulAeLz1KXFsmgEh3n9oZqXpOHkuZwmBjyw6ccyeUolFGdKs2Ugyfpv9pBSpUHd+YQk2R5O",
+	"htdzeipIKaycBYDh5scA+xyb7VSsDga+sAXkyeSzybxKWdO9zThEs8HZRHYe0PgQqEK0TX/nWhLnOYwU",
+	"B+fWnVmSONvrjNjqnJd1FC1NsSxxVkUQA/WA+gpchYUjKwn85ZhltxCVYMeEWTTjs8YftPy4tvCCBsEE",
+	"lXypD7WrduVys2LshlCHuC7syPY6sqOOHZuLyVnCcmDehJZ3cupaFbXaM1OngYrWPB5Pam8/6uGmapjr",
+	"C7mzVkGPnznkrnwCtiF3tjrqSiF3dqfkYUwS/H9cH54vujiiS3XAnUIu0HjA+1j6/P8gx6SCmBXOSHVP",
+	"WlbKeYkb0bQ2PpJxq9UPbTKMNLYLU231SenaTvERZwmnG/GJLFvd2voKyqOMdY2bBcDWKYxLxGS3OiJF",
+	"gKB1RS3cpAmjOGnLX+viL84IS0aY1xw46dhL9i1eVKnKho2pVR8WFaG1LLyX7NhxZsDGosDivRfFmmDN",
+	"Lo5AH1lexnn0Iz+1yiK89W+topivOrdtgV79orMyuWmMxXEi5RCwAyiMzsd7G6YEwW8OtraBS3SgRe9U",
+	"4JpN542bTFbAQ+1UVwGTy2kUSEYX9243wb1w7xOa2dSLHZ7PX/vw7bFwJ80WVJQCWAaeIbnHaqh1AGHd",
+	"Qr85QJtUSnMCscGLc0kUtwdm0T1SYCinkNI/Vr5BV5+XFl6QMY3szblCst6G3AXtWbj7ZyEWebMRudhO",
+	"fwrWJm6guUPrD8cMJqUgoRVsmW7dGEClMuJyIKLHHItyJlawirbW7kL6yhLP5MJF9/N5HLjo1DvgvqXC",
+	"oTpvVRCLzICBxQ5ZCem5C9eIIr3IYkb/RHY7fkObHrO60SfstxMU71q9sFwwaz06a7YMkV/Gis55DRED",
+	"S6635MbGU8+0XnNrUVyIiImwTDhj++RalT+pNZlRBPAaFZXPqIy/n8dtzy6zmfpGyqJYf/ioiZP/3M6s",
+	"op4AV0/J1xEh41JQNzfoiQhjaz6vv5gcDlP/wewm+xa+cvKIM5kQVwoF7PMDCwZcfkPhED+ndIibi4c2",
+	"qmrH5ANlU1VIxGuWEiNaBa7CnZ5+Z4YMpSh5TsU1SQ3mhslG+JEVCooAe4WCXxgigtV41y42nq3KX7E4",
+	"S41ookgDwSCJrhVSuyqk+pRSNyOfqBnN0sbKbHMWdtaPZNG6wWTGxqVu6xTZ7Y1dd2N3uO13nXzAT4OK",
+	"sgX4PW52NPfFEfOjHs0MAbtyNK/HrMaAa7X6H+3A9IJH0N2aBiSJXnon63P6tT0rhW+1go+lvKoFtltf",
+	"al24UUaLG4oxYhNU0npr/laiihhK7IKJGG6fNYKIgbtM4BAnjJYt9dFCkm/WE9rA+Vz8YZ/93qxCpQUr",
+	"N65JuVv+NHm+qoZtX6LjpZ+ttdyrKbi5Y9yry9or98eU7SS/j00KWVpwwgtPz7uDnLDZVBXLnbvPlqzC",
+	"knM1NTJ3mXN5EonGnFt18s0IOi02vaOJXnoW/0S/tnc0QY0KPpa6owlst8qg7o6W0eJ6dEE+3uGf7Aeb",
+	"kg0uB8K5j8JZXZg4o4bvQxXkyzbBxj5vv7DE2nl3GR3wx+DaHcoKe2lIAiuZNLcxTW061fnPBCcoMYcH",
+	"zmkaJyAPotDHoCA3cEIMFhpC8zj2JgEr3cTJxHnykikln0+9T297fdrroFKEfB869E6IkM0qz2y77JRn",
+	"jo4dSfpmKf00ejTft1b4PbPwkwJqeeFXrSzFsTsh+4DmlFCdSfn92+GYuON9kL5J1RUji+HmvWMQlAAx",
+	"HcN5mnqjqTMKU5/VOxkSpfYMi/UkX6duypNPTokXcS1a89L8iU3wDxz5DGC7oKC97CA3mFyEI3P8McyZ",
+	"5KmyQZWwtgUG1ii4CsTWwLUFWcgRLNQ6t+SvgDnkqNVRGR9QPt+CaDucp9GkosiqeCtQwRV3xRzP2kqs",
+	"azpfK7JqRNaGdDuK/Wwz4hrNLrfrWKyVb972lLkywJYJcCiorRyqkkMUtzsjiOqc8gYkGBfk0BDLnAJZ",
+	"MtUpx4/yUprpVJjngyQdxw1wG2Yh1niCBjMmzoqEYivPXo7b33cp0Bj6l5doz+B9qAHZOqmX4o3YSjW9",
+	"VOP+gJsUa5T692coVkaV7090o5g84q1l9EWloR26UgA/8SleonR5URk1XlKShM2/+uVob7lMg84jkCqW",
+	"7RZc0prTntmchuJI7s5MChYhGAXnLCsTI8z0RW0XNiFG2JoZQupijPou+ghDwzaf067mc1pX7p9aTG4y",
+	"w4+ksx3I8lOEZVtl2vK81sDSp7Bzq4oWDH0qbjJxi6h2Lthfl5W4vMf+PIRFLepLA8g8l6yDTWEAEYJz",
+	"TXu0ZQEOdWhZzjWqsButi9TWq2tQ7waLt72R6gyhuEmYeAbatR6EklUENhocJ4JD6P6054nWd5AhZ73R",
+	"XQqhO15gQ+dthJdamRQQYucqQzH8nNFdCOoysV0R7ddypP6oothZ6+kkw7rwN8ugLpWLc169B84n7rGn",
+	"evFRzZC2xYu58qTiAM/PvDim2erZE4EHO5DCzW4fpEOVlx+S1/fhJsxkYXWUDN/2l31GL+Mn3EqDHXIS",
+	"Xk4GdXJEbBUq11xL+D7cfXdFFGy4snMzJWZHvH2tJJjG17eVYDvk6bseCValU8U+qDfVJQAH2MR5IsNp",
+	"GD6UwwTp58/sa3vJZ9X/VJw0eS8soHqX2PB4O2DcBm6aTMPI+zemNcKJX29n4k8EpmVe6aD1h0+lrEoK",
+	"L9CXH8YCqsGBflz2ckMZ8TBO3CgxsuMAvzIL3FUX0OTQ58kiQ97GIpyIAnSFCKU9XyJnvjo60eBB5R6K",
+	"Mn6G5bAyJe6Yhyr4ISOYPK0U56ZUEZNRGnnJguJnBGzoERwUfv2CwGX0QFGan1EQAu7A0nRQV5F1cDko",
+	"EmBBIAdxK4e5HL4cnKuoaiCJi1huZfHOyeIyI0hJfDlYoRBsYWAdg7VWXoqAPH9V1n9dH83mJ7W22RZ3",
+	"tWXoHWJoI+dZcnTliZqQ+X6UBvvbcFIdwGT9NHhpvqqbt6jqENPMSwD3kdZ3y+1Ma6vYBTdKuTdlN8rV",
+	"3nwE88KfxI/fKlnXzWAZLhhDFU5vRogvxHNH71ooVmgCS6DqhUoMvkVLyodWImxLIuRo8cmN6QFfJyLU",
+	"Qx3/hBtdYcqUpNxcTtRWn+kmCZnNeRkl2lYRHybB8dLKzrQSpOoJ14vp6x4XIYwI/N27IDyzU0cdo2yL",
+	"oSOCHSuqVNByPrY8TJu3LLyLdTMirK5Mt6rm5dUL5mkinXaIbrnfdkJTaatmVEaosrrdWxco2ZoqbQGs",
+	"GQ8PqBMuaAVgw7ai5fm0g2b14AyWBj5ce6HY5QuF2KWNSA3+Fr8fp0MJqE2ERJgmQ4RbPOY7uQEqYya4",
+	"38BA6dC+58WHJrQ0iKLQ7kV7Dhfe1fRYUuKi+Xd1J1aKsgiquUV1qezwFILI9r53T0aLkS+LIbsR5gDx",
+	"vUcSUUduG85qH/IoAjSYqVG8tTv1PAEcGuAbRXLoltJKhdLjnBZNy4uFxqfu4Z+6P9uFgNSImI7j+mEw",
+	"YSmyMAMDFyOepnSdhtpeeGCHXugaQNTtwfejUTSK+Wjlxg4Hf6xVWnX0ZG8THFIjeWzEywsPFtl58bKp",
+	"8JE1KVXPHlCyjJxUI0taObnDISbbkZPrUvYOFcWs3giTNWYGFy17KZofceFqyTstHJc9enWcWYhJa8gI",
+	"S3Tfe1GcHDhn2ch464xI4noYPYwW4r85Y3ehyZbKUcm7Ll509q5dlulrSDeWgXbv+jF5jvxj6wUhy+so",
+	"yTtO3CSNiVX+SdG2Gi67fJR5LhjQkbec01HHiUuYERVZ1J5mz3ya5SyXudv7M5xkmFSyvkgKJpcwRlW2",
+	"AZVFbv1MkYoIqcoOjciQWXb5jovtaD3+dy2ERyH/5XM08UFMLPTDW/hz/MOwURmpc7TJmcfLWOZbzt3B",
+	"WB2V8Zay8VOqqPblxxOSCe/q1JzZ2fDDH5YZJpZLU96+h2syhOcrJjAcL/22xRHNfNHqaryw2g1q1W6Z",
+	"sRz7H2hZAT8rXq4vpRzLRguiKHipq4iiYhiw8bxVUfJwL1MWJUcw7U315D+3M6vIiMwNPuTriJBx6TDl",
+	"tVnye1SuQVDtzdZE4Pyp/loXSpfjhNoTmJPpS46sK7C+wZ6pYPAFqwl8u5YtZ9JG2pmLieSd2OsLiXTy",
+	"NLU8Px/SeIhaf3YWNcEYWgX6oIavz+noLXM/P3NnpZOu5fOwgHEV1/c8juh2t7btLdm2P6u4D2yKFmWb",
+	"1FRlWJ/EiafunGxIjxjQsVt582KUCbZhrUbxHWkUMn0OD1usTE7HPfEpi/u+DNGJNbpGFevT3G0smq7H",
+	"Zm1lwAYAvHBhy87PRLVb3xU7aPJNgAbnY2NxtFcnOl+ELYT5UxpZwubZBuLuaHjfErLEPvbPThbGVi8T",
+	"tKWdRvNDVmsck3s39QGWo05OVGyjbqOc+/Uykw9Y+cbhghYEN0zKP9XU+N6w2tU+9qxf31pnHVg5Zm0+",
+	"olORWmWIQQWlx54qjenl5CPalJeD8k7CkGGbOYQntCk/laz7sWeuWGr+lEofAHw+jnP+kyshuFzku6FB",
+	"iCdBal+PaqIiGdls4+UGJEdUlXZAaCTYyvlXOMyAApqYTGrdJ06h3w+tpryYotJyYz1aqQyoQarEB4a5",
+	"RRfTxW092M8czIFq3LGbuM4DWTiPrp8SZ+56UcnPnHx1Z3OfIDNAy+M3tOkxfIDfTthvJ8g4ujVl1u9P",
+	"fLY9rSN6rWg0l7IGxe+el8teW0Vtlc9i+6raw8XmCmsrx+aWS2vnkLGCDtseTBo9tnQSbEihjWioFv5v",
+	"X/zVIiAfS6aVjirrpwEknBcecS9XbwIrh9GtPgv8VFNuRQ2A125iW7a7GIquR1Mza36eINAtvuK5bUXm",
+	"eskOPDvMWRs6Ottj8yWYvhsd1muRD5WJKSgpYCljjFkG8nfmIax60XESb0b+DQtz3AB26ZFEvjvnH1kM",
+	"tfGOaS9fXng+i90SMZtKXqHKmBu2wzUGNTNlPEPOimYiUs1WYVxGq9gUc0eYUbWa8LK7fNADzPaRTn05",
+	"rHc9ao1gu2wEow/DDSxgtP1mzV87bZtD4ICUEWkGd5QCWKzxZ/WBYkvwaTJPa2Hjjh/bsmnm0LZk4ozm",
+	"vnS0L7eM2QD34AVjK6how8YgfYRe9dC8ePMvasCOe4+Alhyi0WeFxyerSwDN5eR4/wj/uzk6ekP/+x8D",
+	"7nn3Lk6gJ148WvcRij1L3qEQDwkMQDYJ8ls6wzphrsDyvRd48XR5mEX/reJ5XUCvFdObe84ovx38sI8Z",
+	"Rd2xtclsxAV6M68Y1OvZpiyo63DQ8KDLs79aJ9QyuOEFlQdt1fBWDd8BNbzVLVvd8lnCmuLlKhbnjU9t",
+	"weL6811TP3h95zyCOk59PB5rrIay5TL2w4Ho3FoRd9mKuLl7kSSAF+Xr1SpTrTL1YpSpbBmZqF6LbdYq",
+	"h7FkcGml3XIC47KEaa0O69VKDBrAZvWSwz/lj/ulNE21LpV6kBvqLC/csVKDA2MNUy2qd9bXUr+7rU9C",
+	"0dnSgKdmDgkG2qhxu1wLA75k58uXxX2bPI7bo/ilO2VuVo7YKQYyE8u3LACwKhs6iJmAPC3roqnx+Hs5",
+	"udOrb69qCL8+9UolaFstxdrc8VJGMle7L+6u+6Wa8v1Hdb/czRorO5cvlwu6Jp6n64nAVmRxzo6sl8dC",
+	"I+AS2V4fLKkSmNuhlcJblMJiB5QNaCJ/jXrD9oTvEuqoKoF/yJtmK36txC9XSOp04rWLXFaEYX8EaElq",
+	"XHRoG5HSTlQPcR9dz3eHIJBR+iriRn8bh5FYkYf4lM744kVvXebBF555NLdZS169Gakw8mmt4YY3+hyS",
+	"lstHmmf/NIZ9OxylUUSqOZtVdOUNHexW4t5b+CO0POWDbZDucKaGdEYhbutYPX8dKwI05CULKsZHYfjg",
+	"kW6KsuufX1BUFSJz8+QmyJ1uv4aMJ14yTYeHI5hv6I4ejOR8GuKLKlavQ8q4wvkd7XmEE7FYu/d06CvE",
+	"5akYvkDgr45Oat4TRnzecXneKXHHvGSlH7LNyO9DUax/KyAzhzuxwPwcluiLEzcyi4IBfl0OcbRrc6xR",
+	"eDaPMwpdQ4SF4cQnm6E3OvR3Tm8MfWumtwxx3x29ecGjlxCburZCG2YdqNJtdXzjCDe07zmfa4OnuDpR",
+	"0xrQ+QW2+qL1sUpTOxewl1HejeaGmKO9Qxf2Y56YLW9d+j2WFjY+SYna1M1nffY2Y09ig7OJ6uuuVlAf",
+	"W7mO/lovAEleDNulvbenr4jQJKkVBRnxezP6Yn32NlXeEAdfA32xlbf0VUlfDNtL0JcfTrzATFYX4SSG",
+	"4YCssPlBhYJxQQfaUOoXPIJx/C0ViLa6RwPmJkALXtBen3fq+pw/1pFqbO/JsKNhmtQwA7Sw44YwfX5b",
+	"D6fRcMfKpbVEWqOMUuqxJdsZwRiVeOrNG1yBlE521yB2hHzKuvEwoo0SuH7S5vchFUXtnWiZO5GKwXqS",
+	"DL3xaCP2nysY+Pu2/lDUrdf2I5H23Vl+5m4cP4VRhccLTwnHTmxHtK86uq/FmJvTZU+nbjCRE+2SUjui",
+	"kI0lolq14QWpDYys8pRuwUQRmeCBGVUZF1iLuFLzlf5gm2IbAcYuMYxAXvuc+iLug4KEbHXr2AcdYyOa",
+	"zABH3mFVpkbUNNRtHgEWDkJlBXjeTvhJwTiPmrvIeXAfQo9f+aBrrX+lQJplDjk+ODo40uUmUdyT/im7",
+	"frEobXVTsdiCS2YFOX8m6M6RRkEOeYX7HEqpNAgA4myKr/tiyP1wzkKhs9nEpj2R4RRoYJ97qx3+yf9g",
+	"EfeJJwVvXfZmY3+3D+nkA5m9xeREW3YWs4yRFPC158LznwvFuEyVTI0uYrzFFyvmOOR4tjHGiKaidmw1",
+	"x3C9J7ZN4LKzfLMeJ0sGPfOx5KhBzPT5hCapK/PTcuzI7WrZc4fYk9qeSlvUlEclb9IfvtW4aLNWWu9r",
+	"6sFpxXPME7XKsVlzxr8ct+bGDqZ8xa3VteS5XIoKQ6W52lGZqtV1NVRqCNm+zMlO0PKmConkzg3TWcEx",
+	"8AyFQix5Ta0Q0nKaoR7IKsxWOE2KEUBWGXBkmIJVyo0G96KdDKNpkj1GAthG8W0/ik93HVIoZskgmk6d",
+	"hmXPCQ1Urh8hmmzJCLKWt56bt9RQtVUYy0bts+euZnrgTjDY+nXBPDJsA+rTrFihcenfdkEiFNXDVh4Y",
+	"FcTVmLNGTbQq40BrX+bqNUjGe5QvHcaTskHZhl3gZ03qVJb4dA11rZavaqUHbBKF6Zzmo81AEBtlBIV2",
+	"+kgWe7W5QjYsJFbMES8eldo08TuoTSyVl76R4BL5i4y+ISL1RtOMQkslEtpJyXWjYZcD5/yeWrfjFKmD",
+	"jDuUq3xYZ5xInvJA0JME89qYspZngn/HFSlOBktmJ3q2nEQKvI2SEbUpiNoURBtIQdRINHPZEFu8auVO",
+	"ciuxzH1rXpAJ5nuQyxuWcsJhajVVsJV3O6UCZqS4qgp4cjiGEQ4TN36wiiTCdkAhbuIMiR8GE8w1Picj",
+	"794bSS8LHLEkZH49OXMnNKcCncpCwJCv8DFwfSz4wt3lzrrvDcwJc95547hS1siCGitxrWV5tWIOtgK8",
+	"G0/CtqpsMTvRJHwDreqT0G0/nXr+OGKMVECefVQXnbW1YxUitfheyKBv+D3P3rTF4Z/4vzpfGGyDVZ8o",
+	"tRW5F0e2LQiA4xizDyKEL/NRhiGh4UlK19uenls6PSX5YT3IoOIoZdRe4hzz4ZlUctYhIDgl+2PPnQRh",
+	"7JmP0t7Xue96gfM0XQiOm3JQh4QEjhvH3iQgtMSZ0alHcuM/cNYzOekPz5sFfNRxKt+ullN3mVNxayh3",
+	"OZK7xHV2rRyM/+7TwtDVijArNl2AQcefPWxnXXjzGfnyuy+6uXnBk+11M+2A01srdXZJ6hi5fAVJU0wt",
+	"DktloVqjRc21G8nEHSXeI3GUTg5wrwv07ZF8KeCO8+QlU9qJsxTc2EQomBuMmSgd84s89iTuaCpGW9Am",
+	"Hsw6TGMPzWMPZBEfaATcaQbKi64tDEN4s3SmIAtXjHKIB9mVUNRxxuTeTf2Etnp9ZJBTMMzd7skqZdsG",
+	"fD23sTtpnHNST4nt9bwgRFQ0pYhnRYgoW2FVn0AjRBDv+5TIYgshkqX6AUCERQ2HYEduUY6AgIzxRjJc",
+	"5EpxRDpp0Mf0VzhIW2d8V+uMd504HbL1l/e+4Ohi9rUhbjSaPpt7i0JoS0kthdhbUVUQVQoxFCUVIt25",
+	"YGhbUlLRy5WNQ5zrcLiEpZl6leRd5AzPmEwTx8Uwx64G3nG7WcKU5kZKvBkR9e4VNJgY1AtGxA5Q9ILc",
+	"x9H3mldwYbIioIXOq8ARXfjTzMafYjZ/0eunwXLOdUVabgVQwcyD+Cm7tlVftSyEzjyEQ9FS9AC1pSDq",
+	"QOMRP0XEfRiHT4GURg0kEYx5jZO/dDlEJZB7j4pBol6fuW8UTJIlTTk5OjneP8L/bo6O3tD//scgG3j3",
+	"Lg68tx4JRSEdEhiAFEAVSXOWBRaEnReDAvyWDt4c3M0LphypLSGaKJ+0wqlCOOUxtD4RZe93oQqdgwr9",
+	"p72B7eQNDE6RuQsj4008hq1kFiag+iG+Bc4j8uiFaSyIv4P5ypimhX5pzKRHvibOnF/fgdrQCnXgfJ7C",
+	"NR1Q0nEYatCXzZsEISaoQ3se5fEwcX1ekNBjpk9kEDjkjP5uDMrKy57mlql6iSwRx0EMemK9ABTRHHb+",
+	"OXCR9amBk0V1WYC3Qc26MTCwi56/hkOzOx57LBMYyjUX+rrOw/4jkJgXWW2aKwe4EwPo928pJyrlxhHv",
+	"6pWjU5lFwwaHrPEdhWWDoVYjNyb7HiggQezR14SSPYiy7NiL5767oBWSbaDn7e94ReVGsqIaJO4eQfDE",
+	"BvKMqdnSAiLa4Q4VBhfGbgpTRCap70YO+QoCOWZhUC71C3bcCQ6YaMDK88qBcxVgCuUFQPDViaduxGT8",
+	"9dXg/L+pRO73TjQTxSiZ0Uk5xNTLHSeGMX1QMjqOH4YPboRPVfAL5o0EaUjQdIxJ92A8WIM7h59D2EbU",
+	"0t3A+f13GOH332P85wn/CfCfiDb//Xe47UREllk5qMQkAEq+NkPjO4oKgCfICIsGf3QErSF5O3+gzvHm",
+	"0fVT8ofzBKATB/+CaHCdcZjsxwT1GDTfiWOFvkc5XVCJ7vkcsxS+0C06cArzxkjDsEvhI4l8UBuRsHAC",
+	"QEqcwpEKN4M/3DfHf1Ck/OEeDN+c/NGxwgxdzB0HYU0ST4e0ME2+I6yx1ayGts3fpAZM9W9cdqB1ULV2",
+	"UF3hsnQ4ckHs+ebgxFP6Pc78hpgSLN7A2U3qaeoBJVP6Z34Yma957PBUqoxMDxzqsE7pm02N5ehdEO6j",
+	"aRQGoLD7C9NdjIHyEm5jG4oQ/PWEoYCisCY4kIcWhBzLWw0JLMBpTiaprZHAyYJSVysIyoKA4XYDoiAi",
+	"qHpW5bDH7zHnZmbBW0IA9ND9g+kwsRQpE+rFi742rnMPC5jiDZxdy9G2Fqtyg8FpLTYY2D+02GAoaCQ2",
+	"IoG1bYqNHJwNxQYDuJUaxvKG9B66TqkhbAP7aEOosbqqMb31llclprw1wLYG2F0wwLa2VyuK52HgreX1",
+	"xVleN2sJKEr01hKwoiUgd6AasmTEaznZ12Mi4Ca3vB6webuBmiOpNR8wFCg4iS2yd2fb9bzWhDzYqxkV",
+	"8tzTypaicWGL0mUpq8PyYuSzpiegO+ZqnuuAdjTx+XtBztrgBQ7S1AGmzSgAkGt3H4UzFuPCZJ4XOfeu",
+	"57P9zmSXajjhGiy6xoEQIO6MghKw9ydT5w5MmcaCotljQKw+PmbL4+5a/w9Bl391AxYmrdhTIpIp8bDc",
+	"P/DWMifjP4poa2iFyaU0a40xFAWrCOFntM2sJITzJppWBlebatYpg/Mi98/Hk331L99s85+hw7UIaZT3",
+	"LCBI4eXAnzx/h1ESkFq/79HLfbVEaJofDWFgniAT2lMjGwrLe7E5GBQsnVGExu29ad0vqJbc1CkRVRP+",
+	"4krOPqoG+zScuVbdcUtqBlMsABF4xiMH0qXAxUh4N1DXlUcSLbjrUU6b8BLGvfjQ0kEVwCc6vYG7vChv",
+	"LWi1O3B6dFzgUgx5zLyJmCrBdAL6hEMdIaYuja7mWkeHAhZwwIvKiQQKFRLURvgNEu2J7AJppVG8A9zc",
+	"sLDyZUSJPFGfX5psReUQ6LJ4DcrQUzq1KUU+txqSLcVSG5GKa5uFdteyMmo0Hyb1ChkjtiO0rVPYFEMK",
+	"CglsK4SXTHZiXZH0u35SMkhnrbn8+1T5mmW/abW9LWp7xeLuQwLaUCSLu3e05d5ptXDGy2nkA1h73758",
+	"+/+V3/575SwDAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,50 @@
+package transformers
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func ToAuditLog(entry *dbsqlc.TenantAuditLog) (*gen.AuditLog, error) {
+	requestMetadata := make(map[string]interface{})
+
+	if len(entry.Metadata) > 0 {
+		if err := json.Unmarshal(entry.Metadata, &requestMetadata); err != nil {
+			return nil, err
+		}
+	}
+
+	// audit log entries are never updated
+	res := &gen.AuditLog{
+		Metadata:        *toAPIMetadata(sqlchelpers.UUIDToStr(entry.ID), entry.CreatedAt.Time, entry.CreatedAt.Time),
+		ActorKind:       gen.AuditLogActorKind(entry.ActorKind),
+		ActorId:         uuid.MustParse(sqlchelpers.UUIDToStr(entry.ActorId)),
+		Source:          gen.AuditLogSource(entry.Source),
+		Action:          entry.Action,
+		ResourceType:    entry.ResourceType,
+		RequestMetadata: requestMetadata,
+	}
+
+	if entry.ActorName.Valid {
+		res.ActorName = &entry.ActorName.String
+	}
+
+	if entry.ResourceId.Valid {
+		res.ResourceId = &entry.ResourceId.String
+	}
+
+	if entry.IpAddress.Valid {
+		res.IpAddress = &entry.IpAddress.String
+	}
+
+	if entry.UserAgent.Valid {
+		res.UserAgent = &entry.UserAgent.String
+	}
+
+	return res, nil
+}
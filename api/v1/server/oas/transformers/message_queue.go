@@ -0,0 +1,62 @@
+package transformers
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+const deadLetterPreviewLength = 1000
+
+func ToDeadLetter(deadLetter *dbsqlc.MessageQueueDeadLetter) *gen.DeadLetter {
+	res := &gen.DeadLetter{
+		Id:             deadLetter.ID,
+		CreatedAt:      deadLetter.CreatedAt.Time,
+		Queue:          deadLetter.QueueId,
+		MessageId:      deadLetter.MessageId,
+		PayloadPreview: toDeadLetterPayloadPreview(deadLetter.Payload),
+		RetryCount:     int(deadLetter.RetryCount),
+	}
+
+	if deadLetter.TenantId.Valid {
+		tenantId := uuid.MustParse(sqlchelpers.UUIDToStr(deadLetter.TenantId))
+		res.TenantId = &tenantId
+	}
+
+	if deadLetter.Error.Valid {
+		res.Error = &deadLetter.Error.String
+	}
+
+	return res
+}
+
+// toDeadLetterPayloadPreview returns the payloads of a serialized message as a JSON array, truncated to
+// deadLetterPreviewLength characters.
+func toDeadLetterPayloadPreview(payload []byte) string {
+	preview := string(payload)
+
+	msg := &msgqueue.Message{}
+
+	if err := json.Unmarshal(payload, msg); err == nil {
+		payloads := make([]string, 0, len(msg.Payloads))
+
+		for _, p := range msg.Payloads {
+			payloads = append(payloads, string(p))
+		}
+
+		preview = "[" + strings.Join(payloads, ",") + "]"
+	}
+
+	if utf8.RuneCountInString(preview) > deadLetterPreviewLength {
+		preview = string([]rune(preview)[:deadLetterPreviewLength]) + "..."
+	}
+
+	return preview
+}
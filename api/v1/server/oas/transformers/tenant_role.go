@@ -0,0 +1,36 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func ToTenantRole(role *dbsqlc.TenantRole) *gen.TenantRole {
+	permissions := make([]gen.TenantPermission, len(role.Permissions))
+
+	for i, permission := range role.Permissions {
+		permissions[i] = gen.TenantPermission(permission)
+	}
+
+	workflowIds := make([]uuid.UUID, len(role.WorkflowIds))
+
+	for i, id := range role.WorkflowIds {
+		workflowIds[i] = uuid.MustParse(sqlchelpers.UUIDToStr(id))
+	}
+
+	res := &gen.TenantRole{
+		Metadata:    *toAPIMetadata(sqlchelpers.UUIDToStr(role.ID), role.CreatedAt.Time, role.UpdatedAt.Time),
+		Name:        role.Name,
+		Permissions: permissions,
+		WorkflowIds: workflowIds,
+	}
+
+	if role.Description.Valid {
+		res.Description = &role.Description.String
+	}
+
+	return res
+}
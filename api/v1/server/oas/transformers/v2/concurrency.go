@@ -0,0 +1,43 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+)
+
+func ToConcurrencyStrategyUsage(usage *v2.ConcurrencyStrategyUsage) gen.V2ConcurrencyStrategyUsage {
+	keys := make([]gen.V2ConcurrencyKeyUsage, len(usage.Keys))
+
+	for i, key := range usage.Keys {
+		keys[i] = gen.V2ConcurrencyKeyUsage{
+			Key:       key.Key,
+			Running:   int(key.Running),
+			Queued:    int(key.Queued),
+			Saturated: key.Running >= usage.MaxConcurrency,
+		}
+	}
+
+	res := gen.V2ConcurrencyStrategyUsage{
+		Id:                usage.ID,
+		WorkflowId:        uuid.MustParse(sqlchelpers.UUIDToStr(usage.WorkflowID)),
+		WorkflowName:      usage.WorkflowName,
+		WorkflowVersionId: uuid.MustParse(sqlchelpers.UUIDToStr(usage.WorkflowVersionID)),
+		StepId:            uuid.MustParse(sqlchelpers.UUIDToStr(usage.StepID)),
+		Strategy:          gen.V2ConcurrencyStrategy(usage.Strategy),
+		Expression:        usage.Expression,
+		MaxConcurrency:    int(usage.MaxConcurrency),
+		Running:           int(usage.Running),
+		Queued:            int(usage.Queued),
+		KeyCount:          int(usage.KeyCount),
+		Keys:              keys,
+	}
+
+	if usage.StepReadableId.Valid {
+		res.StepReadableId = &usage.StepReadableId.String
+	}
+
+	return res
+}
@@ -0,0 +1,68 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	schedulingv2 "github.com/hatchet-dev/hatchet/pkg/scheduling/v2"
+)
+
+func ToTaskQueueDiagnosis(diagnosis *schedulingv2.QueuedTaskDiagnosis) gen.V2TaskQueueDiagnosis {
+	reasons := make([]gen.V2TaskQueueDiagnosisReason, len(diagnosis.Reasons))
+
+	for i, reason := range diagnosis.Reasons {
+		reasons[i] = gen.V2TaskQueueDiagnosisReason{
+			Reason:  gen.V2TaskQueueReason(reason.Reason),
+			Message: reason.Message,
+		}
+	}
+
+	workers := make([]gen.V2TaskQueueWorkerDiagnosis, len(diagnosis.Workers))
+
+	for i, worker := range diagnosis.Workers {
+		workers[i] = gen.V2TaskQueueWorkerDiagnosis{
+			WorkerId:        uuid.MustParse(worker.WorkerId),
+			HasAction:       worker.HasAction,
+			AvailableSlots:  worker.AvailableSlots,
+			UnmatchedLabels: worker.UnmatchedLabels,
+		}
+
+		if worker.ExcludedReason != nil {
+			excludedReason := gen.V2TaskQueueReason(*worker.ExcludedReason)
+			workers[i].ExcludedReason = &excludedReason
+		}
+	}
+
+	rateLimits := make([]gen.V2TaskQueueRateLimitDiagnosis, len(diagnosis.RateLimits))
+
+	for i, rl := range diagnosis.RateLimits {
+		rateLimits[i] = gen.V2TaskQueueRateLimitDiagnosis{
+			Key:          rl.Key,
+			Units:        rl.Units,
+			Available:    rl.Available,
+			NextRefillAt: rl.NextRefillAt,
+			Exceeded:     rl.Exceeded,
+		}
+	}
+
+	concurrency := make([]gen.V2TaskQueueConcurrencyDiagnosis, len(diagnosis.ConcurrencySlots))
+
+	for i, slot := range diagnosis.ConcurrencySlots {
+		concurrency[i] = gen.V2TaskQueueConcurrencyDiagnosis{
+			StrategyId:     slot.StrategyID,
+			Strategy:       gen.V2ConcurrencyStrategy(slot.Strategy),
+			Expression:     slot.Expression,
+			Key:            slot.Key,
+			IsFilled:       slot.IsFilled,
+			FilledSlots:    int(slot.FilledSlots),
+			MaxConcurrency: int(slot.MaxConcurrency),
+		}
+	}
+
+	return gen.V2TaskQueueDiagnosis{
+		Reasons:     reasons,
+		Workers:     workers,
+		RateLimits:  rateLimits,
+		Concurrency: concurrency,
+	}
+}
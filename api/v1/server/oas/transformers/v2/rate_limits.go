@@ -0,0 +1,21 @@
+package transformers
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+)
+
+func ToRateLimitUsage(usage *v2.RateLimitUsage) gen.V2RateLimitUsage {
+	return gen.V2RateLimitUsage{
+		Key:          usage.Key,
+		Algorithm:    gen.V2RateLimitAlgorithm(usage.State.Algorithm),
+		LimitValue:   usage.State.LimitValue,
+		Burst:        usage.State.Burst,
+		Available:    usage.Available,
+		Window:       usage.Window,
+		LastRefill:   usage.State.LastRefill,
+		NextRefillAt: usage.NextRefillAt,
+		Dynamic:      usage.Dynamic,
+		LastUsedAt:   usage.LastUsedAt,
+	}
+}
@@ -105,20 +105,36 @@ func ToDagChildren(
 func ToTaskSummaryMany(
 	tasks []*olapv2.PopulateTaskRunDataRow,
 	total int, limit, offset int64,
+	cursor *repository.ListCursor,
 ) gen.V2TaskSummaryList {
 	toReturn := ToTaskSummaryRows(tasks)
 
-	currentPage := (offset / limit) + 1
-	nextPage := currentPage + 1
-	numPages := int64(math.Ceil(float64(total) / float64(limit)))
+	pagination := gen.PaginationResponse{}
+
+	if cursor == nil {
+		currentPage := (offset / limit) + 1
+		nextPage := currentPage + 1
+		numPages := int64(math.Ceil(float64(total) / float64(limit)))
+
+		pagination.CurrentPage = &currentPage
+		pagination.NextPage = &nextPage
+		pagination.NumPages = &numPages
+	}
+
+	if len(tasks) > 0 && int64(len(tasks)) == limit {
+		last := tasks[len(tasks)-1]
+
+		nextCursor := repository.ListCursor{
+			InsertedAt: last.InsertedAt.Time,
+			ID:         last.ID,
+		}.Encode()
+
+		pagination.NextCursor = &nextCursor
+	}
 
 	return gen.V2TaskSummaryList{
-		Rows: toReturn,
-		Pagination: gen.PaginationResponse{
-			CurrentPage: &currentPage,
-			NextPage:    &nextPage,
-			NumPages:    &numPages,
-		},
+		Rows:       toReturn,
+		Pagination: pagination,
 	}
 }
 
@@ -0,0 +1,91 @@
+package transformers
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func ToWebhookSubscription(subscription *dbsqlc.WebhookSubscription) *gen.WebhookSubscription {
+	eventTypes := make([]gen.WebhookSubscriptionEventType, len(subscription.EventTypes))
+
+	for i, eventType := range subscription.EventTypes {
+		eventTypes[i] = gen.WebhookSubscriptionEventType(eventType)
+	}
+
+	statuses := make([]gen.WebhookSubscriptionRunStatus, len(subscription.Statuses))
+
+	for i, status := range subscription.Statuses {
+		statuses[i] = gen.WebhookSubscriptionRunStatus(status)
+	}
+
+	workflowIds := make([]uuid.UUID, len(subscription.WorkflowIds))
+
+	for i, id := range subscription.WorkflowIds {
+		workflowIds[i] = uuid.MustParse(sqlchelpers.UUIDToStr(id))
+	}
+
+	// the secret is encrypted in the database, so it's never returned here
+	return &gen.WebhookSubscription{
+		Metadata:    *toAPIMetadata(sqlchelpers.UUIDToStr(subscription.ID), subscription.CreatedAt.Time, subscription.UpdatedAt.Time),
+		Name:        subscription.Name,
+		Url:         subscription.Url,
+		Enabled:     subscription.Enabled,
+		EventTypes:  eventTypes,
+		Statuses:    statuses,
+		WorkflowIds: workflowIds,
+	}
+}
+
+func ToWebhookDelivery(delivery *dbsqlc.WebhookDelivery, attempts []*dbsqlc.WebhookDeliveryAttempt) (*gen.WebhookDelivery, error) {
+	payload := make(map[string]interface{})
+
+	if err := json.Unmarshal(delivery.Payload, &payload); err != nil {
+		return nil, err
+	}
+
+	res := &gen.WebhookDelivery{
+		Metadata:  *toAPIMetadata(sqlchelpers.UUIDToStr(delivery.ID), delivery.CreatedAt.Time, delivery.UpdatedAt.Time),
+		EventType: gen.WebhookSubscriptionEventType(delivery.EventType),
+		Status:    gen.WebhookDeliveryStatus(delivery.Status),
+		Payload:   payload,
+		Attempts:  make([]gen.WebhookDeliveryAttempt, len(attempts)),
+	}
+
+	if delivery.Status == dbsqlc.WebhookDeliveryStatusPENDING && delivery.NextAttemptAt.Valid {
+		res.NextAttemptAt = &delivery.NextAttemptAt.Time
+	}
+
+	for i, attempt := range attempts {
+		res.Attempts[i] = *ToWebhookDeliveryAttempt(attempt)
+	}
+
+	return res, nil
+}
+
+func ToWebhookDeliveryAttempt(attempt *dbsqlc.WebhookDeliveryAttempt) *gen.WebhookDeliveryAttempt {
+	res := &gen.WebhookDeliveryAttempt{
+		Attempt:    int(attempt.Attempt),
+		CreatedAt:  attempt.CreatedAt.Time,
+		DurationMs: int(attempt.DurationMs),
+	}
+
+	if attempt.ResponseStatusCode.Valid {
+		statusCode := int(attempt.ResponseStatusCode.Int32)
+		res.ResponseStatusCode = &statusCode
+	}
+
+	if attempt.ResponseBody.Valid {
+		res.ResponseBody = &attempt.ResponseBody.String
+	}
+
+	if attempt.Error.Valid {
+		res.Error = &attempt.Error.String
+	}
+
+	return res
+}
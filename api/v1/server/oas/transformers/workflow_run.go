@@ -568,6 +568,17 @@ func ToCronWorkflowsFromSQLC(cron *dbsqlc.ListCronWorkflowsRow) *gen.CronWorkflo
 		Name:               &cron.Name.String,
 		Enabled:            cron.Enabled,
 		Method:             gen.CronWorkflowsMethod(cron.Method),
+		MissedFirePolicy:   gen.CronWorkflowsMissedFirePolicy(cron.MissedFirePolicy),
+		CatchUpLimit:       int(cron.CatchUpLimit),
+		OverlapPolicy:      gen.CronWorkflowsOverlapPolicy(cron.OverlapPolicy),
+	}
+
+	if cron.NextFireAt.Valid {
+		res.NextFireAt = &cron.NextFireAt.Time
+	}
+
+	if cron.Timezone.Valid {
+		res.Timezone = &cron.Timezone.String
 	}
 
 	return res
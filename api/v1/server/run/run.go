@@ -12,6 +12,7 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/rs/zerolog"
 
+	"github.com/hatchet-dev/hatchet/api/v1/server/auditlog"
 	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
 	"github.com/hatchet-dev/hatchet/api/v1/server/authz"
 	apitokens "github.com/hatchet-dev/hatchet/api/v1/server/handlers/api-tokens"
@@ -19,6 +20,7 @@ import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/info"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/ingestors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/logs"
+	messagequeues "github.com/hatchet-dev/hatchet/api/v1/server/handlers/message-queues"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/metadata"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/monitoring"
 	rate_limits "github.com/hatchet-dev/hatchet/api/v1/server/handlers/rate-limits"
@@ -26,8 +28,11 @@ import (
 	stepruns "github.com/hatchet-dev/hatchet/api/v1/server/handlers/step-runs"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/tenants"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/users"
+	concurrencyv2 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v2/concurrency"
+	ratelimitsv2 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v2/rate-limits"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/v2/tasks"
 	workflowrunsv2 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v2/workflow-runs"
+	webhooksubscriptions "github.com/hatchet-dev/hatchet/api/v1/server/handlers/webhook-subscriptions"
 	webhookworker "github.com/hatchet-dev/hatchet/api/v1/server/handlers/webhook-worker"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/workers"
 	workflowruns "github.com/hatchet-dev/hatchet/api/v1/server/handlers/workflow-runs"
@@ -54,33 +59,41 @@ type apiService struct {
 	*ingestors.IngestorsService
 	*slackapp.SlackAppService
 	*webhookworker.WebhookWorkersService
+	*webhooksubscriptions.WebhookSubscriptionsService
 	*workflowruns.WorkflowRunsService
 	*monitoring.MonitoringService
 	*info.InfoService
 	*tasks.TasksService
 	*workflowrunsv2.V2WorkflowRunsService
+	*messagequeues.MessageQueueService
+	*ratelimitsv2.V2RateLimitsService
+	*concurrencyv2.V2ConcurrencyService
 }
 
 func newAPIService(config *server.ServerConfig) *apiService {
 	return &apiService{
-		UserService:           users.NewUserService(config),
-		TenantService:         tenants.NewTenantService(config),
-		EventService:          events.NewEventService(config),
-		RateLimitService:      rate_limits.NewRateLimitService(config),
-		LogService:            logs.NewLogService(config),
-		WorkflowService:       workflows.NewWorkflowService(config),
-		WorkflowRunsService:   workflowruns.NewWorkflowRunsService(config),
-		WorkerService:         workers.NewWorkerService(config),
-		MetadataService:       metadata.NewMetadataService(config),
-		APITokenService:       apitokens.NewAPITokenService(config),
-		StepRunService:        stepruns.NewStepRunService(config),
-		IngestorsService:      ingestors.NewIngestorsService(config),
-		SlackAppService:       slackapp.NewSlackAppService(config),
-		WebhookWorkersService: webhookworker.NewWebhookWorkersService(config),
-		MonitoringService:     monitoring.NewMonitoringService(config),
-		InfoService:           info.NewInfoService(config),
-		TasksService:          tasks.NewTasksService(config),
-		V2WorkflowRunsService: workflowrunsv2.NewV2WorkflowRunsService(config),
+		UserService:                 users.NewUserService(config),
+		TenantService:               tenants.NewTenantService(config),
+		EventService:                events.NewEventService(config),
+		RateLimitService:            rate_limits.NewRateLimitService(config),
+		LogService:                  logs.NewLogService(config),
+		WorkflowService:             workflows.NewWorkflowService(config),
+		WorkflowRunsService:         workflowruns.NewWorkflowRunsService(config),
+		WorkerService:               workers.NewWorkerService(config),
+		MetadataService:             metadata.NewMetadataService(config),
+		APITokenService:             apitokens.NewAPITokenService(config),
+		StepRunService:              stepruns.NewStepRunService(config),
+		IngestorsService:            ingestors.NewIngestorsService(config),
+		SlackAppService:             slackapp.NewSlackAppService(config),
+		WebhookWorkersService:       webhookworker.NewWebhookWorkersService(config),
+		WebhookSubscriptionsService: webhooksubscriptions.NewWebhookSubscriptionsService(config),
+		MonitoringService:           monitoring.NewMonitoringService(config),
+		InfoService:                 info.NewInfoService(config),
+		TasksService:                tasks.NewTasksService(config),
+		V2WorkflowRunsService:       workflowrunsv2.NewV2WorkflowRunsService(config),
+		MessageQueueService:         messagequeues.NewMessageQueueService(config),
+		V2RateLimitsService:         ratelimitsv2.NewV2RateLimitsService(config),
+		V2ConcurrencyService:        concurrencyv2.NewV2ConcurrencyService(config),
 	}
 }
 
@@ -216,6 +229,26 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 		return tenantInvite, tenantInvite.TenantID, nil
 	})
 
+	populatorMW.RegisterGetter("tenant-role", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		role, err := config.APIRepository.TenantRole().GetTenantRoleById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return role, sqlchelpers.UUIDToStr(role.TenantId), nil
+	})
+
+	populatorMW.RegisterGetter("webhook-subscription", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		subscription, err := config.APIRepository.WebhookSubscription().GetWebhookSubscriptionById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return subscription, sqlchelpers.UUIDToStr(subscription.TenantId), nil
+	})
+
 	populatorMW.RegisterGetter("slack", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
 		slackWebhook, err := config.APIRepository.Slack().GetSlackWebhookById(id)
 
@@ -376,6 +409,7 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 
 	authnMW := authn.NewAuthN(t.config)
 	authzMW := authz.NewAuthZ(t.config)
+	auditLogMW := auditlog.NewAuditLog(t.config)
 
 	mw, err := hatchetmiddleware.NewMiddlewareHandler(spec)
 
@@ -386,6 +420,7 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 	mw.Use(populatorMW.Middleware)
 	mw.Use(authnMW.Middleware)
 	mw.Use(authzMW.Middleware)
+	mw.Use(auditLogMW.Middleware)
 
 	allHatchetMiddleware, err := mw.Middleware()
 
@@ -0,0 +1,196 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// maxDeadLetters is the maximum number of dead letters which are listed or replayed by a single command
+const maxDeadLetters = 1000
+
+var (
+	deadLettersQueue    string
+	deadLettersTenantId string
+	deadLettersIds      []int64
+)
+
+var deadLettersCmd = &cobra.Command{
+	Use:   "dead-letters",
+	Short: "commands for managing the dead letters of a message queue.",
+	Long: `commands for managing the dead letters of a message queue. Unlike the API, these commands can manage
+the dead letters of messages without a tenant, which are selected when --tenant-id is not set.`,
+}
+
+var deadLettersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list the dead letters of a message queue.",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := runDeadLetters(false, listDeadLetters)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [dead-letters list] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var deadLettersReplayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "send the dead letters of a message queue back to the queue.",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := runDeadLetters(true, replayDeadLetters)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [dead-letters replay] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var deadLettersPurgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "delete the dead letters of a message queue.",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := runDeadLetters(false, purgeDeadLetters)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [dead-letters purge] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(deadLettersCmd)
+	deadLettersCmd.AddCommand(deadLettersListCmd, deadLettersReplayCmd, deadLettersPurgeCmd)
+
+	deadLettersCmd.PersistentFlags().StringVar(
+		&deadLettersQueue,
+		"queue",
+		"",
+		"the name of the message queue",
+	)
+
+	// require the queue
+	deadLettersCmd.MarkPersistentFlagRequired("queue") // nolint: errcheck
+
+	deadLettersCmd.PersistentFlags().StringVar(
+		&deadLettersTenantId,
+		"tenant-id",
+		"",
+		"the tenant ID of the dead letters (defaults to the dead letters of messages without a tenant)",
+	)
+
+	deadLettersCmd.PersistentFlags().Int64SliceVar(
+		&deadLettersIds,
+		"ids",
+		[]int64{},
+		"the ids of the dead letters (defaults to all dead letters of the queue)",
+	)
+}
+
+func runDeadLetters(withMessageQueue bool, f func(ctx context.Context, sc *server.ServerConfig, q msgqueue.Queue, tenantId *string) error) error {
+	q, ok := msgqueue.GetStaticQueue(deadLettersQueue)
+
+	if !ok {
+		return fmt.Errorf("unknown message queue %s", deadLettersQueue)
+	}
+
+	var tenantId *string
+
+	if deadLettersTenantId != "" {
+		tenantId = &deadLettersTenantId
+	}
+
+	// read in the local config
+	configLoader := loader.NewConfigLoader(configDirectory)
+
+	cleanup, sc, err := configLoader.CreateServerFromConfig("", func(scf *server.ServerConfigFile) {
+		// the message queue is only needed to replay dead letters
+		scf.MessageQueue.Enabled = withMessageQueue
+
+		// disable security checks since we're not running the server
+		scf.SecurityCheck.Enabled = false
+	})
+
+	if err != nil {
+		return err
+	}
+
+	defer cleanup() // nolint:errcheck
+
+	defer sc.Disconnect() // nolint:errcheck
+
+	return f(context.Background(), sc, q, tenantId)
+}
+
+func listDeadLetters(ctx context.Context, sc *server.ServerConfig, q msgqueue.Queue, tenantId *string) error {
+	limit := maxDeadLetters
+	offset := 0
+
+	listRes, err := sc.EngineRepository.MessageQueue().ListDeadLetters(ctx, tenantId, q.Name(), &repository.ListDeadLettersOpts{
+		Ids:    deadLettersIds,
+		Limit:  &limit,
+		Offset: &offset,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tCREATED AT\tMESSAGE ID\tRETRY COUNT\tERROR")
+
+	for _, deadLetter := range listRes.Rows {
+		fmt.Fprintf(
+			w,
+			"%d\t%s\t%s\t%d\t%s\n",
+			deadLetter.ID,
+			deadLetter.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+			deadLetter.MessageId,
+			deadLetter.RetryCount,
+			deadLetter.Error.String,
+		)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("showing %d of %d dead letters\n", len(listRes.Rows), listRes.Count)
+
+	return nil
+}
+
+func replayDeadLetters(ctx context.Context, sc *server.ServerConfig, q msgqueue.Queue, tenantId *string) error {
+	replayed, err := msgqueue.ReplayDeadLetters(ctx, sc.EngineRepository.MessageQueue(), sc.MessageQueue, q, tenantId, deadLettersIds, maxDeadLetters)
+
+	if replayed != nil {
+		fmt.Printf("replayed %d dead letters\n", len(replayed))
+	}
+
+	return err
+}
+
+func purgeDeadLetters(ctx context.Context, sc *server.ServerConfig, q msgqueue.Queue, tenantId *string) error {
+	count, err := sc.EngineRepository.MessageQueue().DeleteDeadLetters(ctx, tenantId, q.Name(), deadLettersIds)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("deleted %d dead letters\n", count)
+
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 var (
 	tokenTenantId string
 	tokenName     string
+	tokenScopes   []string
 	expiresIn     time.Duration
 )
 
@@ -66,6 +67,13 @@ func init() {
 		"Expiration duration for the API token",
 	)
 
+	tokenCreateAPICmd.PersistentFlags().StringSliceVar(
+		&tokenScopes,
+		"scopes",
+		[]string{},
+		"the scopes to grant to the token, for example workflows:trigger,events:push (defaults to admin)",
+	)
+
 }
 
 func runCreateAPIToken(expiresIn time.Duration) error {
@@ -96,7 +104,7 @@ func runCreateAPIToken(expiresIn time.Duration) error {
 		tenantId = server.Seed.DefaultTenantID
 	}
 
-	defaultTok, err := server.Auth.JWTManager.GenerateTenantToken(context.Background(), tenantId, tokenName, false, &expiresAt)
+	defaultTok, err := server.Auth.JWTManager.GenerateScopedTenantToken(context.Background(), tenantId, tokenName, tokenScopes, &expiresAt)
 
 	if err != nil {
 		return err
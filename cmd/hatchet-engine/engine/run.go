@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	outboundwebhooks "github.com/hatchet-dev/hatchet/internal/integrations/webhooks"
 	"github.com/hatchet-dev/hatchet/internal/services/admin"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/retention"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/v2/olap"
@@ -498,6 +499,15 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		return nil, fmt.Errorf("could not initialize tracer: %w", err)
 	}
 
+	shutdownMeter, err := telemetry.InitMeter(&telemetry.MeterOpts{
+		ServiceName:  sc.OpenTelemetry.ServiceName,
+		CollectorURL: sc.OpenTelemetry.CollectorURL,
+		Insecure:     sc.OpenTelemetry.Insecure,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize meter: %w", err)
+	}
+
 	p, err := partition.NewPartition(l, sc.EngineRepository.Tenant())
 
 	if err != nil {
@@ -564,6 +574,7 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		t, err := ticker.New(
 			ticker.WithMessageQueue(sc.MessageQueue),
 			ticker.WithRepository(sc.EngineRepository),
+			ticker.WithV2Repository(sc.V2),
 			ticker.WithLogger(sc.Logger),
 			ticker.WithTenantAlerter(sc.TenantAlerter),
 			ticker.WithEntitlementsRepository(sc.EntitlementRepository),
@@ -636,6 +647,12 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			Fn:   cleanupTasks,
 		})
 
+		webhookDispatcher, err := outboundwebhooks.New(sc.EngineRepository.WebhookSubscription(), sc.Encryption, sc.Logger, sc.Runtime.WebhookDeniedNetworks)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create webhook dispatcher: %w", err)
+		}
+
 		olap, err := olap.New(
 			olap.WithAlerter(sc.Alerter),
 			olap.WithMessageQueue(sc.MessageQueue),
@@ -643,6 +660,7 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			olap.WithV2Repository(sc.V2.Tasks()),
 			olap.WithLogger(sc.Logger),
 			olap.WithPartition(p),
+			olap.WithWebhookDispatcher(webhookDispatcher),
 		)
 
 		if err != nil {
@@ -780,8 +798,10 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 
 		adminSvc, err := admin.NewAdminService(
 			admin.WithRepository(sc.EngineRepository),
+			admin.WithV2Repository(sc.V2),
 			admin.WithMessageQueue(sc.MessageQueue),
 			admin.WithEntitlementsRepository(sc.EntitlementRepository),
+			admin.WithLogger(sc.Logger),
 		)
 
 		if err != nil {
@@ -894,6 +914,10 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 	teardown = append(teardown, Teardown{
 		Name: "telemetry",
 		Fn: func() error {
+			if err := shutdownMeter(ctx); err != nil {
+				return err
+			}
+
 			return shutdown(ctx)
 		},
 	})
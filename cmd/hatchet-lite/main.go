@@ -33,6 +33,9 @@ var rootCmd = &cobra.Command{
 		}
 
 		cf := loader.NewConfigLoader(configDirectory)
+		// hatchet-lite runs a single instance, so it doesn't require a separate OLAP database. The API and engine
+		// configs are created from the same loader, so they share its in-memory OLAP repository.
+		cf.MemoryOLAPFallback = true
 		interruptChan := cmdutils.InterruptChan()
 
 		if err := start(cf, interruptChan, Version); err != nil {
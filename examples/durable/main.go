@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+	"github.com/hatchet-dev/hatchet/pkg/cmdutils"
+	"github.com/hatchet-dev/hatchet/pkg/worker"
+)
+
+type approvalEvent struct {
+	OrderId  string `json:"order_id"`
+	Approved bool   `json:"approved"`
+}
+
+type stepOneOutput struct {
+	Approved bool `json:"approved"`
+}
+
+func main() {
+	err := godotenv.Load()
+	if err != nil {
+		panic(err)
+	}
+
+	if err := run(cmdutils.InterruptChan()); err != nil {
+		panic(err)
+	}
+}
+
+func run(ch <-chan interface{}) error {
+	c, err := client.New()
+
+	if err != nil {
+		return fmt.Errorf("error creating client: %w", err)
+	}
+
+	w, err := worker.NewWorker(
+		worker.WithClient(
+			c,
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("error creating worker: %w", err)
+	}
+
+	err = w.RegisterWorkflow(
+		&worker.WorkflowJob{
+			On:          worker.Events("order:created"),
+			Name:        "durable-approval",
+			Description: "Sleeps, then waits for an approval event without holding a worker slot.",
+			Steps: []*worker.WorkflowStep{
+				worker.Fn(func(ctx worker.HatchetContext) (result *stepOneOutput, err error) {
+					fmt.Println("sleeping for 10 seconds")
+
+					if err := ctx.SleepFor(10 * time.Second); err != nil {
+						return nil, fmt.Errorf("error sleeping: %w", err)
+					}
+
+					fmt.Println("waiting for approval")
+
+					approval := &approvalEvent{}
+
+					if err := ctx.WaitForEvent("order:approval", "input.order_id == '1234'", approval); err != nil {
+						return nil, fmt.Errorf("error waiting for approval: %w", err)
+					}
+
+					return &stepOneOutput{
+						Approved: approval.Approved,
+					}, nil
+				},
+				).SetName("step-one").SetTimeout("5m"),
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error registering workflow: %w", err)
+	}
+
+	interruptCtx, cancel := cmdutils.InterruptContextFromChan(ch)
+	defer cancel()
+
+	cleanup, err := w.Start()
+	if err != nil {
+		return fmt.Errorf("error starting worker: %w", err)
+	}
+
+	go func() {
+		err := c.Event().Push(context.Background(), "order:created", map[string]string{"order_id": "1234"})
+
+		if err != nil {
+			fmt.Println("error pushing event:", err)
+			return
+		}
+
+		select {
+		case <-interruptCtx.Done():
+			return
+		case <-time.After(15 * time.Second):
+		}
+
+		err = c.Event().Push(context.Background(), "order:approval", approvalEvent{OrderId: "1234", Approved: true})
+
+		if err != nil {
+			fmt.Println("error pushing event:", err)
+		}
+	}()
+
+	<-interruptCtx.Done()
+
+	if err := cleanup(); err != nil {
+		return fmt.Errorf("error cleaning up: %w", err)
+	}
+
+	return nil
+}
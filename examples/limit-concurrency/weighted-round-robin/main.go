@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+	"github.com/hatchet-dev/hatchet/pkg/cmdutils"
+	"github.com/hatchet-dev/hatchet/pkg/worker"
+)
+
+type concurrencyLimitEvent struct {
+	TenantId string `json:"tenant_id"`
+	Tier     string `json:"tier"`
+}
+
+func main() {
+	err := godotenv.Load()
+	if err != nil {
+		panic(err)
+	}
+
+	if err := run(cmdutils.InterruptChan()); err != nil {
+		panic(err)
+	}
+}
+
+func run(ch <-chan interface{}) error {
+	c, err := client.New()
+
+	if err != nil {
+		return fmt.Errorf("error creating client: %w", err)
+	}
+
+	w, err := worker.NewWorker(
+		worker.WithClient(
+			c,
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("error creating worker: %w", err)
+	}
+
+	testSvc := w.NewService("test")
+
+	err = testSvc.On(
+		worker.Events("concurrency-test-event-wrr"),
+		&worker.WorkflowJob{
+			Name:        "concurrency-limit-weighted-round-robin",
+			Description: "This limits concurrency to 2 runs per tenant, and 8 runs for paid tenants.",
+			Concurrency: worker.Expression("input.tenant_id").
+				MaxRuns(2).
+				LimitStrategy(types.WeightedRoundRobin).
+				WeightExpression("input.tier == 'paid' ? 4 : 1"),
+			Steps: []*worker.WorkflowStep{
+				worker.Fn(func(ctx worker.HatchetContext) (result *struct{}, err error) {
+					input := &concurrencyLimitEvent{}
+
+					err = ctx.WorkflowInput(input)
+
+					if err != nil {
+						return nil, fmt.Errorf("error getting input: %w", err)
+					}
+
+					fmt.Println("received event", input.TenantId, input.Tier)
+
+					time.Sleep(5 * time.Second)
+
+					fmt.Println("processed event", input.TenantId, input.Tier)
+
+					return nil, nil
+				},
+				).SetName("step-one"),
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error registering workflow: %w", err)
+	}
+
+	interruptCtx, cancel := cmdutils.InterruptContextFromChan(ch)
+	defer cancel()
+
+	cleanup, err := w.Start()
+	if err != nil {
+		return fmt.Errorf("error starting worker: %w", err)
+	}
+
+	go func() {
+		// sleep with interrupt context
+		select {
+		case <-interruptCtx.Done(): // context cancelled
+			fmt.Println("interrupted")
+			return
+		case <-time.After(2 * time.Second): // timeout
+		}
+
+		for i := 0; i < 40; i++ {
+			event := concurrencyLimitEvent{TenantId: "free-tenant", Tier: "free"}
+
+			if i%2 == 0 {
+				event = concurrencyLimitEvent{TenantId: "paid-tenant", Tier: "paid"}
+			}
+
+			err := c.Event().Push(context.Background(), "concurrency-test-event-wrr", event)
+
+			if err != nil {
+				fmt.Println("error pushing event:", err)
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-interruptCtx.Done():
+			if err := cleanup(); err != nil {
+				return fmt.Errorf("error cleaning up: %w", err)
+			}
+			return nil
+		default:
+			time.Sleep(time.Second)
+		}
+	}
+}
@@ -12,7 +12,7 @@ type avgResult struct {
 	avg   time.Duration
 }
 
-func do(duration time.Duration, eventsPerSecond int, delay time.Duration, wait time.Duration, concurrency int, workerDelay time.Duration, slots int, failureRate float32, payloadSize string) error {
+func do(duration time.Duration, eventsPerSecond int, delay time.Duration, wait time.Duration, concurrency int, concurrencyExpression string, workerDelay time.Duration, slots int, failureRate float32, payloadSize string) error {
 	l.Info().Msgf("testing with duration=%s, eventsPerSecond=%d, delay=%s, wait=%s, concurrency=%d", duration, eventsPerSecond, delay, wait, concurrency)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -50,7 +50,7 @@ func do(duration time.Duration, eventsPerSecond int, delay time.Duration, wait t
 			time.Sleep(workerDelay)
 		}
 		l.Info().Msg("starting worker now")
-		count, uniques := run(ctx, delay, durations, concurrency, slots, failureRate)
+		count, uniques := run(ctx, delay, durations, concurrency, concurrencyExpression, slots, failureRate)
 		close(durations)
 		ch <- count
 		ch <- uniques
@@ -20,6 +20,7 @@ var l zerolog.Logger
 func main() {
 	var events int
 	var concurrency int
+	var concurrencyExpression string
 	var duration time.Duration
 	var wait time.Duration
 	var delay time.Duration
@@ -47,7 +48,7 @@ func main() {
 				}()
 			}
 
-			if err := do(duration, events, delay, wait, concurrency, workerDelay, slots, failureRate, payloadSize); err != nil {
+			if err := do(duration, events, delay, wait, concurrency, concurrencyExpression, workerDelay, slots, failureRate, payloadSize); err != nil {
 				log.Println(err)
 				panic("load test failed")
 			}
@@ -56,6 +57,10 @@ func main() {
 
 	loadtest.Flags().IntVarP(&events, "events", "e", 10, "events per second")
 	loadtest.Flags().IntVarP(&concurrency, "concurrency", "c", 0, "concurrency specifies the maximum events to run at the same time")
+	// to compare the engine's CEL program cache against uncached evaluation, run the same load test with
+	// --concurrencyExpression against an engine with the default SERVER_CEL_PROGRAM_CACHE_SIZE and one with
+	// SERVER_CEL_PROGRAM_CACHE_SIZE=0, and compare the reported durations and the engine's hatchet.cel.* metrics.
+	loadtest.Flags().StringVarP(&concurrencyExpression, "concurrencyExpression", "x", "", "concurrencyExpression specifies a CEL expression for the concurrency key, evaluated by the engine instead of the worker")
 	loadtest.Flags().DurationVarP(&duration, "duration", "d", 10*time.Second, "duration specifies the total time to run the load test")
 	loadtest.Flags().DurationVarP(&delay, "delay", "D", 0, "delay specifies the time to wait in each event to simulate slow tasks")
 	loadtest.Flags().DurationVarP(&wait, "wait", "w", 10*time.Second, "wait specifies the total time to wait until events complete")
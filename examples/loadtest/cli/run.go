@@ -19,7 +19,7 @@ func getConcurrencyKey(ctx worker.HatchetContext) (string, error) {
 	return "my-key", nil
 }
 
-func run(ctx context.Context, delay time.Duration, executions chan<- time.Duration, concurrency, slots int, failureRate float32) (int64, int64) {
+func run(ctx context.Context, delay time.Duration, executions chan<- time.Duration, concurrency int, concurrencyExpression string, slots int, failureRate float32) (int64, int64) {
 	c, err := client.New(
 		client.WithLogLevel("warn"),
 	)
@@ -46,7 +46,9 @@ func run(ctx context.Context, delay time.Duration, executions chan<- time.Durati
 	var executed []int64
 
 	var concurrencyOpts *worker.WorkflowConcurrency
-	if concurrency > 0 {
+	if concurrency > 0 && concurrencyExpression != "" {
+		concurrencyOpts = worker.Expression(concurrencyExpression).MaxRuns(int32(concurrency))
+	} else if concurrency > 0 {
 		concurrencyOpts = worker.Concurrency(getConcurrencyKey).MaxRuns(int32(concurrency))
 	}
 
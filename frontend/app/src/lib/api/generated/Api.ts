@@ -14,6 +14,7 @@ import {
   APIErrors,
   APIMeta,
   AcceptInviteRequest,
+  AuditLogList,
   BulkCreateEventRequest,
   CancelEventRequest,
   CreateAPITokenRequest,
@@ -24,9 +25,12 @@ import {
   CreateTenantAlertEmailGroupRequest,
   CreateTenantInviteRequest,
   CreateTenantRequest,
+  CreateTenantRoleRequest,
+  CreateWebhookSubscriptionRequest,
   CronWorkflows,
   CronWorkflowsList,
   CronWorkflowsOrderByField,
+  DeadLetterList,
   Event,
   EventData,
   EventKey,
@@ -45,10 +49,14 @@ import {
   LogLineOrderByDirection,
   LogLineOrderByField,
   LogLineSearch,
+  PurgeDeadLettersRequest,
+  PurgeDeadLettersResponse,
   RateLimitList,
   RateLimitOrderByDirection,
   RateLimitOrderByField,
   RejectInviteRequest,
+  ReplayDeadLettersRequest,
+  ReplayDeadLettersResponse,
   ReplayEventRequest,
   ReplayWorkflowRunsRequest,
   ReplayWorkflowRunsResponse,
@@ -72,26 +80,49 @@ import {
   TenantMemberList,
   TenantQueueMetrics,
   TenantResourcePolicy,
+  TenantRole,
+  TenantRoleList,
   TenantStepRunQueueMetrics,
   TriggerWorkflowRunRequest,
+  UpdateCronWorkflowTriggerRequest,
   UpdateTenantAlertEmailGroupRequest,
   UpdateTenantInviteRequest,
+  UpdateTenantMemberRequest,
   UpdateTenantRequest,
+  UpdateTenantRoleRequest,
+  UpdateWebhookSubscriptionRequest,
   UpdateWorkerRequest,
   User,
   UserChangePasswordRequest,
   UserLoginRequest,
   UserRegisterRequest,
   UserTenantMembershipsList,
+  V2CancelTasksRequest,
+  V2CancelTasksResponse,
+  V2CancelWorkflowRunsRequest,
+  V2CancelWorkflowRunsResponse,
+  V2ConcurrencyStrategyUsageList,
   V2DagChildren,
+  V2RateLimitUsageList,
+  V2ReplayTasksRequest,
+  V2ReplayTasksResponse,
+  V2ReplayWorkflowRunFromTaskRequest,
+  V2ReplayWorkflowRunFromTaskResponse,
+  V2ReplayWorkflowRunsRequest,
+  V2ReplayWorkflowRunsResponse,
   V2Task,
   V2TaskEventList,
   V2TaskPointMetrics,
+  V2TaskQueueDiagnosis,
   V2TaskRunMetrics,
   V2TaskStatus,
   V2TaskSummaryList,
   V2WorkflowRunDetails,
   V2WorkflowRunList,
+  WebhookDeliveryList,
+  WebhookDeliveryStatus,
+  WebhookSubscription,
+  WebhookSubscriptionList,
   WebhookWorkerCreateRequest,
   WebhookWorkerCreated,
   WebhookWorkerListResponse,
@@ -141,6 +172,8 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
        * @format int64
        */
       limit?: number;
+      /** An opaque cursor returned by a previous request, used to fetch the next page of results. When set, offset is ignored and the total count is not computed. */
+      cursor?: string;
       /** A list of task statuses to filter by */
       statuses?: V2TaskStatus[];
       /**
@@ -164,6 +197,16 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
        * @maxLength 36
        */
       worker_id?: string;
+      /** A case-insensitive substring of the task display name to filter by */
+      display_name?: string;
+      /** A case-insensitive substring of a task error message to filter by */
+      error_contains?: string;
+      /** A regular expression matched against task error messages to filter by. Only syntax shared by POSIX and RE2 regular expressions is supported, so flags, lookarounds, backreferences and escapes other than \d, \s, \w, \n, \r and \t are rejected. */
+      error_regex?: string;
+      /** Filters on the task input, of the form `path:value` where path is a dot-separated list of keys. All filters must match. Filters on the same or overlapping paths, such as `a:1` and `a.b:2`, are rejected. */
+      input_filters?: string[];
+      /** Filters on the task output, of the form `path:value` where path is a dot-separated list of keys. All filters must match. Filters on the same or overlapping paths, such as `a:1` and `a.b:2`, are rejected. */
+      output_filters?: string[];
     },
     params: RequestParams = {},
   ) =>
@@ -175,6 +218,44 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Cancels the queued and running tasks which match the external ids or the filter. Tasks are cancelled asynchronously.
+   *
+   * @tags Task
+   * @name V2TaskCancel
+   * @summary Cancel tasks
+   * @request POST:/api/v2/tenants/{tenant}/tasks/cancel
+   * @secure
+   */
+  v2TaskCancel = (tenant: string, data: V2CancelTasksRequest, params: RequestParams = {}) =>
+    this.request<V2CancelTasksResponse, APIErrors>({
+      path: `/api/v2/tenants/${tenant}/tasks/cancel`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Replays the finished tasks which match the external ids or the filter. Each task is queued again with a fresh set of retries. Tasks are replayed asynchronously.
+   *
+   * @tags Task
+   * @name V2TaskReplay
+   * @summary Replay tasks
+   * @request POST:/api/v2/tenants/{tenant}/tasks/replay
+   * @secure
+   */
+  v2TaskReplay = (tenant: string, data: V2ReplayTasksRequest, params: RequestParams = {}) =>
+    this.request<V2ReplayTasksResponse, APIErrors>({
+      path: `/api/v2/tenants/${tenant}/tasks/replay`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Get a task by id
    *
@@ -225,6 +306,23 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Explain why a task has not been assigned to a worker
+   *
+   * @tags Task
+   * @name V2TaskGetQueueDiagnosis
+   * @summary Get the queue diagnosis for a task
+   * @request GET:/api/v2/tasks/{task}/queue-diagnosis
+   * @secure
+   */
+  v2TaskGetQueueDiagnosis = (task: string, params: RequestParams = {}) =>
+    this.request<V2TaskQueueDiagnosis, APIErrors>({
+      path: `/api/v2/tasks/${task}/queue-diagnosis`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Lists all tasks that belong a specific list of dags
    *
@@ -278,6 +376,8 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
        * @format int64
        */
       limit?: number;
+      /** An opaque cursor returned by a previous request, used to fetch the next page of results. When set, offset is ignored and the total count is not computed. */
+      cursor?: string;
       /** A list of statuses to filter by */
       statuses?: V2TaskStatus[];
       /**
@@ -305,6 +405,44 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Cancels the queued and running tasks of the workflow runs which match the external ids or the filter. Tasks are cancelled asynchronously.
+   *
+   * @tags Workflow Runs
+   * @name V2WorkflowRunCancel
+   * @summary Cancel workflow runs
+   * @request POST:/api/v2/tenants/{tenant}/workflow-runs/cancel
+   * @secure
+   */
+  v2WorkflowRunCancel = (tenant: string, data: V2CancelWorkflowRunsRequest, params: RequestParams = {}) =>
+    this.request<V2CancelWorkflowRunsResponse, APIErrors>({
+      path: `/api/v2/tenants/${tenant}/workflow-runs/cancel`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Replays the finished workflow runs which match the external ids or the filter. Workflow runs which consist of a single task are replayed in full. DAG workflow runs are replayed from each of their failed or cancelled tasks which is not downstream of another failed or cancelled task, reusing the outputs of the tasks which completed; DAGs which cannot be replayed are returned in `skipped`. Workflow runs are replayed asynchronously.
+   *
+   * @tags Workflow Runs
+   * @name V2WorkflowRunReplay
+   * @summary Replay workflow runs
+   * @request POST:/api/v2/tenants/{tenant}/workflow-runs/replay
+   * @secure
+   */
+  v2WorkflowRunReplay = (tenant: string, data: V2ReplayWorkflowRunsRequest, params: RequestParams = {}) =>
+    this.request<V2ReplayWorkflowRunsResponse, APIErrors>({
+      path: `/api/v2/tenants/${tenant}/workflow-runs/replay`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Get a workflow run and its metadata to display on the "detail" page
    *
@@ -355,6 +493,29 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Replays a DAG workflow run from one of its tasks. The task and every task downstream of it run again, while the outputs of the other tasks are reused. Every parent of a task which runs again must have completed, and none of the tasks which run again can be queued or running.
+   *
+   * @tags Workflow Runs
+   * @name V2WorkflowRunReplayFromTask
+   * @summary Replay workflow run from task
+   * @request POST:/api/v2/workflow-runs/{v2-workflow-run}/replay-from-task
+   * @secure
+   */
+  v2WorkflowRunReplayFromTask = (
+    v2WorkflowRun: string,
+    data: V2ReplayWorkflowRunFromTaskRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V2ReplayWorkflowRunFromTaskResponse, APIErrors>({
+      path: `/api/v2/workflow-runs/${v2WorkflowRun}/replay-from-task`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Get a summary of task run metrics for a tenant
    *
@@ -420,6 +581,69 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Lists the current usage of the rate limits for a tenant, as seen by the scheduler.
+   *
+   * @tags Rate Limits
+   * @name V2RateLimitList
+   * @summary List rate limit usage
+   * @request GET:/api/v2/tenants/{tenant}/rate-limits
+   * @secure
+   */
+  v2RateLimitList = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /** A substring of the rate limit key to filter by */
+      search?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<V2RateLimitUsageList, APIErrors>({
+      path: `/api/v2/tenants/${tenant}/rate-limits`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Lists the active concurrency strategies for a tenant, with the number of running and queued tasks for each strategy and its busiest keys.
+   *
+   * @tags Concurrency
+   * @name V2ConcurrencyList
+   * @summary List concurrency usage
+   * @request GET:/api/v2/tenants/{tenant}/concurrency
+   * @secure
+   */
+  v2ConcurrencyList = (
+    tenant: string,
+    query?: {
+      /**
+       * The maximum number of keys to return for each strategy, defaults to 50
+       * @format int64
+       */
+      key_limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<V2ConcurrencyStrategyUsageList, APIErrors>({
+      path: `/api/v2/tenants/${tenant}/concurrency`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Gets the readiness status
    *
@@ -568,6 +792,34 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       method: 'GET',
       ...params,
     });
+  /**
+   * @description Starts the OAuth flow
+   *
+   * @tags User
+   * @name UserUpdateOidcOauthStart
+   * @summary Start OAuth flow
+   * @request GET:/api/v1/users/oidc/start
+   */
+  userUpdateOidcOauthStart = (params: RequestParams = {}) =>
+    this.request<any, void>({
+      path: `/api/v1/users/oidc/start`,
+      method: 'GET',
+      ...params,
+    });
+  /**
+   * @description Completes the OAuth flow
+   *
+   * @tags User
+   * @name UserUpdateOidcOauthCallback
+   * @summary Complete OAuth flow
+   * @request GET:/api/v1/users/oidc/callback
+   */
+  userUpdateOidcOauthCallback = (params: RequestParams = {}) =>
+    this.request<any, void>({
+      path: `/api/v1/users/oidc/callback`,
+      method: 'GET',
+      ...params,
+    });
   /**
    * @description Starts the OAuth flow
    *
@@ -1332,6 +1584,88 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Lists the messages on a queue which could not be processed after exhausting their retries.
+   *
+   * @tags Message Queue
+   * @name MessageQueueDeadLetterList
+   * @summary List dead letters
+   * @request GET:/api/v1/tenants/{tenant}/message-queues/{message-queue}/dead-letters
+   * @secure
+   */
+  messageQueueDeadLetterList = (
+    tenant: string,
+    messageQueue: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<DeadLetterList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/message-queues/${messageQueue}/dead-letters`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Sends dead letters back to their message queue with their retries reset, and removes them from the dead letters.
+   *
+   * @tags Message Queue
+   * @name MessageQueueDeadLetterReplay
+   * @summary Replay dead letters
+   * @request POST:/api/v1/tenants/{tenant}/message-queues/{message-queue}/dead-letters/replay
+   * @secure
+   */
+  messageQueueDeadLetterReplay = (
+    tenant: string,
+    messageQueue: string,
+    data: ReplayDeadLettersRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<ReplayDeadLettersResponse, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/message-queues/${messageQueue}/dead-letters/replay`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Deletes dead letters from a message queue.
+   *
+   * @tags Message Queue
+   * @name MessageQueueDeadLetterPurge
+   * @summary Purge dead letters
+   * @request POST:/api/v1/tenants/{tenant}/message-queues/{message-queue}/dead-letters/purge
+   * @secure
+   */
+  messageQueueDeadLetterPurge = (
+    tenant: string,
+    messageQueue: string,
+    data: PurgeDeadLettersRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<PurgeDeadLettersResponse, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/message-queues/${messageQueue}/dead-letters/purge`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Gets a list of tenant members
    *
@@ -1366,6 +1700,163 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Update a member of a tenant. Custom roles can only be assigned to members with the MEMBER role.
+   *
+   * @tags Tenant
+   * @name TenantMemberUpdate
+   * @summary Update a tenant member
+   * @request PATCH:/api/v1/tenants/{tenant}/members/{member}
+   * @secure
+   */
+  tenantMemberUpdate = (
+    tenant: string,
+    member: string,
+    data: UpdateTenantMemberRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<TenantMember, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/members/${member}`,
+      method: 'PATCH',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Lists the custom roles of a tenant
+   *
+   * @tags Tenant
+   * @name TenantRoleList
+   * @summary List tenant roles
+   * @request GET:/api/v1/tenants/{tenant}/roles
+   * @secure
+   */
+  tenantRoleList = (tenant: string, params: RequestParams = {}) =>
+    this.request<TenantRoleList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/roles`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Creates a custom role in a tenant
+   *
+   * @tags Tenant
+   * @name TenantRoleCreate
+   * @summary Create tenant role
+   * @request POST:/api/v1/tenants/{tenant}/roles
+   * @secure
+   */
+  tenantRoleCreate = (tenant: string, data: CreateTenantRoleRequest, params: RequestParams = {}) =>
+    this.request<TenantRole, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/roles`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Updates a custom role in a tenant
+   *
+   * @tags Tenant
+   * @name TenantRoleUpdate
+   * @summary Update tenant role
+   * @request PATCH:/api/v1/tenants/{tenant}/roles/{tenant-role}
+   * @secure
+   */
+  tenantRoleUpdate = (
+    tenant: string,
+    tenantRole: string,
+    data: UpdateTenantRoleRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<TenantRole, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/roles/${tenantRole}`,
+      method: 'PATCH',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Deletes a custom role from a tenant. Members assigned to the role fall back to the permissions of their built-in role.
+   *
+   * @tags Tenant
+   * @name TenantRoleDelete
+   * @summary Delete tenant role
+   * @request DELETE:/api/v1/tenants/{tenant}/roles/{tenant-role}
+   * @secure
+   */
+  tenantRoleDelete = (tenant: string, tenantRole: string, params: RequestParams = {}) =>
+    this.request<TenantRole, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/roles/${tenantRole}`,
+      method: 'DELETE',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Lists the audit log entries of a tenant, most recent first.
+   *
+   * @tags Audit Log
+   * @name AuditLogList
+   * @summary List audit logs
+   * @request GET:/api/v1/tenants/{tenant}/audit-logs
+   * @secure
+   */
+  auditLogList = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /** A list of actions to filter by */
+      actions?: string[];
+      /**
+       * The id of the user or API token to filter by
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      actorId?: string;
+      /** The resource type to filter by */
+      resourceType?: string;
+      /** The resource id to filter by */
+      resourceId?: string;
+      /**
+       * Only return entries created at or after this time
+       * @format date-time
+       */
+      since?: string;
+      /**
+       * Only return entries created at or before this time
+       * @format date-time
+       */
+      until?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<AuditLogList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/audit-logs`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Get an event.
    *
@@ -1682,6 +2173,30 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       secure: true,
       ...params,
     });
+  /**
+   * @description Update the missed fire policy, timezone and overlap policy of a cron job workflow trigger for a tenant
+   *
+   * @tags Workflow
+   * @name WorkflowCronUpdate
+   * @summary Update cron job workflow trigger
+   * @request PATCH:/api/v1/tenants/{tenant}/workflows/crons/{cron-workflow}
+   * @secure
+   */
+  workflowCronUpdate = (
+    tenant: string,
+    cronWorkflow: string,
+    data: UpdateCronWorkflowTriggerRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<CronWorkflows, APIErrors | APIError>({
+      path: `/api/v1/tenants/${tenant}/workflows/crons/${cronWorkflow}`,
+      method: 'PATCH',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Cancel a batch of workflow runs
    *
@@ -2343,6 +2858,123 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Lists the outbound webhook subscriptions of a tenant
+   *
+   * @tags Webhook Subscription
+   * @name WebhookSubscriptionList
+   * @summary List webhook subscriptions
+   * @request GET:/api/v1/tenants/{tenant}/webhook-subscriptions
+   * @secure
+   */
+  webhookSubscriptionList = (tenant: string, params: RequestParams = {}) =>
+    this.request<WebhookSubscriptionList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/webhook-subscriptions`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Creates an outbound webhook subscription in a tenant, which run lifecycle events are delivered to
+   *
+   * @tags Webhook Subscription
+   * @name WebhookSubscriptionCreate
+   * @summary Create webhook subscription
+   * @request POST:/api/v1/tenants/{tenant}/webhook-subscriptions
+   * @secure
+   */
+  webhookSubscriptionCreate = (
+    tenant: string,
+    data: CreateWebhookSubscriptionRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<WebhookSubscription, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/webhook-subscriptions`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Updates an outbound webhook subscription
+   *
+   * @tags Webhook Subscription
+   * @name WebhookSubscriptionUpdate
+   * @summary Update webhook subscription
+   * @request PATCH:/api/v1/tenants/{tenant}/webhook-subscriptions/{webhook-subscription}
+   * @secure
+   */
+  webhookSubscriptionUpdate = (
+    tenant: string,
+    webhookSubscription: string,
+    data: UpdateWebhookSubscriptionRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<WebhookSubscription, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/webhook-subscriptions/${webhookSubscription}`,
+      method: 'PATCH',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Deletes an outbound webhook subscription, along with its deliveries
+   *
+   * @tags Webhook Subscription
+   * @name WebhookSubscriptionDelete
+   * @summary Delete webhook subscription
+   * @request DELETE:/api/v1/tenants/{tenant}/webhook-subscriptions/{webhook-subscription}
+   * @secure
+   */
+  webhookSubscriptionDelete = (tenant: string, webhookSubscription: string, params: RequestParams = {}) =>
+    this.request<WebhookSubscription, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/webhook-subscriptions/${webhookSubscription}`,
+      method: 'DELETE',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Lists the deliveries of a webhook subscription along with each delivery attempt, most recent first. Deliveries are retained for 7 days.
+   *
+   * @tags Webhook Subscription
+   * @name WebhookDeliveryList
+   * @summary List webhook deliveries
+   * @request GET:/api/v1/tenants/{tenant}/webhook-subscriptions/{webhook-subscription}/deliveries
+   * @secure
+   */
+  webhookDeliveryList = (
+    tenant: string,
+    webhookSubscription: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /** A list of delivery statuses to filter by */
+      statuses?: WebhookDeliveryStatus[];
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WebhookDeliveryList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/webhook-subscriptions/${webhookSubscription}/deliveries`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Lists all webhooks
    *
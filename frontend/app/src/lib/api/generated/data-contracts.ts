@@ -31,6 +31,8 @@ export interface PaginationResponse {
    * @example 3
    */
   next_page?: number;
+  /** an opaque cursor for the next page of results, only set for endpoints which support cursor pagination */
+  next_cursor?: string;
   /**
    * the total number of pages for listing
    * @format int64
@@ -216,6 +218,8 @@ export enum V2TaskEventType {
   CREATED = 'CREATED',
   QUEUED = 'QUEUED',
   SKIPPED = 'SKIPPED',
+  REPLAYED = 'REPLAYED',
+  RESET_BY_REPLAY = 'RESET_BY_REPLAY',
 }
 
 export interface V2TaskEvent {
@@ -318,6 +322,265 @@ export interface V2WorkflowRunDetails {
   tasks: V2TaskSummary[];
 }
 
+export interface V2WorkflowRunFilter {
+  /**
+   * The earliest date to filter by
+   * @format date-time
+   */
+  since: string;
+  /**
+   * The latest date to filter by
+   * @format date-time
+   */
+  until?: string;
+  /** A list of statuses to filter by */
+  statuses?: V2TaskStatus[];
+  /** The workflow ids to filter by */
+  workflowIds?: string[];
+  /** Additional metadata k-v pairs to filter by, of the form `key:value` */
+  additionalMetadata?: string[];
+}
+
+/** Selects the workflow runs to cancel, either by external id or by a filter. Exactly one of externalIds and filter must be set. A filter can match at most 10000 workflow runs. */
+export interface V2CancelWorkflowRunsRequest {
+  /**
+   * The external ids of the workflow runs to cancel. At most 1000 ids can be passed.
+   * @maxItems 1000
+   */
+  externalIds?: string[];
+  filter?: V2WorkflowRunFilter;
+}
+
+export interface V2CancelWorkflowRunsResponse {
+  /** The external ids of the workflow runs which were cancelled */
+  externalIds: string[];
+}
+
+/** Selects the workflow runs to replay, either by external id or by a filter. Exactly one of externalIds and filter must be set. A filter can match at most 10000 workflow runs. */
+export interface V2ReplayWorkflowRunsRequest {
+  /**
+   * The external ids of the workflow runs to replay. At most 1000 ids can be passed.
+   * @maxItems 1000
+   */
+  externalIds?: string[];
+  filter?: V2WorkflowRunFilter;
+}
+
+export interface V2ReplayWorkflowRunsResponse {
+  /** The external ids of the workflow runs which were replayed */
+  externalIds: string[];
+  /** The DAG workflow runs which could not be replayed */
+  skipped: V2SkippedWorkflowRun[];
+}
+
+export interface V2SkippedWorkflowRun {
+  /**
+   * The external id of the workflow run
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  externalId: string;
+  /** Why the workflow run could not be replayed */
+  reason: string;
+}
+
+export interface V2ReplayWorkflowRunFromTaskRequest {
+  /**
+   * The external id of the task to replay the workflow run from
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  taskExternalId: string;
+}
+
+export interface V2ReplayWorkflowRunFromTaskResponse {
+  /** The external ids of the tasks which will run again, starting with the task which the workflow run was replayed from */
+  externalIds: string[];
+}
+
+export enum V2RateLimitAlgorithm {
+  FIXED_WINDOW = 'FIXED_WINDOW',
+  TOKEN_BUCKET = 'TOKEN_BUCKET',
+  SLIDING_WINDOW = 'SLIDING_WINDOW',
+}
+
+export interface V2RateLimitUsage {
+  /** The key for the rate limit. */
+  key: string;
+  algorithm: V2RateLimitAlgorithm;
+  /** The number of units which are added to the rate limit in each window. */
+  limitValue: number;
+  /** The maximum number of units which can accumulate in a token bucket. */
+  burst: number;
+  /** The number of units which can currently be used. */
+  available: number;
+  /** The window of time in which the limitValue is enforced. */
+  window: string;
+  /**
+   * The last time the rate limit was refilled.
+   * @format date-time
+   */
+  lastRefill: string;
+  /**
+   * The next time that units are added to the rate limit. Not set for token buckets which are full.
+   * @format date-time
+   */
+  nextRefillAt?: string;
+  /** Whether the rate limit was created from a dynamic rate limit key. */
+  dynamic: boolean;
+  /**
+   * The last time that units of the rate limit were used.
+   * @format date-time
+   */
+  lastUsedAt: string;
+}
+
+export interface V2RateLimitUsageList {
+  pagination: PaginationResponse;
+  rows: V2RateLimitUsage[];
+}
+
+export enum V2ConcurrencyStrategy {
+  NONE = 'NONE',
+  GROUP_ROUND_ROBIN = 'GROUP_ROUND_ROBIN',
+  CANCEL_IN_PROGRESS = 'CANCEL_IN_PROGRESS',
+  CANCEL_NEWEST = 'CANCEL_NEWEST',
+  WEIGHTED_ROUND_ROBIN = 'WEIGHTED_ROUND_ROBIN',
+}
+
+export interface V2ConcurrencyKeyUsage {
+  /** The concurrency key. */
+  key: string;
+  /** The number of tasks which have been assigned a concurrency slot for the key. */
+  running: number;
+  /** The number of tasks which are waiting for a concurrency slot for the key. */
+  queued: number;
+  /** Whether the key has no free concurrency slots. */
+  saturated: boolean;
+}
+
+export interface V2ConcurrencyStrategyUsage {
+  /**
+   * The ID of the concurrency strategy.
+   * @format int64
+   */
+  id: number;
+  /**
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId: string;
+  workflowName: string;
+  /**
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowVersionId: string;
+  /**
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  stepId: string;
+  stepReadableId?: string;
+  strategy: V2ConcurrencyStrategy;
+  /** The CEL expression which evaluates to the concurrency key of a task. */
+  expression: string;
+  /** The maximum number of tasks which can run concurrently for each key. */
+  maxConcurrency: number;
+  /** The number of tasks which have been assigned a concurrency slot. */
+  running: number;
+  /** The number of tasks which are waiting for a concurrency slot. */
+  queued: number;
+  /** The number of distinct concurrency keys with running or queued tasks. */
+  keyCount: number;
+  /** The keys with the most queued tasks. */
+  keys: V2ConcurrencyKeyUsage[];
+}
+
+export interface V2ConcurrencyStrategyUsageList {
+  rows: V2ConcurrencyStrategyUsage[];
+}
+
+export enum V2TaskQueueReason {
+  NOT_QUEUED = 'NOT_QUEUED',
+  WAITING_FOR_RETRY = 'WAITING_FOR_RETRY',
+  CONCURRENCY_LIMITED = 'CONCURRENCY_LIMITED',
+  SCHEDULE_TIMED_OUT = 'SCHEDULE_TIMED_OUT',
+  NO_WORKER_FOR_ACTION = 'NO_WORKER_FOR_ACTION',
+  STICKY_WORKER_UNAVAILABLE = 'STICKY_WORKER_UNAVAILABLE',
+  LABELS_NOT_MATCHED = 'LABELS_NOT_MATCHED',
+  NO_AVAILABLE_SLOTS = 'NO_AVAILABLE_SLOTS',
+  RATE_LIMITED = 'RATE_LIMITED',
+  ASSIGNABLE = 'ASSIGNABLE',
+}
+
+export interface V2TaskQueueDiagnosisReason {
+  reason: V2TaskQueueReason;
+  /** A human-readable explanation of the reason. */
+  message: string;
+}
+
+export interface V2TaskQueueWorkerDiagnosis {
+  /**
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workerId: string;
+  /** Whether the worker has registered the task's action. */
+  hasAction: boolean;
+  /** The number of free slots on the worker. */
+  availableSlots: number;
+  /** The required affinity labels which the worker doesn't match. */
+  unmatchedLabels: string[];
+  excludedReason?: V2TaskQueueReason;
+}
+
+export interface V2TaskQueueRateLimitDiagnosis {
+  key: string;
+  /** The number of units that the task consumes. */
+  units: number;
+  /** The number of units which are currently available. Not set if the rate limit doesn't exist. */
+  available?: number;
+  /**
+   * The next time that units are added to the rate limit.
+   * @format date-time
+   */
+  nextRefillAt?: string;
+  /** Whether the rate limit is preventing the task from being assigned. */
+  exceeded: boolean;
+}
+
+export interface V2TaskQueueConcurrencyDiagnosis {
+  /**
+   * The ID of the concurrency strategy.
+   * @format int64
+   */
+  strategyId: number;
+  strategy: V2ConcurrencyStrategy;
+  expression: string;
+  /** The concurrency key of the task. */
+  key: string;
+  /** Whether the task holds a concurrency slot. */
+  isFilled: boolean;
+  /** The number of filled concurrency slots for the key. */
+  filledSlots: number;
+  maxConcurrency: number;
+}
+
+export interface V2TaskQueueDiagnosis {
+  /** The reasons that the task hasn't been assigned to a worker. */
+  reasons: V2TaskQueueDiagnosisReason[];
+  workers: V2TaskQueueWorkerDiagnosis[];
+  rateLimits: V2TaskQueueRateLimitDiagnosis[];
+  concurrency: V2TaskQueueConcurrencyDiagnosis[];
+}
+
 export interface V2TaskRunMetric {
   status: V2TaskStatus;
   count: number;
@@ -336,6 +599,72 @@ export interface V2TaskPointMetrics {
   results?: V2TaskPointMetric[];
 }
 
+export interface V2TaskFilter {
+  /**
+   * The earliest date to filter by
+   * @format date-time
+   */
+  since: string;
+  /**
+   * The latest date to filter by
+   * @format date-time
+   */
+  until?: string;
+  /** A list of statuses to filter by */
+  statuses?: V2TaskStatus[];
+  /** The workflow ids to filter by */
+  workflowIds?: string[];
+  /**
+   * The worker id to filter by
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workerId?: string;
+  /** Additional metadata k-v pairs to filter by, of the form `key:value` */
+  additionalMetadata?: string[];
+  /** A case-insensitive substring of the task display name to filter by */
+  displayName?: string;
+  /** A case-insensitive substring of task error messages to filter by */
+  errorContains?: string;
+  /** A regular expression matched against task error messages to filter by. Only syntax shared by POSIX and RE2 regular expressions is supported, so flags, lookarounds, backreferences and escapes other than \d, \s, \w, \n, \r and \t are rejected. */
+  errorRegex?: string;
+  /** Filters on the task input, of the form `path:value` where path is a dot-separated list of keys. All filters must match. Filters on the same or overlapping paths, such as `a:1` and `a.b:2`, are rejected. */
+  inputFilters?: string[];
+  /** Filters on the task output, of the form `path:value` where path is a dot-separated list of keys. All filters must match. Filters on the same or overlapping paths, such as `a:1` and `a.b:2`, are rejected. */
+  outputFilters?: string[];
+}
+
+/** Selects the tasks to cancel, either by external id or by a filter. Exactly one of externalIds and filter must be set. A filter can match at most 10000 tasks. */
+export interface V2CancelTasksRequest {
+  /**
+   * The external ids of the tasks to cancel. At most 1000 ids can be passed.
+   * @maxItems 1000
+   */
+  externalIds?: string[];
+  filter?: V2TaskFilter;
+}
+
+export interface V2CancelTasksResponse {
+  /** The external ids of the tasks which were cancelled */
+  externalIds: string[];
+}
+
+/** Selects the tasks to replay, either by external id or by a filter. Exactly one of externalIds and filter must be set. A filter can match at most 10000 tasks. */
+export interface V2ReplayTasksRequest {
+  /**
+   * The external ids of the tasks to replay. At most 1000 ids can be passed.
+   * @maxItems 1000
+   */
+  externalIds?: string[];
+  filter?: V2TaskFilter;
+}
+
+export interface V2ReplayTasksResponse {
+  /** The external ids of the tasks which were replayed */
+  externalIds: string[];
+}
+
 export interface APIMetaAuth {
   /**
    * the supported types of authentication
@@ -579,6 +908,11 @@ export interface TenantMember {
   role: TenantMemberRole;
   /** The tenant associated with this tenant member. */
   tenant?: Tenant;
+  /**
+   * The id of the custom role assigned to the member, which restricts the member to the permissions of the role.
+   * @format uuid
+   */
+  customRoleId?: string;
 }
 
 export interface UserTenantMembershipsList {
@@ -681,6 +1015,108 @@ export interface UpdateTenantInviteRequest {
   role: TenantMemberRole;
 }
 
+export interface UpdateTenantMemberRequest {
+  /**
+   * The id of the custom role to assign to the member. If omitted, the member's custom role is removed.
+   * @format uuid
+   */
+  customRoleId?: string;
+}
+
+export enum TenantPermission {
+  WorkflowsRead = 'workflows:read',
+  WorkflowsWrite = 'workflows:write',
+  WorkflowsTrigger = 'workflows:trigger',
+  RunsRead = 'runs:read',
+  RunsCancel = 'runs:cancel',
+  RunsReplay = 'runs:replay',
+  EventsRead = 'events:read',
+  EventsWrite = 'events:write',
+  WorkersRead = 'workers:read',
+  WorkersWrite = 'workers:write',
+  QueuesRead = 'queues:read',
+  SettingsRead = 'settings:read',
+  SettingsWrite = 'settings:write',
+}
+
+export interface TenantRole {
+  metadata: APIResourceMeta;
+  /** The name of the role. */
+  name: string;
+  /** A description of the role. */
+  description?: string;
+  /** The permissions granted by the role. */
+  permissions: TenantPermission[];
+  /** The workflows which workflow and run permissions are restricted to. If empty, the permissions apply to every workflow. */
+  workflowIds: string[];
+}
+
+export interface TenantRoleList {
+  rows?: TenantRole[];
+}
+
+export interface CreateTenantRoleRequest {
+  /** The name of the role. */
+  name: string;
+  /** A description of the role. */
+  description?: string;
+  /** The permissions granted by the role. */
+  permissions: TenantPermission[];
+  /** The workflows which workflow and run permissions are restricted to. If omitted, the permissions apply to every workflow. */
+  workflowIds?: string[];
+}
+
+export interface UpdateTenantRoleRequest {
+  /** The name of the role. */
+  name?: string;
+  /** A description of the role. */
+  description?: string;
+  /** The permissions granted by the role. */
+  permissions?: TenantPermission[];
+  /** The workflows which workflow and run permissions are restricted to. An empty list removes the restriction. */
+  workflowIds?: string[];
+}
+
+export enum AuditLogActorKind {
+  USER = 'USER',
+  API_TOKEN = 'API_TOKEN',
+}
+
+export enum AuditLogSource {
+  API = 'API',
+  GRPC = 'GRPC',
+}
+
+export interface AuditLog {
+  metadata: APIResourceMeta;
+  actorKind: AuditLogActorKind;
+  /**
+   * The id of the user or API token which performed the action.
+   * @format uuid
+   */
+  actorId: string;
+  /** The email of the user or the name of the API token which performed the action. */
+  actorName?: string;
+  source: AuditLogSource;
+  /** The action which was performed. */
+  action: string;
+  /** The type of the resource which the action was performed on. */
+  resourceType: string;
+  /** The id of the resource which the action was performed on. */
+  resourceId?: string;
+  /** The IP address which the request was made from. */
+  ipAddress?: string;
+  /** The user agent of the request. */
+  userAgent?: string;
+  /** Additional metadata about the request. */
+  requestMetadata: object;
+}
+
+export interface AuditLogList {
+  pagination?: PaginationResponse;
+  rows?: AuditLog[];
+}
+
 export interface APIToken {
   metadata: APIResourceMeta;
   /**
@@ -693,6 +1129,17 @@ export interface APIToken {
    * @format date-time
    */
   expiresAt: string;
+  /** The scopes granted to the API token. */
+  scopes: APITokenScope[];
+}
+
+export enum APITokenScope {
+  Admin = 'admin',
+  WorkflowsTrigger = 'workflows:trigger',
+  EventsPush = 'events:push',
+  RunsRead = 'runs:read',
+  RunsCancel = 'runs:cancel',
+  WorkersRegister = 'workers:register',
 }
 
 export interface ListAPITokensResponse {
@@ -708,6 +1155,8 @@ export interface CreateAPITokenRequest {
   name: string;
   /** The duration for which the token is valid. */
   expiresIn?: string;
+  /** The scopes to grant to the API token. If not set, the token has the admin scope. */
+  scopes?: APITokenScope[];
 }
 
 export interface CreateAPITokenResponse {
@@ -872,6 +1321,67 @@ export interface RateLimitList {
   rows?: RateLimit[];
 }
 
+export interface DeadLetter {
+  /**
+   * The id of the dead letter.
+   * @format int64
+   */
+  id: number;
+  /**
+   * When the message was moved to the dead letters.
+   * @format date-time
+   */
+  createdAt: string;
+  /** The name of the message queue the message was read from. */
+  queue: string;
+  /**
+   * The tenant of the message.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  tenantId?: string;
+  /** The id of the message, which identifies the type of message. */
+  messageId: string;
+  /** A preview of the message payloads, truncated to 1000 characters. */
+  payloadPreview: string;
+  /** The error returned by the last attempt to process the message. */
+  error?: string;
+  /** The number of times the message was retried. */
+  retryCount: number;
+}
+
+export interface DeadLetterList {
+  pagination?: PaginationResponse;
+  rows?: DeadLetter[];
+}
+
+export interface ReplayDeadLettersRequest {
+  /**
+   * The ids of the dead letters to replay. If empty, the oldest dead letters on the queue are replayed, up to 1000.
+   * @maxItems 1000
+   */
+  ids?: number[];
+}
+
+export interface ReplayDeadLettersResponse {
+  /** The number of replayed dead letters. */
+  count: number;
+}
+
+export interface PurgeDeadLettersRequest {
+  /**
+   * The ids of the dead letters to purge. If empty, all dead letters on the queue are purged.
+   * @maxItems 1000
+   */
+  ids?: number[];
+}
+
+export interface PurgeDeadLettersResponse {
+  /** The number of purged dead letters. */
+  count: number;
+}
+
 export interface TenantMemberList {
   pagination?: PaginationResponse;
   rows?: TenantMember[];
@@ -1016,6 +1526,31 @@ export interface CreateCronWorkflowTriggerRequest {
   additionalMetadata: object;
   cronName: string;
   cronExpression: string;
+  /** How fires which were missed while the engine was unavailable are handled. SKIP drops missed fires, FIRE_ONCE runs the most recent missed fire and CATCH_UP runs up to catchUpLimit of the most recent missed fires. */
+  missedFirePolicy?: CronWorkflowsMissedFirePolicy;
+  /**
+   * @min 0
+   * @max 100
+   */
+  catchUpLimit?: number;
+  /** The IANA timezone which the cron expression is evaluated in, for example America/New_York. */
+  timezone?: string;
+  /** How a fire is handled while the previous run of the cron is still running. ALLOW starts a new run, SKIP_IF_RUNNING drops the fire, CANCEL_PREVIOUS cancels the previous run and QUEUE_ONE starts a single run once the previous run finishes. */
+  overlapPolicy?: CronWorkflowsOverlapPolicy;
+}
+
+export interface UpdateCronWorkflowTriggerRequest {
+  /** How fires which were missed while the engine was unavailable are handled. SKIP drops missed fires, FIRE_ONCE runs the most recent missed fire and CATCH_UP runs up to catchUpLimit of the most recent missed fires. */
+  missedFirePolicy?: CronWorkflowsMissedFirePolicy;
+  /**
+   * @min 0
+   * @max 100
+   */
+  catchUpLimit?: number;
+  /** The IANA timezone which the cron expression is evaluated in, for example America/New_York. An empty string resets the timezone to UTC. */
+  timezone?: string;
+  /** How a fire is handled while the previous run of the cron is still running. ALLOW starts a new run, SKIP_IF_RUNNING drops the fire, CANCEL_PREVIOUS cancels the previous run and QUEUE_ONE starts a single run once the previous run finishes. */
+  overlapPolicy?: CronWorkflowsOverlapPolicy;
 }
 
 export enum CronWorkflowsMethod {
@@ -1023,6 +1558,21 @@ export enum CronWorkflowsMethod {
   API = 'API',
 }
 
+/** How fires which were missed while the engine was unavailable are handled. SKIP drops missed fires, FIRE_ONCE runs the most recent missed fire and CATCH_UP runs up to catchUpLimit of the most recent missed fires. */
+export enum CronWorkflowsMissedFirePolicy {
+  SKIP = 'SKIP',
+  FIRE_ONCE = 'FIRE_ONCE',
+  CATCH_UP = 'CATCH_UP',
+}
+
+/** How a fire is handled while the previous run of the cron is still running. ALLOW starts a new run, SKIP_IF_RUNNING drops the fire, CANCEL_PREVIOUS cancels the previous run and QUEUE_ONE starts a single run once the previous run finishes. */
+export enum CronWorkflowsOverlapPolicy {
+  ALLOW = 'ALLOW',
+  SKIP_IF_RUNNING = 'SKIP_IF_RUNNING',
+  CANCEL_PREVIOUS = 'CANCEL_PREVIOUS',
+  QUEUE_ONE = 'QUEUE_ONE',
+}
+
 export interface CronWorkflows {
   metadata: APIResourceMeta;
   tenantId: string;
@@ -1035,6 +1585,19 @@ export interface CronWorkflows {
   additionalMetadata?: Record<string, any>;
   enabled: boolean;
   method: CronWorkflowsMethod;
+  /** How fires which were missed while the engine was unavailable are handled. SKIP drops missed fires, FIRE_ONCE runs the most recent missed fire and CATCH_UP runs up to catchUpLimit of the most recent missed fires. */
+  missedFirePolicy: CronWorkflowsMissedFirePolicy;
+  /** The maximum number of missed fires which are run with the CATCH_UP policy */
+  catchUpLimit: number;
+  /**
+   * The next time the cron is due to fire
+   * @format date-time
+   */
+  nextFireAt?: string;
+  /** The IANA timezone which the cron expression is evaluated in, or UTC if unset */
+  timezone?: string;
+  /** How a fire is handled while the previous run of the cron is still running. ALLOW starts a new run, SKIP_IF_RUNNING drops the fire, CANCEL_PREVIOUS cancels the previous run and QUEUE_ONE starts a single run once the previous run finishes. */
+  overlapPolicy: CronWorkflowsOverlapPolicy;
 }
 
 export enum CronWorkflowsOrderByField {
@@ -1629,6 +2192,123 @@ export interface WebhookWorkerRequestListResponse {
   requests?: WebhookWorkerRequest[];
 }
 
+export enum WebhookSubscriptionEventType {
+  WORKFLOW_RUN_COMPLETED = 'WORKFLOW_RUN_COMPLETED',
+  WORKFLOW_RUN_FAILED = 'WORKFLOW_RUN_FAILED',
+  WORKFLOW_RUN_CANCELLED = 'WORKFLOW_RUN_CANCELLED',
+  TASK_COMPLETED = 'TASK_COMPLETED',
+  TASK_FAILED = 'TASK_FAILED',
+  TASK_CANCELLED = 'TASK_CANCELLED',
+  TASK_TIMED_OUT = 'TASK_TIMED_OUT',
+}
+
+export enum WebhookSubscriptionRunStatus {
+  COMPLETED = 'COMPLETED',
+  FAILED = 'FAILED',
+  CANCELLED = 'CANCELLED',
+}
+
+export interface WebhookSubscription {
+  metadata: APIResourceMeta;
+  /** The name of the webhook subscription. */
+  name: string;
+  /** The https url which events are delivered to. */
+  url: string;
+  /** Whether events are delivered to the webhook subscription. */
+  enabled: boolean;
+  /** The event types which are delivered. If empty, every event type is delivered. */
+  eventTypes: WebhookSubscriptionEventType[];
+  /** The run statuses which events are delivered for. If empty, events for every status are delivered. */
+  statuses: WebhookSubscriptionRunStatus[];
+  /** The workflows which events are delivered for. If empty, events for every workflow are delivered. */
+  workflowIds: string[];
+  /** The secret which deliveries are signed with. Only returned when the webhook subscription is created. */
+  secret?: string;
+}
+
+export interface WebhookSubscriptionList {
+  rows?: WebhookSubscription[];
+}
+
+export interface CreateWebhookSubscriptionRequest {
+  /** The name of the webhook subscription. */
+  name: string;
+  /** The https url which events are delivered to. */
+  url: string;
+  /**
+   * The secret which deliveries are signed with. If not provided, a random secret will be generated.
+   * @minLength 32
+   */
+  secret?: string;
+  /** Whether events are delivered to the webhook subscription. Defaults to true. */
+  enabled?: boolean;
+  /** The event types to deliver. If empty, every event type is delivered. */
+  eventTypes?: WebhookSubscriptionEventType[];
+  /** The run statuses to deliver events for. If empty, events for every status are delivered. */
+  statuses?: WebhookSubscriptionRunStatus[];
+  /** The workflows to deliver events for. If empty, events for every workflow are delivered. */
+  workflowIds?: string[];
+}
+
+export interface UpdateWebhookSubscriptionRequest {
+  /** The name of the webhook subscription. */
+  name?: string;
+  /** The https url which events are delivered to. */
+  url?: string;
+  /** Whether events are delivered to the webhook subscription. */
+  enabled?: boolean;
+  /** The event types to deliver. An empty list delivers every event type. */
+  eventTypes?: WebhookSubscriptionEventType[];
+  /** The run statuses to deliver events for. An empty list delivers events for every status. */
+  statuses?: WebhookSubscriptionRunStatus[];
+  /** The workflows to deliver events for. An empty list removes the restriction. */
+  workflowIds?: string[];
+}
+
+export enum WebhookDeliveryStatus {
+  PENDING = 'PENDING',
+  SUCCEEDED = 'SUCCEEDED',
+  FAILED = 'FAILED',
+}
+
+export interface WebhookDeliveryAttempt {
+  /** The number of the attempt, starting at 1. */
+  attempt: number;
+  /**
+   * When the attempt was made.
+   * @format date-time
+   */
+  createdAt: string;
+  /** The status code of the response, if a response was received. */
+  responseStatusCode?: number;
+  /** The start of the response body. */
+  responseBody?: string;
+  /** The error which caused the attempt to fail. */
+  error?: string;
+  /** How long the request took, in milliseconds. */
+  durationMs: number;
+}
+
+export interface WebhookDelivery {
+  metadata: APIResourceMeta;
+  eventType: WebhookSubscriptionEventType;
+  status: WebhookDeliveryStatus;
+  /** The JSON body which is delivered. */
+  payload: object;
+  /**
+   * When the delivery will next be attempted, if it is pending.
+   * @format date-time
+   */
+  nextAttemptAt?: string;
+  /** The attempts to deliver the event, in order. */
+  attempts: WebhookDeliveryAttempt[];
+}
+
+export interface WebhookDeliveryList {
+  pagination?: PaginationResponse;
+  rows?: WebhookDelivery[];
+}
+
 export interface TenantList {
   pagination?: PaginationResponse;
   rows?: Tenant[];
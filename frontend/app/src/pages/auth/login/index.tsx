@@ -10,6 +10,7 @@ import { Loading } from '@/components/ui/loading';
 import { Icons } from '@/components/ui/icons';
 import useErrorParam from '../hooks/use-error-param';
 import React from 'react';
+import { LockClosedIcon } from '@radix-ui/react-icons';
 
 export default function Login() {
   useErrorParam();
@@ -23,13 +24,15 @@ export default function Login() {
   const basicEnabled = schemes.includes('basic');
   const googleEnabled = schemes.includes('google');
   const githubEnabled = schemes.includes('github');
+  const oidcEnabled = schemes.includes('oidc');
+  const providerEnabled = googleEnabled || githubEnabled || oidcEnabled;
 
   let prompt = 'Enter your email and password below.';
 
-  if (basicEnabled && (googleEnabled || githubEnabled)) {
+  if (basicEnabled && providerEnabled) {
     prompt =
       'Enter your email and password below, or continue with a supported provider.';
-  } else if (googleEnabled || githubEnabled) {
+  } else if (providerEnabled) {
     prompt = 'Continue with a supported provider.';
   } else if (basicEnabled) {
     prompt = 'Enter your email and password below.';
@@ -41,6 +44,7 @@ export default function Login() {
     basicEnabled && <BasicLogin />,
     googleEnabled && <GoogleLogin />,
     githubEnabled && <GithubLogin />,
+    oidcEnabled && <OidcLogin />,
   ].filter(Boolean);
 
   return (
@@ -166,3 +170,14 @@ export function GithubLogin() {
     </a>
   );
 }
+
+export function OidcLogin() {
+  return (
+    <a href="/api/v1/users/oidc/start" className="w-full">
+      <Button variant="outline" type="button" className="w-full py-2">
+        <LockClosedIcon className="mr-2 h-4 w-4" />
+        Single sign-on
+      </Button>
+    </a>
+  );
+}
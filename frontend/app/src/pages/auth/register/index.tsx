@@ -6,7 +6,12 @@ import { useState } from 'react';
 import { useApiError } from '@/lib/hooks';
 import useApiMeta from '../hooks/use-api-meta';
 import { Loading } from '@/components/ui/loading';
-import { GithubLogin, GoogleLogin, OrContinueWith } from '../login';
+import {
+  GithubLogin,
+  GoogleLogin,
+  OidcLogin,
+  OrContinueWith,
+} from '../login';
 import useErrorParam from '../hooks/use-error-param';
 import React from 'react';
 
@@ -22,13 +27,15 @@ export default function Register() {
   const basicEnabled = schemes.includes('basic');
   const googleEnabled = schemes.includes('google');
   const githubEnabled = schemes.includes('github');
+  const oidcEnabled = schemes.includes('oidc');
+  const providerEnabled = googleEnabled || githubEnabled || oidcEnabled;
 
   let prompt = 'Create an account to get started.';
 
-  if (basicEnabled && (googleEnabled || githubEnabled)) {
+  if (basicEnabled && providerEnabled) {
     prompt =
       'Enter your email and password to create an account, or continue with a supported provider.';
-  } else if (googleEnabled || githubEnabled) {
+  } else if (providerEnabled) {
     prompt = 'Continue with a supported provider.';
   } else if (basicEnabled) {
     prompt = 'Create an account to get started.';
@@ -40,6 +47,7 @@ export default function Register() {
     basicEnabled && <BasicRegister />,
     googleEnabled && <GoogleLogin />,
     githubEnabled && <GithubLogin />,
+    oidcEnabled && <OidcLogin />,
   ].filter(Boolean);
 
   return (
@@ -35,6 +35,8 @@ function eventTypeToSeverity(
     case V2TaskEventType.REQUEUED_NO_WORKER:
     case V2TaskEventType.REQUEUED_RATE_LIMIT:
     case V2TaskEventType.RETRIED_BY_USER:
+    case V2TaskEventType.REPLAYED:
+    case V2TaskEventType.RESET_BY_REPLAY:
     case V2TaskEventType.RETRYING:
       return StepRunEventSeverity.WARNING;
     default:
@@ -197,6 +199,10 @@ function mapEventTypeToTitle(eventType: V2TaskEventType | undefined): string {
       return 'Queued';
     case V2TaskEventType.SKIPPED:
       return 'Skipped';
+    case V2TaskEventType.REPLAYED:
+      return 'Replayed from this task';
+    case V2TaskEventType.RESET_BY_REPLAY:
+      return 'Reset by an upstream replay';
     case undefined:
       return 'Unknown';
     default:
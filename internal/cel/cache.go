@@ -0,0 +1,141 @@
+package cel
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// DefaultProgramCacheSize is the default number of compiled programs which are cached by a CELParser.
+const DefaultProgramCacheSize = 5000
+
+type celEnvKind string
+
+const (
+	celEnvWorkflowString celEnvKind = "workflow_string"
+	celEnvStepRun        celEnvKind = "step_run"
+	celEnvMatchCondition celEnvKind = "match_condition"
+)
+
+type programCacheKey struct {
+	env     celEnvKind
+	version uint32
+	expr    string
+}
+
+// programCacheEntry stores the result of compiling an expression. Compile errors are cached as well, so
+// that invalid expressions which were stored before they were validated aren't compiled on every
+// evaluation.
+type programCacheEntry struct {
+	program cel.Program
+	err     error
+}
+
+// programCache is an LRU cache of compiled CEL programs, keyed by the environment, the version of the
+// function library and the text of the expression.
+type programCache struct {
+	// nil if caching is disabled
+	cache *lru.Cache[programCacheKey, *programCacheEntry]
+
+	hits                   atomic.Int64
+	misses                 atomic.Int64
+	compileDurationNanos   atomic.Int64
+	evaluations            atomic.Int64
+	evaluationDurationNano atomic.Int64
+}
+
+func newProgramCache(size int) *programCache {
+	c := &programCache{}
+
+	if size > 0 {
+		// only errors on a non-positive size
+		c.cache, _ = lru.New[programCacheKey, *programCacheEntry](size)
+	}
+
+	return c
+}
+
+func (c *programCache) getOrCompile(env celEnvKind, version uint32, expr string, compile func() (cel.Program, error)) (cel.Program, error) {
+	key := programCacheKey{env: env, version: version, expr: expr}
+
+	if c.cache != nil {
+		if entry, ok := c.cache.Get(key); ok {
+			c.hits.Add(1)
+			return entry.program, entry.err
+		}
+	}
+
+	c.misses.Add(1)
+
+	start := time.Now()
+	program, err := compile()
+	c.compileDurationNanos.Add(int64(time.Since(start)))
+
+	if c.cache != nil {
+		c.cache.Add(key, &programCacheEntry{program: program, err: err})
+	}
+
+	return program, err
+}
+
+func (c *programCache) recordEvaluation(d time.Duration) {
+	c.evaluations.Add(1)
+	c.evaluationDurationNano.Add(int64(d))
+}
+
+func (c *programCache) stats() ProgramCacheStats {
+	res := ProgramCacheStats{
+		Hits:               c.hits.Load(),
+		Misses:             c.misses.Load(),
+		CompileDuration:    time.Duration(c.compileDurationNanos.Load()),
+		Evaluations:        c.evaluations.Load(),
+		EvaluationDuration: time.Duration(c.evaluationDurationNano.Load()),
+	}
+
+	if c.cache != nil {
+		res.Size = c.cache.Len()
+	}
+
+	return res
+}
+
+// ProgramCacheStats are the cumulative statistics of the compiled program cache of a CELParser.
+type ProgramCacheStats struct {
+	// the number of programs in the cache
+	Size int
+
+	// the number of expressions which were found in the cache
+	Hits int64
+
+	// the number of expressions which were compiled
+	Misses int64
+
+	// the total time spent compiling expressions
+	CompileDuration time.Duration
+
+	// the number of programs which were evaluated
+	Evaluations int64
+
+	// the total time spent evaluating programs
+	EvaluationDuration time.Duration
+}
+
+// HitRate returns the fraction of expressions which were found in the cache.
+func (s ProgramCacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// AvgEvaluationDuration returns the average time spent evaluating a program.
+func (s ProgramCacheStats) AvgEvaluationDuration() time.Duration {
+	if s.Evaluations == 0 {
+		return 0
+	}
+
+	return s.EvaluationDuration / time.Duration(s.Evaluations)
+}
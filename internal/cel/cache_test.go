@@ -0,0 +1,87 @@
+package cel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+)
+
+func TestProgramCache(t *testing.T) {
+	parser := cel.NewCELParser(cel.WithProgramCacheSize(2))
+
+	for i := 0; i < 3; i++ {
+		_, err := parser.ParseMatchCondition(`input.amount > 10`)
+		require.NoError(t, err)
+	}
+
+	// the same expression is cached separately for each environment
+	_, err := parser.ParseStepRun(`input.amount > 10`)
+	require.NoError(t, err)
+
+	// compile errors are cached as well
+	for i := 0; i < 2; i++ {
+		_, err = parser.ParseMatchCondition(`input.amount >`)
+		assert.Error(t, err)
+	}
+
+	stats := parser.ProgramCacheStats()
+
+	assert.Equal(t, 2, stats.Size)
+	assert.Equal(t, int64(3), stats.Hits)
+	assert.Equal(t, int64(3), stats.Misses)
+	assert.Equal(t, 0.5, stats.HitRate())
+}
+
+func TestProgramCacheDisabled(t *testing.T) {
+	parser := cel.NewCELParser(cel.WithProgramCacheSize(0))
+
+	for i := 0; i < 3; i++ {
+		_, err := parser.ParseAndEvalWorkflowString(`input.key`, cel.NewInput(
+			cel.WithInput(map[string]interface{}{"key": "value"}),
+		))
+		require.NoError(t, err)
+	}
+
+	stats := parser.ProgramCacheStats()
+
+	assert.Equal(t, 0, stats.Size)
+	assert.Equal(t, int64(0), stats.Hits)
+	assert.Equal(t, int64(3), stats.Misses)
+	assert.Equal(t, int64(3), stats.Evaluations)
+}
+
+// BenchmarkParseAndEvalStepRun compares evaluating concurrency expressions with and without the program
+// cache. Run with:
+//
+//	go test ./internal/cel -run '^$' -bench ParseAndEvalStepRun -benchmem
+func BenchmarkParseAndEvalStepRun(b *testing.B) {
+	expr := `input.tenant + "-" + string(bucket(input.user_id, 16))`
+
+	in := cel.NewInput(
+		cel.WithInput(map[string]interface{}{
+			"tenant":  "acme",
+			"user_id": "user-1234",
+		}),
+		cel.WithAdditionalMetadata(map[string]interface{}{}),
+		cel.WithWorkflowRunID("d7f4c3c2-2f4e-4b8e-9d6a-7bb8a0f4b1e1"),
+		cel.WithParents(map[string]interface{}{}),
+	)
+
+	for _, size := range []int{0, cel.DefaultProgramCacheSize} {
+		b.Run(fmt.Sprintf("cache_size=%d", size), func(b *testing.B) {
+			parser := cel.NewCELParser(cel.WithProgramCacheSize(size))
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := parser.ParseAndEvalStepRun(expr, in); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
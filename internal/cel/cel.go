@@ -1,8 +1,9 @@
 package cel
 
 import (
-	"crypto/sha256"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/checker/decls"
@@ -10,49 +11,65 @@ import (
 	"github.com/google/cel-go/common/types/ref"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
-
-	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 )
 
 type CELParser struct {
-	workflowStrEnv *cel.Env
-	stepRunEnv     *cel.Env
+	// the version of the function library which expressions are compiled against
+	version uint32
+
+	workflowStrEnv    *cel.Env
+	stepRunEnv        *cel.Env
+	matchConditionEnv *cel.Env
+
+	programs *programCache
+
+	// parsers for every version of the function library, indexed by version and shared between them
+	versions []*CELParser
 }
 
-var checksumDecl = decls.NewFunction("checksum",
-	decls.NewOverload("checksum_string",
-		[]*expr.Type{decls.String},
-		decls.String,
-	),
-)
+type CELParserOpt func(*celParserOpts)
 
-var checksum = cel.Function("checksum",
-	cel.MemberOverload(
-		"checksum_string_impl",
-		[]*cel.Type{cel.StringType},
-		cel.StringType,
-		cel.FunctionBinding(func(args ...ref.Val) ref.Val {
-			if len(args) != 1 {
-				return types.NewErr("checksum requires 1 argument")
-			}
-			str, ok := args[0].(types.String)
-			if !ok {
-				return types.NewErr("argument must be a string")
-			}
-			hash := sha256.Sum256([]byte(str))
-			return types.String(fmt.Sprintf("%x", hash))
-		})),
-)
+type celParserOpts struct {
+	programCacheSize int
+}
+
+// WithProgramCacheSize sets the number of compiled programs which are cached by the parser. A size of 0
+// disables the cache, so every expression is compiled each time that it's parsed.
+func WithProgramCacheSize(size int) CELParserOpt {
+	return func(opts *celParserOpts) {
+		opts.programCacheSize = size
+	}
+}
+
+// NewCELParser returns a parser which compiles expressions against the latest version of the function
+// library. Use AtVersion to compile stored expressions against the version they were validated against.
+func NewCELParser(fs ...CELParserOpt) *CELParser {
+	opts := &celParserOpts{
+		programCacheSize: DefaultProgramCacheSize,
+	}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	programs := newProgramCache(opts.programCacheSize)
+	versions := make([]*CELParser, LibraryVersion+1)
 
-func NewCELParser() *CELParser {
+	for version := range versions {
+		versions[version] = newVersionedCELParser(uint32(version), programs, versions) // nolint: gosec
+	}
+
+	return versions[LibraryVersion]
+}
+
+func newVersionedCELParser(version uint32, programs *programCache, versions []*CELParser) *CELParser {
 	workflowStrEnv, _ := cel.NewEnv(
 		cel.Declarations(
 			decls.NewVar("input", decls.NewMapType(decls.String, decls.Dyn)),
 			decls.NewVar("additional_metadata", decls.NewMapType(decls.String, decls.Dyn)),
 			decls.NewVar("workflow_run_id", decls.String),
-			checksumDecl,
 		),
-		checksum,
+		Library(version),
 	)
 
 	stepRunEnv, _ := cel.NewEnv(
@@ -61,17 +78,63 @@ func NewCELParser() *CELParser {
 			decls.NewVar("additional_metadata", decls.NewMapType(decls.String, decls.Dyn)),
 			decls.NewVar("parents", decls.NewMapType(decls.String, decls.NewMapType(decls.String, decls.Dyn))),
 			decls.NewVar("workflow_run_id", decls.String),
-			checksumDecl,
 		),
-		checksum,
+		Library(version),
+	)
+
+	matchConditionEnv, _ := cel.NewEnv(
+		cel.Declarations(
+			decls.NewVar("input", decls.NewMapType(decls.String, decls.Dyn)),
+			decls.NewVar("event_timestamp", decls.Timestamp),
+		),
+		Library(version),
 	)
 
 	return &CELParser{
-		workflowStrEnv: workflowStrEnv,
-		stepRunEnv:     stepRunEnv,
+		version:           version,
+		workflowStrEnv:    workflowStrEnv,
+		stepRunEnv:        stepRunEnv,
+		matchConditionEnv: matchConditionEnv,
+		programs:          programs,
+		versions:          versions,
 	}
 }
 
+// AtVersion returns a parser which compiles expressions against the given version of the function library,
+// and which shares its program cache with this parser. Stored expressions should be compiled against the
+// version that they were validated against, so that changes to the library don't change their behavior.
+func (p *CELParser) AtVersion(version uint32) (*CELParser, error) {
+	if version > LibraryVersion {
+		return nil, fmt.Errorf("CEL library version %d is newer than the latest supported version %d", version, LibraryVersion)
+	}
+
+	return p.versions[version], nil
+}
+
+// LibraryVersion returns the version of the function library which the parser compiles expressions against.
+func (p *CELParser) LibraryVersion() uint32 {
+	return p.version
+}
+
+// ProgramCacheStats returns the cumulative statistics of the parser's compiled program cache.
+func (p *CELParser) ProgramCacheStats() ProgramCacheStats {
+	return p.programs.stats()
+}
+
+// Eval evaluates a program which was returned by the parser, recording the time spent evaluating it.
+func (p *CELParser) Eval(prg cel.Program, in Input) (ref.Val, error) {
+	start := time.Now()
+	defer func() {
+		p.programs.recordEvaluation(time.Since(start))
+	}()
+
+	var inMap map[string]interface{} = in
+
+	out, _, err := prg.Eval(inMap)
+
+	return out, err
+}
+
 type Input map[string]interface{}
 
 type InputOpts func(Input)
@@ -100,6 +163,12 @@ func WithWorkflowRunID(workflowRunID string) InputOpts {
 	}
 }
 
+func WithEventTimestamp(eventTimestamp time.Time) InputOpts {
+	return func(w Input) {
+		w["event_timestamp"] = eventTimestamp
+	}
+}
+
 func NewInput(opts ...InputOpts) Input {
 	res := make(map[string]interface{})
 
@@ -111,12 +180,22 @@ func NewInput(opts ...InputOpts) Input {
 }
 
 func (p *CELParser) ParseWorkflowString(workflowExp string) (cel.Program, error) {
+	return p.programs.getOrCompile(celEnvWorkflowString, p.version, workflowExp, func() (cel.Program, error) {
+		return p.compileWorkflowString(workflowExp)
+	})
+}
+
+func (p *CELParser) compileWorkflowString(workflowExp string) (cel.Program, error) {
 	ast, issues := p.workflowStrEnv.Compile(workflowExp)
 
 	if issues != nil && issues.Err() != nil {
 		return nil, issues.Err()
 	}
 
+	if ast.OutputType() != cel.StringType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("output must evaluate to a string: got %s", ast.OutputType())
+	}
+
 	return p.workflowStrEnv.Program(ast)
 }
 
@@ -126,9 +205,7 @@ func (p *CELParser) ParseAndEvalWorkflowString(workflowExp string, in Input) (st
 		return "", err
 	}
 
-	var inMap map[string]interface{} = in
-
-	out, _, err := prg.Eval(inMap)
+	out, err := p.Eval(prg, in)
 	if err != nil {
 		return "", err
 	}
@@ -142,6 +219,28 @@ func (p *CELParser) ParseAndEvalWorkflowString(workflowExp string, in Input) (st
 	}
 }
 
+// ParseMatchCondition parses an expression which is evaluated against the payload of an event, and
+// must evaluate to a boolean.
+func (p *CELParser) ParseMatchCondition(matchConditionExpr string) (cel.Program, error) {
+	return p.programs.getOrCompile(celEnvMatchCondition, p.version, matchConditionExpr, func() (cel.Program, error) {
+		return p.compileMatchCondition(matchConditionExpr)
+	})
+}
+
+func (p *CELParser) compileMatchCondition(matchConditionExpr string) (cel.Program, error) {
+	ast, issues := p.matchConditionEnv.Compile(matchConditionExpr)
+
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("output must evaluate to a bool: got %s", ast.OutputType())
+	}
+
+	return p.matchConditionEnv.Program(ast)
+}
+
 type StepRunOutType string
 
 const (
@@ -156,6 +255,12 @@ type StepRunOut struct {
 }
 
 func (p *CELParser) ParseStepRun(stepRunExpr string) (cel.Program, error) {
+	return p.programs.getOrCompile(celEnvStepRun, p.version, stepRunExpr, func() (cel.Program, error) {
+		return p.compileStepRun(stepRunExpr)
+	})
+}
+
+func (p *CELParser) compileStepRun(stepRunExpr string) (cel.Program, error) {
 	ast, issues := p.stepRunEnv.Compile(stepRunExpr)
 
 	if issues != nil && issues.Err() != nil {
@@ -171,9 +276,7 @@ func (p *CELParser) ParseAndEvalStepRun(stepRunExpr string, in Input) (*StepRunO
 		return nil, err
 	}
 
-	var inMap map[string]interface{} = in
-
-	out, _, err := prg.Eval(inMap)
+	out, err := p.Eval(prg, in)
 	if err != nil {
 		return nil, err
 	}
@@ -246,3 +349,23 @@ func (p *CELParser) CheckStepRunOutAgainstKnown(out *StepRunOut, knownType dbsql
 
 	return nil
 }
+
+// CompileErrorMessage returns a single-line description of the issues with an expression which failed
+// to compile, which can be returned to the user who registered the expression.
+func CompileErrorMessage(err error) string {
+	issues := make([]string, 0)
+
+	for _, line := range strings.Split(err.Error(), "\n") {
+		if !strings.HasPrefix(line, "ERROR: ") {
+			continue
+		}
+
+		issues = append(issues, strings.TrimPrefix(strings.TrimPrefix(line, "ERROR: "), "<input>:"))
+	}
+
+	if len(issues) == 0 {
+		return strings.ReplaceAll(err.Error(), "\n", " ")
+	}
+
+	return strings.Join(issues, "; ")
+}
@@ -91,3 +91,45 @@ func TestCELParser(t *testing.T) {
 		})
 	}
 }
+
+func TestParseMatchCondition(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	tests := []struct {
+		expression  string
+		expectError bool
+	}{
+		{
+			expression:  `true`,
+			expectError: false,
+		},
+		{
+			expression:  `input.order_id == "1234" && input.amount > 10`,
+			expectError: false,
+		},
+		{
+			expression:  `input.order_id`, // Dynamic values are only known at evaluation time
+			expectError: false,
+		},
+		{
+			expression:  `"not a bool"`,
+			expectError: true,
+		},
+		{
+			expression:  `parents.step_one.ok`, // Only the event payload is available
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			_, err := parser.ParseMatchCondition(tt.expression)
+
+			if tt.expectError {
+				assert.Error(t, err, "Expected error but got none")
+			} else {
+				assert.NoError(t, err, "Did not expect error but got one")
+			}
+		})
+	}
+}
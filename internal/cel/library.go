@@ -0,0 +1,551 @@
+package cel
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// LibraryVersion is the latest version of the Hatchet CEL function library. Functions are only ever
+// added in new versions of the library, so an expression which compiles against one version of the
+// library compiles against every later version. Expressions are stored with the version of the library
+// which they were validated against and are always compiled against that version, so changing the
+// behavior of an existing function requires a new version.
+//
+// Version 0 contains `checksum`. Version 1 adds hashing, time, JSON path and regex functions:
+//
+//	hash(string) -> int                              a stable, non-negative hash of the string
+//	bucket(string, int) -> int                       hash(string) modulo the number of buckets
+//	timestampFromUnix(int) -> timestamp              a timestamp from seconds since the Unix epoch
+//	<timestamp>.unix() -> int                        seconds since the Unix epoch
+//	<timestamp>.truncate(duration) -> timestamp      the timestamp rounded down to a multiple of the duration
+//	<timestamp>.format(string) -> string             the timestamp formatted with a Go time layout, in UTC
+//	jsonPath(dyn, string) -> dyn                     the value at a path like "a.b[0].c", or an error if missing
+//	jsonPath(dyn, string, dyn) -> dyn                the value at a path, or the default if missing
+//	hasJsonPath(dyn, string) -> bool                 whether a value exists at a path
+//	parseJson(string) -> dyn                         the parsed JSON document
+//	regexExtract(string, string) -> string           the first match (or its first capture group), or ""
+//	regexExtractAll(string, string) -> list(string)  every match (or its first capture group)
+//	regexReplace(string, string, string) -> string   the string with every match replaced
+const LibraryVersion uint32 = 1
+
+// Library returns the Hatchet CEL function library at the given version. Every CEL environment in
+// Hatchet should be constructed with this library so that concurrency keys, match conditions and
+// filters support the same functions.
+func Library(version uint32) cel.EnvOption {
+	return cel.Lib(&hatchetLib{version: version})
+}
+
+type hatchetLib struct {
+	version uint32
+}
+
+func (l *hatchetLib) LibraryName() string {
+	return "hatchet.lib"
+}
+
+func (l *hatchetLib) CompileOptions() []cel.EnvOption {
+	opts := []cel.EnvOption{
+		checksumFunction,
+	}
+
+	if l.version >= 1 {
+		opts = append(opts,
+			hashFunction,
+			bucketFunction,
+			timestampFromUnixFunction,
+			unixFunction,
+			truncateFunction,
+			formatFunction,
+			jsonPathFunction,
+			hasJsonPathFunction,
+			parseJsonFunction,
+			regexExtractFunction,
+			regexExtractAllFunction,
+			regexReplaceFunction,
+		)
+	}
+
+	return opts
+}
+
+func (l *hatchetLib) ProgramOptions() []cel.ProgramOption {
+	return []cel.ProgramOption{}
+}
+
+var checksumFunction = cel.Function("checksum",
+	cel.Overload(
+		"checksum_string",
+		[]*cel.Type{cel.StringType},
+		cel.StringType,
+		cel.UnaryBinding(func(arg ref.Val) ref.Val {
+			str, ok := arg.(types.String)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(arg)
+			}
+
+			hash := sha256.Sum256([]byte(str))
+
+			return types.String(fmt.Sprintf("%x", hash))
+		}),
+	),
+)
+
+var hashFunction = cel.Function("hash",
+	cel.Overload(
+		"hash_string",
+		[]*cel.Type{cel.StringType},
+		cel.IntType,
+		cel.UnaryBinding(func(arg ref.Val) ref.Val {
+			str, ok := arg.(types.String)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(arg)
+			}
+
+			return types.Int(hashString(string(str)))
+		}),
+	),
+)
+
+var bucketFunction = cel.Function("bucket",
+	cel.Overload(
+		"bucket_string_int",
+		[]*cel.Type{cel.StringType, cel.IntType},
+		cel.IntType,
+		cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+			str, ok := lhs.(types.String)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(lhs)
+			}
+
+			buckets, ok := rhs.(types.Int)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(rhs)
+			}
+
+			if buckets <= 0 {
+				return types.NewErr("bucket requires a positive number of buckets, got %d", buckets)
+			}
+
+			return types.Int(hashString(string(str)) % int64(buckets))
+		}),
+	),
+)
+
+// hashString returns the FNV-1a hash of the string, masked to a non-negative integer so that it can be
+// used for modulo bucketing.
+func hashString(s string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+
+	return int64(h.Sum64() & (1<<63 - 1))
+}
+
+var timestampFromUnixFunction = cel.Function("timestampFromUnix",
+	cel.Overload(
+		"timestampFromUnix_int",
+		[]*cel.Type{cel.IntType},
+		cel.TimestampType,
+		cel.UnaryBinding(func(arg ref.Val) ref.Val {
+			secs, ok := arg.(types.Int)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(arg)
+			}
+
+			return types.Timestamp{Time: time.Unix(int64(secs), 0).UTC()}
+		}),
+	),
+)
+
+var unixFunction = cel.Function("unix",
+	cel.MemberOverload(
+		"timestamp_unix",
+		[]*cel.Type{cel.TimestampType},
+		cel.IntType,
+		cel.UnaryBinding(func(arg ref.Val) ref.Val {
+			ts, ok := arg.(types.Timestamp)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(arg)
+			}
+
+			return types.Int(ts.Unix())
+		}),
+	),
+)
+
+var truncateFunction = cel.Function("truncate",
+	cel.MemberOverload(
+		"timestamp_truncate_duration",
+		[]*cel.Type{cel.TimestampType, cel.DurationType},
+		cel.TimestampType,
+		cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+			ts, ok := lhs.(types.Timestamp)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(lhs)
+			}
+
+			d, ok := rhs.(types.Duration)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(rhs)
+			}
+
+			return types.Timestamp{Time: ts.UTC().Truncate(d.Duration)}
+		}),
+	),
+)
+
+var formatFunction = cel.Function("format",
+	cel.MemberOverload(
+		"timestamp_format_string",
+		[]*cel.Type{cel.TimestampType, cel.StringType},
+		cel.StringType,
+		cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+			ts, ok := lhs.(types.Timestamp)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(lhs)
+			}
+
+			layout, ok := rhs.(types.String)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(rhs)
+			}
+
+			return types.String(ts.UTC().Format(string(layout)))
+		}),
+	),
+)
+
+var jsonPathFunction = cel.Function("jsonPath",
+	cel.Overload(
+		"jsonPath_dyn_string",
+		[]*cel.Type{cel.DynType, cel.StringType},
+		cel.DynType,
+		cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+			path, ok := rhs.(types.String)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(rhs)
+			}
+
+			val, found, err := lookupJSONPath(lhs, string(path))
+
+			if err != nil {
+				return types.NewErr("%s", err.Error())
+			}
+
+			if !found {
+				return types.NewErr("no value at path %s", path)
+			}
+
+			return val
+		}),
+	),
+	cel.Overload(
+		"jsonPath_dyn_string_dyn",
+		[]*cel.Type{cel.DynType, cel.StringType, cel.DynType},
+		cel.DynType,
+		cel.FunctionBinding(func(args ...ref.Val) ref.Val {
+			path, ok := args[1].(types.String)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(args[1])
+			}
+
+			val, found, err := lookupJSONPath(args[0], string(path))
+
+			if err != nil {
+				return types.NewErr("%s", err.Error())
+			}
+
+			if !found {
+				return args[2]
+			}
+
+			return val
+		}),
+	),
+)
+
+var hasJsonPathFunction = cel.Function("hasJsonPath",
+	cel.Overload(
+		"hasJsonPath_dyn_string",
+		[]*cel.Type{cel.DynType, cel.StringType},
+		cel.BoolType,
+		cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+			path, ok := rhs.(types.String)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(rhs)
+			}
+
+			_, found, err := lookupJSONPath(lhs, string(path))
+
+			if err != nil {
+				return types.NewErr("%s", err.Error())
+			}
+
+			return types.Bool(found)
+		}),
+	),
+)
+
+var parseJsonFunction = cel.Function("parseJson",
+	cel.Overload(
+		"parseJson_string",
+		[]*cel.Type{cel.StringType},
+		cel.DynType,
+		cel.UnaryBinding(func(arg ref.Val) ref.Val {
+			str, ok := arg.(types.String)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(arg)
+			}
+
+			var res interface{}
+
+			if err := json.Unmarshal([]byte(str), &res); err != nil {
+				return types.NewErr("invalid JSON: %s", err.Error())
+			}
+
+			return types.DefaultTypeAdapter.NativeToValue(res)
+		}),
+	),
+)
+
+// lookupJSONPath returns the value at a path like "a.b[0].c" (optionally prefixed with "$."), and
+// whether a value exists at the path.
+func lookupJSONPath(val ref.Val, path string) (ref.Val, bool, error) {
+	segments, err := parseJSONPath(path)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	curr := val
+
+	for _, segment := range segments {
+		switch v := curr.(type) {
+		case traits.Mapper:
+			if segment.isIndex {
+				return nil, false, nil
+			}
+
+			next, found := v.Find(types.String(segment.key))
+
+			if !found {
+				return nil, false, nil
+			}
+
+			curr = next
+		case traits.Lister:
+			if !segment.isIndex {
+				return nil, false, nil
+			}
+
+			size, ok := v.Size().(types.Int)
+
+			if !ok || segment.index >= int64(size) {
+				return nil, false, nil
+			}
+
+			curr = v.Get(types.Int(segment.index))
+		default:
+			return nil, false, nil
+		}
+	}
+
+	return curr, true, nil
+}
+
+type jsonPathSegment struct {
+	key     string
+	index   int64
+	isIndex bool
+}
+
+func parseJSONPath(path string) ([]jsonPathSegment, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+
+	segments := make([]jsonPathSegment, 0)
+
+	if path == "" {
+		return segments, nil
+	}
+
+	for _, part := range strings.Split(path, ".") {
+		key, rest, _ := strings.Cut(part, "[")
+
+		if key != "" {
+			segments = append(segments, jsonPathSegment{key: key})
+		} else if rest == "" {
+			return nil, fmt.Errorf("invalid JSON path %s: empty key", path)
+		}
+
+		for rest != "" {
+			indexStr, after, found := strings.Cut(rest, "]")
+
+			if !found {
+				return nil, fmt.Errorf("invalid JSON path %s: unclosed index", path)
+			}
+
+			index, err := strconv.ParseInt(indexStr, 10, 64)
+
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid JSON path %s: invalid index %s", path, indexStr)
+			}
+
+			segments = append(segments, jsonPathSegment{index: index, isIndex: true})
+
+			if after != "" && !strings.HasPrefix(after, "[") {
+				return nil, fmt.Errorf("invalid JSON path %s: unexpected %s", path, after)
+			}
+
+			rest = strings.TrimPrefix(after, "[")
+		}
+	}
+
+	return segments, nil
+}
+
+var regexExtractFunction = cel.Function("regexExtract",
+	cel.Overload(
+		"regexExtract_string_string",
+		[]*cel.Type{cel.StringType, cel.StringType},
+		cel.StringType,
+		cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+			str, re, errVal := regexArgs(lhs, rhs)
+
+			if errVal != nil {
+				return errVal
+			}
+
+			match := re.FindStringSubmatch(str)
+
+			switch {
+			case len(match) == 0:
+				return types.String("")
+			case len(match) > 1:
+				return types.String(match[1])
+			default:
+				return types.String(match[0])
+			}
+		}),
+	),
+)
+
+var regexExtractAllFunction = cel.Function("regexExtractAll",
+	cel.Overload(
+		"regexExtractAll_string_string",
+		[]*cel.Type{cel.StringType, cel.StringType},
+		cel.ListType(cel.StringType),
+		cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+			str, re, errVal := regexArgs(lhs, rhs)
+
+			if errVal != nil {
+				return errVal
+			}
+
+			res := make([]string, 0)
+
+			for _, match := range re.FindAllStringSubmatch(str, -1) {
+				if len(match) > 1 {
+					res = append(res, match[1])
+				} else {
+					res = append(res, match[0])
+				}
+			}
+
+			return types.DefaultTypeAdapter.NativeToValue(res)
+		}),
+	),
+)
+
+var regexReplaceFunction = cel.Function("regexReplace",
+	cel.Overload(
+		"regexReplace_string_string_string",
+		[]*cel.Type{cel.StringType, cel.StringType, cel.StringType},
+		cel.StringType,
+		cel.FunctionBinding(func(args ...ref.Val) ref.Val {
+			str, re, errVal := regexArgs(args[0], args[1])
+
+			if errVal != nil {
+				return errVal
+			}
+
+			repl, ok := args[2].(types.String)
+
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(args[2])
+			}
+
+			return types.String(re.ReplaceAllString(str, string(repl)))
+		}),
+	),
+)
+
+func regexArgs(strVal, patternVal ref.Val) (string, *regexp.Regexp, ref.Val) {
+	str, ok := strVal.(types.String)
+
+	if !ok {
+		return "", nil, types.MaybeNoSuchOverloadErr(strVal)
+	}
+
+	pattern, ok := patternVal.(types.String)
+
+	if !ok {
+		return "", nil, types.MaybeNoSuchOverloadErr(patternVal)
+	}
+
+	re, err := compileRegex(string(pattern))
+
+	if err != nil {
+		return "", nil, types.NewErr("invalid regex %s: %s", pattern, err.Error())
+	}
+
+	return string(str), re, nil
+}
+
+// regexCacheSize is the number of compiled patterns which are shared by the regex functions. Patterns are
+// usually literals in an expression, so they're compiled once rather than on every evaluation.
+const regexCacheSize = 1000
+
+type regexCacheEntry struct {
+	re  *regexp.Regexp
+	err error
+}
+
+// only errors on a non-positive size
+var regexCache, _ = lru.New[string, *regexCacheEntry](regexCacheSize)
+
+func compileRegex(pattern string) (*regexp.Regexp, error) {
+	if entry, ok := regexCache.Get(pattern); ok {
+		return entry.re, entry.err
+	}
+
+	re, err := regexp.Compile(pattern)
+
+	regexCache.Add(pattern, &regexCacheEntry{re: re, err: err})
+
+	return re, err
+}
@@ -0,0 +1,26 @@
+package cel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileRegexCached(t *testing.T) {
+	re, err := compileRegex(`user-([0-9]+)`)
+	require.NoError(t, err)
+
+	cached, err := compileRegex(`user-([0-9]+)`)
+	require.NoError(t, err)
+
+	assert.Same(t, re, cached)
+
+	// invalid patterns are cached as well
+	_, err = compileRegex(`(`)
+	assert.Error(t, err)
+
+	entry, ok := regexCache.Get(`(`)
+	require.True(t, ok)
+	assert.Error(t, entry.err)
+}
@@ -0,0 +1,161 @@
+package cel_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+)
+
+func TestLibrary(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	input := cel.NewInput(
+		cel.WithInput(map[string]interface{}{
+			"user_id": "user-1234",
+			"order": map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{"sku": "abc-123"},
+				},
+			},
+			"raw":        `{"region": "eu-west-1"}`,
+			"created_at": int64(1742896800), // 2025-03-25T10:00:00Z
+		}),
+	)
+
+	tests := []struct {
+		expression  string
+		expected    string
+		expectError bool
+	}{
+		{
+			expression: `string(bucket(input.user_id, 16) == hash(input.user_id) % 16)`,
+			expected:   "true",
+		},
+		{
+			expression: `string(bucket(input.user_id, 16) >= 0 && bucket(input.user_id, 16) < 16)`,
+			expected:   "true",
+		},
+		{
+			expression:  `string(bucket(input.user_id, 0))`,
+			expectError: true,
+		},
+		{
+			expression: `timestampFromUnix(input.created_at).truncate(duration("24h")).format("2006-01-02T15:04")`,
+			expected:   "2025-03-25T00:00",
+		},
+		{
+			expression: `string(timestampFromUnix(input.created_at).unix())`,
+			expected:   "1742896800",
+		},
+		{
+			expression: `jsonPath(input, "order.items[0].sku")`,
+			expected:   "abc-123",
+		},
+		{
+			expression: `jsonPath(input, "$.order.items[1].sku", "none")`,
+			expected:   "none",
+		},
+		{
+			expression:  `jsonPath(input, "order.missing")`,
+			expectError: true,
+		},
+		{
+			expression: `string(hasJsonPath(input, "order.items[0]"))`,
+			expected:   "true",
+		},
+		{
+			expression: `parseJson(input.raw).region`,
+			expected:   "eu-west-1",
+		},
+		{
+			expression: `regexExtract(input.user_id, "user-([0-9]+)")`,
+			expected:   "1234",
+		},
+		{
+			expression: `regexExtractAll("a1b22c333", "[0-9]+")[2]`,
+			expected:   "333",
+		},
+		{
+			expression: `regexReplace(input.user_id, "[0-9]", "x")`,
+			expected:   "user-xxxx",
+		},
+		{
+			expression:  `regexReplace(input.user_id, "(", "x")`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			result, err := parser.ParseAndEvalWorkflowString(tt.expression, input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMatchConditionEventTimestamp(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	prg, err := parser.ParseMatchCondition(`event_timestamp.getHours() >= 9 && size(regexExtractAll(input.tags, "[a-z]+")) == 2`)
+	require.NoError(t, err)
+
+	out, _, err := prg.Eval(map[string]interface{}(cel.NewInput(
+		cel.WithInput(map[string]interface{}{"tags": "red,blue"}),
+		cel.WithEventTimestamp(time.Date(2025, 3, 25, 10, 0, 0, 0, time.UTC)),
+	)))
+	require.NoError(t, err)
+
+	assert.Equal(t, true, out.Value())
+}
+
+func TestCompileErrorMessage(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	_, err := parser.ParseWorkflowString(`input.user_id + missing`)
+	require.Error(t, err)
+
+	assert.Equal(t, "1:17: undeclared reference to 'missing' (in container '')", cel.CompileErrorMessage(err))
+
+	_, err = parser.ParseWorkflowString(`1 + 2`)
+	require.Error(t, err)
+
+	assert.Equal(t, "output must evaluate to a string: got int", cel.CompileErrorMessage(err))
+}
+
+func TestLibraryVersions(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	assert.Equal(t, cel.LibraryVersion, parser.LibraryVersion())
+
+	v0, err := parser.AtVersion(0)
+	require.NoError(t, err)
+
+	// functions which were added in later versions don't compile against earlier versions
+	_, err = v0.ParseStepRun(`string(bucket(input.user_id, 16))`)
+	assert.ErrorContains(t, err, "undeclared reference to 'bucket'")
+
+	_, err = parser.ParseStepRun(`string(bucket(input.user_id, 16))`)
+	assert.NoError(t, err)
+
+	// every version supports the functions of earlier versions
+	for version := uint32(0); version <= cel.LibraryVersion; version++ {
+		p, err := parser.AtVersion(version)
+		require.NoError(t, err)
+
+		_, err = p.ParseStepRun(`checksum(input.user_id)`)
+		assert.NoError(t, err, version)
+	}
+
+	_, err = parser.AtVersion(cel.LibraryVersion + 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,340 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/hatchet-dev/hatchet/internal/signature"
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+const (
+	// maxAttempts is the number of times a delivery is attempted before it is marked as failed
+	maxAttempts = 8
+
+	// initialBackoff is the delay before the first retry, which doubles on each subsequent retry
+	initialBackoff = 10 * time.Second
+
+	// maxBackoff is the maximum delay between retries
+	maxBackoff = time.Hour
+
+	// requestTimeout is the timeout of a single delivery attempt
+	requestTimeout = 15 * time.Second
+
+	// pollLimit is the maximum number of deliveries which are sent per tenant on each poll
+	pollLimit = 100
+
+	// pollLease is how long polled deliveries are leased for. It must be longer than the request timeout,
+	// so deliveries aren't sent twice while an attempt is in flight.
+	pollLease = time.Minute
+
+	// maxResponseBodyLength is the number of bytes of the response body which are stored on an attempt
+	maxResponseBodyLength = 1024
+
+	// sendConcurrency is the maximum number of deliveries which are sent at once
+	sendConcurrency = 10
+)
+
+// errAddressDenied is returned when a delivery would connect to an address in a denied network
+var errAddressDenied = errors.New("address is in a denied network")
+
+// Dispatcher delivers run lifecycle events to the webhook subscriptions of a tenant. Events are written
+// as pending deliveries by Publish, and sent by DeliverDue.
+type Dispatcher struct {
+	repo           repository.WebhookSubscriptionRepository
+	enc            encryption.EncryptionService
+	l              *zerolog.Logger
+	client         *http.Client
+	deniedNetworks []*net.IPNet
+}
+
+// New creates a dispatcher which refuses to deliver to addresses in any of the denied networks, given as CIDR
+// ranges. Since subscription urls are chosen by tenants, the denied networks should include any internal
+// networks the engine can reach.
+func New(repo repository.WebhookSubscriptionRepository, enc encryption.EncryptionService, l *zerolog.Logger, deniedNetworks []string) (*Dispatcher, error) {
+	d := &Dispatcher{
+		repo:           repo,
+		enc:            enc,
+		l:              l,
+		deniedNetworks: make([]*net.IPNet, 0, len(deniedNetworks)),
+	}
+
+	for _, cidr := range deniedNetworks {
+		_, network, err := net.ParseCIDR(cidr)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not parse denied network %s: %w", cidr, err)
+		}
+
+		d.deniedNetworks = append(d.deniedNetworks, network)
+	}
+
+	// addresses are checked when dialing rather than when the url is validated, so hostnames which resolve
+	// to a denied address, including through DNS rebinding, are refused
+	dialer := &net.Dialer{
+		Timeout: requestTimeout,
+		Control: d.checkAddress,
+	}
+
+	d.client = &http.Client{
+		Timeout: requestTimeout,
+		Transport: &http.Transport{
+			// proxies are not used, since the proxy address would be checked instead of the subscription url
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			TLSHandshakeTimeout: 10 * time.Second,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		// redirects are not followed, since the subscription url must be https
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return d, nil
+}
+
+// checkAddress is called with the resolved address of each connection, and refuses addresses in denied networks
+func (d *Dispatcher) checkAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+
+	if err != nil {
+		return fmt.Errorf("could not parse address %s: %w", address, err)
+	}
+
+	ip := net.ParseIP(host)
+
+	if ip == nil {
+		return fmt.Errorf("could not parse address %s", address)
+	}
+
+	for _, denied := range d.deniedNetworks {
+		if denied.Contains(ip) {
+			return fmt.Errorf("%w: %s", errAddressDenied, ip.String())
+		}
+	}
+
+	return nil
+}
+
+// Publish creates a delivery for each event and each enabled subscription in the tenant which matches it.
+// Callers retry on error, so an event may be delivered more than once.
+func (d *Dispatcher) Publish(ctx context.Context, tenantId string, events []Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	subscriptions, err := d.repo.ListWebhookSubscriptions(ctx, tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not list webhook subscriptions: %w", err)
+	}
+
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	opts := make([]repository.CreateWebhookDeliveryOpts, 0)
+
+	for i := range events {
+		var payload []byte
+
+		for _, subscription := range subscriptions {
+			if !matches(subscription, &events[i]) {
+				continue
+			}
+
+			if payload == nil {
+				payload, err = json.Marshal(events[i])
+
+				if err != nil {
+					return fmt.Errorf("could not marshal webhook event: %w", err)
+				}
+			}
+
+			opts = append(opts, repository.CreateWebhookDeliveryOpts{
+				SubscriptionId: sqlchelpers.UUIDToStr(subscription.ID),
+				EventType:      string(events[i].Type),
+				Payload:        payload,
+			})
+		}
+	}
+
+	return d.repo.CreateWebhookDeliveries(ctx, tenantId, opts)
+}
+
+// DeliverDue sends the pending deliveries in the tenant which are due, and records an attempt for each
+// of them. It returns true if there may be more deliveries which are due.
+func (d *Dispatcher) DeliverDue(ctx context.Context, tenantId string) (bool, error) {
+	deliveries, err := d.repo.PollWebhookDeliveries(ctx, tenantId, pollLimit, pollLease)
+
+	if err != nil {
+		return false, fmt.Errorf("could not poll webhook deliveries: %w", err)
+	}
+
+	if len(deliveries) == 0 {
+		return false, nil
+	}
+
+	subscriptions, err := d.repo.ListWebhookSubscriptions(ctx, tenantId)
+
+	if err != nil {
+		return false, fmt.Errorf("could not list webhook subscriptions: %w", err)
+	}
+
+	subscriptionsById := make(map[string]*dbsqlc.WebhookSubscription, len(subscriptions))
+
+	for _, subscription := range subscriptions {
+		subscriptionsById[sqlchelpers.UUIDToStr(subscription.ID)] = subscription
+	}
+
+	eg := &errgroup.Group{}
+	eg.SetLimit(sendConcurrency)
+
+	for _, delivery := range deliveries {
+		eg.Go(func() error {
+			subscription := subscriptionsById[sqlchelpers.UUIDToStr(delivery.SubscriptionId)]
+
+			opts := d.attempt(ctx, tenantId, subscription, delivery)
+
+			err := d.repo.RecordWebhookDeliveryAttempt(ctx, sqlchelpers.UUIDToStr(delivery.ID), opts)
+
+			if err != nil {
+				d.l.Error().Err(err).Msgf("could not record attempt for webhook delivery %s", sqlchelpers.UUIDToStr(delivery.ID))
+			}
+
+			return nil
+		})
+	}
+
+	_ = eg.Wait()
+
+	return len(deliveries) == pollLimit, nil
+}
+
+// attempt sends a delivery to its subscription, and returns the attempt to record
+func (d *Dispatcher) attempt(ctx context.Context, tenantId string, subscription *dbsqlc.WebhookSubscription, delivery *dbsqlc.WebhookDelivery) *repository.WebhookDeliveryAttemptOpts {
+	attempt := delivery.Attempts + 1
+	start := time.Now()
+
+	opts := &repository.WebhookDeliveryAttemptOpts{
+		Attempt: attempt,
+	}
+
+	fail := func(err error) *repository.WebhookDeliveryAttemptOpts {
+		errStr := err.Error()
+
+		opts.Error = &errStr
+		opts.Duration = time.Since(start)
+		opts.Status, opts.NextAttemptAt = nextAttempt(attempt, time.Now())
+
+		return opts
+	}
+
+	// deliveries to disabled subscriptions are not retried, since the subscription may never be re-enabled
+	if subscription == nil || !subscription.Enabled {
+		opts.Status = dbsqlc.WebhookDeliveryStatusFAILED
+		errStr := "subscription is disabled"
+		opts.Error = &errStr
+
+		return opts
+	}
+
+	secret, err := d.enc.DecryptString(subscription.Secret, tenantId)
+
+	if err != nil {
+		return fail(fmt.Errorf("could not decrypt webhook secret: %w", err))
+	}
+
+	statusCode, body, err := d.send(ctx, subscription.Url, secret, delivery)
+
+	opts.Duration = time.Since(start)
+
+	if err != nil {
+		return fail(err)
+	}
+
+	opts.ResponseStatusCode = &statusCode
+	opts.ResponseBody = &body
+
+	if statusCode < 200 || statusCode >= 300 {
+		return fail(fmt.Errorf("received non-2xx status code %d", statusCode))
+	}
+
+	opts.Status = dbsqlc.WebhookDeliveryStatusSUCCEEDED
+
+	return opts
+}
+
+// send POSTs the payload of the delivery to the url, signed with the secret. It returns the status code and
+// the start of the response body.
+func (d *Dispatcher) send(ctx context.Context, url, secret string, delivery *dbsqlc.WebhookDelivery) (int32, string, error) {
+	sig, err := signature.Sign(string(delivery.Payload), secret)
+
+	if err != nil {
+		return 0, "", fmt.Errorf("could not sign webhook payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(delivery.Payload))
+
+	if err != nil {
+		return 0, "", fmt.Errorf("could not create webhook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Hatchet-Signature", sig)
+	req.Header.Set("X-Hatchet-Event", delivery.EventType)
+	req.Header.Set("X-Hatchet-Delivery", sqlchelpers.UUIDToStr(delivery.ID))
+
+	resp, err := d.client.Do(req)
+
+	if err != nil {
+		return 0, "", fmt.Errorf("could not send webhook request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyLength))
+
+	if err != nil {
+		return 0, "", fmt.Errorf("could not read webhook response: %w", err)
+	}
+
+	return int32(resp.StatusCode), string(body), nil // nolint: gosec
+}
+
+// nextAttempt returns the status of a delivery after a failed attempt, and when to retry it
+func nextAttempt(attempt int32, now time.Time) (dbsqlc.WebhookDeliveryStatus, *time.Time) {
+	if attempt >= maxAttempts {
+		return dbsqlc.WebhookDeliveryStatusFAILED, nil
+	}
+
+	backoff := initialBackoff << (attempt - 1)
+
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	next := now.Add(backoff)
+
+	return dbsqlc.WebhookDeliveryStatusPENDING, &next
+}
@@ -0,0 +1,227 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/signature"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/olapv2"
+)
+
+func TestMatches(t *testing.T) {
+	workflowId := uuid.NewString()
+
+	event := &Event{
+		Type:       EventTypeWorkflowRunFailed,
+		WorkflowId: workflowId,
+		Status:     "FAILED",
+	}
+
+	tests := []struct {
+		name         string
+		subscription *dbsqlc.WebhookSubscription
+		expected     bool
+	}{
+		{
+			name:         "should match subscriptions without filters",
+			subscription: &dbsqlc.WebhookSubscription{Enabled: true},
+			expected:     true,
+		},
+		{
+			name:         "should not match disabled subscriptions",
+			subscription: &dbsqlc.WebhookSubscription{Enabled: false},
+			expected:     false,
+		},
+		{
+			name: "should match on event type",
+			subscription: &dbsqlc.WebhookSubscription{
+				Enabled:    true,
+				EventTypes: []string{"TASK_FAILED", "WORKFLOW_RUN_FAILED"},
+			},
+			expected: true,
+		},
+		{
+			name: "should not match other event types",
+			subscription: &dbsqlc.WebhookSubscription{
+				Enabled:    true,
+				EventTypes: []string{"WORKFLOW_RUN_COMPLETED"},
+			},
+			expected: false,
+		},
+		{
+			name: "should not match other statuses",
+			subscription: &dbsqlc.WebhookSubscription{
+				Enabled:  true,
+				Statuses: []string{"COMPLETED", "CANCELLED"},
+			},
+			expected: false,
+		},
+		{
+			name: "should match on workflow",
+			subscription: &dbsqlc.WebhookSubscription{
+				Enabled:     true,
+				WorkflowIds: []pgtype.UUID{sqlchelpers.UUIDFromStr(uuid.NewString()), sqlchelpers.UUIDFromStr(workflowId)},
+			},
+			expected: true,
+		},
+		{
+			name: "should not match other workflows",
+			subscription: &dbsqlc.WebhookSubscription{
+				Enabled:     true,
+				WorkflowIds: []pgtype.UUID{sqlchelpers.UUIDFromStr(uuid.NewString())},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, matches(tt.subscription, event))
+		})
+	}
+}
+
+func TestTaskEventType(t *testing.T) {
+	eventType, ok := TaskEventType(olapv2.V2EventTypeOlapSCHEDULINGTIMEDOUT)
+
+	assert.True(t, ok)
+	assert.Equal(t, EventTypeTaskTimedOut, eventType)
+
+	_, ok = TaskEventType(olapv2.V2EventTypeOlapSTARTED)
+
+	assert.False(t, ok)
+}
+
+func TestValidateEventTypes(t *testing.T) {
+	assert.NoError(t, ValidateEventTypes(nil, true))
+	assert.NoError(t, ValidateEventTypes([]string{"WORKFLOW_RUN_FAILED"}, true))
+
+	// without workflow run events, only task event types can be subscribed to
+	assert.NoError(t, ValidateEventTypes([]string{"TASK_FAILED", "TASK_TIMED_OUT"}, false))
+	assert.ErrorIs(t, ValidateEventTypes(nil, false), ErrWorkflowRunEventsUnsupported)
+	assert.ErrorIs(t, ValidateEventTypes([]string{"TASK_FAILED", "WORKFLOW_RUN_CANCELLED"}, false), ErrWorkflowRunEventsUnsupported)
+}
+
+func TestNextAttempt(t *testing.T) {
+	now := time.Now()
+
+	status, next := nextAttempt(1, now)
+
+	assert.Equal(t, dbsqlc.WebhookDeliveryStatusPENDING, status)
+	require.NotNil(t, next)
+	assert.Equal(t, now.Add(10*time.Second), *next)
+
+	status, next = nextAttempt(3, now)
+
+	assert.Equal(t, dbsqlc.WebhookDeliveryStatusPENDING, status)
+	require.NotNil(t, next)
+	assert.Equal(t, now.Add(40*time.Second), *next)
+
+	status, next = nextAttempt(maxAttempts, now)
+
+	assert.Equal(t, dbsqlc.WebhookDeliveryStatusFAILED, status)
+	assert.Nil(t, next)
+}
+
+func TestSend(t *testing.T) {
+	secret := "secret"
+	payload := []byte(`{"type":"TASK_FAILED"}`)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		expected, err := signature.Sign(string(body), secret)
+		require.NoError(t, err)
+
+		if r.Header.Get("X-Hatchet-Signature") != expected || r.Header.Get("X-Hatchet-Event") != "TASK_FAILED" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	defer server.Close()
+
+	l := zerolog.Nop()
+	d, err := New(nil, nil, &l, nil)
+	require.NoError(t, err)
+
+	d.client = server.Client()
+
+	delivery := &dbsqlc.WebhookDelivery{
+		ID:        sqlchelpers.UUIDFromStr(uuid.NewString()),
+		EventType: "TASK_FAILED",
+		Payload:   payload,
+	}
+
+	var statusCode int32
+	var body string
+
+	statusCode, body, err = d.send(context.Background(), server.URL, secret, delivery)
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(http.StatusAccepted), statusCode)
+	assert.Equal(t, "ok", body)
+
+	statusCode, _, err = d.send(context.Background(), server.URL, "wrong", delivery)
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(http.StatusUnauthorized), statusCode)
+}
+
+func TestSendDeniedAddress(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	defer server.Close()
+
+	l := zerolog.Nop()
+	d, err := New(nil, nil, &l, []string{"127.0.0.0/8", "::1/128", "169.254.0.0/16"})
+	require.NoError(t, err)
+
+	delivery := &dbsqlc.WebhookDelivery{
+		ID:        sqlchelpers.UUIDFromStr(uuid.NewString()),
+		EventType: "TASK_FAILED",
+		Payload:   []byte(`{}`),
+	}
+
+	_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+
+	// the address is checked after the hostname is resolved, so both the ip and a hostname which resolves
+	// to it are refused
+	for _, url := range []string{server.URL, "https://localhost:" + port, "https://169.254.169.254/latest/meta-data"} {
+		_, _, err = d.send(context.Background(), url, "secret", delivery)
+
+		assert.ErrorIs(t, err, errAddressDenied, url)
+	}
+
+	assert.Equal(t, 0, requests)
+}
+
+func TestNewInvalidDeniedNetwork(t *testing.T) {
+	l := zerolog.Nop()
+	_, err := New(nil, nil, &l, []string{"not-a-cidr"})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,144 @@
+package webhooks
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/olapv2"
+)
+
+type EventType string
+
+const (
+	EventTypeWorkflowRunCompleted EventType = "WORKFLOW_RUN_COMPLETED"
+	EventTypeWorkflowRunFailed    EventType = "WORKFLOW_RUN_FAILED"
+	EventTypeWorkflowRunCancelled EventType = "WORKFLOW_RUN_CANCELLED"
+	EventTypeTaskCompleted        EventType = "TASK_COMPLETED"
+	EventTypeTaskFailed           EventType = "TASK_FAILED"
+	EventTypeTaskCancelled        EventType = "TASK_CANCELLED"
+	EventTypeTaskTimedOut         EventType = "TASK_TIMED_OUT"
+)
+
+// Event is a run lifecycle event, which is sent as the JSON body of a delivery
+type Event struct {
+	Type       EventType `json:"type"`
+	TenantId   string    `json:"tenantId"`
+	WorkflowId string    `json:"workflowId"`
+
+	// WorkflowRunId is the id of the DAG the task belongs to, or the id of the task if it was run on its own
+	WorkflowRunId string `json:"workflowRunId"`
+
+	// TaskId and RetryCount are only set for task events. A task event is sent for each failed attempt of
+	// a task, including attempts which are retried.
+	TaskId     *string `json:"taskId,omitempty"`
+	RetryCount *int32  `json:"retryCount,omitempty"`
+
+	// Status is the status of the run after the event, one of COMPLETED, FAILED or CANCELLED
+	Status string `json:"status"`
+
+	ErrorMessage *string   `json:"errorMessage,omitempty"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+// TaskEventType returns the webhook event type for a task event, and false if task events of the given
+// type are not delivered.
+func TaskEventType(eventType olapv2.V2EventTypeOlap) (EventType, bool) {
+	switch eventType {
+	case olapv2.V2EventTypeOlapFINISHED:
+		return EventTypeTaskCompleted, true
+	case olapv2.V2EventTypeOlapFAILED:
+		return EventTypeTaskFailed, true
+	case olapv2.V2EventTypeOlapCANCELLED:
+		return EventTypeTaskCancelled, true
+	case olapv2.V2EventTypeOlapTIMEDOUT, olapv2.V2EventTypeOlapSCHEDULINGTIMEDOUT:
+		return EventTypeTaskTimedOut, true
+	}
+
+	return "", false
+}
+
+// WorkflowRunEventType returns the webhook event type for a workflow run which moved into the given status,
+// and false if the status is not a final status.
+func WorkflowRunEventType(status olapv2.V2ReadableStatusOlap) (EventType, bool) {
+	switch status {
+	case olapv2.V2ReadableStatusOlapCOMPLETED:
+		return EventTypeWorkflowRunCompleted, true
+	case olapv2.V2ReadableStatusOlapFAILED:
+		return EventTypeWorkflowRunFailed, true
+	case olapv2.V2ReadableStatusOlapCANCELLED:
+		return EventTypeWorkflowRunCancelled, true
+	}
+
+	return "", false
+}
+
+// ErrWorkflowRunEventsUnsupported is returned by ValidateEventTypes when a subscription would receive workflow
+// run events which the OLAP repository can't report
+var ErrWorkflowRunEventsUnsupported = errors.New("workflow run events are not supported by the configured OLAP backend")
+
+// ValidateEventTypes checks that a subscription to the given event types only receives events which can be
+// delivered. An empty list subscribes to every event type, so it is rejected when workflow run events aren't
+// supported.
+func ValidateEventTypes(eventTypes []string, workflowRunEventsSupported bool) error {
+	if workflowRunEventsSupported {
+		return nil
+	}
+
+	if len(eventTypes) == 0 {
+		return fmt.Errorf("%w, so event types must be set", ErrWorkflowRunEventsUnsupported)
+	}
+
+	for _, eventType := range eventTypes {
+		switch EventType(eventType) {
+		case EventTypeWorkflowRunCompleted, EventTypeWorkflowRunFailed, EventTypeWorkflowRunCancelled:
+			return fmt.Errorf("%w: %s", ErrWorkflowRunEventsUnsupported, eventType)
+		}
+	}
+
+	return nil
+}
+
+// matches returns true if the subscription should receive the event
+func matches(subscription *dbsqlc.WebhookSubscription, event *Event) bool {
+	if !subscription.Enabled {
+		return false
+	}
+
+	if len(subscription.EventTypes) > 0 && !contains(subscription.EventTypes, string(event.Type)) {
+		return false
+	}
+
+	if len(subscription.Statuses) > 0 && !contains(subscription.Statuses, event.Status) {
+		return false
+	}
+
+	if len(subscription.WorkflowIds) > 0 {
+		found := false
+
+		for _, workflowId := range subscription.WorkflowIds {
+			if workflowId.Valid && sqlchelpers.UUIDToStr(workflowId) == event.WorkflowId {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,88 @@
+package msgqueue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// DeadLetter stores a message which could not be processed after exhausting its retries, so that it can be
+// inspected and replayed later. cause is the error returned by the last attempt to process the message.
+func DeadLetter(ctx context.Context, repo repository.MessageQueueRepository, q Queue, msg *Message, retryCount int, cause error) error {
+	payload, err := msg.Serialize()
+
+	if err != nil {
+		return err
+	}
+
+	opts := &repository.CreateDeadLetterOpts{
+		Queue:      q.Name(),
+		MessageId:  msg.ID,
+		Payload:    payload,
+		RetryCount: retryCount,
+	}
+
+	if msg.TenantID != "" {
+		opts.TenantId = &msg.TenantID
+	}
+
+	if cause != nil {
+		errStr := cause.Error()
+		opts.Error = &errStr
+	}
+
+	return repo.CreateDeadLetter(ctx, opts)
+}
+
+// ReplayDeadLetters sends up to limit dead letters of the queue back to the queue with a fresh set of retries,
+// and deletes the dead letters which were replayed. A nil tenantId replays the dead letters of messages
+// without a tenant, and an empty list of ids replays the oldest dead letters. It returns the ids of the
+// replayed dead letters, along with the errors of any dead letters which could not be replayed.
+func ReplayDeadLetters(ctx context.Context, repo repository.MessageQueueRepository, mq MessageQueue, q Queue, tenantId *string, ids []int64, limit int) ([]int64, error) {
+	offset := 0
+
+	listRes, err := repo.ListDeadLetters(ctx, tenantId, q.Name(), &repository.ListDeadLettersOpts{
+		Ids:    ids,
+		Limit:  &limit,
+		Offset: &offset,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	replayed := make([]int64, 0, len(listRes.Rows))
+
+	var errs error
+
+	for _, deadLetter := range listRes.Rows {
+		msg := &Message{}
+
+		if err := json.Unmarshal(deadLetter.Payload, msg); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("could not unmarshal dead letter %d: %w", deadLetter.ID, err))
+			continue
+		}
+
+		// the message gets a fresh set of retries when it is replayed
+		msg.RetryCount = 0
+
+		if err := mq.SendMessage(ctx, q, msg); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("could not replay dead letter %d: %w", deadLetter.ID, err))
+			continue
+		}
+
+		replayed = append(replayed, deadLetter.ID)
+	}
+
+	if len(replayed) > 0 {
+		if _, err := repo.DeleteDeadLetters(ctx, tenantId, q.Name(), replayed); err != nil {
+			return nil, fmt.Errorf("could not delete replayed dead letters: %w", err)
+		}
+	}
+
+	return replayed, errs
+}
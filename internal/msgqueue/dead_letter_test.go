@@ -0,0 +1,149 @@
+package msgqueue
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type testDeadLetterRepository struct {
+	repository.MessageQueueRepository
+
+	deadLetters []*dbsqlc.MessageQueueDeadLetter
+}
+
+func (r *testDeadLetterRepository) CreateDeadLetter(ctx context.Context, opts *repository.CreateDeadLetterOpts) error {
+	deadLetter := &dbsqlc.MessageQueueDeadLetter{
+		ID:         int64(len(r.deadLetters) + 1),
+		QueueId:    opts.Queue,
+		MessageId:  opts.MessageId,
+		Payload:    opts.Payload,
+		RetryCount: int32(opts.RetryCount), // nolint: gosec
+	}
+
+	if opts.TenantId != nil {
+		deadLetter.TenantId = sqlchelpers.UUIDFromStr(*opts.TenantId)
+	}
+
+	r.deadLetters = append(r.deadLetters, deadLetter)
+
+	return nil
+}
+
+func (r *testDeadLetterRepository) matches(deadLetter *dbsqlc.MessageQueueDeadLetter, tenantId *string, queue string) bool {
+	if deadLetter.QueueId != queue {
+		return false
+	}
+
+	if tenantId == nil {
+		return !deadLetter.TenantId.Valid
+	}
+
+	return deadLetter.TenantId.Valid && sqlchelpers.UUIDToStr(deadLetter.TenantId) == *tenantId
+}
+
+func (r *testDeadLetterRepository) ListDeadLetters(ctx context.Context, tenantId *string, queue string, opts *repository.ListDeadLettersOpts) (*repository.ListDeadLettersResult, error) {
+	res := &repository.ListDeadLettersResult{}
+
+	for _, deadLetter := range r.deadLetters {
+		if r.matches(deadLetter, tenantId, queue) {
+			res.Rows = append(res.Rows, deadLetter)
+		}
+	}
+
+	res.Count = len(res.Rows)
+
+	return res, nil
+}
+
+func (r *testDeadLetterRepository) DeleteDeadLetters(ctx context.Context, tenantId *string, queue string, ids []int64) (int64, error) {
+	remaining := make([]*dbsqlc.MessageQueueDeadLetter, 0, len(r.deadLetters))
+
+	for _, deadLetter := range r.deadLetters {
+		deleted := false
+
+		if r.matches(deadLetter, tenantId, queue) {
+			for _, id := range ids {
+				deleted = deleted || deadLetter.ID == id
+			}
+		}
+
+		if !deleted {
+			remaining = append(remaining, deadLetter)
+		}
+	}
+
+	count := int64(len(r.deadLetters) - len(remaining))
+	r.deadLetters = remaining
+
+	return count, nil
+}
+
+type testMessageQueue struct {
+	MessageQueue
+
+	failIds map[string]bool
+	msgs    []*Message
+}
+
+func (q *testMessageQueue) SendMessage(ctx context.Context, queue Queue, msg *Message) error {
+	if q.failIds[msg.ID] {
+		return errors.New("message queue unavailable")
+	}
+
+	q.msgs = append(q.msgs, msg)
+
+	return nil
+}
+
+func TestReplayDeadLetters(t *testing.T) {
+	ctx := context.Background()
+	repo := &testDeadLetterRepository{}
+	mq := &testMessageQueue{failIds: map[string]bool{"fails": true}}
+	q := NewRandomStaticQueue()
+	tenantId := "7a1b3a2e-9a2c-4a8e-8d0c-5a3c8c3a9f11"
+
+	newMessage := func(tenantId, id string) *Message {
+		msg, err := NewTenantMessage(tenantId, id, false, true, map[string]string{"key": "value"})
+		require.NoError(t, err)
+
+		msg.Retries = 2
+		msg.RetryCount = 2
+
+		return msg
+	}
+
+	require.NoError(t, DeadLetter(ctx, repo, q, newMessage("", "tenantless"), 2, errors.New("failed")))
+	require.NoError(t, DeadLetter(ctx, repo, q, newMessage("", "fails"), 2, errors.New("failed")))
+	require.NoError(t, DeadLetter(ctx, repo, q, newMessage(tenantId, "tenant"), 2, errors.New("failed")))
+
+	// a nil tenant id only replays the dead letters of messages without a tenant
+	replayed, err := ReplayDeadLetters(ctx, repo, mq, q, nil, nil, 100)
+	require.ErrorContains(t, err, "could not replay dead letter 2")
+	assert.Equal(t, []int64{1}, replayed)
+
+	require.Len(t, mq.msgs, 1)
+	assert.Equal(t, "tenantless", mq.msgs[0].ID)
+	assert.Equal(t, 0, mq.msgs[0].RetryCount)
+
+	// dead letters which couldn't be replayed are kept
+	require.Len(t, repo.deadLetters, 2)
+	assert.Equal(t, "fails", repo.deadLetters[0].MessageId)
+
+	replayed, err = ReplayDeadLetters(ctx, repo, mq, q, &tenantId, nil, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3}, replayed)
+
+	require.Len(t, mq.msgs, 2)
+	assert.Equal(t, "tenant", mq.msgs[1].ID)
+	assert.Equal(t, tenantId, mq.msgs[1].TenantID)
+
+	require.Len(t, repo.deadLetters, 1)
+}
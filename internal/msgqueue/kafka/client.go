@@ -0,0 +1,348 @@
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+)
+
+// Client is the subset of Kafka functionality used by the message queue. It is implemented by a franz-go
+// client for Kafka clusters, and can be replaced by an in-process broker in tests.
+type Client interface {
+	// CreateTopic creates a topic if it does not already exist.
+	CreateTopic(ctx context.Context, topic string, opts TopicOpts) error
+
+	// Produce synchronously writes records to their topics. Records with the same key are written to the
+	// same partition.
+	Produce(ctx context.Context, records ...*Record) error
+
+	// Consume creates a new consumer.
+	Consume(ctx context.Context, opts ConsumerOpts) (Consumer, error)
+
+	// Ping returns an error if the cluster cannot be reached.
+	Ping(ctx context.Context) error
+
+	Close()
+}
+
+type TopicOpts struct {
+	Partitions int32
+
+	ReplicationFactor int16
+
+	// Retention is the time records are retained for. If zero, the broker default is used.
+	Retention time.Duration
+}
+
+type ConsumerOpts struct {
+	Topic string
+
+	// Group is the consumer group of the consumer. Records are balanced between the consumers in a group,
+	// and offsets are committed for the group. If empty, the consumer reads new records from the end of the
+	// topic and commits are a no-op.
+	Group string
+
+	// Key restricts a consumer without a group to the partition which records with the key are written
+	// to. Records with other keys may still be returned from that partition.
+	Key string
+}
+
+type Consumer interface {
+	// Fetch blocks until records are available, returning at most maxRecords records.
+	Fetch(ctx context.Context, maxRecords int) ([]*Record, error)
+
+	// Commit commits the offsets of the records returned by the last call to Fetch. It must be called after
+	// each Fetch, before the next one.
+	Commit(ctx context.Context) error
+
+	Close()
+}
+
+type Record struct {
+	Topic string
+
+	Key []byte
+
+	Value []byte
+
+	Headers map[string]string
+
+	// Timestamp is set by the broker when a record is produced.
+	Timestamp time.Time
+}
+
+type franzClient struct {
+	opts []kgo.Opt
+	cl   *kgo.Client
+
+	partitioner kgo.Partitioner
+
+	// partition counts of topics, used to find the partition for a key
+	partitionCounts   map[string]int
+	partitionCountsMu sync.RWMutex
+}
+
+type franzClientOpts struct {
+	brokers      []string
+	tls          *tls.Config
+	saslUsername string
+	saslPassword string
+}
+
+func newFranzClient(opts franzClientOpts) (*franzClient, error) {
+	// records with a key are always hashed to the same partition, so that fanout consumers can compute the
+	// partition of a key
+	partitioner := kgo.StickyKeyPartitioner(nil)
+
+	kopts := []kgo.Opt{
+		kgo.SeedBrokers(opts.brokers...),
+		kgo.ClientID("hatchet"),
+		kgo.RecordPartitioner(partitioner),
+	}
+
+	if opts.tls != nil {
+		kopts = append(kopts, kgo.DialTLSConfig(opts.tls))
+	}
+
+	if opts.saslUsername != "" {
+		kopts = append(kopts, kgo.SASL(plain.Auth{
+			User: opts.saslUsername,
+			Pass: opts.saslPassword,
+		}.AsMechanism()))
+	}
+
+	cl, err := kgo.NewClient(kopts...)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create kafka client: %w", err)
+	}
+
+	return &franzClient{
+		opts:            kopts,
+		cl:              cl,
+		partitioner:     partitioner,
+		partitionCounts: make(map[string]int),
+	}, nil
+}
+
+func (c *franzClient) CreateTopic(ctx context.Context, topic string, opts TopicOpts) error {
+	reqTopic := kmsg.NewCreateTopicsRequestTopic()
+	reqTopic.Topic = topic
+	reqTopic.NumPartitions = opts.Partitions
+	reqTopic.ReplicationFactor = opts.ReplicationFactor
+
+	if opts.Retention > 0 {
+		config := kmsg.NewCreateTopicsRequestTopicConfig()
+		config.Name = "retention.ms"
+		config.Value = kmsg.StringPtr(strconv.FormatInt(opts.Retention.Milliseconds(), 10))
+
+		reqTopic.Configs = append(reqTopic.Configs, config)
+	}
+
+	req := kmsg.NewPtrCreateTopicsRequest()
+	req.Topics = append(req.Topics, reqTopic)
+	req.TimeoutMillis = 30000
+
+	resp, err := req.RequestWith(ctx, c.cl)
+
+	if err != nil {
+		return fmt.Errorf("could not create topic %s: %w", topic, err)
+	}
+
+	for _, respTopic := range resp.Topics {
+		if err := kerr.ErrorForCode(respTopic.ErrorCode); err != nil && !errors.Is(err, kerr.TopicAlreadyExists) {
+			return fmt.Errorf("could not create topic %s: %w", topic, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *franzClient) Produce(ctx context.Context, records ...*Record) error {
+	kRecords := make([]*kgo.Record, 0, len(records))
+
+	for _, record := range records {
+		kRecord := &kgo.Record{
+			Topic: record.Topic,
+			Key:   record.Key,
+			Value: record.Value,
+		}
+
+		for k, v := range record.Headers {
+			kRecord.Headers = append(kRecord.Headers, kgo.RecordHeader{Key: k, Value: []byte(v)})
+		}
+
+		kRecords = append(kRecords, kRecord)
+	}
+
+	return c.cl.ProduceSync(ctx, kRecords...).FirstErr()
+}
+
+func (c *franzClient) Consume(ctx context.Context, opts ConsumerOpts) (Consumer, error) {
+	kopts := append([]kgo.Opt{}, c.opts...)
+
+	switch {
+	case opts.Group != "":
+		kopts = append(
+			kopts,
+			kgo.ConsumerGroup(opts.Group),
+			kgo.ConsumeTopics(opts.Topic),
+			kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+			kgo.DisableAutoCommit(),
+			kgo.BlockRebalanceOnPoll(),
+		)
+	case opts.Key != "":
+		partition, err := c.partitionForKey(ctx, opts.Topic, opts.Key)
+
+		if err != nil {
+			return nil, err
+		}
+
+		kopts = append(kopts, kgo.ConsumePartitions(map[string]map[int32]kgo.Offset{
+			opts.Topic: {partition: kgo.NewOffset().AtEnd()},
+		}))
+	default:
+		kopts = append(
+			kopts,
+			kgo.ConsumeTopics(opts.Topic),
+			kgo.ConsumeResetOffset(kgo.NewOffset().AtEnd()),
+		)
+	}
+
+	cl, err := kgo.NewClient(kopts...)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create kafka consumer: %w", err)
+	}
+
+	return &franzConsumer{
+		cl:    cl,
+		group: opts.Group != "",
+	}, nil
+}
+
+func (c *franzClient) partitionForKey(ctx context.Context, topic, key string) (int32, error) {
+	c.partitionCountsMu.RLock()
+	count, ok := c.partitionCounts[topic]
+	c.partitionCountsMu.RUnlock()
+
+	if !ok {
+		reqTopic := kmsg.NewMetadataRequestTopic()
+		reqTopic.Topic = kmsg.StringPtr(topic)
+
+		req := kmsg.NewPtrMetadataRequest()
+		req.Topics = append(req.Topics, reqTopic)
+
+		resp, err := req.RequestWith(ctx, c.cl)
+
+		if err != nil {
+			return 0, fmt.Errorf("could not read metadata for topic %s: %w", topic, err)
+		}
+
+		if len(resp.Topics) != 1 {
+			return 0, fmt.Errorf("could not read metadata for topic %s", topic)
+		}
+
+		if err := kerr.ErrorForCode(resp.Topics[0].ErrorCode); err != nil {
+			return 0, fmt.Errorf("could not read metadata for topic %s: %w", topic, err)
+		}
+
+		count = len(resp.Topics[0].Partitions)
+
+		c.partitionCountsMu.Lock()
+		c.partitionCounts[topic] = count
+		c.partitionCountsMu.Unlock()
+	}
+
+	if count == 0 {
+		return 0, fmt.Errorf("topic %s has no partitions", topic)
+	}
+
+	partition := c.partitioner.ForTopic(topic).Partition(&kgo.Record{Topic: topic, Key: []byte(key)}, count)
+
+	return int32(partition), nil // nolint: gosec
+}
+
+func (c *franzClient) Ping(ctx context.Context) error {
+	return c.cl.Ping(ctx)
+}
+
+func (c *franzClient) Close() {
+	c.cl.Close()
+}
+
+type franzConsumer struct {
+	cl    *kgo.Client
+	group bool
+
+	last []*kgo.Record
+}
+
+func (c *franzConsumer) Fetch(ctx context.Context, maxRecords int) ([]*Record, error) {
+	fetches := c.cl.PollRecords(ctx, maxRecords)
+
+	if fetches.IsClientClosed() {
+		return nil, fmt.Errorf("kafka consumer is closed")
+	}
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	for _, fetchErr := range fetches.Errors() {
+		return nil, fmt.Errorf("could not fetch from %s/%d: %w", fetchErr.Topic, fetchErr.Partition, fetchErr.Err)
+	}
+
+	c.last = fetches.Records()
+
+	res := make([]*Record, 0, len(c.last))
+
+	for _, kRecord := range c.last {
+		record := &Record{
+			Topic:     kRecord.Topic,
+			Key:       kRecord.Key,
+			Value:     kRecord.Value,
+			Headers:   make(map[string]string, len(kRecord.Headers)),
+			Timestamp: kRecord.Timestamp,
+		}
+
+		for _, header := range kRecord.Headers {
+			record.Headers[header.Key] = string(header.Value)
+		}
+
+		res = append(res, record)
+	}
+
+	return res, nil
+}
+
+func (c *franzConsumer) Commit(ctx context.Context) error {
+	if !c.group {
+		return nil
+	}
+
+	defer c.cl.AllowRebalance()
+
+	if len(c.last) == 0 {
+		return nil
+	}
+
+	err := c.cl.CommitRecords(ctx, c.last...)
+
+	c.last = nil
+
+	return err
+}
+
+func (c *franzConsumer) Close() {
+	c.cl.Close()
+}
@@ -0,0 +1,665 @@
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+const (
+	// TENANT_TOPIC is the topic which tenant messages are fanned out on. Records are keyed by the tenant id,
+	// so all messages for a tenant are written to the same partition, and each tenant subscriber only reads
+	// from the partition of its tenant.
+	TENANT_TOPIC = "hatchet_tenant_messages_v2"
+
+	// RETRY_DELAY is the time a message spends on a dead letter topic before it is retried
+	RETRY_DELAY = 5 * time.Second
+
+	// IMMEDIATE_EXPIRY is the maximum age of a message which should immediately expire when it is consumed.
+	// Kafka has no notion of an active consumer, so these messages are dropped by consumers instead.
+	IMMEDIATE_EXPIRY = 5 * time.Second
+
+	// CONSUMER_QUEUE_RETENTION is the retention of topics for non-durable queues, which Kafka cannot
+	// delete when their consumers go away
+	CONSUMER_QUEUE_RETENTION = time.Hour
+
+	RETRY_INTERVAL = 2 * time.Second
+
+	retryCountHeader        = "hatchet-retry-count"
+	originalTopicHeader     = "hatchet-original-topic"
+	immediatelyExpireHeader = "hatchet-immediately-expire"
+)
+
+// MessageQueueImpl implements the MessageQueue interface using Kafka. Queues are mapped to topics, and
+// consumers of a queue share a consumer group with the name of the queue:
+//
+//   - static queues are durable topics
+//   - consumer queues are topics with a short retention
+//   - tenant fanout queues read from the partition of the tenant on TENANT_TOPIC
+//   - dead letter exchanges are topics which are consumed by subscribers of their queue, and republish
+//     messages to the queue after RETRY_DELAY
+type MessageQueueImpl struct {
+	client     Client
+	ownsClient bool
+	configFs   []MessageQueueImplOpt
+
+	l *zerolog.Logger
+
+	qos               int
+	partitions        int32
+	replicationFactor int16
+
+	disableTenantExchangePubs bool
+
+	// retryDelay is the time a message spends on a dead letter topic before it is retried
+	retryDelay time.Duration
+
+	// deadLetters stores messages which have exhausted their retries. If nil, these messages are dropped.
+	deadLetters repository.MessageQueueRepository
+
+	createdTopics   map[string]bool
+	createdTopicsMu sync.RWMutex
+}
+
+type MessageQueueImplOpt func(*MessageQueueImplOpts)
+
+type MessageQueueImplOpts struct {
+	l                         *zerolog.Logger
+	client                    Client
+	brokers                   []string
+	tls                       *tls.Config
+	saslUsername              string
+	saslPassword              string
+	qos                       int
+	partitions                int32
+	replicationFactor         int16
+	disableTenantExchangePubs bool
+	deadLetters               repository.MessageQueueRepository
+}
+
+func defaultMessageQueueImplOpts() *MessageQueueImplOpts {
+	l := logger.NewDefaultLogger("kafka")
+
+	return &MessageQueueImplOpts{
+		l:                 &l,
+		brokers:           []string{"localhost:9092"},
+		qos:               100,
+		partitions:        8,
+		replicationFactor: 1,
+	}
+}
+
+func WithLogger(l *zerolog.Logger) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.l = l
+	}
+}
+
+func WithBrokers(brokers ...string) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.brokers = brokers
+	}
+}
+
+func WithTLS(tls *tls.Config) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.tls = tls
+	}
+}
+
+// WithSASL authenticates with the brokers using SASL/PLAIN
+func WithSASL(username, password string) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.saslUsername = username
+		opts.saslPassword = password
+	}
+}
+
+func WithQos(qos int) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.qos = qos
+	}
+}
+
+// WithPartitions sets the number of partitions of topics created by the message queue
+func WithPartitions(partitions int32) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.partitions = partitions
+	}
+}
+
+// WithReplicationFactor sets the replication factor of topics created by the message queue
+func WithReplicationFactor(replicationFactor int16) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.replicationFactor = replicationFactor
+	}
+}
+
+func WithDisableTenantExchangePubs(disable bool) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.disableTenantExchangePubs = disable
+	}
+}
+
+// WithDeadLetterRepository stores messages which have exhausted their retries in the repository.
+func WithDeadLetterRepository(repo repository.MessageQueueRepository) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.deadLetters = repo
+	}
+}
+
+// WithClient uses an existing client instead of connecting to the brokers. The client is not closed by the
+// message queue.
+func WithClient(client Client) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.client = client
+	}
+}
+
+// New creates a new MessageQueueImpl.
+func New(fs ...MessageQueueImplOpt) (func() error, *MessageQueueImpl) {
+	opts := defaultMessageQueueImplOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	newLogger := opts.l.With().Str("service", "kafka").Logger()
+	opts.l = &newLogger
+
+	client := opts.client
+	ownsClient := false
+
+	if client == nil {
+		franz, err := newFranzClient(franzClientOpts{
+			brokers:      opts.brokers,
+			tls:          opts.tls,
+			saslUsername: opts.saslUsername,
+			saslPassword: opts.saslPassword,
+		})
+
+		if err != nil {
+			opts.l.Error().Msgf("cannot create kafka client: %v", err)
+			return nil, nil
+		}
+
+		client = franz
+		ownsClient = true
+	}
+
+	t := &MessageQueueImpl{
+		client:                    client,
+		ownsClient:                ownsClient,
+		configFs:                  fs,
+		l:                         opts.l,
+		qos:                       opts.qos,
+		partitions:                opts.partitions,
+		replicationFactor:         opts.replicationFactor,
+		disableTenantExchangePubs: opts.disableTenantExchangePubs,
+		retryDelay:                RETRY_DELAY,
+		deadLetters:               opts.deadLetters,
+		createdTopics:             make(map[string]bool),
+	}
+
+	cleanup := func() error {
+		if t.ownsClient {
+			t.client.Close()
+		}
+
+		return nil
+	}
+
+	// init the topics in a blocking fashion
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	for _, q := range []msgqueue.Queue{
+		msgqueue.EVENT_PROCESSING_QUEUE,
+		msgqueue.JOB_PROCESSING_QUEUE,
+		msgqueue.WORKFLOW_PROCESSING_QUEUE,
+		msgqueue.TASK_PROCESSING_QUEUE,
+		msgqueue.OLAP_QUEUE,
+	} {
+		if err := t.initQueue(ctx, q); err != nil {
+			t.l.Error().Msgf("error initializing queue %s: %v", q.Name(), err)
+			_ = cleanup() // nolint: errcheck
+			return nil, nil
+		}
+	}
+
+	if err := t.createTopic(ctx, TENANT_TOPIC, true); err != nil {
+		t.l.Error().Msgf("error initializing tenant topic: %v", err)
+		_ = cleanup() // nolint: errcheck
+		return nil, nil
+	}
+
+	return cleanup, t
+}
+
+func (t *MessageQueueImpl) Clone() (func() error, msgqueue.MessageQueue) {
+	return New(t.configFs...)
+}
+
+// SetQOS sets the maximum number of records which are fetched, and processed concurrently, by each
+// subscriber.
+func (t *MessageQueueImpl) SetQOS(prefetchCount int) {
+	t.qos = prefetchCount
+}
+
+func (t *MessageQueueImpl) IsReady() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	return t.client.Ping(ctx) == nil
+}
+
+// RegisterTenant is a no-op, since all tenants share TENANT_TOPIC.
+func (t *MessageQueueImpl) RegisterTenant(ctx context.Context, tenantId string) error {
+	return nil
+}
+
+func (t *MessageQueueImpl) SendMessage(ctx context.Context, q msgqueue.Queue, msg *msgqueue.Message) error {
+	return t.pubMessage(ctx, q, msg)
+}
+
+func (t *MessageQueueImpl) pubMessage(ctx context.Context, q msgqueue.Queue, msg *msgqueue.Message) error {
+	otelCarrier := telemetry.GetCarrier(ctx)
+
+	ctx, span := telemetry.NewSpanWithCarrier(ctx, "publish-message", otelCarrier)
+	defer span.End()
+
+	msg.SetOtelCarrier(otelCarrier)
+
+	if err := t.initQueue(ctx, q); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(msg)
+
+	if err != nil {
+		t.l.Error().Msgf("error marshaling msg queue: %v", err)
+		return err
+	}
+
+	headers := map[string]string{}
+
+	if msg.ImmediatelyExpire {
+		headers[immediatelyExpireHeader] = "true"
+	}
+
+	records := []*Record{
+		{
+			Topic:   q.Name(),
+			Value:   body,
+			Headers: headers,
+		},
+	}
+
+	// tenant queues are consumed from the tenant topic, so we don't need to publish to the queue directly
+	if q.FanoutExchangeKey() != "" {
+		records = []*Record{}
+	}
+
+	// if this is a tenant msg, publish to the tenant topic
+	if (!t.disableTenantExchangePubs && msg.TenantID != "") || q.FanoutExchangeKey() != "" {
+		tenantId := msg.TenantID
+
+		if q.FanoutExchangeKey() != "" {
+			tenantId = q.FanoutExchangeKey()
+		}
+
+		records = append(records, &Record{
+			Topic: TENANT_TOPIC,
+			Key:   []byte(tenantId),
+			Value: body,
+		})
+	}
+
+	t.l.Debug().Msgf("publishing msg to queue %s", q.Name())
+
+	err = t.client.Produce(ctx, records...)
+
+	if err != nil {
+		t.l.Error().Msgf("error publishing msg: %v", err)
+		return err
+	}
+
+	t.l.Debug().Msgf("published msg to queue %s", q.Name())
+
+	return nil
+}
+
+// Subscribe subscribes to the msg queue.
+func (t *MessageQueueImpl) Subscribe(
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) (func() error, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	t.l.Debug().Msgf("subscribing to queue: %s", q.Name())
+
+	if err := t.initQueue(ctx, q); err != nil {
+		cancel()
+		return nil, fmt.Errorf("error initializing queue: %w", err)
+	}
+
+	consumerOpts := ConsumerOpts{
+		Topic: q.Name(),
+		Group: q.Name(),
+	}
+
+	if q.FanoutExchangeKey() != "" {
+		consumerOpts = ConsumerOpts{
+			Topic: TENANT_TOPIC,
+			Key:   q.FanoutExchangeKey(),
+		}
+	}
+
+	// the first consumer is created before returning, so that fanout consumers receive all messages which
+	// are sent after the subscription is created
+	consumer, err := t.client.Consume(ctx, consumerOpts)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create consumer: %w", err)
+	}
+
+	wg := sync.WaitGroup{}
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		t.consumeLoop(ctx, consumerOpts, consumer, func(consumer Consumer) error {
+			return t.processRecords(ctx, q, consumer, preAck, postAck)
+		})
+	}()
+
+	if q.DLX() != "" {
+		dlxOpts := ConsumerOpts{Topic: q.DLX(), Group: q.DLX()}
+
+		dlxConsumer, err := t.client.Consume(ctx, dlxOpts)
+
+		if err != nil {
+			cancel()
+			wg.Wait()
+			return nil, fmt.Errorf("could not create dead letter consumer: %w", err)
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			t.consumeLoop(ctx, dlxOpts, dlxConsumer, func(consumer Consumer) error {
+				return t.processDeadLetters(ctx, q, consumer)
+			})
+		}()
+	}
+
+	return func() error {
+		cancel()
+
+		t.l.Debug().Msgf("shutting down subscriber: %s", q.Name())
+		wg.Wait()
+		t.l.Debug().Msgf("successfully shut down subscriber: %s", q.Name())
+
+		return nil
+	}, nil
+}
+
+// consumeLoop calls process with the consumer until the context is cancelled. If process returns an error,
+// the consumer is closed and recreated.
+func (t *MessageQueueImpl) consumeLoop(ctx context.Context, opts ConsumerOpts, consumer Consumer, process func(consumer Consumer) error) {
+	for {
+		var err error
+
+		for err == nil {
+			err = process(consumer)
+		}
+
+		consumer.Close()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		t.l.Error().Msgf("could not consume from topic %s: %v", opts.Topic, err)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(RETRY_INTERVAL):
+			}
+
+			consumer, err = t.client.Consume(ctx, opts)
+
+			if err == nil {
+				break
+			}
+
+			t.l.Error().Msgf("could not create consumer for topic %s: %v", opts.Topic, err)
+		}
+	}
+}
+
+func (t *MessageQueueImpl) processRecords(
+	ctx context.Context,
+	q msgqueue.Queue,
+	consumer Consumer,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) error {
+	records, err := consumer.Fetch(ctx, t.qos)
+
+	if err != nil {
+		return err
+	}
+
+	acked := make([]*msgqueue.Message, len(records))
+
+	wg := sync.WaitGroup{}
+
+	for i, record := range records {
+		// fanout consumers read all tenants on the partition of their tenant
+		if q.FanoutExchangeKey() != "" && string(record.Key) != q.FanoutExchangeKey() {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(i int, record *Record) {
+			defer wg.Done()
+
+			if msg := t.processRecord(ctx, q, record, preAck); msg != nil {
+				acked[i] = msg
+			}
+		}(i, record)
+	}
+
+	wg.Wait()
+
+	if err := consumer.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing offsets: %w", err)
+	}
+
+	for _, msg := range acked {
+		if msg == nil {
+			continue
+		}
+
+		if err := postAck(msg); err != nil {
+			t.l.Error().Msgf("error in post-ack: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// processRecord runs the pre-ack hook for a record, and returns the message if it succeeded. Failed messages
+// are published to the dead letter topic of the queue.
+func (t *MessageQueueImpl) processRecord(ctx context.Context, q msgqueue.Queue, record *Record, preAck msgqueue.AckHook) *msgqueue.Message {
+	msg := &msgqueue.Message{}
+
+	if len(record.Value) == 0 {
+		t.l.Error().Msgf("empty message body on topic %s", record.Topic)
+		return nil
+	}
+
+	if err := json.Unmarshal(record.Value, msg); err != nil {
+		t.l.Error().Msgf("error unmarshalling message: %v", err)
+		return nil
+	}
+
+	if record.Headers[immediatelyExpireHeader] == "true" && time.Since(record.Timestamp) > IMMEDIATE_EXPIRY {
+		t.l.Debug().Msgf("message on topic %s has expired", record.Topic)
+		return nil
+	}
+
+	// determine if we've hit the max number of retries
+	retryCount, _ := strconv.Atoi(record.Headers[retryCountHeader]) // nolint: errcheck
+
+	if retryCount > msg.Retries {
+		t.l.Debug().Msgf("message has been rejected %d times, not requeuing", retryCount)
+		return nil
+	}
+
+	if err := preAck(msg); err != nil {
+		t.l.Error().Msgf("error in pre-ack: %v", err)
+
+		if q.DLX() == "" {
+			return nil
+		}
+
+		// if this was the last retry, move the message to the dead letters instead of retrying it
+		if t.deadLetters != nil && retryCount >= msg.Retries {
+			dlErr := msgqueue.DeadLetter(ctx, t.deadLetters, q, msg, retryCount, err)
+
+			if dlErr == nil {
+				return nil
+			}
+
+			t.l.Error().Msgf("error storing dead letter: %v", dlErr)
+		}
+
+		err := t.client.Produce(ctx, &Record{
+			Topic: q.DLX(),
+			Value: record.Value,
+			Headers: map[string]string{
+				retryCountHeader:    strconv.Itoa(retryCount + 1),
+				originalTopicHeader: q.Name(),
+			},
+		})
+
+		if err != nil {
+			t.l.Error().Msgf("error publishing message to dead letter topic %s: %v", q.DLX(), err)
+		}
+
+		return nil
+	}
+
+	return msg
+}
+
+// processDeadLetters republishes records on the dead letter topic of a queue to the queue, once they have
+// been on the dead letter topic for the retry delay.
+func (t *MessageQueueImpl) processDeadLetters(ctx context.Context, q msgqueue.Queue, consumer Consumer) error {
+	records, err := consumer.Fetch(ctx, t.qos)
+
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if wait := time.Until(record.Timestamp.Add(t.retryDelay)); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+
+		topic := record.Headers[originalTopicHeader]
+
+		if topic == "" {
+			topic = q.Name()
+		}
+
+		err := t.client.Produce(ctx, &Record{
+			Topic: topic,
+			Value: record.Value,
+			Headers: map[string]string{
+				retryCountHeader: record.Headers[retryCountHeader],
+			},
+		})
+
+		if err != nil {
+			return fmt.Errorf("error republishing dead letter to %s: %w", topic, err)
+		}
+	}
+
+	return consumer.Commit(ctx)
+}
+
+func (t *MessageQueueImpl) initQueue(ctx context.Context, q msgqueue.Queue) error {
+	// tenant queues are read from the tenant topic, which is created on startup
+	if q.FanoutExchangeKey() != "" {
+		return nil
+	}
+
+	if err := t.createTopic(ctx, q.Name(), q.Durable()); err != nil {
+		return err
+	}
+
+	if q.DLX() != "" {
+		if err := t.createTopic(ctx, q.DLX(), true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *MessageQueueImpl) createTopic(ctx context.Context, topic string, durable bool) error {
+	t.createdTopicsMu.RLock()
+
+	if _, exists := t.createdTopics[topic]; exists {
+		t.createdTopicsMu.RUnlock()
+		return nil
+	}
+
+	t.createdTopicsMu.RUnlock()
+
+	opts := TopicOpts{
+		Partitions:        t.partitions,
+		ReplicationFactor: t.replicationFactor,
+	}
+
+	if !durable {
+		opts.Retention = CONSUMER_QUEUE_RETENTION
+	}
+
+	if err := t.client.CreateTopic(ctx, topic, opts); err != nil {
+		t.l.Error().Msgf("cannot create topic: %q, %v", topic, err)
+		return err
+	}
+
+	t.createdTopicsMu.Lock()
+	defer t.createdTopicsMu.Unlock()
+
+	t.createdTopics[topic] = true
+
+	return nil
+}
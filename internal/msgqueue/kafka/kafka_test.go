@@ -0,0 +1,341 @@
+package kafka
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// testBroker is an in-process stand-in for a Kafka cluster. Each topic is a single log, and consumers in a
+// group share a position in the log.
+type testBroker struct {
+	mu sync.Mutex
+
+	topics    map[string][]*Record
+	positions map[string]int
+
+	// notify is closed and replaced whenever a record is produced
+	notify chan struct{}
+}
+
+func newTestBroker() *testBroker {
+	return &testBroker{
+		topics:    make(map[string][]*Record),
+		positions: make(map[string]int),
+		notify:    make(chan struct{}),
+	}
+}
+
+func (b *testBroker) CreateTopic(ctx context.Context, topic string, opts TopicOpts) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.topics[topic]; !ok {
+		b.topics[topic] = []*Record{}
+	}
+
+	return nil
+}
+
+func (b *testBroker) Produce(ctx context.Context, records ...*Record) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, record := range records {
+		cp := *record
+		cp.Timestamp = time.Now()
+
+		b.topics[record.Topic] = append(b.topics[record.Topic], &cp)
+	}
+
+	close(b.notify)
+	b.notify = make(chan struct{})
+
+	return nil
+}
+
+func (b *testBroker) Consume(ctx context.Context, opts ConsumerOpts) (Consumer, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := &testConsumer{
+		b:     b,
+		topic: opts.Topic,
+		group: opts.Group,
+	}
+
+	if opts.Group == "" {
+		c.position = len(b.topics[opts.Topic])
+	}
+
+	return c, nil
+}
+
+func (b *testBroker) Ping(ctx context.Context) error {
+	return nil
+}
+
+func (b *testBroker) Close() {}
+
+type testConsumer struct {
+	b        *testBroker
+	topic    string
+	group    string
+	position int
+}
+
+func (c *testConsumer) Fetch(ctx context.Context, maxRecords int) ([]*Record, error) {
+	for {
+		c.b.mu.Lock()
+
+		position := c.position
+
+		if c.group != "" {
+			position = c.b.positions[c.group]
+		}
+
+		log := c.b.topics[c.topic]
+
+		if position < len(log) {
+			end := min(position+maxRecords, len(log))
+
+			if c.group != "" {
+				c.b.positions[c.group] = end
+			} else {
+				c.position = end
+			}
+
+			c.b.mu.Unlock()
+
+			return log[position:end], nil
+		}
+
+		notify := c.b.notify
+
+		c.b.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-notify:
+		}
+	}
+}
+
+func (c *testConsumer) Commit(ctx context.Context) error {
+	return nil
+}
+
+func (c *testConsumer) Close() {}
+
+// testDeadLetters records dead letters. Other repository methods are not implemented.
+type testDeadLetters struct {
+	repository.MessageQueueRepository
+
+	created chan *repository.CreateDeadLetterOpts
+}
+
+func (d *testDeadLetters) CreateDeadLetter(ctx context.Context, opts *repository.CreateDeadLetterOpts) error {
+	d.created <- opts
+	return nil
+}
+
+func newTestMessageQueue(t *testing.T, fs ...MessageQueueImplOpt) *MessageQueueImpl {
+	l := zerolog.Nop()
+
+	cleanup, mq := New(append([]MessageQueueImplOpt{WithClient(newTestBroker()), WithLogger(&l)}, fs...)...)
+
+	require.NotNil(t, mq)
+
+	t.Cleanup(func() {
+		_ = cleanup() // nolint: errcheck
+	})
+
+	mq.retryDelay = 10 * time.Millisecond
+
+	return mq
+}
+
+func TestMessageQueue_StaticQueue(t *testing.T) {
+	mq := newTestMessageQueue(t)
+	q := msgqueue.NewRandomStaticQueue()
+
+	received := make(chan *msgqueue.Message, 1)
+
+	cleanup, err := mq.Subscribe(q, msgqueue.NoOpHook, func(msg *msgqueue.Message) error {
+		received <- msg
+		return nil
+	})
+
+	require.NoError(t, err)
+	defer cleanup() // nolint: errcheck
+
+	msg, err := msgqueue.NewTenantMessage("tenant", "test-message", false, true, map[string]string{"key": "value"})
+	require.NoError(t, err)
+
+	require.NoError(t, mq.SendMessage(context.Background(), q, msg))
+
+	select {
+	case got := <-received:
+		assert.Equal(t, msg.ID, got.ID)
+		assert.Equal(t, msg.Payloads, got.Payloads)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for message")
+	}
+}
+
+func TestMessageQueue_Retries(t *testing.T) {
+	mq := newTestMessageQueue(t)
+	q := msgqueue.NewRandomStaticQueue()
+
+	var attempts atomic.Int32
+
+	received := make(chan struct{}, 1)
+
+	// fail the first attempt, which should be retried via the dead letter topic
+	cleanup, err := mq.Subscribe(q, func(msg *msgqueue.Message) error {
+		if attempts.Add(1) == 1 {
+			return assert.AnError
+		}
+
+		return nil
+	}, func(msg *msgqueue.Message) error {
+		received <- struct{}{}
+		return nil
+	})
+
+	require.NoError(t, err)
+	defer cleanup() // nolint: errcheck
+
+	msg, err := msgqueue.NewTenantMessage("tenant", "test-message", false, true, map[string]string{})
+	require.NoError(t, err)
+
+	require.NoError(t, mq.SendMessage(context.Background(), q, msg))
+
+	select {
+	case <-received:
+		assert.Equal(t, int32(2), attempts.Load())
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for retried message")
+	}
+}
+
+func TestMessageQueue_RetriesExhausted(t *testing.T) {
+	mq := newTestMessageQueue(t)
+	q := msgqueue.NewRandomStaticQueue()
+
+	var attempts atomic.Int32
+
+	cleanup, err := mq.Subscribe(q, func(msg *msgqueue.Message) error {
+		attempts.Add(1)
+		return assert.AnError
+	}, msgqueue.NoOpHook)
+
+	require.NoError(t, err)
+	defer cleanup() // nolint: errcheck
+
+	msg, err := msgqueue.NewTenantMessage("tenant", "test-message", false, true, map[string]string{})
+	require.NoError(t, err)
+
+	msg.Retries = 2
+
+	require.NoError(t, mq.SendMessage(context.Background(), q, msg))
+
+	// the first attempt, and one attempt for each retry
+	assert.Eventually(t, func() bool {
+		return attempts.Load() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	time.Sleep(100 * time.Millisecond)
+
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestMessageQueue_DeadLetters(t *testing.T) {
+	deadLetters := &testDeadLetters{
+		created: make(chan *repository.CreateDeadLetterOpts, 1),
+	}
+
+	mq := newTestMessageQueue(t, WithDeadLetterRepository(deadLetters))
+	q := msgqueue.NewRandomStaticQueue()
+
+	var attempts atomic.Int32
+
+	cleanup, err := mq.Subscribe(q, func(msg *msgqueue.Message) error {
+		attempts.Add(1)
+		return assert.AnError
+	}, msgqueue.NoOpHook)
+
+	require.NoError(t, err)
+	defer cleanup() // nolint: errcheck
+
+	msg, err := msgqueue.NewTenantMessage("tenant", "test-message", false, true, map[string]string{})
+	require.NoError(t, err)
+
+	msg.Retries = 1
+
+	require.NoError(t, mq.SendMessage(context.Background(), q, msg))
+
+	select {
+	case opts := <-deadLetters.created:
+		assert.Equal(t, q.Name(), opts.Queue)
+		assert.Equal(t, "test-message", opts.MessageId)
+		assert.Equal(t, "tenant", *opts.TenantId)
+		assert.Equal(t, assert.AnError.Error(), *opts.Error)
+		assert.Equal(t, 1, opts.RetryCount)
+		assert.Equal(t, int32(2), attempts.Load())
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for dead letter")
+	}
+}
+
+func TestMessageQueue_TenantFanout(t *testing.T) {
+	mq := newTestMessageQueue(t)
+
+	receivedA := make(chan *msgqueue.Message, 2)
+	receivedB := make(chan *msgqueue.Message, 2)
+
+	cleanupA, err := mq.Subscribe(msgqueue.TenantEventConsumerQueue("tenant-a"), msgqueue.NoOpHook, func(msg *msgqueue.Message) error {
+		receivedA <- msg
+		return nil
+	})
+
+	require.NoError(t, err)
+	defer cleanupA() // nolint: errcheck
+
+	cleanupB, err := mq.Subscribe(msgqueue.TenantEventConsumerQueue("tenant-b"), msgqueue.NoOpHook, func(msg *msgqueue.Message) error {
+		receivedB <- msg
+		return nil
+	})
+
+	require.NoError(t, err)
+	defer cleanupB() // nolint: errcheck
+
+	msg, err := msgqueue.NewTenantMessage("tenant-a", "test-message", false, true, map[string]string{})
+	require.NoError(t, err)
+
+	// tenant messages sent to any queue are fanned out to the subscribers of the tenant
+	require.NoError(t, mq.SendMessage(context.Background(), msgqueue.TASK_PROCESSING_QUEUE, msg))
+
+	select {
+	case got := <-receivedA:
+		assert.Equal(t, msg.ID, got.ID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for tenant message")
+	}
+
+	select {
+	case <-receivedB:
+		t.Fatal("received message for another tenant")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -23,6 +23,10 @@ type Message struct {
 
 	// Retries is the number of retries for the task.
 	Retries int `json:"retries"`
+
+	// RetryCount is the number of times the message has been retried, for message queues which do not track
+	// redeliveries themselves.
+	RetryCount int `json:"retry_count,omitempty"`
 }
 
 func NewTenantMessage[T any](tenantId, id string, immediatelyExpire, persistent bool, payloads ...T) (*Message, error) {
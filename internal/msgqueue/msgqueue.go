@@ -66,6 +66,26 @@ func (s staticQueue) DLX() string {
 	return fmt.Sprintf("%s_dlx_v2", s.Name())
 }
 
+// staticQueues are the queues which are created on startup and shared by all engine instances.
+var staticQueues = []staticQueue{
+	EVENT_PROCESSING_QUEUE,
+	JOB_PROCESSING_QUEUE,
+	WORKFLOW_PROCESSING_QUEUE,
+	TASK_PROCESSING_QUEUE,
+	OLAP_QUEUE,
+}
+
+// GetStaticQueue returns the static queue with the given name, if it exists.
+func GetStaticQueue(name string) (Queue, bool) {
+	for _, q := range staticQueues {
+		if q.Name() == name {
+			return q, true
+		}
+	}
+
+	return nil, false
+}
+
 func NewRandomStaticQueue() staticQueue {
 	randBytes, _ := random.Generate(8)
 	return staticQueue(fmt.Sprintf("random_static_queue_v2_%s", randBytes))
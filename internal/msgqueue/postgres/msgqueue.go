@@ -19,6 +19,9 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 )
 
+// RETRY_DELAY is the time before a failed message is read again
+const RETRY_DELAY = 5 * time.Second
+
 type PostgresMessageQueue struct {
 	repo repository.MessageQueueRepository
 	l    *zerolog.Logger
@@ -158,6 +161,14 @@ func (p *PostgresMessageQueue) Subscribe(queue msgqueue.Queue, preAck msgqueue.A
 
 		if err != nil {
 			p.l.Error().Err(err).Msg("error pre-acking message")
+
+			// messages which are read from the database are retried, and dead lettered when they run out of retries
+			if ackId != nil && queue.DLX() != "" {
+				if retryErr := p.retryOrDeadLetter(subscribeCtx, queue, &task, *ackId, err); retryErr != nil {
+					p.l.Error().Err(retryErr).Msg("error retrying message")
+				}
+			}
+
 			return err
 		}
 
@@ -190,6 +201,7 @@ func (p *PostgresMessageQueue) Subscribe(queue msgqueue.Queue, preAck msgqueue.A
 			if err != nil {
 				p.l.Error().Err(err).Msg("error unmarshalling message")
 				errs = multierror.Append(errs, err)
+				continue
 			}
 
 			err = doTask(task, &message.ID)
@@ -279,6 +291,30 @@ func (p *PostgresMessageQueue) Subscribe(queue msgqueue.Queue, preAck msgqueue.A
 	}, nil
 }
 
+// retryOrDeadLetter returns a failed message to the queue after RETRY_DELAY, or moves it to the dead letter
+// table if it has run out of retries.
+func (p *PostgresMessageQueue) retryOrDeadLetter(ctx context.Context, queue msgqueue.Queue, task *msgqueue.Message, ackId int64, cause error) error {
+	if task.RetryCount < task.Retries {
+		task.RetryCount++
+
+		msgBytes, err := json.Marshal(task)
+
+		if err != nil {
+			return err
+		}
+
+		return p.repo.RetryMessage(ctx, ackId, msgBytes, RETRY_DELAY)
+	}
+
+	p.l.Debug().Msgf("message has been retried %d times, moving to dead letters", task.RetryCount)
+
+	if err := msgqueue.DeadLetter(ctx, p.repo, queue, task, task.RetryCount, cause); err != nil {
+		return err
+	}
+
+	return p.repo.AckMessage(ctx, ackId)
+}
+
 func (p *PostgresMessageQueue) RegisterTenant(ctx context.Context, tenantId string) error {
 	return nil
 }
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+type testMessageQueueRepository struct {
+	repository.MessageQueueRepository
+
+	retried     map[int64][]byte
+	acked       []int64
+	deadLetters []*repository.CreateDeadLetterOpts
+}
+
+func (r *testMessageQueueRepository) RetryMessage(ctx context.Context, id int64, payload []byte, delay time.Duration) error {
+	r.retried[id] = payload
+	return nil
+}
+
+func (r *testMessageQueueRepository) AckMessage(ctx context.Context, id int64) error {
+	r.acked = append(r.acked, id)
+	return nil
+}
+
+func (r *testMessageQueueRepository) CreateDeadLetter(ctx context.Context, opts *repository.CreateDeadLetterOpts) error {
+	r.deadLetters = append(r.deadLetters, opts)
+	return nil
+}
+
+func TestRetryOrDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+	repo := &testMessageQueueRepository{retried: map[int64][]byte{}}
+	p := NewPostgresMQ(repo, WithLogger(&l))
+	q := msgqueue.NewRandomStaticQueue()
+
+	task, err := msgqueue.NewTenantMessage("", "test-message", false, true, map[string]string{"key": "value"})
+	require.NoError(t, err)
+
+	task.Retries = 2
+
+	errProcess := errors.New("intentional error")
+
+	// the message is returned to the queue until it has exhausted its retries
+	for ackId := int64(1); ackId <= 2; ackId++ {
+		require.NoError(t, p.retryOrDeadLetter(ctx, q, task, ackId, errProcess))
+
+		retried := &msgqueue.Message{}
+		require.NoError(t, json.Unmarshal(repo.retried[ackId], retried))
+		assert.Equal(t, int(ackId), retried.RetryCount)
+	}
+
+	assert.Empty(t, repo.acked)
+	assert.Empty(t, repo.deadLetters)
+
+	// the last attempt moves the message to the dead letters, and removes it from the queue
+	require.NoError(t, p.retryOrDeadLetter(ctx, q, task, 3, errProcess))
+
+	assert.Len(t, repo.retried, 2)
+	assert.Equal(t, []int64{3}, repo.acked)
+
+	require.Len(t, repo.deadLetters, 1)
+
+	deadLetter := repo.deadLetters[0]
+
+	assert.Equal(t, q.Name(), deadLetter.Queue)
+	assert.Equal(t, "test-message", deadLetter.MessageId)
+	assert.Nil(t, deadLetter.TenantId)
+	assert.Equal(t, 2, deadLetter.RetryCount)
+	require.NotNil(t, deadLetter.Error)
+	assert.Equal(t, errProcess.Error(), *deadLetter.Error)
+}
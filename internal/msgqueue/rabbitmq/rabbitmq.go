@@ -18,6 +18,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/pkg/logger"
 	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 )
 
 const MAX_RETRY_COUNT = 15
@@ -36,6 +37,9 @@ type MessageQueueImpl struct {
 
 	disableTenantExchangePubs bool
 
+	// deadLetters stores messages which have exhausted their retries. If nil, these messages are dropped.
+	deadLetters repository.MessageQueueRepository
+
 	// lru cache for tenant ids
 	tenantIdCache *lru.Cache[string, bool]
 
@@ -53,6 +57,7 @@ type MessageQueueImplOpts struct {
 	url                       string
 	qos                       int
 	disableTenantExchangePubs bool
+	deadLetters               repository.MessageQueueRepository
 }
 
 func defaultMessageQueueImplOpts() *MessageQueueImplOpts {
@@ -88,6 +93,13 @@ func WithDisableTenantExchangePubs(disable bool) MessageQueueImplOpt {
 	}
 }
 
+// WithDeadLetterRepository stores messages which have exhausted their retries in the repository.
+func WithDeadLetterRepository(repo repository.MessageQueueRepository) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.deadLetters = repo
+	}
+}
+
 // New creates a new MessageQueueImpl.
 func New(fs ...MessageQueueImplOpt) (func() error, *MessageQueueImpl) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -115,6 +127,7 @@ func New(fs ...MessageQueueImplOpt) (func() error, *MessageQueueImpl) {
 		qos:                       opts.qos,
 		configFs:                  fs,
 		disableTenantExchangePubs: opts.disableTenantExchangePubs,
+		deadLetters:               opts.deadLetters,
 		channels:                  channelPool,
 	}
 
@@ -546,9 +559,11 @@ func (t *MessageQueueImpl) subscribe(
 					// determine if we've hit the max number of retries
 					xDeath, exists := rabbitMsg.Headers["x-death"].([]interface{})
 
+					var deathCount int64
+
 					if exists {
 						// message was rejected before
-						deathCount := xDeath[0].(amqp.Table)["count"].(int64)
+						deathCount = xDeath[0].(amqp.Table)["count"].(int64)
 
 						t.l.Debug().Msgf("message has been rejected %d times", deathCount)
 
@@ -569,6 +584,19 @@ func (t *MessageQueueImpl) subscribe(
 					if err := preAck(msg); err != nil {
 						t.l.Error().Msgf("error in pre-ack: %v", err)
 
+						// if this was the last retry, move the message to the dead letters instead of rejecting it
+						if t.deadLetters != nil && q.DLX() != "" && deathCount >= int64(msg.Retries) {
+							if dlErr := msgqueue.DeadLetter(ctx, t.deadLetters, q, msg, int(deathCount), err); dlErr != nil {
+								t.l.Error().Msgf("error storing dead letter: %v", dlErr)
+							} else {
+								if err := rabbitMsg.Ack(false); err != nil {
+									t.l.Error().Msgf("error acknowledging message: %v", err)
+								}
+
+								return
+							}
+						}
+
 						// nack the message
 						if err := rabbitMsg.Reject(false); err != nil {
 							t.l.Error().Msgf("error rejecting message: %v", err)
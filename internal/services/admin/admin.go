@@ -3,9 +3,14 @@ package admin
 import (
 	"fmt"
 
+	"github.com/rs/zerolog"
+
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/taskactions"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
 	"github.com/hatchet-dev/hatchet/pkg/validator"
 )
 
@@ -20,6 +25,8 @@ type AdminServiceImpl struct {
 	repo         repository.EngineRepository
 	mq           msgqueue.MessageQueue
 	v            validator.Validator
+	bulk         *taskactions.BulkActions
+	l            *zerolog.Logger
 }
 
 type AdminServiceOpt func(*AdminServiceOpts)
@@ -27,15 +34,19 @@ type AdminServiceOpt func(*AdminServiceOpts)
 type AdminServiceOpts struct {
 	entitlements repository.EntitlementsRepository
 	repo         repository.EngineRepository
+	repov2       v2.Repository
 	mq           msgqueue.MessageQueue
 	v            validator.Validator
+	l            *zerolog.Logger
 }
 
 func defaultAdminServiceOpts() *AdminServiceOpts {
 	v := validator.NewDefaultValidator()
+	logger := logger.NewDefaultLogger("admin")
 
 	return &AdminServiceOpts{
 		v: v,
+		l: &logger,
 	}
 }
 
@@ -45,6 +56,12 @@ func WithRepository(r repository.EngineRepository) AdminServiceOpt {
 	}
 }
 
+func WithV2Repository(r v2.Repository) AdminServiceOpt {
+	return func(opts *AdminServiceOpts) {
+		opts.repov2 = r
+	}
+}
+
 func WithEntitlementsRepository(r repository.EntitlementsRepository) AdminServiceOpt {
 	return func(opts *AdminServiceOpts) {
 		opts.entitlements = r
@@ -63,6 +80,12 @@ func WithValidator(v validator.Validator) AdminServiceOpt {
 	}
 }
 
+func WithLogger(l *zerolog.Logger) AdminServiceOpt {
+	return func(opts *AdminServiceOpts) {
+		opts.l = l
+	}
+}
+
 func NewAdminService(fs ...AdminServiceOpt) (AdminService, error) {
 	opts := defaultAdminServiceOpts()
 
@@ -78,10 +101,16 @@ func NewAdminService(fs ...AdminServiceOpt) (AdminService, error) {
 		return nil, fmt.Errorf("task queue is required. use WithMessageQueue")
 	}
 
+	if opts.repov2 == nil {
+		return nil, fmt.Errorf("v2 repository is required. use WithV2Repository")
+	}
+
 	return &AdminServiceImpl{
 		repo:         opts.repo,
 		entitlements: opts.entitlements,
 		mq:           opts.mq,
 		v:            opts.v,
+		bulk:         taskactions.NewBulkActions(opts.repo.OLAP(), opts.repov2, opts.mq),
+		l:            opts.l,
 	}, nil
 }
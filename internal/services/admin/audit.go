@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// audit records an action performed through the admin service in the tenant's audit log. The entry is
+// buffered and written in the background, and since the action has already been performed, errors are
+// logged rather than returned to the caller.
+func (a *AdminServiceImpl) audit(ctx context.Context, tenantId, action, resourceType string, resourceId *string, meta map[string]interface{}) {
+	tokenId, ok := ctx.Value("api_token_id").(string)
+
+	if !ok {
+		return
+	}
+
+	opts := &repository.CreateAuditLogOpts{
+		ActorKind:    dbsqlc.AuditLogActorKindAPITOKEN,
+		ActorId:      tokenId,
+		Source:       dbsqlc.AuditLogSourceGRPC,
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceId:   resourceId,
+		Metadata:     meta,
+	}
+
+	if opts.Metadata == nil {
+		opts.Metadata = make(map[string]interface{})
+	}
+
+	if method, ok := grpc.Method(ctx); ok {
+		opts.Metadata["method"] = method
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr := p.Addr.String()
+		opts.IpAddress = &addr
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if userAgent := md.Get("user-agent"); len(userAgent) > 0 {
+			opts.UserAgent = &userAgent[0]
+		}
+	}
+
+	// the name is set by the auth middleware when it validates the token
+	if tokenName, ok := ctx.Value("api_token_name").(string); ok && tokenName != "" {
+		opts.ActorName = &tokenName
+	}
+
+	if err := a.repo.AuditLog().BufferAuditLog(tenantId, opts); err != nil {
+		a.l.Error().Err(err).Str("action", action).Msg("could not buffer audit log entry")
+	}
+}
@@ -224,6 +224,16 @@ func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.PutWo
 		}
 	}
 
+	// only record changes to the workflow, since workers register their workflows every time they start
+	if workflowVersion != oldWorkflowVersion {
+		workflowId := sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.WorkflowId)
+
+		a.audit(ctx, tenantId, "PutWorkflow", "workflow", &workflowId, map[string]interface{}{
+			"name":              req.Opts.Name,
+			"workflowVersionId": sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID),
+		})
+	}
+
 	resp := toWorkflowVersion(workflowVersion, nil)
 
 	return resp, nil
@@ -331,6 +341,11 @@ func (a *AdminServiceImpl) ScheduleWorkflow(ctx context.Context, req *contracts.
 		return nil, err
 	}
 
+	a.audit(ctx, tenantId, "ScheduleWorkflow", "workflow", &workflowId, map[string]interface{}{
+		"name":      req.Name,
+		"schedules": dbSchedules,
+	})
+
 	resp := toWorkflowVersion(currWorkflow, scheduledRef)
 
 	return resp, nil
@@ -349,10 +364,31 @@ func (a *AdminServiceImpl) PutRateLimit(ctx context.Context, req *contracts.PutR
 
 	limit := int(req.Limit)
 	duration := req.Duration.String()
+	algorithm := req.GetAlgorithm().String()
 
 	createOpts := &repository.UpsertRateLimitOpts{
-		Limit:    limit,
-		Duration: &duration,
+		Limit:     limit,
+		Duration:  &duration,
+		Algorithm: &algorithm,
+	}
+
+	if req.Burst != nil {
+		if req.GetAlgorithm() != contracts.RateLimitAlgorithm_TOKEN_BUCKET {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				"burst can only be set for token bucket rate limits",
+			)
+		}
+
+		if req.GetBurst() < req.Limit {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				"burst must be greater than or equal to the limit",
+			)
+		}
+
+		burst := int(req.GetBurst())
+		createOpts.Burst = &burst
 	}
 
 	_, err := a.repo.RateLimit().UpsertRateLimit(ctx, tenantId, req.Key, createOpts)
@@ -361,6 +397,12 @@ func (a *AdminServiceImpl) PutRateLimit(ctx context.Context, req *contracts.PutR
 		return nil, err
 	}
 
+	a.audit(ctx, tenantId, "PutRateLimit", "rate-limit", &req.Key, map[string]interface{}{
+		"limit":     limit,
+		"duration":  duration,
+		"algorithm": algorithm,
+	})
+
 	return &contracts.PutRateLimitResponse{}, nil
 }
 
@@ -427,11 +469,19 @@ func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.Creat
 			limitStrategy = repository.StringPtr(req.Opts.Concurrency.LimitStrategy.String())
 		}
 
+		if req.Opts.Concurrency.WeightExpression != nil && req.Opts.Concurrency.GetLimitStrategy() != contracts.ConcurrencyLimitStrategy_WEIGHTED_ROUND_ROBIN {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				"concurrency weight expression is only supported for the WEIGHTED_ROUND_ROBIN strategy",
+			)
+		}
+
 		concurrency = &repository.CreateWorkflowConcurrencyOpts{
-			Action:        req.Opts.Concurrency.Action,
-			LimitStrategy: limitStrategy,
-			Expression:    req.Opts.Concurrency.Expression,
-			MaxRuns:       req.Opts.Concurrency.MaxRuns,
+			Action:           req.Opts.Concurrency.Action,
+			LimitStrategy:    limitStrategy,
+			Expression:       req.Opts.Concurrency.Expression,
+			MaxRuns:          req.Opts.Concurrency.MaxRuns,
+			WeightExpression: req.Opts.Concurrency.WeightExpression,
 		}
 	}
 
@@ -553,6 +603,13 @@ func getCreateJobOpts(req *contracts.CreateWorkflowJobOpts, kind string) (*repos
 			steps[j].RateLimits = append(steps[j].RateLimits, opt)
 		}
 
+		for _, condition := range stepCp.UserEventConditions {
+			steps[j].UserEventConditions = append(steps[j].UserEventConditions, repository.CreateWorkflowStepUserEventConditionOpts{
+				EventKey:   condition.EventKey,
+				Expression: condition.Expression,
+			})
+		}
+
 		if stepCp.UserData != "" {
 			steps[j].UserData = &stepCp.UserData
 		}
@@ -0,0 +1,199 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/taskactions"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (a *AdminServiceImpl) CancelTasks(ctx context.Context, req *contracts.CancelTasksRequest) (*contracts.CancelTasksResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	tasks, err := a.resolveTasks(ctx, tenantId, req.ExternalIds, req.Filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled, err := a.bulk.CancelTasks(ctx, tenantId, tasks)
+
+	if err != nil {
+		return nil, err
+	}
+
+	externalIds := externalIdsFromTasks(cancelled)
+
+	a.audit(ctx, tenantId, "CancelTasks", "task", nil, map[string]interface{}{
+		"externalIds": externalIds,
+	})
+
+	return &contracts.CancelTasksResponse{
+		CancelledTasks: externalIds,
+	}, nil
+}
+
+func (a *AdminServiceImpl) ReplayTasks(ctx context.Context, req *contracts.ReplayTasksRequest) (*contracts.ReplayTasksResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	tasks, err := a.resolveTasks(ctx, tenantId, req.ExternalIds, req.Filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	replayed, err := a.bulk.ReplayTasks(ctx, tenantId, tasks)
+
+	if err != nil {
+		return nil, err
+	}
+
+	externalIds := externalIdsFromTasks(replayed)
+
+	a.audit(ctx, tenantId, "ReplayTasks", "task", nil, map[string]interface{}{
+		"externalIds": externalIds,
+	})
+
+	return &contracts.ReplayTasksResponse{
+		ReplayedTasks: externalIds,
+	}, nil
+}
+
+func (a *AdminServiceImpl) resolveTasks(ctx context.Context, tenantId string, externalIds []string, filter *contracts.TasksFilter) ([]*taskactions.Task, error) {
+	if (len(externalIds) == 0) == (filter == nil) {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"exactly one of external_ids and filter must be set",
+		)
+	}
+
+	if len(externalIds) > 0 {
+		if len(externalIds) > taskactions.MaxExternalIds {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"at most %d external ids can be passed",
+				taskactions.MaxExternalIds,
+			)
+		}
+
+		for _, externalId := range externalIds {
+			if _, err := uuid.Parse(externalId); err != nil {
+				return nil, status.Errorf(
+					codes.InvalidArgument,
+					"invalid external id %s",
+					externalId,
+				)
+			}
+		}
+
+		return a.bulk.TasksByExternalIds(ctx, tenantId, externalIds)
+	}
+
+	opts, err := getListTaskRunOpts(filter)
+
+	if err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			err.Error(),
+		)
+	}
+
+	tasks, err := a.bulk.TasksByFilter(ctx, tenantId, opts)
+
+	if errors.Is(err, taskactions.ErrTooManyResults) {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			err.Error(),
+		)
+	}
+
+	return tasks, err
+}
+
+func getListTaskRunOpts(filter *contracts.TasksFilter) (repository.ListTaskRunOpts, error) {
+	opts := repository.ListTaskRunOpts{
+		Statuses: []gen.V2TaskStatus{
+			gen.V2TaskStatusCANCELLED,
+			gen.V2TaskStatusCOMPLETED,
+			gen.V2TaskStatusFAILED,
+			gen.V2TaskStatusQUEUED,
+			gen.V2TaskStatusRUNNING,
+		},
+		WorkflowIds: []uuid.UUID{},
+	}
+
+	if filter.Since == nil {
+		return opts, fmt.Errorf("filter.since is required")
+	}
+
+	opts.CreatedAfter = filter.Since.AsTime()
+
+	if filter.Until != nil {
+		until := filter.Until.AsTime()
+		opts.FinishedBefore = &until
+	}
+
+	if len(filter.Statuses) > 0 {
+		opts.Statuses = make([]gen.V2TaskStatus, 0, len(filter.Statuses))
+
+		for _, s := range filter.Statuses {
+			taskStatus := gen.V2TaskStatus(strings.ToUpper(s))
+
+			switch taskStatus {
+			case gen.V2TaskStatusCANCELLED, gen.V2TaskStatusCOMPLETED, gen.V2TaskStatusFAILED, gen.V2TaskStatusQUEUED, gen.V2TaskStatusRUNNING:
+			default:
+				return opts, fmt.Errorf("invalid status %s", s)
+			}
+
+			opts.Statuses = append(opts.Statuses, taskStatus)
+		}
+	}
+
+	for _, workflowId := range filter.WorkflowIds {
+		id, err := uuid.Parse(workflowId)
+
+		if err != nil {
+			return opts, fmt.Errorf("invalid workflow id %s", workflowId)
+		}
+
+		opts.WorkflowIds = append(opts.WorkflowIds, id)
+	}
+
+	if len(filter.AdditionalMetadata) > 0 {
+		additionalMetadataFilters := make(map[string]interface{})
+
+		for _, v := range filter.AdditionalMetadata {
+			kv_pairs := strings.Split(v, ":")
+			if len(kv_pairs) == 2 {
+				additionalMetadataFilters[kv_pairs[0]] = kv_pairs[1]
+			}
+		}
+
+		opts.AdditionalMetadata = additionalMetadataFilters
+	}
+
+	return opts, nil
+}
+
+func externalIdsFromTasks(tasks []*taskactions.Task) []string {
+	res := make([]string, 0, len(tasks))
+
+	for _, task := range tasks {
+		res = append(res, task.ExternalID)
+	}
+
+	return res
+}
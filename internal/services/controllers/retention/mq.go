@@ -37,5 +37,11 @@ func (rc *RetentionControllerImpl) runDeleteMessageQueueItemsTenant(ctx context.
 		return err
 	}
 
-	return rc.repo.MessageQueue().CleanupMessageQueueItems(ctx)
+	err = rc.repo.MessageQueue().CleanupMessageQueueItems(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	return rc.repo.MessageQueue().CleanupDeadLetters(ctx)
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/integrations/webhooks"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/queueutils"
 	"github.com/hatchet-dev/hatchet/internal/services/partition"
@@ -41,18 +42,21 @@ type OLAPControllerImpl struct {
 	s                          gocron.Scheduler
 	updateTaskStatusOperations *queueutils.OperationPool
 	updateDAGStatusOperations  *queueutils.OperationPool
+	deliverWebhookOperations   *queueutils.OperationPool
+	webhooks                   *webhooks.Dispatcher
 }
 
 type OLAPControllerOpt func(*OLAPControllerOpts)
 
 type OLAPControllerOpts struct {
-	mq      msgqueue.MessageQueue
-	l       *zerolog.Logger
-	repo    repository.OLAPEventRepository
-	v2repo  v2.TaskRepository
-	dv      datautils.DataDecoderValidator
-	alerter hatcheterrors.Alerter
-	p       *partition.Partition
+	mq       msgqueue.MessageQueue
+	l        *zerolog.Logger
+	repo     repository.OLAPEventRepository
+	v2repo   v2.TaskRepository
+	dv       datautils.DataDecoderValidator
+	alerter  hatcheterrors.Alerter
+	p        *partition.Partition
+	webhooks *webhooks.Dispatcher
 }
 
 func defaultOLAPControllerOpts() *OLAPControllerOpts {
@@ -108,6 +112,13 @@ func WithDataDecoderValidator(dv datautils.DataDecoderValidator) OLAPControllerO
 	}
 }
 
+// WithWebhookDispatcher enables delivery of run lifecycle events to the webhook subscriptions of tenants
+func WithWebhookDispatcher(d *webhooks.Dispatcher) OLAPControllerOpt {
+	return func(opts *OLAPControllerOpts) {
+		opts.webhooks = d
+	}
+}
+
 func New(fs ...OLAPControllerOpt) (*OLAPControllerImpl, error) {
 	opts := defaultOLAPControllerOpts()
 
@@ -144,18 +155,20 @@ func New(fs ...OLAPControllerOpt) (*OLAPControllerImpl, error) {
 	a.WithData(map[string]interface{}{"service": "olap-controller"})
 
 	o := &OLAPControllerImpl{
-		mq:     opts.mq,
-		l:      opts.l,
-		s:      s,
-		p:      opts.p,
-		repo:   opts.repo,
-		v2repo: opts.v2repo,
-		dv:     opts.dv,
-		a:      a,
+		mq:       opts.mq,
+		l:        opts.l,
+		s:        s,
+		p:        opts.p,
+		repo:     opts.repo,
+		v2repo:   opts.v2repo,
+		dv:       opts.dv,
+		a:        a,
+		webhooks: opts.webhooks,
 	}
 
 	o.updateTaskStatusOperations = queueutils.NewOperationPool(opts.l, time.Second*15, "update task statuses", o.updateTaskStatuses)
 	o.updateDAGStatusOperations = queueutils.NewOperationPool(opts.l, time.Second*15, "update dag statuses", o.updateDAGStatuses)
+	o.deliverWebhookOperations = queueutils.NewOperationPool(opts.l, time.Second*60, "deliver webhooks", o.deliverWebhooks)
 
 	return o, nil
 }
@@ -215,6 +228,20 @@ func (o *OLAPControllerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule dag status updates: %w", err)
 	}
 
+	if o.webhooks != nil {
+		_, err = o.s.NewJob(
+			gocron.DurationJob(time.Second*1),
+			gocron.NewTask(
+				o.runTenantWebhookDeliveries(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not schedule webhook deliveries: %w", err)
+		}
+	}
+
 	cleanupBuffer, err := mqBuffer.Start()
 
 	if err != nil {
@@ -356,6 +383,10 @@ func (tc *OLAPControllerImpl) handleCreateMonitoringEvent(ctx context.Context, t
 			readableStatuses = append(readableStatuses, olapv2.V2ReadableStatusOlapQUEUED)
 		case olapv2.V2EventTypeOlapRETRIEDBYUSER:
 			readableStatuses = append(readableStatuses, olapv2.V2ReadableStatusOlapQUEUED)
+		case olapv2.V2EventTypeOlapREPLAYED:
+			readableStatuses = append(readableStatuses, olapv2.V2ReadableStatusOlapQUEUED)
+		case olapv2.V2EventTypeOlapRESETBYREPLAY:
+			readableStatuses = append(readableStatuses, olapv2.V2ReadableStatusOlapQUEUED)
 		case olapv2.V2EventTypeOlapCREATED:
 			readableStatuses = append(readableStatuses, olapv2.V2ReadableStatusOlapQUEUED)
 		case olapv2.V2EventTypeOlapQUEUED:
@@ -430,5 +461,55 @@ func (tc *OLAPControllerImpl) handleCreateMonitoringEvent(ctx context.Context, t
 		opts = append(opts, event)
 	}
 
-	return tc.repo.CreateTaskEvents(ctx, tenantId, opts)
+	if tc.webhooks != nil {
+		webhookEvents := make([]webhooks.Event, 0)
+
+		for i, event := range opts {
+			eventType, ok := webhooks.TaskEventType(event.EventType)
+
+			if !ok {
+				continue
+			}
+
+			taskMeta := taskIdsToMetas[event.TaskID]
+			taskExternalId := sqlchelpers.UUIDToStr(taskMeta.ExternalID)
+			workflowRunId := taskExternalId
+
+			if taskMeta.DagExternalID.Valid {
+				workflowRunId = sqlchelpers.UUIDToStr(taskMeta.DagExternalID)
+			}
+
+			webhookEvent := webhooks.Event{
+				Type:          eventType,
+				TenantId:      tenantId,
+				WorkflowId:    sqlchelpers.UUIDToStr(event.WorkflowID),
+				WorkflowRunId: workflowRunId,
+				TaskId:        &taskExternalId,
+				RetryCount:    &retryCounts[i],
+				Status:        string(event.ReadableStatus),
+				Timestamp:     event.EventTimestamp.Time,
+			}
+
+			if event.ErrorMessage.Valid {
+				webhookEvent.ErrorMessage = &event.ErrorMessage.String
+			}
+
+			webhookEvents = append(webhookEvents, webhookEvent)
+		}
+
+		// webhook deliveries are created before the task events are written, so that a failure in either
+		// returns an error and the message is retried. deliveries may be duplicated on a retry, which is
+		// allowed since webhook delivery is at-least-once.
+		if err := tc.webhooks.Publish(ctx, tenantId, webhookEvents); err != nil {
+			return fmt.Errorf("could not publish task events to webhooks: %w", err)
+		}
+	}
+
+	err = tc.repo.CreateTaskEvents(ctx, tenantId, opts)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
@@ -33,5 +33,5 @@ func (o *OLAPControllerImpl) updateDAGStatuses(ctx context.Context, tenantId str
 	ctx, span := telemetry.NewSpan(ctx, "update-dag-statuses")
 	defer span.End()
 
-	return o.repo.UpdateDAGStatuses(ctx, tenantId)
+	return o.repo.UpdateDAGStatuses(ctx, tenantId, o.publishFinishedWorkflowRuns(tenantId))
 }
@@ -33,5 +33,5 @@ func (o *OLAPControllerImpl) updateTaskStatuses(ctx context.Context, tenantId st
 	ctx, span := telemetry.NewSpan(ctx, "update-task-statuses")
 	defer span.End()
 
-	return o.repo.UpdateTaskStatuses(ctx, tenantId)
+	return o.repo.UpdateTaskStatuses(ctx, tenantId, o.publishFinishedWorkflowRuns(tenantId))
 }
@@ -0,0 +1,78 @@
+package olap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/webhooks"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (o *OLAPControllerImpl) runTenantWebhookDeliveries(ctx context.Context) func() {
+	return func() {
+		o.l.Debug().Msgf("partition: running webhook deliveries")
+
+		// list all tenants
+		tenants, err := o.p.ListTenantsForController(ctx)
+
+		if err != nil {
+			o.l.Error().Err(err).Msg("could not list tenants")
+			return
+		}
+
+		o.deliverWebhookOperations.SetTenants(tenants)
+
+		for i := range tenants {
+			tenantId := sqlchelpers.UUIDToStr(tenants[i].ID)
+
+			o.deliverWebhookOperations.RunOrContinue(tenantId)
+		}
+	}
+}
+
+func (o *OLAPControllerImpl) deliverWebhooks(ctx context.Context, tenantId string) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "deliver-webhooks")
+	defer span.End()
+
+	return o.webhooks.DeliverDue(ctx, tenantId)
+}
+
+// publishFinishedWorkflowRuns returns a handler which publishes an event to webhooks for each workflow run
+// which moved into a final status. The handler runs before the status updates are committed, so a failed
+// publish rolls them back and the runs are published again on the next update.
+func (o *OLAPControllerImpl) publishFinishedWorkflowRuns(tenantId string) repository.FinishedWorkflowRunsHandler {
+	if o.webhooks == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, runs []repository.FinishedWorkflowRun) error {
+		now := time.Now().UTC()
+		events := make([]webhooks.Event, 0, len(runs))
+
+		for _, run := range runs {
+			eventType, ok := webhooks.WorkflowRunEventType(run.Status)
+
+			if !ok {
+				continue
+			}
+
+			events = append(events, webhooks.Event{
+				Type:          eventType,
+				TenantId:      tenantId,
+				WorkflowId:    sqlchelpers.UUIDToStr(run.WorkflowId),
+				WorkflowRunId: sqlchelpers.UUIDToStr(run.ExternalId),
+				Status:        string(run.Status),
+				Timestamp:     now,
+			})
+		}
+
+		if err := o.webhooks.Publish(ctx, tenantId, events); err != nil {
+			return fmt.Errorf("could not publish workflow run events to webhooks: %w", err)
+		}
+
+		return nil
+	}
+}
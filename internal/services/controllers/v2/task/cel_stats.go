@@ -0,0 +1,125 @@
+package task
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+)
+
+// registerCELProgramCacheMetrics exports the cumulative CEL program cache stats as metrics, which are
+// collected whenever the meter provider reads them. The returned registration must be unregistered
+// when the controller shuts down.
+func registerCELProgramCacheMetrics(meter metric.Meter, stats func() cel.ProgramCacheStats) (metric.Registration, error) {
+	size, err := meter.Int64ObservableGauge(
+		"hatchet.cel.program_cache.size",
+		metric.WithDescription("The number of compiled CEL programs in the cache"),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	hits, err := meter.Int64ObservableCounter(
+		"hatchet.cel.program_cache.hits",
+		metric.WithDescription("The number of CEL programs which were found in the cache"),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	misses, err := meter.Int64ObservableCounter(
+		"hatchet.cel.program_cache.misses",
+		metric.WithDescription("The number of CEL programs which were compiled because they were not in the cache"),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	compileDuration, err := meter.Float64ObservableCounter(
+		"hatchet.cel.program_cache.compile_duration",
+		metric.WithDescription("The total time spent compiling CEL programs"),
+		metric.WithUnit("s"),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	evaluations, err := meter.Int64ObservableCounter(
+		"hatchet.cel.evaluations",
+		metric.WithDescription("The number of CEL program evaluations"),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	evaluationDuration, err := meter.Float64ObservableCounter(
+		"hatchet.cel.evaluation_duration",
+		metric.WithDescription("The total time spent evaluating CEL programs"),
+		metric.WithUnit("s"),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return meter.RegisterCallback(
+		func(ctx context.Context, o metric.Observer) error {
+			curr := stats()
+
+			o.ObserveInt64(size, int64(curr.Size))
+			o.ObserveInt64(hits, curr.Hits)
+			o.ObserveInt64(misses, curr.Misses)
+			o.ObserveFloat64(compileDuration, curr.CompileDuration.Seconds())
+			o.ObserveInt64(evaluations, curr.Evaluations)
+			o.ObserveFloat64(evaluationDuration, curr.EvaluationDuration.Seconds())
+
+			return nil
+		},
+		size, hits, misses, compileDuration, evaluations, evaluationDuration,
+	)
+}
+
+// runLogCELProgramCacheStats logs the hit rate and evaluation time of the CEL program cache over each
+// interval, which is useful for tuning the cache size at high event rates.
+func (tc *TasksControllerImpl) runLogCELProgramCacheStats(ctx context.Context) func() {
+	var prev cel.ProgramCacheStats
+
+	return func() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		curr := tc.repov2.CELProgramCacheStats()
+
+		interval := cel.ProgramCacheStats{
+			Size:               curr.Size,
+			Hits:               curr.Hits - prev.Hits,
+			Misses:             curr.Misses - prev.Misses,
+			CompileDuration:    curr.CompileDuration - prev.CompileDuration,
+			Evaluations:        curr.Evaluations - prev.Evaluations,
+			EvaluationDuration: curr.EvaluationDuration - prev.EvaluationDuration,
+		}
+
+		prev = curr
+
+		if interval.Hits+interval.Misses == 0 && interval.Evaluations == 0 {
+			return
+		}
+
+		tc.l.Debug().
+			Int("size", interval.Size).
+			Int64("hits", interval.Hits).
+			Int64("misses", interval.Misses).
+			Float64("hit_rate", interval.HitRate()).
+			Dur("compile_duration", interval.CompileDuration).
+			Int64("evaluations", interval.Evaluations).
+			Dur("avg_evaluation_duration", interval.AvgEvaluationDuration()).
+			Msg("cel program cache stats")
+	}
+}
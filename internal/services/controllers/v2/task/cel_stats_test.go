@@ -0,0 +1,72 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+)
+
+func TestRegisterCELProgramCacheMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	stats := cel.ProgramCacheStats{
+		Size:               3,
+		Hits:               10,
+		Misses:             2,
+		CompileDuration:    500 * time.Millisecond,
+		Evaluations:        12,
+		EvaluationDuration: 2 * time.Second,
+	}
+
+	reg, err := registerCELProgramCacheMetrics(provider.Meter("test"), func() cel.ProgramCacheStats {
+		return stats
+	})
+	require.NoError(t, err)
+
+	collect := func() map[string]float64 {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(context.Background(), &rm))
+
+		values := map[string]float64{}
+
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				switch data := m.Data.(type) {
+				case metricdata.Gauge[int64]:
+					values[m.Name] = float64(data.DataPoints[0].Value)
+				case metricdata.Sum[int64]:
+					values[m.Name] = float64(data.DataPoints[0].Value)
+				case metricdata.Sum[float64]:
+					values[m.Name] = data.DataPoints[0].Value
+				}
+			}
+		}
+
+		return values
+	}
+
+	assert.Equal(t, map[string]float64{
+		"hatchet.cel.program_cache.size":             3,
+		"hatchet.cel.program_cache.hits":             10,
+		"hatchet.cel.program_cache.misses":           2,
+		"hatchet.cel.program_cache.compile_duration": 0.5,
+		"hatchet.cel.evaluations":                    12,
+		"hatchet.cel.evaluation_duration":            2,
+	}, collect())
+
+	stats.Hits = 15
+
+	assert.Equal(t, float64(15), collect()["hatchet.cel.program_cache.hits"])
+
+	require.NoError(t, reg.Unregister())
+
+	assert.Empty(t, collect())
+}
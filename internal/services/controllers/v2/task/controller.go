@@ -21,6 +21,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/services/partition"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/recoveryutils"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/pkg/config/shared"
 	hatcheterrors "github.com/hatchet-dev/hatchet/pkg/errors"
 	"github.com/hatchet-dev/hatchet/pkg/logger"
@@ -50,6 +51,7 @@ type TasksControllerImpl struct {
 	celParser              *cel.CELParser
 	timeoutTaskOperations  *queueutils.OperationPool
 	reassignTaskOperations *queueutils.OperationPool
+	durableSleepOperations *queueutils.OperationPool
 }
 
 type TasksControllerOpt func(*TasksControllerOpts)
@@ -192,6 +194,7 @@ func New(fs ...TasksControllerOpt) (*TasksControllerImpl, error) {
 
 	t.timeoutTaskOperations = queueutils.NewOperationPool(opts.l, time.Second*5, "timeout step runs", t.processTaskTimeouts)
 	t.reassignTaskOperations = queueutils.NewOperationPool(opts.l, time.Second*5, "reassign step runs", t.processTaskReassignments)
+	t.durableSleepOperations = queueutils.NewOperationPool(opts.l, time.Second*5, "process durable sleeps", t.processDurableSleeps)
 
 	return t, nil
 }
@@ -239,6 +242,18 @@ func (tc *TasksControllerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule step run reassignment: %w", err)
 	}
 
+	_, err = tc.s.NewJob(
+		gocron.DurationJob(time.Second*1),
+		gocron.NewTask(
+			tc.runTenantProcessDurableSleeps(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule durable sleep processing: %w", err)
+	}
+
 	_, err = tc.s.NewJob(
 		gocron.DurationJob(time.Minute*15),
 		gocron.NewTask(
@@ -251,9 +266,44 @@ func (tc *TasksControllerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule task partition method: %w", err)
 	}
 
+	_, err = tc.s.NewJob(
+		gocron.DurationJob(time.Minute*1),
+		gocron.NewTask(
+			tc.runLogCELProgramCacheStats(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule cel program cache stats: %w", err)
+	}
+
+	celMetrics, err := registerCELProgramCacheMetrics(telemetry.NewMeter("github.com/hatchet-dev/hatchet/internal/services/controllers/v2/task"), tc.repov2.CELProgramCacheStats)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not register cel program cache metrics: %w", err)
+	}
+
+	_, err = tc.s.NewJob(
+		gocron.DurationJob(time.Minute*5),
+		gocron.NewTask(
+			tc.runTenantDeleteIdleRateLimits(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule idle rate limit deletion: %w", err)
+	}
+
 	cleanup := func() error {
 		cancel()
 
+		if err := celMetrics.Unregister(); err != nil {
+			return fmt.Errorf("could not unregister cel program cache metrics: %w", err)
+		}
+
 		if err := cleanupBuffer(); err != nil {
 			return err
 		}
@@ -294,21 +344,28 @@ func (tc *TasksControllerImpl) handleBufferedMsgs(tenantId, msgId string, payloa
 		return tc.handleProcessInternalEvents(context.Background(), tenantId, payloads)
 	case "task-trigger":
 		return tc.handleProcessTaskTrigger(context.Background(), tenantId, payloads)
+	case "task-replay":
+		return tc.handleTaskReplay(context.Background(), tenantId, payloads)
+	case "dag-replayed":
+		return tc.handleDAGReplayed(context.Background(), tenantId, payloads)
 	}
 
 	return fmt.Errorf("unknown message id: %s", msgId)
 }
 
 func (tc *TasksControllerImpl) handleTaskCompleted(ctx context.Context, tenantId string, payloads [][]byte) error {
-	opts := make([]v2.TaskIdRetryCount, 0)
+	opts := make([]v2.CompleteTaskOpts, 0)
 	idsToData := make(map[int64][]byte)
 
 	msgs := msgqueue.JSONConvert[tasktypes.CompletedTaskPayload](payloads)
 
 	for _, msg := range msgs {
-		opts = append(opts, v2.TaskIdRetryCount{
-			Id:         msg.TaskId,
-			RetryCount: msg.RetryCount,
+		opts = append(opts, v2.CompleteTaskOpts{
+			TaskIdRetryCount: &v2.TaskIdRetryCount{
+				Id:         msg.TaskId,
+				RetryCount: msg.RetryCount,
+			},
+			Output: msg.Output,
 		})
 
 		idsToData[msg.TaskId] = msg.Output
@@ -446,15 +503,18 @@ func (tc *TasksControllerImpl) handleTaskFailed(ctx context.Context, tenantId st
 }
 
 func (tc *TasksControllerImpl) handleTaskCancelled(ctx context.Context, tenantId string, payloads [][]byte) error {
-	opts := make([]v2.TaskIdRetryCount, 0)
+	opts := make([]v2.CancelTaskOpts, 0)
 
 	msgs := msgqueue.JSONConvert[tasktypes.CancelledTaskPayload](payloads)
 	shouldTasksNotify := make(map[int64]bool)
 
 	for _, msg := range msgs {
-		opts = append(opts, v2.TaskIdRetryCount{
-			Id:         msg.TaskId,
-			RetryCount: msg.RetryCount,
+		opts = append(opts, v2.CancelTaskOpts{
+			TaskIdRetryCount: &v2.TaskIdRetryCount{
+				Id:         msg.TaskId,
+				RetryCount: msg.RetryCount,
+			},
+			RemoveFromQueues: msg.RemoveFromQueues,
 		})
 
 		shouldTasksNotify[msg.TaskId] = msg.ShouldNotify
@@ -548,6 +608,144 @@ func (tc *TasksControllerImpl) handleTaskCancelled(ctx context.Context, tenantId
 	return err
 }
 
+func (tc *TasksControllerImpl) handleTaskReplay(ctx context.Context, tenantId string, payloads [][]byte) error {
+	msgs := msgqueue.JSONConvert[tasktypes.ReplayTaskPayload](payloads)
+	taskIds := make([]int64, 0, len(msgs))
+
+	for _, msg := range msgs {
+		taskIds = append(taskIds, msg.TaskId)
+	}
+
+	replayedTasks, err := tc.repov2.Tasks().ReplayTasks(ctx, tenantId, taskIds)
+
+	if err != nil {
+		return err
+	}
+
+	if len(replayedTasks) == 0 {
+		return nil
+	}
+
+	err = tc.notifySchedulerOfQueuedTasks(ctx, tenantId, replayedTasks)
+
+	if err != nil {
+		return fmt.Errorf("could not notify scheduler of replayed tasks: %w", err)
+	}
+
+	var outerErr error
+
+	for _, task := range replayedTasks {
+		olapMsg, err := tasktypes.MonitoringEventMessageFromInternal(
+			tenantId,
+			tasktypes.CreateMonitoringEventPayload{
+				TaskId:         task.ID,
+				RetryCount:     task.RetryCount,
+				EventType:      olapv2.V2EventTypeOlapRETRIEDBYUSER,
+				EventTimestamp: time.Now(),
+			},
+		)
+
+		if err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not create monitoring event message: %w", err))
+			continue
+		}
+
+		err = tc.pubBuffer.Pub(
+			ctx,
+			msgqueue.OLAP_QUEUE,
+			olapMsg,
+			false,
+		)
+
+		if err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not publish monitoring event message: %w", err))
+		}
+	}
+
+	return outerErr
+}
+
+// handleDAGReplayed notifies the scheduler of tasks which DAGs were replayed from, and writes the replay to
+// the OLAP repository. The DAG itself is replayed by the API, so that replay errors can be returned to the caller.
+func (tc *TasksControllerImpl) handleDAGReplayed(ctx context.Context, tenantId string, payloads [][]byte) error {
+	msgs := msgqueue.JSONConvert[tasktypes.ReplayedDAGPayload](payloads)
+	taskIds := make([]int64, 0, len(msgs))
+	monitoringEvents := make([]tasktypes.CreateMonitoringEventPayload, 0, len(msgs))
+
+	for _, msg := range msgs {
+		taskIds = append(taskIds, msg.TaskId)
+
+		monitoringEvents = append(monitoringEvents, tasktypes.CreateMonitoringEventPayload{
+			TaskId:         msg.TaskId,
+			RetryCount:     msg.RetryCount,
+			EventType:      olapv2.V2EventTypeOlapREPLAYED,
+			EventTimestamp: time.Now(),
+		})
+
+		for _, resetTask := range msg.ResetTasks {
+			monitoringEvents = append(monitoringEvents, tasktypes.CreateMonitoringEventPayload{
+				TaskId:         resetTask.TaskId,
+				RetryCount:     resetTask.RetryCount + 1,
+				EventType:      olapv2.V2EventTypeOlapRESETBYREPLAY,
+				EventTimestamp: time.Now(),
+			})
+		}
+	}
+
+	var outerErr error
+
+	for _, event := range monitoringEvents {
+		olapMsg, err := tasktypes.MonitoringEventMessageFromInternal(tenantId, event)
+
+		if err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not create monitoring event message: %w", err))
+			continue
+		}
+
+		err = tc.pubBuffer.Pub(
+			ctx,
+			msgqueue.OLAP_QUEUE,
+			olapMsg,
+			false,
+		)
+
+		if err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not publish monitoring event message: %w", err))
+		}
+	}
+
+	tasks, err := tc.repov2.Tasks().ListTasks(ctx, tenantId, taskIds)
+
+	if err != nil {
+		return multierror.Append(outerErr, fmt.Errorf("could not list replayed tasks: %w", err))
+	}
+
+	// only tasks which are still on the retry count of the replay are signaled
+	retryCounts := make(map[int64]int32, len(msgs))
+
+	for _, msg := range msgs {
+		retryCounts[msg.TaskId] = msg.RetryCount
+	}
+
+	replayedTasks := make([]*sqlcv2.V2Task, 0, len(tasks))
+
+	for _, task := range tasks {
+		if retryCount, ok := retryCounts[task.ID]; ok && retryCount == task.RetryCount {
+			replayedTasks = append(replayedTasks, task)
+		}
+	}
+
+	if len(replayedTasks) > 0 {
+		err = tc.signalTasksInitialStates(ctx, tenantId, replayedTasks)
+
+		if err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not signal replayed tasks: %w", err))
+		}
+	}
+
+	return outerErr
+}
+
 func (tc *TasksControllerImpl) sendTaskCancellationsToDispatcher(ctx context.Context, tenantId string, releasedTasks []tasktypes.SignalTaskCancelledPayload) error {
 	workerIds := make([]string, 0)
 
@@ -686,7 +884,45 @@ func (tc *TasksControllerImpl) handleProcessUserEventTrigger(ctx context.Context
 
 // handleProcessUserEventMatches is responsible for signaling or creating tasks based on user event matches.
 func (tc *TasksControllerImpl) handleProcessUserEventMatches(ctx context.Context, tenantId string, payloads []*tasktypes.UserEventTaskPayload) error {
-	// tc.l.Error().Msg("not implemented")
+	candidateMatches := make([]v2.CandidateEventMatch, 0, len(payloads))
+
+	for _, payload := range payloads {
+		eventTimestamp := payload.EventTimestamp
+
+		if eventTimestamp.IsZero() {
+			eventTimestamp = time.Now()
+		}
+
+		candidateMatches = append(candidateMatches, v2.CandidateEventMatch{
+			ID:             payload.EventId,
+			EventTimestamp: eventTimestamp,
+			Key:            payload.EventKey,
+			Data:           payload.EventData,
+		})
+	}
+
+	matchResult, err := tc.repov2.Matches().ProcessUserEventMatches(ctx, tenantId, candidateMatches)
+
+	if err != nil {
+		return fmt.Errorf("could not process user event matches: %w", err)
+	}
+
+	if len(matchResult.CreatedTasks) > 0 {
+		err = tc.signalTasksCreated(ctx, tenantId, matchResult.CreatedTasks)
+
+		if err != nil {
+			return fmt.Errorf("could not signal created tasks: %w", err)
+		}
+	}
+
+	if len(matchResult.ReplayedTasks) > 0 {
+		err = tc.signalTasksInitialStates(ctx, tenantId, matchResult.ReplayedTasks)
+
+		if err != nil {
+			return fmt.Errorf("could not signal replayed tasks: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -779,6 +1015,14 @@ func (tc *TasksControllerImpl) processInternalEvents(ctx context.Context, tenant
 		}
 	}
 
+	if len(matchResult.ReplayedTasks) > 0 {
+		err = tc.signalTasksInitialStates(ctx, tenantId, matchResult.ReplayedTasks)
+
+		if err != nil {
+			return fmt.Errorf("could not signal replayed tasks: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -811,24 +1055,7 @@ func (tc *TasksControllerImpl) signalDAGsCreated(ctx context.Context, tenantId s
 }
 
 func (tc *TasksControllerImpl) signalTasksCreated(ctx context.Context, tenantId string, tasks []*sqlcv2.V2Task) error {
-	// group tasks by initial states
-	queuedTasks := make([]*sqlcv2.V2Task, 0)
-	failedTasks := make([]*sqlcv2.V2Task, 0)
-	cancelledTasks := make([]*sqlcv2.V2Task, 0)
-	skippedTasks := make([]*sqlcv2.V2Task, 0)
-
 	for _, task := range tasks {
-		switch task.InitialState {
-		case sqlcv2.V2TaskInitialStateQUEUED:
-			queuedTasks = append(queuedTasks, task)
-		case sqlcv2.V2TaskInitialStateFAILED:
-			failedTasks = append(failedTasks, task)
-		case sqlcv2.V2TaskInitialStateCANCELLED:
-			cancelledTasks = append(cancelledTasks, task)
-		case sqlcv2.V2TaskInitialStateSKIPPED:
-			skippedTasks = append(skippedTasks, task)
-		}
-
 		msg, err := tasktypes.CreatedTaskMessage(tenantId, task)
 
 		if err != nil {
@@ -849,6 +1076,31 @@ func (tc *TasksControllerImpl) signalTasksCreated(ctx context.Context, tenantId
 		}
 	}
 
+	return tc.signalTasksInitialStates(ctx, tenantId, tasks)
+}
+
+// signalTasksInitialStates signals tasks based on their initial state. This is called for created tasks, and for
+// existing tasks which have been run again with a new retry count.
+func (tc *TasksControllerImpl) signalTasksInitialStates(ctx context.Context, tenantId string, tasks []*sqlcv2.V2Task) error {
+	// group tasks by initial states
+	queuedTasks := make([]*sqlcv2.V2Task, 0)
+	failedTasks := make([]*sqlcv2.V2Task, 0)
+	cancelledTasks := make([]*sqlcv2.V2Task, 0)
+	skippedTasks := make([]*sqlcv2.V2Task, 0)
+
+	for _, task := range tasks {
+		switch task.InitialState {
+		case sqlcv2.V2TaskInitialStateQUEUED:
+			queuedTasks = append(queuedTasks, task)
+		case sqlcv2.V2TaskInitialStateFAILED:
+			failedTasks = append(failedTasks, task)
+		case sqlcv2.V2TaskInitialStateCANCELLED:
+			cancelledTasks = append(cancelledTasks, task)
+		case sqlcv2.V2TaskInitialStateSKIPPED:
+			skippedTasks = append(skippedTasks, task)
+		}
+	}
+
 	eg := &errgroup.Group{}
 
 	if len(queuedTasks) > 0 {
@@ -902,14 +1154,7 @@ func (tc *TasksControllerImpl) signalTasksCreated(ctx context.Context, tenantId
 	return eg.Wait()
 }
 
-func (tc *TasksControllerImpl) signalTasksCreatedAndQueued(ctx context.Context, tenantId string, tasks []*sqlcv2.V2Task) error {
-	// get all unique queues and notify them
-	queues := make(map[string]struct{})
-
-	for _, task := range tasks {
-		queues[task.Queue] = struct{}{}
-	}
-
+func (tc *TasksControllerImpl) notifySchedulerOfQueuedTasks(ctx context.Context, tenantId string, tasks []*sqlcv2.V2Task) error {
 	tenant, err := tc.repo.Tenant().GetTenantByID(ctx, tenantId)
 
 	if err != nil {
@@ -934,6 +1179,16 @@ func (tc *TasksControllerImpl) signalTasksCreatedAndQueued(ctx context.Context,
 		}
 	}
 
+	return nil
+}
+
+func (tc *TasksControllerImpl) signalTasksCreatedAndQueued(ctx context.Context, tenantId string, tasks []*sqlcv2.V2Task) error {
+	err := tc.notifySchedulerOfQueuedTasks(ctx, tenantId, tasks)
+
+	if err != nil {
+		return err
+	}
+
 	// notify that tasks have been created
 	// TODO: make this transactionally safe?
 	for _, task := range tasks {
@@ -951,7 +1206,7 @@ func (tc *TasksControllerImpl) signalTasksCreatedAndQueued(ctx context.Context,
 			tenantId,
 			tasktypes.CreateMonitoringEventPayload{
 				TaskId:         task.ID,
-				RetryCount:     0,
+				RetryCount:     task.RetryCount,
 				EventType:      olapv2.V2EventTypeOlapQUEUED,
 				EventTimestamp: time.Now(),
 				EventMessage:   msg,
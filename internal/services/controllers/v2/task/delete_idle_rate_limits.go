@@ -0,0 +1,44 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+)
+
+// rateLimitIdleTimeout is the time after which a dynamic rate limit which hasn't been used is deleted.
+// Keys are provisioned again if a task which uses them is queued.
+const rateLimitIdleTimeout = 24 * time.Hour
+
+func (tc *TasksControllerImpl) runTenantDeleteIdleRateLimits(ctx context.Context) func() {
+	return func() {
+		tc.l.Debug().Msgf("partition: deleting idle dynamic rate limits")
+
+		ctx, span := telemetry.NewSpan(ctx, "delete-idle-rate-limits")
+		defer span.End()
+
+		// list all tenants
+		tenants, err := tc.p.ListTenantsForController(ctx)
+
+		if err != nil {
+			tc.l.Error().Err(err).Msg("could not list tenants")
+			return
+		}
+
+		idleSince := time.Now().Add(-rateLimitIdleTimeout)
+
+		for i := range tenants {
+			deleted, err := tc.repov2.Scheduler().RateLimit().DeleteIdleDynamicRateLimits(ctx, tenants[i].ID, idleSince)
+
+			if err != nil {
+				tc.l.Error().Err(err).Msg("could not delete idle dynamic rate limits")
+				continue
+			}
+
+			if deleted > 0 {
+				tc.l.Debug().Int64("deleted", deleted).Msg("deleted idle dynamic rate limits")
+			}
+		}
+	}
+}
@@ -38,6 +38,10 @@ type DispatcherClient interface {
 	RefreshTimeout(ctx context.Context, in *RefreshTimeoutRequest, opts ...grpc.CallOption) (*RefreshTimeoutResponse, error)
 	ReleaseSlot(ctx context.Context, in *ReleaseSlotRequest, opts ...grpc.CallOption) (*ReleaseSlotResponse, error)
 	UpsertWorkerLabels(ctx context.Context, in *UpsertWorkerLabelsRequest, opts ...grpc.CallOption) (*UpsertWorkerLabelsResponse, error)
+	// RegisterDurableEvent releases the slot held by a running step run and registers a set of conditions,
+	// the first of which to be satisfied completes the durable event
+	RegisterDurableEvent(ctx context.Context, in *RegisterDurableEventRequest, opts ...grpc.CallOption) (*RegisterDurableEventResponse, error)
+	ListenForDurableEvent(ctx context.Context, opts ...grpc.CallOption) (Dispatcher_ListenForDurableEventClient, error)
 }
 
 type dispatcherClient struct {
@@ -256,6 +260,46 @@ func (c *dispatcherClient) UpsertWorkerLabels(ctx context.Context, in *UpsertWor
 	return out, nil
 }
 
+func (c *dispatcherClient) RegisterDurableEvent(ctx context.Context, in *RegisterDurableEventRequest, opts ...grpc.CallOption) (*RegisterDurableEventResponse, error) {
+	out := new(RegisterDurableEventResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/RegisterDurableEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) ListenForDurableEvent(ctx context.Context, opts ...grpc.CallOption) (Dispatcher_ListenForDurableEventClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Dispatcher_ServiceDesc.Streams[4], "/Dispatcher/ListenForDurableEvent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dispatcherListenForDurableEventClient{stream}
+	return x, nil
+}
+
+type Dispatcher_ListenForDurableEventClient interface {
+	Send(*ListenForDurableEventRequest) error
+	Recv() (*DurableEvent, error)
+	grpc.ClientStream
+}
+
+type dispatcherListenForDurableEventClient struct {
+	grpc.ClientStream
+}
+
+func (x *dispatcherListenForDurableEventClient) Send(m *ListenForDurableEventRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *dispatcherListenForDurableEventClient) Recv() (*DurableEvent, error) {
+	m := new(DurableEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DispatcherServer is the server API for Dispatcher service.
 // All implementations must embed UnimplementedDispatcherServer
 // for forward compatibility
@@ -276,6 +320,10 @@ type DispatcherServer interface {
 	RefreshTimeout(context.Context, *RefreshTimeoutRequest) (*RefreshTimeoutResponse, error)
 	ReleaseSlot(context.Context, *ReleaseSlotRequest) (*ReleaseSlotResponse, error)
 	UpsertWorkerLabels(context.Context, *UpsertWorkerLabelsRequest) (*UpsertWorkerLabelsResponse, error)
+	// RegisterDurableEvent releases the slot held by a running step run and registers a set of conditions,
+	// the first of which to be satisfied completes the durable event
+	RegisterDurableEvent(context.Context, *RegisterDurableEventRequest) (*RegisterDurableEventResponse, error)
+	ListenForDurableEvent(Dispatcher_ListenForDurableEventServer) error
 	mustEmbedUnimplementedDispatcherServer()
 }
 
@@ -322,6 +370,12 @@ func (UnimplementedDispatcherServer) ReleaseSlot(context.Context, *ReleaseSlotRe
 func (UnimplementedDispatcherServer) UpsertWorkerLabels(context.Context, *UpsertWorkerLabelsRequest) (*UpsertWorkerLabelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertWorkerLabels not implemented")
 }
+func (UnimplementedDispatcherServer) RegisterDurableEvent(context.Context, *RegisterDurableEventRequest) (*RegisterDurableEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDurableEvent not implemented")
+}
+func (UnimplementedDispatcherServer) ListenForDurableEvent(Dispatcher_ListenForDurableEventServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListenForDurableEvent not implemented")
+}
 func (UnimplementedDispatcherServer) mustEmbedUnimplementedDispatcherServer() {}
 
 // UnsafeDispatcherServer may be embedded to opt out of forward compatibility for this service.
@@ -586,6 +640,50 @@ func _Dispatcher_UpsertWorkerLabels_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dispatcher_RegisterDurableEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDurableEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).RegisterDurableEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/RegisterDurableEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).RegisterDurableEvent(ctx, req.(*RegisterDurableEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_ListenForDurableEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DispatcherServer).ListenForDurableEvent(&dispatcherListenForDurableEventServer{stream})
+}
+
+type Dispatcher_ListenForDurableEventServer interface {
+	Send(*DurableEvent) error
+	Recv() (*ListenForDurableEventRequest, error)
+	grpc.ServerStream
+}
+
+type dispatcherListenForDurableEventServer struct {
+	grpc.ServerStream
+}
+
+func (x *dispatcherListenForDurableEventServer) Send(m *DurableEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *dispatcherListenForDurableEventServer) Recv() (*ListenForDurableEventRequest, error) {
+	m := new(ListenForDurableEventRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Dispatcher_ServiceDesc is the grpc.ServiceDesc for Dispatcher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -629,6 +727,10 @@ var Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertWorkerLabels",
 			Handler:    _Dispatcher_UpsertWorkerLabels_Handler,
 		},
+		{
+			MethodName: "RegisterDurableEvent",
+			Handler:    _Dispatcher_RegisterDurableEvent_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -652,6 +754,12 @@ var Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ListenForDurableEvent",
+			Handler:       _Dispatcher_ListenForDurableEvent_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "dispatcher.proto",
 }
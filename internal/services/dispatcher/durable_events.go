@@ -0,0 +1,296 @@
+package dispatcher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+func (s *DispatcherImpl) RegisterDurableEvent(ctx context.Context, req *contracts.RegisterDurableEventRequest) (*contracts.RegisterDurableEventResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	taskId, retryCount, err := parseTaskStepRunId(req.StepRunId)
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	opts := v2.RegisterDurableEventOpts{
+		TaskId:     taskId,
+		RetryCount: retryCount,
+		SignalKey:  req.SignalKey,
+	}
+
+	for _, condition := range req.Conditions.GetSleepConditions() {
+		opts.SleepConditions = append(opts.SleepConditions, v2.DurableSleepCondition{
+			SleepFor: condition.SleepFor,
+		})
+	}
+
+	for _, condition := range req.Conditions.GetUserEventConditions() {
+		opts.UserEventConditions = append(opts.UserEventConditions, v2.DurableUserEventCondition{
+			EventKey:   condition.EventKey,
+			Expression: condition.GetExpression(),
+		})
+	}
+
+	if apiErrors, err := s.v.ValidateAPI(opts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", apiErrors.String())
+	}
+
+	if len(opts.SleepConditions) == 0 && len(opts.UserEventConditions) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one sleep or user event condition is required")
+	}
+
+	err = s.v2repo.Matches().RegisterDurableEvent(ctx, tenantId, opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not register durable event: %w", err)
+	}
+
+	return &contracts.RegisterDurableEventResponse{}, nil
+}
+
+// map of durable event keys to the durable events which a client is waiting on
+type durableEventAcks struct {
+	acks map[string]*durableEventListener
+	mu   sync.RWMutex
+}
+
+type durableEventListener struct {
+	taskId    int64
+	eventKey  string
+	stepRunId string
+	signalKey string
+}
+
+func (w *durableEventAcks) addListener(l *durableEventListener) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.acks[getTaskEventKey(l.taskId, l.eventKey)] = l
+}
+
+func (w *durableEventAcks) getListeners() []*durableEventListener {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	res := make([]*durableEventListener, 0, len(w.acks))
+
+	for _, l := range w.acks {
+		res = append(res, l)
+	}
+
+	return res
+}
+
+func (w *durableEventAcks) ackListener(key string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.acks, key)
+}
+
+func (s *DispatcherImpl) ListenForDurableEvent(server contracts.Dispatcher_ListenForDurableEventServer) error {
+	tenant := server.Context().Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	acks := &durableEventAcks{
+		acks: make(map[string]*durableEventListener),
+	}
+
+	ctx, cancel := context.WithCancel(server.Context())
+	defer cancel()
+
+	sendMu := sync.Mutex{}
+
+	iter := func(listeners []*durableEventListener) error {
+		if len(listeners) == 0 {
+			return nil
+		}
+
+		tuples := make([]v2.TaskIdEventKeyTuple, 0, len(listeners))
+		keysToListeners := make(map[string]*durableEventListener, len(listeners))
+
+		for _, l := range listeners {
+			tuples = append(tuples, v2.TaskIdEventKeyTuple{
+				Id:       l.taskId,
+				EventKey: l.eventKey,
+			})
+
+			keysToListeners[getTaskEventKey(l.taskId, l.eventKey)] = l
+		}
+
+		signalEvents, err := s.v2repo.Tasks().ListCompletedTaskSignals(ctx, tenantId, tuples)
+
+		if err != nil {
+			s.l.Error().Err(err).Msg("could not get completed durable events")
+			return err
+		}
+
+		for _, event := range signalEvents {
+			key := getTaskEventKey(event.TaskID, event.EventKey.String)
+
+			l, ok := keysToListeners[key]
+
+			if !ok {
+				continue
+			}
+
+			data, err := durableEventData(event)
+
+			if err != nil {
+				s.l.Error().Err(err).Msgf("could not parse data for durable event %s", key)
+				continue
+			}
+
+			sendMu.Lock()
+			err = server.Send(&contracts.DurableEvent{
+				StepRunId: l.stepRunId,
+				SignalKey: l.signalKey,
+				Data:      data,
+			})
+			sendMu.Unlock()
+
+			if err != nil {
+				s.l.Error().Err(err).Msgf("could not send durable event for step run %s", l.stepRunId)
+				return err
+			}
+
+			acks.ackListener(key)
+		}
+
+		return nil
+	}
+
+	immediateSendFilter := &sendTimeFilter{}
+	iterSendFilter := &sendTimeFilter{}
+
+	// start a new goroutine to handle client-side streaming
+	go func() {
+		for {
+			req, err := server.Recv()
+
+			if err != nil {
+				cancel()
+				if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+					return
+				}
+
+				s.l.Error().Err(err).Msg("could not receive message from client")
+				return
+			}
+
+			taskId, retryCount, err := parseTaskStepRunId(req.StepRunId)
+
+			if err != nil {
+				s.l.Warn().Err(err).Msg("invalid step run id for durable event")
+				continue
+			}
+
+			l := &durableEventListener{
+				taskId:    taskId,
+				eventKey:  v2.GetDurableSignalEventKey(taskId, retryCount, req.SignalKey),
+				stepRunId: req.StepRunId,
+				signalKey: req.SignalKey,
+			}
+
+			acks.addListener(l)
+
+			if immediateSendFilter.canSend() {
+				if err := iter([]*durableEventListener{l}); err != nil {
+					s.l.Error().Err(err).Msg("could not iterate over durable events")
+				}
+			}
+		}
+	}()
+
+	// new goroutine to poll every second for durable events which have completed
+	go func() {
+		ticker := time.NewTicker(1 * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if !iterSendFilter.canSend() {
+					continue
+				}
+
+				if err := iter(acks.getListeners()); err != nil {
+					s.l.Error().Err(err).Msg("could not iterate over durable events")
+				}
+			}
+		}
+	}()
+
+	<-ctx.Done()
+
+	return nil
+}
+
+// durableEventData returns the payloads of the satisfied conditions for a completed signal, keyed by
+// the event key of each condition.
+func durableEventData(event *sqlcv2.V2TaskEvent) ([]byte, error) {
+	parsed := make(map[string]map[string][]json.RawMessage)
+
+	if err := json.Unmarshal(event.Data, &parsed); err != nil {
+		return nil, err
+	}
+
+	data := parsed[string(sqlcv2.V2MatchConditionActionCREATE)]
+
+	if data == nil {
+		data = make(map[string][]json.RawMessage)
+	}
+
+	return json.Marshal(data)
+}
+
+// parseTaskStepRunId parses a step run id of the form id-<taskId>-<retryCount>, which is the id
+// that workers receive for v2 tasks.
+func parseTaskStepRunId(stepRunId string) (int64, int32, error) {
+	if !strings.HasPrefix(stepRunId, "id-") {
+		return 0, 0, fmt.Errorf("invalid task id %s", stepRunId)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(stepRunId, "id-"), "-")
+
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid task id %s", stepRunId)
+	}
+
+	taskId, err := strconv.ParseInt(parts[0], 10, 64)
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not parse task id: %w", err)
+	}
+
+	retryCount, err := strconv.ParseInt(parts[1], 10, 32)
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not parse retry count: %w", err)
+	}
+
+	return taskId, int32(retryCount), nil
+}
@@ -666,6 +666,25 @@ func (s *DispatcherImpl) ReleaseSlot(ctx context.Context, req *contracts.Release
 		return nil, fmt.Errorf("step run id is required")
 	}
 
+	if strings.HasPrefix(req.StepRunId, "id-") {
+		taskId, retryCount, err := parseTaskStepRunId(req.StepRunId)
+
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		_, err = s.v2repo.Tasks().ReleaseSlot(ctx, tenantId, v2.TaskIdRetryCount{
+			Id:         taskId,
+			RetryCount: retryCount,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &contracts.ReleaseSlotResponse{}, nil
+	}
+
 	err := s.repo.StepRun().ReleaseStepRunSemaphore(ctx, tenantId, req.StepRunId, true)
 
 	if err != nil {
@@ -1209,38 +1228,21 @@ func waitFor(wg *sync.WaitGroup, timeout time.Duration, l *zerolog.Logger) {
 
 func (s *DispatcherImpl) SendStepActionEvent(ctx context.Context, request *contracts.StepActionEvent) (*contracts.ActionEventResponse, error) {
 	if strings.HasPrefix(request.StepRunId, "id-") {
-		taskIdRetryCountStr := strings.TrimPrefix(request.StepRunId, "id-")
-
-		parts := strings.Split(taskIdRetryCountStr, "-")
-
-		if len(parts) != 2 {
-			return nil, fmt.Errorf("invalid task id %s", request.StepRunId)
-		}
-
-		taskIdStr := parts[0]
-		retryCountStr := parts[1]
-
-		taskId, err := strconv.ParseInt(taskIdStr, 10, 64)
+		taskId, retryCount, err := parseTaskStepRunId(request.StepRunId)
 
 		if err != nil {
-			return nil, fmt.Errorf("could not parse task id: %w", err)
-		}
-
-		retryCount, err := strconv.ParseInt(retryCountStr, 10, 64)
-
-		if err != nil {
-			return nil, fmt.Errorf("could not parse retry count: %w", err)
+			return nil, err
 		}
 
 		switch request.EventType {
 		case contracts.StepActionEventType_STEP_EVENT_TYPE_STARTED:
-			return s.handleTaskStarted(ctx, taskId, int32(retryCount), request)
+			return s.handleTaskStarted(ctx, taskId, retryCount, request)
 		case contracts.StepActionEventType_STEP_EVENT_TYPE_ACKNOWLEDGED:
 			panic("unimplemented")
 		case contracts.StepActionEventType_STEP_EVENT_TYPE_COMPLETED:
-			return s.handleTaskCompleted(ctx, taskId, int32(retryCount), request)
+			return s.handleTaskCompleted(ctx, taskId, retryCount, request)
 		case contracts.StepActionEventType_STEP_EVENT_TYPE_FAILED:
-			return s.handleTaskFailed(ctx, taskId, int32(retryCount), request)
+			return s.handleTaskFailed(ctx, taskId, retryCount, request)
 		}
 	}
 
@@ -5,12 +5,44 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/hatchet-dev/hatchet/pkg/auth/token"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 )
 
+// methodScopes maps gRPC methods to the scope which a token needs in order to call them. Methods which
+// aren't listed require the admin scope.
+var methodScopes = map[string]token.Scope{
+	"/Dispatcher/Register":                  token.ScopeWorkersRegister,
+	"/Dispatcher/Listen":                    token.ScopeWorkersRegister,
+	"/Dispatcher/ListenV2":                  token.ScopeWorkersRegister,
+	"/Dispatcher/Heartbeat":                 token.ScopeWorkersRegister,
+	"/Dispatcher/SendStepActionEvent":       token.ScopeWorkersRegister,
+	"/Dispatcher/SendGroupKeyActionEvent":   token.ScopeWorkersRegister,
+	"/Dispatcher/PutOverridesData":          token.ScopeWorkersRegister,
+	"/Dispatcher/Unsubscribe":               token.ScopeWorkersRegister,
+	"/Dispatcher/RefreshTimeout":            token.ScopeWorkersRegister,
+	"/Dispatcher/ReleaseSlot":               token.ScopeWorkersRegister,
+	"/Dispatcher/UpsertWorkerLabels":        token.ScopeWorkersRegister,
+	"/Dispatcher/RegisterDurableEvent":      token.ScopeWorkersRegister,
+	"/Dispatcher/ListenForDurableEvent":     token.ScopeWorkersRegister,
+	"/Dispatcher/SubscribeToWorkflowEvents": token.ScopeRunsRead,
+	"/Dispatcher/SubscribeToWorkflowRuns":   token.ScopeRunsRead,
+	"/EventsService/Push":                   token.ScopeEventsPush,
+	"/EventsService/BulkPush":               token.ScopeEventsPush,
+	"/EventsService/PutLog":                 token.ScopeWorkersRegister,
+	"/EventsService/PutStreamEvent":         token.ScopeWorkersRegister,
+	"/WorkflowService/TriggerWorkflow":      token.ScopeWorkflowsTrigger,
+	"/WorkflowService/BulkTriggerWorkflow":  token.ScopeWorkflowsTrigger,
+	"/WorkflowService/ScheduleWorkflow":     token.ScopeWorkflowsTrigger,
+	"/WorkflowService/CancelTasks":          token.ScopeRunsCancel,
+	"/WorkflowService/PutWorkflow":          token.ScopeWorkersRegister,
+	"/WorkflowService/PutRateLimit":         token.ScopeWorkersRegister,
+}
+
 type GRPCAuthN struct {
 	config *server.ServerConfig
 
@@ -26,14 +58,14 @@ func NewAuthN(config *server.ServerConfig) *GRPCAuthN {
 
 func (a *GRPCAuthN) Middleware(ctx context.Context) (context.Context, error) {
 	forbidden := status.Errorf(codes.Unauthenticated, "invalid auth token")
-	token, err := auth.AuthFromMD(ctx, "bearer")
+	bearer, err := auth.AuthFromMD(ctx, "bearer")
 
 	if err != nil {
 		a.l.Debug().Err(err).Msgf("error getting bearer token from request: %s", err)
 		return nil, forbidden
 	}
 
-	tenantId, tokenUUID, err := a.config.Auth.JWTManager.ValidateTenantToken(ctx, token)
+	validated, err := a.config.Auth.JWTManager.ValidateTenantToken(ctx, bearer)
 
 	if err != nil {
 		a.l.Debug().Err(err).Msgf("error validating tenant token: %s", err)
@@ -41,10 +73,18 @@ func (a *GRPCAuthN) Middleware(ctx context.Context) (context.Context, error) {
 		return nil, forbidden
 	}
 
-	ctx = context.WithValue(ctx, "rate_limit_token", tokenUUID)
+	if method, ok := grpc.Method(ctx); ok && !token.HasScope(validated.Scopes, requiredScope(method)) {
+		a.l.Debug().Msgf("token does not have the scope required to call %s", method)
+
+		return nil, status.Errorf(codes.PermissionDenied, "token is not permitted to call %s", method)
+	}
+
+	ctx = context.WithValue(ctx, "rate_limit_token", validated.TokenId)
+	ctx = context.WithValue(ctx, "api_token_id", validated.TokenId)
+	ctx = context.WithValue(ctx, "api_token_name", validated.Name)
 
 	// get the tenant id
-	queriedTenant, err := a.config.EngineRepository.Tenant().GetTenantByID(ctx, tenantId)
+	queriedTenant, err := a.config.EngineRepository.Tenant().GetTenantByID(ctx, validated.TenantId)
 
 	if err != nil {
 		a.l.Debug().Err(err).Msgf("error getting tenant by id: %s", err)
@@ -54,3 +94,11 @@ func (a *GRPCAuthN) Middleware(ctx context.Context) (context.Context, error) {
 	return context.WithValue(ctx, "tenant", queriedTenant), nil
 
 }
+
+func requiredScope(method string) token.Scope {
+	if scope, ok := methodScopes[method]; ok {
+		return scope
+	}
+
+	return token.ScopeAdmin
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/auth/token"
+)
+
+func TestRequiredScope(t *testing.T) {
+	// a worker token must be able to register its workflows and rate limits on startup
+	assert.Equal(t, token.ScopeWorkersRegister, requiredScope("/WorkflowService/PutWorkflow"))
+	assert.Equal(t, token.ScopeWorkersRegister, requiredScope("/WorkflowService/PutRateLimit"))
+	assert.Equal(t, token.ScopeWorkersRegister, requiredScope("/Dispatcher/Listen"))
+	assert.Equal(t, token.ScopeWorkflowsTrigger, requiredScope("/WorkflowService/TriggerWorkflow"))
+
+	// methods which aren't listed require the admin scope
+	assert.Equal(t, token.ScopeAdmin, requiredScope("/WorkflowService/ReplayTasks"))
+	assert.Equal(t, token.ScopeAdmin, requiredScope("/EventsService/ReplaySingleEvent"))
+}
+
+func TestWorkerTokenScopes(t *testing.T) {
+	workerScopes := []string{string(token.ScopeWorkersRegister)}
+
+	for _, method := range []string{
+		"/Dispatcher/Register",
+		"/Dispatcher/Heartbeat",
+		"/EventsService/PutLog",
+		"/WorkflowService/PutWorkflow",
+		"/WorkflowService/PutRateLimit",
+	} {
+		assert.True(t, token.HasScope(workerScopes, requiredScope(method)), method)
+	}
+
+	assert.False(t, token.HasScope(workerScopes, requiredScope("/WorkflowService/TriggerWorkflow")))
+}
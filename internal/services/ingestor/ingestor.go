@@ -3,6 +3,7 @@ package ingestor
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	lru "github.com/hashicorp/golang-lru/v2"
@@ -231,6 +232,7 @@ func eventToTask(tenantId, eventId, key string, data, additionalMeta []byte) (*m
 		EventKey:                key,
 		EventData:               data,
 		EventAdditionalMetadata: additionalMeta,
+		EventTimestamp:          time.Now().UTC(),
 	}
 
 	return msgqueue.NewTenantMessage(
@@ -0,0 +1,480 @@
+// Package taskactions resolves v2 task and workflow runs to their tasks, and cancels or replays those
+// tasks in bulk. It is shared by the REST and gRPC APIs.
+package taskactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/olapv2"
+)
+
+const (
+	// MaxExternalIds is the maximum number of external ids which can be passed to a single bulk action
+	MaxExternalIds = 1000
+
+	// MaxFilterResults is the maximum number of runs which a filter can resolve to in a single bulk action
+	MaxFilterResults = 10000
+
+	pageSize = 1000
+
+	// the number of tasks in a single message to the tasks controller
+	msgBatchSize = 100
+)
+
+var ErrTooManyResults = fmt.Errorf("filter matches more than %d runs, narrow the filter", MaxFilterResults)
+
+// Task is a task which a bulk action can be applied to
+type Task struct {
+	ID         int64
+	ExternalID string
+	WorkflowID string
+	Status     olapv2.V2ReadableStatusOlap
+}
+
+// WorkflowRun is a workflow run and its tasks. A workflow run of kind TASK has a single task, with the same
+// external id as the workflow run.
+type WorkflowRun struct {
+	ExternalID string
+	WorkflowID string
+	Kind       olapv2.V2RunKind
+	Status     olapv2.V2ReadableStatusOlap
+	Tasks      []*Task
+}
+
+type BulkActions struct {
+	olap   repository.OLAPEventRepository
+	repov2 v2.Repository
+	mq     msgqueue.MessageQueue
+}
+
+func NewBulkActions(olap repository.OLAPEventRepository, repov2 v2.Repository, mq msgqueue.MessageQueue) *BulkActions {
+	return &BulkActions{
+		olap:   olap,
+		repov2: repov2,
+		mq:     mq,
+	}
+}
+
+// TasksByExternalIds reads tasks by their external ids. Tasks which don't exist or belong to a different
+// tenant are skipped.
+func (b *BulkActions) TasksByExternalIds(ctx context.Context, tenantId string, externalIds []string) ([]*Task, error) {
+	rows, err := b.olap.ListTasksByExternalIds(ctx, tenantId, externalIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list tasks: %w", err)
+	}
+
+	return tasksFromRows(rows), nil
+}
+
+// TasksByFilter lists the tasks which match a filter. Limit, Offset and Cursor are ignored.
+func (b *BulkActions) TasksByFilter(ctx context.Context, tenantId string, opts repository.ListTaskRunOpts) ([]*Task, error) {
+	opts.Limit = pageSize
+	opts.Offset = 0
+	opts.Cursor = nil
+
+	res := make([]*Task, 0)
+
+	for {
+		rows, _, err := b.olap.ListTasks(ctx, tenantId, opts)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list tasks: %w", err)
+		}
+
+		for _, row := range rows {
+			res = append(res, &Task{
+				ID:         row.ID,
+				ExternalID: sqlchelpers.UUIDToStr(row.ExternalID),
+				WorkflowID: sqlchelpers.UUIDToStr(row.WorkflowID),
+				Status:     row.Status,
+			})
+		}
+
+		if len(res) > MaxFilterResults {
+			return nil, ErrTooManyResults
+		}
+
+		if len(rows) < pageSize {
+			return res, nil
+		}
+
+		last := rows[len(rows)-1]
+
+		opts.Cursor = &repository.ListCursor{
+			InsertedAt: last.InsertedAt.Time,
+			ID:         last.ID,
+		}
+	}
+}
+
+// WorkflowRunsByExternalIds reads workflow runs and their tasks by the external ids of the workflow runs.
+// Workflow runs which don't exist or belong to a different tenant are skipped.
+func (b *BulkActions) WorkflowRunsByExternalIds(ctx context.Context, tenantId string, externalIds []string) ([]*WorkflowRun, error) {
+	rows, err := b.olap.ListWorkflowRunsByExternalIds(ctx, tenantId, externalIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list workflow runs: %w", err)
+	}
+
+	return b.populateWorkflowRuns(ctx, tenantId, rows)
+}
+
+// WorkflowRunsByFilter lists the workflow runs which match a filter, along with their tasks. Limit, Offset
+// and Cursor are ignored.
+func (b *BulkActions) WorkflowRunsByFilter(ctx context.Context, tenantId string, opts repository.ListWorkflowRunOpts) ([]*WorkflowRun, error) {
+	opts.Limit = pageSize
+	opts.Offset = 0
+	opts.Cursor = nil
+
+	res := make([]*WorkflowRun, 0)
+
+	for {
+		rows, _, err := b.olap.ListWorkflowRuns(ctx, tenantId, opts)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list workflow runs: %w", err)
+		}
+
+		if len(res)+len(rows) > MaxFilterResults {
+			return nil, ErrTooManyResults
+		}
+
+		workflowRuns, err := b.populateWorkflowRuns(ctx, tenantId, rows)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, workflowRuns...)
+
+		if len(rows) < pageSize {
+			return res, nil
+		}
+
+		last := rows[len(rows)-1]
+
+		opts.Cursor = &repository.ListCursor{
+			InsertedAt: last.InsertedAt.Time,
+			ID:         last.ID,
+		}
+	}
+}
+
+func (b *BulkActions) populateWorkflowRuns(ctx context.Context, tenantId string, rows []*repository.WorkflowRunData) ([]*WorkflowRun, error) {
+	res := make([]*WorkflowRun, 0, len(rows))
+	byExternalId := make(map[string]*WorkflowRun, len(rows))
+
+	dagIds := make([]pgtype.UUID, 0)
+	taskMetadata := make([]repository.TaskMetadata, 0)
+
+	for _, row := range rows {
+		workflowRun := &WorkflowRun{
+			ExternalID: sqlchelpers.UUIDToStr(row.ExternalID),
+			WorkflowID: sqlchelpers.UUIDToStr(row.WorkflowID),
+			Kind:       row.Kind,
+			Status:     row.ReadableStatus,
+			Tasks:      make([]*Task, 0),
+		}
+
+		res = append(res, workflowRun)
+		byExternalId[workflowRun.ExternalID] = workflowRun
+
+		switch row.Kind {
+		case olapv2.V2RunKindDAG:
+			dagIds = append(dagIds, row.ExternalID)
+		case olapv2.V2RunKindTASK:
+			taskMetadata = append(taskMetadata, repository.TaskMetadata{
+				TaskID:         row.ID,
+				TaskInsertedAt: row.InsertedAt.Time,
+			})
+		}
+	}
+
+	if len(dagIds) > 0 {
+		tasks, taskIdToDagExternalId, err := b.olap.ListTasksByDAGId(ctx, tenantId, dagIds)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list tasks for workflow runs: %w", err)
+		}
+
+		for _, task := range tasksFromRows(tasks) {
+			dagExternalId, ok := taskIdToDagExternalId[task.ID]
+
+			if !ok {
+				continue
+			}
+
+			if workflowRun, ok := byExternalId[dagExternalId.String()]; ok {
+				workflowRun.Tasks = append(workflowRun.Tasks, task)
+			}
+		}
+	}
+
+	if len(taskMetadata) > 0 {
+		tasks, err := b.olap.ListTasksByIdAndInsertedAt(ctx, tenantId, taskMetadata)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list tasks for workflow runs: %w", err)
+		}
+
+		for _, task := range tasksFromRows(tasks) {
+			if workflowRun, ok := byExternalId[task.ExternalID]; ok {
+				workflowRun.Tasks = append(workflowRun.Tasks, task)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// CancelTasks cancels the tasks which are queued or running, and returns the tasks which were cancelled.
+// Cancellations are processed asynchronously by the tasks controller.
+func (b *BulkActions) CancelTasks(ctx context.Context, tenantId string, tasks []*Task) ([]*Task, error) {
+	toCancel := make([]*Task, 0, len(tasks))
+	taskIds := make([]int64, 0, len(tasks))
+
+	for _, task := range tasks {
+		switch task.Status {
+		case olapv2.V2ReadableStatusOlapQUEUED, olapv2.V2ReadableStatusOlapRUNNING:
+			toCancel = append(toCancel, task)
+			taskIds = append(taskIds, task.ID)
+		}
+	}
+
+	if len(toCancel) == 0 {
+		return toCancel, nil
+	}
+
+	// the OLAP repository may lag behind, so we read the current retry count of each task from the
+	// core tables
+	coreTasks, err := b.repov2.Tasks().ListTasks(ctx, tenantId, taskIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list tasks: %w", err)
+	}
+
+	retryCounts := make(map[int64]int32, len(coreTasks))
+
+	for _, task := range coreTasks {
+		retryCounts[task.ID] = task.RetryCount
+	}
+
+	cancelled := make([]*Task, 0, len(toCancel))
+	payloads := make([]tasktypes.CancelledTaskPayload, 0, len(toCancel))
+
+	for _, task := range toCancel {
+		retryCount, ok := retryCounts[task.ID]
+
+		if !ok {
+			continue
+		}
+
+		cancelled = append(cancelled, task)
+		payloads = append(payloads, tasktypes.CancelledTaskPayload{
+			TaskId:       task.ID,
+			RetryCount:   retryCount,
+			EventType:    olapv2.V2EventTypeOlapCANCELLED,
+			ShouldNotify: true,
+			// queued tasks are removed from the queues straight away, rather than waiting for the
+			// scheduler to pick them up
+			RemoveFromQueues: true,
+		})
+	}
+
+	err = sendBatched(ctx, b.mq, tenantId, "task-cancelled", payloads)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cancelled, nil
+}
+
+// ReplayTasks replays the tasks which have finished, and returns the tasks which were replayed. Replays are
+// processed asynchronously by the tasks controller.
+func (b *BulkActions) ReplayTasks(ctx context.Context, tenantId string, tasks []*Task) ([]*Task, error) {
+	replayed := make([]*Task, 0, len(tasks))
+	payloads := make([]tasktypes.ReplayTaskPayload, 0, len(tasks))
+
+	for _, task := range tasks {
+		switch task.Status {
+		case olapv2.V2ReadableStatusOlapCOMPLETED, olapv2.V2ReadableStatusOlapFAILED, olapv2.V2ReadableStatusOlapCANCELLED:
+			replayed = append(replayed, task)
+			payloads = append(payloads, tasktypes.ReplayTaskPayload{
+				TaskId: task.ID,
+			})
+		}
+	}
+
+	err := sendBatched(ctx, b.mq, tenantId, "task-replay", payloads)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return replayed, nil
+}
+
+// SkippedWorkflowRun is a workflow run which a bulk action couldn't be applied to
+type SkippedWorkflowRun struct {
+	ExternalID string
+	Reason     string
+}
+
+// ReplayDAGs replays each DAG from its failed or cancelled tasks which aren't downstream of another failed or
+// cancelled task, reusing the outputs of the tasks which completed. It returns the tasks which were replayed
+// from, along with the DAGs which couldn't be replayed. Workflow runs which aren't DAGs are ignored.
+func (b *BulkActions) ReplayDAGs(ctx context.Context, tenantId string, workflowRuns []*WorkflowRun) ([]*Task, []*SkippedWorkflowRun, error) {
+	replayed := make([]*Task, 0)
+	skipped := make([]*SkippedWorkflowRun, 0)
+	payloads := make([]tasktypes.ReplayedDAGPayload, 0)
+
+	var replayErr error
+
+	for _, workflowRun := range workflowRuns {
+		if replayErr != nil {
+			break
+		}
+
+		if workflowRun.Kind != olapv2.V2RunKindDAG {
+			continue
+		}
+
+		candidates := make([]*Task, 0)
+
+		for _, task := range workflowRun.Tasks {
+			switch task.Status {
+			case olapv2.V2ReadableStatusOlapFAILED, olapv2.V2ReadableStatusOlapCANCELLED:
+				candidates = append(candidates, task)
+			}
+		}
+
+		if len(candidates) == 0 {
+			skipped = append(skipped, &SkippedWorkflowRun{
+				ExternalID: workflowRun.ExternalID,
+				Reason:     "the workflow run has no failed or cancelled tasks",
+			})
+
+			continue
+		}
+
+		// tasks are created after their parents, so visiting tasks in order of their ids replays from the
+		// root-most tasks first. tasks downstream of a replayed task are reset by that replay, so they're
+		// skipped.
+		sort.Slice(candidates, func(i, j int) bool {
+			return candidates[i].ID < candidates[j].ID
+		})
+
+		covered := make(map[int64]bool)
+		var lastErr error
+		numReplayed := 0
+
+		for _, task := range candidates {
+			if covered[task.ID] {
+				continue
+			}
+
+			res, err := b.repov2.Tasks().ReplayDAGFromTask(ctx, tenantId, task.ID)
+
+			if errors.Is(err, v2.ErrInvalidDAGReplay) {
+				lastErr = err
+				continue
+			} else if err != nil {
+				replayErr = fmt.Errorf("could not replay workflow run %s: %w", workflowRun.ExternalID, err)
+				break
+			}
+
+			payload := tasktypes.ReplayedDAGPayload{
+				TaskId:     res.ReplayedTask.ID,
+				RetryCount: res.ReplayedTask.RetryCount,
+				ResetTasks: make([]tasktypes.ResetTaskPayload, 0, len(res.ResetTasks)),
+			}
+
+			covered[res.ReplayedTask.ID] = true
+
+			for _, resetTask := range res.ResetTasks {
+				covered[resetTask.ID] = true
+
+				payload.ResetTasks = append(payload.ResetTasks, tasktypes.ResetTaskPayload{
+					TaskId:     resetTask.ID,
+					RetryCount: resetTask.RetryCount,
+				})
+			}
+
+			payloads = append(payloads, payload)
+			replayed = append(replayed, task)
+			numReplayed++
+		}
+
+		if numReplayed == 0 && lastErr != nil {
+			skipped = append(skipped, &SkippedWorkflowRun{
+				ExternalID: workflowRun.ExternalID,
+				Reason:     lastErr.Error(),
+			})
+		}
+	}
+
+	// replays are committed as they're made, so the DAGs which were replayed before an error are still sent
+	// to the tasks controller
+	err := sendBatched(ctx, b.mq, tenantId, "dag-replayed", payloads)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if replayErr != nil {
+		return nil, nil, replayErr
+	}
+
+	return replayed, skipped, nil
+}
+
+func sendBatched[T any](ctx context.Context, mq msgqueue.MessageQueue, tenantId, msgId string, payloads []T) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	for start := 0; start < len(payloads); start += msgBatchSize {
+		end := min(start+msgBatchSize, len(payloads))
+
+		msg, err := msgqueue.NewTenantMessage(tenantId, msgId, false, true, payloads[start:end]...)
+
+		if err != nil {
+			return fmt.Errorf("could not create %s message: %w", msgId, err)
+		}
+
+		err = mq.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, msg)
+
+		if err != nil {
+			return fmt.Errorf("could not send %s message: %w", msgId, err)
+		}
+	}
+
+	return nil
+}
+
+func tasksFromRows(rows []*olapv2.PopulateTaskRunDataRow) []*Task {
+	res := make([]*Task, 0, len(rows))
+
+	for _, row := range rows {
+		res = append(res, &Task{
+			ID:         row.ID,
+			ExternalID: sqlchelpers.UUIDToStr(row.ExternalID),
+			WorkflowID: sqlchelpers.UUIDToStr(row.WorkflowID),
+			Status:     row.Status,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,303 @@
+package taskactions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/olapv2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+type testMessageQueue struct {
+	msgqueue.MessageQueue
+
+	msgs []*msgqueue.Message
+}
+
+func (q *testMessageQueue) SendMessage(ctx context.Context, queue msgqueue.Queue, msg *msgqueue.Message) error {
+	q.msgs = append(q.msgs, msg)
+	return nil
+}
+
+type testRepository struct {
+	v2.Repository
+
+	tasks *testTaskRepository
+}
+
+func (r *testRepository) Tasks() v2.TaskRepository {
+	return r.tasks
+}
+
+type testTaskRepository struct {
+	v2.TaskRepository
+
+	retryCounts map[int64]int32
+
+	// the tasks which are reset when a DAG is replayed from a task. DAGs can't be replayed from tasks which
+	// are missing.
+	resetTasks map[int64][]int64
+
+	replayedFrom []int64
+}
+
+func (r *testTaskRepository) ReplayDAGFromTask(ctx context.Context, tenantId string, taskId int64) (*v2.ReplayDAGFromTaskResult, error) {
+	resetTaskIds, ok := r.resetTasks[taskId]
+
+	if !ok {
+		return nil, fmt.Errorf("%w: a parent of the task has not completed", v2.ErrInvalidDAGReplay)
+	}
+
+	r.replayedFrom = append(r.replayedFrom, taskId)
+
+	res := &v2.ReplayDAGFromTaskResult{
+		ReplayedTask: &sqlcv2.V2Task{ID: taskId, RetryCount: 1},
+	}
+
+	for _, resetTaskId := range resetTaskIds {
+		res.ResetTasks = append(res.ResetTasks, &sqlcv2.ListDAGTasksForReplayRow{ID: resetTaskId})
+	}
+
+	return res, nil
+}
+
+func (r *testTaskRepository) ListTasks(ctx context.Context, tenantId string, taskIds []int64) ([]*sqlcv2.V2Task, error) {
+	res := make([]*sqlcv2.V2Task, 0, len(taskIds))
+
+	for _, taskId := range taskIds {
+		if retryCount, ok := r.retryCounts[taskId]; ok {
+			res = append(res, &sqlcv2.V2Task{ID: taskId, RetryCount: retryCount})
+		}
+	}
+
+	return res, nil
+}
+
+func newTestTask(tenantId string, id int64) *sqlcv2.V2Task {
+	return &sqlcv2.V2Task{
+		ID:                 id,
+		InsertedAt:         sqlchelpers.TimestamptzFromTime(time.Now().UTC()),
+		TenantID:           sqlchelpers.UUIDFromStr(tenantId),
+		WorkflowID:         sqlchelpers.UUIDFromStr(uuid.NewString()),
+		StepID:             sqlchelpers.UUIDFromStr(uuid.NewString()),
+		ExternalID:         sqlchelpers.UUIDFromStr(uuid.NewString()),
+		Queue:              "default",
+		ActionID:           "test:step",
+		Sticky:             sqlcv2.V2StickyStrategyNONE,
+		DisplayName:        "step",
+		Input:              []byte(`{}`),
+		AdditionalMetadata: []byte(`{}`),
+	}
+}
+
+func TestTasksByExternalIds(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+	olap := repository.NewMemoryOLAPEventRepository(&l)
+	b := NewBulkActions(olap, nil, nil)
+
+	tenantId := uuid.NewString()
+	otherTenantId := uuid.NewString()
+
+	task := newTestTask(tenantId, 1)
+	other := newTestTask(otherTenantId, 2)
+
+	require.NoError(t, olap.CreateTasks(ctx, tenantId, []*sqlcv2.V2Task{task}))
+	require.NoError(t, olap.CreateTasks(ctx, otherTenantId, []*sqlcv2.V2Task{other}))
+
+	tasks, err := b.TasksByExternalIds(ctx, tenantId, []string{
+		sqlchelpers.UUIDToStr(task.ExternalID),
+		sqlchelpers.UUIDToStr(other.ExternalID),
+		uuid.NewString(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*Task{
+		{
+			ID:         task.ID,
+			ExternalID: sqlchelpers.UUIDToStr(task.ExternalID),
+			WorkflowID: sqlchelpers.UUIDToStr(task.WorkflowID),
+			Status:     olapv2.V2ReadableStatusOlapQUEUED,
+		},
+	}, tasks)
+}
+
+func TestWorkflowRunsByExternalIds(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+	olap := repository.NewMemoryOLAPEventRepository(&l)
+	b := NewBulkActions(olap, nil, nil)
+
+	tenantId := uuid.NewString()
+
+	dag := &v2.DAGWithData{
+		V2Dag: &sqlcv2.V2Dag{
+			ID:         1,
+			InsertedAt: sqlchelpers.TimestamptzFromTime(time.Now().UTC()),
+			TenantID:   sqlchelpers.UUIDFromStr(tenantId),
+			ExternalID: sqlchelpers.UUIDFromStr(uuid.NewString()),
+			WorkflowID: sqlchelpers.UUIDFromStr(uuid.NewString()),
+		},
+	}
+
+	first := newTestTask(tenantId, 1)
+	first.DagID = pgtype.Int8{Int64: dag.ID, Valid: true}
+	first.DagInsertedAt = dag.InsertedAt
+
+	second := newTestTask(tenantId, 2)
+	second.DagID = pgtype.Int8{Int64: dag.ID, Valid: true}
+	second.DagInsertedAt = dag.InsertedAt
+
+	standalone := newTestTask(tenantId, 3)
+
+	require.NoError(t, olap.CreateDAGs(ctx, tenantId, []*v2.DAGWithData{dag}))
+	require.NoError(t, olap.CreateTasks(ctx, tenantId, []*sqlcv2.V2Task{first, second, standalone}))
+
+	runs, err := b.WorkflowRunsByExternalIds(ctx, tenantId, []string{
+		sqlchelpers.UUIDToStr(dag.ExternalID),
+		sqlchelpers.UUIDToStr(standalone.ExternalID),
+		uuid.NewString(),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, runs, 2)
+
+	assert.Equal(t, sqlchelpers.UUIDToStr(dag.ExternalID), runs[0].ExternalID)
+	assert.Equal(t, olapv2.V2RunKindDAG, runs[0].Kind)
+	require.Len(t, runs[0].Tasks, 2)
+	assert.ElementsMatch(t, []int64{first.ID, second.ID}, []int64{runs[0].Tasks[0].ID, runs[0].Tasks[1].ID})
+
+	assert.Equal(t, sqlchelpers.UUIDToStr(standalone.ExternalID), runs[1].ExternalID)
+	assert.Equal(t, olapv2.V2RunKindTASK, runs[1].Kind)
+	require.Len(t, runs[1].Tasks, 1)
+	assert.Equal(t, standalone.ID, runs[1].Tasks[0].ID)
+}
+
+func TestCancelTasks(t *testing.T) {
+	ctx := context.Background()
+	mq := &testMessageQueue{}
+	repo := &testRepository{
+		tasks: &testTaskRepository{
+			retryCounts: map[int64]int32{1: 2, 2: 0},
+		},
+	}
+
+	b := NewBulkActions(nil, repo, mq)
+
+	cancelled, err := b.CancelTasks(ctx, uuid.NewString(), []*Task{
+		{ID: 1, Status: olapv2.V2ReadableStatusOlapQUEUED},
+		{ID: 2, Status: olapv2.V2ReadableStatusOlapCOMPLETED},
+		// the task is missing from the core tables, so it's skipped
+		{ID: 3, Status: olapv2.V2ReadableStatusOlapRUNNING},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, cancelled, 1)
+	assert.Equal(t, int64(1), cancelled[0].ID)
+
+	require.Len(t, mq.msgs, 1)
+	assert.Equal(t, "task-cancelled", mq.msgs[0].ID)
+
+	payloads := msgqueue.JSONConvert[tasktypes.CancelledTaskPayload](mq.msgs[0].Payloads)
+	require.Len(t, payloads, 1)
+
+	// tasks cancelled in bulk are removed from the queues straight away
+	assert.Equal(t, tasktypes.CancelledTaskPayload{
+		TaskId:           1,
+		RetryCount:       2,
+		EventType:        olapv2.V2EventTypeOlapCANCELLED,
+		ShouldNotify:     true,
+		RemoveFromQueues: true,
+	}, *payloads[0])
+}
+
+func TestReplayDAGs(t *testing.T) {
+	ctx := context.Background()
+	mq := &testMessageQueue{}
+	tasks := &testTaskRepository{
+		resetTasks: map[int64][]int64{
+			// replaying from task 2 resets its child, task 3
+			2: {3},
+			4: {},
+		},
+	}
+
+	b := NewBulkActions(nil, &testRepository{tasks: tasks}, mq)
+
+	failed := &WorkflowRun{
+		ExternalID: uuid.NewString(),
+		Kind:       olapv2.V2RunKindDAG,
+		Tasks: []*Task{
+			{ID: 3, Status: olapv2.V2ReadableStatusOlapCANCELLED},
+			{ID: 1, Status: olapv2.V2ReadableStatusOlapCOMPLETED},
+			{ID: 2, Status: olapv2.V2ReadableStatusOlapFAILED},
+			{ID: 4, Status: olapv2.V2ReadableStatusOlapCANCELLED},
+		},
+	}
+
+	completed := &WorkflowRun{
+		ExternalID: uuid.NewString(),
+		Kind:       olapv2.V2RunKindDAG,
+		Tasks: []*Task{
+			{ID: 5, Status: olapv2.V2ReadableStatusOlapCOMPLETED},
+		},
+	}
+
+	invalid := &WorkflowRun{
+		ExternalID: uuid.NewString(),
+		Kind:       olapv2.V2RunKindDAG,
+		Tasks: []*Task{
+			{ID: 6, Status: olapv2.V2ReadableStatusOlapFAILED},
+		},
+	}
+
+	// single task workflow runs are replayed by ReplayTasks
+	single := &WorkflowRun{
+		ExternalID: uuid.NewString(),
+		Kind:       olapv2.V2RunKindTASK,
+		Tasks: []*Task{
+			{ID: 7, Status: olapv2.V2ReadableStatusOlapFAILED},
+		},
+	}
+
+	replayed, skipped, err := b.ReplayDAGs(ctx, uuid.NewString(), []*WorkflowRun{failed, completed, invalid, single})
+	require.NoError(t, err)
+
+	// the DAG is replayed from the root-most failed and cancelled tasks, and tasks which are reset by an
+	// earlier replay aren't replayed from
+	assert.Equal(t, []int64{2, 4}, tasks.replayedFrom)
+	require.Len(t, replayed, 2)
+	assert.Equal(t, int64(2), replayed[0].ID)
+	assert.Equal(t, int64(4), replayed[1].ID)
+
+	require.Len(t, skipped, 2)
+	assert.Equal(t, completed.ExternalID, skipped[0].ExternalID)
+	assert.Equal(t, "the workflow run has no failed or cancelled tasks", skipped[0].Reason)
+	assert.Equal(t, invalid.ExternalID, skipped[1].ExternalID)
+	assert.Contains(t, skipped[1].Reason, "a parent of the task has not completed")
+
+	require.Len(t, mq.msgs, 1)
+	assert.Equal(t, "dag-replayed", mq.msgs[0].ID)
+
+	payloads := msgqueue.JSONConvert[tasktypes.ReplayedDAGPayload](mq.msgs[0].Payloads)
+	require.Len(t, payloads, 2)
+
+	assert.Equal(t, tasktypes.ReplayedDAGPayload{
+		TaskId:     2,
+		RetryCount: 1,
+		ResetTasks: []tasktypes.ResetTaskPayload{{TaskId: 3}},
+	}, *payloads[0])
+}
@@ -11,6 +11,9 @@ type UserEventTaskPayload struct {
 	EventKey                string `json:"event_key" validate:"required"`
 	EventData               []byte `json:"event_data" validate:"required"`
 	EventAdditionalMetadata []byte `json:"event_additional_metadata"`
+
+	// the time at which the event was ingested, which may be unset for events ingested by older versions
+	EventTimestamp time.Time `json:"event_timestamp,omitempty"`
 }
 
 type InternalEventTaskPayload struct {
@@ -112,6 +112,9 @@ type CancelledTaskPayload struct {
 
 	// (optional) whether the task should notify the worker
 	ShouldNotify bool
+
+	// (optional) whether the task should be removed from the queues if it hasn't been assigned yet
+	RemoveFromQueues bool
 }
 
 func CancelledTaskMessage(tenantId string, taskId int64, retryCount int32, eventType olapv2.V2EventTypeOlap, shouldNotify bool) (*msgqueue.Message, error) {
@@ -129,6 +132,52 @@ func CancelledTaskMessage(tenantId string, taskId int64, retryCount int32, event
 	)
 }
 
+type ReplayTaskPayload struct {
+	// (required) the task id
+	TaskId int64 `validate:"required"`
+}
+
+func ReplayTaskMessage(tenantId string, taskId int64) (*msgqueue.Message, error) {
+	return msgqueue.NewTenantMessage(
+		tenantId,
+		"task-replay",
+		false,
+		true,
+		ReplayTaskPayload{
+			TaskId: taskId,
+		},
+	)
+}
+
+type ReplayedDAGPayload struct {
+	// (required) the id of the task which the DAG was replayed from
+	TaskId int64 `validate:"required"`
+
+	// (required) the new retry count of the replayed task
+	RetryCount int32
+
+	// (optional) the downstream tasks which were reset, with their retry counts before the replay
+	ResetTasks []ResetTaskPayload
+}
+
+type ResetTaskPayload struct {
+	// (required) the task id
+	TaskId int64 `validate:"required"`
+
+	// (required) the retry count of the task before it was reset
+	RetryCount int32
+}
+
+func ReplayedDAGMessage(tenantId string, payload ReplayedDAGPayload) (*msgqueue.Message, error) {
+	return msgqueue.NewTenantMessage(
+		tenantId,
+		"dag-replayed",
+		false,
+		true,
+		payload,
+	)
+}
+
 type SignalTaskCancelledPayload struct {
 	// (required) the worker id
 	WorkerId string `validate:"required,uuid"`
@@ -1,190 +1,166 @@
 package ticker
 
-// import (
-// 	"context"
-// 	"encoding/json"
-// 	"fmt"
-// 	"time"
-
-// 	"github.com/go-co-op/gocron/v2"
-
-// 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
-// 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
-// 	"github.com/hatchet-dev/hatchet/pkg/repository"
-// 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
-// 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
-// )
+import (
+	"context"
+	"fmt"
+	"time"
 
-// func (t *TickerImpl) runPollCronSchedules(ctx context.Context) func() {
-// 	return func() {
-// 		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-// 		defer cancel()
-
-// 		t.l.Debug().Msgf("ticker: polling cron schedules")
-
-// 		crons, err := t.repo.Ticker().PollCronSchedules(ctx, t.tickerId)
-
-// 		if err != nil {
-// 			t.l.Err(err).Msg("could not poll cron schedules")
-// 			return
-// 		}
-
-// 		existingCrons := make(map[string]bool)
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/taskactions"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/olapv2"
+)
 
-// 		t.crons.Range(func(key, value interface{}) bool {
-// 			existingCrons[key.(string)] = false
-// 			return true
-// 		})
-
-// 		for _, cron := range crons {
-// 			cronKey := getCronKey(cron)
+// a cron's last run which can't be read yet is treated as running for this long after it was triggered
+const pendingCronRunTimeout = time.Minute
 
-// 			t.l.Debug().Msgf("ticker: handling cron %s", cronKey)
-
-// 			// if the cron is already scheduled, mark it as existing
-// 			if _, ok := existingCrons[cronKey]; ok {
-// 				existingCrons[cronKey] = true
-// 				continue
-// 			}
-
-// 			// if the cron is not scheduled, schedule it
-// 			if err := t.handleScheduleCron(ctx, cron); err != nil {
-// 				t.l.Err(err).Msg("could not schedule cron")
-// 			}
-
-// 			existingCrons[cronKey] = true
-// 		}
+func (t *TickerImpl) runTenantCronSchedules(ctx context.Context) func() {
+	return func() {
+		t.l.Debug().Msgf("ticker: polling cron schedules")
 
-// 		// cancel any crons that are no longer assigned to this ticker
-// 		for key, exists := range existingCrons {
-// 			if !exists {
-// 				if err := t.handleCancelCron(ctx, key); err != nil {
-// 					t.l.Err(err).Msg("could not cancel cron")
-// 				}
-// 			}
-// 		}
-// 	}
-// }
+		// list all tenants in this ticker's controller partition, so that each tenant's crons are only
+		// polled by a single ticker
+		tenants, err := t.p.ListTenantsForController(ctx)
 
-// func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *dbsqlc.PollCronSchedulesRow) error {
-// 	t.l.Debug().Msg("ticker: scheduling cron")
+		if err != nil {
+			t.l.Err(err).Msg("could not list tenants")
+			return
+		}
 
-// 	// create a new scheduler
-// 	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
+		t.cronOperations.SetTenants(tenants)
 
-// 	if err != nil {
-// 		return fmt.Errorf("could not create scheduler: %w", err)
-// 	}
+		for i := range tenants {
+			tenantId := sqlchelpers.UUIDToStr(tenants[i].ID)
 
-// 	tenantId := sqlchelpers.UUIDToStr(cron.TenantId)
-// 	workflowVersionId := sqlchelpers.UUIDToStr(cron.WorkflowVersionId)
-// 	cronParentId := sqlchelpers.UUIDToStr(cron.ParentId)
+			t.cronOperations.RunOrContinue(tenantId)
+		}
+	}
+}
 
-// 	var additionalMetadata map[string]interface{}
+func (t *TickerImpl) processCronSchedules(ctx context.Context, tenantId string) (bool, error) {
+	running, lastRuns, err := t.getRunningCrons(ctx, tenantId)
 
-// 	if cron.AdditionalMetadata != nil {
-// 		if err := json.Unmarshal(cron.AdditionalMetadata, &additionalMetadata); err != nil {
-// 			return fmt.Errorf("could not unmarshal additional metadata: %w", err)
-// 		}
-// 	}
-
-// 	// schedule the cron
-// 	_, err = s.NewJob(
-// 		gocron.CronJob(cron.Cron, false),
-// 		gocron.NewTask(
-// 			t.runCronWorkflow(tenantId, workflowVersionId, cron.Cron, cronParentId, &cron.Name.String, cron.Input, additionalMetadata),
-// 		),
-// 	)
-
-// 	if err != nil {
-// 		return fmt.Errorf("could not schedule cron: %w", err)
-// 	}
-
-// 	// store the schedule in the cron map
-// 	t.crons.Store(getCronKey(cron), s)
+	if err != nil {
+		return false, fmt.Errorf("could not check cron last runs for tenant %s: %w", tenantId, err)
+	}
+
+	shouldContinue, err := t.repov2.Ticker().ProcessCronSchedules(ctx, tenantId, running, func(ctx context.Context, res *v2.ProcessCronSchedulesResult) error {
+		if len(res.CancelRunExternalIds) > 0 {
+			toCancel := make([]*taskactions.Task, 0)
+
+			for _, externalId := range res.CancelRunExternalIds {
+				if run, ok := lastRuns[externalId]; ok {
+					toCancel = append(toCancel, run.Tasks...)
+				}
+			}
+
+			_, err := t.bulk.CancelTasks(ctx, tenantId, toCancel)
+
+			if err != nil {
+				return fmt.Errorf("could not cancel previous cron runs: %w", err)
+			}
+		}
 
-// 	s.Start()
+		err := t.triggerWorkflowFires(ctx, tenantId, res.Fires)
+
+		if err != nil {
+			return fmt.Errorf("could not trigger cron workflows: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not process cron schedules for tenant %s: %w", tenantId, err)
+	}
+
+	return shouldContinue, nil
+}
+
+// getRunningCrons returns the ids of the crons whose last run is still queued or running, along with the
+// last runs keyed by their external id.
+func (t *TickerImpl) getRunningCrons(ctx context.Context, tenantId string) (map[string]bool, map[string]*taskactions.WorkflowRun, error) {
+	lastRuns, err := t.repov2.Ticker().ListCronLastRuns(ctx, tenantId)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	running := make(map[string]bool, len(lastRuns))
+	runsByExternalId := make(map[string]*taskactions.WorkflowRun, len(lastRuns))
+
+	if len(lastRuns) == 0 {
+		return running, runsByExternalId, nil
+	}
+
+	externalIds := make([]string, 0, len(lastRuns))
+
+	for _, lastRun := range lastRuns {
+		externalIds = append(externalIds, lastRun.ExternalId)
+	}
+
+	runs, err := t.bulk.WorkflowRunsByExternalIds(ctx, tenantId, externalIds)
 
-// 	return nil
-// }
+	if err != nil {
+		return nil, nil, err
+	}
 
-// func (t *TickerImpl) runCronWorkflow(tenantId, workflowVersionId, cron, cronParentId string, cronName *string, input []byte, additionalMetadata map[string]interface{}) func() {
-// 	return func() {
-// 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-// 		defer cancel()
+	for _, run := range runs {
+		runsByExternalId[run.ExternalID] = run
+	}
 
-// 		t.l.Debug().Msgf("ticker: running workflow %s", workflowVersionId)
+	for _, lastRun := range lastRuns {
+		run, ok := runsByExternalId[lastRun.ExternalId]
 
-// 		workflowVersion, err := t.repo.Workflow().GetWorkflowVersionById(ctx, tenantId, workflowVersionId)
+		if !ok {
+			// runs are written asynchronously, so a run which was triggered recently may not be readable yet
+			running[lastRun.CronId] = time.Since(lastRun.FiredAt) < pendingCronRunTimeout
+			continue
+		}
 
-// 		if err != nil {
-// 			t.l.Err(err).Msg("could not get workflow version")
-// 			return
-// 		}
-// 		// create a new workflow run in the database
-// 		createOpts, err := repository.GetCreateWorkflowRunOptsFromCron(cron, cronParentId, cronName, workflowVersion, input, additionalMetadata)
+		// the status of the workflow run is used rather than the statuses of its tasks, since a DAG which is
+		// between steps has no queued or running tasks
+		running[lastRun.CronId] = run.Status == olapv2.V2ReadableStatusOlapQUEUED || run.Status == olapv2.V2ReadableStatusOlapRUNNING
+	}
 
-// 		if err != nil {
-// 			t.l.Err(err).Msg("could not get create workflow run opts")
-// 			return
-// 		}
+	return running, runsByExternalId, nil
+}
 
-// 		workflowRun, err := t.repo.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, createOpts)
+// triggerWorkflowFires sends a trigger message for each fire to the task controller, which triggers the
+// workflow by name.
+func (t *TickerImpl) triggerWorkflowFires(ctx context.Context, tenantId string, fires []*v2.WorkflowFire) error {
+	for _, fire := range fires {
+		input := fire.Input
 
-// 		if err != nil {
-// 			t.l.Err(err).Msg("could not create workflow run")
-// 			return
-// 		}
+		if len(input) == 0 {
+			input = []byte("{}")
+		}
 
-// 		workflowRunId := sqlchelpers.UUIDToStr(workflowRun.ID)
+		msg, err := tasktypes.TriggerTaskMessage(
+			tenantId,
+			fire.ExternalId,
+			fire.WorkflowName,
+			input,
+			fire.AdditionalMetadata,
+			nil,
+			nil,
+			nil,
+		)
 
-// 		err = t.mq.SendMessage(
-// 			context.Background(),
-// 			msgqueue.WORKFLOW_PROCESSING_QUEUE,
-// 			tasktypes.WorkflowRunQueuedToTask(tenantId, workflowRunId),
-// 		)
-
-// 		if err != nil {
-// 			t.l.Err(err).Msg("could not add workflow run queued task")
-// 			return
-// 		}
-
-// 	}
-// }
+		if err != nil {
+			return fmt.Errorf("could not create trigger message for workflow %s: %w", fire.WorkflowName, err)
+		}
 
-// func (t *TickerImpl) handleCancelCron(ctx context.Context, key string) error {
-// 	t.l.Debug().Msg("ticker: canceling cron")
+		err = t.mq.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, msg)
 
-// 	// get the scheduler
-// 	schedulerVal, ok := t.crons.Load(key)
+		if err != nil {
+			return fmt.Errorf("could not send trigger message for workflow %s: %w", fire.WorkflowName, err)
+		}
 
-// 	if !ok {
-// 		return fmt.Errorf("could not find cron with key %s ", key)
-// 	}
+		t.l.Debug().Msgf("ticker: triggered workflow %s scheduled for %s", fire.WorkflowName, fire.FireAt)
+	}
 
-// 	defer t.crons.Delete(key)
-
-// 	scheduler, ok := schedulerVal.(gocron.Scheduler)
-
-// 	if !ok {
-// 		return fmt.Errorf("could not cast scheduler")
-// 	}
-
-// 	// cancel the cron
-// 	if err := scheduler.Shutdown(); err != nil {
-// 		return fmt.Errorf("could not cancel cron: %w", err)
-// 	}
-
-// 	return nil
-// }
-
-// func getCronKey(cron *dbsqlc.PollCronSchedulesRow) string {
-// 	workflowVersionId := sqlchelpers.UUIDToStr(cron.WorkflowVersionId)
-
-// 	switch cron.Method {
-// 	case dbsqlc.WorkflowTriggerCronRefMethodsAPI:
-// 		return fmt.Sprintf("API-%s-%s-%s", workflowVersionId, cron.Cron, cron.Name.String)
-// 	default:
-// 		return fmt.Sprintf("DEFAULT-%s-%s", workflowVersionId, cron.Cron)
-// 	}
-// }
+	return nil
+}
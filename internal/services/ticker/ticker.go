@@ -3,7 +3,6 @@ package ticker
 import (
 	"context"
 	"fmt"
-	"sync"
 	"time"
 
 	"github.com/go-co-op/gocron/v2"
@@ -13,9 +12,12 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/integrations/alerting"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/queueutils"
 	"github.com/hatchet-dev/hatchet/internal/services/partition"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/taskactions"
 	"github.com/hatchet-dev/hatchet/pkg/logger"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
 )
 
 type Ticker interface {
@@ -28,18 +30,20 @@ type TickerImpl struct {
 
 	entitlements repository.EntitlementsRepository
 
-	repo repository.EngineRepository
-	s    gocron.Scheduler
-	ta   *alerting.TenantAlertManager
-
-	crons              sync.Map
-	scheduledWorkflows sync.Map
+	repo   repository.EngineRepository
+	repov2 v2.Repository
+	s      gocron.Scheduler
+	ta     *alerting.TenantAlertManager
+	bulk   *taskactions.BulkActions
 
 	dv datautils.DataDecoderValidator
 
 	tickerId string
 
 	p *partition.Partition
+
+	cronOperations              *queueutils.OperationPool
+	scheduledWorkflowOperations *queueutils.OperationPool
 }
 
 type TickerOpt func(*TickerOpts)
@@ -50,6 +54,7 @@ type TickerOpts struct {
 
 	entitlements repository.EntitlementsRepository
 	repo         repository.EngineRepository
+	repov2       v2.Repository
 	tickerId     string
 	ta           *alerting.TenantAlertManager
 
@@ -79,6 +84,12 @@ func WithRepository(r repository.EngineRepository) TickerOpt {
 	}
 }
 
+func WithV2Repository(r v2.Repository) TickerOpt {
+	return func(opts *TickerOpts) {
+		opts.repov2 = r
+	}
+}
+
 func WithEntitlementsRepository(r repository.EntitlementsRepository) TickerOpt {
 	return func(opts *TickerOpts) {
 		opts.entitlements = r
@@ -118,6 +129,10 @@ func New(fs ...TickerOpt) (*TickerImpl, error) {
 		return nil, fmt.Errorf("repository is required. use WithRepository")
 	}
 
+	if opts.repov2 == nil {
+		return nil, fmt.Errorf("v2 repository is required. use WithV2Repository")
+	}
+
 	if opts.entitlements == nil {
 		return nil, fmt.Errorf("entitlements repository is required. use WithEntitlementsRepository")
 	}
@@ -139,17 +154,24 @@ func New(fs ...TickerOpt) (*TickerImpl, error) {
 		return nil, fmt.Errorf("could not create scheduler: %w", err)
 	}
 
-	return &TickerImpl{
+	t := &TickerImpl{
 		mq:           opts.mq,
 		l:            opts.l,
 		repo:         opts.repo,
+		repov2:       opts.repov2,
 		entitlements: opts.entitlements,
 		s:            s,
 		dv:           opts.dv,
 		tickerId:     opts.tickerId,
 		ta:           opts.ta,
 		p:            opts.p,
-	}, nil
+		bulk:         taskactions.NewBulkActions(opts.repo.OLAP(), opts.repov2, opts.mq),
+	}
+
+	t.cronOperations = queueutils.NewOperationPool(opts.l, time.Second*5, "process cron schedules", t.processCronSchedules)
+	t.scheduledWorkflowOperations = queueutils.NewOperationPool(opts.l, time.Second*5, "process scheduled workflows", t.processScheduledWorkflows)
+
+	return t, nil
 }
 
 func (t *TickerImpl) Start() (func() error, error) {
@@ -191,31 +213,31 @@ func (t *TickerImpl) Start() (func() error, error) {
 	// 	return nil, fmt.Errorf("could not create update heartbeat job: %w", err)
 	// }
 
-	// _, err = t.s.NewJob(
-	// 	// crons only have a resolution of 1 minute, so only poll every 15 seconds
-	// 	gocron.DurationJob(time.Second*15),
-	// 	gocron.NewTask(
-	// 		t.runPollCronSchedules(ctx),
-	// 	),
-	// )
+	// crons only have a resolution of 1 minute, but we poll every 5 seconds to keep fires close to
+	// their scheduled time
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*5),
+		gocron.NewTask(
+			t.runTenantCronSchedules(ctx),
+		),
+	)
 
-	// if err != nil {
-	// 	cancel()
-	// 	return nil, fmt.Errorf("could not create poll cron schedules job: %w", err)
-	// }
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create poll cron schedules job: %w", err)
+	}
 
-	// _, err = t.s.NewJob(
-	// 	// we look ahead every 5 seconds
-	// 	gocron.DurationJob(time.Second*5),
-	// 	gocron.NewTask(
-	// 		t.runPollSchedules(ctx),
-	// 	),
-	// )
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*1),
+		gocron.NewTask(
+			t.runTenantScheduledWorkflows(ctx),
+		),
+	)
 
-	// if err != nil {
-	// 	cancel()
-	// 	return nil, fmt.Errorf("could not create poll cron schedules job: %w", err)
-	// }
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create poll scheduled workflows job: %w", err)
+	}
 
 	_, err = t.s.NewJob(
 		gocron.DurationJob(time.Minute*5),
@@ -0,0 +1,74 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"google.golang.org/grpc/credentials"
+)
+
+type MeterOpts struct {
+	ServiceName  string
+	CollectorURL string
+	Insecure     bool
+}
+
+// InitMeter sets the global meter provider to export metrics to the collector. Like InitTracer, it is a
+// no-op when no collector is configured, in which case instruments created with NewMeter record nothing.
+func InitMeter(opts *MeterOpts) (func(context.Context) error, error) {
+	if opts.CollectorURL == "" {
+		// no-op
+		return func(context.Context) error {
+			return nil
+		}, nil
+	}
+
+	var secureOption otlpmetricgrpc.Option
+
+	if !opts.Insecure {
+		secureOption = otlpmetricgrpc.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, ""))
+	} else {
+		secureOption = otlpmetricgrpc.WithInsecure()
+	}
+
+	exporter, err := otlpmetricgrpc.New(
+		context.Background(),
+		secureOption,
+		otlpmetricgrpc.WithEndpoint(opts.CollectorURL),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metric exporter: %w", err)
+	}
+
+	resources, err := resource.New(
+		context.Background(),
+		resource.WithAttributes(
+			attribute.String("service.name", opts.ServiceName),
+			attribute.String("library.language", "go"),
+		),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to set resources: %w", err)
+	}
+
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter)),
+		sdkmetric.WithResource(resources),
+	)
+
+	otel.SetMeterProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+func NewMeter(name string) metric.Meter {
+	return otel.Meter(name)
+}
@@ -0,0 +1,267 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+type OIDCConfig struct {
+	Config
+
+	IssuerURL string
+
+	// RolesClaim is the userinfo claim which is matched against the role mappings
+	RolesClaim string
+
+	// RoleMappings have the format <claim value>:<tenant id>:<role>
+	RoleMappings []string
+}
+
+// OIDCRoleMapping grants a tenant role to users whose roles claim contains ClaimValue.
+type OIDCRoleMapping struct {
+	ClaimValue string
+	TenantId   string
+	Role       string
+}
+
+// oidcRolePriority orders roles from least to most privileged, so that the most privileged role wins
+// when several mappings match the same tenant.
+var oidcRolePriority = []string{"MEMBER", "ADMIN", "OWNER"}
+
+// ParseOIDCRoleMappings parses role mappings of the format <claim value>:<tenant id>:<role>. The claim value
+// may itself contain colons.
+func ParseOIDCRoleMappings(mappings []string) ([]OIDCRoleMapping, error) {
+	res := make([]OIDCRoleMapping, 0, len(mappings))
+
+	for _, mapping := range mappings {
+		if mapping == "" {
+			continue
+		}
+
+		parts := strings.Split(mapping, ":")
+
+		if len(parts) < 3 {
+			return nil, fmt.Errorf("invalid oidc role mapping %q: expected <claim value>:<tenant id>:<role>", mapping)
+		}
+
+		role := strings.ToUpper(parts[len(parts)-1])
+		tenantId := parts[len(parts)-2]
+		claimValue := strings.Join(parts[:len(parts)-2], ":")
+
+		if claimValue == "" || tenantId == "" {
+			return nil, fmt.Errorf("invalid oidc role mapping %q: claim value and tenant id are required", mapping)
+		}
+
+		if !slices.Contains(oidcRolePriority, role) {
+			return nil, fmt.Errorf("invalid oidc role mapping %q: role must be one of OWNER, ADMIN or MEMBER", mapping)
+		}
+
+		res = append(res, OIDCRoleMapping{
+			ClaimValue: claimValue,
+			TenantId:   tenantId,
+			Role:       role,
+		})
+	}
+
+	return res, nil
+}
+
+// OIDCProvider is an OpenID Connect provider, configured from its discovery document.
+type OIDCProvider struct {
+	Issuer      string
+	AuthURL     string
+	TokenURL    string
+	UserInfoURL string
+
+	rolesClaim   string
+	roleMappings []OIDCRoleMapping
+	httpClient   *http.Client
+}
+
+type oidcDiscoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// NewOIDCProvider reads the discovery document of the provider at the issuer URL.
+func NewOIDCProvider(ctx context.Context, cfg *OIDCConfig) (*OIDCProvider, error) {
+	roleMappings, err := ParseOIDCRoleMappings(cfg.RoleMappings)
+
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	issuer := strings.TrimSuffix(cfg.IssuerURL, "/")
+
+	doc := &oidcDiscoveryDocument{}
+
+	if err := getJSON(ctx, httpClient, issuer+"/.well-known/openid-configuration", "", doc); err != nil {
+		return nil, fmt.Errorf("could not read oidc discovery document: %w", err)
+	}
+
+	// the issuer must match exactly, otherwise the discovery document may belong to a different provider
+	if strings.TrimSuffix(doc.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("oidc issuer %q does not match the configured issuer %q", doc.Issuer, cfg.IssuerURL)
+	}
+
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.UserInfoEndpoint == "" {
+		return nil, fmt.Errorf("oidc discovery document must include authorization, token and userinfo endpoints")
+	}
+
+	return &OIDCProvider{
+		Issuer:       doc.Issuer,
+		AuthURL:      doc.AuthorizationEndpoint,
+		TokenURL:     doc.TokenEndpoint,
+		UserInfoURL:  doc.UserInfoEndpoint,
+		rolesClaim:   cfg.RolesClaim,
+		roleMappings: roleMappings,
+		httpClient:   httpClient,
+	}, nil
+}
+
+func NewOIDCClient(cfg *Config, provider *OIDCProvider) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  provider.AuthURL,
+			TokenURL: provider.TokenURL,
+		},
+		RedirectURL: cfg.BaseURL + "/api/v1/users/oidc/callback",
+		Scopes:      cfg.Scopes,
+	}
+}
+
+// OIDCUserInfo contains the standard claims of the userinfo response, along with every other claim.
+type OIDCUserInfo struct {
+	Sub           string
+	Email         string
+	EmailVerified bool
+	Name          string
+
+	Claims map[string]interface{}
+}
+
+// GetUserInfo calls the userinfo endpoint of the provider with the access token.
+func (p *OIDCProvider) GetUserInfo(ctx context.Context, tok *oauth2.Token) (*OIDCUserInfo, error) {
+	claims := make(map[string]interface{})
+
+	if err := getJSON(ctx, p.httpClient, p.UserInfoURL, tok.AccessToken, &claims); err != nil {
+		return nil, fmt.Errorf("could not get oidc user info: %w", err)
+	}
+
+	info := &OIDCUserInfo{
+		Claims: claims,
+	}
+
+	info.Sub, _ = claims["sub"].(string)
+	info.Email, _ = claims["email"].(string)
+	info.Name, _ = claims["name"].(string)
+
+	// some providers send email_verified as a string
+	switch verified := claims["email_verified"].(type) {
+	case bool:
+		info.EmailVerified = verified
+	case string:
+		info.EmailVerified = verified == "true"
+	}
+
+	if info.Sub == "" {
+		return nil, fmt.Errorf("oidc user info does not contain a subject")
+	}
+
+	return info, nil
+}
+
+// ClaimValues returns the values of a string or string array claim.
+func (u *OIDCUserInfo) ClaimValues(claim string) []string {
+	switch v := u.Claims[claim].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		res := make([]string, 0, len(v))
+
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				res = append(res, s)
+			}
+		}
+
+		return res
+	default:
+		return nil
+	}
+}
+
+// TenantRoles returns the role which the user should have in each tenant, keyed by tenant id.
+func (p *OIDCProvider) TenantRoles(info *OIDCUserInfo) map[string]string {
+	res := make(map[string]string)
+
+	if p.rolesClaim == "" {
+		return res
+	}
+
+	values := info.ClaimValues(p.rolesClaim)
+
+	for _, mapping := range p.roleMappings {
+		if !slices.Contains(values, mapping.ClaimValue) {
+			continue
+		}
+
+		if curr, ok := res[mapping.TenantId]; ok && slices.Index(oidcRolePriority, curr) >= slices.Index(oidcRolePriority, mapping.Role) {
+			continue
+		}
+
+		res[mapping.TenantId] = mapping.Role
+	}
+
+	return res
+}
+
+func getJSON(ctx context.Context, client *http.Client, url, accessToken string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,130 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// newTestIdentityProvider starts a local OpenID Connect provider which issues a fixed access token.
+func newTestIdentityProvider(t *testing.T, userInfo map[string]interface{}) *httptest.Server {
+	mux := http.NewServeMux()
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 srv.URL,
+			"authorization_endpoint": srv.URL + "/authorize",
+			"token_endpoint":         srv.URL + "/token",
+			"userinfo_endpoint":      srv.URL + "/userinfo",
+		})
+	})
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "test-code" || r.FormValue("code_verifier") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "test-access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	})
+
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(userInfo)
+	})
+
+	return srv
+}
+
+func TestOIDCLogin(t *testing.T) {
+	tenantA := "707d0855-80ab-4e1f-a156-f1c4546cbf52"
+	tenantB := "b2f0a9b1-1f3c-4a4c-9f53-6b1c1c0f3a11"
+
+	srv := newTestIdentityProvider(t, map[string]interface{}{
+		"sub":            "user-1",
+		"email":          "user@example.com",
+		"email_verified": "true",
+		"name":           "Test User",
+		"groups":         []interface{}{"engineering", "urn:group:admins"},
+	})
+
+	ctx := context.Background()
+
+	provider, err := NewOIDCProvider(ctx, &OIDCConfig{
+		IssuerURL:  srv.URL + "/",
+		RolesClaim: "groups",
+		RoleMappings: []string{
+			"engineering:" + tenantA + ":member",
+			"urn:group:admins:" + tenantA + ":ADMIN",
+			"engineering:" + tenantB + ":MEMBER",
+			"sales:" + tenantB + ":OWNER",
+		},
+	})
+	require.NoError(t, err)
+
+	client := NewOIDCClient(&Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		BaseURL:      "http://localhost:8080",
+	}, provider)
+
+	assert.Equal(t, srv.URL+"/authorize", client.Endpoint.AuthURL)
+	assert.Equal(t, "http://localhost:8080/api/v1/users/oidc/callback", client.RedirectURL)
+
+	tok, err := client.Exchange(ctx, "test-code", oauth2.VerifierOption("verifier"))
+	require.NoError(t, err)
+
+	info, err := provider.GetUserInfo(ctx, tok)
+	require.NoError(t, err)
+
+	assert.Equal(t, "user-1", info.Sub)
+	assert.Equal(t, "user@example.com", info.Email)
+	assert.True(t, info.EmailVerified)
+
+	assert.Equal(t, map[string]string{
+		tenantA: "ADMIN",
+		tenantB: "MEMBER",
+	}, provider.TenantRoles(info))
+}
+
+func TestOIDCIssuerMismatch(t *testing.T) {
+	srv := newTestIdentityProvider(t, nil)
+
+	_, err := NewOIDCProvider(context.Background(), &OIDCConfig{
+		IssuerURL: srv.URL + "/other",
+	})
+
+	assert.Error(t, err)
+}
+
+func TestParseOIDCRoleMappings(t *testing.T) {
+	_, err := ParseOIDCRoleMappings([]string{"engineering:tenant"})
+	assert.Error(t, err)
+
+	_, err = ParseOIDCRoleMappings([]string{"engineering:tenant:SUPERUSER"})
+	assert.Error(t, err)
+
+	mappings, err := ParseOIDCRoleMappings([]string{"a:b:c:tenant:owner"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []OIDCRoleMapping{{ClaimValue: "a:b:c", TenantId: "tenant", Role: "OWNER"}}, mappings)
+}
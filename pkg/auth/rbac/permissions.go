@@ -0,0 +1,100 @@
+package rbac
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Permission is a set of related operations which a custom tenant role can grant.
+type Permission string
+
+const (
+	PermissionWorkflowsRead    Permission = "workflows:read"
+	PermissionWorkflowsWrite   Permission = "workflows:write"
+	PermissionWorkflowsTrigger Permission = "workflows:trigger"
+	PermissionRunsRead         Permission = "runs:read"
+	PermissionRunsCancel       Permission = "runs:cancel"
+	PermissionRunsReplay       Permission = "runs:replay"
+	PermissionEventsRead       Permission = "events:read"
+	PermissionEventsWrite      Permission = "events:write"
+	PermissionWorkersRead      Permission = "workers:read"
+	PermissionWorkersWrite     Permission = "workers:write"
+	PermissionQueuesRead       Permission = "queues:read"
+	PermissionSettingsRead     Permission = "settings:read"
+	PermissionSettingsWrite    Permission = "settings:write"
+)
+
+var AllPermissions = []Permission{
+	PermissionWorkflowsRead,
+	PermissionWorkflowsWrite,
+	PermissionWorkflowsTrigger,
+	PermissionRunsRead,
+	PermissionRunsCancel,
+	PermissionRunsReplay,
+	PermissionEventsRead,
+	PermissionEventsWrite,
+	PermissionWorkersRead,
+	PermissionWorkersWrite,
+	PermissionQueuesRead,
+	PermissionSettingsRead,
+	PermissionSettingsWrite,
+}
+
+// workflowScopedPermissions are the permissions which are restricted to a role's workflows, if the role
+// has any.
+var workflowScopedPermissions = []Permission{
+	PermissionWorkflowsRead,
+	PermissionWorkflowsWrite,
+	PermissionWorkflowsTrigger,
+	PermissionRunsRead,
+	PermissionRunsCancel,
+	PermissionRunsReplay,
+}
+
+// ValidatePermissions returns an error if any of the permissions is unknown.
+func ValidatePermissions(permissions []string) error {
+	for _, permission := range permissions {
+		if !slices.Contains(AllPermissions, Permission(permission)) {
+			return fmt.Errorf("unknown permission %q", permission)
+		}
+	}
+
+	return nil
+}
+
+// IsWorkflowScoped returns true if the permission can be restricted to specific workflows.
+func IsWorkflowScoped(permission Permission) bool {
+	return slices.Contains(workflowScopedPermissions, permission)
+}
+
+// Role is the set of permissions granted by a custom tenant role.
+type Role struct {
+	Permissions []string
+
+	// WorkflowIds restricts workflow-scoped permissions to these workflows. If empty, workflow-scoped
+	// permissions apply to every workflow.
+	WorkflowIds []string
+}
+
+// HasPermission returns true if the role grants the permission, for at least one workflow.
+func (r *Role) HasPermission(permission Permission) bool {
+	return slices.Contains(r.Permissions, string(permission))
+}
+
+// RestrictsWorkflows returns true if the permission is limited to the role's workflows.
+func (r *Role) RestrictsWorkflows(permission Permission) bool {
+	return len(r.WorkflowIds) > 0 && IsWorkflowScoped(permission)
+}
+
+// Allows returns true if the role grants the permission on the given workflow.
+func (r *Role) Allows(permission Permission, workflowId string) bool {
+	if !r.HasPermission(permission) {
+		return false
+	}
+
+	if !r.RestrictsWorkflows(permission) {
+		return true
+	}
+
+	return slices.Contains(r.WorkflowIds, workflowId)
+}
@@ -0,0 +1,36 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleAllows(t *testing.T) {
+	billing := "3d6a6a0e-8b3f-4d8a-9a0e-2f0a3d7c1b11"
+	other := "8f1c2b4d-0e6f-4a3b-9c5d-7e8f9a0b1c22"
+
+	onCall := &Role{
+		Permissions: []string{"runs:read", "runs:cancel", "events:read"},
+		WorkflowIds: []string{billing},
+	}
+
+	assert.True(t, onCall.Allows(PermissionRunsCancel, billing))
+	assert.False(t, onCall.Allows(PermissionRunsCancel, other))
+	assert.False(t, onCall.Allows(PermissionWorkflowsWrite, billing))
+
+	// events aren't scoped to workflows, so the restriction doesn't apply
+	assert.True(t, onCall.Allows(PermissionEventsRead, ""))
+	assert.False(t, onCall.RestrictsWorkflows(PermissionEventsRead))
+	assert.True(t, onCall.RestrictsWorkflows(PermissionRunsRead))
+
+	unrestricted := &Role{Permissions: []string{"runs:cancel"}}
+
+	assert.True(t, unrestricted.Allows(PermissionRunsCancel, other))
+	assert.False(t, unrestricted.RestrictsWorkflows(PermissionRunsCancel))
+}
+
+func TestValidatePermissions(t *testing.T) {
+	assert.NoError(t, ValidatePermissions([]string{"runs:read", "runs:cancel"}))
+	assert.Error(t, ValidatePermissions([]string{"runs:delete"}))
+}
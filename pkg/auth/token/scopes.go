@@ -0,0 +1,53 @@
+package token
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Scope restricts the operations which an API token can perform.
+type Scope string
+
+const (
+	// ScopeAdmin grants access to every operation in the tenant. Tokens created without explicit scopes
+	// have this scope.
+	ScopeAdmin Scope = "admin"
+
+	ScopeWorkflowsTrigger Scope = "workflows:trigger"
+	ScopeEventsPush       Scope = "events:push"
+	ScopeRunsRead         Scope = "runs:read"
+	ScopeRunsCancel       Scope = "runs:cancel"
+	ScopeWorkersRegister  Scope = "workers:register"
+)
+
+var AllScopes = []Scope{
+	ScopeAdmin,
+	ScopeWorkflowsTrigger,
+	ScopeEventsPush,
+	ScopeRunsRead,
+	ScopeRunsCancel,
+	ScopeWorkersRegister,
+}
+
+// ValidateScopes returns an error if any of the scopes is unknown. An empty list of scopes is valid, and
+// results in a token with the admin scope.
+func ValidateScopes(scopes []string) error {
+	for _, scope := range scopes {
+		if !slices.Contains(AllScopes, Scope(scope)) {
+			return fmt.Errorf("unknown scope %q", scope)
+		}
+	}
+
+	return nil
+}
+
+// HasScope returns true if the granted scopes permit an operation which requires the given scope.
+func HasScope(granted []string, required Scope) bool {
+	for _, scope := range granted {
+		if Scope(scope) == ScopeAdmin || Scope(scope) == required {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,21 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasScope(t *testing.T) {
+	assert.True(t, HasScope([]string{"admin"}, ScopeRunsCancel))
+	assert.True(t, HasScope([]string{"events:push"}, ScopeEventsPush))
+	assert.False(t, HasScope([]string{"events:push"}, ScopeWorkflowsTrigger))
+	assert.False(t, HasScope([]string{"events:push"}, ScopeAdmin))
+	assert.False(t, HasScope([]string{}, ScopeRunsRead))
+}
+
+func TestValidateScopes(t *testing.T) {
+	assert.NoError(t, ValidateScopes([]string{}))
+	assert.NoError(t, ValidateScopes([]string{"runs:read", "runs:cancel"}))
+	assert.Error(t, ValidateScopes([]string{"runs:write"}))
+}
@@ -15,8 +15,9 @@ import (
 
 type JWTManager interface {
 	GenerateTenantToken(ctx context.Context, tenantId, name string, internal bool, expires *time.Time) (*Token, error)
+	GenerateScopedTenantToken(ctx context.Context, tenantId, name string, scopes []string, expires *time.Time) (*Token, error)
 	UpsertTenantToken(ctx context.Context, tenantId, name, id string, internal bool, expires *time.Time) (string, error)
-	ValidateTenantToken(ctx context.Context, token string) (string, string, error)
+	ValidateTenantToken(ctx context.Context, token string) (*ValidatedToken, error)
 }
 
 type TokenOpts struct {
@@ -54,6 +55,17 @@ type Token struct {
 	Token     string
 }
 
+type ValidatedToken struct {
+	TenantId string
+	TokenId  string
+
+	// the name of the token, which is empty if the token is unnamed
+	Name string
+
+	// the scopes which were granted to the token
+	Scopes []string
+}
+
 func (j *jwtManagerImpl) createToken(ctx context.Context, tenantId, name string, id *string, expires *time.Time) (*Token, error) {
 	// Retrieve the JWT Signer primitive from privateKeysetHandle.
 	signer, err := jwt.NewSigner(j.encryption.GetPrivateJWTHandle())
@@ -84,6 +96,18 @@ func (j *jwtManagerImpl) createToken(ctx context.Context, tenantId, name string,
 }
 
 func (j *jwtManagerImpl) GenerateTenantToken(ctx context.Context, tenantId, name string, internal bool, expires *time.Time) (*Token, error) {
+	return j.generateTenantToken(ctx, tenantId, name, internal, nil, expires)
+}
+
+func (j *jwtManagerImpl) GenerateScopedTenantToken(ctx context.Context, tenantId, name string, scopes []string, expires *time.Time) (*Token, error) {
+	if err := ValidateScopes(scopes); err != nil {
+		return nil, err
+	}
+
+	return j.generateTenantToken(ctx, tenantId, name, false, scopes, expires)
+}
+
+func (j *jwtManagerImpl) generateTenantToken(ctx context.Context, tenantId, name string, internal bool, scopes []string, expires *time.Time) (*Token, error) {
 	token, err := j.createToken(ctx, tenantId, name, nil, expires)
 	if err != nil {
 		return nil, err
@@ -96,6 +120,7 @@ func (j *jwtManagerImpl) GenerateTenantToken(ctx context.Context, tenantId, name
 		TenantId:  &tenantId,
 		Name:      &name,
 		Internal:  internal,
+		Scopes:    scopes,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to write token to database: %v", err)
@@ -125,7 +150,7 @@ func (j *jwtManagerImpl) UpsertTenantToken(ctx context.Context, tenantId, name,
 	return token.Token, nil
 }
 
-func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string) (tenantId string, tokenUUID string, err error) {
+func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string) (*ValidatedToken, error) {
 	// Verify the signed token.
 	audience := j.opts.Audience
 
@@ -137,24 +162,24 @@ func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string)
 	})
 
 	if err != nil {
-		return "", "", fmt.Errorf("failed to create JWT Validator: %v", err)
+		return nil, fmt.Errorf("failed to create JWT Validator: %v", err)
 	}
 
 	verifiedJwt, err := j.verifier.VerifyAndDecode(token, validator)
 
 	if err != nil {
-		return "", "", fmt.Errorf("failed to verify and decode JWT: %v", err)
+		return nil, fmt.Errorf("failed to verify and decode JWT: %v", err)
 	}
 
 	// Read the token from the database and make sure it's not revoked
 	if hasTokenId := verifiedJwt.HasStringClaim("token_id"); !hasTokenId {
-		return "", "", fmt.Errorf("token does not have token_id claim")
+		return nil, fmt.Errorf("token does not have token_id claim")
 	}
 
 	tokenId, err := verifiedJwt.StringClaim("token_id")
 
 	if err != nil {
-		return "", "", fmt.Errorf("failed to read token_id claim: %v", err)
+		return nil, fmt.Errorf("failed to read token_id claim: %v", err)
 	}
 
 	// ensure the current server url matches the token, if present
@@ -162,11 +187,11 @@ func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string)
 		serverURL, err := verifiedJwt.StringClaim("server_url")
 
 		if err != nil {
-			return "", "", fmt.Errorf("failed to read server_url claim: %v", err)
+			return nil, fmt.Errorf("failed to read server_url claim: %v", err)
 		}
 
 		if serverURL != j.opts.ServerURL {
-			return "", "", fmt.Errorf("server_url claim does not match")
+			return nil, fmt.Errorf("server_url claim does not match")
 		}
 	}
 
@@ -174,29 +199,34 @@ func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string)
 	dbToken, err := j.tokenRepo.GetAPITokenById(ctx, tokenId)
 
 	if err != nil {
-		return "", "", fmt.Errorf("failed to read token from database: %v", err)
+		return nil, fmt.Errorf("failed to read token from database: %v", err)
 	}
 
 	if dbToken.Revoked {
-		return "", "", fmt.Errorf("token has been revoked")
+		return nil, fmt.Errorf("token has been revoked")
 	}
 
 	if expiresAt := dbToken.ExpiresAt.Time; expiresAt.Before(time.Now()) {
-		return "", "", fmt.Errorf("token has expired")
+		return nil, fmt.Errorf("token has expired")
 	}
 
 	// ensure the subject of the token matches the tenantId
 	if hasSubject := verifiedJwt.HasSubject(); !hasSubject {
-		return "", "", fmt.Errorf("token does not have subject claim")
+		return nil, fmt.Errorf("token does not have subject claim")
 	}
 
 	subject, err := verifiedJwt.Subject()
 
 	if err != nil {
-		return "", "", fmt.Errorf("failed to read subject claim: %v", err)
+		return nil, fmt.Errorf("failed to read subject claim: %v", err)
 	}
 
-	return subject, sqlchelpers.UUIDToStr(dbToken.ID), nil
+	return &ValidatedToken{
+		TenantId: subject,
+		TokenId:  sqlchelpers.UUIDToStr(dbToken.ID),
+		Name:     dbToken.Name.String,
+		Scopes:   dbToken.Scopes,
+	}, nil
 }
 
 func (j *jwtManagerImpl) getJWTOptionsForTenant(tenantId string, id *string, expires *time.Time) (tokenId string, expiresAt time.Time, opts *jwt.RawJWTOptions) {
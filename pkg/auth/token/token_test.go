@@ -49,10 +49,11 @@ func TestCreateTenantToken(t *testing.T) { // make sure no cache is used for tes
 		}
 
 		// validate the token
-		newTenantId, _, err := jwtManager.ValidateTenantToken(context.Background(), token.Token)
+		validated, err := jwtManager.ValidateTenantToken(context.Background(), token.Token)
 
 		assert.NoError(t, err)
-		assert.Equal(t, tenantId, newTenantId)
+		assert.Equal(t, tenantId, validated.TenantId)
+		assert.Equal(t, []string{"admin"}, validated.Scopes)
 
 		return nil
 	})
@@ -90,7 +91,7 @@ func TestRevokeTenantToken(t *testing.T) {
 		}
 
 		// validate the token
-		_, _, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
+		_, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
 
 		assert.NoError(t, err)
 
@@ -109,7 +110,7 @@ func TestRevokeTenantToken(t *testing.T) {
 		}
 
 		// validate the token again
-		_, _, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
+		_, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
 
 		// error as the token was revoked
 		assert.Error(t, err)
@@ -150,7 +151,7 @@ func TestRevokeTenantTokenCache(t *testing.T) {
 		}
 
 		// validate the token
-		_, _, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
+		_, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
 
 		assert.NoError(t, err)
 
@@ -169,7 +170,7 @@ func TestRevokeTenantTokenCache(t *testing.T) {
 		}
 
 		// validate the token again
-		_, _, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
+		_, err = jwtManager.ValidateTenantToken(context.Background(), token.Token)
 
 		// no error as it is cached
 		assert.NoError(t, err)
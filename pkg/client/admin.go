@@ -408,6 +408,20 @@ func (a *adminClientImpl) PutRateLimit(key string, opts *types.RateLimitOpts) er
 		putParams.Duration = admincontracts.RateLimitDuration_SECOND
 	}
 
+	switch opts.Algorithm {
+	case types.TokenBucket:
+		putParams.Algorithm = admincontracts.RateLimitAlgorithm_TOKEN_BUCKET.Enum()
+	case types.SlidingWindow:
+		putParams.Algorithm = admincontracts.RateLimitAlgorithm_SLIDING_WINDOW.Enum()
+	default:
+		putParams.Algorithm = admincontracts.RateLimitAlgorithm_FIXED_WINDOW.Enum()
+	}
+
+	if opts.Burst != nil {
+		burst := int32(*opts.Burst) // nolint: gosec
+		putParams.Burst = &burst
+	}
+
 	_, err := a.client.PutRateLimit(a.ctx.newContext(context.Background()), putParams)
 
 	if err != nil {
@@ -433,8 +447,9 @@ func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontrac
 
 	if workflow.Concurrency != nil {
 		opts.Concurrency = &admincontracts.WorkflowConcurrencyOpts{
-			Action:     workflow.Concurrency.ActionID,
-			Expression: workflow.Concurrency.Expression,
+			Action:           workflow.Concurrency.ActionID,
+			Expression:       workflow.Concurrency.Expression,
+			WeightExpression: workflow.Concurrency.WeightExpression,
 		}
 
 		var limitStrat admincontracts.ConcurrencyLimitStrategy
@@ -446,6 +461,8 @@ func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontrac
 			limitStrat = admincontracts.ConcurrencyLimitStrategy_GROUP_ROUND_ROBIN
 		case types.CancelNewest:
 			limitStrat = admincontracts.ConcurrencyLimitStrategy_CANCEL_NEWEST
+		case types.WeightedRoundRobin:
+			limitStrat = admincontracts.ConcurrencyLimitStrategy_WEIGHTED_ROUND_ROBIN
 		default:
 			limitStrat = admincontracts.ConcurrencyLimitStrategy_CANCEL_IN_PROGRESS
 		}
@@ -549,6 +566,13 @@ func (a *adminClientImpl) getJobOpts(jobName string, job *types.WorkflowJob) (*a
 			stepOpt.RateLimits = append(stepOpt.RateLimits, opt)
 		}
 
+		for _, condition := range step.UserEventConditions {
+			stepOpt.UserEventConditions = append(stepOpt.UserEventConditions, &admincontracts.CreateStepUserEventCondition{
+				EventKey:   condition.EventKey,
+				Expression: condition.Expression,
+			})
+		}
+
 		if step.DesiredLabels != nil {
 			stepOpt.WorkerLabels = make(map[string]*admincontracts.DesiredWorkerLabels, len(step.DesiredLabels))
 			for key, desiredLabel := range step.DesiredLabels {
@@ -25,6 +25,21 @@ type CronOpts struct {
 
 	// AdditionalMetadata is additional metadata to be stored with the cron trigger
 	AdditionalMetadata map[string]string
+
+	// (optional) MissedFirePolicy is how fires which were missed while the engine was unavailable are
+	// handled: SKIP (the default), FIRE_ONCE or CATCH_UP
+	MissedFirePolicy *rest.CronWorkflowsMissedFirePolicy
+
+	// (optional) CatchUpLimit is the maximum number of missed fires which are run with the CATCH_UP policy
+	CatchUpLimit *int
+
+	// (optional) Timezone is the IANA timezone which the expression is evaluated in, for example
+	// "America/New_York". Defaults to UTC.
+	Timezone *string
+
+	// (optional) OverlapPolicy is how a fire is handled while the previous run is still running: ALLOW (the
+	// default), SKIP_IF_RUNNING, CANCEL_PREVIOUS or QUEUE_ONE
+	OverlapPolicy *rest.CronWorkflowsOverlapPolicy
 }
 
 type CronClient interface {
@@ -82,6 +97,10 @@ func (c *cronClientImpl) Create(ctx context.Context, workflow string, opts *Cron
 			CronExpression:     opts.Expression,
 			Input:              opts.Input,
 			AdditionalMetadata: additionalMeta,
+			MissedFirePolicy:   opts.MissedFirePolicy,
+			CatchUpLimit:       opts.CatchUpLimit,
+			Timezone:           opts.Timezone,
+			OverlapPolicy:      opts.OverlapPolicy,
 		},
 	)
 
@@ -30,6 +30,10 @@ type DispatcherClient interface {
 	RefreshTimeout(ctx context.Context, stepRunId string, incrementTimeoutBy string) error
 
 	UpsertWorkerLabels(ctx context.Context, workerId string, labels map[string]interface{}) error
+
+	RegisterDurableEvent(ctx context.Context, req *RegisterDurableEventRequest) error
+
+	WaitForDurableEvent(ctx context.Context, stepRunId, signalKey string) ([]byte, error)
 }
 
 const (
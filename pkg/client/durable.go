@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dispatchercontracts "github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+)
+
+// RegisterDurableEventRequest registers a set of conditions for a running step run. The step run is
+// resumed when any of the conditions is satisfied.
+type RegisterDurableEventRequest struct {
+	StepRunId string
+
+	// SignalKey identifies the durable event within the step run, and is used to wait for the event.
+	SignalKey string
+
+	SleepConditions []SleepCondition
+
+	UserEventConditions []UserEventCondition
+}
+
+type SleepCondition struct {
+	// Duration is how long to sleep for. Durations are sent with millisecond precision.
+	Duration time.Duration
+}
+
+type UserEventCondition struct {
+	EventKey string
+
+	// Expression is an optional CEL expression evaluated against the event payload (as `input`).
+	Expression string
+}
+
+func (a *dispatcherClientImpl) RegisterDurableEvent(ctx context.Context, req *RegisterDurableEventRequest) error {
+	conditions := &dispatchercontracts.DurableEventConditions{}
+
+	for _, sleep := range req.SleepConditions {
+		conditions.SleepConditions = append(conditions.SleepConditions, &dispatchercontracts.SleepMatchCondition{
+			SleepFor: durationString(sleep.Duration),
+		})
+	}
+
+	for _, event := range req.UserEventConditions {
+		condition := &dispatchercontracts.UserEventMatchCondition{
+			EventKey: event.EventKey,
+		}
+
+		if event.Expression != "" {
+			expression := event.Expression
+			condition.Expression = &expression
+		}
+
+		conditions.UserEventConditions = append(conditions.UserEventConditions, condition)
+	}
+
+	_, err := a.client.RegisterDurableEvent(a.ctx.newContext(ctx), &dispatchercontracts.RegisterDurableEventRequest{
+		StepRunId:  req.StepRunId,
+		SignalKey:  req.SignalKey,
+		Conditions: conditions,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WaitForDurableEvent blocks until the durable event registered under the signal key is satisfied, and
+// returns the payloads of the satisfied conditions as a JSON object keyed by event key. The subscription
+// is re-established if the connection to the engine drops.
+func (a *dispatcherClientImpl) WaitForDurableEvent(ctx context.Context, stepRunId, signalKey string) ([]byte, error) {
+	var lastErr error
+
+	for i := 0; i < DefaultActionListenerRetryCount; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(DefaultActionListenerRetryInterval):
+			}
+		}
+
+		data, err := a.waitForDurableEvent(ctx, stepRunId, signalKey)
+
+		if err == nil {
+			return data, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		a.l.Warn().Err(err).Msgf("durable event listener for step run %s disconnected, retrying", stepRunId)
+
+		lastErr = err
+	}
+
+	return nil, fmt.Errorf("could not wait for durable event after %d retries: %w", DefaultActionListenerRetryCount, lastErr)
+}
+
+func (a *dispatcherClientImpl) waitForDurableEvent(ctx context.Context, stepRunId, signalKey string) ([]byte, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := a.client.ListenForDurableEvent(a.ctx.newContext(ctx), grpc_retry.Disable())
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&dispatchercontracts.ListenForDurableEventRequest{
+		StepRunId: stepRunId,
+		SignalKey: signalKey,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		event, err := stream.Recv()
+
+		if err != nil {
+			if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+				return nil, fmt.Errorf("durable event stream closed: %w", err)
+			}
+
+			return nil, err
+		}
+
+		if event.StepRunId == stepRunId && event.SignalKey == signalKey {
+			_ = stream.CloseSend()
+
+			return event.Data, nil
+		}
+	}
+}
+
+// durationString formats a duration in the simple <N><unit> format which the engine accepts.
+func durationString(d time.Duration) string {
+	if d%time.Second == 0 {
+		return fmt.Sprintf("%ds", d/time.Second)
+	}
+
+	return fmt.Sprintf("%dms", d/time.Millisecond)
+}
@@ -23,12 +23,34 @@ const (
 	CookieAuthScopes = "cookieAuth.Scopes"
 )
 
+// Defines values for APITokenScope.
+const (
+	APITokenScopeAdmin            APITokenScope = "admin"
+	APITokenScopeEventsPush       APITokenScope = "events:push"
+	APITokenScopeRunsCancel       APITokenScope = "runs:cancel"
+	APITokenScopeRunsRead         APITokenScope = "runs:read"
+	APITokenScopeWorkersRegister  APITokenScope = "workers:register"
+	APITokenScopeWorkflowsTrigger APITokenScope = "workflows:trigger"
+)
+
+// Defines values for AuditLogActorKind.
+const (
+	APITOKEN AuditLogActorKind = "API_TOKEN"
+	USER     AuditLogActorKind = "USER"
+)
+
+// Defines values for AuditLogSource.
+const (
+	AuditLogSourceAPI  AuditLogSource = "API"
+	AuditLogSourceGRPC AuditLogSource = "GRPC"
+)
+
 // Defines values for ConcurrencyLimitStrategy.
 const (
-	CANCELINPROGRESS ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
-	DROPNEWEST       ConcurrencyLimitStrategy = "DROP_NEWEST"
-	GROUPROUNDROBIN  ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
-	QUEUENEWEST      ConcurrencyLimitStrategy = "QUEUE_NEWEST"
+	ConcurrencyLimitStrategyCANCELINPROGRESS ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
+	ConcurrencyLimitStrategyDROPNEWEST       ConcurrencyLimitStrategy = "DROP_NEWEST"
+	ConcurrencyLimitStrategyGROUPROUNDROBIN  ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
+	ConcurrencyLimitStrategyQUEUENEWEST      ConcurrencyLimitStrategy = "QUEUE_NEWEST"
 )
 
 // Defines values for CronWorkflowsMethod.
@@ -37,12 +59,27 @@ const (
 	CronWorkflowsMethodDEFAULT CronWorkflowsMethod = "DEFAULT"
 )
 
+// Defines values for CronWorkflowsMissedFirePolicy.
+const (
+	CATCHUP  CronWorkflowsMissedFirePolicy = "CATCH_UP"
+	FIREONCE CronWorkflowsMissedFirePolicy = "FIRE_ONCE"
+	SKIP     CronWorkflowsMissedFirePolicy = "SKIP"
+)
+
 // Defines values for CronWorkflowsOrderByField.
 const (
 	CronWorkflowsOrderByFieldCreatedAt CronWorkflowsOrderByField = "createdAt"
 	CronWorkflowsOrderByFieldName      CronWorkflowsOrderByField = "name"
 )
 
+// Defines values for CronWorkflowsOverlapPolicy.
+const (
+	ALLOW          CronWorkflowsOverlapPolicy = "ALLOW"
+	CANCELPREVIOUS CronWorkflowsOverlapPolicy = "CANCEL_PREVIOUS"
+	QUEUEONE       CronWorkflowsOverlapPolicy = "QUEUE_ONE"
+	SKIPIFRUNNING  CronWorkflowsOverlapPolicy = "SKIP_IF_RUNNING"
+)
+
 // Defines values for EventOrderByDirection.
 const (
 	EventOrderByDirectionAsc  EventOrderByDirection = "asc"
@@ -108,8 +145,8 @@ const (
 
 // Defines values for ScheduledWorkflowsMethod.
 const (
-	ScheduledWorkflowsMethodAPI     ScheduledWorkflowsMethod = "API"
-	ScheduledWorkflowsMethodDEFAULT ScheduledWorkflowsMethod = "DEFAULT"
+	API     ScheduledWorkflowsMethod = "API"
+	DEFAULT ScheduledWorkflowsMethod = "DEFAULT"
 )
 
 // Defines values for ScheduledWorkflowsOrderByField.
@@ -165,6 +202,23 @@ const (
 	OWNER  TenantMemberRole = "OWNER"
 )
 
+// Defines values for TenantPermission.
+const (
+	TenantPermissionEventsRead       TenantPermission = "events:read"
+	TenantPermissionEventsWrite      TenantPermission = "events:write"
+	TenantPermissionQueuesRead       TenantPermission = "queues:read"
+	TenantPermissionRunsCancel       TenantPermission = "runs:cancel"
+	TenantPermissionRunsRead         TenantPermission = "runs:read"
+	TenantPermissionRunsReplay       TenantPermission = "runs:replay"
+	TenantPermissionSettingsRead     TenantPermission = "settings:read"
+	TenantPermissionSettingsWrite    TenantPermission = "settings:write"
+	TenantPermissionWorkersRead      TenantPermission = "workers:read"
+	TenantPermissionWorkersWrite     TenantPermission = "workers:write"
+	TenantPermissionWorkflowsRead    TenantPermission = "workflows:read"
+	TenantPermissionWorkflowsTrigger TenantPermission = "workflows:trigger"
+	TenantPermissionWorkflowsWrite   TenantPermission = "workflows:write"
+)
+
 // Defines values for TenantResource.
 const (
 	CRON        TenantResource = "CRON"
@@ -174,6 +228,22 @@ const (
 	WORKFLOWRUN TenantResource = "WORKFLOW_RUN"
 )
 
+// Defines values for V2ConcurrencyStrategy.
+const (
+	V2ConcurrencyStrategyCANCELINPROGRESS   V2ConcurrencyStrategy = "CANCEL_IN_PROGRESS"
+	V2ConcurrencyStrategyCANCELNEWEST       V2ConcurrencyStrategy = "CANCEL_NEWEST"
+	V2ConcurrencyStrategyGROUPROUNDROBIN    V2ConcurrencyStrategy = "GROUP_ROUND_ROBIN"
+	V2ConcurrencyStrategyNONE               V2ConcurrencyStrategy = "NONE"
+	V2ConcurrencyStrategyWEIGHTEDROUNDROBIN V2ConcurrencyStrategy = "WEIGHTED_ROUND_ROBIN"
+)
+
+// Defines values for V2RateLimitAlgorithm.
+const (
+	FIXEDWINDOW   V2RateLimitAlgorithm = "FIXED_WINDOW"
+	SLIDINGWINDOW V2RateLimitAlgorithm = "SLIDING_WINDOW"
+	TOKENBUCKET   V2RateLimitAlgorithm = "TOKEN_BUCKET"
+)
+
 // Defines values for V2TaskEventType.
 const (
 	V2TaskEventTypeACKNOWLEDGED       V2TaskEventType = "ACKNOWLEDGED"
@@ -185,8 +255,10 @@ const (
 	V2TaskEventTypeQUEUED             V2TaskEventType = "QUEUED"
 	V2TaskEventTypeRATELIMITERROR     V2TaskEventType = "RATE_LIMIT_ERROR"
 	V2TaskEventTypeREASSIGNED         V2TaskEventType = "REASSIGNED"
+	V2TaskEventTypeREPLAYED           V2TaskEventType = "REPLAYED"
 	V2TaskEventTypeREQUEUEDNOWORKER   V2TaskEventType = "REQUEUED_NO_WORKER"
 	V2TaskEventTypeREQUEUEDRATELIMIT  V2TaskEventType = "REQUEUED_RATE_LIMIT"
+	V2TaskEventTypeRESETBYREPLAY      V2TaskEventType = "RESET_BY_REPLAY"
 	V2TaskEventTypeRETRIEDBYUSER      V2TaskEventType = "RETRIED_BY_USER"
 	V2TaskEventTypeRETRYING           V2TaskEventType = "RETRYING"
 	V2TaskEventTypeSCHEDULINGTIMEDOUT V2TaskEventType = "SCHEDULING_TIMED_OUT"
@@ -198,6 +270,20 @@ const (
 	V2TaskEventTypeTIMEOUTREFRESHED   V2TaskEventType = "TIMEOUT_REFRESHED"
 )
 
+// Defines values for V2TaskQueueReason.
+const (
+	ASSIGNABLE              V2TaskQueueReason = "ASSIGNABLE"
+	CONCURRENCYLIMITED      V2TaskQueueReason = "CONCURRENCY_LIMITED"
+	LABELSNOTMATCHED        V2TaskQueueReason = "LABELS_NOT_MATCHED"
+	NOAVAILABLESLOTS        V2TaskQueueReason = "NO_AVAILABLE_SLOTS"
+	NOTQUEUED               V2TaskQueueReason = "NOT_QUEUED"
+	NOWORKERFORACTION       V2TaskQueueReason = "NO_WORKER_FOR_ACTION"
+	RATELIMITED             V2TaskQueueReason = "RATE_LIMITED"
+	SCHEDULETIMEDOUT        V2TaskQueueReason = "SCHEDULE_TIMED_OUT"
+	STICKYWORKERUNAVAILABLE V2TaskQueueReason = "STICKY_WORKER_UNAVAILABLE"
+	WAITINGFORRETRY         V2TaskQueueReason = "WAITING_FOR_RETRY"
+)
+
 // Defines values for V2TaskStatus.
 const (
 	V2TaskStatusCANCELLED V2TaskStatus = "CANCELLED"
@@ -207,6 +293,31 @@ const (
 	V2TaskStatusRUNNING   V2TaskStatus = "RUNNING"
 )
 
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSUCCEEDED WebhookDeliveryStatus = "SUCCEEDED"
+)
+
+// Defines values for WebhookSubscriptionEventType.
+const (
+	TASKCANCELLED        WebhookSubscriptionEventType = "TASK_CANCELLED"
+	TASKCOMPLETED        WebhookSubscriptionEventType = "TASK_COMPLETED"
+	TASKFAILED           WebhookSubscriptionEventType = "TASK_FAILED"
+	TASKTIMEDOUT         WebhookSubscriptionEventType = "TASK_TIMED_OUT"
+	WORKFLOWRUNCANCELLED WebhookSubscriptionEventType = "WORKFLOW_RUN_CANCELLED"
+	WORKFLOWRUNCOMPLETED WebhookSubscriptionEventType = "WORKFLOW_RUN_COMPLETED"
+	WORKFLOWRUNFAILED    WebhookSubscriptionEventType = "WORKFLOW_RUN_FAILED"
+)
+
+// Defines values for WebhookSubscriptionRunStatus.
+const (
+	WebhookSubscriptionRunStatusCANCELLED WebhookSubscriptionRunStatus = "CANCELLED"
+	WebhookSubscriptionRunStatusCOMPLETED WebhookSubscriptionRunStatus = "COMPLETED"
+	WebhookSubscriptionRunStatusFAILED    WebhookSubscriptionRunStatus = "FAILED"
+)
+
 // Defines values for WorkerStatus.
 const (
 	ACTIVE   WorkerStatus = "ACTIVE"
@@ -343,13 +454,61 @@ type APIToken struct {
 
 	// Name The name of the API token.
 	Name string `json:"name"`
+
+	// Scopes The scopes granted to the API token.
+	Scopes []APITokenScope `json:"scopes"`
 }
 
+// APITokenScope defines model for APITokenScope.
+type APITokenScope string
+
 // AcceptInviteRequest defines model for AcceptInviteRequest.
 type AcceptInviteRequest struct {
 	Invite string `json:"invite" validate:"required,uuid"`
 }
 
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	// Action The action which was performed.
+	Action string `json:"action"`
+
+	// ActorId The id of the user or API token which performed the action.
+	ActorId   openapi_types.UUID `json:"actorId"`
+	ActorKind AuditLogActorKind  `json:"actorKind"`
+
+	// ActorName The email of the user or the name of the API token which performed the action.
+	ActorName *string `json:"actorName,omitempty"`
+
+	// IpAddress The IP address which the request was made from.
+	IpAddress *string         `json:"ipAddress,omitempty"`
+	Metadata  APIResourceMeta `json:"metadata"`
+
+	// RequestMetadata Additional metadata about the request.
+	RequestMetadata map[string]interface{} `json:"requestMetadata"`
+
+	// ResourceId The id of the resource which the action was performed on.
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// ResourceType The type of the resource which the action was performed on.
+	ResourceType string         `json:"resourceType"`
+	Source       AuditLogSource `json:"source"`
+
+	// UserAgent The user agent of the request.
+	UserAgent *string `json:"userAgent,omitempty"`
+}
+
+// AuditLogActorKind defines model for AuditLogActorKind.
+type AuditLogActorKind string
+
+// AuditLogList defines model for AuditLogList.
+type AuditLogList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]AuditLog         `json:"rows,omitempty"`
+}
+
+// AuditLogSource defines model for AuditLogSource.
+type AuditLogSource string
+
 // BulkCreateEventRequest defines model for BulkCreateEventRequest.
 type BulkCreateEventRequest struct {
 	Events []CreateEventRequest `json:"events"`
@@ -370,6 +529,9 @@ type CreateAPITokenRequest struct {
 
 	// Name A name for the API token.
 	Name string `json:"name"`
+
+	// Scopes The scopes to grant to the API token. If not set, the token has the admin scope.
+	Scopes *[]APITokenScope `json:"scopes,omitempty"`
 }
 
 // CreateAPITokenResponse defines model for CreateAPITokenResponse.
@@ -381,9 +543,19 @@ type CreateAPITokenResponse struct {
 // CreateCronWorkflowTriggerRequest defines model for CreateCronWorkflowTriggerRequest.
 type CreateCronWorkflowTriggerRequest struct {
 	AdditionalMetadata map[string]interface{} `json:"additionalMetadata"`
+	CatchUpLimit       *int                   `json:"catchUpLimit,omitempty"`
 	CronExpression     string                 `json:"cronExpression"`
 	CronName           string                 `json:"cronName"`
 	Input              map[string]interface{} `json:"input"`
+
+	// MissedFirePolicy How fires which were missed while the engine was unavailable are handled. SKIP drops missed fires, FIRE_ONCE runs the most recent missed fire and CATCH_UP runs up to catchUpLimit of the most recent missed fires.
+	MissedFirePolicy *CronWorkflowsMissedFirePolicy `json:"missedFirePolicy,omitempty"`
+
+	// OverlapPolicy How a fire is handled while the previous run of the cron is still running. ALLOW starts a new run, SKIP_IF_RUNNING drops the fire, CANCEL_PREVIOUS cancels the previous run and QUEUE_ONE starts a single run once the previous run finishes.
+	OverlapPolicy *CronWorkflowsOverlapPolicy `json:"overlapPolicy,omitempty"`
+
+	// Timezone The IANA timezone which the cron expression is evaluated in, for example America/New_York.
+	Timezone *string `json:"timezone,omitempty"`
 }
 
 // CreateEventRequest defines model for CreateEventRequest.
@@ -426,33 +598,126 @@ type CreateTenantRequest struct {
 	Slug string `json:"slug" validate:"required,hatchetName"`
 }
 
+// CreateTenantRoleRequest defines model for CreateTenantRoleRequest.
+type CreateTenantRoleRequest struct {
+	// Description A description of the role.
+	Description *string `json:"description,omitempty" validate:"omitnil,max=255"`
+
+	// Name The name of the role.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// Permissions The permissions granted by the role.
+	Permissions []TenantPermission `json:"permissions" validate:"required,min=1"`
+
+	// WorkflowIds The workflows which workflow and run permissions are restricted to. If omitted, the permissions apply to every workflow.
+	WorkflowIds *[]openapi_types.UUID `json:"workflowIds,omitempty"`
+}
+
+// CreateWebhookSubscriptionRequest defines model for CreateWebhookSubscriptionRequest.
+type CreateWebhookSubscriptionRequest struct {
+	// Enabled Whether events are delivered to the webhook subscription. Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// EventTypes The event types to deliver. If empty, every event type is delivered.
+	EventTypes *[]WebhookSubscriptionEventType `json:"eventTypes,omitempty"`
+
+	// Name The name of the webhook subscription.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// Secret The secret which deliveries are signed with. If not provided, a random secret will be generated.
+	Secret *string `json:"secret,omitempty" validate:"omitnil,min=32"`
+
+	// Statuses The run statuses to deliver events for. If empty, events for every status are delivered.
+	Statuses *[]WebhookSubscriptionRunStatus `json:"statuses,omitempty"`
+
+	// Url The https url which events are delivered to.
+	Url string `json:"url" validate:"required,url,startswith=https://"`
+
+	// WorkflowIds The workflows to deliver events for. If empty, events for every workflow are delivered.
+	WorkflowIds *[]openapi_types.UUID `json:"workflowIds,omitempty"`
+}
+
 // CronWorkflows defines model for CronWorkflows.
 type CronWorkflows struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
-	Cron               string                  `json:"cron"`
-	Enabled            bool                    `json:"enabled"`
-	Input              *map[string]interface{} `json:"input,omitempty"`
-	Metadata           APIResourceMeta         `json:"metadata"`
-	Method             CronWorkflowsMethod     `json:"method"`
-	Name               *string                 `json:"name,omitempty"`
-	TenantId           string                  `json:"tenantId"`
-	WorkflowId         string                  `json:"workflowId"`
-	WorkflowName       string                  `json:"workflowName"`
-	WorkflowVersionId  string                  `json:"workflowVersionId"`
+
+	// CatchUpLimit The maximum number of missed fires which are run with the CATCH_UP policy
+	CatchUpLimit int                     `json:"catchUpLimit"`
+	Cron         string                  `json:"cron"`
+	Enabled      bool                    `json:"enabled"`
+	Input        *map[string]interface{} `json:"input,omitempty"`
+	Metadata     APIResourceMeta         `json:"metadata"`
+	Method       CronWorkflowsMethod     `json:"method"`
+
+	// MissedFirePolicy How fires which were missed while the engine was unavailable are handled. SKIP drops missed fires, FIRE_ONCE runs the most recent missed fire and CATCH_UP runs up to catchUpLimit of the most recent missed fires.
+	MissedFirePolicy CronWorkflowsMissedFirePolicy `json:"missedFirePolicy"`
+	Name             *string                       `json:"name,omitempty"`
+
+	// NextFireAt The next time the cron is due to fire
+	NextFireAt *time.Time `json:"nextFireAt,omitempty"`
+
+	// OverlapPolicy How a fire is handled while the previous run of the cron is still running. ALLOW starts a new run, SKIP_IF_RUNNING drops the fire, CANCEL_PREVIOUS cancels the previous run and QUEUE_ONE starts a single run once the previous run finishes.
+	OverlapPolicy CronWorkflowsOverlapPolicy `json:"overlapPolicy"`
+	TenantId      string                     `json:"tenantId"`
+
+	// Timezone The IANA timezone which the cron expression is evaluated in, or UTC if unset
+	Timezone          *string `json:"timezone,omitempty"`
+	WorkflowId        string  `json:"workflowId"`
+	WorkflowName      string  `json:"workflowName"`
+	WorkflowVersionId string  `json:"workflowVersionId"`
 }
 
+// CronWorkflowsMethod defines model for CronWorkflows.Method.
+type CronWorkflowsMethod string
+
 // CronWorkflowsList defines model for CronWorkflowsList.
 type CronWorkflowsList struct {
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
 	Rows       *[]CronWorkflows    `json:"rows,omitempty"`
 }
 
-// CronWorkflowsMethod defines model for CronWorkflowsMethod.
-type CronWorkflowsMethod string
+// CronWorkflowsMissedFirePolicy How fires which were missed while the engine was unavailable are handled. SKIP drops missed fires, FIRE_ONCE runs the most recent missed fire and CATCH_UP runs up to catchUpLimit of the most recent missed fires.
+type CronWorkflowsMissedFirePolicy string
 
 // CronWorkflowsOrderByField defines model for CronWorkflowsOrderByField.
 type CronWorkflowsOrderByField string
 
+// CronWorkflowsOverlapPolicy How a fire is handled while the previous run of the cron is still running. ALLOW starts a new run, SKIP_IF_RUNNING drops the fire, CANCEL_PREVIOUS cancels the previous run and QUEUE_ONE starts a single run once the previous run finishes.
+type CronWorkflowsOverlapPolicy string
+
+// DeadLetter defines model for DeadLetter.
+type DeadLetter struct {
+	// CreatedAt When the message was moved to the dead letters.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Error The error returned by the last attempt to process the message.
+	Error *string `json:"error,omitempty"`
+
+	// Id The id of the dead letter.
+	Id int64 `json:"id"`
+
+	// MessageId The id of the message, which identifies the type of message.
+	MessageId string `json:"messageId"`
+
+	// PayloadPreview A preview of the message payloads, truncated to 1000 characters.
+	PayloadPreview string `json:"payloadPreview"`
+
+	// Queue The name of the message queue the message was read from.
+	Queue string `json:"queue"`
+
+	// RetryCount The number of times the message was retried.
+	RetryCount int `json:"retryCount"`
+
+	// TenantId The tenant of the message.
+	TenantId *openapi_types.UUID `json:"tenantId,omitempty"`
+}
+
+// DeadLetterList defines model for DeadLetterList.
+type DeadLetterList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]DeadLetter       `json:"rows,omitempty"`
+}
+
 // Event defines model for Event.
 type Event struct {
 	// AdditionalMetadata Additional metadata for the event.
@@ -619,6 +884,9 @@ type PaginationResponse struct {
 	// CurrentPage the current page
 	CurrentPage *int64 `json:"current_page,omitempty"`
 
+	// NextCursor an opaque cursor for the next page of results, only set for endpoints which support cursor pagination
+	NextCursor *string `json:"next_cursor,omitempty"`
+
 	// NextPage the next page
 	NextPage *int64 `json:"next_page,omitempty"`
 
@@ -626,6 +894,18 @@ type PaginationResponse struct {
 	NumPages *int64 `json:"num_pages,omitempty"`
 }
 
+// PurgeDeadLettersRequest defines model for PurgeDeadLettersRequest.
+type PurgeDeadLettersRequest struct {
+	// Ids The ids of the dead letters to purge. If empty, all dead letters on the queue are purged.
+	Ids *[]int64 `json:"ids,omitempty"`
+}
+
+// PurgeDeadLettersResponse defines model for PurgeDeadLettersResponse.
+type PurgeDeadLettersResponse struct {
+	// Count The number of purged dead letters.
+	Count int `json:"count"`
+}
+
 // QueueMetrics defines model for QueueMetrics.
 type QueueMetrics struct {
 	// NumPending The number of items pending.
@@ -688,6 +968,18 @@ type RejectInviteRequest struct {
 	Invite string `json:"invite" validate:"required,uuid"`
 }
 
+// ReplayDeadLettersRequest defines model for ReplayDeadLettersRequest.
+type ReplayDeadLettersRequest struct {
+	// Ids The ids of the dead letters to replay. If empty, the oldest dead letters on the queue are replayed, up to 1000.
+	Ids *[]int64 `json:"ids,omitempty"`
+}
+
+// ReplayDeadLettersResponse defines model for ReplayDeadLettersResponse.
+type ReplayDeadLettersResponse struct {
+	// Count The number of replayed dead letters.
+	Count int `json:"count"`
+}
+
 // ReplayEventRequest defines model for ReplayEventRequest.
 type ReplayEventRequest struct {
 	EventIds []openapi_types.UUID `json:"eventIds"`
@@ -976,10 +1268,12 @@ type TenantInviteList struct {
 
 // TenantMember defines model for TenantMember.
 type TenantMember struct {
-	Metadata APIResourceMeta  `json:"metadata"`
-	Role     TenantMemberRole `json:"role"`
-	Tenant   *Tenant          `json:"tenant,omitempty"`
-	User     UserTenantPublic `json:"user"`
+	// CustomRoleId The id of the custom role assigned to the member, which restricts the member to the permissions of the role.
+	CustomRoleId *openapi_types.UUID `json:"customRoleId,omitempty"`
+	Metadata     APIResourceMeta     `json:"metadata"`
+	Role         TenantMemberRole    `json:"role"`
+	Tenant       *Tenant             `json:"tenant,omitempty"`
+	User         UserTenantPublic    `json:"user"`
 }
 
 // TenantMemberList defines model for TenantMemberList.
@@ -991,6 +1285,9 @@ type TenantMemberList struct {
 // TenantMemberRole defines model for TenantMemberRole.
 type TenantMemberRole string
 
+// TenantPermission defines model for TenantPermission.
+type TenantPermission string
+
 // TenantQueueMetrics defines model for TenantQueueMetrics.
 type TenantQueueMetrics struct {
 	Queues   *map[string]int          `json:"queues,omitempty"`
@@ -1027,6 +1324,27 @@ type TenantResourcePolicy struct {
 	Limits []TenantResourceLimit `json:"limits"`
 }
 
+// TenantRole defines model for TenantRole.
+type TenantRole struct {
+	// Description A description of the role.
+	Description *string         `json:"description,omitempty"`
+	Metadata    APIResourceMeta `json:"metadata"`
+
+	// Name The name of the role.
+	Name string `json:"name"`
+
+	// Permissions The permissions granted by the role.
+	Permissions []TenantPermission `json:"permissions"`
+
+	// WorkflowIds The workflows which workflow and run permissions are restricted to. If empty, the permissions apply to every workflow.
+	WorkflowIds []openapi_types.UUID `json:"workflowIds"`
+}
+
+// TenantRoleList defines model for TenantRoleList.
+type TenantRoleList struct {
+	Rows *[]TenantRole `json:"rows,omitempty"`
+}
+
 // TenantStepRunQueueMetrics defines model for TenantStepRunQueueMetrics.
 type TenantStepRunQueueMetrics struct {
 	Queues *map[string]int `json:"queues,omitempty"`
@@ -1038,6 +1356,20 @@ type TriggerWorkflowRunRequest struct {
 	Input              map[string]interface{}  `json:"input"`
 }
 
+// UpdateCronWorkflowTriggerRequest defines model for UpdateCronWorkflowTriggerRequest.
+type UpdateCronWorkflowTriggerRequest struct {
+	CatchUpLimit *int `json:"catchUpLimit,omitempty"`
+
+	// MissedFirePolicy How fires which were missed while the engine was unavailable are handled. SKIP drops missed fires, FIRE_ONCE runs the most recent missed fire and CATCH_UP runs up to catchUpLimit of the most recent missed fires.
+	MissedFirePolicy *CronWorkflowsMissedFirePolicy `json:"missedFirePolicy,omitempty"`
+
+	// OverlapPolicy How a fire is handled while the previous run of the cron is still running. ALLOW starts a new run, SKIP_IF_RUNNING drops the fire, CANCEL_PREVIOUS cancels the previous run and QUEUE_ONE starts a single run once the previous run finishes.
+	OverlapPolicy *CronWorkflowsOverlapPolicy `json:"overlapPolicy,omitempty"`
+
+	// Timezone The IANA timezone which the cron expression is evaluated in, for example America/New_York. An empty string resets the timezone to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
 // UpdateTenantAlertEmailGroupRequest defines model for UpdateTenantAlertEmailGroupRequest.
 type UpdateTenantAlertEmailGroupRequest struct {
 	// Emails A list of emails for users
@@ -1049,6 +1381,12 @@ type UpdateTenantInviteRequest struct {
 	Role TenantMemberRole `json:"role"`
 }
 
+// UpdateTenantMemberRequest defines model for UpdateTenantMemberRequest.
+type UpdateTenantMemberRequest struct {
+	// CustomRoleId The id of the custom role to assign to the member. If omitted, the member's custom role is removed.
+	CustomRoleId *openapi_types.UUID `json:"customRoleId,omitempty"`
+}
+
 // UpdateTenantRequest defines model for UpdateTenantRequest.
 type UpdateTenantRequest struct {
 	// AlertMemberEmails Whether to alert tenant members.
@@ -1073,6 +1411,42 @@ type UpdateTenantRequest struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// UpdateTenantRoleRequest defines model for UpdateTenantRoleRequest.
+type UpdateTenantRoleRequest struct {
+	// Description A description of the role.
+	Description *string `json:"description,omitempty" validate:"omitnil,max=255"`
+
+	// Name The name of the role.
+	Name *string `json:"name,omitempty" validate:"omitnil,hatchetName"`
+
+	// Permissions The permissions granted by the role.
+	Permissions *[]TenantPermission `json:"permissions,omitempty" validate:"omitnil,min=1"`
+
+	// WorkflowIds The workflows which workflow and run permissions are restricted to. An empty list removes the restriction.
+	WorkflowIds *[]openapi_types.UUID `json:"workflowIds,omitempty"`
+}
+
+// UpdateWebhookSubscriptionRequest defines model for UpdateWebhookSubscriptionRequest.
+type UpdateWebhookSubscriptionRequest struct {
+	// Enabled Whether events are delivered to the webhook subscription.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// EventTypes The event types to deliver. An empty list delivers every event type.
+	EventTypes *[]WebhookSubscriptionEventType `json:"eventTypes,omitempty"`
+
+	// Name The name of the webhook subscription.
+	Name *string `json:"name,omitempty" validate:"omitnil,hatchetName"`
+
+	// Statuses The run statuses to deliver events for. An empty list delivers events for every status.
+	Statuses *[]WebhookSubscriptionRunStatus `json:"statuses,omitempty"`
+
+	// Url The https url which events are delivered to.
+	Url *string `json:"url,omitempty" validate:"omitnil,url,startswith=https://"`
+
+	// WorkflowIds The workflows to deliver events for. An empty list removes the restriction.
+	WorkflowIds *[]openapi_types.UUID `json:"workflowIds,omitempty"`
+}
+
 // UpdateWorkerRequest defines model for UpdateWorkerRequest.
 type UpdateWorkerRequest struct {
 	// IsPaused Whether the worker is paused and cannot accept new runs.
@@ -1143,12 +1517,182 @@ type UserTenantPublic struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// V2CancelTasksRequest Selects the tasks to cancel, either by external id or by a filter. Exactly one of externalIds and filter must be set. A filter can match at most 10000 tasks.
+type V2CancelTasksRequest struct {
+	// ExternalIds The external ids of the tasks to cancel. At most 1000 ids can be passed.
+	ExternalIds *[]openapi_types.UUID `json:"externalIds,omitempty"`
+	Filter      *V2TaskFilter         `json:"filter,omitempty"`
+}
+
+// V2CancelTasksResponse defines model for V2CancelTasksResponse.
+type V2CancelTasksResponse struct {
+	// ExternalIds The external ids of the tasks which were cancelled
+	ExternalIds []openapi_types.UUID `json:"externalIds"`
+}
+
+// V2CancelWorkflowRunsRequest Selects the workflow runs to cancel, either by external id or by a filter. Exactly one of externalIds and filter must be set. A filter can match at most 10000 workflow runs.
+type V2CancelWorkflowRunsRequest struct {
+	// ExternalIds The external ids of the workflow runs to cancel. At most 1000 ids can be passed.
+	ExternalIds *[]openapi_types.UUID `json:"externalIds,omitempty"`
+	Filter      *V2WorkflowRunFilter  `json:"filter,omitempty"`
+}
+
+// V2CancelWorkflowRunsResponse defines model for V2CancelWorkflowRunsResponse.
+type V2CancelWorkflowRunsResponse struct {
+	// ExternalIds The external ids of the workflow runs which were cancelled
+	ExternalIds []openapi_types.UUID `json:"externalIds"`
+}
+
+// V2ConcurrencyKeyUsage defines model for V2ConcurrencyKeyUsage.
+type V2ConcurrencyKeyUsage struct {
+	// Key The concurrency key.
+	Key string `json:"key"`
+
+	// Queued The number of tasks which are waiting for a concurrency slot for the key.
+	Queued int `json:"queued"`
+
+	// Running The number of tasks which have been assigned a concurrency slot for the key.
+	Running int `json:"running"`
+
+	// Saturated Whether the key has no free concurrency slots.
+	Saturated bool `json:"saturated"`
+}
+
+// V2ConcurrencyStrategy defines model for V2ConcurrencyStrategy.
+type V2ConcurrencyStrategy string
+
+// V2ConcurrencyStrategyUsage defines model for V2ConcurrencyStrategyUsage.
+type V2ConcurrencyStrategyUsage struct {
+	// Expression The CEL expression which evaluates to the concurrency key of a task.
+	Expression string `json:"expression"`
+
+	// Id The ID of the concurrency strategy.
+	Id int64 `json:"id"`
+
+	// KeyCount The number of distinct concurrency keys with running or queued tasks.
+	KeyCount int `json:"keyCount"`
+
+	// Keys The keys with the most queued tasks.
+	Keys []V2ConcurrencyKeyUsage `json:"keys"`
+
+	// MaxConcurrency The maximum number of tasks which can run concurrently for each key.
+	MaxConcurrency int `json:"maxConcurrency"`
+
+	// Queued The number of tasks which are waiting for a concurrency slot.
+	Queued int `json:"queued"`
+
+	// Running The number of tasks which have been assigned a concurrency slot.
+	Running           int                   `json:"running"`
+	StepId            openapi_types.UUID    `json:"stepId"`
+	StepReadableId    *string               `json:"stepReadableId,omitempty"`
+	Strategy          V2ConcurrencyStrategy `json:"strategy"`
+	WorkflowId        openapi_types.UUID    `json:"workflowId"`
+	WorkflowName      string                `json:"workflowName"`
+	WorkflowVersionId openapi_types.UUID    `json:"workflowVersionId"`
+}
+
+// V2ConcurrencyStrategyUsageList defines model for V2ConcurrencyStrategyUsageList.
+type V2ConcurrencyStrategyUsageList struct {
+	Rows []V2ConcurrencyStrategyUsage `json:"rows"`
+}
+
 // V2DagChildren defines model for V2DagChildren.
 type V2DagChildren struct {
 	Children *[]V2TaskSummary    `json:"children,omitempty"`
 	DagId    *openapi_types.UUID `json:"dagId,omitempty"`
 }
 
+// V2RateLimitAlgorithm defines model for V2RateLimitAlgorithm.
+type V2RateLimitAlgorithm string
+
+// V2RateLimitUsage defines model for V2RateLimitUsage.
+type V2RateLimitUsage struct {
+	Algorithm V2RateLimitAlgorithm `json:"algorithm"`
+
+	// Available The number of units which can currently be used.
+	Available int `json:"available"`
+
+	// Burst The maximum number of units which can accumulate in a token bucket.
+	Burst int `json:"burst"`
+
+	// Dynamic Whether the rate limit was created from a dynamic rate limit key.
+	Dynamic bool `json:"dynamic"`
+
+	// Key The key for the rate limit.
+	Key string `json:"key"`
+
+	// LastRefill The last time the rate limit was refilled.
+	LastRefill time.Time `json:"lastRefill"`
+
+	// LastUsedAt The last time that units of the rate limit were used.
+	LastUsedAt time.Time `json:"lastUsedAt"`
+
+	// LimitValue The number of units which are added to the rate limit in each window.
+	LimitValue int `json:"limitValue"`
+
+	// NextRefillAt The next time that units are added to the rate limit. Not set for token buckets which are full.
+	NextRefillAt *time.Time `json:"nextRefillAt,omitempty"`
+
+	// Window The window of time in which the limitValue is enforced.
+	Window string `json:"window"`
+}
+
+// V2RateLimitUsageList defines model for V2RateLimitUsageList.
+type V2RateLimitUsageList struct {
+	Pagination PaginationResponse `json:"pagination"`
+	Rows       []V2RateLimitUsage `json:"rows"`
+}
+
+// V2ReplayTasksRequest Selects the tasks to replay, either by external id or by a filter. Exactly one of externalIds and filter must be set. A filter can match at most 10000 tasks.
+type V2ReplayTasksRequest struct {
+	// ExternalIds The external ids of the tasks to replay. At most 1000 ids can be passed.
+	ExternalIds *[]openapi_types.UUID `json:"externalIds,omitempty"`
+	Filter      *V2TaskFilter         `json:"filter,omitempty"`
+}
+
+// V2ReplayTasksResponse defines model for V2ReplayTasksResponse.
+type V2ReplayTasksResponse struct {
+	// ExternalIds The external ids of the tasks which were replayed
+	ExternalIds []openapi_types.UUID `json:"externalIds"`
+}
+
+// V2ReplayWorkflowRunFromTaskRequest defines model for V2ReplayWorkflowRunFromTaskRequest.
+type V2ReplayWorkflowRunFromTaskRequest struct {
+	// TaskExternalId The external id of the task to replay the workflow run from
+	TaskExternalId openapi_types.UUID `json:"taskExternalId"`
+}
+
+// V2ReplayWorkflowRunFromTaskResponse defines model for V2ReplayWorkflowRunFromTaskResponse.
+type V2ReplayWorkflowRunFromTaskResponse struct {
+	// ExternalIds The external ids of the tasks which will run again, starting with the task which the workflow run was replayed from
+	ExternalIds []openapi_types.UUID `json:"externalIds"`
+}
+
+// V2ReplayWorkflowRunsRequest Selects the workflow runs to replay, either by external id or by a filter. Exactly one of externalIds and filter must be set. A filter can match at most 10000 workflow runs.
+type V2ReplayWorkflowRunsRequest struct {
+	// ExternalIds The external ids of the workflow runs to replay. At most 1000 ids can be passed.
+	ExternalIds *[]openapi_types.UUID `json:"externalIds,omitempty"`
+	Filter      *V2WorkflowRunFilter  `json:"filter,omitempty"`
+}
+
+// V2ReplayWorkflowRunsResponse defines model for V2ReplayWorkflowRunsResponse.
+type V2ReplayWorkflowRunsResponse struct {
+	// ExternalIds The external ids of the workflow runs which were replayed
+	ExternalIds []openapi_types.UUID `json:"externalIds"`
+
+	// Skipped The DAG workflow runs which could not be replayed
+	Skipped []V2SkippedWorkflowRun `json:"skipped"`
+}
+
+// V2SkippedWorkflowRun defines model for V2SkippedWorkflowRun.
+type V2SkippedWorkflowRun struct {
+	// ExternalId The external id of the workflow run
+	ExternalId openapi_types.UUID `json:"externalId"`
+
+	// Reason Why the workflow run could not be replayed
+	Reason string `json:"reason"`
+}
+
 // V2Task defines model for V2Task.
 type V2Task struct {
 	// AdditionalMetadata Additional metadata for the task run.
@@ -1213,6 +1757,42 @@ type V2TaskEventList struct {
 // V2TaskEventType defines model for V2TaskEventType.
 type V2TaskEventType string
 
+// V2TaskFilter defines model for V2TaskFilter.
+type V2TaskFilter struct {
+	// AdditionalMetadata Additional metadata k-v pairs to filter by, of the form `key:value`
+	AdditionalMetadata *[]string `json:"additionalMetadata,omitempty"`
+
+	// DisplayName A case-insensitive substring of the task display name to filter by
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// ErrorContains A case-insensitive substring of task error messages to filter by
+	ErrorContains *string `json:"errorContains,omitempty"`
+
+	// ErrorRegex A regular expression matched against task error messages to filter by. Only syntax shared by POSIX and RE2 regular expressions is supported, so flags, lookarounds, backreferences and escapes other than \d, \s, \w, \n, \r and \t are rejected.
+	ErrorRegex *string `json:"errorRegex,omitempty"`
+
+	// InputFilters Filters on the task input, of the form `path:value` where path is a dot-separated list of keys. All filters must match. Filters on the same or overlapping paths, such as `a:1` and `a.b:2`, are rejected.
+	InputFilters *[]string `json:"inputFilters,omitempty"`
+
+	// OutputFilters Filters on the task output, of the form `path:value` where path is a dot-separated list of keys. All filters must match. Filters on the same or overlapping paths, such as `a:1` and `a.b:2`, are rejected.
+	OutputFilters *[]string `json:"outputFilters,omitempty"`
+
+	// Since The earliest date to filter by
+	Since time.Time `json:"since"`
+
+	// Statuses A list of statuses to filter by
+	Statuses *[]V2TaskStatus `json:"statuses,omitempty"`
+
+	// Until The latest date to filter by
+	Until *time.Time `json:"until,omitempty"`
+
+	// WorkerId The worker id to filter by
+	WorkerId *openapi_types.UUID `json:"workerId,omitempty"`
+
+	// WorkflowIds The workflow ids to filter by
+	WorkflowIds *[]openapi_types.UUID `json:"workflowIds,omitempty"`
+}
+
 // V2TaskPointMetric defines model for V2TaskPointMetric.
 type V2TaskPointMetric struct {
 	FAILED    int       `json:"FAILED"`
@@ -1225,6 +1805,75 @@ type V2TaskPointMetrics struct {
 	Results *[]V2TaskPointMetric `json:"results,omitempty"`
 }
 
+// V2TaskQueueConcurrencyDiagnosis defines model for V2TaskQueueConcurrencyDiagnosis.
+type V2TaskQueueConcurrencyDiagnosis struct {
+	Expression string `json:"expression"`
+
+	// FilledSlots The number of filled concurrency slots for the key.
+	FilledSlots int `json:"filledSlots"`
+
+	// IsFilled Whether the task holds a concurrency slot.
+	IsFilled bool `json:"isFilled"`
+
+	// Key The concurrency key of the task.
+	Key            string                `json:"key"`
+	MaxConcurrency int                   `json:"maxConcurrency"`
+	Strategy       V2ConcurrencyStrategy `json:"strategy"`
+
+	// StrategyId The ID of the concurrency strategy.
+	StrategyId int64 `json:"strategyId"`
+}
+
+// V2TaskQueueDiagnosis defines model for V2TaskQueueDiagnosis.
+type V2TaskQueueDiagnosis struct {
+	Concurrency []V2TaskQueueConcurrencyDiagnosis `json:"concurrency"`
+	RateLimits  []V2TaskQueueRateLimitDiagnosis   `json:"rateLimits"`
+
+	// Reasons The reasons that the task hasn't been assigned to a worker.
+	Reasons []V2TaskQueueDiagnosisReason `json:"reasons"`
+	Workers []V2TaskQueueWorkerDiagnosis `json:"workers"`
+}
+
+// V2TaskQueueDiagnosisReason defines model for V2TaskQueueDiagnosisReason.
+type V2TaskQueueDiagnosisReason struct {
+	// Message A human-readable explanation of the reason.
+	Message string            `json:"message"`
+	Reason  V2TaskQueueReason `json:"reason"`
+}
+
+// V2TaskQueueRateLimitDiagnosis defines model for V2TaskQueueRateLimitDiagnosis.
+type V2TaskQueueRateLimitDiagnosis struct {
+	// Available The number of units which are currently available. Not set if the rate limit doesn't exist.
+	Available *int `json:"available,omitempty"`
+
+	// Exceeded Whether the rate limit is preventing the task from being assigned.
+	Exceeded bool   `json:"exceeded"`
+	Key      string `json:"key"`
+
+	// NextRefillAt The next time that units are added to the rate limit.
+	NextRefillAt *time.Time `json:"nextRefillAt,omitempty"`
+
+	// Units The number of units that the task consumes.
+	Units int `json:"units"`
+}
+
+// V2TaskQueueReason defines model for V2TaskQueueReason.
+type V2TaskQueueReason string
+
+// V2TaskQueueWorkerDiagnosis defines model for V2TaskQueueWorkerDiagnosis.
+type V2TaskQueueWorkerDiagnosis struct {
+	// AvailableSlots The number of free slots on the worker.
+	AvailableSlots int                `json:"availableSlots"`
+	ExcludedReason *V2TaskQueueReason `json:"excludedReason,omitempty"`
+
+	// HasAction Whether the worker has registered the task's action.
+	HasAction bool `json:"hasAction"`
+
+	// UnmatchedLabels The required affinity labels which the worker doesn't match.
+	UnmatchedLabels []string           `json:"unmatchedLabels"`
+	WorkerId        openapi_types.UUID `json:"workerId"`
+}
+
 // V2TaskRunMetric defines model for V2TaskRunMetric.
 type V2TaskRunMetric struct {
 	Count  int          `json:"count"`
@@ -1333,6 +1982,24 @@ type V2WorkflowRunDetails struct {
 	Tasks      []V2TaskSummary `json:"tasks"`
 }
 
+// V2WorkflowRunFilter defines model for V2WorkflowRunFilter.
+type V2WorkflowRunFilter struct {
+	// AdditionalMetadata Additional metadata k-v pairs to filter by, of the form `key:value`
+	AdditionalMetadata *[]string `json:"additionalMetadata,omitempty"`
+
+	// Since The earliest date to filter by
+	Since time.Time `json:"since"`
+
+	// Statuses A list of statuses to filter by
+	Statuses *[]V2TaskStatus `json:"statuses,omitempty"`
+
+	// Until The latest date to filter by
+	Until *time.Time `json:"until,omitempty"`
+
+	// WorkflowIds The workflow ids to filter by
+	WorkflowIds *[]openapi_types.UUID `json:"workflowIds,omitempty"`
+}
+
 // V2WorkflowRunList defines model for V2WorkflowRunList.
 type V2WorkflowRunList struct {
 	Pagination PaginationResponse `json:"pagination"`
@@ -1341,19 +2008,100 @@ type V2WorkflowRunList struct {
 	Rows []V2WorkflowRun `json:"rows"`
 }
 
-// WebhookWorker defines model for WebhookWorker.
-type WebhookWorker struct {
-	Metadata APIResourceMeta `json:"metadata"`
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts The attempts to deliver the event, in order.
+	Attempts  []WebhookDeliveryAttempt     `json:"attempts"`
+	EventType WebhookSubscriptionEventType `json:"eventType"`
+	Metadata  APIResourceMeta              `json:"metadata"`
 
-	// Name The name of the webhook worker.
-	Name string `json:"name"`
+	// NextAttemptAt When the delivery will next be attempted, if it is pending.
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
 
-	// Url The webhook url.
-	Url string `json:"url"`
+	// Payload The JSON body which is delivered.
+	Payload map[string]interface{} `json:"payload"`
+	Status  WebhookDeliveryStatus  `json:"status"`
 }
 
-// WebhookWorkerCreateRequest defines model for WebhookWorkerCreateRequest.
-type WebhookWorkerCreateRequest struct {
+// WebhookDeliveryAttempt defines model for WebhookDeliveryAttempt.
+type WebhookDeliveryAttempt struct {
+	// Attempt The number of the attempt, starting at 1.
+	Attempt int `json:"attempt"`
+
+	// CreatedAt When the attempt was made.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DurationMs How long the request took, in milliseconds.
+	DurationMs int `json:"durationMs"`
+
+	// Error The error which caused the attempt to fail.
+	Error *string `json:"error,omitempty"`
+
+	// ResponseBody The start of the response body.
+	ResponseBody *string `json:"responseBody,omitempty"`
+
+	// ResponseStatusCode The status code of the response, if a response was received.
+	ResponseStatusCode *int `json:"responseStatusCode,omitempty"`
+}
+
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]WebhookDelivery  `json:"rows,omitempty"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	// Enabled Whether events are delivered to the webhook subscription.
+	Enabled bool `json:"enabled"`
+
+	// EventTypes The event types which are delivered. If empty, every event type is delivered.
+	EventTypes []WebhookSubscriptionEventType `json:"eventTypes"`
+	Metadata   APIResourceMeta                `json:"metadata"`
+
+	// Name The name of the webhook subscription.
+	Name string `json:"name"`
+
+	// Secret The secret which deliveries are signed with. Only returned when the webhook subscription is created.
+	Secret *string `json:"secret,omitempty"`
+
+	// Statuses The run statuses which events are delivered for. If empty, events for every status are delivered.
+	Statuses []WebhookSubscriptionRunStatus `json:"statuses"`
+
+	// Url The https url which events are delivered to.
+	Url string `json:"url"`
+
+	// WorkflowIds The workflows which events are delivered for. If empty, events for every workflow are delivered.
+	WorkflowIds []openapi_types.UUID `json:"workflowIds"`
+}
+
+// WebhookSubscriptionEventType defines model for WebhookSubscriptionEventType.
+type WebhookSubscriptionEventType string
+
+// WebhookSubscriptionList defines model for WebhookSubscriptionList.
+type WebhookSubscriptionList struct {
+	Rows *[]WebhookSubscription `json:"rows,omitempty"`
+}
+
+// WebhookSubscriptionRunStatus defines model for WebhookSubscriptionRunStatus.
+type WebhookSubscriptionRunStatus string
+
+// WebhookWorker defines model for WebhookWorker.
+type WebhookWorker struct {
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// Name The name of the webhook worker.
+	Name string `json:"name"`
+
+	// Url The webhook url.
+	Url string `json:"url"`
+}
+
+// WebhookWorkerCreateRequest defines model for WebhookWorkerCreateRequest.
+type WebhookWorkerCreateRequest struct {
 	// Name The name of the webhook worker.
 	Name string `json:"name"`
 
@@ -1748,6 +2496,33 @@ type LogLineListParams struct {
 	OrderByDirection *LogLineOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
+// AuditLogListParams defines parameters for AuditLogList.
+type AuditLogListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Actions A list of actions to filter by
+	Actions *[]string `form:"actions,omitempty" json:"actions,omitempty"`
+
+	// ActorId The id of the user or API token to filter by
+	ActorId *openapi_types.UUID `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// ResourceType The resource type to filter by
+	ResourceType *string `form:"resourceType,omitempty" json:"resourceType,omitempty"`
+
+	// ResourceId The resource id to filter by
+	ResourceId *string `form:"resourceId,omitempty" json:"resourceId,omitempty"`
+
+	// Since Only return entries created at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return entries created at or before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
 // EventListParams defines parameters for EventList.
 type EventListParams struct {
 	// Offset The number to skip
@@ -1781,6 +2556,15 @@ type EventListParams struct {
 	EventIds *[]openapi_types.UUID `form:"eventIds,omitempty" json:"eventIds,omitempty"`
 }
 
+// MessageQueueDeadLetterListParams defines parameters for MessageQueueDeadLetterList.
+type MessageQueueDeadLetterListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // TenantGetQueueMetricsParams defines parameters for TenantGetQueueMetrics.
 type TenantGetQueueMetricsParams struct {
 	// Workflows A list of workflow IDs to filter by
@@ -1808,6 +2592,18 @@ type RateLimitListParams struct {
 	OrderByDirection *RateLimitOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
+// WebhookDeliveryListParams defines parameters for WebhookDeliveryList.
+type WebhookDeliveryListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Statuses A list of delivery statuses to filter by
+	Statuses *[]WebhookDeliveryStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
+}
+
 // WorkflowRunListStepRunEventsParams defines parameters for WorkflowRunListStepRunEvents.
 type WorkflowRunListStepRunEventsParams struct {
 	// LastId Last ID of the last event
@@ -1988,6 +2784,24 @@ type V2TaskEventListParams struct {
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// V2ConcurrencyListParams defines parameters for V2ConcurrencyList.
+type V2ConcurrencyListParams struct {
+	// KeyLimit The maximum number of keys to return for each strategy, defaults to 50
+	KeyLimit *int64 `form:"key_limit,omitempty" json:"key_limit,omitempty"`
+}
+
+// V2RateLimitListParams defines parameters for V2RateLimitList.
+type V2RateLimitListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Search A substring of the rate limit key to filter by
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+}
+
 // V2TaskListStatusMetricsParams defines parameters for V2TaskListStatusMetrics.
 type V2TaskListStatusMetricsParams struct {
 	// Since The start time to get metrics for
@@ -2014,6 +2828,9 @@ type V2TaskListParams struct {
 	// Limit The number to limit by
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 
+	// Cursor An opaque cursor returned by a previous request, used to fetch the next page of results. When set, offset is ignored and the total count is not computed.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// Statuses A list of task statuses to filter by
 	Statuses *[]V2TaskStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
 
@@ -2031,6 +2848,21 @@ type V2TaskListParams struct {
 
 	// WorkerId The worker id to filter by
 	WorkerId *openapi_types.UUID `form:"worker_id,omitempty" json:"worker_id,omitempty"`
+
+	// DisplayName A case-insensitive substring of the task display name to filter by
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty"`
+
+	// ErrorContains A case-insensitive substring of a task error message to filter by
+	ErrorContains *string `form:"error_contains,omitempty" json:"error_contains,omitempty"`
+
+	// ErrorRegex A regular expression matched against task error messages to filter by. Only syntax shared by POSIX and RE2 regular expressions is supported, so flags, lookarounds, backreferences and escapes other than \d, \s, \w, \n, \r and \t are rejected.
+	ErrorRegex *string `form:"error_regex,omitempty" json:"error_regex,omitempty"`
+
+	// InputFilters Filters on the task input, of the form `path:value` where path is a dot-separated list of keys. All filters must match. Filters on the same or overlapping paths, such as `a:1` and `a.b:2`, are rejected.
+	InputFilters *[]string `form:"input_filters,omitempty" json:"input_filters,omitempty"`
+
+	// OutputFilters Filters on the task output, of the form `path:value` where path is a dot-separated list of keys. All filters must match. Filters on the same or overlapping paths, such as `a:1` and `a.b:2`, are rejected.
+	OutputFilters *[]string `form:"output_filters,omitempty" json:"output_filters,omitempty"`
 }
 
 // V2WorkflowRunListParams defines parameters for V2WorkflowRunList.
@@ -2041,6 +2873,9 @@ type V2WorkflowRunListParams struct {
 	// Limit The number to limit by
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 
+	// Cursor An opaque cursor returned by a previous request, used to fetch the next page of results. When set, offset is ignored and the total count is not computed.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// Statuses A list of statuses to filter by
 	Statuses *[]V2TaskStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
 
@@ -2099,12 +2934,33 @@ type TenantInviteCreateJSONRequestBody = CreateTenantInviteRequest
 // TenantInviteUpdateJSONRequestBody defines body for TenantInviteUpdate for application/json ContentType.
 type TenantInviteUpdateJSONRequestBody = UpdateTenantInviteRequest
 
+// TenantMemberUpdateJSONRequestBody defines body for TenantMemberUpdate for application/json ContentType.
+type TenantMemberUpdateJSONRequestBody = UpdateTenantMemberRequest
+
+// MessageQueueDeadLetterPurgeJSONRequestBody defines body for MessageQueueDeadLetterPurge for application/json ContentType.
+type MessageQueueDeadLetterPurgeJSONRequestBody = PurgeDeadLettersRequest
+
+// MessageQueueDeadLetterReplayJSONRequestBody defines body for MessageQueueDeadLetterReplay for application/json ContentType.
+type MessageQueueDeadLetterReplayJSONRequestBody = ReplayDeadLettersRequest
+
+// TenantRoleCreateJSONRequestBody defines body for TenantRoleCreate for application/json ContentType.
+type TenantRoleCreateJSONRequestBody = CreateTenantRoleRequest
+
+// TenantRoleUpdateJSONRequestBody defines body for TenantRoleUpdate for application/json ContentType.
+type TenantRoleUpdateJSONRequestBody = UpdateTenantRoleRequest
+
 // SnsCreateJSONRequestBody defines body for SnsCreate for application/json ContentType.
 type SnsCreateJSONRequestBody = CreateSNSIntegrationRequest
 
 // StepRunUpdateRerunJSONRequestBody defines body for StepRunUpdateRerun for application/json ContentType.
 type StepRunUpdateRerunJSONRequestBody = RerunStepRunRequest
 
+// WebhookSubscriptionCreateJSONRequestBody defines body for WebhookSubscriptionCreate for application/json ContentType.
+type WebhookSubscriptionCreateJSONRequestBody = CreateWebhookSubscriptionRequest
+
+// WebhookSubscriptionUpdateJSONRequestBody defines body for WebhookSubscriptionUpdate for application/json ContentType.
+type WebhookSubscriptionUpdateJSONRequestBody = UpdateWebhookSubscriptionRequest
+
 // WebhookCreateJSONRequestBody defines body for WebhookCreate for application/json ContentType.
 type WebhookCreateJSONRequestBody = WebhookWorkerCreateRequest
 
@@ -2114,6 +2970,9 @@ type WorkflowRunUpdateReplayJSONRequestBody = ReplayWorkflowRunsRequest
 // WorkflowRunCancelJSONRequestBody defines body for WorkflowRunCancel for application/json ContentType.
 type WorkflowRunCancelJSONRequestBody = WorkflowRunsCancelRequest
 
+// WorkflowCronUpdateJSONRequestBody defines body for WorkflowCronUpdate for application/json ContentType.
+type WorkflowCronUpdateJSONRequestBody = UpdateCronWorkflowTriggerRequest
+
 // CronWorkflowTriggerCreateJSONRequestBody defines body for CronWorkflowTriggerCreate for application/json ContentType.
 type CronWorkflowTriggerCreateJSONRequestBody = CreateCronWorkflowTriggerRequest
 
@@ -2144,6 +3003,21 @@ type WorkflowUpdateJSONRequestBody = WorkflowUpdateRequest
 // WorkflowRunCreateJSONRequestBody defines body for WorkflowRunCreate for application/json ContentType.
 type WorkflowRunCreateJSONRequestBody = TriggerWorkflowRunRequest
 
+// V2TaskCancelJSONRequestBody defines body for V2TaskCancel for application/json ContentType.
+type V2TaskCancelJSONRequestBody = V2CancelTasksRequest
+
+// V2TaskReplayJSONRequestBody defines body for V2TaskReplay for application/json ContentType.
+type V2TaskReplayJSONRequestBody = V2ReplayTasksRequest
+
+// V2WorkflowRunCancelJSONRequestBody defines body for V2WorkflowRunCancel for application/json ContentType.
+type V2WorkflowRunCancelJSONRequestBody = V2CancelWorkflowRunsRequest
+
+// V2WorkflowRunReplayJSONRequestBody defines body for V2WorkflowRunReplay for application/json ContentType.
+type V2WorkflowRunReplayJSONRequestBody = V2ReplayWorkflowRunsRequest
+
+// V2WorkflowRunReplayFromTaskJSONRequestBody defines body for V2WorkflowRunReplayFromTask for application/json ContentType.
+type V2WorkflowRunReplayFromTaskJSONRequestBody = V2ReplayWorkflowRunFromTaskRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -2299,6 +3173,9 @@ type ClientInterface interface {
 
 	ApiTokenCreate(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AuditLogList request
+	AuditLogList(ctx context.Context, tenant openapi_types.UUID, params *AuditLogListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// EventList request
 	EventList(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2347,6 +3224,24 @@ type ClientInterface interface {
 	// TenantMemberDelete request
 	TenantMemberDelete(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// TenantMemberUpdateWithBody request with any body
+	TenantMemberUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TenantMemberUpdate(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, body TenantMemberUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MessageQueueDeadLetterList request
+	MessageQueueDeadLetterList(ctx context.Context, tenant openapi_types.UUID, messageQueue string, params *MessageQueueDeadLetterListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MessageQueueDeadLetterPurgeWithBody request with any body
+	MessageQueueDeadLetterPurgeWithBody(ctx context.Context, tenant openapi_types.UUID, messageQueue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MessageQueueDeadLetterPurge(ctx context.Context, tenant openapi_types.UUID, messageQueue string, body MessageQueueDeadLetterPurgeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MessageQueueDeadLetterReplayWithBody request with any body
+	MessageQueueDeadLetterReplayWithBody(ctx context.Context, tenant openapi_types.UUID, messageQueue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MessageQueueDeadLetterReplay(ctx context.Context, tenant openapi_types.UUID, messageQueue string, body MessageQueueDeadLetterReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// TenantGetQueueMetrics request
 	TenantGetQueueMetrics(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2356,6 +3251,22 @@ type ClientInterface interface {
 	// TenantResourcePolicyGet request
 	TenantResourcePolicyGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// TenantRoleList request
+	TenantRoleList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantRoleCreateWithBody request with any body
+	TenantRoleCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TenantRoleCreate(ctx context.Context, tenant openapi_types.UUID, body TenantRoleCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantRoleDelete request
+	TenantRoleDelete(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantRoleUpdateWithBody request with any body
+	TenantRoleUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TenantRoleUpdate(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, body TenantRoleUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SlackWebhookList request
 	SlackWebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2387,6 +3298,25 @@ type ClientInterface interface {
 	// StepRunGetSchema request
 	StepRunGetSchema(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WebhookSubscriptionList request
+	WebhookSubscriptionList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookSubscriptionCreateWithBody request with any body
+	WebhookSubscriptionCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WebhookSubscriptionCreate(ctx context.Context, tenant openapi_types.UUID, body WebhookSubscriptionCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookSubscriptionDelete request
+	WebhookSubscriptionDelete(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookSubscriptionUpdateWithBody request with any body
+	WebhookSubscriptionUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WebhookSubscriptionUpdate(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, body WebhookSubscriptionUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhookDeliveryList request
+	WebhookDeliveryList(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, params *WebhookDeliveryListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// WebhookList request
 	WebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2432,6 +3362,11 @@ type ClientInterface interface {
 	// WorkflowCronGet request
 	WorkflowCronGet(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WorkflowCronUpdateWithBody request with any body
+	WorkflowCronUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WorkflowCronUpdate(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, body WorkflowCronUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// WorkflowRunList request
 	WorkflowRunList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2499,6 +3434,12 @@ type ClientInterface interface {
 	// TenantMembershipsList request
 	TenantMembershipsList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UserUpdateOidcOauthCallback request
+	UserUpdateOidcOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserUpdateOidcOauthStart request
+	UserUpdateOidcOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UserUpdatePasswordWithBody request with any body
 	UserUpdatePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2557,9 +3498,18 @@ type ClientInterface interface {
 	// V2TaskGet request
 	V2TaskGet(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// V2TaskGetQueueDiagnosis request
+	V2TaskGetQueueDiagnosis(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// V2TaskEventList request
 	V2TaskEventList(ctx context.Context, task openapi_types.UUID, params *V2TaskEventListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// V2ConcurrencyList request
+	V2ConcurrencyList(ctx context.Context, tenant openapi_types.UUID, params *V2ConcurrencyListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V2RateLimitList request
+	V2RateLimitList(ctx context.Context, tenant openapi_types.UUID, params *V2RateLimitListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// V2TaskListStatusMetrics request
 	V2TaskListStatusMetrics(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListStatusMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2569,12 +3519,37 @@ type ClientInterface interface {
 	// V2TaskList request
 	V2TaskList(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// V2TaskCancelWithBody request with any body
+	V2TaskCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	V2TaskCancel(ctx context.Context, tenant openapi_types.UUID, body V2TaskCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V2TaskReplayWithBody request with any body
+	V2TaskReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	V2TaskReplay(ctx context.Context, tenant openapi_types.UUID, body V2TaskReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// V2WorkflowRunList request
 	V2WorkflowRunList(ctx context.Context, tenant openapi_types.UUID, params *V2WorkflowRunListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// V2WorkflowRunCancelWithBody request with any body
+	V2WorkflowRunCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	V2WorkflowRunCancel(ctx context.Context, tenant openapi_types.UUID, body V2WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V2WorkflowRunReplayWithBody request with any body
+	V2WorkflowRunReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	V2WorkflowRunReplay(ctx context.Context, tenant openapi_types.UUID, body V2WorkflowRunReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// V2WorkflowRunGet request
 	V2WorkflowRunGet(ctx context.Context, v2WorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// V2WorkflowRunReplayFromTaskWithBody request with any body
+	V2WorkflowRunReplayFromTaskWithBody(ctx context.Context, v2WorkflowRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	V2WorkflowRunReplayFromTask(ctx context.Context, v2WorkflowRun openapi_types.UUID, body V2WorkflowRunReplayFromTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// V2WorkflowRunTaskEventsList request
 	V2WorkflowRunTaskEventsList(ctx context.Context, v2WorkflowRun openapi_types.UUID, params *V2WorkflowRunTaskEventsListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -2927,6 +3902,18 @@ func (c *Client) ApiTokenCreate(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
+func (c *Client) AuditLogList(ctx context.Context, tenant openapi_types.UUID, params *AuditLogListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuditLogListRequest(c.Server, tenant, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) EventList(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewEventListRequest(c.Server, tenant, params)
 	if err != nil {
@@ -3143,8 +4130,8 @@ func (c *Client) TenantMemberDelete(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantGetQueueMetrics(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantGetQueueMetricsRequest(c.Server, tenant, params)
+func (c *Client) TenantMemberUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantMemberUpdateRequestWithBody(c.Server, tenant, member, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3155,8 +4142,8 @@ func (c *Client) TenantGetQueueMetrics(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) RateLimitList(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRateLimitListRequest(c.Server, tenant, params)
+func (c *Client) TenantMemberUpdate(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, body TenantMemberUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantMemberUpdateRequest(c.Server, tenant, member, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3167,8 +4154,8 @@ func (c *Client) RateLimitList(ctx context.Context, tenant openapi_types.UUID, p
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantResourcePolicyGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantResourcePolicyGetRequest(c.Server, tenant)
+func (c *Client) MessageQueueDeadLetterList(ctx context.Context, tenant openapi_types.UUID, messageQueue string, params *MessageQueueDeadLetterListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMessageQueueDeadLetterListRequest(c.Server, tenant, messageQueue, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3179,8 +4166,8 @@ func (c *Client) TenantResourcePolicyGet(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) SlackWebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlackWebhookListRequest(c.Server, tenant)
+func (c *Client) MessageQueueDeadLetterPurgeWithBody(ctx context.Context, tenant openapi_types.UUID, messageQueue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMessageQueueDeadLetterPurgeRequestWithBody(c.Server, tenant, messageQueue, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3191,8 +4178,8 @@ func (c *Client) SlackWebhookList(ctx context.Context, tenant openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateSlackOauthStart(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateSlackOauthStartRequest(c.Server, tenant)
+func (c *Client) MessageQueueDeadLetterPurge(ctx context.Context, tenant openapi_types.UUID, messageQueue string, body MessageQueueDeadLetterPurgeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMessageQueueDeadLetterPurgeRequest(c.Server, tenant, messageQueue, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3203,8 +4190,8 @@ func (c *Client) UserUpdateSlackOauthStart(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) SnsList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSnsListRequest(c.Server, tenant)
+func (c *Client) MessageQueueDeadLetterReplayWithBody(ctx context.Context, tenant openapi_types.UUID, messageQueue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMessageQueueDeadLetterReplayRequestWithBody(c.Server, tenant, messageQueue, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3215,8 +4202,8 @@ func (c *Client) SnsList(ctx context.Context, tenant openapi_types.UUID, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) SnsCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSnsCreateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) MessageQueueDeadLetterReplay(ctx context.Context, tenant openapi_types.UUID, messageQueue string, body MessageQueueDeadLetterReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMessageQueueDeadLetterReplayRequest(c.Server, tenant, messageQueue, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3227,8 +4214,8 @@ func (c *Client) SnsCreateWithBody(ctx context.Context, tenant openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) SnsCreate(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSnsCreateRequest(c.Server, tenant, body)
+func (c *Client) TenantGetQueueMetrics(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantGetQueueMetricsRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3239,8 +4226,8 @@ func (c *Client) SnsCreate(ctx context.Context, tenant openapi_types.UUID, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantGetStepRunQueueMetrics(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantGetStepRunQueueMetricsRequest(c.Server, tenant)
+func (c *Client) RateLimitList(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRateLimitListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3251,8 +4238,8 @@ func (c *Client) TenantGetStepRunQueueMetrics(ctx context.Context, tenant openap
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunGet(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunGetRequest(c.Server, tenant, stepRun)
+func (c *Client) TenantResourcePolicyGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantResourcePolicyGetRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3263,8 +4250,8 @@ func (c *Client) StepRunGet(ctx context.Context, tenant openapi_types.UUID, step
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunUpdateCancel(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunUpdateCancelRequest(c.Server, tenant, stepRun)
+func (c *Client) TenantRoleList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantRoleListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3275,8 +4262,8 @@ func (c *Client) StepRunUpdateCancel(ctx context.Context, tenant openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunUpdateRerunWithBody(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunUpdateRerunRequestWithBody(c.Server, tenant, stepRun, contentType, body)
+func (c *Client) TenantRoleCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantRoleCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3287,8 +4274,8 @@ func (c *Client) StepRunUpdateRerunWithBody(ctx context.Context, tenant openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunUpdateRerun(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunUpdateRerunRequest(c.Server, tenant, stepRun, body)
+func (c *Client) TenantRoleCreate(ctx context.Context, tenant openapi_types.UUID, body TenantRoleCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantRoleCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3299,8 +4286,8 @@ func (c *Client) StepRunUpdateRerun(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunGetSchema(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunGetSchemaRequest(c.Server, tenant, stepRun)
+func (c *Client) TenantRoleDelete(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantRoleDeleteRequest(c.Server, tenant, tenantRole)
 	if err != nil {
 		return nil, err
 	}
@@ -3311,8 +4298,8 @@ func (c *Client) StepRunGetSchema(ctx context.Context, tenant openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookListRequest(c.Server, tenant)
+func (c *Client) TenantRoleUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantRoleUpdateRequestWithBody(c.Server, tenant, tenantRole, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3323,8 +4310,8 @@ func (c *Client) WebhookList(ctx context.Context, tenant openapi_types.UUID, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookCreateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) TenantRoleUpdate(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, body TenantRoleUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantRoleUpdateRequest(c.Server, tenant, tenantRole, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3335,8 +4322,8 @@ func (c *Client) WebhookCreateWithBody(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookCreate(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookCreateRequest(c.Server, tenant, body)
+func (c *Client) SlackWebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSlackWebhookListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3347,8 +4334,8 @@ func (c *Client) WebhookCreate(ctx context.Context, tenant openapi_types.UUID, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerListRequest(c.Server, tenant)
+func (c *Client) UserUpdateSlackOauthStart(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateSlackOauthStartRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3359,8 +4346,8 @@ func (c *Client) WorkerList(ctx context.Context, tenant openapi_types.UUID, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunUpdateReplayRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) SnsList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnsListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3371,8 +4358,8 @@ func (c *Client) WorkflowRunUpdateReplayWithBody(ctx context.Context, tenant ope
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunUpdateReplayRequest(c.Server, tenant, body)
+func (c *Client) SnsCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnsCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3383,8 +4370,8 @@ func (c *Client) WorkflowRunUpdateReplay(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunGet(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunGetRequest(c.Server, tenant, workflowRun)
+func (c *Client) SnsCreate(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnsCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3395,8 +4382,8 @@ func (c *Client) WorkflowRunGet(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunGetInput(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunGetInputRequest(c.Server, tenant, workflowRun)
+func (c *Client) TenantGetStepRunQueueMetrics(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantGetStepRunQueueMetricsRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3407,8 +4394,8 @@ func (c *Client) WorkflowRunGetInput(ctx context.Context, tenant openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunGetShape(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunGetShapeRequest(c.Server, tenant, workflowRun)
+func (c *Client) StepRunGet(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunGetRequest(c.Server, tenant, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3419,8 +4406,8 @@ func (c *Client) WorkflowRunGetShape(ctx context.Context, tenant openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunListStepRunEvents(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunListStepRunEventsRequest(c.Server, tenant, workflowRun, params)
+func (c *Client) StepRunUpdateCancel(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunUpdateCancelRequest(c.Server, tenant, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3431,8 +4418,8 @@ func (c *Client) WorkflowRunListStepRunEvents(ctx context.Context, tenant openap
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowListRequest(c.Server, tenant, params)
+func (c *Client) StepRunUpdateRerunWithBody(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunUpdateRerunRequestWithBody(c.Server, tenant, stepRun, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3443,8 +4430,8 @@ func (c *Client) WorkflowList(ctx context.Context, tenant openapi_types.UUID, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunCancelRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) StepRunUpdateRerun(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunUpdateRerunRequest(c.Server, tenant, stepRun, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3455,8 +4442,8 @@ func (c *Client) WorkflowRunCancelWithBody(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunCancel(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunCancelRequest(c.Server, tenant, body)
+func (c *Client) StepRunGetSchema(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunGetSchemaRequest(c.Server, tenant, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3467,8 +4454,8 @@ func (c *Client) WorkflowRunCancel(ctx context.Context, tenant openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) CronWorkflowList(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCronWorkflowListRequest(c.Server, tenant, params)
+func (c *Client) WebhookSubscriptionList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3479,8 +4466,8 @@ func (c *Client) CronWorkflowList(ctx context.Context, tenant openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowCronDelete(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowCronDeleteRequest(c.Server, tenant, cronWorkflow)
+func (c *Client) WebhookSubscriptionCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3491,8 +4478,8 @@ func (c *Client) WorkflowCronDelete(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowCronGet(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowCronGetRequest(c.Server, tenant, cronWorkflow)
+func (c *Client) WebhookSubscriptionCreate(ctx context.Context, tenant openapi_types.UUID, body WebhookSubscriptionCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3503,8 +4490,8 @@ func (c *Client) WorkflowCronGet(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunListRequest(c.Server, tenant, params)
+func (c *Client) WebhookSubscriptionDelete(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionDeleteRequest(c.Server, tenant, webhookSubscription)
 	if err != nil {
 		return nil, err
 	}
@@ -3515,8 +4502,8 @@ func (c *Client) WorkflowRunList(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunGetMetrics(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunGetMetricsRequest(c.Server, tenant, params)
+func (c *Client) WebhookSubscriptionUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionUpdateRequestWithBody(c.Server, tenant, webhookSubscription, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3527,8 +4514,8 @@ func (c *Client) WorkflowRunGetMetrics(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowScheduledList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowScheduledListRequest(c.Server, tenant, params)
+func (c *Client) WebhookSubscriptionUpdate(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, body WebhookSubscriptionUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookSubscriptionUpdateRequest(c.Server, tenant, webhookSubscription, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3539,8 +4526,8 @@ func (c *Client) WorkflowScheduledList(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowScheduledDelete(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowScheduledDeleteRequest(c.Server, tenant, scheduledWorkflowRun)
+func (c *Client) WebhookDeliveryList(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, params *WebhookDeliveryListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookDeliveryListRequest(c.Server, tenant, webhookSubscription, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3551,8 +4538,8 @@ func (c *Client) WorkflowScheduledDelete(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowScheduledGet(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowScheduledGetRequest(c.Server, tenant, scheduledWorkflowRun)
+func (c *Client) WebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3563,8 +4550,8 @@ func (c *Client) WorkflowScheduledGet(ctx context.Context, tenant openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) CronWorkflowTriggerCreateWithBody(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCronWorkflowTriggerCreateRequestWithBody(c.Server, tenant, workflow, contentType, body)
+func (c *Client) WebhookCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3575,9 +4562,9 @@ func (c *Client) CronWorkflowTriggerCreateWithBody(ctx context.Context, tenant o
 	return c.Client.Do(req)
 }
 
-func (c *Client) CronWorkflowTriggerCreate(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCronWorkflowTriggerCreateRequest(c.Server, tenant, workflow, body)
-	if err != nil {
+func (c *Client) WebhookCreate(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookCreateRequest(c.Server, tenant, body)
+	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
@@ -3587,8 +4574,8 @@ func (c *Client) CronWorkflowTriggerCreate(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) ScheduledWorkflowRunCreateWithBody(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewScheduledWorkflowRunCreateRequestWithBody(c.Server, tenant, workflow, contentType, body)
+func (c *Client) WorkerList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3599,8 +4586,8 @@ func (c *Client) ScheduledWorkflowRunCreateWithBody(ctx context.Context, tenant
 	return c.Client.Do(req)
 }
 
-func (c *Client) ScheduledWorkflowRunCreate(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewScheduledWorkflowRunCreateRequest(c.Server, tenant, workflow, body)
+func (c *Client) WorkflowRunUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunUpdateReplayRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3611,8 +4598,8 @@ func (c *Client) ScheduledWorkflowRunCreate(ctx context.Context, tenant openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowGetWorkersCount(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowGetWorkersCountRequest(c.Server, tenant, workflow)
+func (c *Client) WorkflowRunUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunUpdateReplayRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3623,8 +4610,8 @@ func (c *Client) WorkflowGetWorkersCount(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserGetCurrent(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserGetCurrentRequest(c.Server)
+func (c *Client) WorkflowRunGet(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunGetRequest(c.Server, tenant, workflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3635,8 +4622,8 @@ func (c *Client) UserGetCurrent(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateGithubOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateGithubOauthCallbackRequest(c.Server)
+func (c *Client) WorkflowRunGetInput(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunGetInputRequest(c.Server, tenant, workflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3647,8 +4634,8 @@ func (c *Client) UserUpdateGithubOauthCallback(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateGithubOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateGithubOauthStartRequest(c.Server)
+func (c *Client) WorkflowRunGetShape(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunGetShapeRequest(c.Server, tenant, workflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3659,8 +4646,8 @@ func (c *Client) UserUpdateGithubOauthStart(ctx context.Context, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateGoogleOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateGoogleOauthCallbackRequest(c.Server)
+func (c *Client) WorkflowRunListStepRunEvents(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunListStepRunEventsRequest(c.Server, tenant, workflowRun, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3671,8 +4658,8 @@ func (c *Client) UserUpdateGoogleOauthCallback(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateGoogleOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateGoogleOauthStartRequest(c.Server)
+func (c *Client) WorkflowList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3683,8 +4670,8 @@ func (c *Client) UserUpdateGoogleOauthStart(ctx context.Context, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserListTenantInvites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserListTenantInvitesRequest(c.Server)
+func (c *Client) WorkflowRunCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunCancelRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3695,8 +4682,8 @@ func (c *Client) UserListTenantInvites(ctx context.Context, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteAcceptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteAcceptRequestWithBody(c.Server, contentType, body)
+func (c *Client) WorkflowRunCancel(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunCancelRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3707,8 +4694,8 @@ func (c *Client) TenantInviteAcceptWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteAccept(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteAcceptRequest(c.Server, body)
+func (c *Client) CronWorkflowList(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCronWorkflowListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3719,8 +4706,8 @@ func (c *Client) TenantInviteAccept(ctx context.Context, body TenantInviteAccept
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteRejectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteRejectRequestWithBody(c.Server, contentType, body)
+func (c *Client) WorkflowCronDelete(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowCronDeleteRequest(c.Server, tenant, cronWorkflow)
 	if err != nil {
 		return nil, err
 	}
@@ -3731,8 +4718,8 @@ func (c *Client) TenantInviteRejectWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteReject(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteRejectRequest(c.Server, body)
+func (c *Client) WorkflowCronGet(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowCronGetRequest(c.Server, tenant, cronWorkflow)
 	if err != nil {
 		return nil, err
 	}
@@ -3743,8 +4730,8 @@ func (c *Client) TenantInviteReject(ctx context.Context, body TenantInviteReject
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateLoginRequestWithBody(c.Server, contentType, body)
+func (c *Client) WorkflowCronUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowCronUpdateRequestWithBody(c.Server, tenant, cronWorkflow, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3755,8 +4742,8 @@ func (c *Client) UserUpdateLoginWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateLogin(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateLoginRequest(c.Server, body)
+func (c *Client) WorkflowCronUpdate(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, body WorkflowCronUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowCronUpdateRequest(c.Server, tenant, cronWorkflow, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3767,8 +4754,8 @@ func (c *Client) UserUpdateLogin(ctx context.Context, body UserUpdateLoginJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateLogoutRequest(c.Server)
+func (c *Client) WorkflowRunList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3779,8 +4766,8 @@ func (c *Client) UserUpdateLogout(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantMembershipsList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantMembershipsListRequest(c.Server)
+func (c *Client) WorkflowRunGetMetrics(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunGetMetricsRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3791,8 +4778,8 @@ func (c *Client) TenantMembershipsList(ctx context.Context, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdatePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdatePasswordRequestWithBody(c.Server, contentType, body)
+func (c *Client) WorkflowScheduledList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowScheduledListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3803,8 +4790,8 @@ func (c *Client) UserUpdatePasswordWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdatePassword(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdatePasswordRequest(c.Server, body)
+func (c *Client) WorkflowScheduledDelete(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowScheduledDeleteRequest(c.Server, tenant, scheduledWorkflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3815,8 +4802,8 @@ func (c *Client) UserUpdatePassword(ctx context.Context, body UserUpdatePassword
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCreateRequestWithBody(c.Server, contentType, body)
+func (c *Client) WorkflowScheduledGet(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowScheduledGetRequest(c.Server, tenant, scheduledWorkflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3827,8 +4814,8 @@ func (c *Client) UserCreateWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserCreate(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCreateRequest(c.Server, body)
+func (c *Client) CronWorkflowTriggerCreateWithBody(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCronWorkflowTriggerCreateRequestWithBody(c.Server, tenant, workflow, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3839,8 +4826,8 @@ func (c *Client) UserCreate(ctx context.Context, body UserCreateJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateSlackOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateSlackOauthCallbackRequest(c.Server)
+func (c *Client) CronWorkflowTriggerCreate(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCronWorkflowTriggerCreateRequest(c.Server, tenant, workflow, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3851,8 +4838,8 @@ func (c *Client) UserUpdateSlackOauthCallback(ctx context.Context, reqEditors ..
 	return c.Client.Do(req)
 }
 
-func (c *Client) InfoGetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewInfoGetVersionRequest(c.Server)
+func (c *Client) ScheduledWorkflowRunCreateWithBody(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScheduledWorkflowRunCreateRequestWithBody(c.Server, tenant, workflow, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3863,8 +4850,8 @@ func (c *Client) InfoGetVersion(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookDelete(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookDeleteRequest(c.Server, webhook)
+func (c *Client) ScheduledWorkflowRunCreate(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScheduledWorkflowRunCreateRequest(c.Server, tenant, workflow, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3875,8 +4862,8 @@ func (c *Client) WebhookDelete(ctx context.Context, webhook openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookRequestsList(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookRequestsListRequest(c.Server, webhook)
+func (c *Client) WorkflowGetWorkersCount(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowGetWorkersCountRequest(c.Server, tenant, workflow)
 	if err != nil {
 		return nil, err
 	}
@@ -3887,8 +4874,8 @@ func (c *Client) WebhookRequestsList(ctx context.Context, webhook openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerGet(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerGetRequest(c.Server, worker)
+func (c *Client) UserGetCurrent(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetCurrentRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -3899,8 +4886,8 @@ func (c *Client) WorkerGet(ctx context.Context, worker openapi_types.UUID, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerUpdateWithBody(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerUpdateRequestWithBody(c.Server, worker, contentType, body)
+func (c *Client) UserUpdateGithubOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateGithubOauthCallbackRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -3911,8 +4898,8 @@ func (c *Client) WorkerUpdateWithBody(ctx context.Context, worker openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerUpdate(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerUpdateRequest(c.Server, worker, body)
+func (c *Client) UserUpdateGithubOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateGithubOauthStartRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -3923,8 +4910,8 @@ func (c *Client) WorkerUpdate(ctx context.Context, worker openapi_types.UUID, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowDelete(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowDeleteRequest(c.Server, workflow)
+func (c *Client) UserUpdateGoogleOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateGoogleOauthCallbackRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -3935,8 +4922,8 @@ func (c *Client) WorkflowDelete(ctx context.Context, workflow openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowGet(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowGetRequest(c.Server, workflow)
+func (c *Client) UserUpdateGoogleOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateGoogleOauthStartRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -3947,8 +4934,8 @@ func (c *Client) WorkflowGet(ctx context.Context, workflow openapi_types.UUID, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowUpdateWithBody(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowUpdateRequestWithBody(c.Server, workflow, contentType, body)
+func (c *Client) UserListTenantInvites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListTenantInvitesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -3959,8 +4946,8 @@ func (c *Client) WorkflowUpdateWithBody(ctx context.Context, workflow openapi_ty
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowUpdate(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowUpdateRequest(c.Server, workflow, body)
+func (c *Client) TenantInviteAcceptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteAcceptRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3971,8 +4958,8 @@ func (c *Client) WorkflowUpdate(ctx context.Context, workflow openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowGetMetrics(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowGetMetricsRequest(c.Server, workflow, params)
+func (c *Client) TenantInviteAccept(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteAcceptRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3983,8 +4970,8 @@ func (c *Client) WorkflowGetMetrics(ctx context.Context, workflow openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunCreateWithBody(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunCreateRequestWithBody(c.Server, workflow, params, contentType, body)
+func (c *Client) TenantInviteRejectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteRejectRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3995,8 +4982,8 @@ func (c *Client) WorkflowRunCreateWithBody(ctx context.Context, workflow openapi
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunCreate(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunCreateRequest(c.Server, workflow, params, body)
+func (c *Client) TenantInviteReject(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteRejectRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -4007,8 +4994,8 @@ func (c *Client) WorkflowRunCreate(ctx context.Context, workflow openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowVersionGet(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowVersionGetRequest(c.Server, workflow, params)
+func (c *Client) UserUpdateLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateLoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -4019,8 +5006,8 @@ func (c *Client) WorkflowVersionGet(ctx context.Context, workflow openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) V2DagListTasks(ctx context.Context, params *V2DagListTasksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewV2DagListTasksRequest(c.Server, params)
+func (c *Client) UserUpdateLogin(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateLoginRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -4031,8 +5018,8 @@ func (c *Client) V2DagListTasks(ctx context.Context, params *V2DagListTasksParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) V2TaskGet(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewV2TaskGetRequest(c.Server, task)
+func (c *Client) UserUpdateLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateLogoutRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -4043,8 +5030,8 @@ func (c *Client) V2TaskGet(ctx context.Context, task openapi_types.UUID, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) V2TaskEventList(ctx context.Context, task openapi_types.UUID, params *V2TaskEventListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewV2TaskEventListRequest(c.Server, task, params)
+func (c *Client) TenantMembershipsList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantMembershipsListRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -4055,8 +5042,8 @@ func (c *Client) V2TaskEventList(ctx context.Context, task openapi_types.UUID, p
 	return c.Client.Do(req)
 }
 
-func (c *Client) V2TaskListStatusMetrics(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListStatusMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewV2TaskListStatusMetricsRequest(c.Server, tenant, params)
+func (c *Client) UserUpdateOidcOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateOidcOauthCallbackRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -4067,8 +5054,8 @@ func (c *Client) V2TaskListStatusMetrics(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) V2TaskGetPointMetrics(ctx context.Context, tenant openapi_types.UUID, params *V2TaskGetPointMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewV2TaskGetPointMetricsRequest(c.Server, tenant, params)
+func (c *Client) UserUpdateOidcOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateOidcOauthStartRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -4079,8 +5066,8 @@ func (c *Client) V2TaskGetPointMetrics(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) V2TaskList(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewV2TaskListRequest(c.Server, tenant, params)
+func (c *Client) UserUpdatePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdatePasswordRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -4091,8 +5078,8 @@ func (c *Client) V2TaskList(ctx context.Context, tenant openapi_types.UUID, para
 	return c.Client.Do(req)
 }
 
-func (c *Client) V2WorkflowRunList(ctx context.Context, tenant openapi_types.UUID, params *V2WorkflowRunListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewV2WorkflowRunListRequest(c.Server, tenant, params)
+func (c *Client) UserUpdatePassword(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdatePasswordRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -4103,8 +5090,8 @@ func (c *Client) V2WorkflowRunList(ctx context.Context, tenant openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) V2WorkflowRunGet(ctx context.Context, v2WorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewV2WorkflowRunGetRequest(c.Server, v2WorkflowRun)
+func (c *Client) UserCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -4115,8 +5102,8 @@ func (c *Client) V2WorkflowRunGet(ctx context.Context, v2WorkflowRun openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) V2WorkflowRunTaskEventsList(ctx context.Context, v2WorkflowRun openapi_types.UUID, params *V2WorkflowRunTaskEventsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewV2WorkflowRunTaskEventsListRequest(c.Server, v2WorkflowRun, params)
+func (c *Client) UserCreate(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -4127,375 +5114,487 @@ func (c *Client) V2WorkflowRunTaskEventsList(ctx context.Context, v2WorkflowRun
 	return c.Client.Do(req)
 }
 
-// NewLivenessGetRequest generates requests for LivenessGet
-func NewLivenessGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UserUpdateSlackOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateSlackOauthCallbackRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/live")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) InfoGetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInfoGetVersionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewReadinessGetRequest generates requests for ReadinessGet
-func NewReadinessGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) WebhookDelete(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookDeleteRequest(c.Server, webhook)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/ready")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WebhookRequestsList(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookRequestsListRequest(c.Server, webhook)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAlertEmailGroupDeleteRequest generates requests for AlertEmailGroupDelete
-func NewAlertEmailGroupDeleteRequest(server string, alertEmailGroup openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alert-email-group", runtime.ParamLocationPath, alertEmailGroup)
+func (c *Client) WorkerGet(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerGetRequest(c.Server, worker)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/alerting-email-groups/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkerUpdateWithBody(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerUpdateRequestWithBody(c.Server, worker, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAlertEmailGroupUpdateRequest calls the generic AlertEmailGroupUpdate builder with application/json body
-func NewAlertEmailGroupUpdateRequest(server string, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) WorkerUpdate(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerUpdateRequest(c.Server, worker, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAlertEmailGroupUpdateRequestWithBody(server, alertEmailGroup, "application/json", bodyReader)
-}
-
-// NewAlertEmailGroupUpdateRequestWithBody generates requests for AlertEmailGroupUpdate with any type of body
-func NewAlertEmailGroupUpdateRequestWithBody(server string, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alert-email-group", runtime.ParamLocationPath, alertEmailGroup)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) WorkflowDelete(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowDeleteRequest(c.Server, workflow)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/alerting-email-groups/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowGet(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowGetRequest(c.Server, workflow)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewApiTokenUpdateRevokeRequest generates requests for ApiTokenUpdateRevoke
-func NewApiTokenUpdateRevokeRequest(server string, apiToken openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "api-token", runtime.ParamLocationPath, apiToken)
+func (c *Client) WorkflowUpdateWithBody(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowUpdateRequestWithBody(c.Server, workflow, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) WorkflowUpdate(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowUpdateRequest(c.Server, workflow, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/api-tokens/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowGetMetrics(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowGetMetricsRequest(c.Server, workflow, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCloudMetadataGetRequest generates requests for CloudMetadataGet
-func NewCloudMetadataGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) WorkflowRunCreateWithBody(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunCreateRequestWithBody(c.Server, workflow, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/cloud/metadata")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowRunCreate(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunCreateRequest(c.Server, workflow, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewEventGetRequest generates requests for EventGet
-func NewEventGetRequest(server string, event openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
+func (c *Client) WorkflowVersionGet(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowVersionGetRequest(c.Server, workflow, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) V2DagListTasks(ctx context.Context, params *V2DagListTasksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2DagListTasksRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/events/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) V2TaskGet(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2TaskGetRequest(c.Server, task)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewEventDataGetRequest generates requests for EventDataGet
-func NewEventDataGetRequest(server string, event openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
+func (c *Client) V2TaskGetQueueDiagnosis(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2TaskGetQueueDiagnosisRequest(c.Server, task)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) V2TaskEventList(ctx context.Context, task openapi_types.UUID, params *V2TaskEventListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2TaskEventListRequest(c.Server, task, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/events/%s/data", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) V2ConcurrencyList(ctx context.Context, tenant openapi_types.UUID, params *V2ConcurrencyListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2ConcurrencyListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewMetadataGetRequest generates requests for MetadataGet
-func NewMetadataGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) V2RateLimitList(ctx context.Context, tenant openapi_types.UUID, params *V2RateLimitListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2RateLimitListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/meta")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) V2TaskListStatusMetrics(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListStatusMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2TaskListStatusMetricsRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewMetadataListIntegrationsRequest generates requests for MetadataListIntegrations
-func NewMetadataListIntegrationsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) V2TaskGetPointMetrics(ctx context.Context, tenant openapi_types.UUID, params *V2TaskGetPointMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2TaskGetPointMetricsRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/meta/integrations")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) V2TaskList(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2TaskListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewMonitoringPostRunProbeRequest generates requests for MonitoringPostRunProbe
-func NewMonitoringPostRunProbeRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+func (c *Client) V2TaskCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2TaskCancelRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) V2TaskCancel(ctx context.Context, tenant openapi_types.UUID, body V2TaskCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2TaskCancelRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/monitoring/%s/probe", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) V2TaskReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2TaskReplayRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) V2TaskReplay(ctx context.Context, tenant openapi_types.UUID, body V2TaskReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2TaskReplayRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) V2WorkflowRunList(ctx context.Context, tenant openapi_types.UUID, params *V2WorkflowRunListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2WorkflowRunListRequest(c.Server, tenant, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewSlackWebhookDeleteRequest generates requests for SlackWebhookDelete
-func NewSlackWebhookDeleteRequest(server string, slack openapi_types.UUID) (*http.Request, error) {
+func (c *Client) V2WorkflowRunCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2WorkflowRunCancelRequestWithBody(c.Server, tenant, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V2WorkflowRunCancel(ctx context.Context, tenant openapi_types.UUID, body V2WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2WorkflowRunCancelRequest(c.Server, tenant, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V2WorkflowRunReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2WorkflowRunReplayRequestWithBody(c.Server, tenant, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V2WorkflowRunReplay(ctx context.Context, tenant openapi_types.UUID, body V2WorkflowRunReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2WorkflowRunReplayRequest(c.Server, tenant, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V2WorkflowRunGet(ctx context.Context, v2WorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2WorkflowRunGetRequest(c.Server, v2WorkflowRun)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V2WorkflowRunReplayFromTaskWithBody(ctx context.Context, v2WorkflowRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2WorkflowRunReplayFromTaskRequestWithBody(c.Server, v2WorkflowRun, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V2WorkflowRunReplayFromTask(ctx context.Context, v2WorkflowRun openapi_types.UUID, body V2WorkflowRunReplayFromTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2WorkflowRunReplayFromTaskRequest(c.Server, v2WorkflowRun, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V2WorkflowRunTaskEventsList(ctx context.Context, v2WorkflowRun openapi_types.UUID, params *V2WorkflowRunTaskEventsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV2WorkflowRunTaskEventsListRequest(c.Server, v2WorkflowRun, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewLivenessGetRequest generates requests for LivenessGet
+func NewLivenessGetRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slack", runtime.ParamLocationPath, slack)
+	operationPath := fmt.Sprintf("/api/live")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewReadinessGetRequest generates requests for ReadinessGet
+func NewReadinessGetRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/slack/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/ready")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4505,7 +5604,7 @@ func NewSlackWebhookDeleteRequest(server string, slack openapi_types.UUID) (*htt
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4513,13 +5612,13 @@ func NewSlackWebhookDeleteRequest(server string, slack openapi_types.UUID) (*htt
 	return req, nil
 }
 
-// NewSnsDeleteRequest generates requests for SnsDelete
-func NewSnsDeleteRequest(server string, sns openapi_types.UUID) (*http.Request, error) {
+// NewAlertEmailGroupDeleteRequest generates requests for AlertEmailGroupDelete
+func NewAlertEmailGroupDeleteRequest(server string, alertEmailGroup openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sns", runtime.ParamLocationPath, sns)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alert-email-group", runtime.ParamLocationPath, alertEmailGroup)
 	if err != nil {
 		return nil, err
 	}
@@ -4529,7 +5628,7 @@ func NewSnsDeleteRequest(server string, sns openapi_types.UUID) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sns/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/alerting-email-groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4547,20 +5646,24 @@ func NewSnsDeleteRequest(server string, sns openapi_types.UUID) (*http.Request,
 	return req, nil
 }
 
-// NewSnsUpdateRequest generates requests for SnsUpdate
-func NewSnsUpdateRequest(server string, tenant openapi_types.UUID, event string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+// NewAlertEmailGroupUpdateRequest calls the generic AlertEmailGroupUpdate builder with application/json body
+func NewAlertEmailGroupUpdateRequest(server string, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAlertEmailGroupUpdateRequestWithBody(server, alertEmailGroup, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewAlertEmailGroupUpdateRequestWithBody generates requests for AlertEmailGroupUpdate with any type of body
+func NewAlertEmailGroupUpdateRequestWithBody(server string, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alert-email-group", runtime.ParamLocationPath, alertEmailGroup)
 	if err != nil {
 		return nil, err
 	}
@@ -4570,7 +5673,7 @@ func NewSnsUpdateRequest(server string, tenant openapi_types.UUID, event string)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/sns/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/alerting-email-groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4580,21 +5683,23 @@ func NewSnsUpdateRequest(server string, tenant openapi_types.UUID, event string)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewStepRunListArchivesRequest generates requests for StepRunListArchives
-func NewStepRunListArchivesRequest(server string, stepRun openapi_types.UUID, params *StepRunListArchivesParams) (*http.Request, error) {
+// NewApiTokenUpdateRevokeRequest generates requests for ApiTokenUpdateRevoke
+func NewApiTokenUpdateRevokeRequest(server string, apiToken openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "api-token", runtime.ParamLocationPath, apiToken)
 	if err != nil {
 		return nil, err
 	}
@@ -4604,7 +5709,7 @@ func NewStepRunListArchivesRequest(server string, stepRun openapi_types.UUID, pa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/archives", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/api-tokens/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4614,42 +5719,31 @@ func NewStepRunListArchivesRequest(server string, stepRun openapi_types.UUID, pa
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.Limit != nil {
+// NewCloudMetadataGetRequest generates requests for CloudMetadataGet
+func NewCloudMetadataGetRequest(server string) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/cloud/metadata")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -4660,13 +5754,13 @@ func NewStepRunListArchivesRequest(server string, stepRun openapi_types.UUID, pa
 	return req, nil
 }
 
-// NewStepRunListEventsRequest generates requests for StepRunListEvents
-func NewStepRunListEventsRequest(server string, stepRun openapi_types.UUID, params *StepRunListEventsParams) (*http.Request, error) {
+// NewEventGetRequest generates requests for EventGet
+func NewEventGetRequest(server string, event openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
 	if err != nil {
 		return nil, err
 	}
@@ -4676,7 +5770,7 @@ func NewStepRunListEventsRequest(server string, stepRun openapi_types.UUID, para
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/events", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/events/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4686,44 +5780,6 @@ func NewStepRunListEventsRequest(server string, stepRun openapi_types.UUID, para
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -4732,13 +5788,13 @@ func NewStepRunListEventsRequest(server string, stepRun openapi_types.UUID, para
 	return req, nil
 }
 
-// NewLogLineListRequest generates requests for LogLineList
-func NewLogLineListRequest(server string, stepRun openapi_types.UUID, params *LogLineListParams) (*http.Request, error) {
+// NewEventDataGetRequest generates requests for EventDataGet
+func NewEventDataGetRequest(server string, event openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
 	if err != nil {
 		return nil, err
 	}
@@ -4748,7 +5804,7 @@ func NewLogLineListRequest(server string, stepRun openapi_types.UUID, params *Lo
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/logs", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/events/%s/data", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4758,129 +5814,43 @@ func NewLogLineListRequest(server string, stepRun openapi_types.UUID, params *Lo
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Levels != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "levels", runtime.ParamLocationQuery, *params.Levels); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Search != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByField != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.OrderByDirection != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewMetadataGetRequest generates requests for MetadataGet
+func NewMetadataGetRequest(server string) (*http.Request, error) {
+	var err error
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	operationPath := fmt.Sprintf("/api/v1/meta")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewTenantCreateRequest calls the generic TenantCreate builder with application/json body
-func NewTenantCreateRequest(server string, body TenantCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTenantCreateRequestWithBody(server, "application/json", bodyReader)
+
+	return req, nil
 }
 
-// NewTenantCreateRequestWithBody generates requests for TenantCreate with any type of body
-func NewTenantCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewMetadataListIntegrationsRequest generates requests for MetadataListIntegrations
+func NewMetadataListIntegrationsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4888,7 +5858,7 @@ func NewTenantCreateRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants")
+	operationPath := fmt.Sprintf("/api/v1/meta/integrations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4898,29 +5868,16 @@ func NewTenantCreateRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewTenantUpdateRequest calls the generic TenantUpdate builder with application/json body
-func NewTenantUpdateRequest(server string, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTenantUpdateRequestWithBody(server, tenant, "application/json", bodyReader)
-}
-
-// NewTenantUpdateRequestWithBody generates requests for TenantUpdate with any type of body
-func NewTenantUpdateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewMonitoringPostRunProbeRequest generates requests for MonitoringPostRunProbe
+func NewMonitoringPostRunProbeRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4935,7 +5892,7 @@ func NewTenantUpdateRequestWithBody(server string, tenant openapi_types.UUID, co
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/monitoring/%s/probe", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4945,23 +5902,21 @@ func NewTenantUpdateRequestWithBody(server string, tenant openapi_types.UUID, co
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewAlertEmailGroupListRequest generates requests for AlertEmailGroupList
-func NewAlertEmailGroupListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewSlackWebhookDeleteRequest generates requests for SlackWebhookDelete
+func NewSlackWebhookDeleteRequest(server string, slack openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slack", runtime.ParamLocationPath, slack)
 	if err != nil {
 		return nil, err
 	}
@@ -4971,7 +5926,7 @@ func NewAlertEmailGroupListRequest(server string, tenant openapi_types.UUID) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-email-groups", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/slack/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4981,7 +5936,7 @@ func NewAlertEmailGroupListRequest(server string, tenant openapi_types.UUID) (*h
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4989,24 +5944,13 @@ func NewAlertEmailGroupListRequest(server string, tenant openapi_types.UUID) (*h
 	return req, nil
 }
 
-// NewAlertEmailGroupCreateRequest calls the generic AlertEmailGroupCreate builder with application/json body
-func NewAlertEmailGroupCreateRequest(server string, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAlertEmailGroupCreateRequestWithBody(server, tenant, "application/json", bodyReader)
-}
-
-// NewAlertEmailGroupCreateRequestWithBody generates requests for AlertEmailGroupCreate with any type of body
-func NewAlertEmailGroupCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewSnsDeleteRequest generates requests for SnsDelete
+func NewSnsDeleteRequest(server string, sns openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sns", runtime.ParamLocationPath, sns)
 	if err != nil {
 		return nil, err
 	}
@@ -5016,7 +5960,7 @@ func NewAlertEmailGroupCreateRequestWithBody(server string, tenant openapi_types
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-email-groups", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/sns/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5026,18 +5970,16 @@ func NewAlertEmailGroupCreateRequestWithBody(server string, tenant openapi_types
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewTenantAlertingSettingsGetRequest generates requests for TenantAlertingSettingsGet
-func NewTenantAlertingSettingsGetRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewSnsUpdateRequest generates requests for SnsUpdate
+func NewSnsUpdateRequest(server string, tenant openapi_types.UUID, event string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5047,12 +5989,19 @@ func NewTenantAlertingSettingsGetRequest(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting/settings", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/sns/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5062,7 +6011,7 @@ func NewTenantAlertingSettingsGetRequest(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5070,13 +6019,13 @@ func NewTenantAlertingSettingsGetRequest(server string, tenant openapi_types.UUI
 	return req, nil
 }
 
-// NewApiTokenListRequest generates requests for ApiTokenList
-func NewApiTokenListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewStepRunListArchivesRequest generates requests for StepRunListArchives
+func NewStepRunListArchivesRequest(server string, stepRun openapi_types.UUID, params *StepRunListArchivesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -5086,7 +6035,7 @@ func NewApiTokenListRequest(server string, tenant openapi_types.UUID) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/api-tokens", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/archives", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5096,68 +6045,59 @@ func NewApiTokenListRequest(server string, tenant openapi_types.UUID) (*http.Req
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// NewApiTokenCreateRequest calls the generic ApiTokenCreate builder with application/json body
-func NewApiTokenCreateRequest(server string, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewApiTokenCreateRequestWithBody(server, tenant, "application/json", bodyReader)
-}
+		if params.Offset != nil {
 
-// NewApiTokenCreateRequestWithBody generates requests for ApiTokenCreate with any type of body
-func NewApiTokenCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/api-tokens", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewEventListRequest generates requests for EventList
-func NewEventListRequest(server string, tenant openapi_types.UUID, params *EventListParams) (*http.Request, error) {
+// NewStepRunListEventsRequest generates requests for StepRunListEvents
+func NewStepRunListEventsRequest(server string, stepRun openapi_types.UUID, params *StepRunListEventsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -5167,7 +6107,7 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5212,41 +6152,49 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.Keys != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keys", runtime.ParamLocationQuery, *params.Keys); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.Workflows != nil {
+// NewLogLineListRequest generates requests for LogLineList
+func NewLogLineListRequest(server string, stepRun openapi_types.UUID, params *LogLineListParams) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflows", runtime.ParamLocationQuery, *params.Workflows); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Statuses != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5260,9 +6208,9 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.Search != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5276,9 +6224,9 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.OrderByField != nil {
+		if params.Levels != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "levels", runtime.ParamLocationQuery, *params.Levels); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5292,9 +6240,9 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.OrderByDirection != nil {
+		if params.Search != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5308,9 +6256,9 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.AdditionalMetadata != nil {
+		if params.OrderByField != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5324,9 +6272,9 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 
 		}
 
-		if params.EventIds != nil {
+		if params.OrderByDirection != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventIds", runtime.ParamLocationQuery, *params.EventIds); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5351,34 +6299,27 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 	return req, nil
 }
 
-// NewEventCreateRequest calls the generic EventCreate builder with application/json body
-func NewEventCreateRequest(server string, tenant openapi_types.UUID, body EventCreateJSONRequestBody) (*http.Request, error) {
+// NewTenantCreateRequest calls the generic TenantCreate builder with application/json body
+func NewTenantCreateRequest(server string, body TenantCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewEventCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewTenantCreateRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewEventCreateRequestWithBody generates requests for EventCreate with any type of body
-func NewEventCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewTenantCreateRequestWithBody generates requests for TenantCreate with any type of body
+func NewTenantCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5398,19 +6339,19 @@ func NewEventCreateRequestWithBody(server string, tenant openapi_types.UUID, con
 	return req, nil
 }
 
-// NewEventCreateBulkRequest calls the generic EventCreateBulk builder with application/json body
-func NewEventCreateBulkRequest(server string, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody) (*http.Request, error) {
+// NewTenantUpdateRequest calls the generic TenantUpdate builder with application/json body
+func NewTenantUpdateRequest(server string, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewEventCreateBulkRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewTenantUpdateRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewEventCreateBulkRequestWithBody generates requests for EventCreateBulk with any type of body
-func NewEventCreateBulkRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewTenantUpdateRequestWithBody generates requests for TenantUpdate with any type of body
+func NewTenantUpdateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5425,7 +6366,7 @@ func NewEventCreateBulkRequestWithBody(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/bulk", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5435,7 +6376,7 @@ func NewEventCreateBulkRequestWithBody(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -5445,19 +6386,8 @@ func NewEventCreateBulkRequestWithBody(server string, tenant openapi_types.UUID,
 	return req, nil
 }
 
-// NewEventUpdateCancelRequest calls the generic EventUpdateCancel builder with application/json body
-func NewEventUpdateCancelRequest(server string, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewEventUpdateCancelRequestWithBody(server, tenant, "application/json", bodyReader)
-}
-
-// NewEventUpdateCancelRequestWithBody generates requests for EventUpdateCancel with any type of body
-func NewEventUpdateCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewAlertEmailGroupListRequest generates requests for AlertEmailGroupList
+func NewAlertEmailGroupListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5472,7 +6402,7 @@ func NewEventUpdateCancelRequestWithBody(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/cancel", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-email-groups", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5482,18 +6412,27 @@ func NewEventUpdateCancelRequestWithBody(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewEventKeyListRequest generates requests for EventKeyList
-func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewAlertEmailGroupCreateRequest calls the generic AlertEmailGroupCreate builder with application/json body
+func NewAlertEmailGroupCreateRequest(server string, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAlertEmailGroupCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewAlertEmailGroupCreateRequestWithBody generates requests for AlertEmailGroupCreate with any type of body
+func NewAlertEmailGroupCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5508,7 +6447,7 @@ func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/keys", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-email-groups", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5518,27 +6457,18 @@ func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Req
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewEventUpdateReplayRequest calls the generic EventUpdateReplay builder with application/json body
-func NewEventUpdateReplayRequest(server string, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewEventUpdateReplayRequestWithBody(server, tenant, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewEventUpdateReplayRequestWithBody generates requests for EventUpdateReplay with any type of body
-func NewEventUpdateReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewTenantAlertingSettingsGetRequest generates requests for TenantAlertingSettingsGet
+func NewTenantAlertingSettingsGetRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5553,7 +6483,7 @@ func NewEventUpdateReplayRequestWithBody(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/replay", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting/settings", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5563,18 +6493,16 @@ func NewEventUpdateReplayRequestWithBody(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewTenantInviteListRequest generates requests for TenantInviteList
-func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewApiTokenListRequest generates requests for ApiTokenList
+func NewApiTokenListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5589,7 +6517,7 @@ func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/api-tokens", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5607,19 +6535,19 @@ func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http
 	return req, nil
 }
 
-// NewTenantInviteCreateRequest calls the generic TenantInviteCreate builder with application/json body
-func NewTenantInviteCreateRequest(server string, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody) (*http.Request, error) {
+// NewApiTokenCreateRequest calls the generic ApiTokenCreate builder with application/json body
+func NewApiTokenCreateRequest(server string, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewApiTokenCreateRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewTenantInviteCreateRequestWithBody generates requests for TenantInviteCreate with any type of body
-func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewApiTokenCreateRequestWithBody generates requests for ApiTokenCreate with any type of body
+func NewApiTokenCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5634,7 +6562,7 @@ func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/api-tokens", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5654,178 +6582,8 @@ func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UU
 	return req, nil
 }
 
-// NewTenantInviteDeleteRequest generates requests for TenantInviteDelete
-func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewTenantInviteUpdateRequest calls the generic TenantInviteUpdate builder with application/json body
-func NewTenantInviteUpdateRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteUpdateRequestWithBody(server, tenant, tenantInvite, "application/json", bodyReader)
-}
-
-// NewTenantInviteUpdateRequestWithBody generates requests for TenantInviteUpdate with any type of body
-func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewTenantMemberListRequest generates requests for TenantMemberList
-func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewTenantMemberDeleteRequest generates requests for TenantMemberDelete
-func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, member openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "member", runtime.ParamLocationPath, member)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewTenantGetQueueMetricsRequest generates requests for TenantGetQueueMetrics
-func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams) (*http.Request, error) {
+// NewAuditLogListRequest generates requests for AuditLogList
+func NewAuditLogListRequest(server string, tenant openapi_types.UUID, params *AuditLogListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5840,7 +6598,7 @@ func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/queue-metrics", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/audit-logs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5853,9 +6611,9 @@ func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, p
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Workflows != nil {
+		if params.Offset != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflows", runtime.ParamLocationQuery, *params.Workflows); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5869,9 +6627,9 @@ func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, p
 
 		}
 
-		if params.AdditionalMetadata != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5885,49 +6643,9 @@ func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, p
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewRateLimitListRequest generates requests for RateLimitList
-func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *RateLimitListParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/rate-limits", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
+		if params.Actions != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actions", runtime.ParamLocationQuery, *params.Actions); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5941,9 +6659,9 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 
 		}
 
-		if params.Limit != nil {
+		if params.ActorId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actorId", runtime.ParamLocationQuery, *params.ActorId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5957,9 +6675,9 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 
 		}
 
-		if params.Search != nil {
+		if params.ResourceType != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceType", runtime.ParamLocationQuery, *params.ResourceType); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5973,9 +6691,9 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 
 		}
 
-		if params.OrderByField != nil {
+		if params.ResourceId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceId", runtime.ParamLocationQuery, *params.ResourceId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -5989,9 +6707,9 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 
 		}
 
-		if params.OrderByDirection != nil {
+		if params.Since != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6005,19 +6723,35 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		if params.Until != nil {
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-// NewTenantResourcePolicyGetRequest generates requests for TenantResourcePolicyGet
-func NewTenantResourcePolicyGetRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewEventListRequest generates requests for EventList
+func NewEventListRequest(server string, tenant openapi_types.UUID, params *EventListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6032,7 +6766,7 @@ func NewTenantResourcePolicyGetRequest(server string, tenant openapi_types.UUID)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/resource-policy", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6042,6 +6776,172 @@ func NewTenantResourcePolicyGetRequest(server string, tenant openapi_types.UUID)
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Keys != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keys", runtime.ParamLocationQuery, *params.Keys); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Workflows != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflows", runtime.ParamLocationQuery, *params.Workflows); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Statuses != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByField != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AdditionalMetadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EventIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventIds", runtime.ParamLocationQuery, *params.EventIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -6050,8 +6950,19 @@ func NewTenantResourcePolicyGetRequest(server string, tenant openapi_types.UUID)
 	return req, nil
 }
 
-// NewSlackWebhookListRequest generates requests for SlackWebhookList
-func NewSlackWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewEventCreateRequest calls the generic EventCreate builder with application/json body
+func NewEventCreateRequest(server string, tenant openapi_types.UUID, body EventCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEventCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewEventCreateRequestWithBody generates requests for EventCreate with any type of body
+func NewEventCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6066,7 +6977,7 @@ func NewSlackWebhookListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/slack", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6076,16 +6987,29 @@ func NewSlackWebhookListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewUserUpdateSlackOauthStartRequest generates requests for UserUpdateSlackOauthStart
-func NewUserUpdateSlackOauthStartRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewEventCreateBulkRequest calls the generic EventCreateBulk builder with application/json body
+func NewEventCreateBulkRequest(server string, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEventCreateBulkRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewEventCreateBulkRequestWithBody generates requests for EventCreateBulk with any type of body
+func NewEventCreateBulkRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6100,7 +7024,7 @@ func NewUserUpdateSlackOauthStartRequest(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/slack/start", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/bulk", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6110,16 +7034,29 @@ func NewUserUpdateSlackOauthStartRequest(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewSnsListRequest generates requests for SnsList
-func NewSnsListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewEventUpdateCancelRequest calls the generic EventUpdateCancel builder with application/json body
+func NewEventUpdateCancelRequest(server string, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEventUpdateCancelRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewEventUpdateCancelRequestWithBody generates requests for EventUpdateCancel with any type of body
+func NewEventUpdateCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6134,7 +7071,7 @@ func NewSnsListRequest(server string, tenant openapi_types.UUID) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sns", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/cancel", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6144,27 +7081,63 @@ func NewSnsListRequest(server string, tenant openapi_types.UUID) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewSnsCreateRequest calls the generic SnsCreate builder with application/json body
-func NewSnsCreateRequest(server string, tenant openapi_types.UUID, body SnsCreateJSONRequestBody) (*http.Request, error) {
+// NewEventKeyListRequest generates requests for EventKeyList
+func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/keys", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEventUpdateReplayRequest calls the generic EventUpdateReplay builder with application/json body
+func NewEventUpdateReplayRequest(server string, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSnsCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewEventUpdateReplayRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewSnsCreateRequestWithBody generates requests for SnsCreate with any type of body
-func NewSnsCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventUpdateReplayRequestWithBody generates requests for EventUpdateReplay with any type of body
+func NewEventUpdateReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6179,7 +7152,7 @@ func NewSnsCreateRequestWithBody(server string, tenant openapi_types.UUID, conte
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sns", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/replay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6199,8 +7172,8 @@ func NewSnsCreateRequestWithBody(server string, tenant openapi_types.UUID, conte
 	return req, nil
 }
 
-// NewTenantGetStepRunQueueMetricsRequest generates requests for TenantGetStepRunQueueMetrics
-func NewTenantGetStepRunQueueMetricsRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewTenantInviteListRequest generates requests for TenantInviteList
+func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6215,7 +7188,7 @@ func NewTenantGetStepRunQueueMetricsRequest(server string, tenant openapi_types.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-run-queue-metrics", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6233,20 +7206,24 @@ func NewTenantGetStepRunQueueMetricsRequest(server string, tenant openapi_types.
 	return req, nil
 }
 
-// NewStepRunGetRequest generates requests for StepRunGet
-func NewStepRunGetRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+// NewTenantInviteCreateRequest calls the generic TenantInviteCreate builder with application/json body
+func NewTenantInviteCreateRequest(server string, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantInviteCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewTenantInviteCreateRequestWithBody generates requests for TenantInviteCreate with any type of body
+func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -6256,7 +7233,7 @@ func NewStepRunGetRequest(server string, tenant openapi_types.UUID, stepRun open
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6266,16 +7243,18 @@ func NewStepRunGetRequest(server string, tenant openapi_types.UUID, stepRun open
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewStepRunUpdateCancelRequest generates requests for StepRunUpdateCancel
-func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
+// NewTenantInviteDeleteRequest generates requests for TenantInviteDelete
+func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6287,7 +7266,7 @@ func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, ste
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
 	if err != nil {
 		return nil, err
 	}
@@ -6297,7 +7276,7 @@ func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, ste
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/cancel", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6307,7 +7286,7 @@ func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, ste
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6315,19 +7294,19 @@ func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, ste
 	return req, nil
 }
 
-// NewStepRunUpdateRerunRequest calls the generic StepRunUpdateRerun builder with application/json body
-func NewStepRunUpdateRerunRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody) (*http.Request, error) {
+// NewTenantInviteUpdateRequest calls the generic TenantInviteUpdate builder with application/json body
+func NewTenantInviteUpdateRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewStepRunUpdateRerunRequestWithBody(server, tenant, stepRun, "application/json", bodyReader)
+	return NewTenantInviteUpdateRequestWithBody(server, tenant, tenantInvite, "application/json", bodyReader)
 }
 
-// NewStepRunUpdateRerunRequestWithBody generates requests for StepRunUpdateRerun with any type of body
-func NewStepRunUpdateRerunRequestWithBody(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewTenantInviteUpdateRequestWithBody generates requests for TenantInviteUpdate with any type of body
+func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6339,7 +7318,7 @@ func NewStepRunUpdateRerunRequestWithBody(server string, tenant openapi_types.UU
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
 	if err != nil {
 		return nil, err
 	}
@@ -6349,7 +7328,7 @@ func NewStepRunUpdateRerunRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/rerun", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6359,7 +7338,7 @@ func NewStepRunUpdateRerunRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -6369,8 +7348,8 @@ func NewStepRunUpdateRerunRequestWithBody(server string, tenant openapi_types.UU
 	return req, nil
 }
 
-// NewStepRunGetSchemaRequest generates requests for StepRunGetSchema
-func NewStepRunGetSchemaRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
+// NewTenantMemberListRequest generates requests for TenantMemberList
+func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6380,19 +7359,12 @@ func NewStepRunGetSchemaRequest(server string, tenant openapi_types.UUID, stepRu
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/schema", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6410,8 +7382,8 @@ func NewStepRunGetSchemaRequest(server string, tenant openapi_types.UUID, stepRu
 	return req, nil
 }
 
-// NewWebhookListRequest generates requests for WebhookList
-func NewWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewTenantMemberDeleteRequest generates requests for TenantMemberDelete
+func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, member openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6421,12 +7393,19 @@ func NewWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Requ
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "member", runtime.ParamLocationPath, member)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-workers", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6436,7 +7415,7 @@ func NewWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Requ
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6444,19 +7423,19 @@ func NewWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Requ
 	return req, nil
 }
 
-// NewWebhookCreateRequest calls the generic WebhookCreate builder with application/json body
-func NewWebhookCreateRequest(server string, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody) (*http.Request, error) {
+// NewTenantMemberUpdateRequest calls the generic TenantMemberUpdate builder with application/json body
+func NewTenantMemberUpdateRequest(server string, tenant openapi_types.UUID, member openapi_types.UUID, body TenantMemberUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewWebhookCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewTenantMemberUpdateRequestWithBody(server, tenant, member, "application/json", bodyReader)
 }
 
-// NewWebhookCreateRequestWithBody generates requests for WebhookCreate with any type of body
-func NewWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewTenantMemberUpdateRequestWithBody generates requests for TenantMemberUpdate with any type of body
+func NewTenantMemberUpdateRequestWithBody(server string, tenant openapi_types.UUID, member openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6466,12 +7445,19 @@ func NewWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, c
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "member", runtime.ParamLocationPath, member)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-workers", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6481,7 +7467,7 @@ func NewWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, c
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -6491,8 +7477,8 @@ func NewWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, c
 	return req, nil
 }
 
-// NewWorkerListRequest generates requests for WorkerList
-func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewMessageQueueDeadLetterListRequest generates requests for MessageQueueDeadLetterList
+func NewMessageQueueDeadLetterListRequest(server string, tenant openapi_types.UUID, messageQueue string, params *MessageQueueDeadLetterListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6502,12 +7488,19 @@ func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Reque
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "message-queue", runtime.ParamLocationPath, messageQueue)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/worker", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/message-queues/%s/dead-letters", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6517,6 +7510,44 @@ func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Reque
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -6525,19 +7556,19 @@ func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Reque
 	return req, nil
 }
 
-// NewWorkflowRunUpdateReplayRequest calls the generic WorkflowRunUpdateReplay builder with application/json body
-func NewWorkflowRunUpdateReplayRequest(server string, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody) (*http.Request, error) {
+// NewMessageQueueDeadLetterPurgeRequest calls the generic MessageQueueDeadLetterPurge builder with application/json body
+func NewMessageQueueDeadLetterPurgeRequest(server string, tenant openapi_types.UUID, messageQueue string, body MessageQueueDeadLetterPurgeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewWorkflowRunUpdateReplayRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewMessageQueueDeadLetterPurgeRequestWithBody(server, tenant, messageQueue, "application/json", bodyReader)
 }
 
-// NewWorkflowRunUpdateReplayRequestWithBody generates requests for WorkflowRunUpdateReplay with any type of body
-func NewWorkflowRunUpdateReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewMessageQueueDeadLetterPurgeRequestWithBody generates requests for MessageQueueDeadLetterPurge with any type of body
+func NewMessageQueueDeadLetterPurgeRequestWithBody(server string, tenant openapi_types.UUID, messageQueue string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6547,12 +7578,19 @@ func NewWorkflowRunUpdateReplayRequestWithBody(server string, tenant openapi_typ
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "message-queue", runtime.ParamLocationPath, messageQueue)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/replay", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/message-queues/%s/dead-letters/purge", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6572,8 +7610,19 @@ func NewWorkflowRunUpdateReplayRequestWithBody(server string, tenant openapi_typ
 	return req, nil
 }
 
-// NewWorkflowRunGetRequest generates requests for WorkflowRunGet
-func NewWorkflowRunGetRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
+// NewMessageQueueDeadLetterReplayRequest calls the generic MessageQueueDeadLetterReplay builder with application/json body
+func NewMessageQueueDeadLetterReplayRequest(server string, tenant openapi_types.UUID, messageQueue string, body MessageQueueDeadLetterReplayJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMessageQueueDeadLetterReplayRequestWithBody(server, tenant, messageQueue, "application/json", bodyReader)
+}
+
+// NewMessageQueueDeadLetterReplayRequestWithBody generates requests for MessageQueueDeadLetterReplay with any type of body
+func NewMessageQueueDeadLetterReplayRequestWithBody(server string, tenant openapi_types.UUID, messageQueue string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6585,7 +7634,7 @@ func NewWorkflowRunGetRequest(server string, tenant openapi_types.UUID, workflow
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "message-queue", runtime.ParamLocationPath, messageQueue)
 	if err != nil {
 		return nil, err
 	}
@@ -6595,7 +7644,7 @@ func NewWorkflowRunGetRequest(server string, tenant openapi_types.UUID, workflow
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/message-queues/%s/dead-letters/replay", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6605,16 +7654,18 @@ func NewWorkflowRunGetRequest(server string, tenant openapi_types.UUID, workflow
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewWorkflowRunGetInputRequest generates requests for WorkflowRunGetInput
-func NewWorkflowRunGetInputRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
+// NewTenantGetQueueMetricsRequest generates requests for TenantGetQueueMetrics
+func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6624,19 +7675,12 @@ func NewWorkflowRunGetInputRequest(server string, tenant openapi_types.UUID, wor
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/input", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/queue-metrics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6646,166 +7690,12 @@ func NewWorkflowRunGetInputRequest(server string, tenant openapi_types.UUID, wor
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewWorkflowRunGetShapeRequest generates requests for WorkflowRunGetShape
-func NewWorkflowRunGetShapeRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
-	var err error
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	var pathParam0 string
+		if params.Workflows != nil {
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/shape", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewWorkflowRunListStepRunEventsRequest generates requests for WorkflowRunListStepRunEvents
-func NewWorkflowRunListStepRunEventsRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/step-run-events", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.LastId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastId", runtime.ParamLocationQuery, *params.LastId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewWorkflowListRequest generates requests for WorkflowList
-func NewWorkflowListRequest(server string, tenant openapi_types.UUID, params *WorkflowListParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflows", runtime.ParamLocationQuery, *params.Workflows); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6819,9 +7709,9 @@ func NewWorkflowListRequest(server string, tenant openapi_types.UUID, params *Wo
 
 		}
 
-		if params.Name != nil {
+		if params.AdditionalMetadata != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6846,55 +7736,8 @@ func NewWorkflowListRequest(server string, tenant openapi_types.UUID, params *Wo
 	return req, nil
 }
 
-// NewWorkflowRunCancelRequest calls the generic WorkflowRunCancel builder with application/json body
-func NewWorkflowRunCancelRequest(server string, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewWorkflowRunCancelRequestWithBody(server, tenant, "application/json", bodyReader)
-}
-
-// NewWorkflowRunCancelRequestWithBody generates requests for WorkflowRunCancel with any type of body
-func NewWorkflowRunCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/cancel", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewCronWorkflowListRequest generates requests for CronWorkflowList
-func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params *CronWorkflowListParams) (*http.Request, error) {
+// NewRateLimitListRequest generates requests for RateLimitList
+func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *RateLimitListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6909,7 +7752,7 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/rate-limits", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6954,25 +7797,9 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.WorkflowId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.AdditionalMetadata != nil {
+		if params.Search != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -7029,8 +7856,8 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 	return req, nil
 }
 
-// NewWorkflowCronDeleteRequest generates requests for WorkflowCronDelete
-func NewWorkflowCronDeleteRequest(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) (*http.Request, error) {
+// NewTenantResourcePolicyGetRequest generates requests for TenantResourcePolicyGet
+func NewTenantResourcePolicyGetRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7040,19 +7867,12 @@ func NewWorkflowCronDeleteRequest(server string, tenant openapi_types.UUID, cron
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, cronWorkflow)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/resource-policy", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7062,7 +7882,7 @@ func NewWorkflowCronDeleteRequest(server string, tenant openapi_types.UUID, cron
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7070,8 +7890,8 @@ func NewWorkflowCronDeleteRequest(server string, tenant openapi_types.UUID, cron
 	return req, nil
 }
 
-// NewWorkflowCronGetRequest generates requests for WorkflowCronGet
-func NewWorkflowCronGetRequest(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) (*http.Request, error) {
+// NewTenantRoleListRequest generates requests for TenantRoleList
+func NewTenantRoleListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7081,19 +7901,12 @@ func NewWorkflowCronGetRequest(server string, tenant openapi_types.UUID, cronWor
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, cronWorkflow)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/roles", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7111,8 +7924,19 @@ func NewWorkflowCronGetRequest(server string, tenant openapi_types.UUID, cronWor
 	return req, nil
 }
 
-// NewWorkflowRunListRequest generates requests for WorkflowRunList
-func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params *WorkflowRunListParams) (*http.Request, error) {
+// NewTenantRoleCreateRequest calls the generic TenantRoleCreate builder with application/json body
+func NewTenantRoleCreateRequest(server string, tenant openapi_types.UUID, body TenantRoleCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantRoleCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewTenantRoleCreateRequestWithBody generates requests for TenantRoleCreate with any type of body
+func NewTenantRoleCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7127,7 +7951,7 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/runs", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/roles", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7137,250 +7961,169 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Offset != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewTenantRoleDeleteRequest generates requests for TenantRoleDelete
+func NewTenantRoleDeleteRequest(server string, tenant openapi_types.UUID, tenantRole openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-		if params.Limit != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		if params.EventId != nil {
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-role", runtime.ParamLocationPath, tenantRole)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventId", runtime.ParamLocationQuery, *params.EventId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/roles/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.WorkflowId != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.ParentWorkflowRunId != nil {
+// NewTenantRoleUpdateRequest calls the generic TenantRoleUpdate builder with application/json body
+func NewTenantRoleUpdateRequest(server string, tenant openapi_types.UUID, tenantRole openapi_types.UUID, body TenantRoleUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantRoleUpdateRequestWithBody(server, tenant, tenantRole, "application/json", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewTenantRoleUpdateRequestWithBody generates requests for TenantRoleUpdate with any type of body
+func NewTenantRoleUpdateRequestWithBody(server string, tenant openapi_types.UUID, tenantRole openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.ParentStepRunId != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam1 string
 
-		}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-role", runtime.ParamLocationPath, tenantRole)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Statuses != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/roles/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Kinds != nil {
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kinds", runtime.ParamLocationQuery, *params.Kinds); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req.Header.Add("Content-Type", contentType)
 
-		}
+	return req, nil
+}
 
-		if params.AdditionalMetadata != nil {
+// NewSlackWebhookListRequest generates requests for SlackWebhookList
+func NewSlackWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CreatedAfter != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/slack", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CreatedBefore != nil {
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewUserUpdateSlackOauthStartRequest generates requests for UserUpdateSlackOauthStart
+func NewUserUpdateSlackOauthStartRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-		if params.FinishedAfter != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedAfter", runtime.ParamLocationQuery, *params.FinishedAfter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.FinishedBefore != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedBefore", runtime.ParamLocationQuery, *params.FinishedBefore); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByField != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByDirection != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/slack/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -7391,8 +8134,8 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 	return req, nil
 }
 
-// NewWorkflowRunGetMetricsRequest generates requests for WorkflowRunGetMetrics
-func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams) (*http.Request, error) {
+// NewSnsListRequest generates requests for SnsList
+func NewSnsListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7407,7 +8150,7 @@ func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/runs/metrics", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sns", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7417,122 +8160,85 @@ func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.EventId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventId", runtime.ParamLocationQuery, *params.EventId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.WorkflowId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.ParentWorkflowRunId != nil {
+// NewSnsCreateRequest calls the generic SnsCreate builder with application/json body
+func NewSnsCreateRequest(server string, tenant openapi_types.UUID, body SnsCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSnsCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewSnsCreateRequestWithBody generates requests for SnsCreate with any type of body
+func NewSnsCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.ParentStepRunId != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sns", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.AdditionalMetadata != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		if params.CreatedAfter != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewTenantGetStepRunQueueMetricsRequest generates requests for TenantGetStepRunQueueMetrics
+func NewTenantGetStepRunQueueMetricsRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.CreatedBefore != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-run-queue-metrics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -7543,8 +8249,8 @@ func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, p
 	return req, nil
 }
 
-// NewWorkflowScheduledListRequest generates requests for WorkflowScheduledList
-func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, params *WorkflowScheduledListParams) (*http.Request, error) {
+// NewStepRunGetRequest generates requests for StepRunGet
+func NewStepRunGetRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7554,12 +8260,19 @@ func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7569,157 +8282,48 @@ func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Offset != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByField != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByDirection != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.WorkflowId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ParentWorkflowRunId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ParentStepRunId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
+// NewStepRunUpdateCancelRequest generates requests for StepRunUpdateCancel
+func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-		if params.AdditionalMetadata != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		if params.Statuses != nil {
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/cancel", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7727,8 +8331,19 @@ func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, p
 	return req, nil
 }
 
-// NewWorkflowScheduledDeleteRequest generates requests for WorkflowScheduledDelete
-func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) (*http.Request, error) {
+// NewStepRunUpdateRerunRequest calls the generic StepRunUpdateRerun builder with application/json body
+func NewStepRunUpdateRerunRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStepRunUpdateRerunRequestWithBody(server, tenant, stepRun, "application/json", bodyReader)
+}
+
+// NewStepRunUpdateRerunRequestWithBody generates requests for StepRunUpdateRerun with any type of body
+func NewStepRunUpdateRerunRequestWithBody(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7740,7 +8355,7 @@ func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID,
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, scheduledWorkflowRun)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -7750,7 +8365,7 @@ func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/rerun", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7760,16 +8375,18 @@ func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewWorkflowScheduledGetRequest generates requests for WorkflowScheduledGet
-func NewWorkflowScheduledGetRequest(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) (*http.Request, error) {
+// NewStepRunGetSchemaRequest generates requests for StepRunGetSchema
+func NewStepRunGetSchemaRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7781,7 +8398,7 @@ func NewWorkflowScheduledGetRequest(server string, tenant openapi_types.UUID, sc
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, scheduledWorkflowRun)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -7791,7 +8408,7 @@ func NewWorkflowScheduledGetRequest(server string, tenant openapi_types.UUID, sc
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/schema", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7809,19 +8426,8 @@ func NewWorkflowScheduledGetRequest(server string, tenant openapi_types.UUID, sc
 	return req, nil
 }
 
-// NewCronWorkflowTriggerCreateRequest calls the generic CronWorkflowTriggerCreate builder with application/json body
-func NewCronWorkflowTriggerCreateRequest(server string, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCronWorkflowTriggerCreateRequestWithBody(server, tenant, workflow, "application/json", bodyReader)
-}
-
-// NewCronWorkflowTriggerCreateRequestWithBody generates requests for CronWorkflowTriggerCreate with any type of body
-func NewCronWorkflowTriggerCreateRequestWithBody(server string, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader) (*http.Request, error) {
+// NewWebhookSubscriptionListRequest generates requests for WebhookSubscriptionList
+func NewWebhookSubscriptionListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7831,19 +8437,12 @@ func NewCronWorkflowTriggerCreateRequestWithBody(server string, tenant openapi_t
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/crons", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-subscriptions", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7853,29 +8452,27 @@ func NewCronWorkflowTriggerCreateRequestWithBody(server string, tenant openapi_t
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewScheduledWorkflowRunCreateRequest calls the generic ScheduledWorkflowRunCreate builder with application/json body
-func NewScheduledWorkflowRunCreateRequest(server string, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody) (*http.Request, error) {
+// NewWebhookSubscriptionCreateRequest calls the generic WebhookSubscriptionCreate builder with application/json body
+func NewWebhookSubscriptionCreateRequest(server string, tenant openapi_types.UUID, body WebhookSubscriptionCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewScheduledWorkflowRunCreateRequestWithBody(server, tenant, workflow, "application/json", bodyReader)
+	return NewWebhookSubscriptionCreateRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewScheduledWorkflowRunCreateRequestWithBody generates requests for ScheduledWorkflowRunCreate with any type of body
-func NewScheduledWorkflowRunCreateRequestWithBody(server string, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader) (*http.Request, error) {
+// NewWebhookSubscriptionCreateRequestWithBody generates requests for WebhookSubscriptionCreate with any type of body
+func NewWebhookSubscriptionCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7885,19 +8482,12 @@ func NewScheduledWorkflowRunCreateRequestWithBody(server string, tenant openapi_
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/scheduled", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-subscriptions", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7917,8 +8507,8 @@ func NewScheduledWorkflowRunCreateRequestWithBody(server string, tenant openapi_
 	return req, nil
 }
 
-// NewWorkflowGetWorkersCountRequest generates requests for WorkflowGetWorkersCount
-func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID, workflow openapi_types.UUID) (*http.Request, error) {
+// NewWebhookSubscriptionDeleteRequest generates requests for WebhookSubscriptionDelete
+func NewWebhookSubscriptionDeleteRequest(server string, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7930,7 +8520,7 @@ func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID,
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhook-subscription", runtime.ParamLocationPath, webhookSubscription)
 	if err != nil {
 		return nil, err
 	}
@@ -7940,7 +8530,7 @@ func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/worker-count", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-subscriptions/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7950,7 +8540,7 @@ func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7958,43 +8548,41 @@ func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID,
 	return req, nil
 }
 
-// NewUserGetCurrentRequest generates requests for UserGetCurrent
-func NewUserGetCurrentRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// NewWebhookSubscriptionUpdateRequest calls the generic WebhookSubscriptionUpdate builder with application/json body
+func NewWebhookSubscriptionUpdateRequest(server string, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, body WebhookSubscriptionUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWebhookSubscriptionUpdateRequestWithBody(server, tenant, webhookSubscription, "application/json", bodyReader)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/users/current")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// NewWebhookSubscriptionUpdateRequestWithBody generates requests for WebhookSubscriptionUpdate with any type of body
+func NewWebhookSubscriptionUpdateRequestWithBody(server string, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	queryURL, err := serverURL.Parse(operationPath)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhook-subscription", runtime.ParamLocationPath, webhookSubscription)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewUserUpdateGithubOauthCallbackRequest generates requests for UserUpdateGithubOauthCallback
-func NewUserUpdateGithubOauthCallbackRequest(server string) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/github/callback")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-subscriptions/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8004,51 +8592,40 @@ func NewUserUpdateGithubOauthCallbackRequest(server string) (*http.Request, erro
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewUserUpdateGithubOauthStartRequest generates requests for UserUpdateGithubOauthStart
-func NewUserUpdateGithubOauthStartRequest(server string) (*http.Request, error) {
+// NewWebhookDeliveryListRequest generates requests for WebhookDeliveryList
+func NewWebhookDeliveryListRequest(server string, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, params *WebhookDeliveryListParams) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/users/github/start")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	var pathParam0 string
 
-	queryURL, err := serverURL.Parse(operationPath)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhook-subscription", runtime.ParamLocationPath, webhookSubscription)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewUserUpdateGoogleOauthCallbackRequest generates requests for UserUpdateGoogleOauthCallback
-func NewUserUpdateGoogleOauthCallbackRequest(server string) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/google/callback")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-subscriptions/%s/deliveries", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8058,31 +8635,58 @@ func NewUserUpdateGoogleOauthCallbackRequest(server string) (*http.Request, erro
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Offset != nil {
 
-// NewUserUpdateGoogleOauthStartRequest generates requests for UserUpdateGoogleOauthStart
-func NewUserUpdateGoogleOauthStartRequest(server string) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	operationPath := fmt.Sprintf("/api/v1/users/google/start")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if params.Limit != nil {
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Statuses != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -8093,16 +8697,23 @@ func NewUserUpdateGoogleOauthStartRequest(server string) (*http.Request, error)
 	return req, nil
 }
 
-// NewUserListTenantInvitesRequest generates requests for UserListTenantInvites
-func NewUserListTenantInvitesRequest(server string) (*http.Request, error) {
+// NewWebhookListRequest generates requests for WebhookList
+func NewWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/invites")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-workers", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8120,27 +8731,34 @@ func NewUserListTenantInvitesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewTenantInviteAcceptRequest calls the generic TenantInviteAccept builder with application/json body
-func NewTenantInviteAcceptRequest(server string, body TenantInviteAcceptJSONRequestBody) (*http.Request, error) {
+// NewWebhookCreateRequest calls the generic WebhookCreate builder with application/json body
+func NewWebhookCreateRequest(server string, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteAcceptRequestWithBody(server, "application/json", bodyReader)
+	return NewWebhookCreateRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewTenantInviteAcceptRequestWithBody generates requests for TenantInviteAccept with any type of body
-func NewTenantInviteAcceptRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewWebhookCreateRequestWithBody generates requests for WebhookCreate with any type of body
+func NewWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/invites/accept")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-workers", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8160,27 +8778,23 @@ func NewTenantInviteAcceptRequestWithBody(server string, contentType string, bod
 	return req, nil
 }
 
-// NewTenantInviteRejectRequest calls the generic TenantInviteReject builder with application/json body
-func NewTenantInviteRejectRequest(server string, body TenantInviteRejectJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewWorkerListRequest generates requests for WorkerList
+func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteRejectRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewTenantInviteRejectRequestWithBody generates requests for TenantInviteReject with any type of body
-func NewTenantInviteRejectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/invites/reject")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/worker", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8190,37 +8804,42 @@ func NewTenantInviteRejectRequestWithBody(server string, contentType string, bod
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewUserUpdateLoginRequest calls the generic UserUpdateLogin builder with application/json body
-func NewUserUpdateLoginRequest(server string, body UserUpdateLoginJSONRequestBody) (*http.Request, error) {
+// NewWorkflowRunUpdateReplayRequest calls the generic WorkflowRunUpdateReplay builder with application/json body
+func NewWorkflowRunUpdateReplayRequest(server string, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUserUpdateLoginRequestWithBody(server, "application/json", bodyReader)
+	return NewWorkflowRunUpdateReplayRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewUserUpdateLoginRequestWithBody generates requests for UserUpdateLogin with any type of body
-func NewUserUpdateLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewWorkflowRunUpdateReplayRequestWithBody generates requests for WorkflowRunUpdateReplay with any type of body
+func NewWorkflowRunUpdateReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/login")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/replay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8240,16 +8859,30 @@ func NewUserUpdateLoginRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewUserUpdateLogoutRequest generates requests for UserUpdateLogout
-func NewUserUpdateLogoutRequest(server string) (*http.Request, error) {
+// NewWorkflowRunGetRequest generates requests for WorkflowRunGet
+func NewWorkflowRunGetRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/logout")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8259,7 +8892,7 @@ func NewUserUpdateLogoutRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -8267,16 +8900,30 @@ func NewUserUpdateLogoutRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewTenantMembershipsListRequest generates requests for TenantMembershipsList
-func NewTenantMembershipsListRequest(server string) (*http.Request, error) {
+// NewWorkflowRunGetInputRequest generates requests for WorkflowRunGetInput
+func NewWorkflowRunGetInputRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/memberships")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/input", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8294,27 +8941,30 @@ func NewTenantMembershipsListRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUserUpdatePasswordRequest calls the generic UserUpdatePassword builder with application/json body
-func NewUserUpdatePasswordRequest(server string, body UserUpdatePasswordJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewWorkflowRunGetShapeRequest generates requests for WorkflowRunGetShape
+func NewWorkflowRunGetShapeRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUserUpdatePasswordRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewUserUpdatePasswordRequestWithBody generates requests for UserUpdatePassword with any type of body
-func NewUserUpdatePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	if err != nil {
+		return nil, err
+	}
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/password")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/shape", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8324,66 +8974,38 @@ func NewUserUpdatePasswordRequestWithBody(server string, contentType string, bod
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewUserCreateRequest calls the generic UserCreate builder with application/json body
-func NewUserCreateRequest(server string, body UserCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUserCreateRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewUserCreateRequestWithBody generates requests for UserCreate with any type of body
-func NewUserCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewWorkflowRunListStepRunEventsRequest generates requests for WorkflowRunListStepRunEvents
+func NewWorkflowRunListStepRunEventsRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/users/register")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	var pathParam0 string
 
-	queryURL, err := serverURL.Parse(operationPath)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewUserUpdateSlackOauthCallbackRequest generates requests for UserUpdateSlackOauthCallback
-func NewUserUpdateSlackOauthCallbackRequest(server string) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/slack/callback")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/step-run-events", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8393,31 +9015,26 @@ func NewUserUpdateSlackOauthCallbackRequest(server string) (*http.Request, error
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// NewInfoGetVersionRequest generates requests for InfoGetVersion
-func NewInfoGetVersionRequest(server string) (*http.Request, error) {
-	var err error
+		if params.LastId != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastId", runtime.ParamLocationQuery, *params.LastId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/v1/version")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -8428,13 +9045,13 @@ func NewInfoGetVersionRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewWebhookDeleteRequest generates requests for WebhookDelete
-func NewWebhookDeleteRequest(server string, webhook openapi_types.UUID) (*http.Request, error) {
+// NewWorkflowListRequest generates requests for WorkflowList
+func NewWorkflowListRequest(server string, tenant openapi_types.UUID, params *WorkflowListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, webhook)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -8444,7 +9061,7 @@ func NewWebhookDeleteRequest(server string, webhook openapi_types.UUID) (*http.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/webhook-workers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8454,72 +9071,58 @@ func NewWebhookDeleteRequest(server string, webhook openapi_types.UUID) (*http.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewWebhookRequestsListRequest generates requests for WebhookRequestsList
-func NewWebhookRequestsListRequest(server string, webhook openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, webhook)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	operationPath := fmt.Sprintf("/api/v1/webhook-workers/%s/requests", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if params.Offset != nil {
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	return req, nil
-}
+		if params.Limit != nil {
 
-// NewWorkerGetRequest generates requests for WorkerGet
-func NewWorkerGetRequest(server string, worker openapi_types.UUID) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker", runtime.ParamLocationPath, worker)
-	if err != nil {
-		return nil, err
-	}
+		if params.Name != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/v1/workers/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -8530,24 +9133,24 @@ func NewWorkerGetRequest(server string, worker openapi_types.UUID) (*http.Reques
 	return req, nil
 }
 
-// NewWorkerUpdateRequest calls the generic WorkerUpdate builder with application/json body
-func NewWorkerUpdateRequest(server string, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody) (*http.Request, error) {
+// NewWorkflowRunCancelRequest calls the generic WorkflowRunCancel builder with application/json body
+func NewWorkflowRunCancelRequest(server string, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewWorkerUpdateRequestWithBody(server, worker, "application/json", bodyReader)
+	return NewWorkflowRunCancelRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewWorkerUpdateRequestWithBody generates requests for WorkerUpdate with any type of body
-func NewWorkerUpdateRequestWithBody(server string, worker openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewWorkflowRunCancelRequestWithBody generates requests for WorkflowRunCancel with any type of body
+func NewWorkflowRunCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker", runtime.ParamLocationPath, worker)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -8557,7 +9160,7 @@ func NewWorkerUpdateRequestWithBody(server string, worker openapi_types.UUID, co
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/cancel", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8567,7 +9170,7 @@ func NewWorkerUpdateRequestWithBody(server string, worker openapi_types.UUID, co
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -8577,13 +9180,13 @@ func NewWorkerUpdateRequestWithBody(server string, worker openapi_types.UUID, co
 	return req, nil
 }
 
-// NewWorkflowDeleteRequest generates requests for WorkflowDelete
-func NewWorkflowDeleteRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
+// NewCronWorkflowListRequest generates requests for CronWorkflowList
+func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params *CronWorkflowListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -8593,7 +9196,7 @@ func NewWorkflowDeleteRequest(server string, workflow openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8603,38 +9206,106 @@ func NewWorkflowDeleteRequest(server string, workflow openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Offset != nil {
 
-// NewWorkflowGetRequest generates requests for WorkflowGet
-func NewWorkflowGetRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		if params.WorkflowId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AdditionalMetadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByField != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -8645,24 +9316,20 @@ func NewWorkflowGetRequest(server string, workflow openapi_types.UUID) (*http.Re
 	return req, nil
 }
 
-// NewWorkflowUpdateRequest calls the generic WorkflowUpdate builder with application/json body
-func NewWorkflowUpdateRequest(server string, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewWorkflowCronDeleteRequest generates requests for WorkflowCronDelete
+func NewWorkflowCronDeleteRequest(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewWorkflowUpdateRequestWithBody(server, workflow, "application/json", bodyReader)
-}
-
-// NewWorkflowUpdateRequestWithBody generates requests for WorkflowUpdate with any type of body
-func NewWorkflowUpdateRequestWithBody(server string, workflow openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
-	var pathParam0 string
+	var pathParam1 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, cronWorkflow)
 	if err != nil {
 		return nil, err
 	}
@@ -8672,7 +9339,7 @@ func NewWorkflowUpdateRequestWithBody(server string, workflow openapi_types.UUID
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8682,23 +9349,28 @@ func NewWorkflowUpdateRequestWithBody(server string, workflow openapi_types.UUID
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewWorkflowGetMetricsRequest generates requests for WorkflowGetMetrics
-func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, params *WorkflowGetMetricsParams) (*http.Request, error) {
+// NewWorkflowCronGetRequest generates requests for WorkflowCronGet
+func NewWorkflowCronGetRequest(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, cronWorkflow)
 	if err != nil {
 		return nil, err
 	}
@@ -8708,7 +9380,7 @@ func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, pa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s/metrics", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8718,44 +9390,6 @@ func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, pa
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Status != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.GroupKey != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groupKey", runtime.ParamLocationQuery, *params.GroupKey); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -8764,24 +9398,31 @@ func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, pa
 	return req, nil
 }
 
-// NewWorkflowRunCreateRequest calls the generic WorkflowRunCreate builder with application/json body
-func NewWorkflowRunCreateRequest(server string, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody) (*http.Request, error) {
+// NewWorkflowCronUpdateRequest calls the generic WorkflowCronUpdate builder with application/json body
+func NewWorkflowCronUpdateRequest(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, body WorkflowCronUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewWorkflowRunCreateRequestWithBody(server, workflow, params, "application/json", bodyReader)
+	return NewWorkflowCronUpdateRequestWithBody(server, tenant, cronWorkflow, "application/json", bodyReader)
 }
 
-// NewWorkflowRunCreateRequestWithBody generates requests for WorkflowRunCreate with any type of body
-func NewWorkflowRunCreateRequestWithBody(server string, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewWorkflowCronUpdateRequestWithBody generates requests for WorkflowCronUpdate with any type of body
+func NewWorkflowCronUpdateRequestWithBody(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, cronWorkflow)
 	if err != nil {
 		return nil, err
 	}
@@ -8791,7 +9432,7 @@ func NewWorkflowRunCreateRequestWithBody(server string, workflow openapi_types.U
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s/trigger", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8801,29 +9442,7 @@ func NewWorkflowRunCreateRequestWithBody(server string, workflow openapi_types.U
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Version != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -8833,13 +9452,13 @@ func NewWorkflowRunCreateRequestWithBody(server string, workflow openapi_types.U
 	return req, nil
 }
 
-// NewWorkflowVersionGetRequest generates requests for WorkflowVersionGet
-func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, params *WorkflowVersionGetParams) (*http.Request, error) {
+// NewWorkflowRunListRequest generates requests for WorkflowRunList
+func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params *WorkflowRunListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -8849,7 +9468,7 @@ func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, pa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s/versions", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/runs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8862,9 +9481,9 @@ func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, pa
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Version != nil {
+		if params.Offset != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -8878,140 +9497,121 @@ func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, pa
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewV2DagListTasksRequest generates requests for V2DagListTasks
-func NewV2DagListTasksRequest(server string, params *V2DagListTasksParams) (*http.Request, error) {
-	var err error
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.EventId != nil {
 
-	operationPath := fmt.Sprintf("/api/v2/dags/tasks")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventId", runtime.ParamLocationQuery, *params.EventId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+		if params.WorkflowId != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dag_ids", runtime.ParamLocationQuery, params.DagIds); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
-		}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tenant", runtime.ParamLocationQuery, params.Tenant); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewV2TaskGetRequest generates requests for V2TaskGet
-func NewV2TaskGetRequest(server string, task openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task", runtime.ParamLocationPath, task)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.ParentWorkflowRunId != nil {
 
-	operationPath := fmt.Sprintf("/api/v2/tasks/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		if params.ParentStepRunId != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewV2TaskEventListRequest generates requests for V2TaskEventList
-func NewV2TaskEventListRequest(server string, task openapi_types.UUID, params *V2TaskEventListParams) (*http.Request, error) {
-	var err error
+		}
 
-	var pathParam0 string
+		if params.Statuses != nil {
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task", runtime.ParamLocationPath, task)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	operationPath := fmt.Sprintf("/api/v2/tasks/%s/task-events", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if params.Kinds != nil {
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kinds", runtime.ParamLocationQuery, *params.Kinds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+		}
 
-		if params.Offset != nil {
+		if params.AdditionalMetadata != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9025,9 +9625,9 @@ func NewV2TaskEventListRequest(server string, task openapi_types.UUID, params *V
 
 		}
 
-		if params.Limit != nil {
+		if params.CreatedAfter != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9041,61 +9641,73 @@ func NewV2TaskEventListRequest(server string, task openapi_types.UUID, params *V
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		if params.CreatedBefore != nil {
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return req, nil
-}
+		}
 
-// NewV2TaskListStatusMetricsRequest generates requests for V2TaskListStatusMetrics
-func NewV2TaskListStatusMetricsRequest(server string, tenant openapi_types.UUID, params *V2TaskListStatusMetricsParams) (*http.Request, error) {
-	var err error
+		if params.FinishedAfter != nil {
 
-	var pathParam0 string
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedAfter", runtime.ParamLocationQuery, *params.FinishedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.FinishedBefore != nil {
 
-	operationPath := fmt.Sprintf("/api/v2/tenants/%s/task-metrics", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedBefore", runtime.ParamLocationQuery, *params.FinishedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+		if params.OrderByField != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, params.Since); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
-		if params.WorkflowIds != nil {
+		if params.OrderByDirection != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflow_ids", runtime.ParamLocationQuery, *params.WorkflowIds); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9120,8 +9732,8 @@ func NewV2TaskListStatusMetricsRequest(server string, tenant openapi_types.UUID,
 	return req, nil
 }
 
-// NewV2TaskGetPointMetricsRequest generates requests for V2TaskGetPointMetrics
-func NewV2TaskGetPointMetricsRequest(server string, tenant openapi_types.UUID, params *V2TaskGetPointMetricsParams) (*http.Request, error) {
+// NewWorkflowRunGetMetricsRequest generates requests for WorkflowRunGetMetrics
+func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9136,7 +9748,7 @@ func NewV2TaskGetPointMetricsRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2/tenants/%s/task-point-metrics", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/runs/metrics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9149,9 +9761,9 @@ func NewV2TaskGetPointMetricsRequest(server string, tenant openapi_types.UUID, p
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.CreatedAfter != nil {
+		if params.EventId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventId", runtime.ParamLocationQuery, *params.EventId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9165,9 +9777,9 @@ func NewV2TaskGetPointMetricsRequest(server string, tenant openapi_types.UUID, p
 
 		}
 
-		if params.FinishedBefore != nil {
+		if params.WorkflowId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedBefore", runtime.ParamLocationQuery, *params.FinishedBefore); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9181,81 +9793,9 @@ func NewV2TaskGetPointMetricsRequest(server string, tenant openapi_types.UUID, p
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		if params.ParentWorkflowRunId != nil {
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewV2TaskListRequest generates requests for V2TaskList
-func NewV2TaskListRequest(server string, tenant openapi_types.UUID, params *V2TaskListParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v2/tenants/%s/tasks", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Statuses != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9269,21 +9809,9 @@ func NewV2TaskListRequest(server string, tenant openapi_types.UUID, params *V2Ta
 
 		}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, params.Since); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if params.Until != nil {
+		if params.ParentStepRunId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9299,7 +9827,7 @@ func NewV2TaskListRequest(server string, tenant openapi_types.UUID, params *V2Ta
 
 		if params.AdditionalMetadata != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additional_metadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9313,9 +9841,9 @@ func NewV2TaskListRequest(server string, tenant openapi_types.UUID, params *V2Ta
 
 		}
 
-		if params.WorkflowIds != nil {
+		if params.CreatedAfter != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflow_ids", runtime.ParamLocationQuery, *params.WorkflowIds); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9329,9 +9857,9 @@ func NewV2TaskListRequest(server string, tenant openapi_types.UUID, params *V2Ta
 
 		}
 
-		if params.WorkerId != nil {
+		if params.CreatedBefore != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "worker_id", runtime.ParamLocationQuery, *params.WorkerId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9356,8 +9884,8 @@ func NewV2TaskListRequest(server string, tenant openapi_types.UUID, params *V2Ta
 	return req, nil
 }
 
-// NewV2WorkflowRunListRequest generates requests for V2WorkflowRunList
-func NewV2WorkflowRunListRequest(server string, tenant openapi_types.UUID, params *V2WorkflowRunListParams) (*http.Request, error) {
+// NewWorkflowScheduledListRequest generates requests for WorkflowScheduledList
+func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, params *WorkflowScheduledListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9372,7 +9900,7 @@ func NewV2WorkflowRunListRequest(server string, tenant openapi_types.UUID, param
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2/tenants/%s/workflow-runs", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9417,9 +9945,9 @@ func NewV2WorkflowRunListRequest(server string, tenant openapi_types.UUID, param
 
 		}
 
-		if params.Statuses != nil {
+		if params.OrderByField != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9433,21 +9961,57 @@ func NewV2WorkflowRunListRequest(server string, tenant openapi_types.UUID, param
 
 		}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, params.Since); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+		if params.OrderByDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
-		if params.Until != nil {
+		if params.WorkflowId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentWorkflowRunId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentStepRunId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9463,7 +10027,7 @@ func NewV2WorkflowRunListRequest(server string, tenant openapi_types.UUID, param
 
 		if params.AdditionalMetadata != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additional_metadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9477,9 +10041,9 @@ func NewV2WorkflowRunListRequest(server string, tenant openapi_types.UUID, param
 
 		}
 
-		if params.WorkflowIds != nil {
+		if params.Statuses != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflow_ids", runtime.ParamLocationQuery, *params.WorkflowIds); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -9504,13 +10068,20 @@ func NewV2WorkflowRunListRequest(server string, tenant openapi_types.UUID, param
 	return req, nil
 }
 
-// NewV2WorkflowRunGetRequest generates requests for V2WorkflowRunGet
-func NewV2WorkflowRunGetRequest(server string, v2WorkflowRun openapi_types.UUID) (*http.Request, error) {
+// NewWorkflowScheduledDeleteRequest generates requests for WorkflowScheduledDelete
+func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "v2-workflow-run", runtime.ParamLocationPath, v2WorkflowRun)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, scheduledWorkflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -9520,7 +10091,7 @@ func NewV2WorkflowRunGetRequest(server string, v2WorkflowRun openapi_types.UUID)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2/workflow-runs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9530,7 +10101,7 @@ func NewV2WorkflowRunGetRequest(server string, v2WorkflowRun openapi_types.UUID)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -9538,13 +10109,20 @@ func NewV2WorkflowRunGetRequest(server string, v2WorkflowRun openapi_types.UUID)
 	return req, nil
 }
 
-// NewV2WorkflowRunTaskEventsListRequest generates requests for V2WorkflowRunTaskEventsList
-func NewV2WorkflowRunTaskEventsListRequest(server string, v2WorkflowRun openapi_types.UUID, params *V2WorkflowRunTaskEventsListParams) (*http.Request, error) {
+// NewWorkflowScheduledGetRequest generates requests for WorkflowScheduledGet
+func NewWorkflowScheduledGetRequest(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "v2-workflow-run", runtime.ParamLocationPath, v2WorkflowRun)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, scheduledWorkflowRun)
 	if err != nil {
 		return nil, err
 	}
@@ -9554,7 +10132,7 @@ func NewV2WorkflowRunTaskEventsListRequest(server string, v2WorkflowRun openapi_
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2/workflow-runs/%s/task-events", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9564,44 +10142,6 @@ func NewV2WorkflowRunTaskEventsListRequest(server string, v2WorkflowRun openapi_
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -9610,418 +10150,4099 @@ func NewV2WorkflowRunTaskEventsListRequest(server string, v2WorkflowRun openapi_
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewCronWorkflowTriggerCreateRequest calls the generic CronWorkflowTriggerCreate builder with application/json body
+func NewCronWorkflowTriggerCreateRequest(server string, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewCronWorkflowTriggerCreateRequestWithBody(server, tenant, workflow, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewCronWorkflowTriggerCreateRequestWithBody generates requests for CronWorkflowTriggerCreate with any type of body
+func NewCronWorkflowTriggerCreateRequestWithBody(server string, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// LivenessGetWithResponse request
-	LivenessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivenessGetResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ReadinessGetWithResponse request
-	ReadinessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadinessGetResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/crons", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AlertEmailGroupDeleteWithResponse request
-	AlertEmailGroupDeleteWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupDeleteResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// AlertEmailGroupUpdateWithBodyWithResponse request with any body
-	AlertEmailGroupUpdateWithBodyWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	AlertEmailGroupUpdateWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// ApiTokenUpdateRevokeWithResponse request
-	ApiTokenUpdateRevokeWithResponse(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRevokeResponse, error)
+	return req, nil
+}
 
-	// CloudMetadataGetWithResponse request
-	CloudMetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CloudMetadataGetResponse, error)
+// NewScheduledWorkflowRunCreateRequest calls the generic ScheduledWorkflowRunCreate builder with application/json body
+func NewScheduledWorkflowRunCreateRequest(server string, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewScheduledWorkflowRunCreateRequestWithBody(server, tenant, workflow, "application/json", bodyReader)
+}
 
-	// EventGetWithResponse request
-	EventGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventGetResponse, error)
+// NewScheduledWorkflowRunCreateRequestWithBody generates requests for ScheduledWorkflowRunCreate with any type of body
+func NewScheduledWorkflowRunCreateRequestWithBody(server string, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// EventDataGetWithResponse request
-	EventDataGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDataGetResponse, error)
+	var pathParam0 string
 
-	// MetadataGetWithResponse request
-	MetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataGetResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// MetadataListIntegrationsWithResponse request
-	MetadataListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataListIntegrationsResponse, error)
+	var pathParam1 string
 
-	// MonitoringPostRunProbeWithResponse request
-	MonitoringPostRunProbeWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*MonitoringPostRunProbeResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
 
-	// SlackWebhookDeleteWithResponse request
-	SlackWebhookDeleteWithResponse(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookDeleteResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// SnsDeleteWithResponse request
-	SnsDeleteWithResponse(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsDeleteResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/scheduled", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// SnsUpdateWithResponse request
-	SnsUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*SnsUpdateResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// StepRunListArchivesWithResponse request
-	StepRunListArchivesWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*StepRunListArchivesResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// StepRunListEventsWithResponse request
-	StepRunListEventsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*StepRunListEventsResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// LogLineListWithResponse request
-	LogLineListWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*LogLineListResponse, error)
+	return req, nil
+}
 
-	// TenantCreateWithBodyWithResponse request with any body
-	TenantCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error)
+// NewWorkflowGetWorkersCountRequest generates requests for WorkflowGetWorkersCount
+func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID, workflow openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	TenantCreateWithResponse(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error)
+	var pathParam0 string
 
-	// TenantUpdateWithBodyWithResponse request with any body
-	TenantUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	TenantUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error)
+	var pathParam1 string
 
-	// AlertEmailGroupListWithResponse request
-	AlertEmailGroupListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupListResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
 
-	// AlertEmailGroupCreateWithBodyWithResponse request with any body
-	AlertEmailGroupCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	AlertEmailGroupCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/worker-count", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// TenantAlertingSettingsGetWithResponse request
-	TenantAlertingSettingsGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantAlertingSettingsGetResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ApiTokenListWithResponse request
-	ApiTokenListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenListResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// ApiTokenCreateWithBodyWithResponse request with any body
-	ApiTokenCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error)
+	return req, nil
+}
 
-	ApiTokenCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error)
+// NewUserGetCurrentRequest generates requests for UserGetCurrent
+func NewUserGetCurrentRequest(server string) (*http.Request, error) {
+	var err error
 
-	// EventListWithResponse request
-	EventListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*EventListResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// EventCreateWithBodyWithResponse request with any body
-	EventCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/users/current")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	EventCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// EventCreateBulkWithBodyWithResponse request with any body
-	EventCreateBulkWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	EventCreateBulkWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error)
+	return req, nil
+}
 
-	// EventUpdateCancelWithBodyWithResponse request with any body
-	EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error)
+// NewUserUpdateGithubOauthCallbackRequest generates requests for UserUpdateGithubOauthCallback
+func NewUserUpdateGithubOauthCallbackRequest(server string) (*http.Request, error) {
+	var err error
 
-	EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// EventKeyListWithResponse request
-	EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/users/github/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// EventUpdateReplayWithBodyWithResponse request with any body
-	EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantInviteListWithResponse request
-	TenantInviteListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteListResponse, error)
+	return req, nil
+}
 
-	// TenantInviteCreateWithBodyWithResponse request with any body
-	TenantInviteCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error)
+// NewUserUpdateGithubOauthStartRequest generates requests for UserUpdateGithubOauthStart
+func NewUserUpdateGithubOauthStartRequest(server string) (*http.Request, error) {
+	var err error
 
-	TenantInviteCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantInviteDeleteWithResponse request
-	TenantInviteDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteDeleteResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/users/github/start")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// TenantInviteUpdateWithBodyWithResponse request with any body
-	TenantInviteUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	TenantInviteUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantMemberListWithResponse request
-	TenantMemberListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberListResponse, error)
+	return req, nil
+}
 
-	// TenantMemberDeleteWithResponse request
-	TenantMemberDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberDeleteResponse, error)
+// NewUserUpdateGoogleOauthCallbackRequest generates requests for UserUpdateGoogleOauthCallback
+func NewUserUpdateGoogleOauthCallbackRequest(server string) (*http.Request, error) {
+	var err error
 
-	// TenantGetQueueMetricsWithResponse request
-	TenantGetQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*TenantGetQueueMetricsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// RateLimitListWithResponse request
-	RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*RateLimitListResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/users/google/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// TenantResourcePolicyGetWithResponse request
-	TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// SlackWebhookListWithResponse request
-	SlackWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookListResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserUpdateSlackOauthStartWithResponse request
-	UserUpdateSlackOauthStartWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthStartResponse, error)
+	return req, nil
+}
 
-	// SnsListWithResponse request
-	SnsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsListResponse, error)
+// NewUserUpdateGoogleOauthStartRequest generates requests for UserUpdateGoogleOauthStart
+func NewUserUpdateGoogleOauthStartRequest(server string) (*http.Request, error) {
+	var err error
 
-	// SnsCreateWithBodyWithResponse request with any body
-	SnsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	SnsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/users/google/start")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// TenantGetStepRunQueueMetricsWithResponse request
-	TenantGetStepRunQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantGetStepRunQueueMetricsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// StepRunGetWithResponse request
-	StepRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// StepRunUpdateCancelWithResponse request
-	StepRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateCancelResponse, error)
+	return req, nil
+}
 
-	// StepRunUpdateRerunWithBodyWithResponse request with any body
-	StepRunUpdateRerunWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error)
+// NewUserListTenantInvitesRequest generates requests for UserListTenantInvites
+func NewUserListTenantInvitesRequest(server string) (*http.Request, error) {
+	var err error
 
-	StepRunUpdateRerunWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// StepRunGetSchemaWithResponse request
-	StepRunGetSchemaWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetSchemaResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/users/invites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// WebhookListWithResponse request
-	WebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookListResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// WebhookCreateWithBodyWithResponse request with any body
-	WebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	WebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error)
+	return req, nil
+}
 
-	// WorkerListWithResponse request
-	WorkerListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerListResponse, error)
+// NewTenantInviteAcceptRequest calls the generic TenantInviteAccept builder with application/json body
+func NewTenantInviteAcceptRequest(server string, body TenantInviteAcceptJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantInviteAcceptRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// WorkflowRunUpdateReplayWithBodyWithResponse request with any body
-	WorkflowRunUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error)
+// NewTenantInviteAcceptRequestWithBody generates requests for TenantInviteAccept with any type of body
+func NewTenantInviteAcceptRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	WorkflowRunUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunGetWithResponse request
-	WorkflowRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/users/invites/accept")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// WorkflowRunGetInputWithResponse request
-	WorkflowRunGetInputWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetInputResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunGetShapeWithResponse request
-	WorkflowRunGetShapeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetShapeResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunListStepRunEventsWithResponse request
-	WorkflowRunListStepRunEventsWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*WorkflowRunListStepRunEventsResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// WorkflowListWithResponse request
-	WorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowListParams, reqEditors ...RequestEditorFn) (*WorkflowListResponse, error)
+	return req, nil
+}
 
-	// WorkflowRunCancelWithBodyWithResponse request with any body
-	WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error)
+// NewTenantInviteRejectRequest calls the generic TenantInviteReject builder with application/json body
+func NewTenantInviteRejectRequest(server string, body TenantInviteRejectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantInviteRejectRequestWithBody(server, "application/json", bodyReader)
+}
 
-	WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error)
+// NewTenantInviteRejectRequestWithBody generates requests for TenantInviteReject with any type of body
+func NewTenantInviteRejectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// CronWorkflowListWithResponse request
-	CronWorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*CronWorkflowListResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowCronDeleteWithResponse request
-	WorkflowCronDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronDeleteResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/users/invites/reject")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// WorkflowCronGetWithResponse request
-	WorkflowCronGetWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronGetResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunListWithResponse request
-	WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*WorkflowRunListResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunGetMetricsWithResponse request
-	WorkflowRunGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowRunGetMetricsResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// WorkflowScheduledListWithResponse request
-	WorkflowScheduledListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*WorkflowScheduledListResponse, error)
+	return req, nil
+}
 
-	// WorkflowScheduledDeleteWithResponse request
-	WorkflowScheduledDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledDeleteResponse, error)
+// NewUserUpdateLoginRequest calls the generic UserUpdateLogin builder with application/json body
+func NewUserUpdateLoginRequest(server string, body UserUpdateLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserUpdateLoginRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// WorkflowScheduledGetWithResponse request
-	WorkflowScheduledGetWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledGetResponse, error)
+// NewUserUpdateLoginRequestWithBody generates requests for UserUpdateLogin with any type of body
+func NewUserUpdateLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// CronWorkflowTriggerCreateWithBodyWithResponse request with any body
-	CronWorkflowTriggerCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CronWorkflowTriggerCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/users/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ScheduledWorkflowRunCreateWithBodyWithResponse request with any body
-	ScheduledWorkflowRunCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	ScheduledWorkflowRunCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowGetWorkersCountWithResponse request
-	WorkflowGetWorkersCountWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetWorkersCountResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// UserGetCurrentWithResponse request
-	UserGetCurrentWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetCurrentResponse, error)
+	return req, nil
+}
 
-	// UserUpdateGithubOauthCallbackWithResponse request
-	UserUpdateGithubOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthCallbackResponse, error)
+// NewUserUpdateLogoutRequest generates requests for UserUpdateLogout
+func NewUserUpdateLogoutRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantMembershipsListRequest generates requests for TenantMembershipsList
+func NewTenantMembershipsListRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/memberships")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateOidcOauthCallbackRequest generates requests for UserUpdateOidcOauthCallback
+func NewUserUpdateOidcOauthCallbackRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/oidc/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateOidcOauthStartRequest generates requests for UserUpdateOidcOauthStart
+func NewUserUpdateOidcOauthStartRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/oidc/start")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdatePasswordRequest calls the generic UserUpdatePassword builder with application/json body
+func NewUserUpdatePasswordRequest(server string, body UserUpdatePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserUpdatePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserUpdatePasswordRequestWithBody generates requests for UserUpdatePassword with any type of body
+func NewUserUpdatePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserCreateRequest calls the generic UserCreate builder with application/json body
+func NewUserCreateRequest(server string, body UserCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserCreateRequestWithBody generates requests for UserCreate with any type of body
+func NewUserCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserUpdateSlackOauthCallbackRequest generates requests for UserUpdateSlackOauthCallback
+func NewUserUpdateSlackOauthCallbackRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/slack/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewInfoGetVersionRequest generates requests for InfoGetVersion
+func NewInfoGetVersionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebhookDeleteRequest generates requests for WebhookDelete
+func NewWebhookDeleteRequest(server string, webhook openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhook-workers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebhookRequestsListRequest generates requests for WebhookRequestsList
+func NewWebhookRequestsListRequest(server string, webhook openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhook-workers/%s/requests", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkerGetRequest generates requests for WorkerGet
+func NewWorkerGetRequest(server string, worker openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker", runtime.ParamLocationPath, worker)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkerUpdateRequest calls the generic WorkerUpdate builder with application/json body
+func NewWorkerUpdateRequest(server string, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkerUpdateRequestWithBody(server, worker, "application/json", bodyReader)
+}
+
+// NewWorkerUpdateRequestWithBody generates requests for WorkerUpdate with any type of body
+func NewWorkerUpdateRequestWithBody(server string, worker openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker", runtime.ParamLocationPath, worker)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowDeleteRequest generates requests for WorkflowDelete
+func NewWorkflowDeleteRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowGetRequest generates requests for WorkflowGet
+func NewWorkflowGetRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowUpdateRequest calls the generic WorkflowUpdate builder with application/json body
+func NewWorkflowUpdateRequest(server string, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkflowUpdateRequestWithBody(server, workflow, "application/json", bodyReader)
+}
+
+// NewWorkflowUpdateRequestWithBody generates requests for WorkflowUpdate with any type of body
+func NewWorkflowUpdateRequestWithBody(server string, workflow openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowGetMetricsRequest generates requests for WorkflowGetMetrics
+func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, params *WorkflowGetMetricsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/metrics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GroupKey != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groupKey", runtime.ParamLocationQuery, *params.GroupKey); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowRunCreateRequest calls the generic WorkflowRunCreate builder with application/json body
+func NewWorkflowRunCreateRequest(server string, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkflowRunCreateRequestWithBody(server, workflow, params, "application/json", bodyReader)
+}
+
+// NewWorkflowRunCreateRequestWithBody generates requests for WorkflowRunCreate with any type of body
+func NewWorkflowRunCreateRequestWithBody(server string, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/trigger", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Version != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowVersionGetRequest generates requests for WorkflowVersionGet
+func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, params *WorkflowVersionGetParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/versions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Version != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV2DagListTasksRequest generates requests for V2DagListTasks
+func NewV2DagListTasksRequest(server string, params *V2DagListTasksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/dags/tasks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dag_ids", runtime.ParamLocationQuery, params.DagIds); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tenant", runtime.ParamLocationQuery, params.Tenant); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV2TaskGetRequest generates requests for V2TaskGet
+func NewV2TaskGetRequest(server string, task openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task", runtime.ParamLocationPath, task)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/tasks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV2TaskGetQueueDiagnosisRequest generates requests for V2TaskGetQueueDiagnosis
+func NewV2TaskGetQueueDiagnosisRequest(server string, task openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task", runtime.ParamLocationPath, task)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/tasks/%s/queue-diagnosis", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV2TaskEventListRequest generates requests for V2TaskEventList
+func NewV2TaskEventListRequest(server string, task openapi_types.UUID, params *V2TaskEventListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task", runtime.ParamLocationPath, task)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/tasks/%s/task-events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV2ConcurrencyListRequest generates requests for V2ConcurrencyList
+func NewV2ConcurrencyListRequest(server string, tenant openapi_types.UUID, params *V2ConcurrencyListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/tenants/%s/concurrency", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.KeyLimit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key_limit", runtime.ParamLocationQuery, *params.KeyLimit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV2RateLimitListRequest generates requests for V2RateLimitList
+func NewV2RateLimitListRequest(server string, tenant openapi_types.UUID, params *V2RateLimitListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/tenants/%s/rate-limits", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV2TaskListStatusMetricsRequest generates requests for V2TaskListStatusMetrics
+func NewV2TaskListStatusMetricsRequest(server string, tenant openapi_types.UUID, params *V2TaskListStatusMetricsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/tenants/%s/task-metrics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.WorkflowIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflow_ids", runtime.ParamLocationQuery, *params.WorkflowIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV2TaskGetPointMetricsRequest generates requests for V2TaskGetPointMetrics
+func NewV2TaskGetPointMetricsRequest(server string, tenant openapi_types.UUID, params *V2TaskGetPointMetricsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/tenants/%s/task-point-metrics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.CreatedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FinishedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedBefore", runtime.ParamLocationQuery, *params.FinishedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV2TaskListRequest generates requests for V2TaskList
+func NewV2TaskListRequest(server string, tenant openapi_types.UUID, params *V2TaskListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/tenants/%s/tasks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Statuses != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AdditionalMetadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additional_metadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WorkflowIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflow_ids", runtime.ParamLocationQuery, *params.WorkflowIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WorkerId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "worker_id", runtime.ParamLocationQuery, *params.WorkerId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DisplayName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display_name", runtime.ParamLocationQuery, *params.DisplayName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ErrorContains != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error_contains", runtime.ParamLocationQuery, *params.ErrorContains); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ErrorRegex != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error_regex", runtime.ParamLocationQuery, *params.ErrorRegex); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.InputFilters != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "input_filters", runtime.ParamLocationQuery, *params.InputFilters); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OutputFilters != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "output_filters", runtime.ParamLocationQuery, *params.OutputFilters); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV2TaskCancelRequest calls the generic V2TaskCancel builder with application/json body
+func NewV2TaskCancelRequest(server string, tenant openapi_types.UUID, body V2TaskCancelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewV2TaskCancelRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewV2TaskCancelRequestWithBody generates requests for V2TaskCancel with any type of body
+func NewV2TaskCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/tenants/%s/tasks/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewV2TaskReplayRequest calls the generic V2TaskReplay builder with application/json body
+func NewV2TaskReplayRequest(server string, tenant openapi_types.UUID, body V2TaskReplayJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewV2TaskReplayRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewV2TaskReplayRequestWithBody generates requests for V2TaskReplay with any type of body
+func NewV2TaskReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/tenants/%s/tasks/replay", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewV2WorkflowRunListRequest generates requests for V2WorkflowRunList
+func NewV2WorkflowRunListRequest(server string, tenant openapi_types.UUID, params *V2WorkflowRunListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/tenants/%s/workflow-runs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Statuses != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AdditionalMetadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additional_metadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WorkflowIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflow_ids", runtime.ParamLocationQuery, *params.WorkflowIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV2WorkflowRunCancelRequest calls the generic V2WorkflowRunCancel builder with application/json body
+func NewV2WorkflowRunCancelRequest(server string, tenant openapi_types.UUID, body V2WorkflowRunCancelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewV2WorkflowRunCancelRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewV2WorkflowRunCancelRequestWithBody generates requests for V2WorkflowRunCancel with any type of body
+func NewV2WorkflowRunCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/tenants/%s/workflow-runs/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewV2WorkflowRunReplayRequest calls the generic V2WorkflowRunReplay builder with application/json body
+func NewV2WorkflowRunReplayRequest(server string, tenant openapi_types.UUID, body V2WorkflowRunReplayJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewV2WorkflowRunReplayRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewV2WorkflowRunReplayRequestWithBody generates requests for V2WorkflowRunReplay with any type of body
+func NewV2WorkflowRunReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/tenants/%s/workflow-runs/replay", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewV2WorkflowRunGetRequest generates requests for V2WorkflowRunGet
+func NewV2WorkflowRunGetRequest(server string, v2WorkflowRun openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "v2-workflow-run", runtime.ParamLocationPath, v2WorkflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/workflow-runs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV2WorkflowRunReplayFromTaskRequest calls the generic V2WorkflowRunReplayFromTask builder with application/json body
+func NewV2WorkflowRunReplayFromTaskRequest(server string, v2WorkflowRun openapi_types.UUID, body V2WorkflowRunReplayFromTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewV2WorkflowRunReplayFromTaskRequestWithBody(server, v2WorkflowRun, "application/json", bodyReader)
+}
+
+// NewV2WorkflowRunReplayFromTaskRequestWithBody generates requests for V2WorkflowRunReplayFromTask with any type of body
+func NewV2WorkflowRunReplayFromTaskRequestWithBody(server string, v2WorkflowRun openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "v2-workflow-run", runtime.ParamLocationPath, v2WorkflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/workflow-runs/%s/replay-from-task", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewV2WorkflowRunTaskEventsListRequest generates requests for V2WorkflowRunTaskEventsList
+func NewV2WorkflowRunTaskEventsListRequest(server string, v2WorkflowRun openapi_types.UUID, params *V2WorkflowRunTaskEventsListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "v2-workflow-run", runtime.ParamLocationPath, v2WorkflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/workflow-runs/%s/task-events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// LivenessGetWithResponse request
+	LivenessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivenessGetResponse, error)
+
+	// ReadinessGetWithResponse request
+	ReadinessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadinessGetResponse, error)
+
+	// AlertEmailGroupDeleteWithResponse request
+	AlertEmailGroupDeleteWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupDeleteResponse, error)
+
+	// AlertEmailGroupUpdateWithBodyWithResponse request with any body
+	AlertEmailGroupUpdateWithBodyWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error)
+
+	AlertEmailGroupUpdateWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error)
+
+	// ApiTokenUpdateRevokeWithResponse request
+	ApiTokenUpdateRevokeWithResponse(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRevokeResponse, error)
+
+	// CloudMetadataGetWithResponse request
+	CloudMetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CloudMetadataGetResponse, error)
+
+	// EventGetWithResponse request
+	EventGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventGetResponse, error)
+
+	// EventDataGetWithResponse request
+	EventDataGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDataGetResponse, error)
+
+	// MetadataGetWithResponse request
+	MetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataGetResponse, error)
+
+	// MetadataListIntegrationsWithResponse request
+	MetadataListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataListIntegrationsResponse, error)
+
+	// MonitoringPostRunProbeWithResponse request
+	MonitoringPostRunProbeWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*MonitoringPostRunProbeResponse, error)
+
+	// SlackWebhookDeleteWithResponse request
+	SlackWebhookDeleteWithResponse(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookDeleteResponse, error)
+
+	// SnsDeleteWithResponse request
+	SnsDeleteWithResponse(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsDeleteResponse, error)
+
+	// SnsUpdateWithResponse request
+	SnsUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*SnsUpdateResponse, error)
+
+	// StepRunListArchivesWithResponse request
+	StepRunListArchivesWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*StepRunListArchivesResponse, error)
+
+	// StepRunListEventsWithResponse request
+	StepRunListEventsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*StepRunListEventsResponse, error)
+
+	// LogLineListWithResponse request
+	LogLineListWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*LogLineListResponse, error)
+
+	// TenantCreateWithBodyWithResponse request with any body
+	TenantCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error)
+
+	TenantCreateWithResponse(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error)
+
+	// TenantUpdateWithBodyWithResponse request with any body
+	TenantUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error)
+
+	TenantUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error)
+
+	// AlertEmailGroupListWithResponse request
+	AlertEmailGroupListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupListResponse, error)
+
+	// AlertEmailGroupCreateWithBodyWithResponse request with any body
+	AlertEmailGroupCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error)
+
+	AlertEmailGroupCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error)
+
+	// TenantAlertingSettingsGetWithResponse request
+	TenantAlertingSettingsGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantAlertingSettingsGetResponse, error)
+
+	// ApiTokenListWithResponse request
+	ApiTokenListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenListResponse, error)
+
+	// ApiTokenCreateWithBodyWithResponse request with any body
+	ApiTokenCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error)
+
+	ApiTokenCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error)
+
+	// AuditLogListWithResponse request
+	AuditLogListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *AuditLogListParams, reqEditors ...RequestEditorFn) (*AuditLogListResponse, error)
+
+	// EventListWithResponse request
+	EventListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*EventListResponse, error)
+
+	// EventCreateWithBodyWithResponse request with any body
+	EventCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateResponse, error)
+
+	EventCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateResponse, error)
+
+	// EventCreateBulkWithBodyWithResponse request with any body
+	EventCreateBulkWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error)
+
+	EventCreateBulkWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error)
+
+	// EventUpdateCancelWithBodyWithResponse request with any body
+	EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error)
+
+	EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error)
+
+	// EventKeyListWithResponse request
+	EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error)
+
+	// EventUpdateReplayWithBodyWithResponse request with any body
+	EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
+
+	EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
+
+	// TenantInviteListWithResponse request
+	TenantInviteListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteListResponse, error)
+
+	// TenantInviteCreateWithBodyWithResponse request with any body
+	TenantInviteCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error)
+
+	TenantInviteCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error)
+
+	// TenantInviteDeleteWithResponse request
+	TenantInviteDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteDeleteResponse, error)
+
+	// TenantInviteUpdateWithBodyWithResponse request with any body
+	TenantInviteUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error)
+
+	TenantInviteUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error)
+
+	// TenantMemberListWithResponse request
+	TenantMemberListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberListResponse, error)
+
+	// TenantMemberDeleteWithResponse request
+	TenantMemberDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberDeleteResponse, error)
+
+	// TenantMemberUpdateWithBodyWithResponse request with any body
+	TenantMemberUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantMemberUpdateResponse, error)
+
+	TenantMemberUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, body TenantMemberUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantMemberUpdateResponse, error)
+
+	// MessageQueueDeadLetterListWithResponse request
+	MessageQueueDeadLetterListWithResponse(ctx context.Context, tenant openapi_types.UUID, messageQueue string, params *MessageQueueDeadLetterListParams, reqEditors ...RequestEditorFn) (*MessageQueueDeadLetterListResponse, error)
+
+	// MessageQueueDeadLetterPurgeWithBodyWithResponse request with any body
+	MessageQueueDeadLetterPurgeWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, messageQueue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MessageQueueDeadLetterPurgeResponse, error)
+
+	MessageQueueDeadLetterPurgeWithResponse(ctx context.Context, tenant openapi_types.UUID, messageQueue string, body MessageQueueDeadLetterPurgeJSONRequestBody, reqEditors ...RequestEditorFn) (*MessageQueueDeadLetterPurgeResponse, error)
+
+	// MessageQueueDeadLetterReplayWithBodyWithResponse request with any body
+	MessageQueueDeadLetterReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, messageQueue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MessageQueueDeadLetterReplayResponse, error)
+
+	MessageQueueDeadLetterReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, messageQueue string, body MessageQueueDeadLetterReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*MessageQueueDeadLetterReplayResponse, error)
+
+	// TenantGetQueueMetricsWithResponse request
+	TenantGetQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*TenantGetQueueMetricsResponse, error)
+
+	// RateLimitListWithResponse request
+	RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*RateLimitListResponse, error)
+
+	// TenantResourcePolicyGetWithResponse request
+	TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error)
+
+	// TenantRoleListWithResponse request
+	TenantRoleListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantRoleListResponse, error)
+
+	// TenantRoleCreateWithBodyWithResponse request with any body
+	TenantRoleCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantRoleCreateResponse, error)
+
+	TenantRoleCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantRoleCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantRoleCreateResponse, error)
+
+	// TenantRoleDeleteWithResponse request
+	TenantRoleDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantRoleDeleteResponse, error)
+
+	// TenantRoleUpdateWithBodyWithResponse request with any body
+	TenantRoleUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantRoleUpdateResponse, error)
+
+	TenantRoleUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, body TenantRoleUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantRoleUpdateResponse, error)
+
+	// SlackWebhookListWithResponse request
+	SlackWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookListResponse, error)
+
+	// UserUpdateSlackOauthStartWithResponse request
+	UserUpdateSlackOauthStartWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthStartResponse, error)
+
+	// SnsListWithResponse request
+	SnsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsListResponse, error)
+
+	// SnsCreateWithBodyWithResponse request with any body
+	SnsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error)
+
+	SnsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error)
+
+	// TenantGetStepRunQueueMetricsWithResponse request
+	TenantGetStepRunQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantGetStepRunQueueMetricsResponse, error)
+
+	// StepRunGetWithResponse request
+	StepRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetResponse, error)
+
+	// StepRunUpdateCancelWithResponse request
+	StepRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateCancelResponse, error)
+
+	// StepRunUpdateRerunWithBodyWithResponse request with any body
+	StepRunUpdateRerunWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error)
+
+	StepRunUpdateRerunWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error)
+
+	// StepRunGetSchemaWithResponse request
+	StepRunGetSchemaWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetSchemaResponse, error)
+
+	// WebhookSubscriptionListWithResponse request
+	WebhookSubscriptionListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookSubscriptionListResponse, error)
+
+	// WebhookSubscriptionCreateWithBodyWithResponse request with any body
+	WebhookSubscriptionCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookSubscriptionCreateResponse, error)
+
+	WebhookSubscriptionCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookSubscriptionCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookSubscriptionCreateResponse, error)
+
+	// WebhookSubscriptionDeleteWithResponse request
+	WebhookSubscriptionDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookSubscriptionDeleteResponse, error)
+
+	// WebhookSubscriptionUpdateWithBodyWithResponse request with any body
+	WebhookSubscriptionUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookSubscriptionUpdateResponse, error)
+
+	WebhookSubscriptionUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, body WebhookSubscriptionUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookSubscriptionUpdateResponse, error)
+
+	// WebhookDeliveryListWithResponse request
+	WebhookDeliveryListWithResponse(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, params *WebhookDeliveryListParams, reqEditors ...RequestEditorFn) (*WebhookDeliveryListResponse, error)
+
+	// WebhookListWithResponse request
+	WebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookListResponse, error)
+
+	// WebhookCreateWithBodyWithResponse request with any body
+	WebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error)
+
+	WebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error)
+
+	// WorkerListWithResponse request
+	WorkerListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerListResponse, error)
+
+	// WorkflowRunUpdateReplayWithBodyWithResponse request with any body
+	WorkflowRunUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error)
+
+	WorkflowRunUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error)
+
+	// WorkflowRunGetWithResponse request
+	WorkflowRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetResponse, error)
+
+	// WorkflowRunGetInputWithResponse request
+	WorkflowRunGetInputWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetInputResponse, error)
+
+	// WorkflowRunGetShapeWithResponse request
+	WorkflowRunGetShapeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetShapeResponse, error)
+
+	// WorkflowRunListStepRunEventsWithResponse request
+	WorkflowRunListStepRunEventsWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*WorkflowRunListStepRunEventsResponse, error)
+
+	// WorkflowListWithResponse request
+	WorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowListParams, reqEditors ...RequestEditorFn) (*WorkflowListResponse, error)
+
+	// WorkflowRunCancelWithBodyWithResponse request with any body
+	WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error)
+
+	WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error)
+
+	// CronWorkflowListWithResponse request
+	CronWorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*CronWorkflowListResponse, error)
+
+	// WorkflowCronDeleteWithResponse request
+	WorkflowCronDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronDeleteResponse, error)
+
+	// WorkflowCronGetWithResponse request
+	WorkflowCronGetWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronGetResponse, error)
+
+	// WorkflowCronUpdateWithBodyWithResponse request with any body
+	WorkflowCronUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowCronUpdateResponse, error)
+
+	WorkflowCronUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, body WorkflowCronUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowCronUpdateResponse, error)
+
+	// WorkflowRunListWithResponse request
+	WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*WorkflowRunListResponse, error)
+
+	// WorkflowRunGetMetricsWithResponse request
+	WorkflowRunGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowRunGetMetricsResponse, error)
+
+	// WorkflowScheduledListWithResponse request
+	WorkflowScheduledListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*WorkflowScheduledListResponse, error)
+
+	// WorkflowScheduledDeleteWithResponse request
+	WorkflowScheduledDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledDeleteResponse, error)
+
+	// WorkflowScheduledGetWithResponse request
+	WorkflowScheduledGetWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledGetResponse, error)
+
+	// CronWorkflowTriggerCreateWithBodyWithResponse request with any body
+	CronWorkflowTriggerCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error)
+
+	CronWorkflowTriggerCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error)
+
+	// ScheduledWorkflowRunCreateWithBodyWithResponse request with any body
+	ScheduledWorkflowRunCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error)
+
+	ScheduledWorkflowRunCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error)
+
+	// WorkflowGetWorkersCountWithResponse request
+	WorkflowGetWorkersCountWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetWorkersCountResponse, error)
+
+	// UserGetCurrentWithResponse request
+	UserGetCurrentWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetCurrentResponse, error)
+
+	// UserUpdateGithubOauthCallbackWithResponse request
+	UserUpdateGithubOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthCallbackResponse, error)
 
 	// UserUpdateGithubOauthStartWithResponse request
 	UserUpdateGithubOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthStartResponse, error)
 
-	// UserUpdateGoogleOauthCallbackWithResponse request
-	UserUpdateGoogleOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthCallbackResponse, error)
+	// UserUpdateGoogleOauthCallbackWithResponse request
+	UserUpdateGoogleOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthCallbackResponse, error)
+
+	// UserUpdateGoogleOauthStartWithResponse request
+	UserUpdateGoogleOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthStartResponse, error)
+
+	// UserListTenantInvitesWithResponse request
+	UserListTenantInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListTenantInvitesResponse, error)
+
+	// TenantInviteAcceptWithBodyWithResponse request with any body
+	TenantInviteAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error)
+
+	TenantInviteAcceptWithResponse(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error)
+
+	// TenantInviteRejectWithBodyWithResponse request with any body
+	TenantInviteRejectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error)
+
+	TenantInviteRejectWithResponse(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error)
+
+	// UserUpdateLoginWithBodyWithResponse request with any body
+	UserUpdateLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error)
+
+	UserUpdateLoginWithResponse(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error)
+
+	// UserUpdateLogoutWithResponse request
+	UserUpdateLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateLogoutResponse, error)
+
+	// TenantMembershipsListWithResponse request
+	TenantMembershipsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TenantMembershipsListResponse, error)
+
+	// UserUpdateOidcOauthCallbackWithResponse request
+	UserUpdateOidcOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateOidcOauthCallbackResponse, error)
+
+	// UserUpdateOidcOauthStartWithResponse request
+	UserUpdateOidcOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateOidcOauthStartResponse, error)
+
+	// UserUpdatePasswordWithBodyWithResponse request with any body
+	UserUpdatePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error)
+
+	UserUpdatePasswordWithResponse(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error)
+
+	// UserCreateWithBodyWithResponse request with any body
+	UserCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateResponse, error)
+
+	UserCreateWithResponse(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateResponse, error)
+
+	// UserUpdateSlackOauthCallbackWithResponse request
+	UserUpdateSlackOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthCallbackResponse, error)
+
+	// InfoGetVersionWithResponse request
+	InfoGetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*InfoGetVersionResponse, error)
+
+	// WebhookDeleteWithResponse request
+	WebhookDeleteWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeleteResponse, error)
+
+	// WebhookRequestsListWithResponse request
+	WebhookRequestsListWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookRequestsListResponse, error)
+
+	// WorkerGetWithResponse request
+	WorkerGetWithResponse(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerGetResponse, error)
+
+	// WorkerUpdateWithBodyWithResponse request with any body
+	WorkerUpdateWithBodyWithResponse(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error)
+
+	WorkerUpdateWithResponse(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error)
+
+	// WorkflowDeleteWithResponse request
+	WorkflowDeleteWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteResponse, error)
+
+	// WorkflowGetWithResponse request
+	WorkflowGetWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetResponse, error)
+
+	// WorkflowUpdateWithBodyWithResponse request with any body
+	WorkflowUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error)
+
+	WorkflowUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error)
+
+	// WorkflowGetMetricsWithResponse request
+	WorkflowGetMetricsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowGetMetricsResponse, error)
+
+	// WorkflowRunCreateWithBodyWithResponse request with any body
+	WorkflowRunCreateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error)
+
+	WorkflowRunCreateWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error)
+
+	// WorkflowVersionGetWithResponse request
+	WorkflowVersionGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionGetResponse, error)
+
+	// V2DagListTasksWithResponse request
+	V2DagListTasksWithResponse(ctx context.Context, params *V2DagListTasksParams, reqEditors ...RequestEditorFn) (*V2DagListTasksResponse, error)
+
+	// V2TaskGetWithResponse request
+	V2TaskGetWithResponse(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*V2TaskGetResponse, error)
+
+	// V2TaskGetQueueDiagnosisWithResponse request
+	V2TaskGetQueueDiagnosisWithResponse(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*V2TaskGetQueueDiagnosisResponse, error)
+
+	// V2TaskEventListWithResponse request
+	V2TaskEventListWithResponse(ctx context.Context, task openapi_types.UUID, params *V2TaskEventListParams, reqEditors ...RequestEditorFn) (*V2TaskEventListResponse, error)
+
+	// V2ConcurrencyListWithResponse request
+	V2ConcurrencyListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2ConcurrencyListParams, reqEditors ...RequestEditorFn) (*V2ConcurrencyListResponse, error)
+
+	// V2RateLimitListWithResponse request
+	V2RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2RateLimitListParams, reqEditors ...RequestEditorFn) (*V2RateLimitListResponse, error)
+
+	// V2TaskListStatusMetricsWithResponse request
+	V2TaskListStatusMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListStatusMetricsParams, reqEditors ...RequestEditorFn) (*V2TaskListStatusMetricsResponse, error)
+
+	// V2TaskGetPointMetricsWithResponse request
+	V2TaskGetPointMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2TaskGetPointMetricsParams, reqEditors ...RequestEditorFn) (*V2TaskGetPointMetricsResponse, error)
+
+	// V2TaskListWithResponse request
+	V2TaskListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListParams, reqEditors ...RequestEditorFn) (*V2TaskListResponse, error)
+
+	// V2TaskCancelWithBodyWithResponse request with any body
+	V2TaskCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V2TaskCancelResponse, error)
+
+	V2TaskCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body V2TaskCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*V2TaskCancelResponse, error)
+
+	// V2TaskReplayWithBodyWithResponse request with any body
+	V2TaskReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V2TaskReplayResponse, error)
+
+	V2TaskReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body V2TaskReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*V2TaskReplayResponse, error)
+
+	// V2WorkflowRunListWithResponse request
+	V2WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2WorkflowRunListParams, reqEditors ...RequestEditorFn) (*V2WorkflowRunListResponse, error)
+
+	// V2WorkflowRunCancelWithBodyWithResponse request with any body
+	V2WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V2WorkflowRunCancelResponse, error)
+
+	V2WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body V2WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*V2WorkflowRunCancelResponse, error)
+
+	// V2WorkflowRunReplayWithBodyWithResponse request with any body
+	V2WorkflowRunReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V2WorkflowRunReplayResponse, error)
+
+	V2WorkflowRunReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body V2WorkflowRunReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*V2WorkflowRunReplayResponse, error)
+
+	// V2WorkflowRunGetWithResponse request
+	V2WorkflowRunGetWithResponse(ctx context.Context, v2WorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*V2WorkflowRunGetResponse, error)
+
+	// V2WorkflowRunReplayFromTaskWithBodyWithResponse request with any body
+	V2WorkflowRunReplayFromTaskWithBodyWithResponse(ctx context.Context, v2WorkflowRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V2WorkflowRunReplayFromTaskResponse, error)
+
+	V2WorkflowRunReplayFromTaskWithResponse(ctx context.Context, v2WorkflowRun openapi_types.UUID, body V2WorkflowRunReplayFromTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*V2WorkflowRunReplayFromTaskResponse, error)
+
+	// V2WorkflowRunTaskEventsListWithResponse request
+	V2WorkflowRunTaskEventsListWithResponse(ctx context.Context, v2WorkflowRun openapi_types.UUID, params *V2WorkflowRunTaskEventsListParams, reqEditors ...RequestEditorFn) (*V2WorkflowRunTaskEventsListResponse, error)
+}
+
+type LivenessGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r LivenessGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LivenessGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadinessGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadinessGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadinessGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertEmailGroupDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertEmailGroupDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertEmailGroupDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertEmailGroupUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantAlertEmailGroup
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertEmailGroupUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertEmailGroupUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiTokenUpdateRevokeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiTokenUpdateRevokeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiTokenUpdateRevokeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloudMetadataGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIErrors
+	JSON400      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r CloudMetadataGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloudMetadataGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Event
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventDataGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventData
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventDataGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventDataGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIMeta
+	JSON400      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataListIntegrationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListAPIMetaIntegration
+	JSON400      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataListIntegrationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataListIntegrationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MonitoringPostRunProbeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r MonitoringPostRunProbeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MonitoringPostRunProbeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SlackWebhookDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SlackWebhookDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SlackWebhookDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnsDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SnsDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnsDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnsUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SnsUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnsUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunListArchivesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRunArchiveList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunListArchivesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunListArchivesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunListEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRunEventList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunListEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunListEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogLineListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogLineList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r LogLineListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogLineListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tenant
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tenant
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertEmailGroupListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantAlertEmailGroupList
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertEmailGroupListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertEmailGroupListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertEmailGroupCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TenantAlertEmailGroup
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertEmailGroupCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertEmailGroupCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantAlertingSettingsGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantAlertingSettings
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantAlertingSettingsGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantAlertingSettingsGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiTokenListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListAPITokensResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiTokenListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiTokenListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiTokenCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateAPITokenResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiTokenCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiTokenCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuditLogListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditLogList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r AuditLogListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuditLogListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Event
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventCreateBulkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Events
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventCreateBulkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventCreateBulkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventUpdateCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+	}
+	JSON400 *APIErrors
+	JSON403 *APIErrors
+	JSON429 *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventUpdateCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventUpdateCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventKeyListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventKeyList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventKeyListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventKeyListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventUpdateReplayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventUpdateReplayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventUpdateReplayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantInviteListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantInviteList
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantInviteListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantInviteListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UserUpdateGoogleOauthStartWithResponse request
-	UserUpdateGoogleOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthStartResponse, error)
+type TenantInviteCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TenantInvite
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
 
-	// UserListTenantInvitesWithResponse request
-	UserListTenantInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListTenantInvitesResponse, error)
+// Status returns HTTPResponse.Status
+func (r TenantInviteCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// TenantInviteAcceptWithBodyWithResponse request with any body
-	TenantInviteAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantInviteCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	TenantInviteAcceptWithResponse(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error)
+type TenantInviteDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantInvite
+	JSON400      *APIErrors
+}
 
-	// TenantInviteRejectWithBodyWithResponse request with any body
-	TenantInviteRejectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error)
+// Status returns HTTPResponse.Status
+func (r TenantInviteDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	TenantInviteRejectWithResponse(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantInviteDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UserUpdateLoginWithBodyWithResponse request with any body
-	UserUpdateLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error)
+type TenantInviteUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantInvite
+	JSON400      *APIErrors
+}
 
-	UserUpdateLoginWithResponse(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error)
+// Status returns HTTPResponse.Status
+func (r TenantInviteUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UserUpdateLogoutWithResponse request
-	UserUpdateLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateLogoutResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantInviteUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// TenantMembershipsListWithResponse request
-	TenantMembershipsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TenantMembershipsListResponse, error)
+type TenantMemberListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantMemberList
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
 
-	// UserUpdatePasswordWithBodyWithResponse request with any body
-	UserUpdatePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error)
+// Status returns HTTPResponse.Status
+func (r TenantMemberListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	UserUpdatePasswordWithResponse(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantMemberListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UserCreateWithBodyWithResponse request with any body
-	UserCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateResponse, error)
+type TenantMemberDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON204      *TenantMember
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
 
-	UserCreateWithResponse(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateResponse, error)
+// Status returns HTTPResponse.Status
+func (r TenantMemberDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UserUpdateSlackOauthCallbackWithResponse request
-	UserUpdateSlackOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthCallbackResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantMemberDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// InfoGetVersionWithResponse request
-	InfoGetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*InfoGetVersionResponse, error)
+type TenantMemberUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantMember
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantMemberUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantMemberUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MessageQueueDeadLetterListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeadLetterList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r MessageQueueDeadLetterListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MessageQueueDeadLetterListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MessageQueueDeadLetterPurgeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurgeDeadLettersResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r MessageQueueDeadLetterPurgeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MessageQueueDeadLetterPurgeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MessageQueueDeadLetterReplayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReplayDeadLettersResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r MessageQueueDeadLetterReplayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MessageQueueDeadLetterReplayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantGetQueueMetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantQueueMetrics
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantGetQueueMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantGetQueueMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RateLimitListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RateLimitList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r RateLimitListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// WebhookDeleteWithResponse request
-	WebhookDeleteWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeleteResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r RateLimitListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// WebhookRequestsListWithResponse request
-	WebhookRequestsListWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookRequestsListResponse, error)
+type TenantResourcePolicyGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantResourcePolicy
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
 
-	// WorkerGetWithResponse request
-	WorkerGetWithResponse(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerGetResponse, error)
+// Status returns HTTPResponse.Status
+func (r TenantResourcePolicyGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// WorkerUpdateWithBodyWithResponse request with any body
-	WorkerUpdateWithBodyWithResponse(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantResourcePolicyGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	WorkerUpdateWithResponse(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error)
+type TenantRoleListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantRoleList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
 
-	// WorkflowDeleteWithResponse request
-	WorkflowDeleteWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteResponse, error)
+// Status returns HTTPResponse.Status
+func (r TenantRoleListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// WorkflowGetWithResponse request
-	WorkflowGetWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantRoleListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// WorkflowUpdateWithBodyWithResponse request with any body
-	WorkflowUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error)
+type TenantRoleCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TenantRole
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
 
-	WorkflowUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error)
+// Status returns HTTPResponse.Status
+func (r TenantRoleCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// WorkflowGetMetricsWithResponse request
-	WorkflowGetMetricsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowGetMetricsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantRoleCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// WorkflowRunCreateWithBodyWithResponse request with any body
-	WorkflowRunCreateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error)
+type TenantRoleDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantRole
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
 
-	WorkflowRunCreateWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error)
+// Status returns HTTPResponse.Status
+func (r TenantRoleDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// WorkflowVersionGetWithResponse request
-	WorkflowVersionGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionGetResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantRoleDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// V2DagListTasksWithResponse request
-	V2DagListTasksWithResponse(ctx context.Context, params *V2DagListTasksParams, reqEditors ...RequestEditorFn) (*V2DagListTasksResponse, error)
+type TenantRoleUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantRole
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
 
-	// V2TaskGetWithResponse request
-	V2TaskGetWithResponse(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*V2TaskGetResponse, error)
+// Status returns HTTPResponse.Status
+func (r TenantRoleUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// V2TaskEventListWithResponse request
-	V2TaskEventListWithResponse(ctx context.Context, task openapi_types.UUID, params *V2TaskEventListParams, reqEditors ...RequestEditorFn) (*V2TaskEventListResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantRoleUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// V2TaskListStatusMetricsWithResponse request
-	V2TaskListStatusMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListStatusMetricsParams, reqEditors ...RequestEditorFn) (*V2TaskListStatusMetricsResponse, error)
+type SlackWebhookListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListSlackWebhooks
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
 
-	// V2TaskGetPointMetricsWithResponse request
-	V2TaskGetPointMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2TaskGetPointMetricsParams, reqEditors ...RequestEditorFn) (*V2TaskGetPointMetricsResponse, error)
+// Status returns HTTPResponse.Status
+func (r SlackWebhookListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// V2TaskListWithResponse request
-	V2TaskListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListParams, reqEditors ...RequestEditorFn) (*V2TaskListResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r SlackWebhookListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// V2WorkflowRunListWithResponse request
-	V2WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2WorkflowRunListParams, reqEditors ...RequestEditorFn) (*V2WorkflowRunListResponse, error)
+type UserUpdateSlackOauthStartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	// V2WorkflowRunGetWithResponse request
-	V2WorkflowRunGetWithResponse(ctx context.Context, v2WorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*V2WorkflowRunGetResponse, error)
+// Status returns HTTPResponse.Status
+func (r UserUpdateSlackOauthStartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// V2WorkflowRunTaskEventsListWithResponse request
-	V2WorkflowRunTaskEventsListWithResponse(ctx context.Context, v2WorkflowRun openapi_types.UUID, params *V2WorkflowRunTaskEventsListParams, reqEditors ...RequestEditorFn) (*V2WorkflowRunTaskEventsListResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserUpdateSlackOauthStartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type LivenessGetResponse struct {
+type SnsListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ListSNSIntegrations
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r LivenessGetResponse) Status() string {
+func (r SnsListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10029,20 +14250,24 @@ func (r LivenessGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r LivenessGetResponse) StatusCode() int {
+func (r SnsListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ReadinessGetResponse struct {
+type SnsCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON201      *SNSIntegration
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r ReadinessGetResponse) Status() string {
+func (r SnsCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10050,22 +14275,24 @@ func (r ReadinessGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ReadinessGetResponse) StatusCode() int {
+func (r SnsCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AlertEmailGroupDeleteResponse struct {
+type TenantGetStepRunQueueMetricsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *TenantStepRunQueueMetrics
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r AlertEmailGroupDeleteResponse) Status() string {
+func (r TenantGetStepRunQueueMetricsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10073,23 +14300,24 @@ func (r AlertEmailGroupDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AlertEmailGroupDeleteResponse) StatusCode() int {
+func (r TenantGetStepRunQueueMetricsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AlertEmailGroupUpdateResponse struct {
+type StepRunGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantAlertEmailGroup
+	JSON200      *StepRun
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r AlertEmailGroupUpdateResponse) Status() string {
+func (r StepRunGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10097,22 +14325,23 @@ func (r AlertEmailGroupUpdateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AlertEmailGroupUpdateResponse) StatusCode() int {
+func (r StepRunGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ApiTokenUpdateRevokeResponse struct {
+type StepRunUpdateCancelResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *StepRun
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r ApiTokenUpdateRevokeResponse) Status() string {
+func (r StepRunUpdateCancelResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10120,22 +14349,23 @@ func (r ApiTokenUpdateRevokeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ApiTokenUpdateRevokeResponse) StatusCode() int {
+func (r StepRunUpdateCancelResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CloudMetadataGetResponse struct {
+type StepRunUpdateRerunResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *APIErrors
+	JSON200      *StepRun
 	JSON400      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r CloudMetadataGetResponse) Status() string {
+func (r StepRunUpdateRerunResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10143,23 +14373,24 @@ func (r CloudMetadataGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CloudMetadataGetResponse) StatusCode() int {
+func (r StepRunUpdateRerunResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventGetResponse struct {
+type StepRunGetSchemaResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Event
+	JSON200      *map[string]interface{}
 	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventGetResponse) Status() string {
+func (r StepRunGetSchemaResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10167,23 +14398,23 @@ func (r EventGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventGetResponse) StatusCode() int {
+func (r StepRunGetSchemaResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventDataGetResponse struct {
+type WebhookSubscriptionListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EventData
+	JSON200      *WebhookSubscriptionList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventDataGetResponse) Status() string {
+func (r WebhookSubscriptionListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10191,22 +14422,23 @@ func (r EventDataGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventDataGetResponse) StatusCode() int {
+func (r WebhookSubscriptionListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type MetadataGetResponse struct {
+type WebhookSubscriptionCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *APIMeta
+	JSON201      *WebhookSubscription
 	JSON400      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r MetadataGetResponse) Status() string {
+func (r WebhookSubscriptionCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10214,22 +14446,24 @@ func (r MetadataGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r MetadataGetResponse) StatusCode() int {
+func (r WebhookSubscriptionCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type MetadataListIntegrationsResponse struct {
+type WebhookSubscriptionDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ListAPIMetaIntegration
+	JSON200      *WebhookSubscription
 	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r MetadataListIntegrationsResponse) Status() string {
+func (r WebhookSubscriptionDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10237,21 +14471,24 @@ func (r MetadataListIntegrationsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r MetadataListIntegrationsResponse) StatusCode() int {
+func (r WebhookSubscriptionDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type MonitoringPostRunProbeResponse struct {
+type WebhookSubscriptionUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WebhookSubscription
+	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r MonitoringPostRunProbeResponse) Status() string {
+func (r WebhookSubscriptionUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10259,23 +14496,24 @@ func (r MonitoringPostRunProbeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r MonitoringPostRunProbeResponse) StatusCode() int {
+func (r WebhookSubscriptionUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SlackWebhookDeleteResponse struct {
+type WebhookDeliveryListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WebhookDeliveryList
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SlackWebhookDeleteResponse) Status() string {
+func (r WebhookDeliveryListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10283,23 +14521,24 @@ func (r SlackWebhookDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SlackWebhookDeleteResponse) StatusCode() int {
+func (r WebhookDeliveryListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SnsDeleteResponse struct {
+type WebhookListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WebhookWorkerListResponse
 	JSON400      *APIErrors
 	JSON401      *APIErrors
 	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SnsDeleteResponse) Status() string {
+func (r WebhookListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10307,23 +14546,24 @@ func (r SnsDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SnsDeleteResponse) StatusCode() int {
+func (r WebhookListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SnsUpdateResponse struct {
+type WebhookCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WebhookWorkerCreated
 	JSON400      *APIErrors
 	JSON401      *APIErrors
 	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SnsUpdateResponse) Status() string {
+func (r WebhookCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10331,24 +14571,23 @@ func (r SnsUpdateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SnsUpdateResponse) StatusCode() int {
+func (r WebhookCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunListArchivesResponse struct {
+type WorkerListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StepRunArchiveList
+	JSON200      *WorkerList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
-	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunListArchivesResponse) Status() string {
+func (r WorkerListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10356,24 +14595,24 @@ func (r StepRunListArchivesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunListArchivesResponse) StatusCode() int {
+func (r WorkerListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunListEventsResponse struct {
+type WorkflowRunUpdateReplayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StepRunEventList
+	JSON200      *ReplayWorkflowRunsResponse
 	JSON400      *APIErrors
 	JSON403      *APIErrors
-	JSON404      *APIErrors
+	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunListEventsResponse) Status() string {
+func (r WorkflowRunUpdateReplayResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10381,23 +14620,23 @@ func (r StepRunListEventsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunListEventsResponse) StatusCode() int {
+func (r WorkflowRunUpdateReplayResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type LogLineListResponse struct {
+type WorkflowRunGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *LogLineList
+	JSON200      *WorkflowRun
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r LogLineListResponse) Status() string {
+func (r WorkflowRunGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10405,23 +14644,24 @@ func (r LogLineListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r LogLineListResponse) StatusCode() int {
+func (r WorkflowRunGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantCreateResponse struct {
+type WorkflowRunGetInputResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Tenant
+	JSON200      *map[string]interface{}
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantCreateResponse) Status() string {
+func (r WorkflowRunGetInputResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10429,23 +14669,23 @@ func (r TenantCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantCreateResponse) StatusCode() int {
+func (r WorkflowRunGetInputResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantUpdateResponse struct {
+type WorkflowRunGetShapeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Tenant
+	JSON200      *WorkflowRunShape
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantUpdateResponse) Status() string {
+func (r WorkflowRunGetShapeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10453,23 +14693,24 @@ func (r TenantUpdateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantUpdateResponse) StatusCode() int {
+func (r WorkflowRunGetShapeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AlertEmailGroupListResponse struct {
+type WorkflowRunListStepRunEventsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantAlertEmailGroupList
+	JSON200      *StepRunEventList
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r AlertEmailGroupListResponse) Status() string {
+func (r WorkflowRunListStepRunEventsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10477,23 +14718,23 @@ func (r AlertEmailGroupListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AlertEmailGroupListResponse) StatusCode() int {
+func (r WorkflowRunListStepRunEventsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AlertEmailGroupCreateResponse struct {
+type WorkflowListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *TenantAlertEmailGroup
+	JSON200      *WorkflowList
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r AlertEmailGroupCreateResponse) Status() string {
+func (r WorkflowListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10501,23 +14742,25 @@ func (r AlertEmailGroupCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AlertEmailGroupCreateResponse) StatusCode() int {
+func (r WorkflowListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantAlertingSettingsGetResponse struct {
+type WorkflowRunCancelResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantAlertingSettings
-	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON200      *struct {
+		WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+	}
+	JSON400 *APIErrors
+	JSON403 *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantAlertingSettingsGetResponse) Status() string {
+func (r WorkflowRunCancelResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10525,23 +14768,23 @@ func (r TenantAlertingSettingsGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantAlertingSettingsGetResponse) StatusCode() int {
+func (r WorkflowRunCancelResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ApiTokenListResponse struct {
+type CronWorkflowListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ListAPITokensResponse
+	JSON200      *CronWorkflowsList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r ApiTokenListResponse) Status() string {
+func (r CronWorkflowListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10549,23 +14792,22 @@ func (r ApiTokenListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ApiTokenListResponse) StatusCode() int {
+func (r CronWorkflowListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ApiTokenCreateResponse struct {
+type WorkflowCronDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *CreateAPITokenResponse
 	JSON400      *APIErrors
-	JSON403      *APIErrors
+	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r ApiTokenCreateResponse) Status() string {
+func (r WorkflowCronDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10573,23 +14815,24 @@ func (r ApiTokenCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ApiTokenCreateResponse) StatusCode() int {
+func (r WorkflowCronDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventListResponse struct {
+type WorkflowCronGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EventList
+	JSON200      *CronWorkflows
 	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventListResponse) Status() string {
+func (r WorkflowCronGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10597,24 +14840,23 @@ func (r EventListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventListResponse) StatusCode() int {
+func (r WorkflowCronGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventCreateResponse struct {
+type WorkflowCronUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Event
+	JSON200      *CronWorkflows
 	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON429      *APIErrors
+	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r EventCreateResponse) Status() string {
+func (r WorkflowCronUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10622,24 +14864,23 @@ func (r EventCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventCreateResponse) StatusCode() int {
+func (r WorkflowCronUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventCreateBulkResponse struct {
+type WorkflowRunListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Events
+	JSON200      *WorkflowRunList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
-	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventCreateBulkResponse) Status() string {
+func (r WorkflowRunListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10647,26 +14888,23 @@ func (r EventCreateBulkResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventCreateBulkResponse) StatusCode() int {
+func (r WorkflowRunListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventUpdateCancelResponse struct {
+type WorkflowRunGetMetricsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
-	}
-	JSON400 *APIErrors
-	JSON403 *APIErrors
-	JSON429 *APIErrors
+	JSON200      *WorkflowRunsMetrics
+	JSON400      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventUpdateCancelResponse) Status() string {
+func (r WorkflowRunGetMetricsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10674,23 +14912,23 @@ func (r EventUpdateCancelResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventUpdateCancelResponse) StatusCode() int {
+func (r WorkflowRunGetMetricsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventKeyListResponse struct {
+type WorkflowScheduledListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EventKeyList
+	JSON200      *ScheduledWorkflowsList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventKeyListResponse) Status() string {
+func (r WorkflowScheduledListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10698,24 +14936,22 @@ func (r EventKeyListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventKeyListResponse) StatusCode() int {
+func (r WorkflowScheduledListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventUpdateReplayResponse struct {
+type WorkflowScheduledDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EventList
 	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON429      *APIErrors
+	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r EventUpdateReplayResponse) Status() string {
+func (r WorkflowScheduledDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10723,23 +14959,24 @@ func (r EventUpdateReplayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventUpdateReplayResponse) StatusCode() int {
+func (r WorkflowScheduledDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteListResponse struct {
+type WorkflowScheduledGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantInviteList
+	JSON200      *ScheduledWorkflows
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteListResponse) Status() string {
+func (r WorkflowScheduledGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10747,23 +14984,25 @@ func (r TenantInviteListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteListResponse) StatusCode() int {
+func (r WorkflowScheduledGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteCreateResponse struct {
+type CronWorkflowTriggerCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *TenantInvite
+	JSON200      *CronWorkflows
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteCreateResponse) Status() string {
+func (r CronWorkflowTriggerCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10771,22 +15010,25 @@ func (r TenantInviteCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteCreateResponse) StatusCode() int {
+func (r CronWorkflowTriggerCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteDeleteResponse struct {
+type ScheduledWorkflowRunCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantInvite
+	JSON200      *ScheduledWorkflows
 	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteDeleteResponse) Status() string {
+func (r ScheduledWorkflowRunCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10794,22 +15036,23 @@ func (r TenantInviteDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteDeleteResponse) StatusCode() int {
+func (r ScheduledWorkflowRunCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteUpdateResponse struct {
+type WorkflowGetWorkersCountResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantInvite
+	JSON200      *WorkflowWorkersCount
 	JSON400      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteUpdateResponse) Status() string {
+func (r WorkflowGetWorkersCountResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10817,23 +15060,24 @@ func (r TenantInviteUpdateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteUpdateResponse) StatusCode() int {
+func (r WorkflowGetWorkersCountResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantMemberListResponse struct {
+type UserGetCurrentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantMemberList
+	JSON200      *User
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantMemberListResponse) Status() string {
+func (r UserGetCurrentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10841,24 +15085,20 @@ func (r TenantMemberListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantMemberListResponse) StatusCode() int {
+func (r UserGetCurrentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantMemberDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON204      *TenantMember
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+type UserUpdateGithubOauthCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantMemberDeleteResponse) Status() string {
+func (r UserUpdateGithubOauthCallbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10866,24 +15106,20 @@ func (r TenantMemberDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantMemberDeleteResponse) StatusCode() int {
+func (r UserUpdateGithubOauthCallbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantGetQueueMetricsResponse struct {
+type UserUpdateGithubOauthStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantQueueMetrics
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantGetQueueMetricsResponse) Status() string {
+func (r UserUpdateGithubOauthStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10891,23 +15127,20 @@ func (r TenantGetQueueMetricsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantGetQueueMetricsResponse) StatusCode() int {
+func (r UserUpdateGithubOauthStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type RateLimitListResponse struct {
+type UserUpdateGoogleOauthCallbackResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *RateLimitList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r RateLimitListResponse) Status() string {
+func (r UserUpdateGoogleOauthCallbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10915,23 +15148,20 @@ func (r RateLimitListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r RateLimitListResponse) StatusCode() int {
+func (r UserUpdateGoogleOauthCallbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantResourcePolicyGetResponse struct {
+type UserUpdateGoogleOauthStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantResourcePolicy
-	JSON400      *APIErrors
-	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantResourcePolicyGetResponse) Status() string {
+func (r UserUpdateGoogleOauthStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10939,24 +15169,23 @@ func (r TenantResourcePolicyGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantResourcePolicyGetResponse) StatusCode() int {
+func (r UserUpdateGoogleOauthStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SlackWebhookListResponse struct {
+type UserListTenantInvitesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ListSlackWebhooks
+	JSON200      *TenantInviteList
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SlackWebhookListResponse) Status() string {
+func (r UserListTenantInvitesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10964,20 +15193,22 @@ func (r SlackWebhookListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SlackWebhookListResponse) StatusCode() int {
+func (r UserListTenantInvitesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserUpdateSlackOauthStartResponse struct {
+type TenantInviteAcceptResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r UserUpdateSlackOauthStartResponse) Status() string {
+func (r TenantInviteAcceptResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10985,24 +15216,22 @@ func (r UserUpdateSlackOauthStartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateSlackOauthStartResponse) StatusCode() int {
+func (r TenantInviteAcceptResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SnsListResponse struct {
+type TenantInviteRejectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ListSNSIntegrations
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r SnsListResponse) Status() string {
+func (r TenantInviteRejectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11010,24 +15239,24 @@ func (r SnsListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SnsListResponse) StatusCode() int {
+func (r TenantInviteRejectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SnsCreateResponse struct {
+type UserUpdateLoginResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *SNSIntegration
+	JSON200      *User
 	JSON400      *APIErrors
 	JSON401      *APIErrors
 	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SnsCreateResponse) Status() string {
+func (r UserUpdateLoginResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11035,24 +15264,24 @@ func (r SnsCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SnsCreateResponse) StatusCode() int {
+func (r UserUpdateLoginResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantGetStepRunQueueMetricsResponse struct {
+type UserUpdateLogoutResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantStepRunQueueMetrics
+	JSON200      *User
 	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantGetStepRunQueueMetricsResponse) Status() string {
+func (r UserUpdateLogoutResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11060,24 +15289,23 @@ func (r TenantGetStepRunQueueMetricsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantGetStepRunQueueMetricsResponse) StatusCode() int {
+func (r UserUpdateLogoutResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunGetResponse struct {
+type TenantMembershipsListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StepRun
+	JSON200      *UserTenantMembershipsList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
-	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunGetResponse) Status() string {
+func (r TenantMembershipsListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11085,23 +15313,20 @@ func (r StepRunGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunGetResponse) StatusCode() int {
+func (r TenantMembershipsListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunUpdateCancelResponse struct {
+type UserUpdateOidcOauthCallbackResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StepRun
-	JSON400      *APIErrors
-	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunUpdateCancelResponse) Status() string {
+func (r UserUpdateOidcOauthCallbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11109,23 +15334,20 @@ func (r StepRunUpdateCancelResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunUpdateCancelResponse) StatusCode() int {
+func (r UserUpdateOidcOauthCallbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunUpdateRerunResponse struct {
+type UserUpdateOidcOauthStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StepRun
-	JSON400      *APIErrors
-	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunUpdateRerunResponse) Status() string {
+func (r UserUpdateOidcOauthStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11133,24 +15355,24 @@ func (r StepRunUpdateRerunResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunUpdateRerunResponse) StatusCode() int {
+func (r UserUpdateOidcOauthStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunGetSchemaResponse struct {
+type UserUpdatePasswordResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
+	JSON200      *User
 	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunGetSchemaResponse) Status() string {
+func (r UserUpdatePasswordResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11158,24 +15380,24 @@ func (r StepRunGetSchemaResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunGetSchemaResponse) StatusCode() int {
+func (r UserUpdatePasswordResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WebhookListResponse struct {
+type UserCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WebhookWorkerListResponse
+	JSON200      *User
 	JSON400      *APIErrors
 	JSON401      *APIErrors
 	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WebhookListResponse) Status() string {
+func (r UserCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11183,24 +15405,20 @@ func (r WebhookListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WebhookListResponse) StatusCode() int {
+func (r UserCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WebhookCreateResponse struct {
+type UserUpdateSlackOauthCallbackResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WebhookWorkerCreated
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WebhookCreateResponse) Status() string {
+func (r UserUpdateSlackOauthCallbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11208,23 +15426,23 @@ func (r WebhookCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WebhookCreateResponse) StatusCode() int {
+func (r UserUpdateSlackOauthCallbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkerListResponse struct {
+type InfoGetVersionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkerList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+	JSON200      *struct {
+		Version string `json:"version"`
+	}
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkerListResponse) Status() string {
+func (r InfoGetVersionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11232,24 +15450,23 @@ func (r WorkerListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkerListResponse) StatusCode() int {
+func (r InfoGetVersionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowRunUpdateReplayResponse struct {
+type WebhookDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ReplayWorkflowRunsResponse
 	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON429      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowRunUpdateReplayResponse) Status() string {
+func (r WebhookDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11257,23 +15474,24 @@ func (r WorkflowRunUpdateReplayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunUpdateReplayResponse) StatusCode() int {
+func (r WebhookDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowRunGetResponse struct {
+type WebhookRequestsListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkflowRun
+	JSON200      *WebhookWorkerRequestListResponse
 	JSON400      *APIErrors
-	JSON403      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowRunGetResponse) Status() string {
+func (r WebhookRequestsListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11281,24 +15499,23 @@ func (r WorkflowRunGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunGetResponse) StatusCode() int {
+func (r WebhookRequestsListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowRunGetInputResponse struct {
+type WorkerGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
+	JSON200      *Worker
 	JSON400      *APIErrors
 	JSON403      *APIErrors
-	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowRunGetInputResponse) Status() string {
+func (r WorkerGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11306,23 +15523,23 @@ func (r WorkflowRunGetInputResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunGetInputResponse) StatusCode() int {
+func (r WorkerGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowRunGetShapeResponse struct {
+type WorkerUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkflowRunShape
+	JSON200      *Worker
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowRunGetShapeResponse) Status() string {
+func (r WorkerUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11330,24 +15547,23 @@ func (r WorkflowRunGetShapeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunGetShapeResponse) StatusCode() int {
+func (r WorkerUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowRunListStepRunEventsResponse struct {
+type WorkflowDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StepRunEventList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowRunListStepRunEventsResponse) Status() string {
+func (r WorkflowDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11355,23 +15571,24 @@ func (r WorkflowRunListStepRunEventsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunListStepRunEventsResponse) StatusCode() int {
+func (r WorkflowDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowListResponse struct {
+type WorkflowGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkflowList
+	JSON200      *Workflow
 	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowListResponse) Status() string {
+func (r WorkflowGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11379,25 +15596,23 @@ func (r WorkflowListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowListResponse) StatusCode() int {
+func (r WorkflowGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowRunCancelResponse struct {
+type WorkflowUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
-	}
-	JSON400 *APIErrors
-	JSON403 *APIErrors
+	JSON200      *Workflow
+	JSON400      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowRunCancelResponse) Status() string {
+func (r WorkflowUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11405,23 +15620,24 @@ func (r WorkflowRunCancelResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunCancelResponse) StatusCode() int {
+func (r WorkflowUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CronWorkflowListResponse struct {
+type WorkflowGetMetricsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *CronWorkflowsList
+	JSON200      *WorkflowMetrics
 	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r CronWorkflowListResponse) Status() string {
+func (r WorkflowGetMetricsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11429,22 +15645,25 @@ func (r CronWorkflowListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CronWorkflowListResponse) StatusCode() int {
+func (r WorkflowGetMetricsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowCronDeleteResponse struct {
+type WorkflowRunCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WorkflowRun
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowCronDeleteResponse) Status() string {
+func (r WorkflowRunCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11452,24 +15671,24 @@ func (r WorkflowCronDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowCronDeleteResponse) StatusCode() int {
+func (r WorkflowRunCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowCronGetResponse struct {
+type WorkflowVersionGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *CronWorkflows
+	JSON200      *WorkflowVersion
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowCronGetResponse) Status() string {
+func (r WorkflowVersionGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11477,23 +15696,23 @@ func (r WorkflowCronGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowCronGetResponse) StatusCode() int {
+func (r WorkflowVersionGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowRunListResponse struct {
+type V2DagListTasksResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkflowRunList
+	JSON200      *[]V2DagChildren
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowRunListResponse) Status() string {
+func (r V2DagListTasksResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11501,23 +15720,24 @@ func (r WorkflowRunListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunListResponse) StatusCode() int {
+func (r V2DagListTasksResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowRunGetMetricsResponse struct {
+type V2TaskGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkflowRunsMetrics
+	JSON200      *V2Task
 	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowRunGetMetricsResponse) Status() string {
+func (r V2TaskGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11525,23 +15745,24 @@ func (r WorkflowRunGetMetricsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunGetMetricsResponse) StatusCode() int {
+func (r V2TaskGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowScheduledListResponse struct {
+type V2TaskGetQueueDiagnosisResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ScheduledWorkflowsList
+	JSON200      *V2TaskQueueDiagnosis
 	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowScheduledListResponse) Status() string {
+func (r V2TaskGetQueueDiagnosisResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11549,22 +15770,24 @@ func (r WorkflowScheduledListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowScheduledListResponse) StatusCode() int {
+func (r V2TaskGetQueueDiagnosisResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowScheduledDeleteResponse struct {
+type V2TaskEventListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *V2TaskEventList
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowScheduledDeleteResponse) Status() string {
+func (r V2TaskEventListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11572,24 +15795,23 @@ func (r WorkflowScheduledDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowScheduledDeleteResponse) StatusCode() int {
+func (r V2TaskEventListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowScheduledGetResponse struct {
+type V2ConcurrencyListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ScheduledWorkflows
+	JSON200      *V2ConcurrencyStrategyUsageList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
-	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowScheduledGetResponse) Status() string {
+func (r V2ConcurrencyListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11597,25 +15819,23 @@ func (r WorkflowScheduledGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowScheduledGetResponse) StatusCode() int {
+func (r V2ConcurrencyListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CronWorkflowTriggerCreateResponse struct {
+type V2RateLimitListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *CronWorkflows
+	JSON200      *V2RateLimitUsageList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
-	JSON404      *APIErrors
-	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r CronWorkflowTriggerCreateResponse) Status() string {
+func (r V2RateLimitListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11623,25 +15843,23 @@ func (r CronWorkflowTriggerCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CronWorkflowTriggerCreateResponse) StatusCode() int {
+func (r V2RateLimitListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ScheduledWorkflowRunCreateResponse struct {
+type V2TaskListStatusMetricsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ScheduledWorkflows
+	JSON200      *V2TaskRunMetrics
 	JSON400      *APIErrors
 	JSON403      *APIErrors
-	JSON404      *APIErrors
-	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r ScheduledWorkflowRunCreateResponse) Status() string {
+func (r V2TaskListStatusMetricsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11649,23 +15867,23 @@ func (r ScheduledWorkflowRunCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ScheduledWorkflowRunCreateResponse) StatusCode() int {
+func (r V2TaskListStatusMetricsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type WorkflowGetWorkersCountResponse struct {
+type V2TaskGetPointMetricsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkflowWorkersCount
+	JSON200      *V2TaskPointMetrics
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r WorkflowGetWorkersCountResponse) Status() string {
+func (r V2TaskGetPointMetricsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11673,24 +15891,23 @@ func (r WorkflowGetWorkersCountResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowGetWorkersCountResponse) StatusCode() int {
+func (r V2TaskGetPointMetricsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserGetCurrentResponse struct {
+type V2TaskListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *User
+	JSON200      *V2TaskSummaryList
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r UserGetCurrentResponse) Status() string {
+func (r V2TaskListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11698,20 +15915,23 @@ func (r UserGetCurrentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserGetCurrentResponse) StatusCode() int {
+func (r V2TaskListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserUpdateGithubOauthCallbackResponse struct {
+type V2TaskCancelResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *V2CancelTasksResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r UserUpdateGithubOauthCallbackResponse) Status() string {
+func (r V2TaskCancelResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11719,20 +15939,23 @@ func (r UserUpdateGithubOauthCallbackResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateGithubOauthCallbackResponse) StatusCode() int {
+func (r V2TaskCancelResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserUpdateGithubOauthStartResponse struct {
+type V2TaskReplayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *V2ReplayTasksResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r UserUpdateGithubOauthStartResponse) Status() string {
+func (r V2TaskReplayResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11740,20 +15963,23 @@ func (r UserUpdateGithubOauthStartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateGithubOauthStartResponse) StatusCode() int {
+func (r V2TaskReplayResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserUpdateGoogleOauthCallbackResponse struct {
+type V2WorkflowRunListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *V2WorkflowRunList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r UserUpdateGoogleOauthCallbackResponse) Status() string {
+func (r V2WorkflowRunListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11761,20 +15987,23 @@ func (r UserUpdateGoogleOauthCallbackResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateGoogleOauthCallbackResponse) StatusCode() int {
+func (r V2WorkflowRunListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserUpdateGoogleOauthStartResponse struct {
+type V2WorkflowRunCancelResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *V2CancelWorkflowRunsResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r UserUpdateGoogleOauthStartResponse) Status() string {
+func (r V2WorkflowRunCancelResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11782,23 +16011,23 @@ func (r UserUpdateGoogleOauthStartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateGoogleOauthStartResponse) StatusCode() int {
+func (r V2WorkflowRunCancelResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserListTenantInvitesResponse struct {
+type V2WorkflowRunReplayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantInviteList
+	JSON200      *V2ReplayWorkflowRunsResponse
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r UserListTenantInvitesResponse) Status() string {
+func (r V2WorkflowRunReplayResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11806,22 +16035,23 @@ func (r UserListTenantInvitesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserListTenantInvitesResponse) StatusCode() int {
+func (r V2WorkflowRunReplayResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteAcceptResponse struct {
+type V2WorkflowRunGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *V2WorkflowRunDetails
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteAcceptResponse) Status() string {
+func (r V2WorkflowRunGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11829,22 +16059,24 @@ func (r TenantInviteAcceptResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteAcceptResponse) StatusCode() int {
+func (r V2WorkflowRunGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteRejectResponse struct {
+type V2WorkflowRunReplayFromTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *V2ReplayWorkflowRunFromTaskResponse
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteRejectResponse) Status() string {
+func (r V2WorkflowRunReplayFromTaskResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11852,24 +16084,23 @@ func (r TenantInviteRejectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteRejectResponse) StatusCode() int {
+func (r V2WorkflowRunReplayFromTaskResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserUpdateLoginResponse struct {
+type V2WorkflowRunTaskEventsListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *User
+	JSON200      *V2TaskEventList
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r UserUpdateLoginResponse) Status() string {
+func (r V2WorkflowRunTaskEventsListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11877,1802 +16108,2415 @@ func (r UserUpdateLoginResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateLoginResponse) StatusCode() int {
+func (r V2WorkflowRunTaskEventsListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserUpdateLogoutResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// LivenessGetWithResponse request returning *LivenessGetResponse
+func (c *ClientWithResponses) LivenessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivenessGetResponse, error) {
+	rsp, err := c.LivenessGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLivenessGetResponse(rsp)
+}
+
+// ReadinessGetWithResponse request returning *ReadinessGetResponse
+func (c *ClientWithResponses) ReadinessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadinessGetResponse, error) {
+	rsp, err := c.ReadinessGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadinessGetResponse(rsp)
+}
+
+// AlertEmailGroupDeleteWithResponse request returning *AlertEmailGroupDeleteResponse
+func (c *ClientWithResponses) AlertEmailGroupDeleteWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupDeleteResponse, error) {
+	rsp, err := c.AlertEmailGroupDelete(ctx, alertEmailGroup, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertEmailGroupDeleteResponse(rsp)
+}
+
+// AlertEmailGroupUpdateWithBodyWithResponse request with arbitrary body returning *AlertEmailGroupUpdateResponse
+func (c *ClientWithResponses) AlertEmailGroupUpdateWithBodyWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error) {
+	rsp, err := c.AlertEmailGroupUpdateWithBody(ctx, alertEmailGroup, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertEmailGroupUpdateResponse(rsp)
+}
+
+func (c *ClientWithResponses) AlertEmailGroupUpdateWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error) {
+	rsp, err := c.AlertEmailGroupUpdate(ctx, alertEmailGroup, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertEmailGroupUpdateResponse(rsp)
+}
+
+// ApiTokenUpdateRevokeWithResponse request returning *ApiTokenUpdateRevokeResponse
+func (c *ClientWithResponses) ApiTokenUpdateRevokeWithResponse(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRevokeResponse, error) {
+	rsp, err := c.ApiTokenUpdateRevoke(ctx, apiToken, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiTokenUpdateRevokeResponse(rsp)
+}
+
+// CloudMetadataGetWithResponse request returning *CloudMetadataGetResponse
+func (c *ClientWithResponses) CloudMetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CloudMetadataGetResponse, error) {
+	rsp, err := c.CloudMetadataGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloudMetadataGetResponse(rsp)
+}
+
+// EventGetWithResponse request returning *EventGetResponse
+func (c *ClientWithResponses) EventGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventGetResponse, error) {
+	rsp, err := c.EventGet(ctx, event, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventGetResponse(rsp)
+}
+
+// EventDataGetWithResponse request returning *EventDataGetResponse
+func (c *ClientWithResponses) EventDataGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDataGetResponse, error) {
+	rsp, err := c.EventDataGet(ctx, event, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventDataGetResponse(rsp)
+}
+
+// MetadataGetWithResponse request returning *MetadataGetResponse
+func (c *ClientWithResponses) MetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataGetResponse, error) {
+	rsp, err := c.MetadataGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataGetResponse(rsp)
+}
+
+// MetadataListIntegrationsWithResponse request returning *MetadataListIntegrationsResponse
+func (c *ClientWithResponses) MetadataListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataListIntegrationsResponse, error) {
+	rsp, err := c.MetadataListIntegrations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadataListIntegrationsResponse(rsp)
+}
+
+// MonitoringPostRunProbeWithResponse request returning *MonitoringPostRunProbeResponse
+func (c *ClientWithResponses) MonitoringPostRunProbeWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*MonitoringPostRunProbeResponse, error) {
+	rsp, err := c.MonitoringPostRunProbe(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMonitoringPostRunProbeResponse(rsp)
+}
+
+// SlackWebhookDeleteWithResponse request returning *SlackWebhookDeleteResponse
+func (c *ClientWithResponses) SlackWebhookDeleteWithResponse(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookDeleteResponse, error) {
+	rsp, err := c.SlackWebhookDelete(ctx, slack, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSlackWebhookDeleteResponse(rsp)
+}
+
+// SnsDeleteWithResponse request returning *SnsDeleteResponse
+func (c *ClientWithResponses) SnsDeleteWithResponse(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsDeleteResponse, error) {
+	rsp, err := c.SnsDelete(ctx, sns, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSnsDeleteResponse(rsp)
+}
+
+// SnsUpdateWithResponse request returning *SnsUpdateResponse
+func (c *ClientWithResponses) SnsUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*SnsUpdateResponse, error) {
+	rsp, err := c.SnsUpdate(ctx, tenant, event, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSnsUpdateResponse(rsp)
+}
+
+// StepRunListArchivesWithResponse request returning *StepRunListArchivesResponse
+func (c *ClientWithResponses) StepRunListArchivesWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*StepRunListArchivesResponse, error) {
+	rsp, err := c.StepRunListArchives(ctx, stepRun, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStepRunListArchivesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateLogoutResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// StepRunListEventsWithResponse request returning *StepRunListEventsResponse
+func (c *ClientWithResponses) StepRunListEventsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*StepRunListEventsResponse, error) {
+	rsp, err := c.StepRunListEvents(ctx, stepRun, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseStepRunListEventsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateLogoutResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// LogLineListWithResponse request returning *LogLineListResponse
+func (c *ClientWithResponses) LogLineListWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*LogLineListResponse, error) {
+	rsp, err := c.LogLineList(ctx, stepRun, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseLogLineListResponse(rsp)
 }
 
-type TenantMembershipsListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserTenantMembershipsList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// TenantCreateWithBodyWithResponse request with arbitrary body returning *TenantCreateResponse
+func (c *ClientWithResponses) TenantCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error) {
+	rsp, err := c.TenantCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r TenantMembershipsListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) TenantCreateWithResponse(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error) {
+	rsp, err := c.TenantCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TenantMembershipsListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantUpdateWithBodyWithResponse request with arbitrary body returning *TenantUpdateResponse
+func (c *ClientWithResponses) TenantUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error) {
+	rsp, err := c.TenantUpdateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantUpdateResponse(rsp)
 }
 
-type UserUpdatePasswordResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+func (c *ClientWithResponses) TenantUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error) {
+	rsp, err := c.TenantUpdate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantUpdateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdatePasswordResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// AlertEmailGroupListWithResponse request returning *AlertEmailGroupListResponse
+func (c *ClientWithResponses) AlertEmailGroupListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupListResponse, error) {
+	rsp, err := c.AlertEmailGroupList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseAlertEmailGroupListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdatePasswordResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// AlertEmailGroupCreateWithBodyWithResponse request with arbitrary body returning *AlertEmailGroupCreateResponse
+func (c *ClientWithResponses) AlertEmailGroupCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error) {
+	rsp, err := c.AlertEmailGroupCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseAlertEmailGroupCreateResponse(rsp)
 }
 
-type UserCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+func (c *ClientWithResponses) AlertEmailGroupCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error) {
+	rsp, err := c.AlertEmailGroupCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertEmailGroupCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantAlertingSettingsGetWithResponse request returning *TenantAlertingSettingsGetResponse
+func (c *ClientWithResponses) TenantAlertingSettingsGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantAlertingSettingsGetResponse, error) {
+	rsp, err := c.TenantAlertingSettingsGet(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantAlertingSettingsGetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ApiTokenListWithResponse request returning *ApiTokenListResponse
+func (c *ClientWithResponses) ApiTokenListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenListResponse, error) {
+	rsp, err := c.ApiTokenList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseApiTokenListResponse(rsp)
 }
 
-type UserUpdateSlackOauthCallbackResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// ApiTokenCreateWithBodyWithResponse request with arbitrary body returning *ApiTokenCreateResponse
+func (c *ClientWithResponses) ApiTokenCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error) {
+	rsp, err := c.ApiTokenCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiTokenCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateSlackOauthCallbackResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) ApiTokenCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error) {
+	rsp, err := c.ApiTokenCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseApiTokenCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateSlackOauthCallbackResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// AuditLogListWithResponse request returning *AuditLogListResponse
+func (c *ClientWithResponses) AuditLogListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *AuditLogListParams, reqEditors ...RequestEditorFn) (*AuditLogListResponse, error) {
+	rsp, err := c.AuditLogList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseAuditLogListResponse(rsp)
 }
 
-type InfoGetVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Version string `json:"version"`
+// EventListWithResponse request returning *EventListResponse
+func (c *ClientWithResponses) EventListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*EventListResponse, error) {
+	rsp, err := c.EventList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseEventListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r InfoGetVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EventCreateWithBodyWithResponse request with arbitrary body returning *EventCreateResponse
+func (c *ClientWithResponses) EventCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateResponse, error) {
+	rsp, err := c.EventCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r InfoGetVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) EventCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateResponse, error) {
+	rsp, err := c.EventCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventCreateResponse(rsp)
 }
 
-type WebhookDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// EventCreateBulkWithBodyWithResponse request with arbitrary body returning *EventCreateBulkResponse
+func (c *ClientWithResponses) EventCreateBulkWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error) {
+	rsp, err := c.EventCreateBulkWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventCreateBulkResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WebhookDeleteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) EventCreateBulkWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error) {
+	rsp, err := c.EventCreateBulk(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventCreateBulkResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WebhookDeleteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// EventUpdateCancelWithBodyWithResponse request with arbitrary body returning *EventUpdateCancelResponse
+func (c *ClientWithResponses) EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
+	rsp, err := c.EventUpdateCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventUpdateCancelResponse(rsp)
 }
 
-type WebhookRequestsListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WebhookWorkerRequestListResponse
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+func (c *ClientWithResponses) EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
+	rsp, err := c.EventUpdateCancel(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventUpdateCancelResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WebhookRequestsListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EventKeyListWithResponse request returning *EventKeyListResponse
+func (c *ClientWithResponses) EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error) {
+	rsp, err := c.EventKeyList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventKeyListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WebhookRequestsListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// EventUpdateReplayWithBodyWithResponse request with arbitrary body returning *EventUpdateReplayResponse
+func (c *ClientWithResponses) EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
+	rsp, err := c.EventUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventUpdateReplayResponse(rsp)
 }
 
-type WorkerGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Worker
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+func (c *ClientWithResponses) EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
+	rsp, err := c.EventUpdateReplay(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventUpdateReplayResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkerGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantInviteListWithResponse request returning *TenantInviteListResponse
+func (c *ClientWithResponses) TenantInviteListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteListResponse, error) {
+	rsp, err := c.TenantInviteList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantInviteListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkerGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantInviteCreateWithBodyWithResponse request with arbitrary body returning *TenantInviteCreateResponse
+func (c *ClientWithResponses) TenantInviteCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error) {
+	rsp, err := c.TenantInviteCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantInviteCreateResponse(rsp)
 }
 
-type WorkerUpdateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Worker
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+func (c *ClientWithResponses) TenantInviteCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error) {
+	rsp, err := c.TenantInviteCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantInviteCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkerUpdateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantInviteDeleteWithResponse request returning *TenantInviteDeleteResponse
+func (c *ClientWithResponses) TenantInviteDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteDeleteResponse, error) {
+	rsp, err := c.TenantInviteDelete(ctx, tenant, tenantInvite, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantInviteDeleteResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkerUpdateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantInviteUpdateWithBodyWithResponse request with arbitrary body returning *TenantInviteUpdateResponse
+func (c *ClientWithResponses) TenantInviteUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error) {
+	rsp, err := c.TenantInviteUpdateWithBody(ctx, tenant, tenantInvite, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantInviteUpdateResponse(rsp)
 }
 
-type WorkflowDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+func (c *ClientWithResponses) TenantInviteUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error) {
+	rsp, err := c.TenantInviteUpdate(ctx, tenant, tenantInvite, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantInviteUpdateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowDeleteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantMemberListWithResponse request returning *TenantMemberListResponse
+func (c *ClientWithResponses) TenantMemberListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberListResponse, error) {
+	rsp, err := c.TenantMemberList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantMemberListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowDeleteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantMemberDeleteWithResponse request returning *TenantMemberDeleteResponse
+func (c *ClientWithResponses) TenantMemberDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberDeleteResponse, error) {
+	rsp, err := c.TenantMemberDelete(ctx, tenant, member, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantMemberDeleteResponse(rsp)
 }
 
-type WorkflowGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Workflow
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// TenantMemberUpdateWithBodyWithResponse request with arbitrary body returning *TenantMemberUpdateResponse
+func (c *ClientWithResponses) TenantMemberUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantMemberUpdateResponse, error) {
+	rsp, err := c.TenantMemberUpdateWithBody(ctx, tenant, member, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantMemberUpdateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) TenantMemberUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, body TenantMemberUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantMemberUpdateResponse, error) {
+	rsp, err := c.TenantMemberUpdate(ctx, tenant, member, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantMemberUpdateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// MessageQueueDeadLetterListWithResponse request returning *MessageQueueDeadLetterListResponse
+func (c *ClientWithResponses) MessageQueueDeadLetterListWithResponse(ctx context.Context, tenant openapi_types.UUID, messageQueue string, params *MessageQueueDeadLetterListParams, reqEditors ...RequestEditorFn) (*MessageQueueDeadLetterListResponse, error) {
+	rsp, err := c.MessageQueueDeadLetterList(ctx, tenant, messageQueue, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseMessageQueueDeadLetterListResponse(rsp)
 }
 
-type WorkflowUpdateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Workflow
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// MessageQueueDeadLetterPurgeWithBodyWithResponse request with arbitrary body returning *MessageQueueDeadLetterPurgeResponse
+func (c *ClientWithResponses) MessageQueueDeadLetterPurgeWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, messageQueue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MessageQueueDeadLetterPurgeResponse, error) {
+	rsp, err := c.MessageQueueDeadLetterPurgeWithBody(ctx, tenant, messageQueue, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMessageQueueDeadLetterPurgeResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowUpdateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) MessageQueueDeadLetterPurgeWithResponse(ctx context.Context, tenant openapi_types.UUID, messageQueue string, body MessageQueueDeadLetterPurgeJSONRequestBody, reqEditors ...RequestEditorFn) (*MessageQueueDeadLetterPurgeResponse, error) {
+	rsp, err := c.MessageQueueDeadLetterPurge(ctx, tenant, messageQueue, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseMessageQueueDeadLetterPurgeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowUpdateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// MessageQueueDeadLetterReplayWithBodyWithResponse request with arbitrary body returning *MessageQueueDeadLetterReplayResponse
+func (c *ClientWithResponses) MessageQueueDeadLetterReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, messageQueue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MessageQueueDeadLetterReplayResponse, error) {
+	rsp, err := c.MessageQueueDeadLetterReplayWithBody(ctx, tenant, messageQueue, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseMessageQueueDeadLetterReplayResponse(rsp)
 }
 
-type WorkflowGetMetricsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowMetrics
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+func (c *ClientWithResponses) MessageQueueDeadLetterReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, messageQueue string, body MessageQueueDeadLetterReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*MessageQueueDeadLetterReplayResponse, error) {
+	rsp, err := c.MessageQueueDeadLetterReplay(ctx, tenant, messageQueue, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMessageQueueDeadLetterReplayResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowGetMetricsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantGetQueueMetricsWithResponse request returning *TenantGetQueueMetricsResponse
+func (c *ClientWithResponses) TenantGetQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*TenantGetQueueMetricsResponse, error) {
+	rsp, err := c.TenantGetQueueMetrics(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantGetQueueMetricsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowGetMetricsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// RateLimitListWithResponse request returning *RateLimitListResponse
+func (c *ClientWithResponses) RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*RateLimitListResponse, error) {
+	rsp, err := c.RateLimitList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseRateLimitListResponse(rsp)
 }
 
-type WorkflowRunCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowRun
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
-	JSON429      *APIErrors
+// TenantResourcePolicyGetWithResponse request returning *TenantResourcePolicyGetResponse
+func (c *ClientWithResponses) TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error) {
+	rsp, err := c.TenantResourcePolicyGet(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantResourcePolicyGetResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantRoleListWithResponse request returning *TenantRoleListResponse
+func (c *ClientWithResponses) TenantRoleListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantRoleListResponse, error) {
+	rsp, err := c.TenantRoleList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantRoleListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantRoleCreateWithBodyWithResponse request with arbitrary body returning *TenantRoleCreateResponse
+func (c *ClientWithResponses) TenantRoleCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantRoleCreateResponse, error) {
+	rsp, err := c.TenantRoleCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantRoleCreateResponse(rsp)
 }
 
-type WorkflowVersionGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowVersion
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+func (c *ClientWithResponses) TenantRoleCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantRoleCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantRoleCreateResponse, error) {
+	rsp, err := c.TenantRoleCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantRoleCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowVersionGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantRoleDeleteWithResponse request returning *TenantRoleDeleteResponse
+func (c *ClientWithResponses) TenantRoleDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantRoleDeleteResponse, error) {
+	rsp, err := c.TenantRoleDelete(ctx, tenant, tenantRole, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantRoleDeleteResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowVersionGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantRoleUpdateWithBodyWithResponse request with arbitrary body returning *TenantRoleUpdateResponse
+func (c *ClientWithResponses) TenantRoleUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantRoleUpdateResponse, error) {
+	rsp, err := c.TenantRoleUpdateWithBody(ctx, tenant, tenantRole, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantRoleUpdateResponse(rsp)
 }
 
-type V2DagListTasksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]V2DagChildren
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+func (c *ClientWithResponses) TenantRoleUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantRole openapi_types.UUID, body TenantRoleUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantRoleUpdateResponse, error) {
+	rsp, err := c.TenantRoleUpdate(ctx, tenant, tenantRole, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantRoleUpdateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r V2DagListTasksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// SlackWebhookListWithResponse request returning *SlackWebhookListResponse
+func (c *ClientWithResponses) SlackWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookListResponse, error) {
+	rsp, err := c.SlackWebhookList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSlackWebhookListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r V2DagListTasksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UserUpdateSlackOauthStartWithResponse request returning *UserUpdateSlackOauthStartResponse
+func (c *ClientWithResponses) UserUpdateSlackOauthStartWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthStartResponse, error) {
+	rsp, err := c.UserUpdateSlackOauthStart(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUserUpdateSlackOauthStartResponse(rsp)
 }
 
-type V2TaskGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *V2Task
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// SnsListWithResponse request returning *SnsListResponse
+func (c *ClientWithResponses) SnsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsListResponse, error) {
+	rsp, err := c.SnsList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSnsListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r V2TaskGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// SnsCreateWithBodyWithResponse request with arbitrary body returning *SnsCreateResponse
+func (c *ClientWithResponses) SnsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error) {
+	rsp, err := c.SnsCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSnsCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r V2TaskGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) SnsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error) {
+	rsp, err := c.SnsCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSnsCreateResponse(rsp)
 }
 
-type V2TaskEventListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *V2TaskEventList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// TenantGetStepRunQueueMetricsWithResponse request returning *TenantGetStepRunQueueMetricsResponse
+func (c *ClientWithResponses) TenantGetStepRunQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantGetStepRunQueueMetricsResponse, error) {
+	rsp, err := c.TenantGetStepRunQueueMetrics(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantGetStepRunQueueMetricsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r V2TaskEventListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// StepRunGetWithResponse request returning *StepRunGetResponse
+func (c *ClientWithResponses) StepRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetResponse, error) {
+	rsp, err := c.StepRunGet(ctx, tenant, stepRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseStepRunGetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r V2TaskEventListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StepRunUpdateCancelWithResponse request returning *StepRunUpdateCancelResponse
+func (c *ClientWithResponses) StepRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateCancelResponse, error) {
+	rsp, err := c.StepRunUpdateCancel(ctx, tenant, stepRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseStepRunUpdateCancelResponse(rsp)
 }
 
-type V2TaskListStatusMetricsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *V2TaskRunMetrics
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// StepRunUpdateRerunWithBodyWithResponse request with arbitrary body returning *StepRunUpdateRerunResponse
+func (c *ClientWithResponses) StepRunUpdateRerunWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error) {
+	rsp, err := c.StepRunUpdateRerunWithBody(ctx, tenant, stepRun, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStepRunUpdateRerunResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r V2TaskListStatusMetricsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) StepRunUpdateRerunWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error) {
+	rsp, err := c.StepRunUpdateRerun(ctx, tenant, stepRun, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseStepRunUpdateRerunResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r V2TaskListStatusMetricsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StepRunGetSchemaWithResponse request returning *StepRunGetSchemaResponse
+func (c *ClientWithResponses) StepRunGetSchemaWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetSchemaResponse, error) {
+	rsp, err := c.StepRunGetSchema(ctx, tenant, stepRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseStepRunGetSchemaResponse(rsp)
 }
 
-type V2TaskGetPointMetricsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *V2TaskPointMetrics
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// WebhookSubscriptionListWithResponse request returning *WebhookSubscriptionListResponse
+func (c *ClientWithResponses) WebhookSubscriptionListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookSubscriptionListResponse, error) {
+	rsp, err := c.WebhookSubscriptionList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookSubscriptionListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r V2TaskGetPointMetricsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WebhookSubscriptionCreateWithBodyWithResponse request with arbitrary body returning *WebhookSubscriptionCreateResponse
+func (c *ClientWithResponses) WebhookSubscriptionCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookSubscriptionCreateResponse, error) {
+	rsp, err := c.WebhookSubscriptionCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWebhookSubscriptionCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r V2TaskGetPointMetricsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) WebhookSubscriptionCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookSubscriptionCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookSubscriptionCreateResponse, error) {
+	rsp, err := c.WebhookSubscriptionCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWebhookSubscriptionCreateResponse(rsp)
 }
 
-type V2TaskListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *V2TaskSummaryList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// WebhookSubscriptionDeleteWithResponse request returning *WebhookSubscriptionDeleteResponse
+func (c *ClientWithResponses) WebhookSubscriptionDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookSubscriptionDeleteResponse, error) {
+	rsp, err := c.WebhookSubscriptionDelete(ctx, tenant, webhookSubscription, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookSubscriptionDeleteResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r V2TaskListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WebhookSubscriptionUpdateWithBodyWithResponse request with arbitrary body returning *WebhookSubscriptionUpdateResponse
+func (c *ClientWithResponses) WebhookSubscriptionUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookSubscriptionUpdateResponse, error) {
+	rsp, err := c.WebhookSubscriptionUpdateWithBody(ctx, tenant, webhookSubscription, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWebhookSubscriptionUpdateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r V2TaskListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) WebhookSubscriptionUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, body WebhookSubscriptionUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookSubscriptionUpdateResponse, error) {
+	rsp, err := c.WebhookSubscriptionUpdate(ctx, tenant, webhookSubscription, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWebhookSubscriptionUpdateResponse(rsp)
 }
 
-type V2WorkflowRunListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *V2WorkflowRunList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// WebhookDeliveryListWithResponse request returning *WebhookDeliveryListResponse
+func (c *ClientWithResponses) WebhookDeliveryListWithResponse(ctx context.Context, tenant openapi_types.UUID, webhookSubscription openapi_types.UUID, params *WebhookDeliveryListParams, reqEditors ...RequestEditorFn) (*WebhookDeliveryListResponse, error) {
+	rsp, err := c.WebhookDeliveryList(ctx, tenant, webhookSubscription, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookDeliveryListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r V2WorkflowRunListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WebhookListWithResponse request returning *WebhookListResponse
+func (c *ClientWithResponses) WebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookListResponse, error) {
+	rsp, err := c.WebhookList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWebhookListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r V2WorkflowRunListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WebhookCreateWithBodyWithResponse request with arbitrary body returning *WebhookCreateResponse
+func (c *ClientWithResponses) WebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error) {
+	rsp, err := c.WebhookCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWebhookCreateResponse(rsp)
 }
 
-type V2WorkflowRunGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *V2WorkflowRunDetails
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+func (c *ClientWithResponses) WebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error) {
+	rsp, err := c.WebhookCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r V2WorkflowRunGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkerListWithResponse request returning *WorkerListResponse
+func (c *ClientWithResponses) WorkerListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerListResponse, error) {
+	rsp, err := c.WorkerList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkerListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r V2WorkflowRunGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkflowRunUpdateReplayWithBodyWithResponse request with arbitrary body returning *WorkflowRunUpdateReplayResponse
+func (c *ClientWithResponses) WorkflowRunUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error) {
+	rsp, err := c.WorkflowRunUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowRunUpdateReplayResponse(rsp)
 }
 
-type V2WorkflowRunTaskEventsListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *V2TaskEventList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+func (c *ClientWithResponses) WorkflowRunUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error) {
+	rsp, err := c.WorkflowRunUpdateReplay(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowRunUpdateReplayResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r V2WorkflowRunTaskEventsListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowRunGetWithResponse request returning *WorkflowRunGetResponse
+func (c *ClientWithResponses) WorkflowRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetResponse, error) {
+	rsp, err := c.WorkflowRunGet(ctx, tenant, workflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowRunGetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r V2WorkflowRunTaskEventsListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkflowRunGetInputWithResponse request returning *WorkflowRunGetInputResponse
+func (c *ClientWithResponses) WorkflowRunGetInputWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetInputResponse, error) {
+	rsp, err := c.WorkflowRunGetInput(ctx, tenant, workflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowRunGetInputResponse(rsp)
 }
 
-// LivenessGetWithResponse request returning *LivenessGetResponse
-func (c *ClientWithResponses) LivenessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivenessGetResponse, error) {
-	rsp, err := c.LivenessGet(ctx, reqEditors...)
+// WorkflowRunGetShapeWithResponse request returning *WorkflowRunGetShapeResponse
+func (c *ClientWithResponses) WorkflowRunGetShapeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetShapeResponse, error) {
+	rsp, err := c.WorkflowRunGetShape(ctx, tenant, workflowRun, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLivenessGetResponse(rsp)
+	return ParseWorkflowRunGetShapeResponse(rsp)
 }
 
-// ReadinessGetWithResponse request returning *ReadinessGetResponse
-func (c *ClientWithResponses) ReadinessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadinessGetResponse, error) {
-	rsp, err := c.ReadinessGet(ctx, reqEditors...)
+// WorkflowRunListStepRunEventsWithResponse request returning *WorkflowRunListStepRunEventsResponse
+func (c *ClientWithResponses) WorkflowRunListStepRunEventsWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*WorkflowRunListStepRunEventsResponse, error) {
+	rsp, err := c.WorkflowRunListStepRunEvents(ctx, tenant, workflowRun, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseReadinessGetResponse(rsp)
+	return ParseWorkflowRunListStepRunEventsResponse(rsp)
 }
 
-// AlertEmailGroupDeleteWithResponse request returning *AlertEmailGroupDeleteResponse
-func (c *ClientWithResponses) AlertEmailGroupDeleteWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupDeleteResponse, error) {
-	rsp, err := c.AlertEmailGroupDelete(ctx, alertEmailGroup, reqEditors...)
+// WorkflowListWithResponse request returning *WorkflowListResponse
+func (c *ClientWithResponses) WorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowListParams, reqEditors ...RequestEditorFn) (*WorkflowListResponse, error) {
+	rsp, err := c.WorkflowList(ctx, tenant, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupDeleteResponse(rsp)
+	return ParseWorkflowListResponse(rsp)
 }
 
-// AlertEmailGroupUpdateWithBodyWithResponse request with arbitrary body returning *AlertEmailGroupUpdateResponse
-func (c *ClientWithResponses) AlertEmailGroupUpdateWithBodyWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error) {
-	rsp, err := c.AlertEmailGroupUpdateWithBody(ctx, alertEmailGroup, contentType, body, reqEditors...)
+// WorkflowRunCancelWithBodyWithResponse request with arbitrary body returning *WorkflowRunCancelResponse
+func (c *ClientWithResponses) WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error) {
+	rsp, err := c.WorkflowRunCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupUpdateResponse(rsp)
+	return ParseWorkflowRunCancelResponse(rsp)
 }
 
-func (c *ClientWithResponses) AlertEmailGroupUpdateWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error) {
-	rsp, err := c.AlertEmailGroupUpdate(ctx, alertEmailGroup, body, reqEditors...)
+func (c *ClientWithResponses) WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error) {
+	rsp, err := c.WorkflowRunCancel(ctx, tenant, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupUpdateResponse(rsp)
+	return ParseWorkflowRunCancelResponse(rsp)
 }
 
-// ApiTokenUpdateRevokeWithResponse request returning *ApiTokenUpdateRevokeResponse
-func (c *ClientWithResponses) ApiTokenUpdateRevokeWithResponse(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRevokeResponse, error) {
-	rsp, err := c.ApiTokenUpdateRevoke(ctx, apiToken, reqEditors...)
+// CronWorkflowListWithResponse request returning *CronWorkflowListResponse
+func (c *ClientWithResponses) CronWorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*CronWorkflowListResponse, error) {
+	rsp, err := c.CronWorkflowList(ctx, tenant, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiTokenUpdateRevokeResponse(rsp)
+	return ParseCronWorkflowListResponse(rsp)
 }
 
-// CloudMetadataGetWithResponse request returning *CloudMetadataGetResponse
-func (c *ClientWithResponses) CloudMetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CloudMetadataGetResponse, error) {
-	rsp, err := c.CloudMetadataGet(ctx, reqEditors...)
+// WorkflowCronDeleteWithResponse request returning *WorkflowCronDeleteResponse
+func (c *ClientWithResponses) WorkflowCronDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronDeleteResponse, error) {
+	rsp, err := c.WorkflowCronDelete(ctx, tenant, cronWorkflow, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCloudMetadataGetResponse(rsp)
+	return ParseWorkflowCronDeleteResponse(rsp)
 }
 
-// EventGetWithResponse request returning *EventGetResponse
-func (c *ClientWithResponses) EventGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventGetResponse, error) {
-	rsp, err := c.EventGet(ctx, event, reqEditors...)
+// WorkflowCronGetWithResponse request returning *WorkflowCronGetResponse
+func (c *ClientWithResponses) WorkflowCronGetWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronGetResponse, error) {
+	rsp, err := c.WorkflowCronGet(ctx, tenant, cronWorkflow, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventGetResponse(rsp)
+	return ParseWorkflowCronGetResponse(rsp)
 }
 
-// EventDataGetWithResponse request returning *EventDataGetResponse
-func (c *ClientWithResponses) EventDataGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDataGetResponse, error) {
-	rsp, err := c.EventDataGet(ctx, event, reqEditors...)
+// WorkflowCronUpdateWithBodyWithResponse request with arbitrary body returning *WorkflowCronUpdateResponse
+func (c *ClientWithResponses) WorkflowCronUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowCronUpdateResponse, error) {
+	rsp, err := c.WorkflowCronUpdateWithBody(ctx, tenant, cronWorkflow, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventDataGetResponse(rsp)
+	return ParseWorkflowCronUpdateResponse(rsp)
 }
 
-// MetadataGetWithResponse request returning *MetadataGetResponse
-func (c *ClientWithResponses) MetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataGetResponse, error) {
-	rsp, err := c.MetadataGet(ctx, reqEditors...)
+func (c *ClientWithResponses) WorkflowCronUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, body WorkflowCronUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowCronUpdateResponse, error) {
+	rsp, err := c.WorkflowCronUpdate(ctx, tenant, cronWorkflow, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseMetadataGetResponse(rsp)
+	return ParseWorkflowCronUpdateResponse(rsp)
 }
 
-// MetadataListIntegrationsWithResponse request returning *MetadataListIntegrationsResponse
-func (c *ClientWithResponses) MetadataListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataListIntegrationsResponse, error) {
-	rsp, err := c.MetadataListIntegrations(ctx, reqEditors...)
+// WorkflowRunListWithResponse request returning *WorkflowRunListResponse
+func (c *ClientWithResponses) WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*WorkflowRunListResponse, error) {
+	rsp, err := c.WorkflowRunList(ctx, tenant, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseMetadataListIntegrationsResponse(rsp)
+	return ParseWorkflowRunListResponse(rsp)
 }
 
-// MonitoringPostRunProbeWithResponse request returning *MonitoringPostRunProbeResponse
-func (c *ClientWithResponses) MonitoringPostRunProbeWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*MonitoringPostRunProbeResponse, error) {
-	rsp, err := c.MonitoringPostRunProbe(ctx, tenant, reqEditors...)
+// WorkflowRunGetMetricsWithResponse request returning *WorkflowRunGetMetricsResponse
+func (c *ClientWithResponses) WorkflowRunGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowRunGetMetricsResponse, error) {
+	rsp, err := c.WorkflowRunGetMetrics(ctx, tenant, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseMonitoringPostRunProbeResponse(rsp)
+	return ParseWorkflowRunGetMetricsResponse(rsp)
 }
 
-// SlackWebhookDeleteWithResponse request returning *SlackWebhookDeleteResponse
-func (c *ClientWithResponses) SlackWebhookDeleteWithResponse(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookDeleteResponse, error) {
-	rsp, err := c.SlackWebhookDelete(ctx, slack, reqEditors...)
+// WorkflowScheduledListWithResponse request returning *WorkflowScheduledListResponse
+func (c *ClientWithResponses) WorkflowScheduledListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*WorkflowScheduledListResponse, error) {
+	rsp, err := c.WorkflowScheduledList(ctx, tenant, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSlackWebhookDeleteResponse(rsp)
+	return ParseWorkflowScheduledListResponse(rsp)
 }
 
-// SnsDeleteWithResponse request returning *SnsDeleteResponse
-func (c *ClientWithResponses) SnsDeleteWithResponse(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsDeleteResponse, error) {
-	rsp, err := c.SnsDelete(ctx, sns, reqEditors...)
+// WorkflowScheduledDeleteWithResponse request returning *WorkflowScheduledDeleteResponse
+func (c *ClientWithResponses) WorkflowScheduledDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledDeleteResponse, error) {
+	rsp, err := c.WorkflowScheduledDelete(ctx, tenant, scheduledWorkflowRun, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSnsDeleteResponse(rsp)
+	return ParseWorkflowScheduledDeleteResponse(rsp)
 }
 
-// SnsUpdateWithResponse request returning *SnsUpdateResponse
-func (c *ClientWithResponses) SnsUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*SnsUpdateResponse, error) {
-	rsp, err := c.SnsUpdate(ctx, tenant, event, reqEditors...)
+// WorkflowScheduledGetWithResponse request returning *WorkflowScheduledGetResponse
+func (c *ClientWithResponses) WorkflowScheduledGetWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledGetResponse, error) {
+	rsp, err := c.WorkflowScheduledGet(ctx, tenant, scheduledWorkflowRun, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSnsUpdateResponse(rsp)
+	return ParseWorkflowScheduledGetResponse(rsp)
 }
 
-// StepRunListArchivesWithResponse request returning *StepRunListArchivesResponse
-func (c *ClientWithResponses) StepRunListArchivesWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*StepRunListArchivesResponse, error) {
-	rsp, err := c.StepRunListArchives(ctx, stepRun, params, reqEditors...)
+// CronWorkflowTriggerCreateWithBodyWithResponse request with arbitrary body returning *CronWorkflowTriggerCreateResponse
+func (c *ClientWithResponses) CronWorkflowTriggerCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error) {
+	rsp, err := c.CronWorkflowTriggerCreateWithBody(ctx, tenant, workflow, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunListArchivesResponse(rsp)
+	return ParseCronWorkflowTriggerCreateResponse(rsp)
 }
 
-// StepRunListEventsWithResponse request returning *StepRunListEventsResponse
-func (c *ClientWithResponses) StepRunListEventsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*StepRunListEventsResponse, error) {
-	rsp, err := c.StepRunListEvents(ctx, stepRun, params, reqEditors...)
+func (c *ClientWithResponses) CronWorkflowTriggerCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error) {
+	rsp, err := c.CronWorkflowTriggerCreate(ctx, tenant, workflow, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunListEventsResponse(rsp)
+	return ParseCronWorkflowTriggerCreateResponse(rsp)
 }
 
-// LogLineListWithResponse request returning *LogLineListResponse
-func (c *ClientWithResponses) LogLineListWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*LogLineListResponse, error) {
-	rsp, err := c.LogLineList(ctx, stepRun, params, reqEditors...)
+// ScheduledWorkflowRunCreateWithBodyWithResponse request with arbitrary body returning *ScheduledWorkflowRunCreateResponse
+func (c *ClientWithResponses) ScheduledWorkflowRunCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error) {
+	rsp, err := c.ScheduledWorkflowRunCreateWithBody(ctx, tenant, workflow, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLogLineListResponse(rsp)
+	return ParseScheduledWorkflowRunCreateResponse(rsp)
 }
 
-// TenantCreateWithBodyWithResponse request with arbitrary body returning *TenantCreateResponse
-func (c *ClientWithResponses) TenantCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error) {
-	rsp, err := c.TenantCreateWithBody(ctx, contentType, body, reqEditors...)
+func (c *ClientWithResponses) ScheduledWorkflowRunCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error) {
+	rsp, err := c.ScheduledWorkflowRunCreate(ctx, tenant, workflow, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantCreateResponse(rsp)
+	return ParseScheduledWorkflowRunCreateResponse(rsp)
 }
 
-func (c *ClientWithResponses) TenantCreateWithResponse(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error) {
-	rsp, err := c.TenantCreate(ctx, body, reqEditors...)
+// WorkflowGetWorkersCountWithResponse request returning *WorkflowGetWorkersCountResponse
+func (c *ClientWithResponses) WorkflowGetWorkersCountWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetWorkersCountResponse, error) {
+	rsp, err := c.WorkflowGetWorkersCount(ctx, tenant, workflow, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantCreateResponse(rsp)
+	return ParseWorkflowGetWorkersCountResponse(rsp)
 }
 
-// TenantUpdateWithBodyWithResponse request with arbitrary body returning *TenantUpdateResponse
-func (c *ClientWithResponses) TenantUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error) {
-	rsp, err := c.TenantUpdateWithBody(ctx, tenant, contentType, body, reqEditors...)
+// UserGetCurrentWithResponse request returning *UserGetCurrentResponse
+func (c *ClientWithResponses) UserGetCurrentWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetCurrentResponse, error) {
+	rsp, err := c.UserGetCurrent(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantUpdateResponse(rsp)
+	return ParseUserGetCurrentResponse(rsp)
 }
 
-func (c *ClientWithResponses) TenantUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error) {
-	rsp, err := c.TenantUpdate(ctx, tenant, body, reqEditors...)
+// UserUpdateGithubOauthCallbackWithResponse request returning *UserUpdateGithubOauthCallbackResponse
+func (c *ClientWithResponses) UserUpdateGithubOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthCallbackResponse, error) {
+	rsp, err := c.UserUpdateGithubOauthCallback(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantUpdateResponse(rsp)
+	return ParseUserUpdateGithubOauthCallbackResponse(rsp)
 }
 
-// AlertEmailGroupListWithResponse request returning *AlertEmailGroupListResponse
-func (c *ClientWithResponses) AlertEmailGroupListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupListResponse, error) {
-	rsp, err := c.AlertEmailGroupList(ctx, tenant, reqEditors...)
+// UserUpdateGithubOauthStartWithResponse request returning *UserUpdateGithubOauthStartResponse
+func (c *ClientWithResponses) UserUpdateGithubOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthStartResponse, error) {
+	rsp, err := c.UserUpdateGithubOauthStart(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupListResponse(rsp)
+	return ParseUserUpdateGithubOauthStartResponse(rsp)
 }
 
-// AlertEmailGroupCreateWithBodyWithResponse request with arbitrary body returning *AlertEmailGroupCreateResponse
-func (c *ClientWithResponses) AlertEmailGroupCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error) {
-	rsp, err := c.AlertEmailGroupCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+// UserUpdateGoogleOauthCallbackWithResponse request returning *UserUpdateGoogleOauthCallbackResponse
+func (c *ClientWithResponses) UserUpdateGoogleOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthCallbackResponse, error) {
+	rsp, err := c.UserUpdateGoogleOauthCallback(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupCreateResponse(rsp)
+	return ParseUserUpdateGoogleOauthCallbackResponse(rsp)
 }
 
-func (c *ClientWithResponses) AlertEmailGroupCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error) {
-	rsp, err := c.AlertEmailGroupCreate(ctx, tenant, body, reqEditors...)
+// UserUpdateGoogleOauthStartWithResponse request returning *UserUpdateGoogleOauthStartResponse
+func (c *ClientWithResponses) UserUpdateGoogleOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthStartResponse, error) {
+	rsp, err := c.UserUpdateGoogleOauthStart(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupCreateResponse(rsp)
+	return ParseUserUpdateGoogleOauthStartResponse(rsp)
 }
 
-// TenantAlertingSettingsGetWithResponse request returning *TenantAlertingSettingsGetResponse
-func (c *ClientWithResponses) TenantAlertingSettingsGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantAlertingSettingsGetResponse, error) {
-	rsp, err := c.TenantAlertingSettingsGet(ctx, tenant, reqEditors...)
+// UserListTenantInvitesWithResponse request returning *UserListTenantInvitesResponse
+func (c *ClientWithResponses) UserListTenantInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListTenantInvitesResponse, error) {
+	rsp, err := c.UserListTenantInvites(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantAlertingSettingsGetResponse(rsp)
+	return ParseUserListTenantInvitesResponse(rsp)
 }
 
-// ApiTokenListWithResponse request returning *ApiTokenListResponse
-func (c *ClientWithResponses) ApiTokenListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenListResponse, error) {
-	rsp, err := c.ApiTokenList(ctx, tenant, reqEditors...)
+// TenantInviteAcceptWithBodyWithResponse request with arbitrary body returning *TenantInviteAcceptResponse
+func (c *ClientWithResponses) TenantInviteAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error) {
+	rsp, err := c.TenantInviteAcceptWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiTokenListResponse(rsp)
+	return ParseTenantInviteAcceptResponse(rsp)
 }
 
-// ApiTokenCreateWithBodyWithResponse request with arbitrary body returning *ApiTokenCreateResponse
-func (c *ClientWithResponses) ApiTokenCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error) {
-	rsp, err := c.ApiTokenCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+func (c *ClientWithResponses) TenantInviteAcceptWithResponse(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error) {
+	rsp, err := c.TenantInviteAccept(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiTokenCreateResponse(rsp)
+	return ParseTenantInviteAcceptResponse(rsp)
 }
 
-func (c *ClientWithResponses) ApiTokenCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error) {
-	rsp, err := c.ApiTokenCreate(ctx, tenant, body, reqEditors...)
+// TenantInviteRejectWithBodyWithResponse request with arbitrary body returning *TenantInviteRejectResponse
+func (c *ClientWithResponses) TenantInviteRejectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error) {
+	rsp, err := c.TenantInviteRejectWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiTokenCreateResponse(rsp)
+	return ParseTenantInviteRejectResponse(rsp)
 }
 
-// EventListWithResponse request returning *EventListResponse
-func (c *ClientWithResponses) EventListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*EventListResponse, error) {
-	rsp, err := c.EventList(ctx, tenant, params, reqEditors...)
+func (c *ClientWithResponses) TenantInviteRejectWithResponse(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error) {
+	rsp, err := c.TenantInviteReject(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventListResponse(rsp)
+	return ParseTenantInviteRejectResponse(rsp)
 }
 
-// EventCreateWithBodyWithResponse request with arbitrary body returning *EventCreateResponse
-func (c *ClientWithResponses) EventCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateResponse, error) {
-	rsp, err := c.EventCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+// UserUpdateLoginWithBodyWithResponse request with arbitrary body returning *UserUpdateLoginResponse
+func (c *ClientWithResponses) UserUpdateLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error) {
+	rsp, err := c.UserUpdateLoginWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventCreateResponse(rsp)
+	return ParseUserUpdateLoginResponse(rsp)
 }
 
-func (c *ClientWithResponses) EventCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateResponse, error) {
-	rsp, err := c.EventCreate(ctx, tenant, body, reqEditors...)
+func (c *ClientWithResponses) UserUpdateLoginWithResponse(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error) {
+	rsp, err := c.UserUpdateLogin(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventCreateResponse(rsp)
+	return ParseUserUpdateLoginResponse(rsp)
 }
 
-// EventCreateBulkWithBodyWithResponse request with arbitrary body returning *EventCreateBulkResponse
-func (c *ClientWithResponses) EventCreateBulkWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error) {
-	rsp, err := c.EventCreateBulkWithBody(ctx, tenant, contentType, body, reqEditors...)
+// UserUpdateLogoutWithResponse request returning *UserUpdateLogoutResponse
+func (c *ClientWithResponses) UserUpdateLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateLogoutResponse, error) {
+	rsp, err := c.UserUpdateLogout(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventCreateBulkResponse(rsp)
+	return ParseUserUpdateLogoutResponse(rsp)
 }
 
-func (c *ClientWithResponses) EventCreateBulkWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error) {
-	rsp, err := c.EventCreateBulk(ctx, tenant, body, reqEditors...)
+// TenantMembershipsListWithResponse request returning *TenantMembershipsListResponse
+func (c *ClientWithResponses) TenantMembershipsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TenantMembershipsListResponse, error) {
+	rsp, err := c.TenantMembershipsList(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventCreateBulkResponse(rsp)
+	return ParseTenantMembershipsListResponse(rsp)
 }
 
-// EventUpdateCancelWithBodyWithResponse request with arbitrary body returning *EventUpdateCancelResponse
-func (c *ClientWithResponses) EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
-	rsp, err := c.EventUpdateCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
+// UserUpdateOidcOauthCallbackWithResponse request returning *UserUpdateOidcOauthCallbackResponse
+func (c *ClientWithResponses) UserUpdateOidcOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateOidcOauthCallbackResponse, error) {
+	rsp, err := c.UserUpdateOidcOauthCallback(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventUpdateCancelResponse(rsp)
+	return ParseUserUpdateOidcOauthCallbackResponse(rsp)
 }
 
-func (c *ClientWithResponses) EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
-	rsp, err := c.EventUpdateCancel(ctx, tenant, body, reqEditors...)
+// UserUpdateOidcOauthStartWithResponse request returning *UserUpdateOidcOauthStartResponse
+func (c *ClientWithResponses) UserUpdateOidcOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateOidcOauthStartResponse, error) {
+	rsp, err := c.UserUpdateOidcOauthStart(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventUpdateCancelResponse(rsp)
+	return ParseUserUpdateOidcOauthStartResponse(rsp)
 }
 
-// EventKeyListWithResponse request returning *EventKeyListResponse
-func (c *ClientWithResponses) EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error) {
-	rsp, err := c.EventKeyList(ctx, tenant, reqEditors...)
+// UserUpdatePasswordWithBodyWithResponse request with arbitrary body returning *UserUpdatePasswordResponse
+func (c *ClientWithResponses) UserUpdatePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error) {
+	rsp, err := c.UserUpdatePasswordWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventKeyListResponse(rsp)
+	return ParseUserUpdatePasswordResponse(rsp)
 }
 
-// EventUpdateReplayWithBodyWithResponse request with arbitrary body returning *EventUpdateReplayResponse
-func (c *ClientWithResponses) EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
-	rsp, err := c.EventUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
+func (c *ClientWithResponses) UserUpdatePasswordWithResponse(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error) {
+	rsp, err := c.UserUpdatePassword(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventUpdateReplayResponse(rsp)
+	return ParseUserUpdatePasswordResponse(rsp)
 }
 
-func (c *ClientWithResponses) EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
-	rsp, err := c.EventUpdateReplay(ctx, tenant, body, reqEditors...)
+// UserCreateWithBodyWithResponse request with arbitrary body returning *UserCreateResponse
+func (c *ClientWithResponses) UserCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateResponse, error) {
+	rsp, err := c.UserCreateWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventUpdateReplayResponse(rsp)
+	return ParseUserCreateResponse(rsp)
 }
 
-// TenantInviteListWithResponse request returning *TenantInviteListResponse
-func (c *ClientWithResponses) TenantInviteListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteListResponse, error) {
-	rsp, err := c.TenantInviteList(ctx, tenant, reqEditors...)
+func (c *ClientWithResponses) UserCreateWithResponse(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateResponse, error) {
+	rsp, err := c.UserCreate(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteListResponse(rsp)
+	return ParseUserCreateResponse(rsp)
 }
 
-// TenantInviteCreateWithBodyWithResponse request with arbitrary body returning *TenantInviteCreateResponse
-func (c *ClientWithResponses) TenantInviteCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error) {
-	rsp, err := c.TenantInviteCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+// UserUpdateSlackOauthCallbackWithResponse request returning *UserUpdateSlackOauthCallbackResponse
+func (c *ClientWithResponses) UserUpdateSlackOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthCallbackResponse, error) {
+	rsp, err := c.UserUpdateSlackOauthCallback(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteCreateResponse(rsp)
+	return ParseUserUpdateSlackOauthCallbackResponse(rsp)
 }
 
-func (c *ClientWithResponses) TenantInviteCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error) {
-	rsp, err := c.TenantInviteCreate(ctx, tenant, body, reqEditors...)
+// InfoGetVersionWithResponse request returning *InfoGetVersionResponse
+func (c *ClientWithResponses) InfoGetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*InfoGetVersionResponse, error) {
+	rsp, err := c.InfoGetVersion(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteCreateResponse(rsp)
+	return ParseInfoGetVersionResponse(rsp)
 }
 
-// TenantInviteDeleteWithResponse request returning *TenantInviteDeleteResponse
-func (c *ClientWithResponses) TenantInviteDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteDeleteResponse, error) {
-	rsp, err := c.TenantInviteDelete(ctx, tenant, tenantInvite, reqEditors...)
+// WebhookDeleteWithResponse request returning *WebhookDeleteResponse
+func (c *ClientWithResponses) WebhookDeleteWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeleteResponse, error) {
+	rsp, err := c.WebhookDelete(ctx, webhook, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteDeleteResponse(rsp)
+	return ParseWebhookDeleteResponse(rsp)
 }
 
-// TenantInviteUpdateWithBodyWithResponse request with arbitrary body returning *TenantInviteUpdateResponse
-func (c *ClientWithResponses) TenantInviteUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error) {
-	rsp, err := c.TenantInviteUpdateWithBody(ctx, tenant, tenantInvite, contentType, body, reqEditors...)
+// WebhookRequestsListWithResponse request returning *WebhookRequestsListResponse
+func (c *ClientWithResponses) WebhookRequestsListWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookRequestsListResponse, error) {
+	rsp, err := c.WebhookRequestsList(ctx, webhook, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteUpdateResponse(rsp)
+	return ParseWebhookRequestsListResponse(rsp)
 }
 
-func (c *ClientWithResponses) TenantInviteUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error) {
-	rsp, err := c.TenantInviteUpdate(ctx, tenant, tenantInvite, body, reqEditors...)
+// WorkerGetWithResponse request returning *WorkerGetResponse
+func (c *ClientWithResponses) WorkerGetWithResponse(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerGetResponse, error) {
+	rsp, err := c.WorkerGet(ctx, worker, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteUpdateResponse(rsp)
+	return ParseWorkerGetResponse(rsp)
 }
 
-// TenantMemberListWithResponse request returning *TenantMemberListResponse
-func (c *ClientWithResponses) TenantMemberListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberListResponse, error) {
-	rsp, err := c.TenantMemberList(ctx, tenant, reqEditors...)
+// WorkerUpdateWithBodyWithResponse request with arbitrary body returning *WorkerUpdateResponse
+func (c *ClientWithResponses) WorkerUpdateWithBodyWithResponse(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error) {
+	rsp, err := c.WorkerUpdateWithBody(ctx, worker, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantMemberListResponse(rsp)
+	return ParseWorkerUpdateResponse(rsp)
 }
 
-// TenantMemberDeleteWithResponse request returning *TenantMemberDeleteResponse
-func (c *ClientWithResponses) TenantMemberDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberDeleteResponse, error) {
-	rsp, err := c.TenantMemberDelete(ctx, tenant, member, reqEditors...)
+func (c *ClientWithResponses) WorkerUpdateWithResponse(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error) {
+	rsp, err := c.WorkerUpdate(ctx, worker, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantMemberDeleteResponse(rsp)
+	return ParseWorkerUpdateResponse(rsp)
 }
 
-// TenantGetQueueMetricsWithResponse request returning *TenantGetQueueMetricsResponse
-func (c *ClientWithResponses) TenantGetQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*TenantGetQueueMetricsResponse, error) {
-	rsp, err := c.TenantGetQueueMetrics(ctx, tenant, params, reqEditors...)
+// WorkflowDeleteWithResponse request returning *WorkflowDeleteResponse
+func (c *ClientWithResponses) WorkflowDeleteWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteResponse, error) {
+	rsp, err := c.WorkflowDelete(ctx, workflow, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantGetQueueMetricsResponse(rsp)
+	return ParseWorkflowDeleteResponse(rsp)
 }
 
-// RateLimitListWithResponse request returning *RateLimitListResponse
-func (c *ClientWithResponses) RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*RateLimitListResponse, error) {
-	rsp, err := c.RateLimitList(ctx, tenant, params, reqEditors...)
+// WorkflowGetWithResponse request returning *WorkflowGetResponse
+func (c *ClientWithResponses) WorkflowGetWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetResponse, error) {
+	rsp, err := c.WorkflowGet(ctx, workflow, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRateLimitListResponse(rsp)
+	return ParseWorkflowGetResponse(rsp)
 }
 
-// TenantResourcePolicyGetWithResponse request returning *TenantResourcePolicyGetResponse
-func (c *ClientWithResponses) TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error) {
-	rsp, err := c.TenantResourcePolicyGet(ctx, tenant, reqEditors...)
+// WorkflowUpdateWithBodyWithResponse request with arbitrary body returning *WorkflowUpdateResponse
+func (c *ClientWithResponses) WorkflowUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error) {
+	rsp, err := c.WorkflowUpdateWithBody(ctx, workflow, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantResourcePolicyGetResponse(rsp)
+	return ParseWorkflowUpdateResponse(rsp)
 }
 
-// SlackWebhookListWithResponse request returning *SlackWebhookListResponse
-func (c *ClientWithResponses) SlackWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookListResponse, error) {
-	rsp, err := c.SlackWebhookList(ctx, tenant, reqEditors...)
+func (c *ClientWithResponses) WorkflowUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error) {
+	rsp, err := c.WorkflowUpdate(ctx, workflow, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSlackWebhookListResponse(rsp)
+	return ParseWorkflowUpdateResponse(rsp)
 }
 
-// UserUpdateSlackOauthStartWithResponse request returning *UserUpdateSlackOauthStartResponse
-func (c *ClientWithResponses) UserUpdateSlackOauthStartWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthStartResponse, error) {
-	rsp, err := c.UserUpdateSlackOauthStart(ctx, tenant, reqEditors...)
+// WorkflowGetMetricsWithResponse request returning *WorkflowGetMetricsResponse
+func (c *ClientWithResponses) WorkflowGetMetricsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowGetMetricsResponse, error) {
+	rsp, err := c.WorkflowGetMetrics(ctx, workflow, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserUpdateSlackOauthStartResponse(rsp)
+	return ParseWorkflowGetMetricsResponse(rsp)
 }
 
-// SnsListWithResponse request returning *SnsListResponse
-func (c *ClientWithResponses) SnsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsListResponse, error) {
-	rsp, err := c.SnsList(ctx, tenant, reqEditors...)
+// WorkflowRunCreateWithBodyWithResponse request with arbitrary body returning *WorkflowRunCreateResponse
+func (c *ClientWithResponses) WorkflowRunCreateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error) {
+	rsp, err := c.WorkflowRunCreateWithBody(ctx, workflow, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSnsListResponse(rsp)
+	return ParseWorkflowRunCreateResponse(rsp)
 }
 
-// SnsCreateWithBodyWithResponse request with arbitrary body returning *SnsCreateResponse
-func (c *ClientWithResponses) SnsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error) {
-	rsp, err := c.SnsCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+func (c *ClientWithResponses) WorkflowRunCreateWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error) {
+	rsp, err := c.WorkflowRunCreate(ctx, workflow, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSnsCreateResponse(rsp)
+	return ParseWorkflowRunCreateResponse(rsp)
 }
 
-func (c *ClientWithResponses) SnsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error) {
-	rsp, err := c.SnsCreate(ctx, tenant, body, reqEditors...)
+// WorkflowVersionGetWithResponse request returning *WorkflowVersionGetResponse
+func (c *ClientWithResponses) WorkflowVersionGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionGetResponse, error) {
+	rsp, err := c.WorkflowVersionGet(ctx, workflow, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSnsCreateResponse(rsp)
+	return ParseWorkflowVersionGetResponse(rsp)
 }
 
-// TenantGetStepRunQueueMetricsWithResponse request returning *TenantGetStepRunQueueMetricsResponse
-func (c *ClientWithResponses) TenantGetStepRunQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantGetStepRunQueueMetricsResponse, error) {
-	rsp, err := c.TenantGetStepRunQueueMetrics(ctx, tenant, reqEditors...)
+// V2DagListTasksWithResponse request returning *V2DagListTasksResponse
+func (c *ClientWithResponses) V2DagListTasksWithResponse(ctx context.Context, params *V2DagListTasksParams, reqEditors ...RequestEditorFn) (*V2DagListTasksResponse, error) {
+	rsp, err := c.V2DagListTasks(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantGetStepRunQueueMetricsResponse(rsp)
+	return ParseV2DagListTasksResponse(rsp)
 }
 
-// StepRunGetWithResponse request returning *StepRunGetResponse
-func (c *ClientWithResponses) StepRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetResponse, error) {
-	rsp, err := c.StepRunGet(ctx, tenant, stepRun, reqEditors...)
+// V2TaskGetWithResponse request returning *V2TaskGetResponse
+func (c *ClientWithResponses) V2TaskGetWithResponse(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*V2TaskGetResponse, error) {
+	rsp, err := c.V2TaskGet(ctx, task, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunGetResponse(rsp)
+	return ParseV2TaskGetResponse(rsp)
 }
 
-// StepRunUpdateCancelWithResponse request returning *StepRunUpdateCancelResponse
-func (c *ClientWithResponses) StepRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateCancelResponse, error) {
-	rsp, err := c.StepRunUpdateCancel(ctx, tenant, stepRun, reqEditors...)
+// V2TaskGetQueueDiagnosisWithResponse request returning *V2TaskGetQueueDiagnosisResponse
+func (c *ClientWithResponses) V2TaskGetQueueDiagnosisWithResponse(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*V2TaskGetQueueDiagnosisResponse, error) {
+	rsp, err := c.V2TaskGetQueueDiagnosis(ctx, task, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunUpdateCancelResponse(rsp)
+	return ParseV2TaskGetQueueDiagnosisResponse(rsp)
 }
 
-// StepRunUpdateRerunWithBodyWithResponse request with arbitrary body returning *StepRunUpdateRerunResponse
-func (c *ClientWithResponses) StepRunUpdateRerunWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error) {
-	rsp, err := c.StepRunUpdateRerunWithBody(ctx, tenant, stepRun, contentType, body, reqEditors...)
+// V2TaskEventListWithResponse request returning *V2TaskEventListResponse
+func (c *ClientWithResponses) V2TaskEventListWithResponse(ctx context.Context, task openapi_types.UUID, params *V2TaskEventListParams, reqEditors ...RequestEditorFn) (*V2TaskEventListResponse, error) {
+	rsp, err := c.V2TaskEventList(ctx, task, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunUpdateRerunResponse(rsp)
+	return ParseV2TaskEventListResponse(rsp)
 }
 
-func (c *ClientWithResponses) StepRunUpdateRerunWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error) {
-	rsp, err := c.StepRunUpdateRerun(ctx, tenant, stepRun, body, reqEditors...)
+// V2ConcurrencyListWithResponse request returning *V2ConcurrencyListResponse
+func (c *ClientWithResponses) V2ConcurrencyListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2ConcurrencyListParams, reqEditors ...RequestEditorFn) (*V2ConcurrencyListResponse, error) {
+	rsp, err := c.V2ConcurrencyList(ctx, tenant, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunUpdateRerunResponse(rsp)
+	return ParseV2ConcurrencyListResponse(rsp)
 }
 
-// StepRunGetSchemaWithResponse request returning *StepRunGetSchemaResponse
-func (c *ClientWithResponses) StepRunGetSchemaWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetSchemaResponse, error) {
-	rsp, err := c.StepRunGetSchema(ctx, tenant, stepRun, reqEditors...)
+// V2RateLimitListWithResponse request returning *V2RateLimitListResponse
+func (c *ClientWithResponses) V2RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2RateLimitListParams, reqEditors ...RequestEditorFn) (*V2RateLimitListResponse, error) {
+	rsp, err := c.V2RateLimitList(ctx, tenant, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunGetSchemaResponse(rsp)
+	return ParseV2RateLimitListResponse(rsp)
 }
 
-// WebhookListWithResponse request returning *WebhookListResponse
-func (c *ClientWithResponses) WebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookListResponse, error) {
-	rsp, err := c.WebhookList(ctx, tenant, reqEditors...)
+// V2TaskListStatusMetricsWithResponse request returning *V2TaskListStatusMetricsResponse
+func (c *ClientWithResponses) V2TaskListStatusMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListStatusMetricsParams, reqEditors ...RequestEditorFn) (*V2TaskListStatusMetricsResponse, error) {
+	rsp, err := c.V2TaskListStatusMetrics(ctx, tenant, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWebhookListResponse(rsp)
+	return ParseV2TaskListStatusMetricsResponse(rsp)
 }
 
-// WebhookCreateWithBodyWithResponse request with arbitrary body returning *WebhookCreateResponse
-func (c *ClientWithResponses) WebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error) {
-	rsp, err := c.WebhookCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+// V2TaskGetPointMetricsWithResponse request returning *V2TaskGetPointMetricsResponse
+func (c *ClientWithResponses) V2TaskGetPointMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2TaskGetPointMetricsParams, reqEditors ...RequestEditorFn) (*V2TaskGetPointMetricsResponse, error) {
+	rsp, err := c.V2TaskGetPointMetrics(ctx, tenant, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWebhookCreateResponse(rsp)
+	return ParseV2TaskGetPointMetricsResponse(rsp)
 }
 
-func (c *ClientWithResponses) WebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error) {
-	rsp, err := c.WebhookCreate(ctx, tenant, body, reqEditors...)
+// V2TaskListWithResponse request returning *V2TaskListResponse
+func (c *ClientWithResponses) V2TaskListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListParams, reqEditors ...RequestEditorFn) (*V2TaskListResponse, error) {
+	rsp, err := c.V2TaskList(ctx, tenant, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWebhookCreateResponse(rsp)
+	return ParseV2TaskListResponse(rsp)
 }
 
-// WorkerListWithResponse request returning *WorkerListResponse
-func (c *ClientWithResponses) WorkerListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerListResponse, error) {
-	rsp, err := c.WorkerList(ctx, tenant, reqEditors...)
+// V2TaskCancelWithBodyWithResponse request with arbitrary body returning *V2TaskCancelResponse
+func (c *ClientWithResponses) V2TaskCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V2TaskCancelResponse, error) {
+	rsp, err := c.V2TaskCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkerListResponse(rsp)
+	return ParseV2TaskCancelResponse(rsp)
 }
 
-// WorkflowRunUpdateReplayWithBodyWithResponse request with arbitrary body returning *WorkflowRunUpdateReplayResponse
-func (c *ClientWithResponses) WorkflowRunUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error) {
-	rsp, err := c.WorkflowRunUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
+func (c *ClientWithResponses) V2TaskCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body V2TaskCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*V2TaskCancelResponse, error) {
+	rsp, err := c.V2TaskCancel(ctx, tenant, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunUpdateReplayResponse(rsp)
+	return ParseV2TaskCancelResponse(rsp)
 }
 
-func (c *ClientWithResponses) WorkflowRunUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error) {
-	rsp, err := c.WorkflowRunUpdateReplay(ctx, tenant, body, reqEditors...)
+// V2TaskReplayWithBodyWithResponse request with arbitrary body returning *V2TaskReplayResponse
+func (c *ClientWithResponses) V2TaskReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V2TaskReplayResponse, error) {
+	rsp, err := c.V2TaskReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunUpdateReplayResponse(rsp)
+	return ParseV2TaskReplayResponse(rsp)
 }
 
-// WorkflowRunGetWithResponse request returning *WorkflowRunGetResponse
-func (c *ClientWithResponses) WorkflowRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetResponse, error) {
-	rsp, err := c.WorkflowRunGet(ctx, tenant, workflowRun, reqEditors...)
+func (c *ClientWithResponses) V2TaskReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body V2TaskReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*V2TaskReplayResponse, error) {
+	rsp, err := c.V2TaskReplay(ctx, tenant, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunGetResponse(rsp)
+	return ParseV2TaskReplayResponse(rsp)
 }
 
-// WorkflowRunGetInputWithResponse request returning *WorkflowRunGetInputResponse
-func (c *ClientWithResponses) WorkflowRunGetInputWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetInputResponse, error) {
-	rsp, err := c.WorkflowRunGetInput(ctx, tenant, workflowRun, reqEditors...)
+// V2WorkflowRunListWithResponse request returning *V2WorkflowRunListResponse
+func (c *ClientWithResponses) V2WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2WorkflowRunListParams, reqEditors ...RequestEditorFn) (*V2WorkflowRunListResponse, error) {
+	rsp, err := c.V2WorkflowRunList(ctx, tenant, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunGetInputResponse(rsp)
+	return ParseV2WorkflowRunListResponse(rsp)
 }
 
-// WorkflowRunGetShapeWithResponse request returning *WorkflowRunGetShapeResponse
-func (c *ClientWithResponses) WorkflowRunGetShapeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetShapeResponse, error) {
-	rsp, err := c.WorkflowRunGetShape(ctx, tenant, workflowRun, reqEditors...)
+// V2WorkflowRunCancelWithBodyWithResponse request with arbitrary body returning *V2WorkflowRunCancelResponse
+func (c *ClientWithResponses) V2WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V2WorkflowRunCancelResponse, error) {
+	rsp, err := c.V2WorkflowRunCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunGetShapeResponse(rsp)
+	return ParseV2WorkflowRunCancelResponse(rsp)
 }
 
-// WorkflowRunListStepRunEventsWithResponse request returning *WorkflowRunListStepRunEventsResponse
-func (c *ClientWithResponses) WorkflowRunListStepRunEventsWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*WorkflowRunListStepRunEventsResponse, error) {
-	rsp, err := c.WorkflowRunListStepRunEvents(ctx, tenant, workflowRun, params, reqEditors...)
+func (c *ClientWithResponses) V2WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body V2WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*V2WorkflowRunCancelResponse, error) {
+	rsp, err := c.V2WorkflowRunCancel(ctx, tenant, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunListStepRunEventsResponse(rsp)
+	return ParseV2WorkflowRunCancelResponse(rsp)
 }
 
-// WorkflowListWithResponse request returning *WorkflowListResponse
-func (c *ClientWithResponses) WorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowListParams, reqEditors ...RequestEditorFn) (*WorkflowListResponse, error) {
-	rsp, err := c.WorkflowList(ctx, tenant, params, reqEditors...)
+// V2WorkflowRunReplayWithBodyWithResponse request with arbitrary body returning *V2WorkflowRunReplayResponse
+func (c *ClientWithResponses) V2WorkflowRunReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V2WorkflowRunReplayResponse, error) {
+	rsp, err := c.V2WorkflowRunReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowListResponse(rsp)
+	return ParseV2WorkflowRunReplayResponse(rsp)
 }
 
-// WorkflowRunCancelWithBodyWithResponse request with arbitrary body returning *WorkflowRunCancelResponse
-func (c *ClientWithResponses) WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error) {
-	rsp, err := c.WorkflowRunCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
+func (c *ClientWithResponses) V2WorkflowRunReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body V2WorkflowRunReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*V2WorkflowRunReplayResponse, error) {
+	rsp, err := c.V2WorkflowRunReplay(ctx, tenant, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunCancelResponse(rsp)
+	return ParseV2WorkflowRunReplayResponse(rsp)
 }
 
-func (c *ClientWithResponses) WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error) {
-	rsp, err := c.WorkflowRunCancel(ctx, tenant, body, reqEditors...)
+// V2WorkflowRunGetWithResponse request returning *V2WorkflowRunGetResponse
+func (c *ClientWithResponses) V2WorkflowRunGetWithResponse(ctx context.Context, v2WorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*V2WorkflowRunGetResponse, error) {
+	rsp, err := c.V2WorkflowRunGet(ctx, v2WorkflowRun, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunCancelResponse(rsp)
+	return ParseV2WorkflowRunGetResponse(rsp)
 }
 
-// CronWorkflowListWithResponse request returning *CronWorkflowListResponse
-func (c *ClientWithResponses) CronWorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*CronWorkflowListResponse, error) {
-	rsp, err := c.CronWorkflowList(ctx, tenant, params, reqEditors...)
+// V2WorkflowRunReplayFromTaskWithBodyWithResponse request with arbitrary body returning *V2WorkflowRunReplayFromTaskResponse
+func (c *ClientWithResponses) V2WorkflowRunReplayFromTaskWithBodyWithResponse(ctx context.Context, v2WorkflowRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V2WorkflowRunReplayFromTaskResponse, error) {
+	rsp, err := c.V2WorkflowRunReplayFromTaskWithBody(ctx, v2WorkflowRun, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCronWorkflowListResponse(rsp)
+	return ParseV2WorkflowRunReplayFromTaskResponse(rsp)
 }
 
-// WorkflowCronDeleteWithResponse request returning *WorkflowCronDeleteResponse
-func (c *ClientWithResponses) WorkflowCronDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronDeleteResponse, error) {
-	rsp, err := c.WorkflowCronDelete(ctx, tenant, cronWorkflow, reqEditors...)
+func (c *ClientWithResponses) V2WorkflowRunReplayFromTaskWithResponse(ctx context.Context, v2WorkflowRun openapi_types.UUID, body V2WorkflowRunReplayFromTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*V2WorkflowRunReplayFromTaskResponse, error) {
+	rsp, err := c.V2WorkflowRunReplayFromTask(ctx, v2WorkflowRun, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowCronDeleteResponse(rsp)
+	return ParseV2WorkflowRunReplayFromTaskResponse(rsp)
 }
 
-// WorkflowCronGetWithResponse request returning *WorkflowCronGetResponse
-func (c *ClientWithResponses) WorkflowCronGetWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronGetResponse, error) {
-	rsp, err := c.WorkflowCronGet(ctx, tenant, cronWorkflow, reqEditors...)
+// V2WorkflowRunTaskEventsListWithResponse request returning *V2WorkflowRunTaskEventsListResponse
+func (c *ClientWithResponses) V2WorkflowRunTaskEventsListWithResponse(ctx context.Context, v2WorkflowRun openapi_types.UUID, params *V2WorkflowRunTaskEventsListParams, reqEditors ...RequestEditorFn) (*V2WorkflowRunTaskEventsListResponse, error) {
+	rsp, err := c.V2WorkflowRunTaskEventsList(ctx, v2WorkflowRun, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowCronGetResponse(rsp)
+	return ParseV2WorkflowRunTaskEventsListResponse(rsp)
 }
 
-// WorkflowRunListWithResponse request returning *WorkflowRunListResponse
-func (c *ClientWithResponses) WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*WorkflowRunListResponse, error) {
-	rsp, err := c.WorkflowRunList(ctx, tenant, params, reqEditors...)
+// ParseLivenessGetResponse parses an HTTP response from a LivenessGetWithResponse call
+func ParseLivenessGetResponse(rsp *http.Response) (*LivenessGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunListResponse(rsp)
+
+	response := &LivenessGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// WorkflowRunGetMetricsWithResponse request returning *WorkflowRunGetMetricsResponse
-func (c *ClientWithResponses) WorkflowRunGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowRunGetMetricsResponse, error) {
-	rsp, err := c.WorkflowRunGetMetrics(ctx, tenant, params, reqEditors...)
+// ParseReadinessGetResponse parses an HTTP response from a ReadinessGetWithResponse call
+func ParseReadinessGetResponse(rsp *http.Response) (*ReadinessGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunGetMetricsResponse(rsp)
+
+	response := &ReadinessGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// WorkflowScheduledListWithResponse request returning *WorkflowScheduledListResponse
-func (c *ClientWithResponses) WorkflowScheduledListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*WorkflowScheduledListResponse, error) {
-	rsp, err := c.WorkflowScheduledList(ctx, tenant, params, reqEditors...)
+// ParseAlertEmailGroupDeleteResponse parses an HTTP response from a AlertEmailGroupDeleteWithResponse call
+func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowScheduledListResponse(rsp)
+
+	response := &AlertEmailGroupDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
 }
 
-// WorkflowScheduledDeleteWithResponse request returning *WorkflowScheduledDeleteResponse
-func (c *ClientWithResponses) WorkflowScheduledDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledDeleteResponse, error) {
-	rsp, err := c.WorkflowScheduledDelete(ctx, tenant, scheduledWorkflowRun, reqEditors...)
+// ParseAlertEmailGroupUpdateResponse parses an HTTP response from a AlertEmailGroupUpdateWithResponse call
+func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowScheduledDeleteResponse(rsp)
+
+	response := &AlertEmailGroupUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantAlertEmailGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
 }
 
-// WorkflowScheduledGetWithResponse request returning *WorkflowScheduledGetResponse
-func (c *ClientWithResponses) WorkflowScheduledGetWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledGetResponse, error) {
-	rsp, err := c.WorkflowScheduledGet(ctx, tenant, scheduledWorkflowRun, reqEditors...)
+// ParseApiTokenUpdateRevokeResponse parses an HTTP response from a ApiTokenUpdateRevokeWithResponse call
+func ParseApiTokenUpdateRevokeResponse(rsp *http.Response) (*ApiTokenUpdateRevokeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowScheduledGetResponse(rsp)
+
+	response := &ApiTokenUpdateRevokeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
 }
 
-// CronWorkflowTriggerCreateWithBodyWithResponse request with arbitrary body returning *CronWorkflowTriggerCreateResponse
-func (c *ClientWithResponses) CronWorkflowTriggerCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error) {
-	rsp, err := c.CronWorkflowTriggerCreateWithBody(ctx, tenant, workflow, contentType, body, reqEditors...)
+// ParseCloudMetadataGetResponse parses an HTTP response from a CloudMetadataGetWithResponse call
+func ParseCloudMetadataGetResponse(rsp *http.Response) (*CloudMetadataGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCronWorkflowTriggerCreateResponse(rsp)
+
+	response := &CloudMetadataGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CronWorkflowTriggerCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error) {
-	rsp, err := c.CronWorkflowTriggerCreate(ctx, tenant, workflow, body, reqEditors...)
+// ParseEventGetResponse parses an HTTP response from a EventGetWithResponse call
+func ParseEventGetResponse(rsp *http.Response) (*EventGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCronWorkflowTriggerCreateResponse(rsp)
+
+	response := &EventGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Event
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ScheduledWorkflowRunCreateWithBodyWithResponse request with arbitrary body returning *ScheduledWorkflowRunCreateResponse
-func (c *ClientWithResponses) ScheduledWorkflowRunCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error) {
-	rsp, err := c.ScheduledWorkflowRunCreateWithBody(ctx, tenant, workflow, contentType, body, reqEditors...)
+// ParseEventDataGetResponse parses an HTTP response from a EventDataGetWithResponse call
+func ParseEventDataGetResponse(rsp *http.Response) (*EventDataGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseScheduledWorkflowRunCreateResponse(rsp)
+
+	response := &EventDataGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) ScheduledWorkflowRunCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error) {
-	rsp, err := c.ScheduledWorkflowRunCreate(ctx, tenant, workflow, body, reqEditors...)
+// ParseMetadataGetResponse parses an HTTP response from a MetadataGetWithResponse call
+func ParseMetadataGetResponse(rsp *http.Response) (*MetadataGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseScheduledWorkflowRunCreateResponse(rsp)
-}
 
-// WorkflowGetWorkersCountWithResponse request returning *WorkflowGetWorkersCountResponse
-func (c *ClientWithResponses) WorkflowGetWorkersCountWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetWorkersCountResponse, error) {
-	rsp, err := c.WorkflowGetWorkersCount(ctx, tenant, workflow, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &MetadataGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowGetWorkersCountResponse(rsp)
-}
 
-// UserGetCurrentWithResponse request returning *UserGetCurrentResponse
-func (c *ClientWithResponses) UserGetCurrentWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetCurrentResponse, error) {
-	rsp, err := c.UserGetCurrent(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIMeta
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseUserGetCurrentResponse(rsp)
+
+	return response, nil
 }
 
-// UserUpdateGithubOauthCallbackWithResponse request returning *UserUpdateGithubOauthCallbackResponse
-func (c *ClientWithResponses) UserUpdateGithubOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthCallbackResponse, error) {
-	rsp, err := c.UserUpdateGithubOauthCallback(ctx, reqEditors...)
+// ParseMetadataListIntegrationsResponse parses an HTTP response from a MetadataListIntegrationsWithResponse call
+func ParseMetadataListIntegrationsResponse(rsp *http.Response) (*MetadataListIntegrationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserUpdateGithubOauthCallbackResponse(rsp)
-}
 
-// UserUpdateGithubOauthStartWithResponse request returning *UserUpdateGithubOauthStartResponse
-func (c *ClientWithResponses) UserUpdateGithubOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthStartResponse, error) {
-	rsp, err := c.UserUpdateGithubOauthStart(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &MetadataListIntegrationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserUpdateGithubOauthStartResponse(rsp)
-}
 
-// UserUpdateGoogleOauthCallbackWithResponse request returning *UserUpdateGoogleOauthCallbackResponse
-func (c *ClientWithResponses) UserUpdateGoogleOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthCallbackResponse, error) {
-	rsp, err := c.UserUpdateGoogleOauthCallback(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListAPIMetaIntegration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseUserUpdateGoogleOauthCallbackResponse(rsp)
+
+	return response, nil
 }
 
-// UserUpdateGoogleOauthStartWithResponse request returning *UserUpdateGoogleOauthStartResponse
-func (c *ClientWithResponses) UserUpdateGoogleOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthStartResponse, error) {
-	rsp, err := c.UserUpdateGoogleOauthStart(ctx, reqEditors...)
+// ParseMonitoringPostRunProbeResponse parses an HTTP response from a MonitoringPostRunProbeWithResponse call
+func ParseMonitoringPostRunProbeResponse(rsp *http.Response) (*MonitoringPostRunProbeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserUpdateGoogleOauthStartResponse(rsp)
-}
 
-// UserListTenantInvitesWithResponse request returning *UserListTenantInvitesResponse
-func (c *ClientWithResponses) UserListTenantInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListTenantInvitesResponse, error) {
-	rsp, err := c.UserListTenantInvites(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &MonitoringPostRunProbeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserListTenantInvitesResponse(rsp)
-}
 
-// TenantInviteAcceptWithBodyWithResponse request with arbitrary body returning *TenantInviteAcceptResponse
-func (c *ClientWithResponses) TenantInviteAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error) {
-	rsp, err := c.TenantInviteAcceptWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseTenantInviteAcceptResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) TenantInviteAcceptWithResponse(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error) {
-	rsp, err := c.TenantInviteAccept(ctx, body, reqEditors...)
+// ParseSlackWebhookDeleteResponse parses an HTTP response from a SlackWebhookDeleteWithResponse call
+func ParseSlackWebhookDeleteResponse(rsp *http.Response) (*SlackWebhookDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteAcceptResponse(rsp)
-}
 
-// TenantInviteRejectWithBodyWithResponse request with arbitrary body returning *TenantInviteRejectResponse
-func (c *ClientWithResponses) TenantInviteRejectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error) {
-	rsp, err := c.TenantInviteRejectWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SlackWebhookDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTenantInviteRejectResponse(rsp)
-}
 
-func (c *ClientWithResponses) TenantInviteRejectWithResponse(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error) {
-	rsp, err := c.TenantInviteReject(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
+
 	}
-	return ParseTenantInviteRejectResponse(rsp)
+
+	return response, nil
 }
 
-// UserUpdateLoginWithBodyWithResponse request with arbitrary body returning *UserUpdateLoginResponse
-func (c *ClientWithResponses) UserUpdateLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error) {
-	rsp, err := c.UserUpdateLoginWithBody(ctx, contentType, body, reqEditors...)
+// ParseSnsDeleteResponse parses an HTTP response from a SnsDeleteWithResponse call
+func ParseSnsDeleteResponse(rsp *http.Response) (*SnsDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserUpdateLoginResponse(rsp)
-}
 
-func (c *ClientWithResponses) UserUpdateLoginWithResponse(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error) {
-	rsp, err := c.UserUpdateLogin(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SnsDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserUpdateLoginResponse(rsp)
-}
 
-// UserUpdateLogoutWithResponse request returning *UserUpdateLogoutResponse
-func (c *ClientWithResponses) UserUpdateLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateLogoutResponse, error) {
-	rsp, err := c.UserUpdateLogout(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
+
 	}
-	return ParseUserUpdateLogoutResponse(rsp)
+
+	return response, nil
 }
 
-// TenantMembershipsListWithResponse request returning *TenantMembershipsListResponse
-func (c *ClientWithResponses) TenantMembershipsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TenantMembershipsListResponse, error) {
-	rsp, err := c.TenantMembershipsList(ctx, reqEditors...)
+// ParseSnsUpdateResponse parses an HTTP response from a SnsUpdateWithResponse call
+func ParseSnsUpdateResponse(rsp *http.Response) (*SnsUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantMembershipsListResponse(rsp)
-}
 
-// UserUpdatePasswordWithBodyWithResponse request with arbitrary body returning *UserUpdatePasswordResponse
-func (c *ClientWithResponses) UserUpdatePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error) {
-	rsp, err := c.UserUpdatePasswordWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SnsUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
+
 	}
-	return ParseUserUpdatePasswordResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UserUpdatePasswordWithResponse(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error) {
-	rsp, err := c.UserUpdatePassword(ctx, body, reqEditors...)
+// ParseStepRunListArchivesResponse parses an HTTP response from a StepRunListArchivesWithResponse call
+func ParseStepRunListArchivesResponse(rsp *http.Response) (*StepRunListArchivesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserUpdatePasswordResponse(rsp)
-}
 
-// UserCreateWithBodyWithResponse request with arbitrary body returning *UserCreateResponse
-func (c *ClientWithResponses) UserCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateResponse, error) {
-	rsp, err := c.UserCreateWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &StepRunListArchivesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) UserCreateWithResponse(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateResponse, error) {
-	rsp, err := c.UserCreate(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepRunArchiveList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseUserCreateResponse(rsp)
+
+	return response, nil
 }
 
-// UserUpdateSlackOauthCallbackWithResponse request returning *UserUpdateSlackOauthCallbackResponse
-func (c *ClientWithResponses) UserUpdateSlackOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthCallbackResponse, error) {
-	rsp, err := c.UserUpdateSlackOauthCallback(ctx, reqEditors...)
+// ParseStepRunListEventsResponse parses an HTTP response from a StepRunListEventsWithResponse call
+func ParseStepRunListEventsResponse(rsp *http.Response) (*StepRunListEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserUpdateSlackOauthCallbackResponse(rsp)
-}
 
-// InfoGetVersionWithResponse request returning *InfoGetVersionResponse
-func (c *ClientWithResponses) InfoGetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*InfoGetVersionResponse, error) {
-	rsp, err := c.InfoGetVersion(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &StepRunListEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseInfoGetVersionResponse(rsp)
-}
 
-// WebhookDeleteWithResponse request returning *WebhookDeleteResponse
-func (c *ClientWithResponses) WebhookDeleteWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeleteResponse, error) {
-	rsp, err := c.WebhookDelete(ctx, webhook, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepRunEventList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseWebhookDeleteResponse(rsp)
+
+	return response, nil
 }
 
-// WebhookRequestsListWithResponse request returning *WebhookRequestsListResponse
-func (c *ClientWithResponses) WebhookRequestsListWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookRequestsListResponse, error) {
-	rsp, err := c.WebhookRequestsList(ctx, webhook, reqEditors...)
+// ParseLogLineListResponse parses an HTTP response from a LogLineListWithResponse call
+func ParseLogLineListResponse(rsp *http.Response) (*LogLineListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWebhookRequestsListResponse(rsp)
-}
 
-// WorkerGetWithResponse request returning *WorkerGetResponse
-func (c *ClientWithResponses) WorkerGetWithResponse(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerGetResponse, error) {
-	rsp, err := c.WorkerGet(ctx, worker, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &LogLineListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkerGetResponse(rsp)
-}
 
-// WorkerUpdateWithBodyWithResponse request with arbitrary body returning *WorkerUpdateResponse
-func (c *ClientWithResponses) WorkerUpdateWithBodyWithResponse(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error) {
-	rsp, err := c.WorkerUpdateWithBody(ctx, worker, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogLineList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseWorkerUpdateResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) WorkerUpdateWithResponse(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error) {
-	rsp, err := c.WorkerUpdate(ctx, worker, body, reqEditors...)
+// ParseTenantCreateResponse parses an HTTP response from a TenantCreateWithResponse call
+func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkerUpdateResponse(rsp)
-}
 
-// WorkflowDeleteWithResponse request returning *WorkflowDeleteResponse
-func (c *ClientWithResponses) WorkflowDeleteWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteResponse, error) {
-	rsp, err := c.WorkflowDelete(ctx, workflow, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &TenantCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowDeleteResponse(rsp)
-}
 
-// WorkflowGetWithResponse request returning *WorkflowGetResponse
-func (c *ClientWithResponses) WorkflowGetWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetResponse, error) {
-	rsp, err := c.WorkflowGet(ctx, workflow, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tenant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseWorkflowGetResponse(rsp)
+
+	return response, nil
 }
 
-// WorkflowUpdateWithBodyWithResponse request with arbitrary body returning *WorkflowUpdateResponse
-func (c *ClientWithResponses) WorkflowUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error) {
-	rsp, err := c.WorkflowUpdateWithBody(ctx, workflow, contentType, body, reqEditors...)
+// ParseTenantUpdateResponse parses an HTTP response from a TenantUpdateWithResponse call
+func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowUpdateResponse(rsp)
-}
 
-func (c *ClientWithResponses) WorkflowUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error) {
-	rsp, err := c.WorkflowUpdate(ctx, workflow, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &TenantUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowUpdateResponse(rsp)
-}
 
-// WorkflowGetMetricsWithResponse request returning *WorkflowGetMetricsResponse
-func (c *ClientWithResponses) WorkflowGetMetricsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowGetMetricsResponse, error) {
-	rsp, err := c.WorkflowGetMetrics(ctx, workflow, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tenant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseWorkflowGetMetricsResponse(rsp)
+
+	return response, nil
 }
 
-// WorkflowRunCreateWithBodyWithResponse request with arbitrary body returning *WorkflowRunCreateResponse
-func (c *ClientWithResponses) WorkflowRunCreateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error) {
-	rsp, err := c.WorkflowRunCreateWithBody(ctx, workflow, params, contentType, body, reqEditors...)
+// ParseAlertEmailGroupListResponse parses an HTTP response from a AlertEmailGroupListWithResponse call
+func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) WorkflowRunCreateWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error) {
-	rsp, err := c.WorkflowRunCreate(ctx, workflow, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AlertEmailGroupListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowRunCreateResponse(rsp)
-}
 
-// WorkflowVersionGetWithResponse request returning *WorkflowVersionGetResponse
-func (c *ClientWithResponses) WorkflowVersionGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionGetResponse, error) {
-	rsp, err := c.WorkflowVersionGet(ctx, workflow, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantAlertEmailGroupList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseWorkflowVersionGetResponse(rsp)
+
+	return response, nil
 }
 
-// V2DagListTasksWithResponse request returning *V2DagListTasksResponse
-func (c *ClientWithResponses) V2DagListTasksWithResponse(ctx context.Context, params *V2DagListTasksParams, reqEditors ...RequestEditorFn) (*V2DagListTasksResponse, error) {
-	rsp, err := c.V2DagListTasks(ctx, params, reqEditors...)
+// ParseAlertEmailGroupCreateResponse parses an HTTP response from a AlertEmailGroupCreateWithResponse call
+func ParseAlertEmailGroupCreateResponse(rsp *http.Response) (*AlertEmailGroupCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseV2DagListTasksResponse(rsp)
-}
 
-// V2TaskGetWithResponse request returning *V2TaskGetResponse
-func (c *ClientWithResponses) V2TaskGetWithResponse(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*V2TaskGetResponse, error) {
-	rsp, err := c.V2TaskGet(ctx, task, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AlertEmailGroupCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseV2TaskGetResponse(rsp)
-}
 
-// V2TaskEventListWithResponse request returning *V2TaskEventListResponse
-func (c *ClientWithResponses) V2TaskEventListWithResponse(ctx context.Context, task openapi_types.UUID, params *V2TaskEventListParams, reqEditors ...RequestEditorFn) (*V2TaskEventListResponse, error) {
-	rsp, err := c.V2TaskEventList(ctx, task, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TenantAlertEmailGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseV2TaskEventListResponse(rsp)
+
+	return response, nil
 }
 
-// V2TaskListStatusMetricsWithResponse request returning *V2TaskListStatusMetricsResponse
-func (c *ClientWithResponses) V2TaskListStatusMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListStatusMetricsParams, reqEditors ...RequestEditorFn) (*V2TaskListStatusMetricsResponse, error) {
-	rsp, err := c.V2TaskListStatusMetrics(ctx, tenant, params, reqEditors...)
+// ParseTenantAlertingSettingsGetResponse parses an HTTP response from a TenantAlertingSettingsGetWithResponse call
+func ParseTenantAlertingSettingsGetResponse(rsp *http.Response) (*TenantAlertingSettingsGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseV2TaskListStatusMetricsResponse(rsp)
-}
 
-// V2TaskGetPointMetricsWithResponse request returning *V2TaskGetPointMetricsResponse
-func (c *ClientWithResponses) V2TaskGetPointMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2TaskGetPointMetricsParams, reqEditors ...RequestEditorFn) (*V2TaskGetPointMetricsResponse, error) {
-	rsp, err := c.V2TaskGetPointMetrics(ctx, tenant, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &TenantAlertingSettingsGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseV2TaskGetPointMetricsResponse(rsp)
-}
 
-// V2TaskListWithResponse request returning *V2TaskListResponse
-func (c *ClientWithResponses) V2TaskListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2TaskListParams, reqEditors ...RequestEditorFn) (*V2TaskListResponse, error) {
-	rsp, err := c.V2TaskList(ctx, tenant, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantAlertingSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseV2TaskListResponse(rsp)
+
+	return response, nil
 }
 
-// V2WorkflowRunListWithResponse request returning *V2WorkflowRunListResponse
-func (c *ClientWithResponses) V2WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V2WorkflowRunListParams, reqEditors ...RequestEditorFn) (*V2WorkflowRunListResponse, error) {
-	rsp, err := c.V2WorkflowRunList(ctx, tenant, params, reqEditors...)
+// ParseApiTokenListResponse parses an HTTP response from a ApiTokenListWithResponse call
+func ParseApiTokenListResponse(rsp *http.Response) (*ApiTokenListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseV2WorkflowRunListResponse(rsp)
-}
 
-// V2WorkflowRunGetWithResponse request returning *V2WorkflowRunGetResponse
-func (c *ClientWithResponses) V2WorkflowRunGetWithResponse(ctx context.Context, v2WorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*V2WorkflowRunGetResponse, error) {
-	rsp, err := c.V2WorkflowRunGet(ctx, v2WorkflowRun, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ApiTokenListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseV2WorkflowRunGetResponse(rsp)
-}
 
-// V2WorkflowRunTaskEventsListWithResponse request returning *V2WorkflowRunTaskEventsListResponse
-func (c *ClientWithResponses) V2WorkflowRunTaskEventsListWithResponse(ctx context.Context, v2WorkflowRun openapi_types.UUID, params *V2WorkflowRunTaskEventsListParams, reqEditors ...RequestEditorFn) (*V2WorkflowRunTaskEventsListResponse, error) {
-	rsp, err := c.V2WorkflowRunTaskEventsList(ctx, v2WorkflowRun, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListAPITokensResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseV2WorkflowRunTaskEventsListResponse(rsp)
+
+	return response, nil
 }
 
-// ParseLivenessGetResponse parses an HTTP response from a LivenessGetWithResponse call
-func ParseLivenessGetResponse(rsp *http.Response) (*LivenessGetResponse, error) {
+// ParseApiTokenCreateResponse parses an HTTP response from a ApiTokenCreateWithResponse call
+func ParseApiTokenCreateResponse(rsp *http.Response) (*ApiTokenCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LivenessGetResponse{
+	response := &ApiTokenCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateAPITokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseReadinessGetResponse parses an HTTP response from a ReadinessGetWithResponse call
-func ParseReadinessGetResponse(rsp *http.Response) (*ReadinessGetResponse, error) {
+// ParseAuditLogListResponse parses an HTTP response from a AuditLogListWithResponse call
+func ParseAuditLogListResponse(rsp *http.Response) (*AuditLogListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReadinessGetResponse{
+	response := &AuditLogListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditLogList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
 	return response, nil
 }
-
-// ParseAlertEmailGroupDeleteResponse parses an HTTP response from a AlertEmailGroupDeleteWithResponse call
-func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDeleteResponse, error) {
+
+// ParseEventListResponse parses an HTTP response from a EventListWithResponse call
+func ParseEventListResponse(rsp *http.Response) (*EventListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupDeleteResponse{
+	response := &EventListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -13681,7 +18525,7 @@ func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDel
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13692,22 +18536,22 @@ func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDel
 	return response, nil
 }
 
-// ParseAlertEmailGroupUpdateResponse parses an HTTP response from a AlertEmailGroupUpdateWithResponse call
-func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpdateResponse, error) {
+// ParseEventCreateResponse parses an HTTP response from a EventCreateWithResponse call
+func ParseEventCreateResponse(rsp *http.Response) (*EventCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupUpdateResponse{
+	response := &EventCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantAlertEmailGroup
+		var dest Event
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13721,31 +18565,45 @@ func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpd
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseApiTokenUpdateRevokeResponse parses an HTTP response from a ApiTokenUpdateRevokeWithResponse call
-func ParseApiTokenUpdateRevokeResponse(rsp *http.Response) (*ApiTokenUpdateRevokeResponse, error) {
+// ParseEventCreateBulkResponse parses an HTTP response from a EventCreateBulkWithResponse call
+func ParseEventCreateBulkResponse(rsp *http.Response) (*EventCreateBulkResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiTokenUpdateRevokeResponse{
+	response := &EventCreateBulkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Events
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -13760,27 +18618,36 @@ func ParseApiTokenUpdateRevokeResponse(rsp *http.Response) (*ApiTokenUpdateRevok
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCloudMetadataGetResponse parses an HTTP response from a CloudMetadataGetWithResponse call
-func ParseCloudMetadataGetResponse(rsp *http.Response) (*CloudMetadataGetResponse, error) {
+// ParseEventUpdateCancelResponse parses an HTTP response from a EventUpdateCancelWithResponse call
+func ParseEventUpdateCancelResponse(rsp *http.Response) (*EventUpdateCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CloudMetadataGetResponse{
+	response := &EventUpdateCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest APIErrors
+		var dest struct {
+			WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13793,27 +18660,41 @@ func ParseCloudMetadataGetResponse(rsp *http.Response) (*CloudMetadataGetRespons
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseEventGetResponse parses an HTTP response from a EventGetWithResponse call
-func ParseEventGetResponse(rsp *http.Response) (*EventGetResponse, error) {
+// ParseEventKeyListResponse parses an HTTP response from a EventKeyListWithResponse call
+func ParseEventKeyListResponse(rsp *http.Response) (*EventKeyListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventGetResponse{
+	response := &EventKeyListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Event
+		var dest EventKeyList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13838,22 +18719,22 @@ func ParseEventGetResponse(rsp *http.Response) (*EventGetResponse, error) {
 	return response, nil
 }
 
-// ParseEventDataGetResponse parses an HTTP response from a EventDataGetWithResponse call
-func ParseEventDataGetResponse(rsp *http.Response) (*EventDataGetResponse, error) {
+// ParseEventUpdateReplayResponse parses an HTTP response from a EventUpdateReplayWithResponse call
+func ParseEventUpdateReplayResponse(rsp *http.Response) (*EventUpdateReplayResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventDataGetResponse{
+	response := &EventUpdateReplayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventData
+		var dest EventList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13873,27 +18754,34 @@ func ParseEventDataGetResponse(rsp *http.Response) (*EventDataGetResponse, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseMetadataGetResponse parses an HTTP response from a MetadataGetWithResponse call
-func ParseMetadataGetResponse(rsp *http.Response) (*MetadataGetResponse, error) {
+// ParseTenantInviteListResponse parses an HTTP response from a TenantInviteListWithResponse call
+func ParseTenantInviteListResponse(rsp *http.Response) (*TenantInviteListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MetadataGetResponse{
+	response := &TenantInviteListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest APIMeta
+		var dest TenantInviteList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13906,31 +18794,38 @@ func ParseMetadataGetResponse(rsp *http.Response) (*MetadataGetResponse, error)
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseMetadataListIntegrationsResponse parses an HTTP response from a MetadataListIntegrationsWithResponse call
-func ParseMetadataListIntegrationsResponse(rsp *http.Response) (*MetadataListIntegrationsResponse, error) {
+// ParseTenantInviteCreateResponse parses an HTTP response from a TenantInviteCreateWithResponse call
+func ParseTenantInviteCreateResponse(rsp *http.Response) (*TenantInviteCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MetadataListIntegrationsResponse{
+	response := &TenantInviteCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListAPIMetaIntegration
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TenantInvite
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -13939,131 +18834,145 @@ func ParseMetadataListIntegrationsResponse(rsp *http.Response) (*MetadataListInt
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseMonitoringPostRunProbeResponse parses an HTTP response from a MonitoringPostRunProbeWithResponse call
-func ParseMonitoringPostRunProbeResponse(rsp *http.Response) (*MonitoringPostRunProbeResponse, error) {
+// ParseTenantInviteDeleteResponse parses an HTTP response from a TenantInviteDeleteWithResponse call
+func ParseTenantInviteDeleteResponse(rsp *http.Response) (*TenantInviteDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MonitoringPostRunProbeResponse{
+	response := &TenantInviteDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantInvite
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON400 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSlackWebhookDeleteResponse parses an HTTP response from a SlackWebhookDeleteWithResponse call
-func ParseSlackWebhookDeleteResponse(rsp *http.Response) (*SlackWebhookDeleteResponse, error) {
+// ParseTenantInviteUpdateResponse parses an HTTP response from a TenantInviteUpdateWithResponse call
+func ParseTenantInviteUpdateResponse(rsp *http.Response) (*TenantInviteUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SlackWebhookDeleteResponse{
+	response := &TenantInviteUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest APIErrors
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantInvite
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON400 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSnsDeleteResponse parses an HTTP response from a SnsDeleteWithResponse call
-func ParseSnsDeleteResponse(rsp *http.Response) (*SnsDeleteResponse, error) {
+// ParseTenantMemberListResponse parses an HTTP response from a TenantMemberListWithResponse call
+func ParseTenantMemberListResponse(rsp *http.Response) (*TenantMemberListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsDeleteResponse{
+	response := &TenantMemberListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest APIErrors
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantMemberList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
-		var dest APIErrors
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSnsUpdateResponse parses an HTTP response from a SnsUpdateWithResponse call
-func ParseSnsUpdateResponse(rsp *http.Response) (*SnsUpdateResponse, error) {
+// ParseTenantMemberDeleteResponse parses an HTTP response from a TenantMemberDeleteWithResponse call
+func ParseTenantMemberDeleteResponse(rsp *http.Response) (*TenantMemberDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsUpdateResponse{
+	response := &TenantMemberDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
+		var dest TenantMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON204 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14071,41 +18980,41 @@ func ParseSnsUpdateResponse(rsp *http.Response) (*SnsUpdateResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseStepRunListArchivesResponse parses an HTTP response from a StepRunListArchivesWithResponse call
-func ParseStepRunListArchivesResponse(rsp *http.Response) (*StepRunListArchivesResponse, error) {
+// ParseTenantMemberUpdateResponse parses an HTTP response from a TenantMemberUpdateWithResponse call
+func ParseTenantMemberUpdateResponse(rsp *http.Response) (*TenantMemberUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunListArchivesResponse{
+	response := &TenantMemberUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRunArchiveList
+		var dest TenantMember
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14137,22 +19046,22 @@ func ParseStepRunListArchivesResponse(rsp *http.Response) (*StepRunListArchivesR
 	return response, nil
 }
 
-// ParseStepRunListEventsResponse parses an HTTP response from a StepRunListEventsWithResponse call
-func ParseStepRunListEventsResponse(rsp *http.Response) (*StepRunListEventsResponse, error) {
+// ParseMessageQueueDeadLetterListResponse parses an HTTP response from a MessageQueueDeadLetterListWithResponse call
+func ParseMessageQueueDeadLetterListResponse(rsp *http.Response) (*MessageQueueDeadLetterListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunListEventsResponse{
+	response := &MessageQueueDeadLetterListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRunEventList
+		var dest DeadLetterList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14172,34 +19081,27 @@ func ParseStepRunListEventsResponse(rsp *http.Response) (*StepRunListEventsRespo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseLogLineListResponse parses an HTTP response from a LogLineListWithResponse call
-func ParseLogLineListResponse(rsp *http.Response) (*LogLineListResponse, error) {
+// ParseMessageQueueDeadLetterPurgeResponse parses an HTTP response from a MessageQueueDeadLetterPurgeWithResponse call
+func ParseMessageQueueDeadLetterPurgeResponse(rsp *http.Response) (*MessageQueueDeadLetterPurgeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LogLineListResponse{
+	response := &MessageQueueDeadLetterPurgeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LogLineList
+		var dest PurgeDeadLettersResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14224,22 +19126,22 @@ func ParseLogLineListResponse(rsp *http.Response) (*LogLineListResponse, error)
 	return response, nil
 }
 
-// ParseTenantCreateResponse parses an HTTP response from a TenantCreateWithResponse call
-func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error) {
+// ParseMessageQueueDeadLetterReplayResponse parses an HTTP response from a MessageQueueDeadLetterReplayWithResponse call
+func ParseMessageQueueDeadLetterReplayResponse(rsp *http.Response) (*MessageQueueDeadLetterReplayResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantCreateResponse{
+	response := &MessageQueueDeadLetterReplayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Tenant
+		var dest ReplayDeadLettersResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14253,7 +19155,7 @@ func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14264,22 +19166,22 @@ func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error
 	return response, nil
 }
 
-// ParseTenantUpdateResponse parses an HTTP response from a TenantUpdateWithResponse call
-func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error) {
+// ParseTenantGetQueueMetricsResponse parses an HTTP response from a TenantGetQueueMetricsWithResponse call
+func ParseTenantGetQueueMetricsResponse(rsp *http.Response) (*TenantGetQueueMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantUpdateResponse{
+	response := &TenantGetQueueMetricsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Tenant
+		var dest TenantQueueMetrics
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14290,36 +19192,43 @@ func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAlertEmailGroupListResponse parses an HTTP response from a AlertEmailGroupListWithResponse call
-func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListResponse, error) {
+// ParseRateLimitListResponse parses an HTTP response from a RateLimitListWithResponse call
+func ParseRateLimitListResponse(rsp *http.Response) (*RateLimitListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupListResponse{
+	response := &RateLimitListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantAlertEmailGroupList
+		var dest RateLimitList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14333,7 +19242,7 @@ func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListR
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14344,26 +19253,26 @@ func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListR
 	return response, nil
 }
 
-// ParseAlertEmailGroupCreateResponse parses an HTTP response from a AlertEmailGroupCreateWithResponse call
-func ParseAlertEmailGroupCreateResponse(rsp *http.Response) (*AlertEmailGroupCreateResponse, error) {
+// ParseTenantResourcePolicyGetResponse parses an HTTP response from a TenantResourcePolicyGetWithResponse call
+func ParseTenantResourcePolicyGetResponse(rsp *http.Response) (*TenantResourcePolicyGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupCreateResponse{
+	response := &TenantResourcePolicyGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TenantAlertEmailGroup
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantResourcePolicy
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -14384,22 +19293,22 @@ func ParseAlertEmailGroupCreateResponse(rsp *http.Response) (*AlertEmailGroupCre
 	return response, nil
 }
 
-// ParseTenantAlertingSettingsGetResponse parses an HTTP response from a TenantAlertingSettingsGetWithResponse call
-func ParseTenantAlertingSettingsGetResponse(rsp *http.Response) (*TenantAlertingSettingsGetResponse, error) {
+// ParseTenantRoleListResponse parses an HTTP response from a TenantRoleListWithResponse call
+func ParseTenantRoleListResponse(rsp *http.Response) (*TenantRoleListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantAlertingSettingsGetResponse{
+	response := &TenantRoleListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantAlertingSettings
+		var dest TenantRoleList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14413,7 +19322,7 @@ func ParseTenantAlertingSettingsGetResponse(rsp *http.Response) (*TenantAlerting
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14424,26 +19333,26 @@ func ParseTenantAlertingSettingsGetResponse(rsp *http.Response) (*TenantAlerting
 	return response, nil
 }
 
-// ParseApiTokenListResponse parses an HTTP response from a ApiTokenListWithResponse call
-func ParseApiTokenListResponse(rsp *http.Response) (*ApiTokenListResponse, error) {
+// ParseTenantRoleCreateResponse parses an HTTP response from a TenantRoleCreateWithResponse call
+func ParseTenantRoleCreateResponse(rsp *http.Response) (*TenantRoleCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiTokenListResponse{
+	response := &TenantRoleCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListAPITokensResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TenantRole
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -14464,22 +19373,22 @@ func ParseApiTokenListResponse(rsp *http.Response) (*ApiTokenListResponse, error
 	return response, nil
 }
 
-// ParseApiTokenCreateResponse parses an HTTP response from a ApiTokenCreateWithResponse call
-func ParseApiTokenCreateResponse(rsp *http.Response) (*ApiTokenCreateResponse, error) {
+// ParseTenantRoleDeleteResponse parses an HTTP response from a TenantRoleDeleteWithResponse call
+func ParseTenantRoleDeleteResponse(rsp *http.Response) (*TenantRoleDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiTokenCreateResponse{
+	response := &TenantRoleDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CreateAPITokenResponse
+		var dest TenantRole
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14499,27 +19408,34 @@ func ParseApiTokenCreateResponse(rsp *http.Response) (*ApiTokenCreateResponse, e
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseEventListResponse parses an HTTP response from a EventListWithResponse call
-func ParseEventListResponse(rsp *http.Response) (*EventListResponse, error) {
+// ParseTenantRoleUpdateResponse parses an HTTP response from a TenantRoleUpdateWithResponse call
+func ParseTenantRoleUpdateResponse(rsp *http.Response) (*TenantRoleUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventListResponse{
+	response := &TenantRoleUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventList
+		var dest TenantRole
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14539,27 +19455,34 @@ func ParseEventListResponse(rsp *http.Response) (*EventListResponse, error) {
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseEventCreateResponse parses an HTTP response from a EventCreateWithResponse call
-func ParseEventCreateResponse(rsp *http.Response) (*EventCreateResponse, error) {
+// ParseSlackWebhookListResponse parses an HTTP response from a SlackWebhookListWithResponse call
+func ParseSlackWebhookListResponse(rsp *http.Response) (*SlackWebhookListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventCreateResponse{
+	response := &SlackWebhookListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Event
+		var dest ListSlackWebhooks
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14572,41 +19495,57 @@ func ParseEventCreateResponse(rsp *http.Response) (*EventCreateResponse, error)
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseEventCreateBulkResponse parses an HTTP response from a EventCreateBulkWithResponse call
-func ParseEventCreateBulkResponse(rsp *http.Response) (*EventCreateBulkResponse, error) {
+// ParseUserUpdateSlackOauthStartResponse parses an HTTP response from a UserUpdateSlackOauthStartWithResponse call
+func ParseUserUpdateSlackOauthStartResponse(rsp *http.Response) (*UserUpdateSlackOauthStartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventCreateBulkResponse{
+	response := &UserUpdateSlackOauthStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSnsListResponse parses an HTTP response from a SnsListWithResponse call
+func ParseSnsListResponse(rsp *http.Response) (*SnsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SnsListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Events
+		var dest ListSNSIntegrations
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14619,47 +19558,45 @@ func ParseEventCreateBulkResponse(rsp *http.Response) (*EventCreateBulkResponse,
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseEventUpdateCancelResponse parses an HTTP response from a EventUpdateCancelWithResponse call
-func ParseEventUpdateCancelResponse(rsp *http.Response) (*EventUpdateCancelResponse, error) {
+// ParseSnsCreateResponse parses an HTTP response from a SnsCreateWithResponse call
+func ParseSnsCreateResponse(rsp *http.Response) (*SnsCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventUpdateCancelResponse{
+	response := &SnsCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SNSIntegration
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -14668,41 +19605,41 @@ func ParseEventUpdateCancelResponse(rsp *http.Response) (*EventUpdateCancelRespo
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseEventKeyListResponse parses an HTTP response from a EventKeyListWithResponse call
-func ParseEventKeyListResponse(rsp *http.Response) (*EventKeyListResponse, error) {
+// ParseTenantGetStepRunQueueMetricsResponse parses an HTTP response from a TenantGetStepRunQueueMetricsWithResponse call
+func ParseTenantGetStepRunQueueMetricsResponse(rsp *http.Response) (*TenantGetStepRunQueueMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventKeyListResponse{
+	response := &TenantGetStepRunQueueMetricsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventKeyList
+		var dest TenantStepRunQueueMetrics
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14722,27 +19659,34 @@ func ParseEventKeyListResponse(rsp *http.Response) (*EventKeyListResponse, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseEventUpdateReplayResponse parses an HTTP response from a EventUpdateReplayWithResponse call
-func ParseEventUpdateReplayResponse(rsp *http.Response) (*EventUpdateReplayResponse, error) {
+// ParseStepRunGetResponse parses an HTTP response from a StepRunGetWithResponse call
+func ParseStepRunGetResponse(rsp *http.Response) (*StepRunGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventUpdateReplayResponse{
+	response := &StepRunGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventList
+		var dest StepRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14762,34 +19706,34 @@ func ParseEventUpdateReplayResponse(rsp *http.Response) (*EventUpdateReplayRespo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseTenantInviteListResponse parses an HTTP response from a TenantInviteListWithResponse call
-func ParseTenantInviteListResponse(rsp *http.Response) (*TenantInviteListResponse, error) {
+// ParseStepRunUpdateCancelResponse parses an HTTP response from a StepRunUpdateCancelWithResponse call
+func ParseStepRunUpdateCancelResponse(rsp *http.Response) (*StepRunUpdateCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantInviteListResponse{
+	response := &StepRunUpdateCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantInviteList
+		var dest StepRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14803,7 +19747,7 @@ func ParseTenantInviteListResponse(rsp *http.Response) (*TenantInviteListRespons
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14814,26 +19758,26 @@ func ParseTenantInviteListResponse(rsp *http.Response) (*TenantInviteListRespons
 	return response, nil
 }
 
-// ParseTenantInviteCreateResponse parses an HTTP response from a TenantInviteCreateWithResponse call
-func ParseTenantInviteCreateResponse(rsp *http.Response) (*TenantInviteCreateResponse, error) {
+// ParseStepRunUpdateRerunResponse parses an HTTP response from a StepRunUpdateRerunWithResponse call
+func ParseStepRunUpdateRerunResponse(rsp *http.Response) (*StepRunUpdateRerunResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantInviteCreateResponse{
+	response := &StepRunUpdateRerunResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TenantInvite
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -14843,7 +19787,7 @@ func ParseTenantInviteCreateResponse(rsp *http.Response) (*TenantInviteCreateRes
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14854,22 +19798,22 @@ func ParseTenantInviteCreateResponse(rsp *http.Response) (*TenantInviteCreateRes
 	return response, nil
 }
 
-// ParseTenantInviteDeleteResponse parses an HTTP response from a TenantInviteDeleteWithResponse call
-func ParseTenantInviteDeleteResponse(rsp *http.Response) (*TenantInviteDeleteResponse, error) {
+// ParseStepRunGetSchemaResponse parses an HTTP response from a StepRunGetSchemaWithResponse call
+func ParseStepRunGetSchemaResponse(rsp *http.Response) (*StepRunGetSchemaResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantInviteDeleteResponse{
+	response := &StepRunGetSchemaResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantInvite
+		var dest map[string]interface{}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14882,27 +19826,41 @@ func ParseTenantInviteDeleteResponse(rsp *http.Response) (*TenantInviteDeleteRes
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseTenantInviteUpdateResponse parses an HTTP response from a TenantInviteUpdateWithResponse call
-func ParseTenantInviteUpdateResponse(rsp *http.Response) (*TenantInviteUpdateResponse, error) {
+// ParseWebhookSubscriptionListResponse parses an HTTP response from a WebhookSubscriptionListWithResponse call
+func ParseWebhookSubscriptionListResponse(rsp *http.Response) (*WebhookSubscriptionListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantInviteUpdateResponse{
+	response := &WebhookSubscriptionListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantInvite
+		var dest WebhookSubscriptionList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14915,31 +19873,38 @@ func ParseTenantInviteUpdateResponse(rsp *http.Response) (*TenantInviteUpdateRes
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseTenantMemberListResponse parses an HTTP response from a TenantMemberListWithResponse call
-func ParseTenantMemberListResponse(rsp *http.Response) (*TenantMemberListResponse, error) {
+// ParseWebhookSubscriptionCreateResponse parses an HTTP response from a WebhookSubscriptionCreateWithResponse call
+func ParseWebhookSubscriptionCreateResponse(rsp *http.Response) (*WebhookSubscriptionCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantMemberListResponse{
+	response := &WebhookSubscriptionCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantMemberList
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookSubscription
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -14949,7 +19914,7 @@ func ParseTenantMemberListResponse(rsp *http.Response) (*TenantMemberListRespons
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14960,26 +19925,26 @@ func ParseTenantMemberListResponse(rsp *http.Response) (*TenantMemberListRespons
 	return response, nil
 }
 
-// ParseTenantMemberDeleteResponse parses an HTTP response from a TenantMemberDeleteWithResponse call
-func ParseTenantMemberDeleteResponse(rsp *http.Response) (*TenantMemberDeleteResponse, error) {
+// ParseWebhookSubscriptionDeleteResponse parses an HTTP response from a WebhookSubscriptionDeleteWithResponse call
+func ParseWebhookSubscriptionDeleteResponse(rsp *http.Response) (*WebhookSubscriptionDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantMemberDeleteResponse{
+	response := &WebhookSubscriptionDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
-		var dest TenantMember
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookSubscription
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON204 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -15007,22 +19972,22 @@ func ParseTenantMemberDeleteResponse(rsp *http.Response) (*TenantMemberDeleteRes
 	return response, nil
 }
 
-// ParseTenantGetQueueMetricsResponse parses an HTTP response from a TenantGetQueueMetricsWithResponse call
-func ParseTenantGetQueueMetricsResponse(rsp *http.Response) (*TenantGetQueueMetricsResponse, error) {
+// ParseWebhookSubscriptionUpdateResponse parses an HTTP response from a WebhookSubscriptionUpdateWithResponse call
+func ParseWebhookSubscriptionUpdateResponse(rsp *http.Response) (*WebhookSubscriptionUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantGetQueueMetricsResponse{
+	response := &WebhookSubscriptionUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantQueueMetrics
+		var dest WebhookSubscription
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15054,22 +20019,22 @@ func ParseTenantGetQueueMetricsResponse(rsp *http.Response) (*TenantGetQueueMetr
 	return response, nil
 }
 
-// ParseRateLimitListResponse parses an HTTP response from a RateLimitListWithResponse call
-func ParseRateLimitListResponse(rsp *http.Response) (*RateLimitListResponse, error) {
+// ParseWebhookDeliveryListResponse parses an HTTP response from a WebhookDeliveryListWithResponse call
+func ParseWebhookDeliveryListResponse(rsp *http.Response) (*WebhookDeliveryListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RateLimitListResponse{
+	response := &WebhookDeliveryListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RateLimitList
+		var dest WebhookDeliveryList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15089,27 +20054,34 @@ func ParseRateLimitListResponse(rsp *http.Response) (*RateLimitListResponse, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseTenantResourcePolicyGetResponse parses an HTTP response from a TenantResourcePolicyGetWithResponse call
-func ParseTenantResourcePolicyGetResponse(rsp *http.Response) (*TenantResourcePolicyGetResponse, error) {
+// ParseWebhookListResponse parses an HTTP response from a WebhookListWithResponse call
+func ParseWebhookListResponse(rsp *http.Response) (*WebhookListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantResourcePolicyGetResponse{
+	response := &WebhookListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantResourcePolicy
+		var dest WebhookWorkerListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15122,34 +20094,41 @@ func ParseTenantResourcePolicyGetResponse(rsp *http.Response) (*TenantResourcePo
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSlackWebhookListResponse parses an HTTP response from a SlackWebhookListWithResponse call
-func ParseSlackWebhookListResponse(rsp *http.Response) (*SlackWebhookListResponse, error) {
+// ParseWebhookCreateResponse parses an HTTP response from a WebhookCreateWithResponse call
+func ParseWebhookCreateResponse(rsp *http.Response) (*WebhookCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SlackWebhookListResponse{
+	response := &WebhookCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListSlackWebhooks
+		var dest WebhookWorkerCreated
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15181,38 +20160,62 @@ func ParseSlackWebhookListResponse(rsp *http.Response) (*SlackWebhookListRespons
 	return response, nil
 }
 
-// ParseUserUpdateSlackOauthStartResponse parses an HTTP response from a UserUpdateSlackOauthStartWithResponse call
-func ParseUserUpdateSlackOauthStartResponse(rsp *http.Response) (*UserUpdateSlackOauthStartResponse, error) {
+// ParseWorkerListResponse parses an HTTP response from a WorkerListWithResponse call
+func ParseWorkerListResponse(rsp *http.Response) (*WorkerListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserUpdateSlackOauthStartResponse{
+	response := &WorkerListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkerList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseSnsListResponse parses an HTTP response from a SnsListWithResponse call
-func ParseSnsListResponse(rsp *http.Response) (*SnsListResponse, error) {
+// ParseWorkflowRunUpdateReplayResponse parses an HTTP response from a WorkflowRunUpdateReplayWithResponse call
+func ParseWorkflowRunUpdateReplayResponse(rsp *http.Response) (*WorkflowRunUpdateReplayResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsListResponse{
+	response := &WorkflowRunUpdateReplayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListSNSIntegrations
+		var dest ReplayWorkflowRunsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15225,45 +20228,45 @@ func ParseSnsListResponse(rsp *http.Response) (*SnsListResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON429 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSnsCreateResponse parses an HTTP response from a SnsCreateWithResponse call
-func ParseSnsCreateResponse(rsp *http.Response) (*SnsCreateResponse, error) {
+// ParseWorkflowRunGetResponse parses an HTTP response from a WorkflowRunGetWithResponse call
+func ParseWorkflowRunGetResponse(rsp *http.Response) (*WorkflowRunGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsCreateResponse{
+	response := &WorkflowRunGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest SNSIntegration
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -15272,41 +20275,34 @@ func ParseSnsCreateResponse(rsp *http.Response) (*SnsCreateResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseTenantGetStepRunQueueMetricsResponse parses an HTTP response from a TenantGetStepRunQueueMetricsWithResponse call
-func ParseTenantGetStepRunQueueMetricsResponse(rsp *http.Response) (*TenantGetStepRunQueueMetricsResponse, error) {
+// ParseWorkflowRunGetInputResponse parses an HTTP response from a WorkflowRunGetInputWithResponse call
+func ParseWorkflowRunGetInputResponse(rsp *http.Response) (*WorkflowRunGetInputResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantGetStepRunQueueMetricsResponse{
+	response := &WorkflowRunGetInputResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantStepRunQueueMetrics
+		var dest map[string]interface{}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15338,22 +20334,22 @@ func ParseTenantGetStepRunQueueMetricsResponse(rsp *http.Response) (*TenantGetSt
 	return response, nil
 }
 
-// ParseStepRunGetResponse parses an HTTP response from a StepRunGetWithResponse call
-func ParseStepRunGetResponse(rsp *http.Response) (*StepRunGetResponse, error) {
+// ParseWorkflowRunGetShapeResponse parses an HTTP response from a WorkflowRunGetShapeWithResponse call
+func ParseWorkflowRunGetShapeResponse(rsp *http.Response) (*WorkflowRunGetShapeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunGetResponse{
+	response := &WorkflowRunGetShapeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRun
+		var dest WorkflowRunShape
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15373,34 +20369,27 @@ func ParseStepRunGetResponse(rsp *http.Response) (*StepRunGetResponse, error) {
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseStepRunUpdateCancelResponse parses an HTTP response from a StepRunUpdateCancelWithResponse call
-func ParseStepRunUpdateCancelResponse(rsp *http.Response) (*StepRunUpdateCancelResponse, error) {
+// ParseWorkflowRunListStepRunEventsResponse parses an HTTP response from a WorkflowRunListStepRunEventsWithResponse call
+func ParseWorkflowRunListStepRunEventsResponse(rsp *http.Response) (*WorkflowRunListStepRunEventsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunUpdateCancelResponse{
+	response := &WorkflowRunListStepRunEventsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRun
+		var dest StepRunEventList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15420,27 +20409,34 @@ func ParseStepRunUpdateCancelResponse(rsp *http.Response) (*StepRunUpdateCancelR
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseStepRunUpdateRerunResponse parses an HTTP response from a StepRunUpdateRerunWithResponse call
-func ParseStepRunUpdateRerunResponse(rsp *http.Response) (*StepRunUpdateRerunResponse, error) {
+// ParseWorkflowListResponse parses an HTTP response from a WorkflowListWithResponse call
+func ParseWorkflowListResponse(rsp *http.Response) (*WorkflowListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunUpdateRerunResponse{
+	response := &WorkflowListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRun
+		var dest WorkflowList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15465,22 +20461,24 @@ func ParseStepRunUpdateRerunResponse(rsp *http.Response) (*StepRunUpdateRerunRes
 	return response, nil
 }
 
-// ParseStepRunGetSchemaResponse parses an HTTP response from a StepRunGetSchemaWithResponse call
-func ParseStepRunGetSchemaResponse(rsp *http.Response) (*StepRunGetSchemaResponse, error) {
+// ParseWorkflowRunCancelResponse parses an HTTP response from a WorkflowRunCancelWithResponse call
+func ParseWorkflowRunCancelResponse(rsp *http.Response) (*WorkflowRunCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunGetSchemaResponse{
+	response := &WorkflowRunCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest map[string]interface{}
+		var dest struct {
+			WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15500,34 +20498,27 @@ func ParseStepRunGetSchemaResponse(rsp *http.Response) (*StepRunGetSchemaRespons
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseWebhookListResponse parses an HTTP response from a WebhookListWithResponse call
-func ParseWebhookListResponse(rsp *http.Response) (*WebhookListResponse, error) {
+// ParseCronWorkflowListResponse parses an HTTP response from a CronWorkflowListWithResponse call
+func ParseCronWorkflowListResponse(rsp *http.Response) (*CronWorkflowListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WebhookListResponse{
+	response := &CronWorkflowListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WebhookWorkerListResponse
+		var dest CronWorkflowsList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15540,46 +20531,32 @@ func ParseWebhookListResponse(rsp *http.Response) (*WebhookListResponse, error)
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseWebhookCreateResponse parses an HTTP response from a WebhookCreateWithResponse call
-func ParseWebhookCreateResponse(rsp *http.Response) (*WebhookCreateResponse, error) {
+// ParseWorkflowCronDeleteResponse parses an HTTP response from a WorkflowCronDeleteWithResponse call
+func ParseWorkflowCronDeleteResponse(rsp *http.Response) (*WorkflowCronDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WebhookCreateResponse{
+	response := &WorkflowCronDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WebhookWorkerCreated
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -15587,41 +20564,34 @@ func ParseWebhookCreateResponse(rsp *http.Response) (*WebhookCreateResponse, err
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
-		var dest APIErrors
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseWorkerListResponse parses an HTTP response from a WorkerListWithResponse call
-func ParseWorkerListResponse(rsp *http.Response) (*WorkerListResponse, error) {
+// ParseWorkflowCronGetResponse parses an HTTP response from a WorkflowCronGetWithResponse call
+func ParseWorkflowCronGetResponse(rsp *http.Response) (*WorkflowCronGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkerListResponse{
+	response := &WorkflowCronGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkerList
+		var dest CronWorkflows
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15641,27 +20611,34 @@ func ParseWorkerListResponse(rsp *http.Response) (*WorkerListResponse, error) {
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowRunUpdateReplayResponse parses an HTTP response from a WorkflowRunUpdateReplayWithResponse call
-func ParseWorkflowRunUpdateReplayResponse(rsp *http.Response) (*WorkflowRunUpdateReplayResponse, error) {
+// ParseWorkflowCronUpdateResponse parses an HTTP response from a WorkflowCronUpdateWithResponse call
+func ParseWorkflowCronUpdateResponse(rsp *http.Response) (*WorkflowCronUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunUpdateReplayResponse{
+	response := &WorkflowCronUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ReplayWorkflowRunsResponse
+		var dest CronWorkflows
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15675,40 +20652,33 @@ func ParseWorkflowRunUpdateReplayResponse(rsp *http.Response) (*WorkflowRunUpdat
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowRunGetResponse parses an HTTP response from a WorkflowRunGetWithResponse call
-func ParseWorkflowRunGetResponse(rsp *http.Response) (*WorkflowRunGetResponse, error) {
+// ParseWorkflowRunListResponse parses an HTTP response from a WorkflowRunListWithResponse call
+func ParseWorkflowRunListResponse(rsp *http.Response) (*WorkflowRunListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunGetResponse{
+	response := &WorkflowRunListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowRun
+		var dest WorkflowRunList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15733,22 +20703,22 @@ func ParseWorkflowRunGetResponse(rsp *http.Response) (*WorkflowRunGetResponse, e
 	return response, nil
 }
 
-// ParseWorkflowRunGetInputResponse parses an HTTP response from a WorkflowRunGetInputWithResponse call
-func ParseWorkflowRunGetInputResponse(rsp *http.Response) (*WorkflowRunGetInputResponse, error) {
+// ParseWorkflowRunGetMetricsResponse parses an HTTP response from a WorkflowRunGetMetricsWithResponse call
+func ParseWorkflowRunGetMetricsResponse(rsp *http.Response) (*WorkflowRunGetMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunGetInputResponse{
+	response := &WorkflowRunGetMetricsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest map[string]interface{}
+		var dest WorkflowRunsMetrics
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15761,41 +20731,34 @@ func ParseWorkflowRunGetInputResponse(rsp *http.Response) (*WorkflowRunGetInputR
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowRunGetShapeResponse parses an HTTP response from a WorkflowRunGetShapeWithResponse call
-func ParseWorkflowRunGetShapeResponse(rsp *http.Response) (*WorkflowRunGetShapeResponse, error) {
+// ParseWorkflowScheduledListResponse parses an HTTP response from a WorkflowScheduledListWithResponse call
+func ParseWorkflowScheduledListResponse(rsp *http.Response) (*WorkflowScheduledListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunGetShapeResponse{
+	response := &WorkflowScheduledListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowRunShape
+		var dest ScheduledWorkflowsList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15820,27 +20783,20 @@ func ParseWorkflowRunGetShapeResponse(rsp *http.Response) (*WorkflowRunGetShapeR
 	return response, nil
 }
 
-// ParseWorkflowRunListStepRunEventsResponse parses an HTTP response from a WorkflowRunListStepRunEventsWithResponse call
-func ParseWorkflowRunListStepRunEventsResponse(rsp *http.Response) (*WorkflowRunListStepRunEventsResponse, error) {
+// ParseWorkflowScheduledDeleteResponse parses an HTTP response from a WorkflowScheduledDeleteWithResponse call
+func ParseWorkflowScheduledDeleteResponse(rsp *http.Response) (*WorkflowScheduledDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunListStepRunEventsResponse{
+	response := &WorkflowScheduledDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRunEventList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -15849,40 +20805,33 @@ func ParseWorkflowRunListStepRunEventsResponse(rsp *http.Response) (*WorkflowRun
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowListResponse parses an HTTP response from a WorkflowListWithResponse call
-func ParseWorkflowListResponse(rsp *http.Response) (*WorkflowListResponse, error) {
+// ParseWorkflowScheduledGetResponse parses an HTTP response from a WorkflowScheduledGetWithResponse call
+func ParseWorkflowScheduledGetResponse(rsp *http.Response) (*WorkflowScheduledGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowListResponse{
+	response := &WorkflowScheduledGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowList
+		var dest ScheduledWorkflows
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15902,29 +20851,34 @@ func ParseWorkflowListResponse(rsp *http.Response) (*WorkflowListResponse, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowRunCancelResponse parses an HTTP response from a WorkflowRunCancelWithResponse call
-func ParseWorkflowRunCancelResponse(rsp *http.Response) (*WorkflowRunCancelResponse, error) {
+// ParseCronWorkflowTriggerCreateResponse parses an HTTP response from a CronWorkflowTriggerCreateWithResponse call
+func ParseCronWorkflowTriggerCreateResponse(rsp *http.Response) (*CronWorkflowTriggerCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunCancelResponse{
+	response := &CronWorkflowTriggerCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
-		}
+		var dest CronWorkflows
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15944,27 +20898,41 @@ func ParseWorkflowRunCancelResponse(rsp *http.Response) (*WorkflowRunCancelRespo
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCronWorkflowListResponse parses an HTTP response from a CronWorkflowListWithResponse call
-func ParseCronWorkflowListResponse(rsp *http.Response) (*CronWorkflowListResponse, error) {
+// ParseScheduledWorkflowRunCreateResponse parses an HTTP response from a ScheduledWorkflowRunCreateWithResponse call
+func ParseScheduledWorkflowRunCreateResponse(rsp *http.Response) (*ScheduledWorkflowRunCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CronWorkflowListResponse{
+	response := &ScheduledWorkflowRunCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CronWorkflowsList
+		var dest ScheduledWorkflows
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15984,25 +20952,46 @@ func ParseCronWorkflowListResponse(rsp *http.Response) (*CronWorkflowListRespons
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowCronDeleteResponse parses an HTTP response from a WorkflowCronDeleteWithResponse call
-func ParseWorkflowCronDeleteResponse(rsp *http.Response) (*WorkflowCronDeleteResponse, error) {
+// ParseWorkflowGetWorkersCountResponse parses an HTTP response from a WorkflowGetWorkersCountWithResponse call
+func ParseWorkflowGetWorkersCountResponse(rsp *http.Response) (*WorkflowGetWorkersCountResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowCronDeleteResponse{
+	response := &WorkflowGetWorkersCountResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowWorkersCount
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -16011,7 +21000,7 @@ func ParseWorkflowCronDeleteResponse(rsp *http.Response) (*WorkflowCronDeleteRes
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16022,22 +21011,22 @@ func ParseWorkflowCronDeleteResponse(rsp *http.Response) (*WorkflowCronDeleteRes
 	return response, nil
 }
 
-// ParseWorkflowCronGetResponse parses an HTTP response from a WorkflowCronGetWithResponse call
-func ParseWorkflowCronGetResponse(rsp *http.Response) (*WorkflowCronGetResponse, error) {
+// ParseUserGetCurrentResponse parses an HTTP response from a UserGetCurrentWithResponse call
+func ParseUserGetCurrentResponse(rsp *http.Response) (*UserGetCurrentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowCronGetResponse{
+	response := &UserGetCurrentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CronWorkflows
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16050,81 +21039,105 @@ func ParseWorkflowCronGetResponse(rsp *http.Response) (*WorkflowCronGetResponse,
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowRunListResponse parses an HTTP response from a WorkflowRunListWithResponse call
-func ParseWorkflowRunListResponse(rsp *http.Response) (*WorkflowRunListResponse, error) {
+// ParseUserUpdateGithubOauthCallbackResponse parses an HTTP response from a UserUpdateGithubOauthCallbackWithResponse call
+func ParseUserUpdateGithubOauthCallbackResponse(rsp *http.Response) (*UserUpdateGithubOauthCallbackResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunListResponse{
+	response := &UserUpdateGithubOauthCallbackResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowRunList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return response, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// ParseUserUpdateGithubOauthStartResponse parses an HTTP response from a UserUpdateGithubOauthStartWithResponse call
+func ParseUserUpdateGithubOauthStartResponse(rsp *http.Response) (*UserUpdateGithubOauthStartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	response := &UserUpdateGithubOauthStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUserUpdateGoogleOauthCallbackResponse parses an HTTP response from a UserUpdateGoogleOauthCallbackWithResponse call
+func ParseUserUpdateGoogleOauthCallbackResponse(rsp *http.Response) (*UserUpdateGoogleOauthCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
+	response := &UserUpdateGoogleOauthCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowRunGetMetricsResponse parses an HTTP response from a WorkflowRunGetMetricsWithResponse call
-func ParseWorkflowRunGetMetricsResponse(rsp *http.Response) (*WorkflowRunGetMetricsResponse, error) {
+// ParseUserUpdateGoogleOauthStartResponse parses an HTTP response from a UserUpdateGoogleOauthStartWithResponse call
+func ParseUserUpdateGoogleOauthStartResponse(rsp *http.Response) (*UserUpdateGoogleOauthStartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunGetMetricsResponse{
+	response := &UserUpdateGoogleOauthStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUserListTenantInvitesResponse parses an HTTP response from a UserListTenantInvitesWithResponse call
+func ParseUserListTenantInvitesResponse(rsp *http.Response) (*UserListTenantInvitesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserListTenantInvitesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowRunsMetrics
+		var dest TenantInviteList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16149,27 +21162,20 @@ func ParseWorkflowRunGetMetricsResponse(rsp *http.Response) (*WorkflowRunGetMetr
 	return response, nil
 }
 
-// ParseWorkflowScheduledListResponse parses an HTTP response from a WorkflowScheduledListWithResponse call
-func ParseWorkflowScheduledListResponse(rsp *http.Response) (*WorkflowScheduledListResponse, error) {
+// ParseTenantInviteAcceptResponse parses an HTTP response from a TenantInviteAcceptWithResponse call
+func ParseTenantInviteAcceptResponse(rsp *http.Response) (*TenantInviteAcceptResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowScheduledListResponse{
+	response := &TenantInviteAcceptResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScheduledWorkflowsList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -16178,7 +21184,7 @@ func ParseWorkflowScheduledListResponse(rsp *http.Response) (*WorkflowScheduledL
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16189,15 +21195,15 @@ func ParseWorkflowScheduledListResponse(rsp *http.Response) (*WorkflowScheduledL
 	return response, nil
 }
 
-// ParseWorkflowScheduledDeleteResponse parses an HTTP response from a WorkflowScheduledDeleteWithResponse call
-func ParseWorkflowScheduledDeleteResponse(rsp *http.Response) (*WorkflowScheduledDeleteResponse, error) {
+// ParseTenantInviteRejectResponse parses an HTTP response from a TenantInviteRejectWithResponse call
+func ParseTenantInviteRejectResponse(rsp *http.Response) (*TenantInviteRejectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowScheduledDeleteResponse{
+	response := &TenantInviteRejectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -16222,22 +21228,22 @@ func ParseWorkflowScheduledDeleteResponse(rsp *http.Response) (*WorkflowSchedule
 	return response, nil
 }
 
-// ParseWorkflowScheduledGetResponse parses an HTTP response from a WorkflowScheduledGetWithResponse call
-func ParseWorkflowScheduledGetResponse(rsp *http.Response) (*WorkflowScheduledGetResponse, error) {
+// ParseUserUpdateLoginResponse parses an HTTP response from a UserUpdateLoginWithResponse call
+func ParseUserUpdateLoginResponse(rsp *http.Response) (*UserUpdateLoginResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowScheduledGetResponse{
+	response := &UserUpdateLoginResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScheduledWorkflows
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16250,41 +21256,41 @@ func ParseWorkflowScheduledGetResponse(rsp *http.Response) (*WorkflowScheduledGe
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCronWorkflowTriggerCreateResponse parses an HTTP response from a CronWorkflowTriggerCreateWithResponse call
-func ParseCronWorkflowTriggerCreateResponse(rsp *http.Response) (*CronWorkflowTriggerCreateResponse, error) {
+// ParseUserUpdateLogoutResponse parses an HTTP response from a UserUpdateLogoutWithResponse call
+func ParseUserUpdateLogoutResponse(rsp *http.Response) (*UserUpdateLogoutResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CronWorkflowTriggerCreateResponse{
+	response := &UserUpdateLogoutResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CronWorkflows
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16297,48 +21303,41 @@ func ParseCronWorkflowTriggerCreateResponse(rsp *http.Response) (*CronWorkflowTr
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseScheduledWorkflowRunCreateResponse parses an HTTP response from a ScheduledWorkflowRunCreateWithResponse call
-func ParseScheduledWorkflowRunCreateResponse(rsp *http.Response) (*ScheduledWorkflowRunCreateResponse, error) {
+// ParseTenantMembershipsListResponse parses an HTTP response from a TenantMembershipsListWithResponse call
+func ParseTenantMembershipsListResponse(rsp *http.Response) (*TenantMembershipsListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ScheduledWorkflowRunCreateResponse{
+	response := &TenantMembershipsListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScheduledWorkflows
+		var dest UserTenantMembershipsList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16358,41 +21357,59 @@ func ParseScheduledWorkflowRunCreateResponse(rsp *http.Response) (*ScheduledWork
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
+	return response, nil
+}
+
+// ParseUserUpdateOidcOauthCallbackResponse parses an HTTP response from a UserUpdateOidcOauthCallbackWithResponse call
+func ParseUserUpdateOidcOauthCallbackResponse(rsp *http.Response) (*UserUpdateOidcOauthCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
+	response := &UserUpdateOidcOauthCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowGetWorkersCountResponse parses an HTTP response from a WorkflowGetWorkersCountWithResponse call
-func ParseWorkflowGetWorkersCountResponse(rsp *http.Response) (*WorkflowGetWorkersCountResponse, error) {
+// ParseUserUpdateOidcOauthStartResponse parses an HTTP response from a UserUpdateOidcOauthStartWithResponse call
+func ParseUserUpdateOidcOauthStartResponse(rsp *http.Response) (*UserUpdateOidcOauthStartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowGetWorkersCountResponse{
+	response := &UserUpdateOidcOauthStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUserUpdatePasswordResponse parses an HTTP response from a UserUpdatePasswordWithResponse call
+func ParseUserUpdatePasswordResponse(rsp *http.Response) (*UserUpdatePasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserUpdatePasswordResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowWorkersCount
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16405,27 +21422,34 @@ func ParseWorkflowGetWorkersCountResponse(rsp *http.Response) (*WorkflowGetWorke
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUserGetCurrentResponse parses an HTTP response from a UserGetCurrentWithResponse call
-func ParseUserGetCurrentResponse(rsp *http.Response) (*UserGetCurrentResponse, error) {
+// ParseUserCreateResponse parses an HTTP response from a UserCreateWithResponse call
+func ParseUserCreateResponse(rsp *http.Response) (*UserCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserGetCurrentResponse{
+	response := &UserCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -16464,31 +21488,15 @@ func ParseUserGetCurrentResponse(rsp *http.Response) (*UserGetCurrentResponse, e
 	return response, nil
 }
 
-// ParseUserUpdateGithubOauthCallbackResponse parses an HTTP response from a UserUpdateGithubOauthCallbackWithResponse call
-func ParseUserUpdateGithubOauthCallbackResponse(rsp *http.Response) (*UserUpdateGithubOauthCallbackResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &UserUpdateGithubOauthCallbackResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParseUserUpdateGithubOauthStartResponse parses an HTTP response from a UserUpdateGithubOauthStartWithResponse call
-func ParseUserUpdateGithubOauthStartResponse(rsp *http.Response) (*UserUpdateGithubOauthStartResponse, error) {
+// ParseUserUpdateSlackOauthCallbackResponse parses an HTTP response from a UserUpdateSlackOauthCallbackWithResponse call
+func ParseUserUpdateSlackOauthCallbackResponse(rsp *http.Response) (*UserUpdateSlackOauthCallbackResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserUpdateGithubOauthStartResponse{
+	response := &UserUpdateSlackOauthCallbackResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -16496,92 +21504,95 @@ func ParseUserUpdateGithubOauthStartResponse(rsp *http.Response) (*UserUpdateGit
 	return response, nil
 }
 
-// ParseUserUpdateGoogleOauthCallbackResponse parses an HTTP response from a UserUpdateGoogleOauthCallbackWithResponse call
-func ParseUserUpdateGoogleOauthCallbackResponse(rsp *http.Response) (*UserUpdateGoogleOauthCallbackResponse, error) {
+// ParseInfoGetVersionResponse parses an HTTP response from a InfoGetVersionWithResponse call
+func ParseInfoGetVersionResponse(rsp *http.Response) (*InfoGetVersionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserUpdateGoogleOauthCallbackResponse{
+	response := &InfoGetVersionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	return response, nil
-}
-
-// ParseUserUpdateGoogleOauthStartResponse parses an HTTP response from a UserUpdateGoogleOauthStartWithResponse call
-func ParseUserUpdateGoogleOauthStartResponse(rsp *http.Response) (*UserUpdateGoogleOauthStartResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Version string `json:"version"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	response := &UserUpdateGoogleOauthStartResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseUserListTenantInvitesResponse parses an HTTP response from a UserListTenantInvitesWithResponse call
-func ParseUserListTenantInvitesResponse(rsp *http.Response) (*UserListTenantInvitesResponse, error) {
+// ParseWebhookDeleteResponse parses an HTTP response from a WebhookDeleteWithResponse call
+func ParseWebhookDeleteResponse(rsp *http.Response) (*WebhookDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserListTenantInvitesResponse{
+	response := &WebhookDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantInviteList
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseTenantInviteAcceptResponse parses an HTTP response from a TenantInviteAcceptWithResponse call
-func ParseTenantInviteAcceptResponse(rsp *http.Response) (*TenantInviteAcceptResponse, error) {
+// ParseWebhookRequestsListResponse parses an HTTP response from a WebhookRequestsListWithResponse call
+func ParseWebhookRequestsListResponse(rsp *http.Response) (*WebhookRequestsListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantInviteAcceptResponse{
+	response := &WebhookRequestsListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookWorkerRequestListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -16589,32 +21600,46 @@ func ParseTenantInviteAcceptResponse(rsp *http.Response) (*TenantInviteAcceptRes
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseTenantInviteRejectResponse parses an HTTP response from a TenantInviteRejectWithResponse call
-func ParseTenantInviteRejectResponse(rsp *http.Response) (*TenantInviteRejectResponse, error) {
+// ParseWorkerGetResponse parses an HTTP response from a WorkerGetWithResponse call
+func ParseWorkerGetResponse(rsp *http.Response) (*WorkerGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantInviteRejectResponse{
+	response := &WorkerGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Worker
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -16623,7 +21648,7 @@ func ParseTenantInviteRejectResponse(rsp *http.Response) (*TenantInviteRejectRes
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16634,22 +21659,22 @@ func ParseTenantInviteRejectResponse(rsp *http.Response) (*TenantInviteRejectRes
 	return response, nil
 }
 
-// ParseUserUpdateLoginResponse parses an HTTP response from a UserUpdateLoginWithResponse call
-func ParseUserUpdateLoginResponse(rsp *http.Response) (*UserUpdateLoginResponse, error) {
+// ParseWorkerUpdateResponse parses an HTTP response from a WorkerUpdateWithResponse call
+func ParseWorkerUpdateResponse(rsp *http.Response) (*WorkerUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserUpdateLoginResponse{
+	response := &WorkerUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
+		var dest Worker
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16662,46 +21687,32 @@ func ParseUserUpdateLoginResponse(rsp *http.Response) (*UserUpdateLoginResponse,
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUserUpdateLogoutResponse parses an HTTP response from a UserUpdateLogoutWithResponse call
-func ParseUserUpdateLogoutResponse(rsp *http.Response) (*UserUpdateLogoutResponse, error) {
+// ParseWorkflowDeleteResponse parses an HTTP response from a WorkflowDeleteWithResponse call
+func ParseWorkflowDeleteResponse(rsp *http.Response) (*WorkflowDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserUpdateLogoutResponse{
+	response := &WorkflowDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -16709,41 +21720,41 @@ func ParseUserUpdateLogoutResponse(rsp *http.Response) (*UserUpdateLogoutRespons
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseTenantMembershipsListResponse parses an HTTP response from a TenantMembershipsListWithResponse call
-func ParseTenantMembershipsListResponse(rsp *http.Response) (*TenantMembershipsListResponse, error) {
+// ParseWorkflowGetResponse parses an HTTP response from a WorkflowGetWithResponse call
+func ParseWorkflowGetResponse(rsp *http.Response) (*WorkflowGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantMembershipsListResponse{
+	response := &WorkflowGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserTenantMembershipsList
+		var dest Workflow
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16763,74 +21774,74 @@ func ParseTenantMembershipsListResponse(rsp *http.Response) (*TenantMembershipsL
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseUserUpdatePasswordResponse parses an HTTP response from a UserUpdatePasswordWithResponse call
-func ParseUserUpdatePasswordResponse(rsp *http.Response) (*UserUpdatePasswordResponse, error) {
+// ParseWorkflowUpdateResponse parses an HTTP response from a WorkflowUpdateWithResponse call
+func ParseWorkflowUpdateResponse(rsp *http.Response) (*WorkflowUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserUpdatePasswordResponse{
+	response := &WorkflowUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
+		var dest Workflow
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUserCreateResponse parses an HTTP response from a UserCreateWithResponse call
-func ParseUserCreateResponse(rsp *http.Response) (*UserCreateResponse, error) {
+// ParseWorkflowGetMetricsResponse parses an HTTP response from a WorkflowGetMetricsWithResponse call
+func ParseWorkflowGetMetricsResponse(rsp *http.Response) (*WorkflowGetMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserCreateResponse{
+	response := &WorkflowGetMetricsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
+		var dest WorkflowMetrics
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16843,83 +21854,46 @@ func ParseUserCreateResponse(rsp *http.Response) (*UserCreateResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseUserUpdateSlackOauthCallbackResponse parses an HTTP response from a UserUpdateSlackOauthCallbackWithResponse call
-func ParseUserUpdateSlackOauthCallbackResponse(rsp *http.Response) (*UserUpdateSlackOauthCallbackResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		response.JSON404 = &dest
 
-	response := &UserUpdateSlackOauthCallbackResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseInfoGetVersionResponse parses an HTTP response from a InfoGetVersionWithResponse call
-func ParseInfoGetVersionResponse(rsp *http.Response) (*InfoGetVersionResponse, error) {
+// ParseWorkflowRunCreateResponse parses an HTTP response from a WorkflowRunCreateWithResponse call
+func ParseWorkflowRunCreateResponse(rsp *http.Response) (*WorkflowRunCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &InfoGetVersionResponse{
+	response := &WorkflowRunCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Version string `json:"version"`
-		}
+		var dest WorkflowRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	}
-
-	return response, nil
-}
-
-// ParseWebhookDeleteResponse parses an HTTP response from a WebhookDeleteWithResponse call
-func ParseWebhookDeleteResponse(rsp *http.Response) (*WebhookDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &WebhookDeleteResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -16927,41 +21901,48 @@ func ParseWebhookDeleteResponse(rsp *http.Response) (*WebhookDeleteResponse, err
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseWebhookRequestsListResponse parses an HTTP response from a WebhookRequestsListWithResponse call
-func ParseWebhookRequestsListResponse(rsp *http.Response) (*WebhookRequestsListResponse, error) {
+// ParseWorkflowVersionGetResponse parses an HTTP response from a WorkflowVersionGetWithResponse call
+func ParseWorkflowVersionGetResponse(rsp *http.Response) (*WorkflowVersionGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WebhookRequestsListResponse{
+	response := &WorkflowVersionGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WebhookWorkerRequestListResponse
+		var dest WorkflowVersion
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16974,41 +21955,41 @@ func ParseWebhookRequestsListResponse(rsp *http.Response) (*WebhookRequestsListR
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseWorkerGetResponse parses an HTTP response from a WorkerGetWithResponse call
-func ParseWorkerGetResponse(rsp *http.Response) (*WorkerGetResponse, error) {
+// ParseV2DagListTasksResponse parses an HTTP response from a V2DagListTasksWithResponse call
+func ParseV2DagListTasksResponse(rsp *http.Response) (*V2DagListTasksResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkerGetResponse{
+	response := &V2DagListTasksResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Worker
+		var dest []V2DagChildren
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17033,22 +22014,22 @@ func ParseWorkerGetResponse(rsp *http.Response) (*WorkerGetResponse, error) {
 	return response, nil
 }
 
-// ParseWorkerUpdateResponse parses an HTTP response from a WorkerUpdateWithResponse call
-func ParseWorkerUpdateResponse(rsp *http.Response) (*WorkerUpdateResponse, error) {
+// ParseV2TaskGetResponse parses an HTTP response from a V2TaskGetWithResponse call
+func ParseV2TaskGetResponse(rsp *http.Response) (*V2TaskGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkerUpdateResponse{
+	response := &V2TaskGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Worker
+		var dest V2Task
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17068,25 +22049,39 @@ func ParseWorkerUpdateResponse(rsp *http.Response) (*WorkerUpdateResponse, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowDeleteResponse parses an HTTP response from a WorkflowDeleteWithResponse call
-func ParseWorkflowDeleteResponse(rsp *http.Response) (*WorkflowDeleteResponse, error) {
+// ParseV2TaskGetQueueDiagnosisResponse parses an HTTP response from a V2TaskGetQueueDiagnosisWithResponse call
+func ParseV2TaskGetQueueDiagnosisResponse(rsp *http.Response) (*V2TaskGetQueueDiagnosisResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowDeleteResponse{
+	response := &V2TaskGetQueueDiagnosisResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V2TaskQueueDiagnosis
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -17113,22 +22108,22 @@ func ParseWorkflowDeleteResponse(rsp *http.Response) (*WorkflowDeleteResponse, e
 	return response, nil
 }
 
-// ParseWorkflowGetResponse parses an HTTP response from a WorkflowGetWithResponse call
-func ParseWorkflowGetResponse(rsp *http.Response) (*WorkflowGetResponse, error) {
+// ParseV2TaskEventListResponse parses an HTTP response from a V2TaskEventListWithResponse call
+func ParseV2TaskEventListResponse(rsp *http.Response) (*V2TaskEventListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowGetResponse{
+	response := &V2TaskEventListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Workflow
+		var dest V2TaskEventList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17160,22 +22155,22 @@ func ParseWorkflowGetResponse(rsp *http.Response) (*WorkflowGetResponse, error)
 	return response, nil
 }
 
-// ParseWorkflowUpdateResponse parses an HTTP response from a WorkflowUpdateWithResponse call
-func ParseWorkflowUpdateResponse(rsp *http.Response) (*WorkflowUpdateResponse, error) {
+// ParseV2ConcurrencyListResponse parses an HTTP response from a V2ConcurrencyListWithResponse call
+func ParseV2ConcurrencyListResponse(rsp *http.Response) (*V2ConcurrencyListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowUpdateResponse{
+	response := &V2ConcurrencyListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Workflow
+		var dest V2ConcurrencyStrategyUsageList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17200,22 +22195,22 @@ func ParseWorkflowUpdateResponse(rsp *http.Response) (*WorkflowUpdateResponse, e
 	return response, nil
 }
 
-// ParseWorkflowGetMetricsResponse parses an HTTP response from a WorkflowGetMetricsWithResponse call
-func ParseWorkflowGetMetricsResponse(rsp *http.Response) (*WorkflowGetMetricsResponse, error) {
+// ParseV2RateLimitListResponse parses an HTTP response from a V2RateLimitListWithResponse call
+func ParseV2RateLimitListResponse(rsp *http.Response) (*V2RateLimitListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowGetMetricsResponse{
+	response := &V2RateLimitListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowMetrics
+		var dest V2RateLimitUsageList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17235,34 +22230,27 @@ func ParseWorkflowGetMetricsResponse(rsp *http.Response) (*WorkflowGetMetricsRes
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowRunCreateResponse parses an HTTP response from a WorkflowRunCreateWithResponse call
-func ParseWorkflowRunCreateResponse(rsp *http.Response) (*WorkflowRunCreateResponse, error) {
+// ParseV2TaskListStatusMetricsResponse parses an HTTP response from a V2TaskListStatusMetricsWithResponse call
+func ParseV2TaskListStatusMetricsResponse(rsp *http.Response) (*V2TaskListStatusMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunCreateResponse{
+	response := &V2TaskListStatusMetricsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowRun
+		var dest V2TaskRunMetrics
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17282,41 +22270,27 @@ func ParseWorkflowRunCreateResponse(rsp *http.Response) (*WorkflowRunCreateRespo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowVersionGetResponse parses an HTTP response from a WorkflowVersionGetWithResponse call
-func ParseWorkflowVersionGetResponse(rsp *http.Response) (*WorkflowVersionGetResponse, error) {
+// ParseV2TaskGetPointMetricsResponse parses an HTTP response from a V2TaskGetPointMetricsWithResponse call
+func ParseV2TaskGetPointMetricsResponse(rsp *http.Response) (*V2TaskGetPointMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowVersionGetResponse{
+	response := &V2TaskGetPointMetricsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowVersion
+		var dest V2TaskPointMetrics
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17336,34 +22310,27 @@ func ParseWorkflowVersionGetResponse(rsp *http.Response) (*WorkflowVersionGetRes
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseV2DagListTasksResponse parses an HTTP response from a V2DagListTasksWithResponse call
-func ParseV2DagListTasksResponse(rsp *http.Response) (*V2DagListTasksResponse, error) {
+// ParseV2TaskListResponse parses an HTTP response from a V2TaskListWithResponse call
+func ParseV2TaskListResponse(rsp *http.Response) (*V2TaskListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V2DagListTasksResponse{
+	response := &V2TaskListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []V2DagChildren
+		var dest V2TaskSummaryList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17388,22 +22355,22 @@ func ParseV2DagListTasksResponse(rsp *http.Response) (*V2DagListTasksResponse, e
 	return response, nil
 }
 
-// ParseV2TaskGetResponse parses an HTTP response from a V2TaskGetWithResponse call
-func ParseV2TaskGetResponse(rsp *http.Response) (*V2TaskGetResponse, error) {
+// ParseV2TaskCancelResponse parses an HTTP response from a V2TaskCancelWithResponse call
+func ParseV2TaskCancelResponse(rsp *http.Response) (*V2TaskCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V2TaskGetResponse{
+	response := &V2TaskCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V2Task
+		var dest V2CancelTasksResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17423,34 +22390,27 @@ func ParseV2TaskGetResponse(rsp *http.Response) (*V2TaskGetResponse, error) {
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseV2TaskEventListResponse parses an HTTP response from a V2TaskEventListWithResponse call
-func ParseV2TaskEventListResponse(rsp *http.Response) (*V2TaskEventListResponse, error) {
+// ParseV2TaskReplayResponse parses an HTTP response from a V2TaskReplayWithResponse call
+func ParseV2TaskReplayResponse(rsp *http.Response) (*V2TaskReplayResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V2TaskEventListResponse{
+	response := &V2TaskReplayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V2TaskEventList
+		var dest V2ReplayTasksResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17470,34 +22430,27 @@ func ParseV2TaskEventListResponse(rsp *http.Response) (*V2TaskEventListResponse,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseV2TaskListStatusMetricsResponse parses an HTTP response from a V2TaskListStatusMetricsWithResponse call
-func ParseV2TaskListStatusMetricsResponse(rsp *http.Response) (*V2TaskListStatusMetricsResponse, error) {
+// ParseV2WorkflowRunListResponse parses an HTTP response from a V2WorkflowRunListWithResponse call
+func ParseV2WorkflowRunListResponse(rsp *http.Response) (*V2WorkflowRunListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V2TaskListStatusMetricsResponse{
+	response := &V2WorkflowRunListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V2TaskRunMetrics
+		var dest V2WorkflowRunList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17522,22 +22475,22 @@ func ParseV2TaskListStatusMetricsResponse(rsp *http.Response) (*V2TaskListStatus
 	return response, nil
 }
 
-// ParseV2TaskGetPointMetricsResponse parses an HTTP response from a V2TaskGetPointMetricsWithResponse call
-func ParseV2TaskGetPointMetricsResponse(rsp *http.Response) (*V2TaskGetPointMetricsResponse, error) {
+// ParseV2WorkflowRunCancelResponse parses an HTTP response from a V2WorkflowRunCancelWithResponse call
+func ParseV2WorkflowRunCancelResponse(rsp *http.Response) (*V2WorkflowRunCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V2TaskGetPointMetricsResponse{
+	response := &V2WorkflowRunCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V2TaskPointMetrics
+		var dest V2CancelWorkflowRunsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17562,22 +22515,22 @@ func ParseV2TaskGetPointMetricsResponse(rsp *http.Response) (*V2TaskGetPointMetr
 	return response, nil
 }
 
-// ParseV2TaskListResponse parses an HTTP response from a V2TaskListWithResponse call
-func ParseV2TaskListResponse(rsp *http.Response) (*V2TaskListResponse, error) {
+// ParseV2WorkflowRunReplayResponse parses an HTTP response from a V2WorkflowRunReplayWithResponse call
+func ParseV2WorkflowRunReplayResponse(rsp *http.Response) (*V2WorkflowRunReplayResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V2TaskListResponse{
+	response := &V2WorkflowRunReplayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V2TaskSummaryList
+		var dest V2ReplayWorkflowRunsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17602,22 +22555,22 @@ func ParseV2TaskListResponse(rsp *http.Response) (*V2TaskListResponse, error) {
 	return response, nil
 }
 
-// ParseV2WorkflowRunListResponse parses an HTTP response from a V2WorkflowRunListWithResponse call
-func ParseV2WorkflowRunListResponse(rsp *http.Response) (*V2WorkflowRunListResponse, error) {
+// ParseV2WorkflowRunGetResponse parses an HTTP response from a V2WorkflowRunGetWithResponse call
+func ParseV2WorkflowRunGetResponse(rsp *http.Response) (*V2WorkflowRunGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V2WorkflowRunListResponse{
+	response := &V2WorkflowRunGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V2WorkflowRunList
+		var dest V2WorkflowRunDetails
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17642,22 +22595,22 @@ func ParseV2WorkflowRunListResponse(rsp *http.Response) (*V2WorkflowRunListRespo
 	return response, nil
 }
 
-// ParseV2WorkflowRunGetResponse parses an HTTP response from a V2WorkflowRunGetWithResponse call
-func ParseV2WorkflowRunGetResponse(rsp *http.Response) (*V2WorkflowRunGetResponse, error) {
+// ParseV2WorkflowRunReplayFromTaskResponse parses an HTTP response from a V2WorkflowRunReplayFromTaskWithResponse call
+func ParseV2WorkflowRunReplayFromTaskResponse(rsp *http.Response) (*V2WorkflowRunReplayFromTaskResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V2WorkflowRunGetResponse{
+	response := &V2WorkflowRunReplayFromTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V2WorkflowRunDetails
+		var dest V2ReplayWorkflowRunFromTaskResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -17677,6 +22630,13 @@ func ParseV2WorkflowRunGetResponse(rsp *http.Response) (*V2WorkflowRunGetRespons
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
@@ -43,9 +43,10 @@ type Workflow struct {
 type WorkflowConcurrencyLimitStrategy string
 
 const (
-	CancelInProgress WorkflowConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
-	CancelNewest     WorkflowConcurrencyLimitStrategy = "CANCEL_NEWEST"
-	GroupRoundRobin  WorkflowConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
+	CancelInProgress   WorkflowConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
+	CancelNewest       WorkflowConcurrencyLimitStrategy = "CANCEL_NEWEST"
+	GroupRoundRobin    WorkflowConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
+	WeightedRoundRobin WorkflowConcurrencyLimitStrategy = "WEIGHTED_ROUND_ROBIN"
 )
 
 type WorkflowConcurrency struct {
@@ -56,6 +57,8 @@ type WorkflowConcurrency struct {
 	MaxRuns int32 `yaml:"maxRuns,omitempty"`
 
 	LimitStrategy WorkflowConcurrencyLimitStrategy `yaml:"limitStrategy,omitempty"`
+
+	WeightExpression *string `yaml:"weightExpression,omitempty"`
 }
 
 type WorkflowTriggers struct {
@@ -124,6 +127,12 @@ type WorkflowStep struct {
 	DesiredLabels          map[string]*DesiredWorkerLabel `yaml:"desiredLabels,omitempty"`
 	RetryBackoffFactor     *float32                       `yaml:"retryBackoffFactor,omitempty"`
 	RetryMaxBackoffSeconds *int32                         `yaml:"retryMaxBackoffSeconds,omitempty"`
+	UserEventConditions    []UserEventCondition           `yaml:"userEventConditions,omitempty"`
+}
+
+type UserEventCondition struct {
+	EventKey   string  `yaml:"eventKey"`
+	Expression *string `yaml:"expression,omitempty"`
 }
 
 type RateLimit struct {
@@ -12,7 +12,27 @@ const (
 	Year   RateLimitDuration = "year"
 )
 
+type RateLimitAlgorithm string
+
+const (
+	// FixedWindow resets the rate limit to its max at the end of each window
+	FixedWindow RateLimitAlgorithm = "fixed_window"
+
+	// TokenBucket refills the rate limit continuously, at a rate of max units per window
+	TokenBucket RateLimitAlgorithm = "token_bucket"
+
+	// SlidingWindow weights the units used in the previous window by how much of it overlaps the
+	// trailing window
+	SlidingWindow RateLimitAlgorithm = "sliding_window"
+)
+
 type RateLimitOpts struct {
 	Max      int
 	Duration RateLimitDuration
+
+	// (optional) the algorithm used to refill the rate limit, defaults to a fixed window
+	Algorithm RateLimitAlgorithm
+
+	// (optional) the max number of units which can accumulate in a token bucket, defaults to max
+	Burst *int
 }
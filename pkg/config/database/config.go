@@ -32,6 +32,29 @@ type ConfigFile struct {
 	LogQueries bool `mapstructure:"logQueries" json:"logQueries,omitempty" default:"false"`
 
 	CacheDuration time.Duration `mapstructure:"cacheDuration" json:"cacheDuration,omitempty" default:"60s"`
+
+	OLAP OLAPConfigFile `mapstructure:"olap" json:"olap,omitempty"`
+}
+
+type OLAPConfigFile struct {
+	// Kind is the OLAP backend which task and workflow run events are written to, either "timescale", "clickhouse"
+	// or "memory". If not set, timescale is used. hatchet-lite uses memory instead when no TimescaleURL is set.
+	Kind string `mapstructure:"kind" json:"kind,omitempty"`
+
+	TimescaleURL string `mapstructure:"timescaleUrl" json:"timescaleUrl,omitempty"`
+
+	Clickhouse ClickhouseConfigFile `mapstructure:"clickhouse" json:"clickhouse,omitempty"`
+}
+
+type ClickhouseConfigFile struct {
+	Addrs    []string `mapstructure:"addrs" json:"addrs,omitempty" default:"[\"127.0.0.1:9000\"]"`
+	Database string   `mapstructure:"database" json:"database,omitempty" default:"default"`
+	Username string   `mapstructure:"username" json:"username,omitempty" default:"default"`
+	Password string   `mapstructure:"password" json:"password,omitempty"`
+	Secure   bool     `mapstructure:"secure" json:"secure,omitempty" default:"false"`
+
+	MaxOpenConns int `mapstructure:"maxOpenConns" json:"maxOpenConns,omitempty" default:"50"`
+	MaxIdleConns int `mapstructure:"maxIdleConns" json:"maxIdleConns,omitempty" default:"10"`
 }
 
 type SeedConfigFile struct {
@@ -92,4 +115,14 @@ func BindAllEnv(v *viper.Viper) {
 
 	_ = v.BindEnv("logger.level", "DATABASE_LOGGER_LEVEL")
 	_ = v.BindEnv("logger.format", "DATABASE_LOGGER_FORMAT")
+
+	_ = v.BindEnv("olap.kind", "DATABASE_OLAP_KIND")
+	_ = v.BindEnv("olap.timescaleUrl", "TIMESCALE_URL")
+	_ = v.BindEnv("olap.clickhouse.addrs", "DATABASE_CLICKHOUSE_ADDRS")
+	_ = v.BindEnv("olap.clickhouse.database", "DATABASE_CLICKHOUSE_DATABASE")
+	_ = v.BindEnv("olap.clickhouse.username", "DATABASE_CLICKHOUSE_USERNAME")
+	_ = v.BindEnv("olap.clickhouse.password", "DATABASE_CLICKHOUSE_PASSWORD")
+	_ = v.BindEnv("olap.clickhouse.secure", "DATABASE_CLICKHOUSE_SECURE")
+	_ = v.BindEnv("olap.clickhouse.maxOpenConns", "DATABASE_CLICKHOUSE_MAX_OPEN_CONNS")
+	_ = v.BindEnv("olap.clickhouse.maxIdleConns", "DATABASE_CLICKHOUSE_MAX_IDLE_CONNS")
 }
@@ -4,23 +4,27 @@ package loader
 
 import (
 	"context"
+	cryptotls "crypto/tls"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/exaring/otelpgx"
 	pgxzero "github.com/jackc/pgx-zerolog"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/tracelog"
+	"github.com/rs/zerolog"
 	"golang.org/x/oauth2"
 
 	"github.com/hatchet-dev/hatchet/internal/integrations/alerting"
 	"github.com/hatchet-dev/hatchet/internal/integrations/email"
 	"github.com/hatchet-dev/hatchet/internal/integrations/email/postmark"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue/kafka"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue/postgres"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue/rabbitmq"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
@@ -39,6 +43,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
+	"github.com/hatchet-dev/hatchet/pkg/repository/olap"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 	v2 "github.com/hatchet-dev/hatchet/pkg/scheduling/v2"
@@ -76,6 +81,15 @@ type RepositoryOverrides struct {
 type ConfigLoader struct {
 	directory           string
 	RepositoryOverrides RepositoryOverrides
+
+	// MemoryOLAPFallback uses the in-memory OLAP repository when no OLAP backend is configured, rather than
+	// failing to start. This is only intended for single-instance deployments such as hatchet-lite.
+	MemoryOLAPFallback bool
+
+	// memoryOLAP is shared by every data layer which the loader initializes, so that an API and engine running
+	// in the same process read and write the same in-memory OLAP repository
+	memoryOLAP   repository.OLAPEventRepository
+	memoryOLAPMu sync.Mutex
 }
 
 func NewConfigLoader(directory string) *ConfigLoader {
@@ -190,9 +204,15 @@ func (c *ConfigLoader) InitDataLayer() (res *database.Layer, err error) {
 
 	meter := metered.NewMetered(entitlementRepo, &l)
 
+	olapRepo, cleanupOLAP, err := c.newOLAPRepository(&cf.OLAP, &l)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create olap repository: %w", err)
+	}
+
 	var opts []prisma.PrismaRepositoryOpt
 
-	opts = append(opts, prisma.WithLogger(&l), prisma.WithCache(ch), prisma.WithMetered(meter))
+	opts = append(opts, prisma.WithLogger(&l), prisma.WithCache(ch), prisma.WithMetered(meter), prisma.WithOLAPEventRepository(olapRepo))
 
 	if c.RepositoryOverrides.LogsEngineRepository != nil {
 		opts = append(opts, prisma.WithLogsEngineRepository(c.RepositoryOverrides.LogsEngineRepository))
@@ -204,7 +224,7 @@ func (c *ConfigLoader) InitDataLayer() (res *database.Layer, err error) {
 		return nil, fmt.Errorf("could not create engine repository: %w", err)
 	}
 
-	v2Repo := repov2.NewRepository(pool, &l)
+	v2Repo := repov2.NewRepository(pool, &l, repov2.WithCELProgramCacheSize(scf.Runtime.CELProgramCacheSize))
 
 	if c.RepositoryOverrides.LogsAPIRepository != nil {
 		opts = append(opts, prisma.WithLogsAPIRepository(c.RepositoryOverrides.LogsAPIRepository))
@@ -227,13 +247,16 @@ func (c *ConfigLoader) InitDataLayer() (res *database.Layer, err error) {
 			if err = cleanupApiRepo(); err != nil {
 				return err
 			}
+			if err = cleanupOLAP(); err != nil {
+				return err
+			}
 			return client.Prisma.Disconnect()
 		},
 		Pool:                  pool,
 		EssentialPool:         essentialPool,
 		QueuePool:             pool,
 		APIRepository:         apiRepo,
-		OLAPRepository:        repository.NewOLAPEventRepository(&l),
+		OLAPRepository:        olapRepo,
 		EngineRepository:      engineRepo,
 		V2:                    v2Repo,
 		EntitlementRepository: entitlementRepo,
@@ -242,6 +265,92 @@ func (c *ConfigLoader) InitDataLayer() (res *database.Layer, err error) {
 
 }
 
+// newOLAPRepository creates the OLAP repository for the configured backend, along with a cleanup function
+// which closes its connections. If no backend is configured, timescale is used, or memory if
+// MemoryOLAPFallback is set and TIMESCALE_URL is not set.
+func (c *ConfigLoader) newOLAPRepository(cf *database.OLAPConfigFile, l *zerolog.Logger) (repository.OLAPEventRepository, func() error, error) {
+	kind := cf.Kind
+
+	if kind == "" {
+		kind = "timescale"
+
+		if cf.TimescaleURL == "" && c.MemoryOLAPFallback {
+			kind = "memory"
+		}
+	}
+
+	switch kind {
+	case "memory":
+		c.memoryOLAPMu.Lock()
+		defer c.memoryOLAPMu.Unlock()
+
+		if c.memoryOLAP == nil {
+			l.Warn().Msg("using in-memory OLAP repository, task run data will not be persisted across restarts")
+
+			c.memoryOLAP = repository.NewMemoryOLAPEventRepository(l)
+		}
+
+		return c.memoryOLAP, func() error { return nil }, nil
+	case "timescale":
+		if cf.TimescaleURL == "" {
+			return nil, nil, fmt.Errorf("TIMESCALE_URL is not set, set DATABASE_OLAP_KIND to memory to use the in-memory OLAP repository")
+		}
+
+		config, err := pgxpool.ParseConfig(cf.TimescaleURL)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		config.MaxConns = 150
+		config.MinConns = 10
+		config.MaxConnLifetime = 15 * 60 * time.Second
+
+		pool, err := pgxpool.NewWithConfig(context.Background(), config)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not connect to timescale: %w", err)
+		}
+
+		repo, err := repository.NewTimescaleOLAPEventRepository(pool, l)
+
+		if err != nil {
+			pool.Close()
+			return nil, nil, err
+		}
+
+		return repo, func() error {
+			pool.Close()
+			return nil
+		}, nil
+	case "clickhouse":
+		conn, err := olap.CreateClickhouseConnection(olap.ClickhouseConnectionOpts{
+			Addrs:        cf.Clickhouse.Addrs,
+			Database:     cf.Clickhouse.Database,
+			Username:     cf.Clickhouse.Username,
+			Password:     cf.Clickhouse.Password,
+			Secure:       cf.Clickhouse.Secure,
+			MaxOpenConns: cf.Clickhouse.MaxOpenConns,
+			MaxIdleConns: cf.Clickhouse.MaxIdleConns,
+		})
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not connect to clickhouse: %w", err)
+		}
+
+		repo, err := repository.NewClickhouseOLAPEventRepository(conn, l)
+
+		if err != nil {
+			_ = conn.Close()
+			return nil, nil, err
+		}
+
+		return repo, conn.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown olap kind: %s", kind)
+	}
+}
+
 type ServerConfigFileOverride func(*server.ServerConfigFile)
 
 // CreateServerFromConfig loads the server configuration and returns a server
@@ -318,6 +427,7 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 				rabbitmq.WithLogger(&l),
 				rabbitmq.WithQos(cf.MessageQueue.RabbitMQ.Qos),
 				rabbitmq.WithDisableTenantExchangePubs(cf.Runtime.DisableTenantPubs),
+				rabbitmq.WithDeadLetterRepository(dc.EngineRepository.MessageQueue()),
 			)
 
 			if rabbitMq == nil {
@@ -326,6 +436,35 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 
 			cleanup1 = cleanupRabbit
 			mq = rabbitMq
+		case "kafka":
+			kafkaOpts := []kafka.MessageQueueImplOpt{
+				kafka.WithBrokers(getStrArr(cf.MessageQueue.Kafka.Brokers)...),
+				kafka.WithLogger(&l),
+				kafka.WithQos(cf.MessageQueue.Kafka.Qos),
+				kafka.WithPartitions(cf.MessageQueue.Kafka.Partitions),
+				kafka.WithReplicationFactor(cf.MessageQueue.Kafka.ReplicationFactor),
+				kafka.WithDisableTenantExchangePubs(cf.Runtime.DisableTenantPubs),
+				kafka.WithDeadLetterRepository(dc.EngineRepository.MessageQueue()),
+			}
+
+			if cf.MessageQueue.Kafka.TLS {
+				kafkaOpts = append(kafkaOpts, kafka.WithTLS(&cryptotls.Config{
+					MinVersion: cryptotls.VersionTLS12,
+				}))
+			}
+
+			if cf.MessageQueue.Kafka.SASLUsername != "" {
+				kafkaOpts = append(kafkaOpts, kafka.WithSASL(cf.MessageQueue.Kafka.SASLUsername, cf.MessageQueue.Kafka.SASLPassword))
+			}
+
+			cleanupKafka, kafkaMq := kafka.New(kafkaOpts...)
+
+			if kafkaMq == nil {
+				return nil, nil, fmt.Errorf("could not create kafka message queue")
+			}
+
+			cleanup1 = cleanupKafka
+			mq = kafkaMq
 		}
 
 		ing, err = ingestor.NewIngestor(
@@ -444,6 +583,41 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 		})
 	}
 
+	if cf.Auth.OIDC.Enabled {
+		if cf.Auth.OIDC.IssuerURL == "" {
+			return nil, nil, fmt.Errorf("oidc issuer url is required")
+		}
+
+		if cf.Auth.OIDC.ClientID == "" {
+			return nil, nil, fmt.Errorf("oidc client id is required")
+		}
+
+		if cf.Auth.OIDC.ClientSecret == "" {
+			return nil, nil, fmt.Errorf("oidc client secret is required")
+		}
+
+		discoveryCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		oidcProvider, err := oauth.NewOIDCProvider(discoveryCtx, &oauth.OIDCConfig{
+			IssuerURL:    cf.Auth.OIDC.IssuerURL,
+			RolesClaim:   cf.Auth.OIDC.RolesClaim,
+			RoleMappings: cf.Auth.OIDC.RoleMappings,
+		})
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not load oidc provider: %w", err)
+		}
+
+		auth.OIDCProvider = oidcProvider
+		auth.OIDCOAuthConfig = oauth.NewOIDCClient(&oauth.Config{
+			ClientID:     cf.Auth.OIDC.ClientID,
+			ClientSecret: cf.Auth.OIDC.ClientSecret,
+			BaseURL:      cf.Runtime.ServerURL,
+			Scopes:       cf.Auth.OIDC.Scopes,
+		}, oidcProvider)
+	}
+
 	encryptionSvc, err := loadEncryptionSvc(cf)
 
 	if err != nil {
@@ -486,10 +660,24 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 
 	v := validator.NewDefaultValidator()
 
+	slotRanker, err := v2.NewSlotRanker(v2.SlotRankerPolicy(cf.Runtime.SlotRankerPolicy))
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create slot ranker: %w", err)
+	}
+
+	slotRankerResolver, err := v2.NewTenantSlotRankerResolver(cf.Runtime.SlotRankerTenantPolicies)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create tenant slot rankers: %w", err)
+	}
+
 	schedulingPool, cleanupSchedulingPool, err := v2.NewSchedulingPool(
 		dc.V2.Scheduler(),
 		&queueLogger,
 		cf.Runtime.SingleQueueLimit,
+		v2.WithDefaultSlotRanker(slotRanker),
+		v2.WithSlotRankerResolver(slotRankerResolver),
 	)
 
 	if err != nil {
@@ -0,0 +1,66 @@
+//go:build integration
+
+package loader_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+func TestMemoryOLAPFallbackIsSharedBetweenDataLayers(t *testing.T) {
+	testutils.Prepare(t)
+
+	t.Setenv("DATABASE_OLAP_KIND", "")
+	t.Setenv("TIMESCALE_URL", "")
+
+	cf := &loader.ConfigLoader{
+		MemoryOLAPFallback: true,
+	}
+
+	// hatchet-lite initializes separate data layers for the API and the engine from the same loader
+	engineLayer, err := cf.InitDataLayer()
+	require.NoError(t, err)
+	defer engineLayer.Disconnect() // nolint: errcheck
+
+	apiLayer, err := cf.InitDataLayer()
+	require.NoError(t, err)
+	defer apiLayer.Disconnect() // nolint: errcheck
+
+	ctx := context.Background()
+	tenantId := "707d0855-80ab-4e1f-a156-f1c4546cbf52"
+	externalId := uuid.NewString()
+
+	err = engineLayer.EngineRepository.OLAP().CreateTasks(ctx, tenantId, []*sqlcv2.V2Task{
+		{
+			ID:                 1,
+			InsertedAt:         sqlchelpers.TimestamptzFromTime(time.Now().UTC()),
+			TenantID:           sqlchelpers.UUIDFromStr(tenantId),
+			Queue:              "default",
+			ActionID:           "test:step",
+			StepID:             sqlchelpers.UUIDFromStr(uuid.NewString()),
+			WorkflowID:         sqlchelpers.UUIDFromStr(uuid.NewString()),
+			ScheduleTimeout:    "5m",
+			Sticky:             sqlcv2.V2StickyStrategyNONE,
+			ExternalID:         sqlchelpers.UUIDFromStr(externalId),
+			DisplayName:        "step",
+			Input:              []byte(`{}`),
+			AdditionalMetadata: []byte(`{}`),
+		},
+	})
+	require.NoError(t, err)
+
+	task, err := apiLayer.EngineRepository.OLAP().ReadTaskRun(ctx, externalId)
+	require.NoError(t, err)
+
+	assert.Equal(t, externalId, sqlchelpers.UUIDToStr(task.ExternalID))
+}
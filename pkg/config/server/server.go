@@ -14,6 +14,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
 	"github.com/hatchet-dev/hatchet/pkg/analytics"
 	"github.com/hatchet-dev/hatchet/pkg/auth/cookie"
+	"github.com/hatchet-dev/hatchet/pkg/auth/oauth"
 	"github.com/hatchet-dev/hatchet/pkg/auth/token"
 	"github.com/hatchet-dev/hatchet/pkg/config/database"
 	"github.com/hatchet-dev/hatchet/pkg/config/shared"
@@ -117,6 +118,21 @@ type ConfigFileRuntime struct {
 	// QueueLimit is the limit of items to return from a single queue at a time
 	SingleQueueLimit int `mapstructure:"singleQueueLimit" json:"singleQueueLimit,omitempty" default:"100"`
 
+	// SlotRankerPolicy is the default policy for spreading work across workers: RANDOM_SPREAD, LEAST_LOADED or BIN_PACKING
+	SlotRankerPolicy string `mapstructure:"slotRankerPolicy" json:"slotRankerPolicy,omitempty" default:"RANDOM_SPREAD"`
+
+	// SlotRankerTenantPolicies overrides SlotRankerPolicy for individual tenants, as a list of tenantId=POLICY pairs
+	SlotRankerTenantPolicies []string `mapstructure:"slotRankerTenantPolicies" json:"slotRankerTenantPolicies,omitempty"`
+
+	// CELProgramCacheSize is the number of compiled CEL programs which are cached for evaluating concurrency
+	// keys and match conditions. A size of 0 disables the cache.
+	CELProgramCacheSize int `mapstructure:"celProgramCacheSize" json:"celProgramCacheSize,omitempty" default:"5000"`
+
+	// WebhookDeniedNetworks are the CIDR ranges which outbound webhook subscriptions can't deliver to. Defaults to
+	// loopback, private, link-local and shared address ranges, so tenants can't use webhooks to reach internal
+	// services. Self-hosted instances which deliver to internal services can remove those ranges from the list.
+	WebhookDeniedNetworks []string `mapstructure:"webhookDeniedNetworks" json:"webhookDeniedNetworks,omitempty" default:"[\"0.0.0.0/8\", \"10.0.0.0/8\", \"100.64.0.0/10\", \"127.0.0.0/8\", \"169.254.0.0/16\", \"172.16.0.0/12\", \"192.168.0.0/16\", \"::/128\", \"::1/128\", \"fc00::/7\", \"fe80::/10\"]"`
+
 	// How many buckets to hash into for parallelizing updates
 	UpdateHashFactor int `mapstructure:"updateHashFactor" json:"updateHashFactor,omitempty" default:"100"`
 
@@ -302,6 +318,8 @@ type ConfigFileAuth struct {
 	Google ConfigFileAuthGoogle `mapstructure:"google" json:"google,omitempty"`
 
 	Github ConfigFileAuthGithub `mapstructure:"github" json:"github,omitempty"`
+
+	OIDC ConfigFileAuthOIDC `mapstructure:"oidc" json:"oidc,omitempty"`
 }
 
 type ConfigFileTenantAlerting struct {
@@ -332,6 +350,25 @@ type ConfigFileAuthGithub struct {
 	Scopes       []string `mapstructure:"scopes" json:"scopes,omitempty" default:"[\"read:user\", \"user:email\"]"`
 }
 
+type ConfigFileAuthOIDC struct {
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
+
+	// IssuerURL is the URL of the OpenID Connect provider, which must serve a discovery document at
+	// /.well-known/openid-configuration
+	IssuerURL string `mapstructure:"issuerURL" json:"issuerURL,omitempty"`
+
+	ClientID     string   `mapstructure:"clientID" json:"clientID,omitempty"`
+	ClientSecret string   `mapstructure:"clientSecret" json:"clientSecret,omitempty"`
+	Scopes       []string `mapstructure:"scopes" json:"scopes,omitempty" default:"[\"openid\", \"profile\", \"email\"]"`
+
+	// RolesClaim is the claim which is matched against RoleMappings, for example groups
+	RolesClaim string `mapstructure:"rolesClaim" json:"rolesClaim,omitempty" default:"groups"`
+
+	// RoleMappings add users to tenants when they log in, based on the values of RolesClaim. Each mapping has
+	// the format <claim value>:<tenant id>:<role>, where role is one of OWNER, ADMIN or MEMBER.
+	RoleMappings []string `mapstructure:"roleMappings" json:"roleMappings,omitempty"`
+}
+
 type ConfigFileAuthCookie struct {
 	Name     string `mapstructure:"name" json:"name,omitempty" default:"hatchet"`
 	Domain   string `mapstructure:"domain" json:"domain,omitempty"`
@@ -347,6 +384,8 @@ type MessageQueueConfigFile struct {
 	Postgres PostgresMQConfigFile `mapstructure:"postgres" json:"postgres,omitempty"`
 
 	RabbitMQ RabbitMQConfigFile `mapstructure:"rabbitmq" json:"rabbitmq,omitempty" validate:"required"`
+
+	Kafka KafkaMQConfigFile `mapstructure:"kafka" json:"kafka,omitempty"`
 }
 
 type PostgresMQConfigFile struct {
@@ -358,6 +397,20 @@ type RabbitMQConfigFile struct {
 	Qos int    `mapstructure:"qos" json:"qos,omitempty" default:"100"`
 }
 
+type KafkaMQConfigFile struct {
+	// Brokers is a comma-separated list of seed brokers
+	Brokers string `mapstructure:"brokers" json:"brokers,omitempty" default:"localhost:9092"`
+	Qos     int    `mapstructure:"qos" json:"qos,omitempty" default:"100"`
+
+	// Partitions and ReplicationFactor are used when creating topics
+	Partitions        int32 `mapstructure:"partitions" json:"partitions,omitempty" default:"8"`
+	ReplicationFactor int16 `mapstructure:"replicationFactor" json:"replicationFactor,omitempty" default:"1"`
+
+	TLS          bool   `mapstructure:"tls" json:"tls,omitempty" default:"false"`
+	SASLUsername string `mapstructure:"saslUsername" json:"saslUsername,omitempty"`
+	SASLPassword string `mapstructure:"saslPassword" json:"saslPassword,omitempty"`
+}
+
 type ConfigFileEmail struct {
 	Postmark PostmarkConfigFile `mapstructure:"postmark" json:"postmark,omitempty"`
 }
@@ -394,6 +447,11 @@ type AuthConfig struct {
 
 	GithubOAuthConfig *oauth2.Config
 
+	OIDCOAuthConfig *oauth2.Config
+
+	// OIDCProvider is the discovered OpenID Connect provider, if OIDC login is enabled
+	OIDCProvider *oauth.OIDCProvider
+
 	JWTManager token.JWTManager
 }
 
@@ -595,6 +653,13 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("auth.github.clientID", "SERVER_AUTH_GITHUB_CLIENT_ID")
 	_ = v.BindEnv("auth.github.clientSecret", "SERVER_AUTH_GITHUB_CLIENT_SECRET")
 	_ = v.BindEnv("auth.github.scopes", "SERVER_AUTH_GITHUB_SCOPES")
+	_ = v.BindEnv("auth.oidc.enabled", "SERVER_AUTH_OIDC_ENABLED")
+	_ = v.BindEnv("auth.oidc.issuerURL", "SERVER_AUTH_OIDC_ISSUER_URL")
+	_ = v.BindEnv("auth.oidc.clientID", "SERVER_AUTH_OIDC_CLIENT_ID")
+	_ = v.BindEnv("auth.oidc.clientSecret", "SERVER_AUTH_OIDC_CLIENT_SECRET")
+	_ = v.BindEnv("auth.oidc.scopes", "SERVER_AUTH_OIDC_SCOPES")
+	_ = v.BindEnv("auth.oidc.rolesClaim", "SERVER_AUTH_OIDC_ROLES_CLAIM")
+	_ = v.BindEnv("auth.oidc.roleMappings", "SERVER_AUTH_OIDC_ROLE_MAPPINGS")
 
 	// task queue options
 	// legacy options
@@ -603,11 +668,22 @@ func BindAllEnv(v *viper.Viper) {
 
 	_ = v.BindEnv("msgQueue.kind", "SERVER_MSGQUEUE_KIND")
 	_ = v.BindEnv("msgQueue.rabbitmq.url", "SERVER_MSGQUEUE_RABBITMQ_URL")
+	_ = v.BindEnv("msgQueue.kafka.brokers", "SERVER_MSGQUEUE_KAFKA_BROKERS")
+	_ = v.BindEnv("msgQueue.kafka.partitions", "SERVER_MSGQUEUE_KAFKA_PARTITIONS")
+	_ = v.BindEnv("msgQueue.kafka.replicationFactor", "SERVER_MSGQUEUE_KAFKA_REPLICATION_FACTOR")
+	_ = v.BindEnv("msgQueue.kafka.tls", "SERVER_MSGQUEUE_KAFKA_TLS")
+	_ = v.BindEnv("msgQueue.kafka.saslUsername", "SERVER_MSGQUEUE_KAFKA_SASL_USERNAME")
+	_ = v.BindEnv("msgQueue.kafka.saslPassword", "SERVER_MSGQUEUE_KAFKA_SASL_PASSWORD")
 
 	// throughput options
 	_ = v.BindEnv("msgQueue.rabbitmq.qos", "SERVER_MSGQUEUE_RABBITMQ_QOS")
+	_ = v.BindEnv("msgQueue.kafka.qos", "SERVER_MSGQUEUE_KAFKA_QOS")
 	_ = v.BindEnv("runtime.requeueLimit", "SERVER_REQUEUE_LIMIT")
 	_ = v.BindEnv("runtime.singleQueueLimit", "SERVER_SINGLE_QUEUE_LIMIT")
+	_ = v.BindEnv("runtime.slotRankerPolicy", "SERVER_SLOT_RANKER_POLICY")
+	_ = v.BindEnv("runtime.slotRankerTenantPolicies", "SERVER_SLOT_RANKER_TENANT_POLICIES")
+	_ = v.BindEnv("runtime.celProgramCacheSize", "SERVER_CEL_PROGRAM_CACHE_SIZE")
+	_ = v.BindEnv("runtime.webhookDeniedNetworks", "SERVER_WEBHOOK_DENIED_NETWORKS")
 	_ = v.BindEnv("runtime.updateHashFactor", "SERVER_UPDATE_HASH_FACTOR")
 	_ = v.BindEnv("runtime.updateConcurrentFactor", "SERVER_UPDATE_CONCURRENT_FACTOR")
 
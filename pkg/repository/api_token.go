@@ -22,6 +22,9 @@ type CreateAPITokenOpts struct {
 	Name *string `validate:"omitempty,max=255"`
 
 	Internal bool
+
+	// (optional) The scopes granted to the token. If empty, the token has the admin scope.
+	Scopes []string
 }
 
 type APITokenRepository interface {
@@ -33,4 +36,5 @@ type APITokenRepository interface {
 type EngineTokenRepository interface {
 	CreateAPIToken(ctx context.Context, opts *CreateAPITokenOpts) (*dbsqlc.APIToken, error)
 	GetAPITokenById(ctx context.Context, id string) (*dbsqlc.APIToken, error)
+	ListAPITokensByTenant(ctx context.Context, tenantId string) ([]*dbsqlc.APIToken, error)
 }
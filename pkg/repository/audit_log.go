@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type CreateAuditLogOpts struct {
+	// (required) whether the action was performed by a user or an API token
+	ActorKind dbsqlc.AuditLogActorKind `validate:"required,oneof=USER API_TOKEN"`
+
+	// (required) the id of the user or API token
+	ActorId string `validate:"required,uuid"`
+
+	// (optional) a readable name for the actor, such as the user's email
+	ActorName *string
+
+	// (required) the interface which the action was performed through
+	Source dbsqlc.AuditLogSource `validate:"required,oneof=API GRPC"`
+
+	// (required) the action which was performed, for example ApiTokenCreate
+	Action string `validate:"required,max=255"`
+
+	// (required) the type of the resource which the action was performed on
+	ResourceType string `validate:"required,max=255"`
+
+	// (optional) the id of the resource which the action was performed on
+	ResourceId *string
+
+	// (optional) the ip address of the request
+	IpAddress *string
+
+	// (optional) the user agent of the request
+	UserAgent *string
+
+	// (optional) additional metadata about the request
+	Metadata map[string]interface{}
+}
+
+type ListAuditLogsOpts struct {
+	// (optional) a list of actions to filter by
+	Actions []string
+
+	// (optional) the id of the actor to filter by
+	ActorId *string `validate:"omitnil,uuid"`
+
+	// (optional) the resource type to filter by
+	ResourceType *string
+
+	// (optional) the resource id to filter by
+	ResourceId *string
+
+	// (optional) only return entries created at or after this time
+	Since *time.Time
+
+	// (optional) only return entries created at or before this time
+	Until *time.Time
+
+	// (optional) number of entries to skip
+	Offset *int
+
+	// (optional) number of entries to return
+	Limit *int `validate:"omitnil,min=1,max=1000"`
+}
+
+type ListAuditLogsResult struct {
+	Rows  []*dbsqlc.TenantAuditLog
+	Count int
+}
+
+// AuditLogRepository stores an append-only record of the actions performed in a tenant. Entries cannot be
+// updated or deleted.
+type AuditLogRepository interface {
+	// CreateAuditLog records an action performed in the tenant
+	CreateAuditLog(ctx context.Context, tenantId string, opts *CreateAuditLogOpts) (*dbsqlc.TenantAuditLog, error)
+
+	// BufferAuditLog queues an action to be recorded in the tenant without waiting on the database. Queued
+	// entries are written in batches, and a batch which fails to write is retried.
+	BufferAuditLog(tenantId string, opts *CreateAuditLogOpts) error
+
+	// ListAuditLogs lists the actions performed in the tenant, most recent first
+	ListAuditLogs(ctx context.Context, tenantId string, opts *ListAuditLogsOpts) (*ListAuditLogsResult, error)
+}
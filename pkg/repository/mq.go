@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 )
@@ -11,6 +12,42 @@ type PubMessage struct {
 	Payload string
 }
 
+type CreateDeadLetterOpts struct {
+	// The name of the queue the message was read from
+	Queue string `validate:"required"`
+
+	// (optional) the tenant of the message
+	TenantId *string `validate:"omitnil,uuid"`
+
+	// The id of the message, which identifies the type of message
+	MessageId string `validate:"required"`
+
+	// The serialized message
+	Payload []byte `validate:"required"`
+
+	// (optional) the error returned by the last attempt to process the message
+	Error *string
+
+	// The number of times the message was retried
+	RetryCount int
+}
+
+type ListDeadLettersOpts struct {
+	// (optional) the ids of the dead letters to list
+	Ids []int64
+
+	// (optional) number of dead letters to skip
+	Offset *int
+
+	// (optional) number of dead letters to return
+	Limit *int
+}
+
+type ListDeadLettersResult struct {
+	Rows  []*dbsqlc.MessageQueueDeadLetter
+	Count int
+}
+
 type MessageQueueRepository interface {
 	// PubSub
 	Listen(ctx context.Context, name string, f func(ctx context.Context, notification *PubMessage) error) error
@@ -26,4 +63,20 @@ type MessageQueueRepository interface {
 	ReadMessages(ctx context.Context, queue string, qos int) ([]*dbsqlc.ReadMessagesRow, error)
 	AckMessage(ctx context.Context, id int64) error
 	CleanupMessageQueueItems(ctx context.Context) error
+
+	// RetryMessage returns an assigned message to its queue with a new payload, to be read after the delay.
+	RetryMessage(ctx context.Context, id int64, payload []byte, delay time.Duration) error
+
+	// Dead letters
+	CreateDeadLetter(ctx context.Context, opts *CreateDeadLetterOpts) error
+
+	// ListDeadLetters lists the dead letters of a tenant for the queue. A nil tenantId lists the dead letters
+	// of messages without a tenant.
+	ListDeadLetters(ctx context.Context, tenantId *string, queue string, opts *ListDeadLettersOpts) (*ListDeadLettersResult, error)
+
+	// DeleteDeadLetters deletes the dead letters with the given ids, or all dead letters for the queue if
+	// ids is empty. A nil tenantId deletes the dead letters of messages without a tenant. It returns the
+	// number of deleted dead letters.
+	DeleteDeadLetters(ctx context.Context, tenantId *string, queue string, ids []int64) (int64, error)
+	CleanupDeadLetters(ctx context.Context) error
 }
@@ -2,13 +2,19 @@ package repository
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"os"
+	"regexp/syntax"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 	lru "github.com/hashicorp/golang-lru/v2"
@@ -44,9 +50,227 @@ type ListTaskRunOpts struct {
 
 	AdditionalMetadata map[string]interface{}
 
+	// DisplayName filters by a case-insensitive substring of the task display name
+	DisplayName *string
+
+	// ErrorContains filters by a case-insensitive substring of any error message of the task
+	ErrorContains *string
+
+	// ErrorRegex filters by a regular expression matched against any error message of the task
+	ErrorRegex *string
+
+	// InputFilters must all match the task input
+	InputFilters []JSONFilter
+
+	// OutputFilters must all match the output of the task
+	OutputFilters []JSONFilter
+
 	Limit int64
 
 	Offset int64
+
+	// Cursor is the position to continue listing from. When set, Offset is ignored and the total count
+	// is not computed.
+	Cursor *ListCursor
+}
+
+// JSONFilter matches JSON documents where the value at Path is equal to Value. Value is a JSON scalar,
+// i.e. a string, float64, bool or nil.
+type JSONFilter struct {
+	Path []string
+
+	Value interface{}
+}
+
+// ParseJSONFilter parses a filter of the form `path:value`, where path is a dot-separated list of object
+// keys. The value is parsed as a JSON scalar if possible (`count:5`, `ok:true`, `status:"5"`), and is
+// otherwise treated as a string (`status:ok`).
+func ParseJSONFilter(filter string) (JSONFilter, error) {
+	path, value, ok := strings.Cut(filter, ":")
+
+	if !ok || path == "" {
+		return JSONFilter{}, fmt.Errorf("invalid filter %q: must be of the form path:value", filter)
+	}
+
+	keys := strings.Split(path, ".")
+
+	for _, key := range keys {
+		if key == "" {
+			return JSONFilter{}, fmt.Errorf("invalid filter %q: path contains an empty key", filter)
+		}
+	}
+
+	var parsed interface{}
+
+	if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+		parsed = value
+	}
+
+	switch parsed.(type) {
+	case string, float64, bool, nil:
+	default:
+		parsed = value
+	}
+
+	return JSONFilter{
+		Path:  keys,
+		Value: parsed,
+	}, nil
+}
+
+// ParseJSONFilters parses a list of filters with ParseJSONFilter. Filters which match the same path, or where
+// one path is a prefix of another (`a:1` and `a.b:2`), are rejected, since they can't all match the same
+// document.
+func ParseJSONFilters(filters []string) ([]JSONFilter, error) {
+	res := make([]JSONFilter, 0, len(filters))
+
+	for _, filter := range filters {
+		parsed, err := ParseJSONFilter(filter)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, parsed)
+	}
+
+	if err := validateJSONFilters(res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func validateJSONFilters(filters []JSONFilter) error {
+	for i, a := range filters {
+		for _, b := range filters[i+1:] {
+			shorter, longer := a.Path, b.Path
+
+			if len(shorter) > len(longer) {
+				shorter, longer = longer, shorter
+			}
+
+			if slices.Equal(shorter, longer[:len(shorter)]) {
+				return fmt.Errorf("conflicting filters on %s and %s", strings.Join(a.Path, "."), strings.Join(b.Path, "."))
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateErrorRegex checks that an error regex only uses syntax which has the same meaning in each OLAP
+// backend. Postgres matches it as a POSIX advanced regular expression, while ClickHouse and the in-memory
+// repository use RE2, so escapes other than \d, \s, \w, \n, \r, \t and escaped punctuation, groups other
+// than (...) and (?:...) and repetition counts above 255 are rejected.
+func ValidateErrorRegex(pattern string) error {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+
+	if err != nil {
+		return fmt.Errorf("invalid error regex: %w", err)
+	}
+
+	if err := validateRegexRepeats(re); err != nil {
+		return err
+	}
+
+	inClass := false
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\':
+			// the pattern was parsed, so an escape is always followed by another character
+			i++
+			escaped := rune(pattern[i])
+
+			allowed := "dswnrt"
+
+			if !inClass {
+				allowed += "DSW"
+			}
+
+			if (unicode.IsLetter(escaped) || unicode.IsDigit(escaped)) && !strings.ContainsRune(allowed, escaped) {
+				return fmt.Errorf("invalid error regex: unsupported escape \\%c", escaped)
+			}
+		case c == '[' && !inClass:
+			inClass = true
+
+			// a ] at the start of a class is a literal
+			if strings.HasPrefix(pattern[i+1:], "^") {
+				i++
+			}
+
+			if strings.HasPrefix(pattern[i+1:], "]") {
+				i++
+			}
+		case c == '[' && inClass && strings.HasPrefix(pattern[i:], "[:"):
+			// skip named classes such as [:alpha:]
+			if end := strings.Index(pattern[i+2:], ":]"); end >= 0 {
+				i += end + 3
+			}
+		case c == ']' && inClass:
+			inClass = false
+		case c == '(' && !inClass && strings.HasPrefix(pattern[i+1:], "?") && !strings.HasPrefix(pattern[i+1:], "?:"):
+			return fmt.Errorf("invalid error regex: flags and named groups are not supported")
+		}
+	}
+
+	return nil
+}
+
+func validateRegexRepeats(re *syntax.Regexp) error {
+	// the maximum repetition count in Postgres
+	const maxRepeat = 255
+
+	if re.Op == syntax.OpRepeat && (re.Min > maxRepeat || re.Max > maxRepeat) {
+		return fmt.Errorf("invalid error regex: repetition counts above %d are not supported", maxRepeat)
+	}
+
+	for _, sub := range re.Sub {
+		if err := validateRegexRepeats(sub); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// jsonFilterContainment returns a JSON document which contains all of the filters, for use with the
+// Postgres @> operator. It returns nil if there are no filters.
+func jsonFilterContainment(filters []JSONFilter) ([]byte, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+
+	if err := validateJSONFilters(filters); err != nil {
+		return nil, err
+	}
+
+	doc := make(map[string]interface{})
+
+	for _, filter := range filters {
+		curr := doc
+
+		for _, key := range filter.Path[:len(filter.Path)-1] {
+			next, ok := curr[key].(map[string]interface{})
+
+			if !ok {
+				next = make(map[string]interface{})
+				curr[key] = next
+			}
+
+			curr = next
+		}
+
+		curr[filter.Path[len(filter.Path)-1]] = filter.Value
+	}
+
+	return json.Marshal(doc)
+}
+
+// escapeLike escapes the wildcard characters of a LIKE pattern
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
 }
 
 type ListWorkflowRunOpts struct {
@@ -65,6 +289,57 @@ type ListWorkflowRunOpts struct {
 	Limit int64
 
 	Offset int64
+
+	// Cursor is the position to continue listing from. When set, Offset is ignored and the total count
+	// is not computed.
+	Cursor *ListCursor
+}
+
+// ListCursor is a keyset pagination cursor over (inserted_at, id). Listing with a cursor returns the rows
+// which come after it in (inserted_at DESC, id DESC) order.
+type ListCursor struct {
+	InsertedAt time.Time
+
+	ID int64
+}
+
+// Encode returns the opaque string representation of the cursor
+func (c ListCursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(fmt.Sprintf("%d:%d", c.InsertedAt.UnixMicro(), c.ID)),
+	)
+}
+
+// DecodeListCursor parses a cursor returned by ListCursor.Encode
+func DecodeListCursor(cursor string) (*ListCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	insertedAtStr, idStr, ok := strings.Cut(string(decoded), ":")
+
+	if !ok {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	insertedAt, err := strconv.ParseInt(insertedAtStr, 10, 64)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	return &ListCursor{
+		InsertedAt: time.UnixMicro(insertedAt).UTC(),
+		ID:         id,
+	}, nil
 }
 
 type ReadTaskRunMetricsOpts struct {
@@ -74,6 +349,7 @@ type ReadTaskRunMetricsOpts struct {
 }
 
 type WorkflowRunData struct {
+	ID                 int64                       `json:"id"`
 	TenantID           pgtype.UUID                 `json:"tenant_id"`
 	InsertedAt         pgtype.Timestamptz          `json:"inserted_at"`
 	ExternalID         pgtype.UUID                 `json:"external_id"`
@@ -95,6 +371,19 @@ type V2WorkflowRunPopulator struct {
 	TaskMetadata []TaskMetadata
 }
 
+// FinishedWorkflowRun is a DAG, or a task which isn't part of a DAG, which moved into a final status when
+// statuses were updated
+type FinishedWorkflowRun struct {
+	ExternalId pgtype.UUID
+	WorkflowId pgtype.UUID
+	Status     olapv2.V2ReadableStatusOlap
+}
+
+// FinishedWorkflowRunsHandler is called with the workflow runs which moved into a final status, before the
+// status updates which finished them are committed. If it returns an error, the status updates are rolled
+// back, so the same runs are reported again on the next update.
+type FinishedWorkflowRunsHandler func(ctx context.Context, runs []FinishedWorkflowRun) error
+
 type OLAPEventRepository interface {
 	UpdateTablePartitions(ctx context.Context) error
 	ReadTaskRun(ctx context.Context, taskExternalId string) (*olapv2.V2TasksOlap, error)
@@ -109,11 +398,24 @@ type OLAPEventRepository interface {
 	CreateTaskEvents(ctx context.Context, tenantId string, events []olapv2.CreateTaskEventsOLAPParams) error
 	CreateDAGs(ctx context.Context, tenantId string, dags []*v2.DAGWithData) error
 	GetTaskPointMetrics(ctx context.Context, tenantId string, startTimestamp *time.Time, endTimestamp *time.Time, bucketInterval time.Duration) ([]*olapv2.GetTaskPointMetricsRow, error)
-	UpdateTaskStatuses(ctx context.Context, tenantId string) (bool, error)
-	UpdateDAGStatuses(ctx context.Context, tenantId string) (bool, error)
+	UpdateTaskStatuses(ctx context.Context, tenantId string, onFinished FinishedWorkflowRunsHandler) (bool, error)
+	UpdateDAGStatuses(ctx context.Context, tenantId string, onFinished FinishedWorkflowRunsHandler) (bool, error)
+
+	// ReportsFinishedWorkflowRuns returns false if UpdateTaskStatuses and UpdateDAGStatuses never pass
+	// finished workflow runs to their handler, in which case workflow run webhook events can't be delivered.
+	ReportsFinishedWorkflowRuns() bool
+
 	ReadDAG(ctx context.Context, dagExternalId string) (*olapv2.V2DagsOlap, error)
 	ListTasksByDAGId(ctx context.Context, tenantId string, dagIds []pgtype.UUID) ([]*olapv2.PopulateTaskRunDataRow, map[int64]uuid.UUID, error)
 	ListTasksByIdAndInsertedAt(ctx context.Context, tenantId string, taskMetadata []TaskMetadata) ([]*olapv2.PopulateTaskRunDataRow, error)
+
+	// ListTasksByExternalIds lists the tasks of the tenant with the given external ids. External ids which
+	// don't belong to a task in the tenant are skipped.
+	ListTasksByExternalIds(ctx context.Context, tenantId string, externalIds []string) ([]*olapv2.PopulateTaskRunDataRow, error)
+
+	// ListWorkflowRunsByExternalIds lists the workflow runs of the tenant with the given external ids. External
+	// ids which don't belong to a DAG, or a task which isn't part of a DAG, in the tenant are skipped.
+	ListWorkflowRunsByExternalIds(ctx context.Context, tenantId string, externalIds []string) ([]*WorkflowRunData, error)
 }
 
 type olapEventRepository struct {
@@ -124,6 +426,8 @@ type olapEventRepository struct {
 	queries    *olapv2.Queries
 }
 
+// NewOLAPEventRepository creates an OLAPEventRepository which writes to the TimescaleDB instance at
+// TIMESCALE_URL. Use NewMemoryOLAPEventRepository for an in-memory repository.
 func NewOLAPEventRepository(l *zerolog.Logger) OLAPEventRepository {
 	timescaleUrl := os.Getenv("TIMESCALE_URL")
 
@@ -147,20 +451,31 @@ func NewOLAPEventRepository(l *zerolog.Logger) OLAPEventRepository {
 		log.Fatalf("Unable to create connection pool: %v\n", err)
 	}
 
-	eventCache, err := lru.New[string, bool](100000)
+	repo, err := NewTimescaleOLAPEventRepository(timescalePool, l)
 
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	return repo
+}
+
+// NewTimescaleOLAPEventRepository creates an OLAPEventRepository which writes to the given TimescaleDB pool.
+func NewTimescaleOLAPEventRepository(pool *pgxpool.Pool, l *zerolog.Logger) (OLAPEventRepository, error) {
+	eventCache, err := lru.New[string, bool](100000)
+
+	if err != nil {
+		return nil, err
+	}
+
 	queries := olapv2.New()
 
 	return &olapEventRepository{
-		pool:       timescalePool,
+		pool:       pool,
 		l:          l,
 		queries:    queries,
 		eventCache: eventCache,
-	}
+	}, nil
 }
 
 func (o *olapEventRepository) UpdateTablePartitions(ctx context.Context) error {
@@ -486,6 +801,42 @@ func (r *olapEventRepository) ListTasks(ctx context.Context, tenantId string, op
 		countParams.Values = append(countParams.Values, value.(string))
 	}
 
+	if opts.DisplayName != nil {
+		params.DisplayName = sqlchelpers.TextFromStr(escapeLike(*opts.DisplayName))
+		countParams.DisplayName = params.DisplayName
+	}
+
+	if opts.ErrorContains != nil {
+		params.ErrorContains = sqlchelpers.TextFromStr(escapeLike(*opts.ErrorContains))
+		countParams.ErrorContains = params.ErrorContains
+	}
+
+	if opts.ErrorRegex != nil {
+		params.ErrorRegex = sqlchelpers.TextFromStr(*opts.ErrorRegex)
+		countParams.ErrorRegex = params.ErrorRegex
+	}
+
+	params.InputContains, err = jsonFilterContainment(opts.InputFilters)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	params.OutputContains, err = jsonFilterContainment(opts.OutputFilters)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	countParams.InputContains = params.InputContains
+	countParams.OutputContains = params.OutputContains
+
+	if opts.Cursor != nil {
+		params.CursorInsertedAt = sqlchelpers.TimestamptzFromTime(opts.Cursor.InsertedAt)
+		params.CursorId = pgtype.Int8{Int64: opts.Cursor.ID, Valid: true}
+		params.Taskoffset = 0
+	}
+
 	rows, err := r.queries.ListTasks(ctx, tx, params)
 
 	if err != nil {
@@ -510,10 +861,14 @@ func (r *olapEventRepository) ListTasks(ctx context.Context, tenantId string, op
 		return nil, 0, err
 	}
 
-	count, err := r.queries.CountTasks(ctx, tx, countParams)
+	var count int64
 
-	if err != nil {
-		count = int64(len(tasksWithData))
+	if opts.Cursor == nil {
+		count, err = r.queries.CountTasks(ctx, tx, countParams)
+
+		if err != nil {
+			count = int64(len(tasksWithData))
+		}
 	}
 
 	if err := tx.Commit(ctx); err != nil {
@@ -605,6 +960,43 @@ func (r *olapEventRepository) ListTasksByIdAndInsertedAt(ctx context.Context, te
 	return tasksWithData, nil
 }
 
+func (r *olapEventRepository) ListTasksByExternalIds(ctx context.Context, tenantId string, externalIds []string) ([]*olapv2.PopulateTaskRunDataRow, error) {
+	ids := make([]pgtype.UUID, 0, len(externalIds))
+
+	for _, externalId := range externalIds {
+		ids = append(ids, sqlchelpers.UUIDFromStr(externalId))
+	}
+
+	rows, err := r.queries.ListTasksByExternalIds(ctx, r.pool, olapv2.ListTasksByExternalIdsParams{
+		Externalids: ids,
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	taskMetadata := make([]TaskMetadata, 0, len(rows))
+
+	for _, row := range rows {
+		// the lookup table also contains DAGs, which don't have a task id
+		if !row.TaskID.Valid {
+			continue
+		}
+
+		taskMetadata = append(taskMetadata, TaskMetadata{
+			TaskID:         row.TaskID.Int64,
+			TaskInsertedAt: row.InsertedAt.Time,
+		})
+	}
+
+	if len(taskMetadata) == 0 {
+		return []*olapv2.PopulateTaskRunDataRow{}, nil
+	}
+
+	return r.ListTasksByIdAndInsertedAt(ctx, tenantId, taskMetadata)
+}
+
 func (r *olapEventRepository) ListWorkflowRuns(ctx context.Context, tenantId string, opts ListWorkflowRunOpts) ([]*WorkflowRunData, int, error) {
 	tx, err := r.pool.Begin(ctx)
 
@@ -670,18 +1062,80 @@ func (r *olapEventRepository) ListWorkflowRuns(ctx context.Context, tenantId str
 		countParams.Values = append(countParams.Values, value.(string))
 	}
 
+	if opts.Cursor != nil {
+		params.CursorInsertedAt = sqlchelpers.TimestamptzFromTime(opts.Cursor.InsertedAt)
+		params.CursorId = pgtype.Int8{Int64: opts.Cursor.ID, Valid: true}
+		params.Listworkflowrunsoffset = 0
+	}
+
 	workflowRunIds, err := r.queries.FetchWorkflowRunIds(ctx, tx, params)
 
 	if err != nil {
 		return nil, 0, err
 	}
 
+	res, err := r.populateWorkflowRuns(ctx, tx, tenantId, workflowRunIds)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var count int64
+
+	if opts.Cursor == nil {
+		count, err = r.queries.CountWorkflowRuns(ctx, tx, countParams)
+
+		if err != nil {
+			r.l.Error().Msgf("error counting workflow runs: %v", err)
+			count = int64(len(workflowRunIds))
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	return res, int(count), nil
+}
+
+func (r *olapEventRepository) ListWorkflowRunsByExternalIds(ctx context.Context, tenantId string, externalIds []string) ([]*WorkflowRunData, error) {
+	ids := make([]pgtype.UUID, 0, len(externalIds))
+
+	for _, externalId := range externalIds {
+		ids = append(ids, sqlchelpers.UUIDFromStr(externalId))
+	}
+
+	rows, err := r.queries.ListWorkflowRunsByExternalIds(ctx, r.pool, olapv2.ListWorkflowRunsByExternalIdsParams{
+		Externalids: ids,
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*olapv2.FetchWorkflowRunIdsRow, 0, len(rows))
+
+	for _, row := range rows {
+		runs = append(runs, &olapv2.FetchWorkflowRunIdsRow{
+			ID:         row.ID,
+			InsertedAt: row.InsertedAt,
+			Kind:       row.Kind,
+			ExternalID: row.ExternalID,
+		})
+	}
+
+	return r.populateWorkflowRuns(ctx, r.pool, tenantId, runs)
+}
+
+// populateWorkflowRuns reads the DAG metadata and task data of runs, returning them in the same order.
+func (r *olapEventRepository) populateWorkflowRuns(ctx context.Context, db olapv2.DBTX, tenantId string, runs []*olapv2.FetchWorkflowRunIdsRow) ([]*WorkflowRunData, error) {
 	runIdsWithDAGs := make([]int64, 0)
 	runInsertedAtsWithDAGs := make([]pgtype.Timestamptz, 0)
 	runIdsWithTasks := make([]int64, 0)
 	runInsertedAtsWithTasks := make([]pgtype.Timestamptz, 0)
 
-	for _, row := range workflowRunIds {
+	for _, row := range runs {
 		if row.Kind == olapv2.V2RunKindDAG {
 			runIdsWithDAGs = append(runIdsWithDAGs, row.ID)
 			runInsertedAtsWithDAGs = append(runInsertedAtsWithDAGs, row.InsertedAt)
@@ -691,14 +1145,14 @@ func (r *olapEventRepository) ListWorkflowRuns(ctx context.Context, tenantId str
 		}
 	}
 
-	populatedDAGs, err := r.queries.PopulateDAGMetadata(ctx, tx, olapv2.PopulateDAGMetadataParams{
+	populatedDAGs, err := r.queries.PopulateDAGMetadata(ctx, db, olapv2.PopulateDAGMetadataParams{
 		Ids:         runIdsWithDAGs,
 		Insertedats: runInsertedAtsWithDAGs,
 		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
 	})
 
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
-		return nil, 0, err
+		return nil, err
 	}
 
 	dagsToPopulated := make(map[string]*olapv2.PopulateDAGMetadataRow)
@@ -709,14 +1163,14 @@ func (r *olapEventRepository) ListWorkflowRuns(ctx context.Context, tenantId str
 		dagsToPopulated[externalId] = dag
 	}
 
-	populatedTasks, err := r.queries.PopulateTaskRunData(ctx, tx, olapv2.PopulateTaskRunDataParams{
+	populatedTasks, err := r.queries.PopulateTaskRunData(ctx, db, olapv2.PopulateTaskRunDataParams{
 		Taskids:         runIdsWithTasks,
 		Taskinsertedats: runInsertedAtsWithTasks,
 		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
 	})
 
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
-		return nil, 0, err
+		return nil, err
 	}
 
 	tasksToPopulated := make(map[string]*olapv2.PopulateTaskRunDataRow)
@@ -726,20 +1180,9 @@ func (r *olapEventRepository) ListWorkflowRuns(ctx context.Context, tenantId str
 		tasksToPopulated[externalId] = task
 	}
 
-	count, err := r.queries.CountWorkflowRuns(ctx, tx, countParams)
-
-	if err != nil {
-		r.l.Error().Msgf("error counting workflow runs: %v", err)
-		count = int64(len(workflowRunIds))
-	}
-
-	if err := tx.Commit(ctx); err != nil {
-		return nil, 0, err
-	}
-
 	res := make([]*WorkflowRunData, 0)
 
-	for _, row := range workflowRunIds {
+	for _, row := range runs {
 		externalId := sqlchelpers.UUIDToStr(row.ExternalID)
 
 		if row.Kind == olapv2.V2RunKindDAG {
@@ -751,6 +1194,7 @@ func (r *olapEventRepository) ListWorkflowRuns(ctx context.Context, tenantId str
 			}
 
 			res = append(res, &WorkflowRunData{
+				ID:                 row.ID,
 				TenantID:           dag.TenantID,
 				InsertedAt:         dag.InsertedAt,
 				ExternalID:         dag.ExternalID,
@@ -774,6 +1218,7 @@ func (r *olapEventRepository) ListWorkflowRuns(ctx context.Context, tenantId str
 			}
 
 			res = append(res, &WorkflowRunData{
+				ID:                 row.ID,
 				TenantID:           task.TenantID,
 				InsertedAt:         task.InsertedAt,
 				ExternalID:         task.ExternalID,
@@ -790,7 +1235,7 @@ func (r *olapEventRepository) ListWorkflowRuns(ctx context.Context, tenantId str
 		}
 	}
 
-	return res, int(count), nil
+	return res, nil
 }
 
 func (r *olapEventRepository) ListTaskRunEvents(ctx context.Context, tenantId string, taskId int64, taskInsertedAt pgtype.Timestamptz, limit, offset int64) ([]*olapv2.ListTaskEventsRow, error) {
@@ -939,7 +1384,7 @@ func (r *olapEventRepository) writeTaskEventBatch(ctx context.Context, tenantId
 	return nil
 }
 
-func (r *olapEventRepository) UpdateTaskStatuses(ctx context.Context, tenantId string) (bool, error) {
+func (r *olapEventRepository) UpdateTaskStatuses(ctx context.Context, tenantId string, onFinished FinishedWorkflowRunsHandler) (bool, error) {
 	var limit int32 = 10000
 
 	// each partition gets its own goroutine
@@ -961,7 +1406,7 @@ func (r *olapEventRepository) UpdateTaskStatuses(ctx context.Context, tenantId s
 
 			defer rollback()
 
-			count, err := r.queries.UpdateTaskStatuses(ctx, tx, olapv2.UpdateTaskStatusesParams{
+			res, err := r.queries.UpdateTaskStatuses(ctx, tx, olapv2.UpdateTaskStatusesParams{
 				Partitionnumber: int32(partitionNumber), // nolint: gosec
 				Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
 				Eventlimit:      limit,
@@ -971,13 +1416,30 @@ func (r *olapEventRepository) UpdateTaskStatuses(ctx context.Context, tenantId s
 				return err
 			}
 
+			finished := make([]FinishedWorkflowRun, len(res.FinishedTaskExternalIds))
+
+			for i := range res.FinishedTaskExternalIds {
+				finished[i] = FinishedWorkflowRun{
+					ExternalId: res.FinishedTaskExternalIds[i],
+					WorkflowId: res.FinishedTaskWorkflowIds[i],
+					Status:     olapv2.V2ReadableStatusOlap(res.FinishedTaskStatuses[i]),
+				}
+			}
+
+			if onFinished != nil && len(finished) > 0 {
+				if err := onFinished(ctx, finished); err != nil {
+					return err
+				}
+			}
+
 			if err := commit(ctx); err != nil {
 				return err
 			}
 
 			mu.Lock()
-			isSaturated = isSaturated || count == int64(limit)
-			mu.Unlock()
+			defer mu.Unlock()
+
+			isSaturated = isSaturated || res.Count == int64(limit)
 
 			return nil
 		})
@@ -990,7 +1452,7 @@ func (r *olapEventRepository) UpdateTaskStatuses(ctx context.Context, tenantId s
 	return isSaturated, nil
 }
 
-func (r *olapEventRepository) UpdateDAGStatuses(ctx context.Context, tenantId string) (bool, error) {
+func (r *olapEventRepository) UpdateDAGStatuses(ctx context.Context, tenantId string, onFinished FinishedWorkflowRunsHandler) (bool, error) {
 	var limit int32 = 10000
 
 	// each partition gets its own goroutine
@@ -1012,7 +1474,7 @@ func (r *olapEventRepository) UpdateDAGStatuses(ctx context.Context, tenantId st
 
 			defer rollback()
 
-			count, err := r.queries.UpdateDAGStatuses(ctx, tx, olapv2.UpdateDAGStatusesParams{
+			res, err := r.queries.UpdateDAGStatuses(ctx, tx, olapv2.UpdateDAGStatusesParams{
 				Partitionnumber: int32(partitionNumber), // nolint: gosec
 				Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
 				Eventlimit:      limit,
@@ -1022,13 +1484,30 @@ func (r *olapEventRepository) UpdateDAGStatuses(ctx context.Context, tenantId st
 				return err
 			}
 
+			finished := make([]FinishedWorkflowRun, len(res.FinishedDagExternalIds))
+
+			for i := range res.FinishedDagExternalIds {
+				finished[i] = FinishedWorkflowRun{
+					ExternalId: res.FinishedDagExternalIds[i],
+					WorkflowId: res.FinishedDagWorkflowIds[i],
+					Status:     olapv2.V2ReadableStatusOlap(res.FinishedDagStatuses[i]),
+				}
+			}
+
+			if onFinished != nil && len(finished) > 0 {
+				if err := onFinished(ctx, finished); err != nil {
+					return err
+				}
+			}
+
 			if err := commit(ctx); err != nil {
 				return err
 			}
 
 			mu.Lock()
-			isSaturated = isSaturated || count == int64(limit)
-			mu.Unlock()
+			defer mu.Unlock()
+
+			isSaturated = isSaturated || res.Count == int64(limit)
 
 			return nil
 		})
@@ -1041,6 +1520,10 @@ func (r *olapEventRepository) UpdateDAGStatuses(ctx context.Context, tenantId st
 	return isSaturated, nil
 }
 
+func (r *olapEventRepository) ReportsFinishedWorkflowRuns() bool {
+	return true
+}
+
 func (r *olapEventRepository) writeTaskBatch(ctx context.Context, tenantId string, tasks []*sqlcv2.V2Task) error {
 	params := make([]olapv2.CreateTasksOLAPParams, 0)
 
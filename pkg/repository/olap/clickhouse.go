@@ -3,29 +3,47 @@ package olap
 import (
 	"crypto/tls"
 	"fmt"
-	"os"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
 )
 
-func CreateClickhouseConnection() (clickhouse.Conn, error) {
-	return clickhouse.Open(&clickhouse.Options{
-		Addr: []string{os.Getenv("CLICKHOUSE_SECURE_NATIVE_HOSTNAME") + ":9440"},
+type ClickhouseConnectionOpts struct {
+	Addrs    []string
+	Database string
+	Username string
+	Password string
+
+	// Secure enables TLS on the native protocol connection
+	Secure bool
+
+	MaxOpenConns int
+	MaxIdleConns int
+}
+
+func CreateClickhouseConnection(opts ClickhouseConnectionOpts) (clickhouse.Conn, error) {
+	if len(opts.Addrs) == 0 {
+		return nil, fmt.Errorf("at least one clickhouse address is required")
+	}
+
+	chOpts := &clickhouse.Options{
+		Addr: opts.Addrs,
 		Auth: clickhouse.Auth{
-			Database: "default",
-			Username: "default",
-			Password: os.Getenv("CLICKHOUSE_PASSWORD"),
-		},
-		Debugf: func(format string, v ...interface{}) {
-			fmt.Printf(format, v)
-		},
-		TLS: &tls.Config{
-			InsecureSkipVerify: true,
+			Database: opts.Database,
+			Username: opts.Username,
+			Password: opts.Password,
 		},
 		Protocol: clickhouse.Native,
 		// See docs on connection pooling with the Clickhouse Go client
 		// https://clickhouse.com/docs/en/integrations/go#connection-pooling
-		MaxIdleConns: 20,
-		MaxOpenConns: 40,
-	})
+		MaxIdleConns: opts.MaxIdleConns,
+		MaxOpenConns: opts.MaxOpenConns,
+	}
+
+	if opts.Secure {
+		chOpts.TLS = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return clickhouse.Open(chOpts)
 }
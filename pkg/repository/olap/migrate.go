@@ -0,0 +1,152 @@
+package olap
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/rs/zerolog"
+)
+
+//go:embed migrations/*.sql
+var clickhouseMigrations embed.FS
+
+const createMigrationsTable = `CREATE TABLE IF NOT EXISTS hatchet_olap_migrations (
+    version String,
+    applied_at DateTime64(3, 'UTC') DEFAULT now64(3)
+)
+ENGINE = ReplacingMergeTree
+ORDER BY version`
+
+type clickhouseMigration struct {
+	version    string
+	statements []string
+}
+
+// MigrateClickhouse applies any embedded migrations which have not yet been recorded in the
+// hatchet_olap_migrations table. Statements are written to be idempotent, so it is safe for
+// multiple engines to run migrations concurrently.
+func MigrateClickhouse(ctx context.Context, conn clickhouse.Conn, l *zerolog.Logger) error {
+	if err := conn.Exec(ctx, createMigrationsTable); err != nil {
+		return fmt.Errorf("could not create migrations table: %w", err)
+	}
+
+	applied, err := listAppliedMigrations(ctx, conn)
+
+	if err != nil {
+		return err
+	}
+
+	migrations, err := readClickhouseMigrations()
+
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range migrations {
+		if _, ok := applied[migration.version]; ok {
+			continue
+		}
+
+		l.Info().Msgf("applying clickhouse migration %s", migration.version)
+
+		for _, stmt := range migration.statements {
+			if err := conn.Exec(ctx, stmt); err != nil {
+				return fmt.Errorf("could not apply clickhouse migration %s: %w", migration.version, err)
+			}
+		}
+
+		err := conn.Exec(ctx, "INSERT INTO hatchet_olap_migrations (version) VALUES (?)", migration.version)
+
+		if err != nil {
+			return fmt.Errorf("could not record clickhouse migration %s: %w", migration.version, err)
+		}
+	}
+
+	return nil
+}
+
+func listAppliedMigrations(ctx context.Context, conn clickhouse.Conn) (map[string]struct{}, error) {
+	rows, err := conn.Query(ctx, "SELECT DISTINCT version FROM hatchet_olap_migrations")
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list applied migrations: %w", err)
+	}
+
+	defer rows.Close()
+
+	res := make(map[string]struct{})
+
+	for rows.Next() {
+		var version string
+
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+
+		res[version] = struct{}{}
+	}
+
+	return res, rows.Err()
+}
+
+func readClickhouseMigrations() ([]clickhouseMigration, error) {
+	entries, err := clickhouseMigrations.ReadDir("migrations")
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]clickhouseMigration, 0, len(entries))
+
+	for _, entry := range entries {
+		contents, err := clickhouseMigrations.ReadFile(path.Join("migrations", entry.Name()))
+
+		if err != nil {
+			return nil, err
+		}
+
+		version, _, _ := strings.Cut(entry.Name(), "_")
+
+		res = append(res, clickhouseMigration{
+			version:    version,
+			statements: splitStatements(string(contents)),
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].version < res[j].version
+	})
+
+	return res, nil
+}
+
+// splitStatements splits a migration file into individual statements, since the native protocol
+// only supports a single statement per query.
+func splitStatements(contents string) []string {
+	res := make([]string, 0)
+
+	for _, stmt := range strings.Split(contents, ";") {
+		lines := make([]string, 0)
+
+		for _, line := range strings.Split(stmt, "\n") {
+			if strings.HasPrefix(strings.TrimSpace(line), "--") {
+				continue
+			}
+
+			lines = append(lines, line)
+		}
+
+		stmt = strings.TrimSpace(strings.Join(lines, "\n"))
+
+		if stmt != "" {
+			res = append(res, stmt)
+		}
+	}
+
+	return res
+}
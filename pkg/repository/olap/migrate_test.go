@@ -0,0 +1,37 @@
+package olap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitStatements(t *testing.T) {
+	stmts := splitStatements(`-- a comment
+CREATE TABLE a (id Int64) ENGINE = MergeTree ORDER BY id;
+
+-- another comment
+CREATE TABLE b (id Int64) ENGINE = MergeTree ORDER BY id;
+`)
+
+	assert.Equal(t, []string{
+		"CREATE TABLE a (id Int64) ENGINE = MergeTree ORDER BY id",
+		"CREATE TABLE b (id Int64) ENGINE = MergeTree ORDER BY id",
+	}, stmts)
+}
+
+func TestReadClickhouseMigrations(t *testing.T) {
+	migrations, err := readClickhouseMigrations()
+
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+
+	for i, migration := range migrations {
+		assert.NotEmpty(t, migration.statements, "migration %s has no statements", migration.version)
+
+		if i > 0 {
+			assert.Less(t, migrations[i-1].version, migration.version)
+		}
+	}
+}
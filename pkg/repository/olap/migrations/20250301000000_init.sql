@@ -0,0 +1,119 @@
+-- TASKS DEFINITIONS --
+-- tasks are written once when they are created, and are deduplicated on (tenant_id, inserted_at, id) in
+-- case a batch is retried.
+CREATE TABLE IF NOT EXISTS v2_tasks_olap (
+    tenant_id UUID,
+    id Int64,
+    inserted_at DateTime64(6, 'UTC'),
+    external_id UUID,
+    queue String,
+    action_id String,
+    step_id UUID,
+    workflow_id UUID,
+    schedule_timeout String,
+    step_timeout Nullable(String),
+    priority Nullable(Int32),
+    sticky Enum8('NONE' = 1, 'SOFT' = 2, 'HARD' = 3),
+    desired_worker_id Nullable(UUID),
+    display_name String,
+    input String,
+    additional_metadata String,
+    dag_id Nullable(Int64),
+    dag_inserted_at Nullable(DateTime64(6, 'UTC')),
+
+    INDEX v2_tasks_olap_external_id_idx external_id TYPE bloom_filter GRANULARITY 4,
+    INDEX v2_tasks_olap_dag_id_idx dag_id TYPE bloom_filter GRANULARITY 4
+)
+ENGINE = ReplacingMergeTree
+PARTITION BY toDate(inserted_at)
+ORDER BY (tenant_id, inserted_at, id);
+
+-- DAG DEFINITIONS --
+CREATE TABLE IF NOT EXISTS v2_dags_olap (
+    tenant_id UUID,
+    id Int64,
+    inserted_at DateTime64(6, 'UTC'),
+    external_id UUID,
+    display_name String,
+    workflow_id UUID,
+    workflow_version_id UUID,
+    input String,
+    additional_metadata String,
+
+    INDEX v2_dags_olap_external_id_idx external_id TYPE bloom_filter GRANULARITY 4
+)
+ENGINE = ReplacingMergeTree
+PARTITION BY toDate(inserted_at)
+ORDER BY (tenant_id, inserted_at, id);
+
+-- EVENT DEFINITIONS --
+CREATE TABLE IF NOT EXISTS v2_task_events_olap (
+    tenant_id UUID,
+    id Int64 DEFAULT toInt64(intDiv(rand64(), 2)),
+    inserted_at DateTime64(6, 'UTC') DEFAULT now64(6),
+    task_id Int64,
+    task_inserted_at DateTime64(6, 'UTC'),
+    event_type Enum8(
+        'RETRYING' = 1,
+        'REASSIGNED' = 2,
+        'RETRIED_BY_USER' = 3,
+        'CREATED' = 4,
+        'QUEUED' = 5,
+        'REQUEUED_NO_WORKER' = 6,
+        'REQUEUED_RATE_LIMIT' = 7,
+        'ASSIGNED' = 8,
+        'ACKNOWLEDGED' = 9,
+        'SENT_TO_WORKER' = 10,
+        'SLOT_RELEASED' = 11,
+        'STARTED' = 12,
+        'TIMEOUT_REFRESHED' = 13,
+        'SCHEDULING_TIMED_OUT' = 14,
+        'FINISHED' = 15,
+        'FAILED' = 16,
+        'CANCELLED' = 17,
+        'TIMED_OUT' = 18,
+        'RATE_LIMIT_ERROR' = 19,
+        'SKIPPED' = 20
+    ),
+    workflow_id UUID,
+    event_timestamp DateTime64(6, 'UTC'),
+    -- the ordering of the statuses matters, the latest status of a task is the greatest status of its
+    -- latest retry
+    readable_status Enum8('QUEUED' = 1, 'RUNNING' = 2, 'COMPLETED' = 3, 'CANCELLED' = 4, 'FAILED' = 5),
+    retry_count Int32,
+    error_message Nullable(String),
+    output Nullable(String),
+    worker_id Nullable(UUID),
+    additional__event_data Nullable(String),
+    additional__event_message Nullable(String)
+)
+ENGINE = MergeTree
+PARTITION BY toDate(task_inserted_at)
+ORDER BY (tenant_id, task_inserted_at, task_id, retry_count, event_timestamp);
+
+-- STATUS DEFINITIONS --
+-- v2_task_statuses_olap stores the latest (retry_count, readable_status) tuple for each task, which is the
+-- readable status of the task. this replaces the status updates which are written back to the tasks table
+-- in Postgres.
+CREATE TABLE IF NOT EXISTS v2_task_statuses_olap (
+    tenant_id UUID,
+    task_id Int64,
+    task_inserted_at DateTime64(6, 'UTC'),
+    workflow_id SimpleAggregateFunction(any, UUID),
+    latest SimpleAggregateFunction(max, Tuple(Int32, Enum8('QUEUED' = 1, 'RUNNING' = 2, 'COMPLETED' = 3, 'CANCELLED' = 4, 'FAILED' = 5)))
+)
+ENGINE = AggregatingMergeTree
+PARTITION BY toDate(task_inserted_at)
+ORDER BY (tenant_id, task_inserted_at, task_id);
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS v2_task_statuses_olap_mv TO v2_task_statuses_olap AS
+SELECT
+    tenant_id,
+    task_id,
+    task_inserted_at,
+    any(workflow_id) AS workflow_id,
+    max((retry_count, readable_status)) AS latest
+FROM
+    v2_task_events_olap
+GROUP BY
+    tenant_id, task_id, task_inserted_at;
@@ -0,0 +1,9 @@
+-- skip indexes which back searching tasks by display name and error message. these are n-gram bloom
+-- filters, which can be used by LIKE and match() conditions on the indexed columns.
+ALTER TABLE v2_tasks_olap ADD INDEX IF NOT EXISTS v2_tasks_olap_display_name_idx lower(display_name) TYPE ngrambf_v1(3, 8192, 3, 0) GRANULARITY 4;
+
+ALTER TABLE v2_task_events_olap ADD INDEX IF NOT EXISTS v2_task_events_olap_error_message_idx lower(assumeNotNull(error_message)) TYPE ngrambf_v1(3, 8192, 3, 0) GRANULARITY 4;
+
+ALTER TABLE v2_tasks_olap MATERIALIZE INDEX v2_tasks_olap_display_name_idx;
+
+ALTER TABLE v2_task_events_olap MATERIALIZE INDEX v2_task_events_olap_error_message_idx;
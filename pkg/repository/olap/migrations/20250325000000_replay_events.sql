@@ -0,0 +1,26 @@
+-- add the event types which are written when a DAG is replayed from a task. appending values to an
+-- Enum8 only changes the column metadata, so existing parts are not rewritten.
+ALTER TABLE v2_task_events_olap MODIFY COLUMN event_type Enum8(
+    'RETRYING' = 1,
+    'REASSIGNED' = 2,
+    'RETRIED_BY_USER' = 3,
+    'CREATED' = 4,
+    'QUEUED' = 5,
+    'REQUEUED_NO_WORKER' = 6,
+    'REQUEUED_RATE_LIMIT' = 7,
+    'ASSIGNED' = 8,
+    'ACKNOWLEDGED' = 9,
+    'SENT_TO_WORKER' = 10,
+    'SLOT_RELEASED' = 11,
+    'STARTED' = 12,
+    'TIMEOUT_REFRESHED' = 13,
+    'SCHEDULING_TIMED_OUT' = 14,
+    'FINISHED' = 15,
+    'FAILED' = 16,
+    'CANCELLED' = 17,
+    'TIMED_OUT' = 18,
+    'RATE_LIMIT_ERROR' = 19,
+    'SKIPPED' = 20,
+    'REPLAYED' = 21,
+    'RESET_BY_REPLAY' = 22
+);
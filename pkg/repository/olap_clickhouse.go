@@ -0,0 +1,1955 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/olap"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/olapv2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+// the number of days of data which are retained in ClickHouse, matching the partition retention in
+// TimescaleDB
+const clickhouseRetentionDays = 7
+
+// clickhouseOLAPEventRepository is an OLAPEventRepository backed by ClickHouse. Rows are never
+// updated in place: task statuses are derived from the task events (via the v2_task_statuses_olap
+// materialized view) and DAG statuses are derived from the statuses of their tasks at query time.
+type clickhouseOLAPEventRepository struct {
+	conn clickhouse.Conn
+	l    *zerolog.Logger
+
+	eventCache *lru.Cache[string, bool]
+}
+
+func NewClickhouseOLAPEventRepository(conn clickhouse.Conn, l *zerolog.Logger) (OLAPEventRepository, error) {
+	eventCache, err := lru.New[string, bool](100000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &clickhouseOLAPEventRepository{
+		conn:       conn,
+		l:          l,
+		eventCache: eventCache,
+	}, nil
+}
+
+type chTask struct {
+	TenantID           uuid.UUID  `ch:"tenant_id"`
+	ID                 int64      `ch:"id"`
+	InsertedAt         time.Time  `ch:"inserted_at"`
+	ExternalID         uuid.UUID  `ch:"external_id"`
+	Queue              string     `ch:"queue"`
+	ActionID           string     `ch:"action_id"`
+	StepID             uuid.UUID  `ch:"step_id"`
+	WorkflowID         uuid.UUID  `ch:"workflow_id"`
+	ScheduleTimeout    string     `ch:"schedule_timeout"`
+	StepTimeout        *string    `ch:"step_timeout"`
+	Priority           *int32     `ch:"priority"`
+	Sticky             string     `ch:"sticky"`
+	DesiredWorkerID    *uuid.UUID `ch:"desired_worker_id"`
+	DisplayName        string     `ch:"display_name"`
+	Input              string     `ch:"input"`
+	AdditionalMetadata string     `ch:"additional_metadata"`
+	DagID              *int64     `ch:"dag_id"`
+	DagInsertedAt      *time.Time `ch:"dag_inserted_at"`
+}
+
+const chTaskColumns = `tenant_id, id, inserted_at, external_id, queue, action_id, step_id, workflow_id, schedule_timeout,
+    step_timeout, priority, toString(sticky) AS sticky, desired_worker_id, display_name, input, additional_metadata,
+    dag_id, dag_inserted_at`
+
+type chDAG struct {
+	TenantID           uuid.UUID `ch:"tenant_id"`
+	ID                 int64     `ch:"id"`
+	InsertedAt         time.Time `ch:"inserted_at"`
+	ExternalID         uuid.UUID `ch:"external_id"`
+	DisplayName        string    `ch:"display_name"`
+	WorkflowID         uuid.UUID `ch:"workflow_id"`
+	WorkflowVersionID  uuid.UUID `ch:"workflow_version_id"`
+	Input              string    `ch:"input"`
+	AdditionalMetadata string    `ch:"additional_metadata"`
+}
+
+const chDAGColumns = `tenant_id, id, inserted_at, external_id, display_name, workflow_id, workflow_version_id, input,
+    additional_metadata`
+
+// chTaskStatus is the status of a task computed from the events of its latest retry
+type chTaskStatus struct {
+	TaskID         int64     `ch:"task_id"`
+	RetryCount     int32     `ch:"retry_count"`
+	ReadableStatus string    `ch:"readable_status"`
+	StartedAt      time.Time `ch:"started_at"`
+	FinishedAt     time.Time `ch:"finished_at"`
+	ErrorMessage   string    `ch:"error_message"`
+	Output         string    `ch:"output"`
+	LatestWorkerID string    `ch:"latest_worker_id"`
+}
+
+type chDAGMetadata struct {
+	DagID        int64     `ch:"dag_id"`
+	CreatedAt    time.Time `ch:"created_at"`
+	StartedAt    time.Time `ch:"started_at"`
+	FinishedAt   time.Time `ch:"finished_at"`
+	ErrorMessage string    `ch:"error_message"`
+}
+
+type chRun struct {
+	ID             int64     `ch:"id"`
+	InsertedAt     time.Time `ch:"inserted_at"`
+	Kind           string    `ch:"kind"`
+	ExternalID     uuid.UUID `ch:"external_id"`
+	ReadableStatus string    `ch:"readable_status"`
+}
+
+type chTaskEvent struct {
+	TenantID               uuid.UUID `ch:"tenant_id"`
+	TaskID                 int64     `ch:"task_id"`
+	TaskInsertedAt         time.Time `ch:"task_inserted_at"`
+	RetryCount             int32     `ch:"retry_count"`
+	EventType              string    `ch:"event_type_str"`
+	TimeFirstSeen          time.Time `ch:"time_first_seen"`
+	TimeLastSeen           time.Time `ch:"time_last_seen"`
+	Count                  uint64    `ch:"event_count"`
+	ID                     int64     `ch:"first_id"`
+	ReadableStatus         string    `ch:"first_readable_status"`
+	ErrorMessage           string    `ch:"first_error_message"`
+	Output                 string    `ch:"first_output"`
+	WorkerID               string    `ch:"first_worker_id"`
+	AdditionalEventData    string    `ch:"first_event_data"`
+	AdditionalEventMessage string    `ch:"first_event_message"`
+}
+
+// chTaskStatusesQuery selects the latest readable status of each task inserted after @since. Tasks without
+// any events do not have a row, and should be treated as QUEUED.
+const chTaskStatusesQuery = `SELECT
+        task_id,
+        task_inserted_at,
+        toString(tupleElement(max(latest), 2)) AS readable_status
+    FROM
+        v2_task_statuses_olap
+    WHERE
+        tenant_id = @tenantId
+        AND task_inserted_at >= @since
+    GROUP BY
+        task_id, task_inserted_at`
+
+// chDAGStatusExpr derives the readable status of a DAG from the counts of its task statuses, using the
+// same rules as the DAG status updates in Postgres.
+const chDAGStatusExpr = `multiIf(
+        c.task_count = 0 OR c.queued_count = c.task_count, 'QUEUED',
+        c.running_count > 0 OR c.queued_count > 0, 'RUNNING',
+        c.failed_count > 0, 'FAILED',
+        c.cancelled_count > 0, 'CANCELLED',
+        c.completed_count = c.task_count, 'COMPLETED',
+        'RUNNING'
+    )`
+
+// chRunsQuery is a CTE which selects all runs (tasks which don't belong to a DAG, and DAGs) inserted after
+// @since, along with their readable status.
+const chRunsQuery = `WITH task_statuses AS (
+    ` + chTaskStatusesQuery + `
+), tasks AS (
+    SELECT
+        t.id AS id,
+        t.inserted_at AS inserted_at,
+        t.external_id AS external_id,
+        t.workflow_id AS workflow_id,
+        t.additional_metadata AS additional_metadata,
+        t.dag_id AS dag_id,
+        t.dag_inserted_at AS dag_inserted_at,
+        if(s.readable_status = '', 'QUEUED', s.readable_status) AS readable_status
+    FROM
+        v2_tasks_olap AS t FINAL
+    LEFT JOIN
+        task_statuses AS s ON s.task_id = t.id AND s.task_inserted_at = t.inserted_at
+    WHERE
+        t.tenant_id = @tenantId
+        AND t.inserted_at >= @since
+), dag_task_counts AS (
+    SELECT
+        assumeNotNull(dag_id) AS dag_id,
+        assumeNotNull(dag_inserted_at) AS dag_inserted_at,
+        count() AS task_count,
+        countIf(readable_status = 'QUEUED') AS queued_count,
+        countIf(readable_status = 'RUNNING') AS running_count,
+        countIf(readable_status = 'COMPLETED') AS completed_count,
+        countIf(readable_status = 'CANCELLED') AS cancelled_count,
+        countIf(readable_status = 'FAILED') AS failed_count
+    FROM
+        tasks
+    WHERE
+        dag_id IS NOT NULL
+    GROUP BY
+        dag_id, dag_inserted_at
+), runs AS (
+    SELECT
+        id,
+        inserted_at,
+        'TASK' AS kind,
+        external_id,
+        workflow_id,
+        additional_metadata,
+        readable_status
+    FROM
+        tasks
+    WHERE
+        dag_id IS NULL
+    UNION ALL
+    SELECT
+        d.id AS id,
+        d.inserted_at AS inserted_at,
+        'DAG' AS kind,
+        d.external_id AS external_id,
+        d.workflow_id AS workflow_id,
+        d.additional_metadata AS additional_metadata,
+        ` + chDAGStatusExpr + ` AS readable_status
+    FROM
+        v2_dags_olap AS d FINAL
+    LEFT JOIN
+        dag_task_counts AS c ON c.dag_id = d.id AND c.dag_inserted_at = d.inserted_at
+    WHERE
+        d.tenant_id = @tenantId
+        AND d.inserted_at >= @since
+)
+`
+
+func (r *clickhouseOLAPEventRepository) UpdateTablePartitions(ctx context.Context) error {
+	// the engine owns the ClickHouse schema, so migrations are applied along with the partition maintenance
+	if err := olap.MigrateClickhouse(ctx, r.conn, r.l); err != nil {
+		return err
+	}
+
+	before := time.Now().UTC().AddDate(0, 0, -clickhouseRetentionDays)
+
+	for _, table := range []string{"v2_tasks_olap", "v2_dags_olap", "v2_task_events_olap", "v2_task_statuses_olap"} {
+		var partitionIds []struct {
+			PartitionID string `ch:"partition_id"`
+		}
+
+		err := r.conn.Select(
+			ctx,
+			&partitionIds,
+			`SELECT DISTINCT partition_id
+			FROM system.parts
+			WHERE database = currentDatabase() AND table = @table AND active AND max_date < @before`,
+			clickhouse.Named("table", table),
+			clickhouse.Named("before", before),
+		)
+
+		if err != nil {
+			return fmt.Errorf("could not list partitions for %s: %w", table, err)
+		}
+
+		for _, partition := range partitionIds {
+			err := r.conn.Exec(ctx, fmt.Sprintf("ALTER TABLE %s DROP PARTITION ID '%s'", table, partition.PartitionID))
+
+			if err != nil {
+				return fmt.Errorf("could not drop partition %s of %s: %w", partition.PartitionID, table, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *clickhouseOLAPEventRepository) ReadTaskRun(ctx context.Context, taskExternalId string) (*olapv2.V2TasksOlap, error) {
+	var tasks []chTask
+
+	err := r.conn.Select(
+		ctx,
+		&tasks,
+		`SELECT `+chTaskColumns+` FROM v2_tasks_olap FINAL WHERE external_id = @externalId LIMIT 1`,
+		clickhouse.Named("externalId", taskExternalId),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tasks) == 0 {
+		return nil, pgx.ErrNoRows
+	}
+
+	task := tasks[0]
+
+	statuses, err := r.listTaskStatuses(ctx, task.TenantID.String(), []int64{task.ID}, task.InsertedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	status := statuses[task.ID]
+
+	return &olapv2.V2TasksOlap{
+		TenantID:           chUUID(task.TenantID),
+		ID:                 task.ID,
+		InsertedAt:         chTimestamptz(task.InsertedAt),
+		ExternalID:         chUUID(task.ExternalID),
+		Queue:              task.Queue,
+		ActionID:           task.ActionID,
+		StepID:             chUUID(task.StepID),
+		WorkflowID:         chUUID(task.WorkflowID),
+		ScheduleTimeout:    task.ScheduleTimeout,
+		StepTimeout:        chNullText(task.StepTimeout),
+		Priority:           chNullInt4(task.Priority),
+		Sticky:             olapv2.V2StickyStrategyOlap(task.Sticky),
+		DesiredWorkerID:    chNullUUID(task.DesiredWorkerID),
+		DisplayName:        task.DisplayName,
+		Input:              chBytes(task.Input),
+		AdditionalMetadata: chBytes(task.AdditionalMetadata),
+		ReadableStatus:     olapv2.V2ReadableStatusOlap(status.ReadableStatus),
+		LatestRetryCount:   status.RetryCount,
+		LatestWorkerID:     chUUIDFromStr(status.LatestWorkerID),
+		DagID:              chNullInt8(task.DagID),
+		DagInsertedAt:      chNullTimestamptz(task.DagInsertedAt),
+	}, nil
+}
+
+func (r *clickhouseOLAPEventRepository) ReadWorkflowRun(ctx context.Context, workflowRunExternalId pgtype.UUID) (*V2WorkflowRunPopulator, error) {
+	dag, err := r.readDAGByExternalId(ctx, sqlchelpers.UUIDToStr(workflowRunExternalId))
+
+	if err != nil {
+		return nil, err
+	}
+
+	tenantId := dag.TenantID.String()
+
+	statuses, err := r.listDAGStatuses(ctx, tenantId, []int64{dag.ID}, dag.InsertedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := r.listDAGMetadata(ctx, tenantId, []int64{dag.ID}, dag.InsertedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []struct {
+		ID         int64     `ch:"id"`
+		InsertedAt time.Time `ch:"inserted_at"`
+	}
+
+	err = r.conn.Select(
+		ctx,
+		&tasks,
+		`SELECT id, inserted_at
+		FROM v2_tasks_olap FINAL
+		WHERE tenant_id = @tenantId AND inserted_at >= @since AND dag_id = @dagId AND dag_inserted_at = @dagInsertedAt`,
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.DateNamed("since", dag.InsertedAt, clickhouse.MicroSeconds),
+		clickhouse.Named("dagId", dag.ID),
+		clickhouse.DateNamed("dagInsertedAt", dag.InsertedAt, clickhouse.MicroSeconds),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	taskMetadata := make([]TaskMetadata, 0, len(tasks))
+
+	for _, task := range tasks {
+		taskMetadata = append(taskMetadata, TaskMetadata{
+			TaskID:         task.ID,
+			TaskInsertedAt: task.InsertedAt,
+		})
+	}
+
+	workflowRun := &WorkflowRunData{
+		TenantID:           chUUID(dag.TenantID),
+		InsertedAt:         chTimestamptz(dag.InsertedAt),
+		ExternalID:         chUUID(dag.ExternalID),
+		ReadableStatus:     olapv2.V2ReadableStatusOlap(statuses[dag.ID]),
+		Kind:               olapv2.V2RunKindDAG,
+		WorkflowID:         chUUID(dag.WorkflowID),
+		DisplayName:        dag.DisplayName,
+		AdditionalMetadata: chBytes(dag.AdditionalMetadata),
+		WorkflowVersionId:  chUUID(dag.WorkflowVersionID),
+		Input:              chBytes(dag.Input),
+	}
+
+	if m, ok := metadata[dag.ID]; ok {
+		workflowRun.CreatedAt = chTimestamptz(m.CreatedAt)
+		workflowRun.StartedAt = chTimestamptz(m.StartedAt)
+		workflowRun.FinishedAt = chTimestamptz(m.FinishedAt)
+		workflowRun.ErrorMessage = m.ErrorMessage
+	}
+
+	return &V2WorkflowRunPopulator{
+		WorkflowRun:  workflowRun,
+		TaskMetadata: taskMetadata,
+	}, nil
+}
+
+func (r *clickhouseOLAPEventRepository) ReadTaskRunData(ctx context.Context, tenantId pgtype.UUID, taskId int64, taskInsertedAt pgtype.Timestamptz) (*olapv2.PopulateSingleTaskRunDataRow, *pgtype.UUID, error) {
+	tenantIdStr := sqlchelpers.UUIDToStr(tenantId)
+
+	var tasks []chTask
+
+	err := r.conn.Select(
+		ctx,
+		&tasks,
+		`SELECT `+chTaskColumns+`
+		FROM v2_tasks_olap FINAL
+		WHERE tenant_id = @tenantId AND inserted_at = @insertedAt AND id = @taskId`,
+		clickhouse.Named("tenantId", tenantIdStr),
+		clickhouse.DateNamed("insertedAt", taskInsertedAt.Time, clickhouse.MicroSeconds),
+		clickhouse.Named("taskId", taskId),
+	)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(tasks) == 0 {
+		return nil, nil, pgx.ErrNoRows
+	}
+
+	task := tasks[0]
+
+	statuses, err := r.listTaskStatuses(ctx, tenantIdStr, []int64{task.ID}, task.InsertedAt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := statuses[task.ID]
+
+	workflowRunId := chUUID(task.ExternalID)
+
+	if task.DagID != nil && task.DagInsertedAt != nil {
+		var dags []chDAG
+
+		err := r.conn.Select(
+			ctx,
+			&dags,
+			`SELECT `+chDAGColumns+`
+			FROM v2_dags_olap FINAL
+			WHERE tenant_id = @tenantId AND inserted_at = @dagInsertedAt AND id = @dagId`,
+			clickhouse.Named("tenantId", tenantIdStr),
+			clickhouse.DateNamed("dagInsertedAt", *task.DagInsertedAt, clickhouse.MicroSeconds),
+			clickhouse.Named("dagId", *task.DagID),
+		)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(dags) == 0 {
+			return nil, nil, pgx.ErrNoRows
+		}
+
+		workflowRunId = chUUID(dags[0].ExternalID)
+	}
+
+	return &olapv2.PopulateSingleTaskRunDataRow{
+		TenantID:           chUUID(task.TenantID),
+		ID:                 task.ID,
+		InsertedAt:         chTimestamptz(task.InsertedAt),
+		ExternalID:         chUUID(task.ExternalID),
+		Queue:              task.Queue,
+		ActionID:           task.ActionID,
+		StepID:             chUUID(task.StepID),
+		WorkflowID:         chUUID(task.WorkflowID),
+		ScheduleTimeout:    task.ScheduleTimeout,
+		StepTimeout:        chNullText(task.StepTimeout),
+		Priority:           chNullInt4(task.Priority),
+		Sticky:             olapv2.V2StickyStrategyOlap(task.Sticky),
+		DesiredWorkerID:    chNullUUID(task.DesiredWorkerID),
+		DisplayName:        task.DisplayName,
+		Input:              chBytes(task.Input),
+		AdditionalMetadata: chBytes(task.AdditionalMetadata),
+		ReadableStatus:     olapv2.V2ReadableStatusOlap(status.ReadableStatus),
+		LatestRetryCount:   status.RetryCount,
+		LatestWorkerID:     chUUIDFromStr(status.LatestWorkerID),
+		DagID:              chNullInt8(task.DagID),
+		DagInsertedAt:      chNullTimestamptz(task.DagInsertedAt),
+		Status:             olapv2.V2ReadableStatusOlap(status.ReadableStatus),
+		FinishedAt:         chTimestamptz(status.FinishedAt),
+		StartedAt:          chTimestamptz(status.StartedAt),
+		Output:             chBytes(status.Output),
+		ErrorMessage:       chText(status.ErrorMessage),
+	}, &workflowRunId, nil
+}
+
+func (r *clickhouseOLAPEventRepository) ListTasks(ctx context.Context, tenantId string, opts ListTaskRunOpts) ([]*olapv2.PopulateTaskRunDataRow, int, error) {
+	conds := []string{"has(@statuses, if(s.readable_status = '', 'QUEUED', s.readable_status))"}
+
+	args := []any{
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.DateNamed("since", opts.CreatedAfter, clickhouse.MicroSeconds),
+		clickhouse.Named("statuses", chStatuses(opts.Statuses)),
+	}
+
+	if len(opts.WorkflowIds) > 0 {
+		conds = append(conds, "has(@workflowIds, toString(t.workflow_id))")
+		args = append(args, clickhouse.Named("workflowIds", chUUIDStrs(opts.WorkflowIds)))
+	}
+
+	if opts.FinishedBefore != nil {
+		conds = append(conds, "t.inserted_at <= @until")
+		args = append(args, clickhouse.DateNamed("until", *opts.FinishedBefore, clickhouse.MicroSeconds))
+	}
+
+	if opts.WorkerId != nil {
+		conds = append(conds, `t.id IN (
+			SELECT task_id
+			FROM v2_task_events_olap
+			WHERE tenant_id = @tenantId AND task_inserted_at >= @since AND worker_id IS NOT NULL
+			GROUP BY task_id
+			HAVING argMax(toString(worker_id), (retry_count, event_timestamp)) = @workerId
+		)`)
+		args = append(args, clickhouse.Named("workerId", opts.WorkerId.String()))
+	}
+
+	if len(opts.AdditionalMetadata) > 0 {
+		cond, metadataArgs := chAdditionalMetadataFilter("t.additional_metadata", opts.AdditionalMetadata)
+		conds = append(conds, cond)
+		args = append(args, metadataArgs...)
+	}
+
+	if opts.DisplayName != nil {
+		conds = append(conds, "lower(t.display_name) LIKE @displayName")
+		args = append(args, clickhouse.Named("displayName", "%"+escapeLike(strings.ToLower(*opts.DisplayName))+"%"))
+	}
+
+	if len(opts.InputFilters) > 0 {
+		cond, filterArgs, err := chJSONFilter("t.input", "input", opts.InputFilters)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		conds = append(conds, cond)
+		args = append(args, filterArgs...)
+	}
+
+	eventConds := make([]string, 0)
+
+	if opts.ErrorContains != nil {
+		eventConds = append(eventConds, "lower(assumeNotNull(error_message)) LIKE @errorContains")
+		args = append(args, clickhouse.Named("errorContains", "%"+escapeLike(strings.ToLower(*opts.ErrorContains))+"%"))
+	}
+
+	if opts.ErrorRegex != nil {
+		eventConds = append(eventConds, "match(assumeNotNull(error_message), @errorRegex)")
+		args = append(args, clickhouse.Named("errorRegex", *opts.ErrorRegex))
+	}
+
+	if len(opts.OutputFilters) > 0 {
+		cond, filterArgs, err := chJSONFilter("assumeNotNull(output)", "output", opts.OutputFilters)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		eventConds = append(eventConds, "output IS NOT NULL AND "+cond)
+		args = append(args, filterArgs...)
+	}
+
+	// each event condition may be matched by a different event of the task
+	for _, cond := range eventConds {
+		conds = append(conds, `t.id IN (
+			SELECT task_id
+			FROM v2_task_events_olap
+			WHERE tenant_id = @tenantId AND task_inserted_at >= @since AND `+cond+`
+		)`)
+	}
+
+	offset := opts.Offset
+
+	if opts.Cursor != nil {
+		conds = append(conds, "(t.inserted_at, t.id) < (@cursorInsertedAt, @cursorId)")
+		args = append(
+			args,
+			clickhouse.DateNamed("cursorInsertedAt", opts.Cursor.InsertedAt, clickhouse.MicroSeconds),
+			clickhouse.Named("cursorId", opts.Cursor.ID),
+		)
+		offset = 0
+	}
+
+	filtered := `SELECT
+            t.id AS id,
+            t.inserted_at AS inserted_at
+        FROM
+            v2_tasks_olap AS t FINAL
+        LEFT JOIN
+            (` + chTaskStatusesQuery + `) AS s ON s.task_id = t.id AND s.task_inserted_at = t.inserted_at
+        WHERE
+            t.tenant_id = @tenantId
+            AND t.inserted_at >= @since
+            AND ` + strings.Join(conds, "\n            AND ") + `
+        ORDER BY
+            t.inserted_at DESC, t.id DESC`
+
+	var rows []struct {
+		ID         int64     `ch:"id"`
+		InsertedAt time.Time `ch:"inserted_at"`
+	}
+
+	err := r.conn.Select(
+		ctx,
+		&rows,
+		filtered+"\nLIMIT @taskLimit OFFSET @taskOffset",
+		append(
+			args,
+			clickhouse.Named("taskLimit", opts.Limit),
+			clickhouse.Named("taskOffset", offset),
+		)...,
+	)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	taskMetadata := make([]TaskMetadata, 0, len(rows))
+
+	for _, row := range rows {
+		taskMetadata = append(taskMetadata, TaskMetadata{
+			TaskID:         row.ID,
+			TaskInsertedAt: row.InsertedAt,
+		})
+	}
+
+	tasksWithData, err := r.listTasksWithData(ctx, tenantId, taskMetadata)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var count uint64
+
+	if opts.Cursor == nil {
+		err = r.conn.QueryRow(ctx, "SELECT count() FROM ("+filtered+"\nLIMIT 20000)", args...).Scan(&count)
+
+		if err != nil {
+			r.l.Error().Msgf("error counting tasks: %v", err)
+			count = uint64(len(tasksWithData))
+		}
+	}
+
+	return tasksWithData, int(count), nil // nolint: gosec
+}
+
+func (r *clickhouseOLAPEventRepository) ListWorkflowRuns(ctx context.Context, tenantId string, opts ListWorkflowRunOpts) ([]*WorkflowRunData, int, error) {
+	conds := []string{"has(@statuses, readable_status)"}
+
+	args := []any{
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.DateNamed("since", opts.CreatedAfter, clickhouse.MicroSeconds),
+		clickhouse.Named("statuses", chStatuses(opts.Statuses)),
+	}
+
+	if len(opts.WorkflowIds) > 0 {
+		conds = append(conds, "has(@workflowIds, toString(workflow_id))")
+		args = append(args, clickhouse.Named("workflowIds", chUUIDStrs(opts.WorkflowIds)))
+	}
+
+	if opts.FinishedBefore != nil {
+		conds = append(conds, "inserted_at <= @until")
+		args = append(args, clickhouse.DateNamed("until", *opts.FinishedBefore, clickhouse.MicroSeconds))
+	}
+
+	if len(opts.AdditionalMetadata) > 0 {
+		cond, metadataArgs := chAdditionalMetadataFilter("additional_metadata", opts.AdditionalMetadata)
+		conds = append(conds, cond)
+		args = append(args, metadataArgs...)
+	}
+
+	offset := opts.Offset
+
+	if opts.Cursor != nil {
+		conds = append(conds, "(inserted_at, id) < (@cursorInsertedAt, @cursorId)")
+		args = append(
+			args,
+			clickhouse.DateNamed("cursorInsertedAt", opts.Cursor.InsertedAt, clickhouse.MicroSeconds),
+			clickhouse.Named("cursorId", opts.Cursor.ID),
+		)
+		offset = 0
+	}
+
+	filtered := chRunsQuery + `SELECT
+    id,
+    inserted_at,
+    kind,
+    external_id,
+    readable_status
+FROM
+    runs
+WHERE
+    ` + strings.Join(conds, "\n    AND ") + `
+ORDER BY
+    inserted_at DESC, id DESC`
+
+	var runs []chRun
+
+	err := r.conn.Select(
+		ctx,
+		&runs,
+		filtered+"\nLIMIT @listWorkflowRunsLimit OFFSET @listWorkflowRunsOffset",
+		append(
+			args,
+			clickhouse.Named("listWorkflowRunsLimit", opts.Limit),
+			clickhouse.Named("listWorkflowRunsOffset", offset),
+		)...,
+	)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var count uint64
+
+	if opts.Cursor == nil {
+		err = r.conn.QueryRow(ctx, "SELECT count() FROM ("+filtered+"\nLIMIT 20000)", args...).Scan(&count)
+
+		if err != nil {
+			r.l.Error().Msgf("error counting workflow runs: %v", err)
+			count = uint64(len(runs))
+		}
+	}
+
+	res, err := r.populateRuns(ctx, tenantId, runs)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res, int(count), nil // nolint: gosec
+}
+
+func (r *clickhouseOLAPEventRepository) ListWorkflowRunsByExternalIds(ctx context.Context, tenantId string, externalIds []string) ([]*WorkflowRunData, error) {
+	if len(externalIds) == 0 {
+		return []*WorkflowRunData{}, nil
+	}
+
+	var runs []chRun
+
+	err := r.conn.Select(
+		ctx,
+		&runs,
+		`SELECT id, inserted_at, 'TASK' AS kind, external_id, '' AS readable_status
+		FROM v2_tasks_olap FINAL
+		WHERE tenant_id = @tenantId AND has(@externalIds, toString(external_id)) AND dag_id IS NULL
+		UNION ALL
+		SELECT id, inserted_at, 'DAG' AS kind, external_id, '' AS readable_status
+		FROM v2_dags_olap FINAL
+		WHERE tenant_id = @tenantId AND has(@externalIds, toString(external_id))`,
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.Named("externalIds", externalIds),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dagIds := make([]int64, 0)
+	since := time.Now().UTC()
+
+	for _, run := range runs {
+		if run.Kind == string(olapv2.V2RunKindDAG) {
+			dagIds = append(dagIds, run.ID)
+
+			if run.InsertedAt.Before(since) {
+				since = run.InsertedAt
+			}
+		}
+	}
+
+	// the statuses of DAGs are derived from their tasks, while populateRuns reads the statuses of tasks
+	if len(dagIds) > 0 {
+		dagStatuses, err := r.listDAGStatuses(ctx, tenantId, dagIds, since)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range runs {
+			if runs[i].Kind == string(olapv2.V2RunKindDAG) {
+				runs[i].ReadableStatus = dagStatuses[runs[i].ID]
+			}
+		}
+	}
+
+	return r.populateRuns(ctx, tenantId, runs)
+}
+
+// populateRuns reads the DAGs and tasks of runs, returning them in the same order. The statuses of DAGs
+// are taken from the runs.
+func (r *clickhouseOLAPEventRepository) populateRuns(ctx context.Context, tenantId string, runs []chRun) ([]*WorkflowRunData, error) {
+	dagIds := make([]int64, 0)
+	taskMetadata := make([]TaskMetadata, 0)
+	since := time.Now().UTC()
+
+	for _, run := range runs {
+		if run.Kind == string(olapv2.V2RunKindDAG) {
+			dagIds = append(dagIds, run.ID)
+		} else {
+			taskMetadata = append(taskMetadata, TaskMetadata{
+				TaskID:         run.ID,
+				TaskInsertedAt: run.InsertedAt,
+			})
+		}
+
+		if run.InsertedAt.Before(since) {
+			since = run.InsertedAt
+		}
+	}
+
+	dags, err := r.listDAGs(ctx, tenantId, dagIds, since)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dagMetadata, err := r.listDAGMetadata(ctx, tenantId, dagIds, since)
+
+	if err != nil {
+		return nil, err
+	}
+
+	populatedTasks, err := r.listTasksWithData(ctx, tenantId, taskMetadata)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tasksToPopulated := make(map[int64]*olapv2.PopulateTaskRunDataRow)
+
+	for _, task := range populatedTasks {
+		tasksToPopulated[task.ID] = task
+	}
+
+	res := make([]*WorkflowRunData, 0, len(runs))
+
+	for _, run := range runs {
+		if run.Kind == string(olapv2.V2RunKindDAG) {
+			dag, ok := dags[run.ID]
+
+			if !ok {
+				r.l.Error().Msgf("could not find dag with external id %s", run.ExternalID.String())
+				continue
+			}
+
+			workflowRun := &WorkflowRunData{
+				ID:                 run.ID,
+				TenantID:           chUUID(dag.TenantID),
+				InsertedAt:         chTimestamptz(dag.InsertedAt),
+				ExternalID:         chUUID(dag.ExternalID),
+				WorkflowID:         chUUID(dag.WorkflowID),
+				DisplayName:        dag.DisplayName,
+				ReadableStatus:     olapv2.V2ReadableStatusOlap(run.ReadableStatus),
+				AdditionalMetadata: chBytes(dag.AdditionalMetadata),
+				Kind:               olapv2.V2RunKindDAG,
+				WorkflowVersionId:  chUUID(dag.WorkflowVersionID),
+			}
+
+			if m, ok := dagMetadata[run.ID]; ok {
+				workflowRun.CreatedAt = chTimestamptz(m.CreatedAt)
+				workflowRun.StartedAt = chTimestamptz(m.StartedAt)
+				workflowRun.FinishedAt = chTimestamptz(m.FinishedAt)
+				workflowRun.ErrorMessage = m.ErrorMessage
+			}
+
+			res = append(res, workflowRun)
+		} else {
+			task, ok := tasksToPopulated[run.ID]
+
+			if !ok {
+				r.l.Error().Msgf("could not find task with external id %s", run.ExternalID.String())
+				continue
+			}
+
+			res = append(res, &WorkflowRunData{
+				ID:                 run.ID,
+				TenantID:           task.TenantID,
+				InsertedAt:         task.InsertedAt,
+				ExternalID:         task.ExternalID,
+				WorkflowID:         task.WorkflowID,
+				DisplayName:        task.DisplayName,
+				ReadableStatus:     task.Status,
+				AdditionalMetadata: task.AdditionalMetadata,
+				CreatedAt:          task.InsertedAt,
+				StartedAt:          task.StartedAt,
+				FinishedAt:         task.FinishedAt,
+				ErrorMessage:       task.ErrorMessage.String,
+				Kind:               olapv2.V2RunKindTASK,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+const chTaskEventsQuery = `SELECT
+    tenant_id,
+    task_id,
+    task_inserted_at,
+    retry_count,
+    toString(event_type) AS event_type_str,
+    min(event_timestamp) AS time_first_seen,
+    max(event_timestamp) AS time_last_seen,
+    count() AS event_count,
+    argMin(id, event_timestamp) AS first_id,
+    toString(argMin(readable_status, event_timestamp)) AS first_readable_status,
+    argMin(ifNull(error_message, ''), event_timestamp) AS first_error_message,
+    argMin(ifNull(output, ''), event_timestamp) AS first_output,
+    argMin(ifNull(toString(worker_id), ''), event_timestamp) AS first_worker_id,
+    argMin(ifNull(additional__event_data, ''), event_timestamp) AS first_event_data,
+    argMin(ifNull(additional__event_message, ''), event_timestamp) AS first_event_message
+FROM
+    v2_task_events_olap
+WHERE
+    %s
+GROUP BY
+    tenant_id, task_id, task_inserted_at, retry_count, event_type
+ORDER BY
+    time_first_seen DESC`
+
+func (r *clickhouseOLAPEventRepository) ListTaskRunEvents(ctx context.Context, tenantId string, taskId int64, taskInsertedAt pgtype.Timestamptz, limit, offset int64) ([]*olapv2.ListTaskEventsRow, error) {
+	var events []chTaskEvent
+
+	err := r.conn.Select(
+		ctx,
+		&events,
+		fmt.Sprintf(chTaskEventsQuery, "tenant_id = @tenantId AND task_inserted_at = @taskInsertedAt AND task_id = @taskId"),
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.DateNamed("taskInsertedAt", taskInsertedAt.Time, clickhouse.MicroSeconds),
+		clickhouse.Named("taskId", taskId),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*olapv2.ListTaskEventsRow, 0, len(events))
+
+	for _, event := range events {
+		res = append(res, &olapv2.ListTaskEventsRow{
+			TenantID:               chUUID(event.TenantID),
+			TaskID:                 event.TaskID,
+			TaskInsertedAt:         chTimestamptz(event.TaskInsertedAt),
+			RetryCount:             event.RetryCount,
+			EventType:              olapv2.V2EventTypeOlap(event.EventType),
+			TimeFirstSeen:          chTimestamptz(event.TimeFirstSeen),
+			TimeLastSeen:           chTimestamptz(event.TimeLastSeen),
+			Count:                  int64(event.Count), // nolint: gosec
+			ID:                     event.ID,
+			EventTimestamp:         chTimestamptz(event.TimeFirstSeen),
+			ReadableStatus:         olapv2.V2ReadableStatusOlap(event.ReadableStatus),
+			ErrorMessage:           chText(event.ErrorMessage),
+			Output:                 chBytes(event.Output),
+			WorkerID:               chUUIDFromStr(event.WorkerID),
+			AdditionalEventData:    chText(event.AdditionalEventData),
+			AdditionalEventMessage: chText(event.AdditionalEventMessage),
+		})
+	}
+
+	return res, nil
+}
+
+func (r *clickhouseOLAPEventRepository) ListTaskRunEventsByWorkflowRunId(ctx context.Context, tenantId string, workflowRunId pgtype.UUID) ([]*olapv2.ListTaskEventsForWorkflowRunRow, error) {
+	dag, err := r.readDAGByExternalId(ctx, sqlchelpers.UUIDToStr(workflowRunId))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if dag.TenantID.String() != tenantId {
+		return nil, pgx.ErrNoRows
+	}
+
+	var tasks []chTask
+
+	err = r.conn.Select(
+		ctx,
+		&tasks,
+		`SELECT `+chTaskColumns+`
+		FROM v2_tasks_olap FINAL
+		WHERE tenant_id = @tenantId AND inserted_at >= @since AND dag_id = @dagId AND dag_inserted_at = @dagInsertedAt`,
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.DateNamed("since", dag.InsertedAt, clickhouse.MicroSeconds),
+		clickhouse.Named("dagId", dag.ID),
+		clickhouse.DateNamed("dagInsertedAt", dag.InsertedAt, clickhouse.MicroSeconds),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tasks) == 0 {
+		return []*olapv2.ListTaskEventsForWorkflowRunRow{}, nil
+	}
+
+	taskIds := make([]int64, 0, len(tasks))
+	idsToTasks := make(map[int64]chTask, len(tasks))
+
+	for _, task := range tasks {
+		taskIds = append(taskIds, task.ID)
+		idsToTasks[task.ID] = task
+	}
+
+	var events []chTaskEvent
+
+	err = r.conn.Select(
+		ctx,
+		&events,
+		fmt.Sprintf(chTaskEventsQuery, "tenant_id = @tenantId AND task_inserted_at >= @since AND has(@taskIds, task_id)"),
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.DateNamed("since", dag.InsertedAt, clickhouse.MicroSeconds),
+		clickhouse.Named("taskIds", taskIds),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*olapv2.ListTaskEventsForWorkflowRunRow, 0, len(events))
+
+	for _, event := range events {
+		task := idsToTasks[event.TaskID]
+
+		res = append(res, &olapv2.ListTaskEventsForWorkflowRunRow{
+			TenantID:               chUUID(event.TenantID),
+			TaskID:                 event.TaskID,
+			TaskInsertedAt:         chTimestamptz(event.TaskInsertedAt),
+			RetryCount:             event.RetryCount,
+			EventType:              olapv2.V2EventTypeOlap(event.EventType),
+			TimeFirstSeen:          chTimestamptz(event.TimeFirstSeen),
+			TimeLastSeen:           chTimestamptz(event.TimeLastSeen),
+			Count:                  int64(event.Count), // nolint: gosec
+			ID:                     event.ID,
+			EventTimestamp:         chTimestamptz(event.TimeFirstSeen),
+			ReadableStatus:         olapv2.V2ReadableStatusOlap(event.ReadableStatus),
+			ErrorMessage:           chText(event.ErrorMessage),
+			Output:                 chBytes(event.Output),
+			WorkerID:               chUUIDFromStr(event.WorkerID),
+			AdditionalEventData:    chText(event.AdditionalEventData),
+			AdditionalEventMessage: chText(event.AdditionalEventMessage),
+			DisplayName:            task.DisplayName,
+			TaskExternalID:         chUUID(task.ExternalID),
+		})
+	}
+
+	return res, nil
+}
+
+func (r *clickhouseOLAPEventRepository) ReadTaskRunMetrics(ctx context.Context, tenantId string, opts ReadTaskRunMetricsOpts) ([]olap.TaskRunMetric, error) {
+	query := chRunsQuery + `SELECT readable_status, count() AS count FROM runs %s GROUP BY readable_status`
+
+	args := []any{
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.DateNamed("since", opts.CreatedAfter, clickhouse.MicroSeconds),
+	}
+
+	if len(opts.WorkflowIds) > 0 {
+		query = fmt.Sprintf(query, "WHERE has(@workflowIds, toString(workflow_id))")
+		args = append(args, clickhouse.Named("workflowIds", chUUIDStrs(opts.WorkflowIds)))
+	} else {
+		query = fmt.Sprintf(query, "")
+	}
+
+	var rows []struct {
+		ReadableStatus string `ch:"readable_status"`
+		Count          uint64 `ch:"count"`
+	}
+
+	if err := r.conn.Select(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]uint64, len(rows))
+
+	for _, row := range rows {
+		counts[row.ReadableStatus] = row.Count
+	}
+
+	metrics := make([]olap.TaskRunMetric, 0)
+
+	for _, status := range []olapv2.V2ReadableStatusOlap{
+		olapv2.V2ReadableStatusOlapQUEUED,
+		olapv2.V2ReadableStatusOlapRUNNING,
+		olapv2.V2ReadableStatusOlapCOMPLETED,
+		olapv2.V2ReadableStatusOlapCANCELLED,
+		olapv2.V2ReadableStatusOlapFAILED,
+	} {
+		metrics = append(metrics, olap.TaskRunMetric{
+			Status: string(status),
+			Count:  counts[string(status)],
+		})
+	}
+
+	return metrics, nil
+}
+
+func (r *clickhouseOLAPEventRepository) CreateTasks(ctx context.Context, tenantId string, tasks []*sqlcv2.V2Task) error {
+	batch, err := r.conn.PrepareBatch(ctx, `INSERT INTO v2_tasks_olap (
+		tenant_id, id, inserted_at, external_id, queue, action_id, step_id, workflow_id, schedule_timeout,
+		step_timeout, priority, sticky, desired_worker_id, display_name, input, additional_metadata,
+		dag_id, dag_inserted_at
+	)`)
+
+	if err != nil {
+		return err
+	}
+
+	defer batch.Abort() // nolint: errcheck
+
+	for _, task := range tasks {
+		err := batch.Append(
+			uuid.UUID(task.TenantID.Bytes),
+			task.ID,
+			task.InsertedAt.Time,
+			uuid.UUID(task.ExternalID.Bytes),
+			task.Queue,
+			task.ActionID,
+			uuid.UUID(task.StepID.Bytes),
+			uuid.UUID(task.WorkflowID.Bytes),
+			task.ScheduleTimeout,
+			pgTextToCh(task.StepTimeout),
+			pgInt4ToCh(task.Priority),
+			string(task.Sticky),
+			pgUUIDToCh(task.DesiredWorkerID),
+			task.DisplayName,
+			string(task.Input),
+			string(task.AdditionalMetadata),
+			pgInt8ToCh(task.DagID),
+			pgTimestamptzToCh(task.DagInsertedAt),
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return batch.Send()
+}
+
+func (r *clickhouseOLAPEventRepository) CreateTaskEvents(ctx context.Context, tenantId string, events []olapv2.CreateTaskEventsOLAPParams) error {
+	// skip any events which have a corresponding event already
+	eventsToWrite := make([]olapv2.CreateTaskEventsOLAPParams, 0)
+
+	for _, event := range events {
+		if _, ok := r.eventCache.Get(getCacheKey(event)); !ok {
+			eventsToWrite = append(eventsToWrite, event)
+		}
+	}
+
+	if len(eventsToWrite) == 0 {
+		return nil
+	}
+
+	batch, err := r.conn.PrepareBatch(ctx, `INSERT INTO v2_task_events_olap (
+		tenant_id, task_id, task_inserted_at, event_type, workflow_id, event_timestamp, readable_status,
+		retry_count, error_message, output, worker_id, additional__event_data, additional__event_message
+	)`)
+
+	if err != nil {
+		return err
+	}
+
+	defer batch.Abort() // nolint: errcheck
+
+	for _, event := range eventsToWrite {
+		eventTimestamp := event.EventTimestamp.Time
+
+		if !event.EventTimestamp.Valid {
+			eventTimestamp = time.Now().UTC()
+		}
+
+		var output *string
+
+		if event.Output != nil {
+			outputStr := string(event.Output)
+			output = &outputStr
+		}
+
+		err := batch.Append(
+			uuid.UUID(event.TenantID.Bytes),
+			event.TaskID,
+			event.TaskInsertedAt.Time,
+			string(event.EventType),
+			uuid.UUID(event.WorkflowID.Bytes),
+			eventTimestamp,
+			string(event.ReadableStatus),
+			event.RetryCount,
+			pgTextToCh(event.ErrorMessage),
+			output,
+			pgUUIDToCh(event.WorkerID),
+			pgTextToCh(event.AdditionalEventData),
+			pgTextToCh(event.AdditionalEventMessage),
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Send(); err != nil {
+		return err
+	}
+
+	for _, event := range eventsToWrite {
+		r.eventCache.Add(getCacheKey(event), true)
+	}
+
+	return nil
+}
+
+func (r *clickhouseOLAPEventRepository) CreateDAGs(ctx context.Context, tenantId string, dags []*v2.DAGWithData) error {
+	batch, err := r.conn.PrepareBatch(ctx, `INSERT INTO v2_dags_olap (
+		tenant_id, id, inserted_at, external_id, display_name, workflow_id, workflow_version_id, input,
+		additional_metadata
+	)`)
+
+	if err != nil {
+		return err
+	}
+
+	defer batch.Abort() // nolint: errcheck
+
+	for _, dag := range dags {
+		err := batch.Append(
+			uuid.UUID(dag.TenantID.Bytes),
+			dag.ID,
+			dag.InsertedAt.Time,
+			uuid.UUID(dag.ExternalID.Bytes),
+			dag.DisplayName,
+			uuid.UUID(dag.WorkflowID.Bytes),
+			uuid.UUID(dag.WorkflowVersionID.Bytes),
+			string(dag.Input),
+			string(dag.AdditionalMetadata),
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return batch.Send()
+}
+
+func (r *clickhouseOLAPEventRepository) GetTaskPointMetrics(ctx context.Context, tenantId string, startTimestamp *time.Time, endTimestamp *time.Time, bucketInterval time.Duration) ([]*olapv2.GetTaskPointMetricsRow, error) {
+	intervalSeconds := int64(bucketInterval.Seconds())
+
+	if intervalSeconds < 1 {
+		intervalSeconds = 60
+	}
+
+	var rows []struct {
+		Bucket         time.Time `ch:"bucket"`
+		CompletedCount uint64    `ch:"completed_count"`
+		FailedCount    uint64    `ch:"failed_count"`
+	}
+
+	err := r.conn.Select(
+		ctx,
+		&rows,
+		`SELECT
+			toStartOfInterval(task_inserted_at, INTERVAL @interval SECOND) AS bucket,
+			countIf(readable_status = 'COMPLETED') AS completed_count,
+			countIf(readable_status = 'FAILED') AS failed_count
+		FROM
+			v2_task_events_olap
+		WHERE
+			tenant_id = @tenantId
+			AND task_inserted_at BETWEEN @createdAfter AND @createdBefore
+		GROUP BY bucket
+		ORDER BY bucket`,
+		clickhouse.Named("interval", intervalSeconds),
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.DateNamed("createdAfter", *startTimestamp, clickhouse.MicroSeconds),
+		clickhouse.DateNamed("createdBefore", *endTimestamp, clickhouse.MicroSeconds),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*olapv2.GetTaskPointMetricsRow, 0, len(rows))
+
+	for _, row := range rows {
+		res = append(res, &olapv2.GetTaskPointMetricsRow{
+			Bucket2:        chTimestamptz(row.Bucket),
+			CompletedCount: int64(row.CompletedCount), // nolint: gosec
+			FailedCount:    int64(row.FailedCount),    // nolint: gosec
+		})
+	}
+
+	return res, nil
+}
+
+// UpdateTaskStatuses is a no-op, since task statuses are maintained by a materialized view. As a result,
+// finished tasks are not reported, see ReportsFinishedWorkflowRuns.
+func (r *clickhouseOLAPEventRepository) UpdateTaskStatuses(ctx context.Context, tenantId string, onFinished FinishedWorkflowRunsHandler) (bool, error) {
+	return false, nil
+}
+
+// UpdateDAGStatuses is a no-op, since DAG statuses are derived from task statuses at query time. As a
+// result, finished DAGs are not reported, see ReportsFinishedWorkflowRuns.
+func (r *clickhouseOLAPEventRepository) UpdateDAGStatuses(ctx context.Context, tenantId string, onFinished FinishedWorkflowRunsHandler) (bool, error) {
+	return false, nil
+}
+
+// ReportsFinishedWorkflowRuns returns false, since statuses are never updated in place and the status view
+// doesn't record when a run finished, so there is nothing to detect the transition into a final status from.
+func (r *clickhouseOLAPEventRepository) ReportsFinishedWorkflowRuns() bool {
+	return false
+}
+
+func (r *clickhouseOLAPEventRepository) ReadDAG(ctx context.Context, dagExternalId string) (*olapv2.V2DagsOlap, error) {
+	dag, err := r.readDAGByExternalId(ctx, dagExternalId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := r.listDAGStatuses(ctx, dag.TenantID.String(), []int64{dag.ID}, dag.InsertedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &olapv2.V2DagsOlap{
+		ID:                 dag.ID,
+		InsertedAt:         chTimestamptz(dag.InsertedAt),
+		TenantID:           chUUID(dag.TenantID),
+		ExternalID:         chUUID(dag.ExternalID),
+		DisplayName:        dag.DisplayName,
+		WorkflowID:         chUUID(dag.WorkflowID),
+		WorkflowVersionID:  chUUID(dag.WorkflowVersionID),
+		ReadableStatus:     olapv2.V2ReadableStatusOlap(statuses[dag.ID]),
+		Input:              chBytes(dag.Input),
+		AdditionalMetadata: chBytes(dag.AdditionalMetadata),
+	}, nil
+}
+
+func (r *clickhouseOLAPEventRepository) ListTasksByDAGId(ctx context.Context, tenantId string, dagIds []pgtype.UUID) ([]*olapv2.PopulateTaskRunDataRow, map[int64]uuid.UUID, error) {
+	taskIdToDagExternalId := make(map[int64]uuid.UUID)
+
+	if len(dagIds) == 0 {
+		return []*olapv2.PopulateTaskRunDataRow{}, taskIdToDagExternalId, nil
+	}
+
+	externalIds := make([]string, 0, len(dagIds))
+
+	for _, id := range dagIds {
+		externalIds = append(externalIds, sqlchelpers.UUIDToStr(id))
+	}
+
+	var rows []struct {
+		TaskID         int64     `ch:"task_id"`
+		TaskInsertedAt time.Time `ch:"task_inserted_at"`
+		DagExternalID  uuid.UUID `ch:"dag_external_id"`
+	}
+
+	err := r.conn.Select(
+		ctx,
+		&rows,
+		`SELECT
+			t.id AS task_id,
+			t.inserted_at AS task_inserted_at,
+			d.external_id AS dag_external_id
+		FROM
+			v2_tasks_olap AS t FINAL
+		JOIN
+			(
+				SELECT id, inserted_at, external_id
+				FROM v2_dags_olap FINAL
+				WHERE tenant_id = @tenantId AND has(@dagIds, toString(external_id))
+			) AS d ON d.id = assumeNotNull(t.dag_id) AND d.inserted_at = assumeNotNull(t.dag_inserted_at)
+		WHERE
+			t.tenant_id = @tenantId
+			AND t.dag_id IS NOT NULL`,
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.Named("dagIds", externalIds),
+	)
+
+	if err != nil {
+		return nil, taskIdToDagExternalId, err
+	}
+
+	taskMetadata := make([]TaskMetadata, 0, len(rows))
+
+	for _, row := range rows {
+		taskIdToDagExternalId[row.TaskID] = row.DagExternalID
+
+		taskMetadata = append(taskMetadata, TaskMetadata{
+			TaskID:         row.TaskID,
+			TaskInsertedAt: row.TaskInsertedAt,
+		})
+	}
+
+	tasksWithData, err := r.listTasksWithData(ctx, tenantId, taskMetadata)
+
+	if err != nil {
+		return nil, taskIdToDagExternalId, err
+	}
+
+	return tasksWithData, taskIdToDagExternalId, nil
+}
+
+func (r *clickhouseOLAPEventRepository) ListTasksByIdAndInsertedAt(ctx context.Context, tenantId string, taskMetadata []TaskMetadata) ([]*olapv2.PopulateTaskRunDataRow, error) {
+	return r.listTasksWithData(ctx, tenantId, taskMetadata)
+}
+
+func (r *clickhouseOLAPEventRepository) ListTasksByExternalIds(ctx context.Context, tenantId string, externalIds []string) ([]*olapv2.PopulateTaskRunDataRow, error) {
+	if len(externalIds) == 0 {
+		return []*olapv2.PopulateTaskRunDataRow{}, nil
+	}
+
+	var rows []struct {
+		ID         int64     `ch:"id"`
+		InsertedAt time.Time `ch:"inserted_at"`
+	}
+
+	err := r.conn.Select(
+		ctx,
+		&rows,
+		`SELECT id, inserted_at FROM v2_tasks_olap FINAL WHERE tenant_id = @tenantId AND has(@externalIds, toString(external_id))`,
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.Named("externalIds", externalIds),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	taskMetadata := make([]TaskMetadata, 0, len(rows))
+
+	for _, row := range rows {
+		taskMetadata = append(taskMetadata, TaskMetadata{
+			TaskID:         row.ID,
+			TaskInsertedAt: row.InsertedAt,
+		})
+	}
+
+	return r.listTasksWithData(ctx, tenantId, taskMetadata)
+}
+
+// listTasksWithData is the equivalent of PopulateTaskRunData, returning the tasks ordered by inserted_at
+// and id descending.
+func (r *clickhouseOLAPEventRepository) listTasksWithData(ctx context.Context, tenantId string, taskMetadata []TaskMetadata) ([]*olapv2.PopulateTaskRunDataRow, error) {
+	if len(taskMetadata) == 0 {
+		return []*olapv2.PopulateTaskRunDataRow{}, nil
+	}
+
+	taskIds := make([]int64, 0, len(taskMetadata))
+	since := taskMetadata[0].TaskInsertedAt
+
+	for _, metadata := range taskMetadata {
+		taskIds = append(taskIds, metadata.TaskID)
+
+		if metadata.TaskInsertedAt.Before(since) {
+			since = metadata.TaskInsertedAt
+		}
+	}
+
+	var tasks []chTask
+
+	err := r.conn.Select(
+		ctx,
+		&tasks,
+		`SELECT `+chTaskColumns+`
+		FROM v2_tasks_olap FINAL
+		WHERE tenant_id = @tenantId AND inserted_at >= @since AND has(@taskIds, id)
+		ORDER BY inserted_at DESC, id DESC`,
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.DateNamed("since", since, clickhouse.MicroSeconds),
+		clickhouse.Named("taskIds", taskIds),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := r.listTaskStatuses(ctx, tenantId, taskIds, since)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*olapv2.PopulateTaskRunDataRow, 0, len(tasks))
+
+	for _, task := range tasks {
+		status := statuses[task.ID]
+
+		res = append(res, &olapv2.PopulateTaskRunDataRow{
+			TenantID:           chUUID(task.TenantID),
+			ID:                 task.ID,
+			InsertedAt:         chTimestamptz(task.InsertedAt),
+			ExternalID:         chUUID(task.ExternalID),
+			Queue:              task.Queue,
+			ActionID:           task.ActionID,
+			StepID:             chUUID(task.StepID),
+			WorkflowID:         chUUID(task.WorkflowID),
+			ScheduleTimeout:    task.ScheduleTimeout,
+			StepTimeout:        chNullText(task.StepTimeout),
+			Priority:           chNullInt4(task.Priority),
+			Sticky:             olapv2.V2StickyStrategyOlap(task.Sticky),
+			DisplayName:        task.DisplayName,
+			AdditionalMetadata: chBytes(task.AdditionalMetadata),
+			Status:             olapv2.V2ReadableStatusOlap(status.ReadableStatus),
+			FinishedAt:         chTimestamptz(status.FinishedAt),
+			StartedAt:          chTimestamptz(status.StartedAt),
+			ErrorMessage:       chText(status.ErrorMessage),
+		})
+	}
+
+	return res, nil
+}
+
+// listTaskStatuses returns the status of each task, computed from the events of its latest retry. Tasks
+// which don't have any events are returned as QUEUED.
+func (r *clickhouseOLAPEventRepository) listTaskStatuses(ctx context.Context, tenantId string, taskIds []int64, since time.Time) (map[int64]chTaskStatus, error) {
+	var rows []chTaskStatus
+
+	err := r.conn.Select(
+		ctx,
+		&rows,
+		`WITH latest_retry_counts AS (
+			SELECT
+				task_id,
+				max(retry_count) AS max_retry_count
+			FROM
+				v2_task_events_olap
+			WHERE
+				tenant_id = @tenantId
+				AND task_inserted_at >= @since
+				AND has(@taskIds, task_id)
+			GROUP BY
+				task_id
+		)
+		SELECT
+			e.task_id AS task_id,
+			any(e.retry_count) AS retry_count,
+			toString(max(e.readable_status)) AS readable_status,
+			maxIf(e.event_timestamp, e.event_type = 'STARTED') AS started_at,
+			maxIf(e.event_timestamp, e.readable_status IN ('COMPLETED', 'FAILED', 'CANCELLED')) AS finished_at,
+			argMaxIf(ifNull(e.error_message, ''), e.event_timestamp, e.readable_status = 'FAILED') AS error_message,
+			argMaxIf(ifNull(e.output, ''), e.event_timestamp, e.event_type = 'FINISHED') AS output,
+			argMaxIf(ifNull(toString(e.worker_id), ''), e.event_timestamp, e.worker_id IS NOT NULL) AS latest_worker_id
+		FROM
+			v2_task_events_olap AS e
+		JOIN
+			latest_retry_counts AS l ON l.task_id = e.task_id AND l.max_retry_count = e.retry_count
+		WHERE
+			e.tenant_id = @tenantId
+			AND e.task_inserted_at >= @since
+			AND has(@taskIds, e.task_id)
+		GROUP BY
+			e.task_id`,
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.DateNamed("since", since, clickhouse.MicroSeconds),
+		clickhouse.Named("taskIds", taskIds),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int64]chTaskStatus, len(taskIds))
+
+	for _, id := range taskIds {
+		res[id] = chTaskStatus{
+			TaskID:         id,
+			ReadableStatus: string(olapv2.V2ReadableStatusOlapQUEUED),
+		}
+	}
+
+	for _, row := range rows {
+		res[row.TaskID] = row
+	}
+
+	return res, nil
+}
+
+func (r *clickhouseOLAPEventRepository) readDAGByExternalId(ctx context.Context, externalId string) (*chDAG, error) {
+	var dags []chDAG
+
+	err := r.conn.Select(
+		ctx,
+		&dags,
+		`SELECT `+chDAGColumns+` FROM v2_dags_olap FINAL WHERE external_id = @externalId LIMIT 1`,
+		clickhouse.Named("externalId", externalId),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dags) == 0 {
+		return nil, pgx.ErrNoRows
+	}
+
+	return &dags[0], nil
+}
+
+func (r *clickhouseOLAPEventRepository) listDAGs(ctx context.Context, tenantId string, dagIds []int64, since time.Time) (map[int64]chDAG, error) {
+	res := make(map[int64]chDAG, len(dagIds))
+
+	if len(dagIds) == 0 {
+		return res, nil
+	}
+
+	var dags []chDAG
+
+	err := r.conn.Select(
+		ctx,
+		&dags,
+		`SELECT `+chDAGColumns+`
+		FROM v2_dags_olap FINAL
+		WHERE tenant_id = @tenantId AND inserted_at >= @since AND has(@dagIds, id)`,
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.DateNamed("since", since, clickhouse.MicroSeconds),
+		clickhouse.Named("dagIds", dagIds),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dag := range dags {
+		res[dag.ID] = dag
+	}
+
+	return res, nil
+}
+
+// listDAGStatuses returns the readable status of each DAG, derived from the statuses of its tasks
+func (r *clickhouseOLAPEventRepository) listDAGStatuses(ctx context.Context, tenantId string, dagIds []int64, since time.Time) (map[int64]string, error) {
+	var rows []struct {
+		DagID          int64  `ch:"dag_id"`
+		ReadableStatus string `ch:"readable_status"`
+	}
+
+	err := r.conn.Select(
+		ctx,
+		&rows,
+		`WITH task_statuses AS (
+			`+chTaskStatusesQuery+`
+		), c AS (
+			SELECT
+				assumeNotNull(t.dag_id) AS dag_id,
+				count() AS task_count,
+				countIf(if(s.readable_status = '', 'QUEUED', s.readable_status) = 'QUEUED') AS queued_count,
+				countIf(s.readable_status = 'RUNNING') AS running_count,
+				countIf(s.readable_status = 'COMPLETED') AS completed_count,
+				countIf(s.readable_status = 'CANCELLED') AS cancelled_count,
+				countIf(s.readable_status = 'FAILED') AS failed_count
+			FROM
+				v2_tasks_olap AS t FINAL
+			LEFT JOIN
+				task_statuses AS s ON s.task_id = t.id AND s.task_inserted_at = t.inserted_at
+			WHERE
+				t.tenant_id = @tenantId
+				AND t.inserted_at >= @since
+				AND t.dag_id IS NOT NULL
+				AND has(@dagIds, assumeNotNull(t.dag_id))
+			GROUP BY
+				dag_id
+		)
+		SELECT
+			c.dag_id AS dag_id,
+			`+chDAGStatusExpr+` AS readable_status
+		FROM
+			c`,
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.DateNamed("since", since, clickhouse.MicroSeconds),
+		clickhouse.Named("dagIds", dagIds),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int64]string, len(dagIds))
+
+	for _, id := range dagIds {
+		res[id] = string(olapv2.V2ReadableStatusOlapQUEUED)
+	}
+
+	for _, row := range rows {
+		res[row.DagID] = row.ReadableStatus
+	}
+
+	return res, nil
+}
+
+// listDAGMetadata is the equivalent of PopulateDAGMetadata, computing the timestamps and error message of
+// each DAG from the events of its tasks.
+func (r *clickhouseOLAPEventRepository) listDAGMetadata(ctx context.Context, tenantId string, dagIds []int64, since time.Time) (map[int64]chDAGMetadata, error) {
+	res := make(map[int64]chDAGMetadata, len(dagIds))
+
+	if len(dagIds) == 0 {
+		return res, nil
+	}
+
+	var rows []chDAGMetadata
+
+	err := r.conn.Select(
+		ctx,
+		&rows,
+		`SELECT
+			t.dag_id AS dag_id,
+			min(e.inserted_at) AS created_at,
+			minIf(e.inserted_at, e.readable_status = 'RUNNING') AS started_at,
+			maxIf(e.inserted_at, e.readable_status IN ('COMPLETED', 'CANCELLED', 'FAILED')) AS finished_at,
+			argMaxIf(ifNull(e.error_message, ''), e.retry_count, e.readable_status = 'FAILED') AS error_message
+		FROM
+			v2_task_events_olap AS e
+		JOIN
+			(
+				SELECT id, inserted_at, assumeNotNull(dag_id) AS dag_id
+				FROM v2_tasks_olap FINAL
+				WHERE tenant_id = @tenantId AND inserted_at >= @since AND dag_id IS NOT NULL AND has(@dagIds, assumeNotNull(dag_id))
+			) AS t ON t.id = e.task_id AND t.inserted_at = e.task_inserted_at
+		WHERE
+			e.tenant_id = @tenantId
+			AND e.task_inserted_at >= @since
+		GROUP BY
+			t.dag_id`,
+		clickhouse.Named("tenantId", tenantId),
+		clickhouse.DateNamed("since", since, clickhouse.MicroSeconds),
+		clickhouse.Named("dagIds", dagIds),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		res[row.DagID] = row
+	}
+
+	return res, nil
+}
+
+// chAdditionalMetadataFilter matches rows where any of the given key-value pairs are present in the
+// additional metadata, which is the same behavior as the Postgres queries.
+func chAdditionalMetadataFilter(column string, additionalMetadata map[string]interface{}) (string, []any) {
+	keys := make([]string, 0, len(additionalMetadata))
+	values := make([]string, 0, len(additionalMetadata))
+
+	for key, value := range additionalMetadata {
+		keys = append(keys, key)
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+
+	cond := fmt.Sprintf("arrayExists((k, v) -> JSONExtractString(%s, k) = v, @keys, @values)", column)
+
+	return cond, []any{
+		clickhouse.Named("keys", keys),
+		clickhouse.Named("values", values),
+	}
+}
+
+// chJSONFilter returns a condition which matches rows where all of the filters match the JSON document in
+// column. Values are compared against the raw JSON at each path.
+func chJSONFilter(column, name string, filters []JSONFilter) (string, []any, error) {
+	conds := make([]string, 0, len(filters))
+	args := make([]any, 0)
+
+	for i, filter := range filters {
+		pathArgs := make([]string, 0, len(filter.Path))
+
+		for j, key := range filter.Path {
+			argName := fmt.Sprintf("%sPath%d_%d", name, i, j)
+			pathArgs = append(pathArgs, "@"+argName)
+			args = append(args, clickhouse.Named(argName, key))
+		}
+
+		value, err := json.Marshal(filter.Value)
+
+		if err != nil {
+			return "", nil, err
+		}
+
+		valueName := fmt.Sprintf("%sValue%d", name, i)
+		args = append(args, clickhouse.Named(valueName, string(value)))
+
+		conds = append(conds, fmt.Sprintf("JSONExtractRaw(%s, %s) = @%s", column, strings.Join(pathArgs, ", "), valueName))
+	}
+
+	return strings.Join(conds, " AND "), args, nil
+}
+
+func chStatuses(statuses []gen.V2TaskStatus) []string {
+	res := make([]string, 0, len(statuses))
+
+	for _, status := range statuses {
+		res = append(res, string(status))
+	}
+
+	if len(res) == 0 {
+		res = []string{
+			string(olapv2.V2ReadableStatusOlapQUEUED),
+			string(olapv2.V2ReadableStatusOlapRUNNING),
+			string(olapv2.V2ReadableStatusOlapCOMPLETED),
+			string(olapv2.V2ReadableStatusOlapCANCELLED),
+			string(olapv2.V2ReadableStatusOlapFAILED),
+		}
+	}
+
+	return res
+}
+
+func chUUIDStrs(ids []uuid.UUID) []string {
+	res := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		res = append(res, id.String())
+	}
+
+	return res
+}
+
+func chUUID(id uuid.UUID) pgtype.UUID {
+	return pgtype.UUID{
+		Bytes: id,
+		Valid: true,
+	}
+}
+
+func chNullUUID(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+
+	return chUUID(*id)
+}
+
+func chUUIDFromStr(id string) pgtype.UUID {
+	if id == "" {
+		return pgtype.UUID{}
+	}
+
+	return sqlchelpers.UUIDFromStr(id)
+}
+
+// chTimestamptz converts a ClickHouse timestamp to a pgtype.Timestamptz. ClickHouse returns the zero
+// value of the column (the unix epoch) rather than NULL from aggregates with no matching rows, so these
+// are treated as NULL.
+func chTimestamptz(t time.Time) pgtype.Timestamptz {
+	if t.IsZero() || t.Unix() <= 0 {
+		return pgtype.Timestamptz{}
+	}
+
+	return sqlchelpers.TimestamptzFromTime(t)
+}
+
+func chNullTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+
+	return chTimestamptz(*t)
+}
+
+func chText(s string) pgtype.Text {
+	return pgtype.Text{
+		String: s,
+		Valid:  s != "",
+	}
+}
+
+func chNullText(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{}
+	}
+
+	return pgtype.Text{
+		String: *s,
+		Valid:  true,
+	}
+}
+
+func chNullInt4(i *int32) pgtype.Int4 {
+	if i == nil {
+		return pgtype.Int4{}
+	}
+
+	return pgtype.Int4{
+		Int32: *i,
+		Valid: true,
+	}
+}
+
+func chNullInt8(i *int64) pgtype.Int8 {
+	if i == nil {
+		return pgtype.Int8{}
+	}
+
+	return pgtype.Int8{
+		Int64: *i,
+		Valid: true,
+	}
+}
+
+func chBytes(s string) []byte {
+	if s == "" {
+		return nil
+	}
+
+	return []byte(s)
+}
+
+func pgUUIDToCh(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+
+	res := uuid.UUID(id.Bytes)
+
+	return &res
+}
+
+func pgTextToCh(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.String
+}
+
+func pgInt4ToCh(i pgtype.Int4) *int32 {
+	if !i.Valid {
+		return nil
+	}
+
+	return &i.Int32
+}
+
+func pgInt8ToCh(i pgtype.Int8) *int64 {
+	if !i.Valid {
+		return nil
+	}
+
+	return &i.Int64
+}
+
+func pgTimestamptzToCh(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.Time
+}
@@ -0,0 +1,1277 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/olap"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/olapv2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+// the maximum number of rows which are counted when listing tasks and workflow runs, matching the
+// limit in the count queries
+const memoryOLAPMaxCount = 20000
+
+// readableStatusOrder is the ordering of the readable status enum. The status of a task is the greatest
+// status of its latest retry.
+var readableStatusOrder = map[olapv2.V2ReadableStatusOlap]int{
+	olapv2.V2ReadableStatusOlapQUEUED:    0,
+	olapv2.V2ReadableStatusOlapRUNNING:   1,
+	olapv2.V2ReadableStatusOlapCOMPLETED: 2,
+	olapv2.V2ReadableStatusOlapCANCELLED: 3,
+	olapv2.V2ReadableStatusOlapFAILED:    4,
+}
+
+// memoryOLAPEventRepository is an OLAPEventRepository which stores all data in memory. It is intended
+// for single-binary deployments (hatchet-lite) and tests, where running a separate OLAP database is
+// not practical. Statuses are derived from the task events at read time, and data is retained for 7 days.
+type memoryOLAPEventRepository struct {
+	l *zerolog.Logger
+
+	mu sync.RWMutex
+
+	tasks               map[int64]*sqlcv2.V2Task
+	taskIdsByExternalId map[string]int64
+
+	dags               map[int64]*v2.DAGWithData
+	dagIdsByExternalId map[string]int64
+	dagTaskIds         map[int64][]int64
+
+	// the statuses of tasks and DAGs as of the last status update, used to report finished workflow runs
+	updatedTaskStatuses map[int64]memoryTaskStatus
+	updatedDAGStatuses  map[int64]olapv2.V2ReadableStatusOlap
+
+	events      map[int64][]*memoryTaskEvent
+	eventKeys   map[string]struct{}
+	nextEventId int64
+}
+
+type memoryTaskEvent struct {
+	olapv2.CreateTaskEventsOLAPParams
+
+	ID         int64
+	InsertedAt time.Time
+}
+
+// memoryTaskState is the state of a task computed from the events of its latest retry
+type memoryTaskState struct {
+	status         olapv2.V2ReadableStatusOlap
+	retryCount     int32
+	startedAt      pgtype.Timestamptz
+	finishedAt     pgtype.Timestamptz
+	errorMessage   pgtype.Text
+	output         []byte
+	latestWorkerId pgtype.UUID
+}
+
+type memoryTaskStatus struct {
+	status     olapv2.V2ReadableStatusOlap
+	retryCount int32
+}
+
+type memoryRun struct {
+	id                 int64
+	insertedAt         time.Time
+	kind               olapv2.V2RunKind
+	workflowId         pgtype.UUID
+	additionalMetadata []byte
+	status             olapv2.V2ReadableStatusOlap
+}
+
+func NewMemoryOLAPEventRepository(l *zerolog.Logger) OLAPEventRepository {
+	return &memoryOLAPEventRepository{
+		l:                   l,
+		tasks:               make(map[int64]*sqlcv2.V2Task),
+		taskIdsByExternalId: make(map[string]int64),
+		dags:                make(map[int64]*v2.DAGWithData),
+		dagIdsByExternalId:  make(map[string]int64),
+		dagTaskIds:          make(map[int64][]int64),
+		updatedTaskStatuses: make(map[int64]memoryTaskStatus),
+		updatedDAGStatuses:  make(map[int64]olapv2.V2ReadableStatusOlap),
+		events:              make(map[int64][]*memoryTaskEvent),
+		eventKeys:           make(map[string]struct{}),
+	}
+}
+
+// UpdateTablePartitions removes all tasks and DAGs which were inserted more than 7 days ago, along with
+// their events.
+func (r *memoryOLAPEventRepository) UpdateTablePartitions(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sevenDaysAgo := time.Now().UTC().AddDate(0, 0, -7)
+
+	for id, task := range r.tasks {
+		if !task.InsertedAt.Time.Before(sevenDaysAgo) {
+			continue
+		}
+
+		for _, event := range r.events[id] {
+			delete(r.eventKeys, getCacheKey(event.CreateTaskEventsOLAPParams))
+		}
+
+		delete(r.events, id)
+		delete(r.updatedTaskStatuses, id)
+		delete(r.taskIdsByExternalId, sqlchelpers.UUIDToStr(task.ExternalID))
+		delete(r.tasks, id)
+	}
+
+	for id, dag := range r.dags {
+		if !dag.InsertedAt.Time.Before(sevenDaysAgo) {
+			continue
+		}
+
+		delete(r.dagTaskIds, id)
+		delete(r.updatedDAGStatuses, id)
+		delete(r.dagIdsByExternalId, sqlchelpers.UUIDToStr(dag.ExternalID))
+		delete(r.dags, id)
+	}
+
+	return nil
+}
+
+func (r *memoryOLAPEventRepository) ReadTaskRun(ctx context.Context, taskExternalId string) (*olapv2.V2TasksOlap, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	taskId, ok := r.taskIdsByExternalId[taskExternalId]
+
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+
+	task := r.tasks[taskId]
+	state := r.taskState(taskId)
+
+	return &olapv2.V2TasksOlap{
+		TenantID:           task.TenantID,
+		ID:                 task.ID,
+		InsertedAt:         task.InsertedAt,
+		ExternalID:         task.ExternalID,
+		Queue:              task.Queue,
+		ActionID:           task.ActionID,
+		StepID:             task.StepID,
+		WorkflowID:         task.WorkflowID,
+		ScheduleTimeout:    task.ScheduleTimeout,
+		StepTimeout:        task.StepTimeout,
+		Priority:           task.Priority,
+		Sticky:             olapv2.V2StickyStrategyOlap(task.Sticky),
+		DesiredWorkerID:    task.DesiredWorkerID,
+		DisplayName:        task.DisplayName,
+		Input:              task.Input,
+		AdditionalMetadata: task.AdditionalMetadata,
+		ReadableStatus:     state.status,
+		LatestRetryCount:   state.retryCount,
+		LatestWorkerID:     state.latestWorkerId,
+		DagID:              task.DagID,
+		DagInsertedAt:      task.DagInsertedAt,
+	}, nil
+}
+
+func (r *memoryOLAPEventRepository) ReadWorkflowRun(ctx context.Context, workflowRunExternalId pgtype.UUID) (*V2WorkflowRunPopulator, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	dagId, ok := r.dagIdsByExternalId[sqlchelpers.UUIDToStr(workflowRunExternalId)]
+
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+
+	taskMetadata := make([]TaskMetadata, 0)
+
+	for _, taskId := range r.dagTaskIds[dagId] {
+		taskMetadata = append(taskMetadata, TaskMetadata{
+			TaskID:         taskId,
+			TaskInsertedAt: r.tasks[taskId].InsertedAt.Time,
+		})
+	}
+
+	return &V2WorkflowRunPopulator{
+		WorkflowRun:  r.dagRunData(r.dags[dagId]),
+		TaskMetadata: taskMetadata,
+	}, nil
+}
+
+func (r *memoryOLAPEventRepository) ReadTaskRunData(ctx context.Context, tenantId pgtype.UUID, taskId int64, taskInsertedAt pgtype.Timestamptz) (*olapv2.PopulateSingleTaskRunDataRow, *pgtype.UUID, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	task, ok := r.tasks[taskId]
+
+	if !ok || task.TenantID != tenantId {
+		return nil, nil, pgx.ErrNoRows
+	}
+
+	state := r.taskState(taskId)
+
+	workflowRunId := task.ExternalID
+
+	if task.DagID.Valid {
+		dag, ok := r.dags[task.DagID.Int64]
+
+		if !ok {
+			return nil, nil, pgx.ErrNoRows
+		}
+
+		workflowRunId = dag.ExternalID
+	}
+
+	return &olapv2.PopulateSingleTaskRunDataRow{
+		TenantID:           task.TenantID,
+		ID:                 task.ID,
+		InsertedAt:         task.InsertedAt,
+		ExternalID:         task.ExternalID,
+		Queue:              task.Queue,
+		ActionID:           task.ActionID,
+		StepID:             task.StepID,
+		WorkflowID:         task.WorkflowID,
+		ScheduleTimeout:    task.ScheduleTimeout,
+		StepTimeout:        task.StepTimeout,
+		Priority:           task.Priority,
+		Sticky:             olapv2.V2StickyStrategyOlap(task.Sticky),
+		DesiredWorkerID:    task.DesiredWorkerID,
+		DisplayName:        task.DisplayName,
+		Input:              task.Input,
+		AdditionalMetadata: task.AdditionalMetadata,
+		ReadableStatus:     state.status,
+		LatestRetryCount:   state.retryCount,
+		LatestWorkerID:     state.latestWorkerId,
+		DagID:              task.DagID,
+		DagInsertedAt:      task.DagInsertedAt,
+		Status:             state.status,
+		FinishedAt:         state.finishedAt,
+		StartedAt:          state.startedAt,
+		Output:             state.output,
+		ErrorMessage:       state.errorMessage,
+	}, &workflowRunId, nil
+}
+
+func (r *memoryOLAPEventRepository) ListTasks(ctx context.Context, tenantId string, opts ListTaskRunOpts) ([]*olapv2.PopulateTaskRunDataRow, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	statuses := memoryStatusSet(opts.Statuses)
+	workflowIds := memoryUUIDSet(opts.WorkflowIds)
+
+	var errorRegex *regexp.Regexp
+
+	if opts.ErrorRegex != nil {
+		var err error
+
+		errorRegex, err = regexp.Compile(*opts.ErrorRegex)
+
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	matches := make([]*sqlcv2.V2Task, 0)
+
+	for _, task := range r.tasks {
+		if sqlchelpers.UUIDToStr(task.TenantID) != tenantId || task.InsertedAt.Time.Before(opts.CreatedAfter) {
+			continue
+		}
+
+		if opts.FinishedBefore != nil && task.InsertedAt.Time.After(*opts.FinishedBefore) {
+			continue
+		}
+
+		if len(workflowIds) > 0 && !workflowIds[sqlchelpers.UUIDToStr(task.WorkflowID)] {
+			continue
+		}
+
+		if !matchesAdditionalMetadata(task.AdditionalMetadata, opts.AdditionalMetadata) {
+			continue
+		}
+
+		if !r.matchesTaskSearch(task, opts, errorRegex) {
+			continue
+		}
+
+		state := r.taskState(task.ID)
+
+		if !statuses[state.status] {
+			continue
+		}
+
+		if opts.WorkerId != nil && sqlchelpers.UUIDToStr(state.latestWorkerId) != opts.WorkerId.String() {
+			continue
+		}
+
+		if opts.Cursor != nil && !memoryLess(opts.Cursor.InsertedAt, opts.Cursor.ID, task.InsertedAt.Time, task.ID) {
+			continue
+		}
+
+		matches = append(matches, task)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return memoryLess(matches[i].InsertedAt.Time, matches[i].ID, matches[j].InsertedAt.Time, matches[j].ID)
+	})
+
+	var count int
+
+	if opts.Cursor == nil {
+		count = min(len(matches), memoryOLAPMaxCount)
+		matches = memoryPage(matches, opts.Limit, opts.Offset)
+	} else {
+		matches = memoryPage(matches, opts.Limit, 0)
+	}
+
+	res := make([]*olapv2.PopulateTaskRunDataRow, 0, len(matches))
+
+	for _, task := range matches {
+		res = append(res, r.populateTask(task))
+	}
+
+	return res, count, nil
+}
+
+func (r *memoryOLAPEventRepository) ListWorkflowRuns(ctx context.Context, tenantId string, opts ListWorkflowRunOpts) ([]*WorkflowRunData, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	statuses := memoryStatusSet(opts.Statuses)
+	workflowIds := memoryUUIDSet(opts.WorkflowIds)
+
+	matches := make([]memoryRun, 0)
+
+	for _, run := range r.listRuns(tenantId, opts.CreatedAfter) {
+		if opts.FinishedBefore != nil && run.insertedAt.After(*opts.FinishedBefore) {
+			continue
+		}
+
+		if len(workflowIds) > 0 && !workflowIds[sqlchelpers.UUIDToStr(run.workflowId)] {
+			continue
+		}
+
+		if !statuses[run.status] || !matchesAdditionalMetadata(run.additionalMetadata, opts.AdditionalMetadata) {
+			continue
+		}
+
+		if opts.Cursor != nil && !memoryLess(opts.Cursor.InsertedAt, opts.Cursor.ID, run.insertedAt, run.id) {
+			continue
+		}
+
+		matches = append(matches, run)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return memoryLess(matches[i].insertedAt, matches[i].id, matches[j].insertedAt, matches[j].id)
+	})
+
+	var count int
+
+	if opts.Cursor == nil {
+		count = min(len(matches), memoryOLAPMaxCount)
+		matches = memoryPage(matches, opts.Limit, opts.Offset)
+	} else {
+		matches = memoryPage(matches, opts.Limit, 0)
+	}
+
+	res := make([]*WorkflowRunData, 0, len(matches))
+
+	for _, run := range matches {
+		if run.kind == olapv2.V2RunKindDAG {
+			res = append(res, r.dagRunData(r.dags[run.id]))
+			continue
+		}
+
+		res = append(res, r.taskRunData(r.tasks[run.id]))
+	}
+
+	return res, count, nil
+}
+
+func (r *memoryOLAPEventRepository) ListTaskRunEvents(ctx context.Context, tenantId string, taskId int64, taskInsertedAt pgtype.Timestamptz, limit, offset int64) ([]*olapv2.ListTaskEventsRow, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	task, ok := r.tasks[taskId]
+
+	if !ok || sqlchelpers.UUIDToStr(task.TenantID) != tenantId {
+		return []*olapv2.ListTaskEventsRow{}, nil
+	}
+
+	res := make([]*olapv2.ListTaskEventsRow, 0)
+
+	for _, agg := range aggregateTaskEvents(r.events[taskId]) {
+		res = append(res, &olapv2.ListTaskEventsRow{
+			TenantID:               agg.first.TenantID,
+			TaskID:                 agg.first.TaskID,
+			TaskInsertedAt:         agg.first.TaskInsertedAt,
+			RetryCount:             agg.first.RetryCount,
+			EventType:              agg.first.EventType,
+			TimeFirstSeen:          sqlchelpers.TimestamptzFromTime(agg.timeFirstSeen),
+			TimeLastSeen:           sqlchelpers.TimestamptzFromTime(agg.timeLastSeen),
+			Count:                  agg.count,
+			ID:                     agg.first.ID,
+			EventTimestamp:         agg.first.EventTimestamp,
+			ReadableStatus:         agg.first.ReadableStatus,
+			ErrorMessage:           agg.first.ErrorMessage,
+			Output:                 agg.first.Output,
+			WorkerID:               agg.first.WorkerID,
+			AdditionalEventData:    agg.first.AdditionalEventData,
+			AdditionalEventMessage: agg.first.AdditionalEventMessage,
+		})
+	}
+
+	return res, nil
+}
+
+func (r *memoryOLAPEventRepository) ListTaskRunEventsByWorkflowRunId(ctx context.Context, tenantId string, workflowRunId pgtype.UUID) ([]*olapv2.ListTaskEventsForWorkflowRunRow, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	dagId, ok := r.dagIdsByExternalId[sqlchelpers.UUIDToStr(workflowRunId)]
+
+	if !ok || sqlchelpers.UUIDToStr(r.dags[dagId].TenantID) != tenantId {
+		return []*olapv2.ListTaskEventsForWorkflowRunRow{}, nil
+	}
+
+	events := make([]*memoryTaskEvent, 0)
+
+	for _, taskId := range r.dagTaskIds[dagId] {
+		events = append(events, r.events[taskId]...)
+	}
+
+	res := make([]*olapv2.ListTaskEventsForWorkflowRunRow, 0)
+
+	for _, agg := range aggregateTaskEvents(events) {
+		task := r.tasks[agg.first.TaskID]
+
+		res = append(res, &olapv2.ListTaskEventsForWorkflowRunRow{
+			TenantID:               agg.first.TenantID,
+			TaskID:                 agg.first.TaskID,
+			TaskInsertedAt:         agg.first.TaskInsertedAt,
+			RetryCount:             agg.first.RetryCount,
+			EventType:              agg.first.EventType,
+			TimeFirstSeen:          sqlchelpers.TimestamptzFromTime(agg.timeFirstSeen),
+			TimeLastSeen:           sqlchelpers.TimestamptzFromTime(agg.timeLastSeen),
+			Count:                  agg.count,
+			ID:                     agg.first.ID,
+			EventTimestamp:         agg.first.EventTimestamp,
+			ReadableStatus:         agg.first.ReadableStatus,
+			ErrorMessage:           agg.first.ErrorMessage,
+			Output:                 agg.first.Output,
+			WorkerID:               agg.first.WorkerID,
+			AdditionalEventData:    agg.first.AdditionalEventData,
+			AdditionalEventMessage: agg.first.AdditionalEventMessage,
+			DisplayName:            task.DisplayName,
+			TaskExternalID:         task.ExternalID,
+		})
+	}
+
+	return res, nil
+}
+
+func (r *memoryOLAPEventRepository) ReadTaskRunMetrics(ctx context.Context, tenantId string, opts ReadTaskRunMetricsOpts) ([]olap.TaskRunMetric, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	workflowIds := memoryUUIDSet(opts.WorkflowIds)
+	counts := make(map[olapv2.V2ReadableStatusOlap]uint64)
+
+	for _, run := range r.listRuns(tenantId, opts.CreatedAfter) {
+		if len(workflowIds) > 0 && !workflowIds[sqlchelpers.UUIDToStr(run.workflowId)] {
+			continue
+		}
+
+		counts[run.status]++
+	}
+
+	metrics := make([]olap.TaskRunMetric, 0)
+
+	for _, status := range []olapv2.V2ReadableStatusOlap{
+		olapv2.V2ReadableStatusOlapQUEUED,
+		olapv2.V2ReadableStatusOlapRUNNING,
+		olapv2.V2ReadableStatusOlapCOMPLETED,
+		olapv2.V2ReadableStatusOlapCANCELLED,
+		olapv2.V2ReadableStatusOlapFAILED,
+	} {
+		metrics = append(metrics, olap.TaskRunMetric{
+			Status: string(status),
+			Count:  counts[status],
+		})
+	}
+
+	return metrics, nil
+}
+
+func (r *memoryOLAPEventRepository) CreateTasks(ctx context.Context, tenantId string, tasks []*sqlcv2.V2Task) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, task := range tasks {
+		if _, ok := r.tasks[task.ID]; ok {
+			continue
+		}
+
+		taskCp := *task
+
+		r.tasks[task.ID] = &taskCp
+		r.taskIdsByExternalId[sqlchelpers.UUIDToStr(task.ExternalID)] = task.ID
+
+		if task.DagID.Valid {
+			r.dagTaskIds[task.DagID.Int64] = append(r.dagTaskIds[task.DagID.Int64], task.ID)
+		}
+	}
+
+	return nil
+}
+
+func (r *memoryOLAPEventRepository) CreateTaskEvents(ctx context.Context, tenantId string, events []olapv2.CreateTaskEventsOLAPParams) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	for _, event := range events {
+		key := getCacheKey(event)
+
+		// skip any events which have a corresponding event already
+		if _, ok := r.eventKeys[key]; ok {
+			continue
+		}
+
+		if !event.EventTimestamp.Valid {
+			event.EventTimestamp = sqlchelpers.TimestamptzFromTime(now)
+		}
+
+		r.nextEventId++
+		r.eventKeys[key] = struct{}{}
+
+		r.events[event.TaskID] = append(r.events[event.TaskID], &memoryTaskEvent{
+			CreateTaskEventsOLAPParams: event,
+			ID:                         r.nextEventId,
+			InsertedAt:                 now,
+		})
+	}
+
+	return nil
+}
+
+func (r *memoryOLAPEventRepository) CreateDAGs(ctx context.Context, tenantId string, dags []*v2.DAGWithData) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, dag := range dags {
+		if _, ok := r.dags[dag.ID]; ok {
+			continue
+		}
+
+		dagCp := *dag
+
+		r.dags[dag.ID] = &dagCp
+		r.dagIdsByExternalId[sqlchelpers.UUIDToStr(dag.ExternalID)] = dag.ID
+	}
+
+	return nil
+}
+
+func (r *memoryOLAPEventRepository) GetTaskPointMetrics(ctx context.Context, tenantId string, startTimestamp *time.Time, endTimestamp *time.Time, bucketInterval time.Duration) ([]*olapv2.GetTaskPointMetricsRow, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if bucketInterval <= 0 {
+		bucketInterval = time.Minute
+	}
+
+	buckets := make(map[time.Time]*olapv2.GetTaskPointMetricsRow)
+
+	for _, events := range r.events {
+		for _, event := range events {
+			if sqlchelpers.UUIDToStr(event.TenantID) != tenantId {
+				continue
+			}
+
+			insertedAt := event.TaskInsertedAt.Time
+
+			if insertedAt.Before(*startTimestamp) || insertedAt.After(*endTimestamp) {
+				continue
+			}
+
+			bucket := insertedAt.UTC().Truncate(bucketInterval)
+
+			row, ok := buckets[bucket]
+
+			if !ok {
+				row = &olapv2.GetTaskPointMetricsRow{
+					Bucket2: sqlchelpers.TimestamptzFromTime(bucket),
+				}
+
+				buckets[bucket] = row
+			}
+
+			switch event.ReadableStatus {
+			case olapv2.V2ReadableStatusOlapCOMPLETED:
+				row.CompletedCount++
+			case olapv2.V2ReadableStatusOlapFAILED:
+				row.FailedCount++
+			}
+		}
+	}
+
+	res := make([]*olapv2.GetTaskPointMetricsRow, 0, len(buckets))
+
+	for _, row := range buckets {
+		res = append(res, row)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Bucket2.Time.Before(res[j].Bucket2.Time)
+	})
+
+	return res, nil
+}
+
+// UpdateTaskStatuses doesn't store task statuses, since they are computed from the task events on read, but
+// reports the tasks outside of a DAG which have moved into a final status since the last call.
+func (r *memoryOLAPEventRepository) UpdateTaskStatuses(ctx context.Context, tenantId string, onFinished FinishedWorkflowRunsHandler) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	updated := make(map[int64]memoryTaskStatus)
+	finished := make([]FinishedWorkflowRun, 0)
+
+	for id, task := range r.tasks {
+		if task.DagID.Valid || sqlchelpers.UUIDToStr(task.TenantID) != tenantId {
+			continue
+		}
+
+		state := r.taskState(id)
+		status := memoryTaskStatus{status: state.status, retryCount: state.retryCount}
+
+		if prev, ok := r.updatedTaskStatuses[id]; ok && prev == status {
+			continue
+		}
+
+		updated[id] = status
+
+		if isFinalStatus(status.status) {
+			finished = append(finished, FinishedWorkflowRun{
+				ExternalId: task.ExternalID,
+				WorkflowId: task.WorkflowID,
+				Status:     status.status,
+			})
+		}
+	}
+
+	if onFinished != nil && len(finished) > 0 {
+		if err := onFinished(ctx, finished); err != nil {
+			return false, err
+		}
+	}
+
+	for id, status := range updated {
+		r.updatedTaskStatuses[id] = status
+	}
+
+	return false, nil
+}
+
+// UpdateDAGStatuses doesn't store DAG statuses, since they are computed from the task events on read, but
+// reports the DAGs which have moved into a final status since the last call.
+func (r *memoryOLAPEventRepository) UpdateDAGStatuses(ctx context.Context, tenantId string, onFinished FinishedWorkflowRunsHandler) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	updated := make(map[int64]olapv2.V2ReadableStatusOlap)
+	finished := make([]FinishedWorkflowRun, 0)
+
+	for id, dag := range r.dags {
+		if sqlchelpers.UUIDToStr(dag.TenantID) != tenantId {
+			continue
+		}
+
+		status := r.dagStatus(id)
+
+		if prev, ok := r.updatedDAGStatuses[id]; ok && prev == status {
+			continue
+		}
+
+		updated[id] = status
+
+		if isFinalStatus(status) {
+			finished = append(finished, FinishedWorkflowRun{
+				ExternalId: dag.ExternalID,
+				WorkflowId: dag.WorkflowID,
+				Status:     status,
+			})
+		}
+	}
+
+	if onFinished != nil && len(finished) > 0 {
+		if err := onFinished(ctx, finished); err != nil {
+			return false, err
+		}
+	}
+
+	for id, status := range updated {
+		r.updatedDAGStatuses[id] = status
+	}
+
+	return false, nil
+}
+
+func (r *memoryOLAPEventRepository) ReportsFinishedWorkflowRuns() bool {
+	return true
+}
+
+func isFinalStatus(status olapv2.V2ReadableStatusOlap) bool {
+	switch status {
+	case olapv2.V2ReadableStatusOlapCOMPLETED, olapv2.V2ReadableStatusOlapFAILED, olapv2.V2ReadableStatusOlapCANCELLED:
+		return true
+	}
+
+	return false
+}
+
+func (r *memoryOLAPEventRepository) ReadDAG(ctx context.Context, dagExternalId string) (*olapv2.V2DagsOlap, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	dagId, ok := r.dagIdsByExternalId[dagExternalId]
+
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+
+	dag := r.dags[dagId]
+
+	return &olapv2.V2DagsOlap{
+		ID:                 dag.ID,
+		InsertedAt:         dag.InsertedAt,
+		TenantID:           dag.TenantID,
+		ExternalID:         dag.ExternalID,
+		DisplayName:        dag.DisplayName,
+		WorkflowID:         dag.WorkflowID,
+		WorkflowVersionID:  dag.WorkflowVersionID,
+		ReadableStatus:     r.dagStatus(dagId),
+		Input:              dag.Input,
+		AdditionalMetadata: dag.AdditionalMetadata,
+	}, nil
+}
+
+func (r *memoryOLAPEventRepository) ListTasksByDAGId(ctx context.Context, tenantId string, dagIds []pgtype.UUID) ([]*olapv2.PopulateTaskRunDataRow, map[int64]uuid.UUID, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	taskIdToDagExternalId := make(map[int64]uuid.UUID)
+	res := make([]*olapv2.PopulateTaskRunDataRow, 0)
+
+	for _, dagExternalId := range dagIds {
+		dagId, ok := r.dagIdsByExternalId[sqlchelpers.UUIDToStr(dagExternalId)]
+
+		if !ok || sqlchelpers.UUIDToStr(r.dags[dagId].TenantID) != tenantId {
+			continue
+		}
+
+		for _, taskId := range r.dagTaskIds[dagId] {
+			taskIdToDagExternalId[taskId] = uuid.UUID(dagExternalId.Bytes)
+			res = append(res, r.populateTask(r.tasks[taskId]))
+		}
+	}
+
+	return res, taskIdToDagExternalId, nil
+}
+
+func (r *memoryOLAPEventRepository) ListTasksByIdAndInsertedAt(ctx context.Context, tenantId string, taskMetadata []TaskMetadata) ([]*olapv2.PopulateTaskRunDataRow, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*olapv2.PopulateTaskRunDataRow, 0, len(taskMetadata))
+
+	for _, metadata := range taskMetadata {
+		task, ok := r.tasks[metadata.TaskID]
+
+		if !ok || sqlchelpers.UUIDToStr(task.TenantID) != tenantId {
+			continue
+		}
+
+		res = append(res, r.populateTask(task))
+	}
+
+	return res, nil
+}
+
+func (r *memoryOLAPEventRepository) ListTasksByExternalIds(ctx context.Context, tenantId string, externalIds []string) ([]*olapv2.PopulateTaskRunDataRow, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*olapv2.PopulateTaskRunDataRow, 0, len(externalIds))
+
+	for _, externalId := range externalIds {
+		taskId, ok := r.taskIdsByExternalId[externalId]
+
+		if !ok || sqlchelpers.UUIDToStr(r.tasks[taskId].TenantID) != tenantId {
+			continue
+		}
+
+		res = append(res, r.populateTask(r.tasks[taskId]))
+	}
+
+	return res, nil
+}
+
+func (r *memoryOLAPEventRepository) ListWorkflowRunsByExternalIds(ctx context.Context, tenantId string, externalIds []string) ([]*WorkflowRunData, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*WorkflowRunData, 0, len(externalIds))
+
+	for _, externalId := range externalIds {
+		if dagId, ok := r.dagIdsByExternalId[externalId]; ok {
+			if sqlchelpers.UUIDToStr(r.dags[dagId].TenantID) == tenantId {
+				res = append(res, r.dagRunData(r.dags[dagId]))
+			}
+
+			continue
+		}
+
+		taskId, ok := r.taskIdsByExternalId[externalId]
+
+		if !ok || r.tasks[taskId].DagID.Valid || sqlchelpers.UUIDToStr(r.tasks[taskId].TenantID) != tenantId {
+			continue
+		}
+
+		res = append(res, r.taskRunData(r.tasks[taskId]))
+	}
+
+	return res, nil
+}
+
+// taskState computes the state of a task from the events of its latest retry. The read lock must be held.
+func (r *memoryOLAPEventRepository) taskState(taskId int64) memoryTaskState {
+	state := memoryTaskState{
+		status: olapv2.V2ReadableStatusOlapQUEUED,
+	}
+
+	events := r.events[taskId]
+
+	for _, event := range events {
+		state.retryCount = max(state.retryCount, event.RetryCount)
+	}
+
+	var latestWorkerAt, errorAt, outputAt time.Time
+
+	for _, event := range events {
+		if event.WorkerID.Valid && !event.EventTimestamp.Time.Before(latestWorkerAt) {
+			latestWorkerAt = event.EventTimestamp.Time
+			state.latestWorkerId = event.WorkerID
+		}
+
+		if event.RetryCount != state.retryCount {
+			continue
+		}
+
+		if readableStatusOrder[event.ReadableStatus] > readableStatusOrder[state.status] {
+			state.status = event.ReadableStatus
+		}
+
+		ts := event.EventTimestamp
+
+		switch event.ReadableStatus {
+		case olapv2.V2ReadableStatusOlapCOMPLETED, olapv2.V2ReadableStatusOlapFAILED, olapv2.V2ReadableStatusOlapCANCELLED:
+			if !state.finishedAt.Valid || ts.Time.After(state.finishedAt.Time) {
+				state.finishedAt = ts
+			}
+		}
+
+		if event.EventType == olapv2.V2EventTypeOlapSTARTED && (!state.startedAt.Valid || ts.Time.After(state.startedAt.Time)) {
+			state.startedAt = ts
+		}
+
+		if event.ReadableStatus == olapv2.V2ReadableStatusOlapFAILED && !ts.Time.Before(errorAt) {
+			errorAt = ts.Time
+			state.errorMessage = event.ErrorMessage
+		}
+
+		if event.EventType == olapv2.V2EventTypeOlapFINISHED && !ts.Time.Before(outputAt) {
+			outputAt = ts.Time
+			state.output = event.Output
+		}
+	}
+
+	return state
+}
+
+// dagStatus derives the status of a DAG from the statuses of its tasks, using the same rules as the
+// DAG status updates in Postgres. The read lock must be held.
+func (r *memoryOLAPEventRepository) dagStatus(dagId int64) olapv2.V2ReadableStatusOlap {
+	counts := make(map[olapv2.V2ReadableStatusOlap]int)
+	taskIds := r.dagTaskIds[dagId]
+
+	for _, taskId := range taskIds {
+		counts[r.taskState(taskId).status]++
+	}
+
+	switch {
+	case len(taskIds) == 0 || counts[olapv2.V2ReadableStatusOlapQUEUED] == len(taskIds):
+		return olapv2.V2ReadableStatusOlapQUEUED
+	case counts[olapv2.V2ReadableStatusOlapRUNNING] > 0 || counts[olapv2.V2ReadableStatusOlapQUEUED] > 0:
+		return olapv2.V2ReadableStatusOlapRUNNING
+	case counts[olapv2.V2ReadableStatusOlapFAILED] > 0:
+		return olapv2.V2ReadableStatusOlapFAILED
+	case counts[olapv2.V2ReadableStatusOlapCANCELLED] > 0:
+		return olapv2.V2ReadableStatusOlapCANCELLED
+	case counts[olapv2.V2ReadableStatusOlapCOMPLETED] == len(taskIds):
+		return olapv2.V2ReadableStatusOlapCOMPLETED
+	default:
+		return olapv2.V2ReadableStatusOlapRUNNING
+	}
+}
+
+// dagRunData populates a DAG with the timestamps and error message from the events of its tasks. The
+// read lock must be held.
+func (r *memoryOLAPEventRepository) dagRunData(dag *v2.DAGWithData) *WorkflowRunData {
+	res := &WorkflowRunData{
+		ID:                 dag.ID,
+		TenantID:           dag.TenantID,
+		InsertedAt:         dag.InsertedAt,
+		ExternalID:         dag.ExternalID,
+		ReadableStatus:     r.dagStatus(dag.ID),
+		Kind:               olapv2.V2RunKindDAG,
+		WorkflowID:         dag.WorkflowID,
+		DisplayName:        dag.DisplayName,
+		AdditionalMetadata: dag.AdditionalMetadata,
+		WorkflowVersionId:  dag.WorkflowVersionID,
+		Input:              dag.Input,
+	}
+
+	var errorRetryCount int32 = -1
+
+	for _, taskId := range r.dagTaskIds[dag.ID] {
+		for _, event := range r.events[taskId] {
+			insertedAt := event.InsertedAt
+
+			if !res.CreatedAt.Valid || insertedAt.Before(res.CreatedAt.Time) {
+				res.CreatedAt = sqlchelpers.TimestamptzFromTime(insertedAt)
+			}
+
+			switch event.ReadableStatus {
+			case olapv2.V2ReadableStatusOlapRUNNING:
+				if !res.StartedAt.Valid || insertedAt.Before(res.StartedAt.Time) {
+					res.StartedAt = sqlchelpers.TimestamptzFromTime(insertedAt)
+				}
+			case olapv2.V2ReadableStatusOlapCOMPLETED, olapv2.V2ReadableStatusOlapCANCELLED, olapv2.V2ReadableStatusOlapFAILED:
+				if !res.FinishedAt.Valid || insertedAt.After(res.FinishedAt.Time) {
+					res.FinishedAt = sqlchelpers.TimestamptzFromTime(insertedAt)
+				}
+			}
+
+			if event.ReadableStatus == olapv2.V2ReadableStatusOlapFAILED && event.RetryCount > errorRetryCount {
+				errorRetryCount = event.RetryCount
+				res.ErrorMessage = event.ErrorMessage.String
+			}
+		}
+	}
+
+	return res
+}
+
+// taskRunData populates a task which isn't part of a DAG as a workflow run. The read lock must be held.
+func (r *memoryOLAPEventRepository) taskRunData(task *sqlcv2.V2Task) *WorkflowRunData {
+	populated := r.populateTask(task)
+
+	return &WorkflowRunData{
+		ID:                 populated.ID,
+		TenantID:           populated.TenantID,
+		InsertedAt:         populated.InsertedAt,
+		ExternalID:         populated.ExternalID,
+		WorkflowID:         populated.WorkflowID,
+		DisplayName:        populated.DisplayName,
+		ReadableStatus:     populated.Status,
+		AdditionalMetadata: populated.AdditionalMetadata,
+		CreatedAt:          populated.InsertedAt,
+		StartedAt:          populated.StartedAt,
+		FinishedAt:         populated.FinishedAt,
+		ErrorMessage:       populated.ErrorMessage.String,
+		Kind:               olapv2.V2RunKindTASK,
+	}
+}
+
+func (r *memoryOLAPEventRepository) populateTask(task *sqlcv2.V2Task) *olapv2.PopulateTaskRunDataRow {
+	state := r.taskState(task.ID)
+
+	return &olapv2.PopulateTaskRunDataRow{
+		TenantID:           task.TenantID,
+		ID:                 task.ID,
+		InsertedAt:         task.InsertedAt,
+		ExternalID:         task.ExternalID,
+		Queue:              task.Queue,
+		ActionID:           task.ActionID,
+		StepID:             task.StepID,
+		WorkflowID:         task.WorkflowID,
+		ScheduleTimeout:    task.ScheduleTimeout,
+		StepTimeout:        task.StepTimeout,
+		Priority:           task.Priority,
+		Sticky:             olapv2.V2StickyStrategyOlap(task.Sticky),
+		DisplayName:        task.DisplayName,
+		AdditionalMetadata: task.AdditionalMetadata,
+		Status:             state.status,
+		FinishedAt:         state.finishedAt,
+		StartedAt:          state.startedAt,
+		ErrorMessage:       state.errorMessage,
+	}
+}
+
+// listRuns returns all tasks which don't belong to a DAG, and all DAGs, which were inserted after the
+// given time. The read lock must be held.
+func (r *memoryOLAPEventRepository) listRuns(tenantId string, since time.Time) []memoryRun {
+	res := make([]memoryRun, 0)
+
+	for _, task := range r.tasks {
+		if task.DagID.Valid || sqlchelpers.UUIDToStr(task.TenantID) != tenantId || task.InsertedAt.Time.Before(since) {
+			continue
+		}
+
+		res = append(res, memoryRun{
+			id:                 task.ID,
+			insertedAt:         task.InsertedAt.Time,
+			kind:               olapv2.V2RunKindTASK,
+			workflowId:         task.WorkflowID,
+			additionalMetadata: task.AdditionalMetadata,
+			status:             r.taskState(task.ID).status,
+		})
+	}
+
+	for _, dag := range r.dags {
+		if sqlchelpers.UUIDToStr(dag.TenantID) != tenantId || dag.InsertedAt.Time.Before(since) {
+			continue
+		}
+
+		res = append(res, memoryRun{
+			id:                 dag.ID,
+			insertedAt:         dag.InsertedAt.Time,
+			kind:               olapv2.V2RunKindDAG,
+			workflowId:         dag.WorkflowID,
+			additionalMetadata: dag.AdditionalMetadata,
+			status:             r.dagStatus(dag.ID),
+		})
+	}
+
+	return res
+}
+
+type memoryAggregatedEvent struct {
+	first         *memoryTaskEvent
+	timeFirstSeen time.Time
+	timeLastSeen  time.Time
+	count         int64
+}
+
+// aggregateTaskEvents groups events by task, retry count and event type, which is the equivalent of the
+// ListTaskEvents query. Results are ordered by the time the event was first seen, descending.
+func aggregateTaskEvents(events []*memoryTaskEvent) []*memoryAggregatedEvent {
+	type aggKey struct {
+		taskId     int64
+		retryCount int32
+		eventType  olapv2.V2EventTypeOlap
+	}
+
+	aggs := make(map[aggKey]*memoryAggregatedEvent)
+
+	for _, event := range events {
+		key := aggKey{event.TaskID, event.RetryCount, event.EventType}
+		ts := event.EventTimestamp.Time
+
+		agg, ok := aggs[key]
+
+		if !ok {
+			aggs[key] = &memoryAggregatedEvent{
+				first:         event,
+				timeFirstSeen: ts,
+				timeLastSeen:  ts,
+				count:         1,
+			}
+
+			continue
+		}
+
+		agg.count++
+
+		if ts.Before(agg.timeFirstSeen) {
+			agg.timeFirstSeen = ts
+		}
+
+		if ts.After(agg.timeLastSeen) {
+			agg.timeLastSeen = ts
+		}
+
+		if event.ID < agg.first.ID {
+			agg.first = event
+		}
+	}
+
+	res := make([]*memoryAggregatedEvent, 0, len(aggs))
+
+	for _, agg := range aggs {
+		res = append(res, agg)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].timeFirstSeen.Equal(res[j].timeFirstSeen) {
+			return res[i].timeFirstSeen.After(res[j].timeFirstSeen)
+		}
+
+		return res[i].first.EventTimestamp.Time.After(res[j].first.EventTimestamp.Time)
+	})
+
+	return res
+}
+
+// matchesAdditionalMetadata returns true if any of the key-value pairs in the filter are present in the
+// additional metadata, which is the same behavior as the Postgres queries.
+func matchesAdditionalMetadata(additionalMetadata []byte, filter map[string]interface{}) bool {
+	if len(filter) == 0 {
+		return true
+	}
+
+	metadata := make(map[string]interface{})
+
+	if err := json.Unmarshal(additionalMetadata, &metadata); err != nil {
+		return false
+	}
+
+	for key, value := range filter {
+		if v, ok := metadata[key]; ok && fmt.Sprintf("%v", v) == fmt.Sprintf("%v", value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesTaskSearch returns whether a task matches the display name, error and JSON filters of opts. Each
+// event filter may be matched by a different event of the task.
+func (r *memoryOLAPEventRepository) matchesTaskSearch(task *sqlcv2.V2Task, opts ListTaskRunOpts, errorRegex *regexp.Regexp) bool {
+	if opts.DisplayName != nil && !strings.Contains(strings.ToLower(task.DisplayName), strings.ToLower(*opts.DisplayName)) {
+		return false
+	}
+
+	if !matchesJSONFilters(task.Input, opts.InputFilters) {
+		return false
+	}
+
+	matchedErrorContains := opts.ErrorContains == nil
+	matchedErrorRegex := errorRegex == nil
+	matchedOutput := len(opts.OutputFilters) == 0
+
+	for _, event := range r.events[task.ID] {
+		if event.ErrorMessage.Valid {
+			if opts.ErrorContains != nil && strings.Contains(strings.ToLower(event.ErrorMessage.String), strings.ToLower(*opts.ErrorContains)) {
+				matchedErrorContains = true
+			}
+
+			if errorRegex != nil && errorRegex.MatchString(event.ErrorMessage.String) {
+				matchedErrorRegex = true
+			}
+		}
+
+		if len(event.Output) > 0 && len(opts.OutputFilters) > 0 && matchesJSONFilters(event.Output, opts.OutputFilters) {
+			matchedOutput = true
+		}
+	}
+
+	return matchedErrorContains && matchedErrorRegex && matchedOutput
+}
+
+func matchesJSONFilters(doc []byte, filters []JSONFilter) bool {
+	if len(filters) == 0 {
+		return true
+	}
+
+	var parsed interface{}
+
+	if err := json.Unmarshal(doc, &parsed); err != nil {
+		return false
+	}
+
+	for _, filter := range filters {
+		curr := parsed
+
+		for _, key := range filter.Path {
+			obj, ok := curr.(map[string]interface{})
+
+			if !ok {
+				return false
+			}
+
+			if curr, ok = obj[key]; !ok {
+				return false
+			}
+		}
+
+		if curr != filter.Value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func memoryStatusSet(statuses []gen.V2TaskStatus) map[olapv2.V2ReadableStatusOlap]bool {
+	res := make(map[olapv2.V2ReadableStatusOlap]bool)
+
+	for _, status := range statuses {
+		res[olapv2.V2ReadableStatusOlap(status)] = true
+	}
+
+	if len(res) == 0 {
+		for status := range readableStatusOrder {
+			res[status] = true
+		}
+	}
+
+	return res
+}
+
+func memoryUUIDSet(ids []uuid.UUID) map[string]bool {
+	res := make(map[string]bool, len(ids))
+
+	for _, id := range ids {
+		res[id.String()] = true
+	}
+
+	return res
+}
+
+// memoryLess orders rows by inserted_at and id, descending
+func memoryLess(insertedAtI time.Time, idI int64, insertedAtJ time.Time, idJ int64) bool {
+	if !insertedAtI.Equal(insertedAtJ) {
+		return insertedAtI.After(insertedAtJ)
+	}
+
+	return idI > idJ
+}
+
+func memoryPage[T any](items []T, limit, offset int64) []T {
+	if offset >= int64(len(items)) {
+		return []T{}
+	}
+
+	items = items[offset:]
+
+	if limit > 0 && limit < int64(len(items)) {
+		items = items[:limit]
+	}
+
+	return items
+}
@@ -0,0 +1,543 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/olapv2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+func newTestMemoryTask(tenantId, workflowId string, id int64, insertedAt time.Time, dag *v2.DAGWithData) *sqlcv2.V2Task {
+	task := &sqlcv2.V2Task{
+		ID:                 id,
+		InsertedAt:         sqlchelpers.TimestamptzFromTime(insertedAt),
+		TenantID:           sqlchelpers.UUIDFromStr(tenantId),
+		Queue:              "default",
+		ActionID:           "test:step",
+		StepID:             sqlchelpers.UUIDFromStr(uuid.NewString()),
+		WorkflowID:         sqlchelpers.UUIDFromStr(workflowId),
+		ScheduleTimeout:    "5m",
+		Sticky:             sqlcv2.V2StickyStrategyNONE,
+		ExternalID:         sqlchelpers.UUIDFromStr(uuid.NewString()),
+		DisplayName:        "step",
+		Input:              []byte(`{}`),
+		AdditionalMetadata: []byte(`{"key":"value"}`),
+	}
+
+	if dag != nil {
+		task.DagID = pgtype.Int8{Int64: dag.ID, Valid: true}
+		task.DagInsertedAt = dag.InsertedAt
+	}
+
+	return task
+}
+
+func newTestMemoryEvent(task *sqlcv2.V2Task, eventType olapv2.V2EventTypeOlap, status olapv2.V2ReadableStatusOlap, retryCount int32, ts time.Time) olapv2.CreateTaskEventsOLAPParams {
+	return olapv2.CreateTaskEventsOLAPParams{
+		TenantID:       task.TenantID,
+		TaskID:         task.ID,
+		TaskInsertedAt: task.InsertedAt,
+		EventType:      eventType,
+		WorkflowID:     task.WorkflowID,
+		EventTimestamp: sqlchelpers.TimestamptzFromTime(ts),
+		ReadableStatus: status,
+		RetryCount:     retryCount,
+	}
+}
+
+func TestMemoryOLAPEventRepository_Tasks(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+	r := NewMemoryOLAPEventRepository(&l)
+
+	tenantId := uuid.NewString()
+	workflowId := uuid.NewString()
+	now := time.Now().UTC()
+
+	completed := newTestMemoryTask(tenantId, workflowId, 1, now.Add(-2*time.Minute), nil)
+	retried := newTestMemoryTask(tenantId, workflowId, 2, now.Add(-time.Minute), nil)
+
+	require.NoError(t, r.CreateTasks(ctx, tenantId, []*sqlcv2.V2Task{completed, retried}))
+
+	require.NoError(t, r.CreateTaskEvents(ctx, tenantId, []olapv2.CreateTaskEventsOLAPParams{
+		newTestMemoryEvent(completed, olapv2.V2EventTypeOlapSTARTED, olapv2.V2ReadableStatusOlapRUNNING, 0, now),
+		newTestMemoryEvent(completed, olapv2.V2EventTypeOlapFINISHED, olapv2.V2ReadableStatusOlapCOMPLETED, 0, now.Add(time.Second)),
+		newTestMemoryEvent(retried, olapv2.V2EventTypeOlapFAILED, olapv2.V2ReadableStatusOlapFAILED, 0, now),
+		newTestMemoryEvent(retried, olapv2.V2EventTypeOlapSTARTED, olapv2.V2ReadableStatusOlapRUNNING, 1, now.Add(time.Second)),
+	}))
+
+	tasks, count, err := r.ListTasks(ctx, tenantId, ListTaskRunOpts{
+		CreatedAfter: now.Add(-time.Hour),
+		Limit:        50,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.Len(t, tasks, 2)
+
+	// ordered by inserted_at descending, and the status is the status of the latest retry
+	assert.Equal(t, retried.ID, tasks[0].ID)
+	assert.Equal(t, olapv2.V2ReadableStatusOlapRUNNING, tasks[0].Status)
+	assert.Equal(t, completed.ID, tasks[1].ID)
+	assert.Equal(t, olapv2.V2ReadableStatusOlapCOMPLETED, tasks[1].Status)
+	assert.True(t, tasks[1].FinishedAt.Valid)
+
+	tasks, count, err = r.ListTasks(ctx, tenantId, ListTaskRunOpts{
+		CreatedAfter: now.Add(-time.Hour),
+		Statuses:     []gen.V2TaskStatus{gen.V2TaskStatusCOMPLETED},
+		Limit:        50,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	assert.Equal(t, completed.ID, tasks[0].ID)
+
+	_, count, err = r.ListTasks(ctx, uuid.NewString(), ListTaskRunOpts{
+		CreatedAfter: now.Add(-time.Hour),
+		Limit:        50,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	_, err = r.ReadTaskRun(ctx, uuid.NewString())
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestMemoryOLAPEventRepository_ListTasksCursor(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+	r := NewMemoryOLAPEventRepository(&l)
+
+	tenantId := uuid.NewString()
+	workflowId := uuid.NewString()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	// tasks 2 and 3 share an inserted_at, so the id is used as a tiebreaker
+	tasks := []*sqlcv2.V2Task{
+		newTestMemoryTask(tenantId, workflowId, 1, now.Add(-2*time.Minute), nil),
+		newTestMemoryTask(tenantId, workflowId, 2, now.Add(-time.Minute), nil),
+		newTestMemoryTask(tenantId, workflowId, 3, now.Add(-time.Minute), nil),
+		newTestMemoryTask(tenantId, workflowId, 4, now, nil),
+	}
+
+	require.NoError(t, r.CreateTasks(ctx, tenantId, tasks))
+
+	var (
+		cursor *ListCursor
+		ids    []int64
+	)
+
+	for {
+		page, count, err := r.ListTasks(ctx, tenantId, ListTaskRunOpts{
+			CreatedAfter: now.Add(-time.Hour),
+			Limit:        3,
+			Cursor:       cursor,
+		})
+
+		require.NoError(t, err)
+
+		if cursor != nil {
+			// counts are not computed when paginating with a cursor
+			assert.Equal(t, 0, count)
+		}
+
+		for _, task := range page {
+			ids = append(ids, task.ID)
+		}
+
+		if len(page) < 3 {
+			break
+		}
+
+		last := page[len(page)-1]
+
+		cursor, err = DecodeListCursor(ListCursor{InsertedAt: last.InsertedAt.Time, ID: last.ID}.Encode())
+
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []int64{4, 3, 2, 1}, ids)
+
+	_, err := DecodeListCursor("not-a-cursor")
+	assert.Error(t, err)
+}
+
+func TestMemoryOLAPEventRepository_ListTasksSearch(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+	r := NewMemoryOLAPEventRepository(&l)
+
+	tenantId := uuid.NewString()
+	workflowId := uuid.NewString()
+	now := time.Now().UTC()
+
+	failed := newTestMemoryTask(tenantId, workflowId, 1, now.Add(-time.Minute), nil)
+	failed.DisplayName = "charge-card"
+	failed.Input = []byte(`{"customer":{"id":"cus_1"}}`)
+
+	succeeded := newTestMemoryTask(tenantId, workflowId, 2, now, nil)
+	succeeded.DisplayName = "send-email"
+
+	require.NoError(t, r.CreateTasks(ctx, tenantId, []*sqlcv2.V2Task{failed, succeeded}))
+
+	failedEvent := newTestMemoryEvent(failed, olapv2.V2EventTypeOlapFAILED, olapv2.V2ReadableStatusOlapFAILED, 0, now)
+	failedEvent.ErrorMessage = sqlchelpers.TextFromStr("card declined: insufficient funds")
+
+	finishedEvent := newTestMemoryEvent(succeeded, olapv2.V2EventTypeOlapFINISHED, olapv2.V2ReadableStatusOlapCOMPLETED, 0, now)
+	finishedEvent.Output = []byte(`{"result":{"status":"sent","attempts":2}}`)
+
+	require.NoError(t, r.CreateTaskEvents(ctx, tenantId, []olapv2.CreateTaskEventsOLAPParams{failedEvent, finishedEvent}))
+
+	mustParse := func(filter string) JSONFilter {
+		parsed, err := ParseJSONFilter(filter)
+		require.NoError(t, err)
+		return parsed
+	}
+
+	str := func(s string) *string {
+		return &s
+	}
+
+	for name, tc := range map[string]struct {
+		opts     ListTaskRunOpts
+		expected []int64
+	}{
+		"display name":        {ListTaskRunOpts{DisplayName: str("CARD")}, []int64{1}},
+		"error contains":      {ListTaskRunOpts{ErrorContains: str("Declined")}, []int64{1}},
+		"error regex":         {ListTaskRunOpts{ErrorRegex: str(`^card \w+:`)}, []int64{1}},
+		"error regex no rows": {ListTaskRunOpts{ErrorRegex: str(`^declined`)}, []int64{}},
+		"input filter":        {ListTaskRunOpts{InputFilters: []JSONFilter{mustParse("customer.id:cus_1")}}, []int64{1}},
+		"output filters": {ListTaskRunOpts{OutputFilters: []JSONFilter{
+			mustParse("result.status:sent"),
+			mustParse("result.attempts:2"),
+		}}, []int64{2}},
+		"output filter type mismatch": {ListTaskRunOpts{OutputFilters: []JSONFilter{mustParse(`result.attempts:"2"`)}}, []int64{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tc.opts.CreatedAfter = now.Add(-time.Hour)
+			tc.opts.Limit = 50
+
+			tasks, _, err := r.ListTasks(ctx, tenantId, tc.opts)
+
+			require.NoError(t, err)
+
+			ids := make([]int64, 0, len(tasks))
+
+			for _, task := range tasks {
+				ids = append(ids, task.ID)
+			}
+
+			assert.Equal(t, tc.expected, ids)
+		})
+	}
+
+	_, err := ParseJSONFilter("no-value")
+	assert.Error(t, err)
+
+	_, err = ParseJSONFilter("a..b:1")
+	assert.Error(t, err)
+}
+
+func TestMemoryOLAPEventRepository_WorkflowRuns(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+	r := NewMemoryOLAPEventRepository(&l)
+
+	tenantId := uuid.NewString()
+	workflowId := uuid.NewString()
+	now := time.Now().UTC()
+
+	dag := &v2.DAGWithData{
+		V2Dag: &sqlcv2.V2Dag{
+			ID:                1,
+			InsertedAt:        sqlchelpers.TimestamptzFromTime(now.Add(-time.Minute)),
+			TenantID:          sqlchelpers.UUIDFromStr(tenantId),
+			ExternalID:        sqlchelpers.UUIDFromStr(uuid.NewString()),
+			DisplayName:       "dag",
+			WorkflowID:        sqlchelpers.UUIDFromStr(workflowId),
+			WorkflowVersionID: sqlchelpers.UUIDFromStr(uuid.NewString()),
+		},
+		Input:              []byte(`{}`),
+		AdditionalMetadata: []byte(`{}`),
+	}
+
+	first := newTestMemoryTask(tenantId, workflowId, 1, now.Add(-time.Minute), dag)
+	second := newTestMemoryTask(tenantId, workflowId, 2, now.Add(-time.Minute), dag)
+	standalone := newTestMemoryTask(tenantId, workflowId, 3, now, nil)
+
+	require.NoError(t, r.CreateDAGs(ctx, tenantId, []*v2.DAGWithData{dag}))
+	require.NoError(t, r.CreateTasks(ctx, tenantId, []*sqlcv2.V2Task{first, second, standalone}))
+
+	require.NoError(t, r.CreateTaskEvents(ctx, tenantId, []olapv2.CreateTaskEventsOLAPParams{
+		newTestMemoryEvent(first, olapv2.V2EventTypeOlapFINISHED, olapv2.V2ReadableStatusOlapCOMPLETED, 0, now),
+		newTestMemoryEvent(second, olapv2.V2EventTypeOlapFAILED, olapv2.V2ReadableStatusOlapFAILED, 0, now),
+	}))
+
+	runs, count, err := r.ListWorkflowRuns(ctx, tenantId, ListWorkflowRunOpts{
+		CreatedAfter: now.Add(-time.Hour),
+		Limit:        50,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.Len(t, runs, 2)
+
+	assert.Equal(t, olapv2.V2RunKindTASK, runs[0].Kind)
+	assert.Equal(t, olapv2.V2ReadableStatusOlapQUEUED, runs[0].ReadableStatus)
+	assert.Equal(t, olapv2.V2RunKindDAG, runs[1].Kind)
+	assert.Equal(t, olapv2.V2ReadableStatusOlapFAILED, runs[1].ReadableStatus)
+
+	workflowRun, err := r.ReadWorkflowRun(ctx, dag.ExternalID)
+
+	require.NoError(t, err)
+	assert.Len(t, workflowRun.TaskMetadata, 2)
+
+	metrics, err := r.ReadTaskRunMetrics(ctx, tenantId, ReadTaskRunMetricsOpts{
+		CreatedAfter: now.Add(-time.Hour),
+	})
+
+	require.NoError(t, err)
+
+	for _, metric := range metrics {
+		switch olapv2.V2ReadableStatusOlap(metric.Status) {
+		case olapv2.V2ReadableStatusOlapQUEUED, olapv2.V2ReadableStatusOlapFAILED:
+			assert.Equal(t, uint64(1), metric.Count, metric.Status)
+		default:
+			assert.Equal(t, uint64(0), metric.Count, metric.Status)
+		}
+	}
+}
+
+func TestMemoryOLAPEventRepository_UpdateDAGStatuses(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+	r := NewMemoryOLAPEventRepository(&l)
+
+	tenantId := uuid.NewString()
+	workflowId := uuid.NewString()
+	now := time.Now().UTC()
+
+	dag := &v2.DAGWithData{
+		V2Dag: &sqlcv2.V2Dag{
+			ID:                1,
+			InsertedAt:        sqlchelpers.TimestamptzFromTime(now),
+			TenantID:          sqlchelpers.UUIDFromStr(tenantId),
+			ExternalID:        sqlchelpers.UUIDFromStr(uuid.NewString()),
+			DisplayName:       "dag",
+			WorkflowID:        sqlchelpers.UUIDFromStr(workflowId),
+			WorkflowVersionID: sqlchelpers.UUIDFromStr(uuid.NewString()),
+		},
+		Input:              []byte(`{}`),
+		AdditionalMetadata: []byte(`{}`),
+	}
+
+	first := newTestMemoryTask(tenantId, workflowId, 1, now, dag)
+	second := newTestMemoryTask(tenantId, workflowId, 2, now, dag)
+
+	require.NoError(t, r.CreateDAGs(ctx, tenantId, []*v2.DAGWithData{dag}))
+	require.NoError(t, r.CreateTasks(ctx, tenantId, []*sqlcv2.V2Task{first, second}))
+	require.NoError(t, r.CreateTaskEvents(ctx, tenantId, []olapv2.CreateTaskEventsOLAPParams{
+		newTestMemoryEvent(first, olapv2.V2EventTypeOlapFINISHED, olapv2.V2ReadableStatusOlapCOMPLETED, 0, now),
+	}))
+
+	finished := updateStatuses(t, r.UpdateDAGStatuses, tenantId)
+
+	assert.Empty(t, finished)
+
+	require.NoError(t, r.CreateTaskEvents(ctx, tenantId, []olapv2.CreateTaskEventsOLAPParams{
+		newTestMemoryEvent(second, olapv2.V2EventTypeOlapFAILED, olapv2.V2ReadableStatusOlapFAILED, 0, now),
+	}))
+
+	finished = updateStatuses(t, r.UpdateDAGStatuses, tenantId)
+
+	require.Len(t, finished, 1)
+	assert.Equal(t, dag.ExternalID, finished[0].ExternalId)
+	assert.Equal(t, dag.WorkflowID, finished[0].WorkflowId)
+	assert.Equal(t, olapv2.V2ReadableStatusOlapFAILED, finished[0].Status)
+
+	// a finished DAG is only reported once
+	finished = updateStatuses(t, r.UpdateDAGStatuses, tenantId)
+
+	assert.Empty(t, finished)
+}
+
+func TestMemoryOLAPEventRepository_UpdateTaskStatuses(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+	r := NewMemoryOLAPEventRepository(&l)
+
+	tenantId := uuid.NewString()
+	workflowId := uuid.NewString()
+	now := time.Now().UTC()
+
+	task := newTestMemoryTask(tenantId, workflowId, 1, now, nil)
+
+	require.NoError(t, r.CreateTasks(ctx, tenantId, []*sqlcv2.V2Task{task}))
+	require.NoError(t, r.CreateTaskEvents(ctx, tenantId, []olapv2.CreateTaskEventsOLAPParams{
+		newTestMemoryEvent(task, olapv2.V2EventTypeOlapFAILED, olapv2.V2ReadableStatusOlapFAILED, 0, now),
+	}))
+
+	finished := updateStatuses(t, r.UpdateTaskStatuses, tenantId)
+
+	require.Len(t, finished, 1)
+	assert.Equal(t, task.ExternalID, finished[0].ExternalId)
+	assert.Equal(t, olapv2.V2ReadableStatusOlapFAILED, finished[0].Status)
+
+	// a retry which fails again is reported again
+	require.NoError(t, r.CreateTaskEvents(ctx, tenantId, []olapv2.CreateTaskEventsOLAPParams{
+		newTestMemoryEvent(task, olapv2.V2EventTypeOlapFAILED, olapv2.V2ReadableStatusOlapFAILED, 1, now.Add(time.Second)),
+	}))
+
+	finished = updateStatuses(t, r.UpdateTaskStatuses, tenantId)
+
+	assert.Len(t, finished, 1)
+
+	finished = updateStatuses(t, r.UpdateTaskStatuses, tenantId)
+
+	assert.Empty(t, finished)
+}
+
+func TestMemoryOLAPEventRepository_UpdateTaskStatusesHandlerError(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+	r := NewMemoryOLAPEventRepository(&l)
+
+	tenantId := uuid.NewString()
+	now := time.Now().UTC()
+
+	task := newTestMemoryTask(tenantId, uuid.NewString(), 1, now, nil)
+
+	require.NoError(t, r.CreateTasks(ctx, tenantId, []*sqlcv2.V2Task{task}))
+	require.NoError(t, r.CreateTaskEvents(ctx, tenantId, []olapv2.CreateTaskEventsOLAPParams{
+		newTestMemoryEvent(task, olapv2.V2EventTypeOlapFINISHED, olapv2.V2ReadableStatusOlapCOMPLETED, 0, now),
+	}))
+
+	handlerErr := errors.New("could not publish")
+
+	_, err := r.UpdateTaskStatuses(ctx, tenantId, func(ctx context.Context, runs []FinishedWorkflowRun) error {
+		return handlerErr
+	})
+
+	require.ErrorIs(t, err, handlerErr)
+
+	// the failed update isn't recorded, so the task is reported again
+	finished := updateStatuses(t, r.UpdateTaskStatuses, tenantId)
+
+	require.Len(t, finished, 1)
+	assert.Equal(t, task.ExternalID, finished[0].ExternalId)
+}
+
+func updateStatuses(t *testing.T, update func(context.Context, string, FinishedWorkflowRunsHandler) (bool, error), tenantId string) []FinishedWorkflowRun {
+	t.Helper()
+
+	finished := make([]FinishedWorkflowRun, 0)
+
+	_, err := update(context.Background(), tenantId, func(ctx context.Context, runs []FinishedWorkflowRun) error {
+		finished = append(finished, runs...)
+		return nil
+	})
+
+	require.NoError(t, err)
+
+	return finished
+}
+
+func TestMemoryOLAPEventRepository_ListTasksByExternalIds(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+	r := NewMemoryOLAPEventRepository(&l)
+
+	tenantId := uuid.NewString()
+	otherTenantId := uuid.NewString()
+	workflowId := uuid.NewString()
+	now := time.Now().UTC()
+
+	first := newTestMemoryTask(tenantId, workflowId, 1, now, nil)
+	second := newTestMemoryTask(tenantId, workflowId, 2, now, nil)
+	other := newTestMemoryTask(otherTenantId, workflowId, 3, now, nil)
+
+	require.NoError(t, r.CreateTasks(ctx, tenantId, []*sqlcv2.V2Task{first, second}))
+	require.NoError(t, r.CreateTasks(ctx, otherTenantId, []*sqlcv2.V2Task{other}))
+
+	rows, err := r.ListTasksByExternalIds(ctx, tenantId, []string{
+		sqlchelpers.UUIDToStr(first.ExternalID),
+		sqlchelpers.UUIDToStr(other.ExternalID),
+		uuid.NewString(),
+		sqlchelpers.UUIDToStr(second.ExternalID),
+	})
+	require.NoError(t, err)
+
+	// tasks which don't exist or belong to a different tenant are skipped
+	require.Len(t, rows, 2)
+	assert.Equal(t, first.ID, rows[0].ID)
+	assert.Equal(t, second.ID, rows[1].ID)
+	assert.Equal(t, olapv2.V2ReadableStatusOlapQUEUED, rows[0].Status)
+}
+
+func TestMemoryOLAPEventRepository_ListWorkflowRunsByExternalIds(t *testing.T) {
+	ctx := context.Background()
+	l := zerolog.Nop()
+	r := NewMemoryOLAPEventRepository(&l)
+
+	tenantId := uuid.NewString()
+	otherTenantId := uuid.NewString()
+	workflowId := uuid.NewString()
+	now := time.Now().UTC()
+
+	dag := &v2.DAGWithData{
+		V2Dag: &sqlcv2.V2Dag{
+			ID:          1,
+			InsertedAt:  sqlchelpers.TimestamptzFromTime(now),
+			TenantID:    sqlchelpers.UUIDFromStr(tenantId),
+			ExternalID:  sqlchelpers.UUIDFromStr(uuid.NewString()),
+			DisplayName: "dag",
+			WorkflowID:  sqlchelpers.UUIDFromStr(workflowId),
+		},
+		Input:              []byte(`{}`),
+		AdditionalMetadata: []byte(`{}`),
+	}
+
+	child := newTestMemoryTask(tenantId, workflowId, 1, now, dag)
+	standalone := newTestMemoryTask(tenantId, workflowId, 2, now, nil)
+	other := newTestMemoryTask(otherTenantId, workflowId, 3, now, nil)
+
+	require.NoError(t, r.CreateDAGs(ctx, tenantId, []*v2.DAGWithData{dag}))
+	require.NoError(t, r.CreateTasks(ctx, tenantId, []*sqlcv2.V2Task{child, standalone}))
+	require.NoError(t, r.CreateTasks(ctx, otherTenantId, []*sqlcv2.V2Task{other}))
+
+	require.NoError(t, r.CreateTaskEvents(ctx, tenantId, []olapv2.CreateTaskEventsOLAPParams{
+		newTestMemoryEvent(child, olapv2.V2EventTypeOlapFAILED, olapv2.V2ReadableStatusOlapFAILED, 0, now),
+	}))
+
+	runs, err := r.ListWorkflowRunsByExternalIds(ctx, tenantId, []string{
+		sqlchelpers.UUIDToStr(dag.ExternalID),
+		sqlchelpers.UUIDToStr(child.ExternalID),
+		sqlchelpers.UUIDToStr(standalone.ExternalID),
+		sqlchelpers.UUIDToStr(other.ExternalID),
+		uuid.NewString(),
+	})
+	require.NoError(t, err)
+
+	// tasks which belong to a DAG aren't workflow runs, and runs of other tenants are skipped
+	require.Len(t, runs, 2)
+	assert.Equal(t, dag.ExternalID, runs[0].ExternalID)
+	assert.Equal(t, olapv2.V2RunKindDAG, runs[0].Kind)
+	assert.Equal(t, olapv2.V2ReadableStatusOlapFAILED, runs[0].ReadableStatus)
+	assert.Equal(t, standalone.ExternalID, runs[1].ExternalID)
+	assert.Equal(t, olapv2.V2RunKindTASK, runs[1].Kind)
+	assert.Equal(t, olapv2.V2ReadableStatusOlapQUEUED, runs[1].ReadableStatus)
+}
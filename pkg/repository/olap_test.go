@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSONFilters(t *testing.T) {
+	filters, err := ParseJSONFilters([]string{"customer.id:cus_1", "customer.tier:paid", "count:5"})
+	require.NoError(t, err)
+	require.Len(t, filters, 3)
+
+	doc, err := jsonFilterContainment(filters)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"customer":{"id":"cus_1","tier":"paid"},"count":5}`, string(doc))
+
+	for _, conflicting := range [][]string{
+		{"a:1", "a:2"},
+		{"a:1", "a.b:2"},
+		{"a.b.c:1", "a.b:2"},
+	} {
+		_, err := ParseJSONFilters(conflicting)
+		assert.ErrorContains(t, err, "conflicting filters", conflicting)
+	}
+
+	// filters which share a prefix but not a full key don't conflict
+	_, err = ParseJSONFilters([]string{"a:1", "ab:2"})
+	assert.NoError(t, err)
+
+	_, err = jsonFilterContainment([]JSONFilter{{Path: []string{"a"}, Value: 1.0}, {Path: []string{"a", "b"}, Value: 2.0}})
+	assert.Error(t, err)
+}
+
+func TestValidateErrorRegex(t *testing.T) {
+	for _, pattern := range []string{
+		`^card \w+:`,
+		`timeout after \d{1,3}s$`,
+		`(?:connection|socket) (reset|refused)`,
+		`[[:alpha:]_]+Error`,
+		`[]\d-]`,
+		`[^\s]+\.go:\d+`,
+		`a{255}`,
+		`line one\nline two`,
+	} {
+		assert.NoError(t, ValidateErrorRegex(pattern), pattern)
+	}
+
+	for _, pattern := range []string{
+		`(`,
+		`\bword\b`,
+		`\Astart`,
+		`end\z`,
+		`(?i)declined`,
+		`(?P<code>\d+)`,
+		`\pL+`,
+		`[\D]`,
+		`a{256}`,
+	} {
+		assert.Error(t, ValidateErrorRegex(pattern), pattern)
+	}
+}
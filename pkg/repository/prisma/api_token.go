@@ -121,6 +121,10 @@ func (a *engineTokenRepository) CreateAPIToken(ctx context.Context, opts *reposi
 		createParams.Name = sqlchelpers.TextFromStr(*opts.Name)
 	}
 
+	if len(opts.Scopes) > 0 {
+		createParams.Scopes = opts.Scopes
+	}
+
 	return a.queries.CreateAPIToken(ctx, a.pool, createParams)
 }
 
@@ -129,3 +133,7 @@ func (a *engineTokenRepository) GetAPITokenById(ctx context.Context, id string)
 		return a.queries.GetAPITokenById(ctx, a.pool, sqlchelpers.UUIDFromStr(id))
 	})
 }
+
+func (a *engineTokenRepository) ListAPITokensByTenant(ctx context.Context, tenantId string) ([]*dbsqlc.APIToken, error) {
+	return a.queries.ListAPITokensByTenant(ctx, a.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
@@ -0,0 +1,155 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type auditLogRepository struct {
+	*sharedRepository
+}
+
+func NewAuditLogRepository(shared *sharedRepository) repository.AuditLogRepository {
+	return &auditLogRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *auditLogRepository) CreateAuditLog(ctx context.Context, tenantId string, opts *repository.CreateAuditLogOpts) (*dbsqlc.TenantAuditLog, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params, err := auditLogParams(tenantId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queries.CreateAuditLog(ctx, r.pool, params)
+}
+
+func (r *auditLogRepository) BufferAuditLog(tenantId string, opts *repository.CreateAuditLogOpts) error {
+	if err := r.v.Validate(opts); err != nil {
+		return err
+	}
+
+	return r.bulkAuditLogBuffer.FireForget(tenantId, &bufferedAuditLog{
+		tenantId: tenantId,
+		opts:     opts,
+	})
+}
+
+func auditLogParams(tenantId string, opts *repository.CreateAuditLogOpts) (dbsqlc.CreateAuditLogParams, error) {
+	params := dbsqlc.CreateAuditLogParams{
+		Tenantid:     sqlchelpers.UUIDFromStr(tenantId),
+		Actorkind:    opts.ActorKind,
+		Actorid:      sqlchelpers.UUIDFromStr(opts.ActorId),
+		Source:       opts.Source,
+		Action:       opts.Action,
+		Resourcetype: opts.ResourceType,
+	}
+
+	if opts.ActorName != nil {
+		params.ActorName = sqlchelpers.TextFromStr(*opts.ActorName)
+	}
+
+	if opts.ResourceId != nil {
+		params.ResourceId = sqlchelpers.TextFromStr(*opts.ResourceId)
+	}
+
+	if opts.IpAddress != nil {
+		params.IpAddress = sqlchelpers.TextFromStr(*opts.IpAddress)
+	}
+
+	if opts.UserAgent != nil {
+		params.UserAgent = sqlchelpers.TextFromStr(*opts.UserAgent)
+	}
+
+	if opts.Metadata != nil {
+		metadata, err := json.Marshal(opts.Metadata)
+
+		if err != nil {
+			return params, err
+		}
+
+		params.Metadata = metadata
+	}
+
+	return params, nil
+}
+
+func (r *auditLogRepository) ListAuditLogs(ctx context.Context, tenantId string, opts *repository.ListAuditLogsOpts) (*repository.ListAuditLogsResult, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	queryParams := dbsqlc.ListAuditLogsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	countParams := dbsqlc.CountAuditLogsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	if len(opts.Actions) > 0 {
+		queryParams.Actions = opts.Actions
+		countParams.Actions = opts.Actions
+	}
+
+	if opts.ActorId != nil {
+		queryParams.ActorId = sqlchelpers.UUIDFromStr(*opts.ActorId)
+		countParams.ActorId = sqlchelpers.UUIDFromStr(*opts.ActorId)
+	}
+
+	if opts.ResourceType != nil {
+		queryParams.ResourceType = sqlchelpers.TextFromStr(*opts.ResourceType)
+		countParams.ResourceType = sqlchelpers.TextFromStr(*opts.ResourceType)
+	}
+
+	if opts.ResourceId != nil {
+		queryParams.ResourceId = sqlchelpers.TextFromStr(*opts.ResourceId)
+		countParams.ResourceId = sqlchelpers.TextFromStr(*opts.ResourceId)
+	}
+
+	if opts.Since != nil {
+		queryParams.Since = pgtype.Timestamp{Time: opts.Since.UTC(), Valid: true}
+		countParams.Since = pgtype.Timestamp{Time: opts.Since.UTC(), Valid: true}
+	}
+
+	if opts.Until != nil {
+		queryParams.Until = pgtype.Timestamp{Time: opts.Until.UTC(), Valid: true}
+		countParams.Until = pgtype.Timestamp{Time: opts.Until.UTC(), Valid: true}
+	}
+
+	if opts.Offset != nil {
+		queryParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		queryParams.Limit = *opts.Limit
+	}
+
+	rows, err := r.queries.ListAuditLogs(ctx, r.pool, queryParams)
+
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := r.queries.CountAuditLogs(ctx, r.pool, countParams)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.ListAuditLogsResult{
+		Rows:  rows,
+		Count: int(count),
+	}, nil
+}
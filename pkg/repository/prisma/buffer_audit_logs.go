@@ -0,0 +1,98 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/buffer"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type bufferedAuditLog struct {
+	tenantId string
+	opts     *repository.CreateAuditLogOpts
+}
+
+func newAuditLogBuffer(shared *sharedRepository) (*buffer.TenantBufferManager[*bufferedAuditLog, dbsqlc.TenantAuditLog], error) {
+	auditLogBufOpts := buffer.TenantBufManagerOpts[*bufferedAuditLog, dbsqlc.TenantAuditLog]{
+		Name:       "create_audit_logs",
+		OutputFunc: shared.bulkWriteAuditLogs,
+		SizeFunc:   sizeOfAuditLog,
+		L:          shared.l,
+		V:          shared.v,
+	}
+
+	manager, err := buffer.NewTenantBufManager(auditLogBufOpts)
+
+	if err != nil {
+		shared.l.Err(err).Msg("could not create tenant buffer manager")
+		return nil, err
+	}
+
+	return manager, nil
+}
+
+func sizeOfAuditLog(item *bufferedAuditLog) int {
+	return 512
+}
+
+// bulkWriteAuditLogs writes a batch of audit log entries in a single transaction. Callers of BufferAuditLog
+// don't wait for the write, so a failed batch is retried before its entries are dropped.
+func (r *sharedRepository) bulkWriteAuditLogs(ctx context.Context, items []*bufferedAuditLog) ([]*dbsqlc.TenantAuditLog, error) {
+	params := make([]dbsqlc.CreateAuditLogParams, len(items))
+
+	for i, item := range items {
+		p, err := auditLogParams(item.tenantId, item.opts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		params[i] = p
+	}
+
+	var res []*dbsqlc.TenantAuditLog
+
+	err := sqlchelpers.WriteRetry(r.l, func() error {
+		var err error
+		res, err = r.writeAuditLogs(ctx, params)
+		return err
+	})
+
+	if err != nil {
+		r.l.Error().Err(err).Msgf("could not write %d audit log entries", len(items))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *sharedRepository) writeAuditLogs(ctx context.Context, params []dbsqlc.CreateAuditLogParams) ([]*dbsqlc.TenantAuditLog, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not prepare transaction: %w", err)
+	}
+
+	defer rollback()
+
+	res := make([]*dbsqlc.TenantAuditLog, 0, len(params))
+
+	for _, p := range params {
+		entry, err := r.queries.CreateAuditLog(ctx, tx, p)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create audit log entry: %w", err)
+		}
+
+		res = append(res, entry)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return res, nil
+}
@@ -14,7 +14,8 @@ INSERT INTO "APIToken" (
     "tenantId",
     "name",
     "expiresAt",
-    "internal"
+    "internal",
+    "scopes"
 ) VALUES (
     coalesce(@id::uuid, gen_random_uuid()),
     CURRENT_TIMESTAMP,
@@ -22,5 +23,18 @@ INSERT INTO "APIToken" (
     sqlc.narg('tenantId')::uuid,
     sqlc.narg('name')::text,
     @expiresAt::timestamp,
-    COALESCE(sqlc.narg('internal')::boolean, FALSE)
+    COALESCE(sqlc.narg('internal')::boolean, FALSE),
+    COALESCE(sqlc.narg('scopes')::text[], ARRAY['admin']::text[])
 ) RETURNING *;
+
+-- name: ListAPITokensByTenant :many
+SELECT
+    *
+FROM
+    "APIToken"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "revoked" = FALSE
+    AND "internal" = FALSE
+ORDER BY
+    "createdAt" DESC;
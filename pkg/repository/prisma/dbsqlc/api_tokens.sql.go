@@ -19,7 +19,8 @@ INSERT INTO "APIToken" (
     "tenantId",
     "name",
     "expiresAt",
-    "internal"
+    "internal",
+    "scopes"
 ) VALUES (
     coalesce($1::uuid, gen_random_uuid()),
     CURRENT_TIMESTAMP,
@@ -27,8 +28,9 @@ INSERT INTO "APIToken" (
     $2::uuid,
     $3::text,
     $4::timestamp,
-    COALESCE($5::boolean, FALSE)
-) RETURNING id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal
+    COALESCE($5::boolean, FALSE),
+    COALESCE($6::text[], ARRAY['admin']::text[])
+) RETURNING id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal, scopes
 `
 
 type CreateAPITokenParams struct {
@@ -37,6 +39,7 @@ type CreateAPITokenParams struct {
 	Name      pgtype.Text      `json:"name"`
 	Expiresat pgtype.Timestamp `json:"expiresat"`
 	Internal  pgtype.Bool      `json:"internal"`
+	Scopes    []string         `json:"scopes"`
 }
 
 func (q *Queries) CreateAPIToken(ctx context.Context, db DBTX, arg CreateAPITokenParams) (*APIToken, error) {
@@ -46,6 +49,7 @@ func (q *Queries) CreateAPIToken(ctx context.Context, db DBTX, arg CreateAPIToke
 		arg.Name,
 		arg.Expiresat,
 		arg.Internal,
+		arg.Scopes,
 	)
 	var i APIToken
 	err := row.Scan(
@@ -58,13 +62,14 @@ func (q *Queries) CreateAPIToken(ctx context.Context, db DBTX, arg CreateAPIToke
 		&i.TenantId,
 		&i.NextAlertAt,
 		&i.Internal,
+		&i.Scopes,
 	)
 	return &i, err
 }
 
 const getAPITokenById = `-- name: GetAPITokenById :one
 SELECT
-    id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal
+    id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal, scopes
 FROM
     "APIToken"
 WHERE
@@ -84,6 +89,51 @@ func (q *Queries) GetAPITokenById(ctx context.Context, db DBTX, id pgtype.UUID)
 		&i.TenantId,
 		&i.NextAlertAt,
 		&i.Internal,
+		&i.Scopes,
 	)
 	return &i, err
 }
+
+const listAPITokensByTenant = `-- name: ListAPITokensByTenant :many
+SELECT
+    id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal, scopes
+FROM
+    "APIToken"
+WHERE
+    "tenantId" = $1::uuid
+    AND "revoked" = FALSE
+    AND "internal" = FALSE
+ORDER BY
+    "createdAt" DESC
+`
+
+func (q *Queries) ListAPITokensByTenant(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*APIToken, error) {
+	rows, err := db.Query(ctx, listAPITokensByTenant, tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*APIToken
+	for rows.Next() {
+		var i APIToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExpiresAt,
+			&i.Revoked,
+			&i.Name,
+			&i.TenantId,
+			&i.NextAlertAt,
+			&i.Internal,
+			&i.Scopes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,100 @@
+-- name: CreateAuditLog :one
+INSERT INTO "TenantAuditLog" (
+    "id",
+    "createdAt",
+    "tenantId",
+    "actorKind",
+    "actorId",
+    "actorName",
+    "source",
+    "action",
+    "resourceType",
+    "resourceId",
+    "ipAddress",
+    "userAgent",
+    "metadata"
+) VALUES (
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    @actorKind::"AuditLogActorKind",
+    @actorId::uuid,
+    sqlc.narg('actorName')::text,
+    @source::"AuditLogSource",
+    @action::text,
+    @resourceType::text,
+    sqlc.narg('resourceId')::text,
+    sqlc.narg('ipAddress')::text,
+    sqlc.narg('userAgent')::text,
+    COALESCE(sqlc.narg('metadata')::jsonb, '{}'::jsonb)
+) RETURNING *;
+
+-- name: ListAuditLogs :many
+SELECT
+    *
+FROM
+    "TenantAuditLog"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND (
+        sqlc.narg('actions')::text[] IS NULL OR
+        "action" = ANY(sqlc.narg('actions')::text[])
+    )
+    AND (
+        sqlc.narg('actorId')::uuid IS NULL OR
+        "actorId" = sqlc.narg('actorId')::uuid
+    )
+    AND (
+        sqlc.narg('resourceType')::text IS NULL OR
+        "resourceType" = sqlc.narg('resourceType')::text
+    )
+    AND (
+        sqlc.narg('resourceId')::text IS NULL OR
+        "resourceId" = sqlc.narg('resourceId')::text
+    )
+    AND (
+        sqlc.narg('since')::timestamp IS NULL OR
+        "createdAt" >= sqlc.narg('since')::timestamp
+    )
+    AND (
+        sqlc.narg('until')::timestamp IS NULL OR
+        "createdAt" <= sqlc.narg('until')::timestamp
+    )
+ORDER BY
+    "createdAt" DESC, "id" DESC
+OFFSET
+    COALESCE(sqlc.narg('offset'), 0)
+LIMIT
+    COALESCE(sqlc.narg('limit'), 50);
+
+-- name: CountAuditLogs :one
+SELECT
+    count(*) AS total
+FROM
+    "TenantAuditLog"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND (
+        sqlc.narg('actions')::text[] IS NULL OR
+        "action" = ANY(sqlc.narg('actions')::text[])
+    )
+    AND (
+        sqlc.narg('actorId')::uuid IS NULL OR
+        "actorId" = sqlc.narg('actorId')::uuid
+    )
+    AND (
+        sqlc.narg('resourceType')::text IS NULL OR
+        "resourceType" = sqlc.narg('resourceType')::text
+    )
+    AND (
+        sqlc.narg('resourceId')::text IS NULL OR
+        "resourceId" = sqlc.narg('resourceId')::text
+    )
+    AND (
+        sqlc.narg('since')::timestamp IS NULL OR
+        "createdAt" >= sqlc.narg('since')::timestamp
+    )
+    AND (
+        sqlc.narg('until')::timestamp IS NULL OR
+        "createdAt" <= sqlc.narg('until')::timestamp
+    );
@@ -11,14 +11,99 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AuditLogActorKind string
+
+const (
+	AuditLogActorKindUSER     AuditLogActorKind = "USER"
+	AuditLogActorKindAPITOKEN AuditLogActorKind = "API_TOKEN"
+)
+
+func (e *AuditLogActorKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AuditLogActorKind(s)
+	case string:
+		*e = AuditLogActorKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AuditLogActorKind: %T", src)
+	}
+	return nil
+}
+
+type NullAuditLogActorKind struct {
+	AuditLogActorKind AuditLogActorKind `json:"AuditLogActorKind"`
+	Valid             bool              `json:"valid"` // Valid is true if AuditLogActorKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAuditLogActorKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.AuditLogActorKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AuditLogActorKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAuditLogActorKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AuditLogActorKind), nil
+}
+
+type AuditLogSource string
+
+const (
+	AuditLogSourceAPI  AuditLogSource = "API"
+	AuditLogSourceGRPC AuditLogSource = "GRPC"
+)
+
+func (e *AuditLogSource) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AuditLogSource(s)
+	case string:
+		*e = AuditLogSource(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AuditLogSource: %T", src)
+	}
+	return nil
+}
+
+type NullAuditLogSource struct {
+	AuditLogSource AuditLogSource `json:"AuditLogSource"`
+	Valid          bool           `json:"valid"` // Valid is true if AuditLogSource is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAuditLogSource) Scan(value interface{}) error {
+	if value == nil {
+		ns.AuditLogSource, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AuditLogSource.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAuditLogSource) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AuditLogSource), nil
+}
+
 type ConcurrencyLimitStrategy string
 
 const (
-	ConcurrencyLimitStrategyCANCELINPROGRESS ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
-	ConcurrencyLimitStrategyDROPNEWEST       ConcurrencyLimitStrategy = "DROP_NEWEST"
-	ConcurrencyLimitStrategyQUEUENEWEST      ConcurrencyLimitStrategy = "QUEUE_NEWEST"
-	ConcurrencyLimitStrategyGROUPROUNDROBIN  ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
-	ConcurrencyLimitStrategyCANCELNEWEST     ConcurrencyLimitStrategy = "CANCEL_NEWEST"
+	ConcurrencyLimitStrategyCANCELINPROGRESS   ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
+	ConcurrencyLimitStrategyDROPNEWEST         ConcurrencyLimitStrategy = "DROP_NEWEST"
+	ConcurrencyLimitStrategyQUEUENEWEST        ConcurrencyLimitStrategy = "QUEUE_NEWEST"
+	ConcurrencyLimitStrategyGROUPROUNDROBIN    ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
+	ConcurrencyLimitStrategyCANCELNEWEST       ConcurrencyLimitStrategy = "CANCEL_NEWEST"
+	ConcurrencyLimitStrategyWEIGHTEDROUNDROBIN ConcurrencyLimitStrategy = "WEIGHTED_ROUND_ROBIN"
 )
 
 func (e *ConcurrencyLimitStrategy) Scan(src interface{}) error {
@@ -405,6 +490,49 @@ func (ns NullMessageQueueItemStatus) Value() (driver.Value, error) {
 	return string(ns.MessageQueueItemStatus), nil
 }
 
+type RateLimitAlgorithm string
+
+const (
+	RateLimitAlgorithmFIXEDWINDOW   RateLimitAlgorithm = "FIXED_WINDOW"
+	RateLimitAlgorithmTOKENBUCKET   RateLimitAlgorithm = "TOKEN_BUCKET"
+	RateLimitAlgorithmSLIDINGWINDOW RateLimitAlgorithm = "SLIDING_WINDOW"
+)
+
+func (e *RateLimitAlgorithm) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RateLimitAlgorithm(s)
+	case string:
+		*e = RateLimitAlgorithm(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RateLimitAlgorithm: %T", src)
+	}
+	return nil
+}
+
+type NullRateLimitAlgorithm struct {
+	RateLimitAlgorithm RateLimitAlgorithm `json:"RateLimitAlgorithm"`
+	Valid              bool               `json:"valid"` // Valid is true if RateLimitAlgorithm is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRateLimitAlgorithm) Scan(value interface{}) error {
+	if value == nil {
+		ns.RateLimitAlgorithm, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RateLimitAlgorithm.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRateLimitAlgorithm) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RateLimitAlgorithm), nil
+}
+
 type StepExpressionKind string
 
 const (
@@ -771,10 +899,11 @@ func (ns NullTenantResourceLimitAlertType) Value() (driver.Value, error) {
 type V2ConcurrencyStrategy string
 
 const (
-	V2ConcurrencyStrategyNONE             V2ConcurrencyStrategy = "NONE"
-	V2ConcurrencyStrategyGROUPROUNDROBIN  V2ConcurrencyStrategy = "GROUP_ROUND_ROBIN"
-	V2ConcurrencyStrategyCANCELINPROGRESS V2ConcurrencyStrategy = "CANCEL_IN_PROGRESS"
-	V2ConcurrencyStrategyCANCELNEWEST     V2ConcurrencyStrategy = "CANCEL_NEWEST"
+	V2ConcurrencyStrategyNONE               V2ConcurrencyStrategy = "NONE"
+	V2ConcurrencyStrategyGROUPROUNDROBIN    V2ConcurrencyStrategy = "GROUP_ROUND_ROBIN"
+	V2ConcurrencyStrategyCANCELINPROGRESS   V2ConcurrencyStrategy = "CANCEL_IN_PROGRESS"
+	V2ConcurrencyStrategyCANCELNEWEST       V2ConcurrencyStrategy = "CANCEL_NEWEST"
+	V2ConcurrencyStrategyWEIGHTEDROUNDROBIN V2ConcurrencyStrategy = "WEIGHTED_ROUND_ROBIN"
 )
 
 func (e *V2ConcurrencyStrategy) Scan(src interface{}) error {
@@ -1112,6 +1241,49 @@ func (ns NullVcsProvider) Value() (driver.Value, error) {
 	return string(ns.VcsProvider), nil
 }
 
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSUCCEEDED WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus `json:"WebhookDeliveryStatus"`
+	Valid                 bool                  `json:"valid"` // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
 type WebhookWorkerRequestMethod string
 
 const (
@@ -1420,6 +1592,93 @@ func (ns NullWorkflowTriggerCronRefMethods) Value() (driver.Value, error) {
 	return string(ns.WorkflowTriggerCronRefMethods), nil
 }
 
+type WorkflowTriggerCronRefMissedFirePolicy string
+
+const (
+	WorkflowTriggerCronRefMissedFirePolicySKIP     WorkflowTriggerCronRefMissedFirePolicy = "SKIP"
+	WorkflowTriggerCronRefMissedFirePolicyFIREONCE WorkflowTriggerCronRefMissedFirePolicy = "FIRE_ONCE"
+	WorkflowTriggerCronRefMissedFirePolicyCATCHUP  WorkflowTriggerCronRefMissedFirePolicy = "CATCH_UP"
+)
+
+func (e *WorkflowTriggerCronRefMissedFirePolicy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkflowTriggerCronRefMissedFirePolicy(s)
+	case string:
+		*e = WorkflowTriggerCronRefMissedFirePolicy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkflowTriggerCronRefMissedFirePolicy: %T", src)
+	}
+	return nil
+}
+
+type NullWorkflowTriggerCronRefMissedFirePolicy struct {
+	WorkflowTriggerCronRefMissedFirePolicy WorkflowTriggerCronRefMissedFirePolicy `json:"WorkflowTriggerCronRefMissedFirePolicy"`
+	Valid                                  bool                                   `json:"valid"` // Valid is true if WorkflowTriggerCronRefMissedFirePolicy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkflowTriggerCronRefMissedFirePolicy) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkflowTriggerCronRefMissedFirePolicy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkflowTriggerCronRefMissedFirePolicy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkflowTriggerCronRefMissedFirePolicy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkflowTriggerCronRefMissedFirePolicy), nil
+}
+
+type WorkflowTriggerCronRefOverlapPolicy string
+
+const (
+	WorkflowTriggerCronRefOverlapPolicyALLOW          WorkflowTriggerCronRefOverlapPolicy = "ALLOW"
+	WorkflowTriggerCronRefOverlapPolicySKIPIFRUNNING  WorkflowTriggerCronRefOverlapPolicy = "SKIP_IF_RUNNING"
+	WorkflowTriggerCronRefOverlapPolicyCANCELPREVIOUS WorkflowTriggerCronRefOverlapPolicy = "CANCEL_PREVIOUS"
+	WorkflowTriggerCronRefOverlapPolicyQUEUEONE       WorkflowTriggerCronRefOverlapPolicy = "QUEUE_ONE"
+)
+
+func (e *WorkflowTriggerCronRefOverlapPolicy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkflowTriggerCronRefOverlapPolicy(s)
+	case string:
+		*e = WorkflowTriggerCronRefOverlapPolicy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkflowTriggerCronRefOverlapPolicy: %T", src)
+	}
+	return nil
+}
+
+type NullWorkflowTriggerCronRefOverlapPolicy struct {
+	WorkflowTriggerCronRefOverlapPolicy WorkflowTriggerCronRefOverlapPolicy `json:"WorkflowTriggerCronRefOverlapPolicy"`
+	Valid                               bool                                `json:"valid"` // Valid is true if WorkflowTriggerCronRefOverlapPolicy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkflowTriggerCronRefOverlapPolicy) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkflowTriggerCronRefOverlapPolicy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkflowTriggerCronRefOverlapPolicy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkflowTriggerCronRefOverlapPolicy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkflowTriggerCronRefOverlapPolicy), nil
+}
+
 type WorkflowTriggerScheduledRefMethods string
 
 const (
@@ -1472,6 +1731,7 @@ type APIToken struct {
 	TenantId    pgtype.UUID      `json:"tenantId"`
 	NextAlertAt pgtype.Timestamp `json:"nextAlertAt"`
 	Internal    bool             `json:"internal"`
+	Scopes      []string         `json:"scopes"`
 }
 
 type Action struct {
@@ -1624,6 +1884,17 @@ type MessageQueue struct {
 	ExclusiveConsumerId pgtype.UUID      `json:"exclusiveConsumerId"`
 }
 
+type MessageQueueDeadLetter struct {
+	ID         int64            `json:"id"`
+	CreatedAt  pgtype.Timestamp `json:"createdAt"`
+	QueueId    string           `json:"queueId"`
+	TenantId   pgtype.UUID      `json:"tenantId"`
+	MessageId  string           `json:"messageId"`
+	Payload    []byte           `json:"payload"`
+	Error      pgtype.Text      `json:"error"`
+	RetryCount int32            `json:"retryCount"`
+}
+
 type MessageQueueItem struct {
 	ID        int64                  `json:"id"`
 	Payload   []byte                 `json:"payload"`
@@ -1656,12 +1927,17 @@ type QueueItem struct {
 }
 
 type RateLimit struct {
-	TenantId   pgtype.UUID      `json:"tenantId"`
-	Key        string           `json:"key"`
-	LimitValue int32            `json:"limitValue"`
-	Value      int32            `json:"value"`
-	Window     string           `json:"window"`
-	LastRefill pgtype.Timestamp `json:"lastRefill"`
+	TenantId      pgtype.UUID        `json:"tenantId"`
+	Key           string             `json:"key"`
+	LimitValue    int32              `json:"limitValue"`
+	Value         int32              `json:"value"`
+	Window        string             `json:"window"`
+	LastRefill    pgtype.Timestamp   `json:"lastRefill"`
+	Dynamic       bool               `json:"dynamic"`
+	LastUsedAt    pgtype.Timestamp   `json:"lastUsedAt"`
+	Algorithm     RateLimitAlgorithm `json:"algorithm"`
+	Burst         pgtype.Int4        `json:"burst"`
+	PreviousUsage int32              `json:"previousUsage"`
 }
 
 type RetryQueueItem struct {
@@ -1757,10 +2033,11 @@ type StepDesiredWorkerLabel struct {
 }
 
 type StepExpression struct {
-	Key        string             `json:"key"`
-	StepId     pgtype.UUID        `json:"stepId"`
-	Expression string             `json:"expression"`
-	Kind       StepExpressionKind `json:"kind"`
+	Key               string             `json:"key"`
+	StepId            pgtype.UUID        `json:"stepId"`
+	Expression        string             `json:"expression"`
+	Kind              StepExpressionKind `json:"kind"`
+	CelLibraryVersion int32              `json:"celLibraryVersion"`
 }
 
 type StepOrder struct {
@@ -1901,6 +2178,22 @@ type TenantAlertingSettings struct {
 	EnableTenantResourceLimitAlerts bool             `json:"enableTenantResourceLimitAlerts"`
 }
 
+type TenantAuditLog struct {
+	ID           pgtype.UUID       `json:"id"`
+	CreatedAt    pgtype.Timestamp  `json:"createdAt"`
+	TenantId     pgtype.UUID       `json:"tenantId"`
+	ActorKind    AuditLogActorKind `json:"actorKind"`
+	ActorId      pgtype.UUID       `json:"actorId"`
+	ActorName    pgtype.Text       `json:"actorName"`
+	Source       AuditLogSource    `json:"source"`
+	Action       string            `json:"action"`
+	ResourceType string            `json:"resourceType"`
+	ResourceId   pgtype.Text       `json:"resourceId"`
+	IpAddress    pgtype.Text       `json:"ipAddress"`
+	UserAgent    pgtype.Text       `json:"userAgent"`
+	Metadata     []byte            `json:"metadata"`
+}
+
 type TenantInviteLink struct {
 	ID           pgtype.UUID      `json:"id"`
 	CreatedAt    pgtype.Timestamp `json:"createdAt"`
@@ -1914,12 +2207,13 @@ type TenantInviteLink struct {
 }
 
 type TenantMember struct {
-	ID        pgtype.UUID      `json:"id"`
-	CreatedAt pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt pgtype.Timestamp `json:"updatedAt"`
-	TenantId  pgtype.UUID      `json:"tenantId"`
-	UserId    pgtype.UUID      `json:"userId"`
-	Role      TenantMemberRole `json:"role"`
+	ID           pgtype.UUID      `json:"id"`
+	CreatedAt    pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt    pgtype.Timestamp `json:"updatedAt"`
+	TenantId     pgtype.UUID      `json:"tenantId"`
+	UserId       pgtype.UUID      `json:"userId"`
+	Role         TenantMemberRole `json:"role"`
+	CustomRoleId pgtype.UUID      `json:"customRoleId"`
 }
 
 type TenantResourceLimit struct {
@@ -1948,6 +2242,17 @@ type TenantResourceLimitAlert struct {
 	Limit           int32                        `json:"limit"`
 }
 
+type TenantRole struct {
+	ID          pgtype.UUID      `json:"id"`
+	CreatedAt   pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamp `json:"updatedAt"`
+	TenantId    pgtype.UUID      `json:"tenantId"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	Permissions []string         `json:"permissions"`
+	WorkflowIds []pgtype.UUID    `json:"workflowIds"`
+}
+
 type TenantVcsProvider struct {
 	ID          pgtype.UUID      `json:"id"`
 	CreatedAt   pgtype.Timestamp `json:"createdAt"`
@@ -2019,6 +2324,14 @@ type UserSession struct {
 	ExpiresAt pgtype.Timestamp `json:"expiresAt"`
 }
 
+type V2ConcurrencyKeyWeight struct {
+	TenantID   pgtype.UUID        `json:"tenant_id"`
+	StrategyID int64              `json:"strategy_id"`
+	Key        string             `json:"key"`
+	Weight     int32              `json:"weight"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
 type V2ConcurrencySlot struct {
 	TaskID            int64              `json:"task_id"`
 	TaskInsertedAt    pgtype.Timestamptz `json:"task_inserted_at"`
@@ -2059,31 +2372,41 @@ type V2DagToTask struct {
 	TaskInsertedAt pgtype.Timestamptz `json:"task_inserted_at"`
 }
 
+type V2DurableSleep struct {
+	ID            int64              `json:"id"`
+	TenantID      pgtype.UUID        `json:"tenant_id"`
+	SleepUntil    pgtype.Timestamptz `json:"sleep_until"`
+	SleepDuration string             `json:"sleep_duration"`
+}
+
 type V2Match struct {
-	ID                   int64              `json:"id"`
-	TenantID             pgtype.UUID        `json:"tenant_id"`
-	Kind                 V2MatchKind        `json:"kind"`
-	IsSatisfied          bool               `json:"is_satisfied"`
-	SignalTargetID       pgtype.Int8        `json:"signal_target_id"`
-	SignalKey            pgtype.Text        `json:"signal_key"`
-	TriggerDagID         pgtype.Int8        `json:"trigger_dag_id"`
-	TriggerDagInsertedAt pgtype.Timestamptz `json:"trigger_dag_inserted_at"`
-	TriggerStepID        pgtype.UUID        `json:"trigger_step_id"`
-	TriggerExternalID    pgtype.UUID        `json:"trigger_external_id"`
+	ID                            int64              `json:"id"`
+	TenantID                      pgtype.UUID        `json:"tenant_id"`
+	Kind                          V2MatchKind        `json:"kind"`
+	IsSatisfied                   bool               `json:"is_satisfied"`
+	SignalTargetID                pgtype.Int8        `json:"signal_target_id"`
+	SignalKey                     pgtype.Text        `json:"signal_key"`
+	TriggerDagID                  pgtype.Int8        `json:"trigger_dag_id"`
+	TriggerDagInsertedAt          pgtype.Timestamptz `json:"trigger_dag_inserted_at"`
+	TriggerStepID                 pgtype.UUID        `json:"trigger_step_id"`
+	TriggerExternalID             pgtype.UUID        `json:"trigger_external_id"`
+	TriggerExistingTaskID         pgtype.Int8        `json:"trigger_existing_task_id"`
+	TriggerExistingTaskInsertedAt pgtype.Timestamptz `json:"trigger_existing_task_inserted_at"`
 }
 
 type V2MatchCondition struct {
-	V2MatchID    int64                  `json:"v2_match_id"`
-	ID           int64                  `json:"id"`
-	TenantID     pgtype.UUID            `json:"tenant_id"`
-	RegisteredAt pgtype.Timestamptz     `json:"registered_at"`
-	EventType    V2EventType            `json:"event_type"`
-	EventKey     string                 `json:"event_key"`
-	IsSatisfied  bool                   `json:"is_satisfied"`
-	Action       V2MatchConditionAction `json:"action"`
-	OrGroupID    pgtype.UUID            `json:"or_group_id"`
-	Expression   pgtype.Text            `json:"expression"`
-	Data         []byte                 `json:"data"`
+	V2MatchID         int64                  `json:"v2_match_id"`
+	ID                int64                  `json:"id"`
+	TenantID          pgtype.UUID            `json:"tenant_id"`
+	RegisteredAt      pgtype.Timestamptz     `json:"registered_at"`
+	EventType         V2EventType            `json:"event_type"`
+	EventKey          string                 `json:"event_key"`
+	IsSatisfied       bool                   `json:"is_satisfied"`
+	Action            V2MatchConditionAction `json:"action"`
+	OrGroupID         pgtype.UUID            `json:"or_group_id"`
+	Expression        pgtype.Text            `json:"expression"`
+	Data              []byte                 `json:"data"`
+	CelLibraryVersion int32                  `json:"cel_library_version"`
 }
 
 type V2Queue struct {
@@ -2126,6 +2449,17 @@ type V2StepConcurrency struct {
 	Expression        string                `json:"expression"`
 	TenantID          pgtype.UUID           `json:"tenant_id"`
 	MaxConcurrency    int32                 `json:"max_concurrency"`
+	WeightExpression  pgtype.Text           `json:"weight_expression"`
+	CelLibraryVersion int32                 `json:"cel_library_version"`
+}
+
+type V2StepMatchCondition struct {
+	ID                int64       `json:"id"`
+	TenantID          pgtype.UUID `json:"tenant_id"`
+	StepID            pgtype.UUID `json:"step_id"`
+	EventKey          string      `json:"event_key"`
+	Expression        pgtype.Text `json:"expression"`
+	CelLibraryVersion int32       `json:"cel_library_version"`
 }
 
 type V2Task struct {
@@ -2173,12 +2507,61 @@ type V2TaskEvent struct {
 	Data       []byte           `json:"data"`
 }
 
+type V2TaskRateLimit struct {
+	TaskID         int64              `json:"task_id"`
+	TaskInsertedAt pgtype.Timestamptz `json:"task_inserted_at"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	Key            string             `json:"key"`
+	Units          int32              `json:"units"`
+	LimitValue     pgtype.Int4        `json:"limit_value"`
+	LimitWindow    pgtype.Text        `json:"limit_window"`
+}
+
 type V2TaskRuntime struct {
-	TaskID     int64            `json:"task_id"`
-	RetryCount int32            `json:"retry_count"`
-	WorkerID   pgtype.UUID      `json:"worker_id"`
-	TenantID   pgtype.UUID      `json:"tenant_id"`
-	TimeoutAt  pgtype.Timestamp `json:"timeout_at"`
+	TaskID       int64            `json:"task_id"`
+	RetryCount   int32            `json:"retry_count"`
+	WorkerID     pgtype.UUID      `json:"worker_id"`
+	TenantID     pgtype.UUID      `json:"tenant_id"`
+	TimeoutAt    pgtype.Timestamp `json:"timeout_at"`
+	SlotReleased bool             `json:"slot_released"`
+}
+
+type WebhookDelivery struct {
+	ID             pgtype.UUID           `json:"id"`
+	CreatedAt      pgtype.Timestamp      `json:"createdAt"`
+	UpdatedAt      pgtype.Timestamp      `json:"updatedAt"`
+	TenantId       pgtype.UUID           `json:"tenantId"`
+	SubscriptionId pgtype.UUID           `json:"subscriptionId"`
+	EventType      string                `json:"eventType"`
+	Payload        []byte                `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int32                 `json:"attempts"`
+	NextAttemptAt  pgtype.Timestamp      `json:"nextAttemptAt"`
+}
+
+type WebhookDeliveryAttempt struct {
+	ID                 pgtype.UUID      `json:"id"`
+	CreatedAt          pgtype.Timestamp `json:"createdAt"`
+	DeliveryId         pgtype.UUID      `json:"deliveryId"`
+	Attempt            int32            `json:"attempt"`
+	ResponseStatusCode pgtype.Int4      `json:"responseStatusCode"`
+	ResponseBody       pgtype.Text      `json:"responseBody"`
+	Error              pgtype.Text      `json:"error"`
+	DurationMs         int32            `json:"durationMs"`
+}
+
+type WebhookSubscription struct {
+	ID          pgtype.UUID      `json:"id"`
+	CreatedAt   pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt   pgtype.Timestamp `json:"updatedAt"`
+	TenantId    pgtype.UUID      `json:"tenantId"`
+	Name        string           `json:"name"`
+	Url         string           `json:"url"`
+	Secret      string           `json:"secret"`
+	Enabled     bool             `json:"enabled"`
+	EventTypes  []string         `json:"eventTypes"`
+	Statuses    []string         `json:"statuses"`
+	WorkflowIds []pgtype.UUID    `json:"workflowIds"`
 }
 
 type WebhookWorker struct {
@@ -2258,14 +2641,16 @@ type Workflow struct {
 }
 
 type WorkflowConcurrency struct {
-	ID                         pgtype.UUID              `json:"id"`
-	CreatedAt                  pgtype.Timestamp         `json:"createdAt"`
-	UpdatedAt                  pgtype.Timestamp         `json:"updatedAt"`
-	WorkflowVersionId          pgtype.UUID              `json:"workflowVersionId"`
-	GetConcurrencyGroupId      pgtype.UUID              `json:"getConcurrencyGroupId"`
-	MaxRuns                    int32                    `json:"maxRuns"`
-	LimitStrategy              ConcurrencyLimitStrategy `json:"limitStrategy"`
-	ConcurrencyGroupExpression pgtype.Text              `json:"concurrencyGroupExpression"`
+	ID                               pgtype.UUID              `json:"id"`
+	CreatedAt                        pgtype.Timestamp         `json:"createdAt"`
+	UpdatedAt                        pgtype.Timestamp         `json:"updatedAt"`
+	WorkflowVersionId                pgtype.UUID              `json:"workflowVersionId"`
+	GetConcurrencyGroupId            pgtype.UUID              `json:"getConcurrencyGroupId"`
+	MaxRuns                          int32                    `json:"maxRuns"`
+	LimitStrategy                    ConcurrencyLimitStrategy `json:"limitStrategy"`
+	ConcurrencyGroupExpression       pgtype.Text              `json:"concurrencyGroupExpression"`
+	ConcurrencyGroupWeightExpression pgtype.Text              `json:"concurrencyGroupWeightExpression"`
+	CelLibraryVersion                int32                    `json:"celLibraryVersion"`
 }
 
 type WorkflowRun struct {
@@ -2341,18 +2726,26 @@ type WorkflowToWorkflowTag struct {
 }
 
 type WorkflowTriggerCronRef struct {
-	ParentId           pgtype.UUID                   `json:"parentId"`
-	Cron               string                        `json:"cron"`
-	TickerId           pgtype.UUID                   `json:"tickerId"`
-	Input              []byte                        `json:"input"`
-	Enabled            bool                          `json:"enabled"`
-	AdditionalMetadata []byte                        `json:"additionalMetadata"`
-	CreatedAt          pgtype.Timestamp              `json:"createdAt"`
-	DeletedAt          pgtype.Timestamp              `json:"deletedAt"`
-	UpdatedAt          pgtype.Timestamp              `json:"updatedAt"`
-	Name               pgtype.Text                   `json:"name"`
-	ID                 pgtype.UUID                   `json:"id"`
-	Method             WorkflowTriggerCronRefMethods `json:"method"`
+	ParentId           pgtype.UUID                            `json:"parentId"`
+	Cron               string                                 `json:"cron"`
+	TickerId           pgtype.UUID                            `json:"tickerId"`
+	Input              []byte                                 `json:"input"`
+	Enabled            bool                                   `json:"enabled"`
+	AdditionalMetadata []byte                                 `json:"additionalMetadata"`
+	CreatedAt          pgtype.Timestamp                       `json:"createdAt"`
+	DeletedAt          pgtype.Timestamp                       `json:"deletedAt"`
+	UpdatedAt          pgtype.Timestamp                       `json:"updatedAt"`
+	Name               pgtype.Text                            `json:"name"`
+	ID                 pgtype.UUID                            `json:"id"`
+	Method             WorkflowTriggerCronRefMethods          `json:"method"`
+	MissedFirePolicy   WorkflowTriggerCronRefMissedFirePolicy `json:"missedFirePolicy"`
+	CatchUpLimit       int32                                  `json:"catchUpLimit"`
+	NextFireAt         pgtype.Timestamp                       `json:"nextFireAt"`
+	Timezone           pgtype.Text                            `json:"timezone"`
+	OverlapPolicy      WorkflowTriggerCronRefOverlapPolicy    `json:"overlapPolicy"`
+	LastRunExternalId  pgtype.UUID                            `json:"lastRunExternalId"`
+	LastFiredAt        pgtype.Timestamp                       `json:"lastFiredAt"`
+	QueuedFireAt       pgtype.Timestamp                       `json:"queuedFireAt"`
 }
 
 type WorkflowTriggerEventRef struct {
@@ -2375,6 +2768,7 @@ type WorkflowTriggerScheduledRef struct {
 	DeletedAt           pgtype.Timestamp                   `json:"deletedAt"`
 	UpdatedAt           pgtype.Timestamp                   `json:"updatedAt"`
 	Method              WorkflowTriggerScheduledRefMethods `json:"method"`
+	TriggeredAt         pgtype.Timestamp                   `json:"triggeredAt"`
 }
 
 type WorkflowTriggers struct {
@@ -122,3 +122,95 @@ WHERE "expiresAt" < NOW()
 AND
     "id" >= @minId::bigint
     AND "id" <= @maxId::bigint;
+
+-- name: RetryMessage :exec
+UPDATE
+    "MessageQueueItem"
+SET
+    "payload" = @retryPayload::jsonb,
+    "status" = 'PENDING',
+    "readAfter" = @retryAfter::timestamp,
+    "expiresAt" = @expiresAt::timestamp
+WHERE
+    "id" = @id::bigint
+    AND "status" = 'ASSIGNED';
+
+-- name: CreateMessageQueueDeadLetter :exec
+INSERT INTO
+    "MessageQueueDeadLetter" (
+        "queueId",
+        "tenantId",
+        "messageId",
+        "payload",
+        "error",
+        "retryCount"
+    )
+VALUES (
+    @queueId::text,
+    sqlc.narg('tenantId')::uuid,
+    @messageId::text,
+    @payload::jsonb,
+    sqlc.narg('error')::text,
+    @retryCount::integer
+);
+
+-- name: ListMessageQueueDeadLetters :many
+SELECT
+    *
+FROM
+    "MessageQueueDeadLetter"
+WHERE
+    (
+        "tenantId" = @tenantId::uuid
+        -- messages without a tenant are selected with a null tenant id
+        OR (@tenantId::uuid IS NULL AND "tenantId" IS NULL)
+    )
+    AND "queueId" = @queueId::text
+    AND (
+        sqlc.narg('ids')::bigint[] IS NULL
+        OR "id" = ANY(sqlc.narg('ids')::bigint[])
+    )
+ORDER BY
+    "id" ASC
+OFFSET
+    COALESCE(sqlc.narg('offset')::integer, 0)
+LIMIT
+    COALESCE(sqlc.narg('limit')::integer, 50);
+
+-- name: CountMessageQueueDeadLetters :one
+SELECT
+    COUNT(*) AS total
+FROM
+    "MessageQueueDeadLetter"
+WHERE
+    (
+        "tenantId" = @tenantId::uuid
+        -- messages without a tenant are selected with a null tenant id
+        OR (@tenantId::uuid IS NULL AND "tenantId" IS NULL)
+    )
+    AND "queueId" = @queueId::text
+    AND (
+        sqlc.narg('ids')::bigint[] IS NULL
+        OR "id" = ANY(sqlc.narg('ids')::bigint[])
+    );
+
+-- name: DeleteMessageQueueDeadLetters :execrows
+DELETE FROM
+    "MessageQueueDeadLetter"
+WHERE
+    (
+        "tenantId" = @tenantId::uuid
+        -- messages without a tenant are selected with a null tenant id
+        OR (@tenantId::uuid IS NULL AND "tenantId" IS NULL)
+    )
+    AND "queueId" = @queueId::text
+    AND (
+        sqlc.narg('ids')::bigint[] IS NULL
+        OR "id" = ANY(sqlc.narg('ids')::bigint[])
+    );
+
+-- name: CleanupMessageQueueDeadLetters :exec
+DELETE FROM
+    "MessageQueueDeadLetter"
+WHERE
+    "createdAt" < NOW() - INTERVAL '7 days';
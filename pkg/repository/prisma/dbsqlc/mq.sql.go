@@ -44,6 +44,18 @@ func (q *Queries) CleanupMessageQueue(ctx context.Context, db DBTX) error {
 	return err
 }
 
+const cleanupMessageQueueDeadLetters = `-- name: CleanupMessageQueueDeadLetters :exec
+DELETE FROM
+    "MessageQueueDeadLetter"
+WHERE
+    "createdAt" < NOW() - INTERVAL '7 days'
+`
+
+func (q *Queries) CleanupMessageQueueDeadLetters(ctx context.Context, db DBTX) error {
+	_, err := db.Exec(ctx, cleanupMessageQueueDeadLetters)
+	return err
+}
+
 const cleanupMessageQueueItems = `-- name: CleanupMessageQueueItems :exec
 DELETE FROM "MessageQueueItem"
 WHERE "expiresAt" < NOW()
@@ -62,6 +74,78 @@ func (q *Queries) CleanupMessageQueueItems(ctx context.Context, db DBTX, arg Cle
 	return err
 }
 
+const countMessageQueueDeadLetters = `-- name: CountMessageQueueDeadLetters :one
+SELECT
+    COUNT(*) AS total
+FROM
+    "MessageQueueDeadLetter"
+WHERE
+    (
+        "tenantId" = $1::uuid
+        -- messages without a tenant are selected with a null tenant id
+        OR ($1::uuid IS NULL AND "tenantId" IS NULL)
+    )
+    AND "queueId" = $2::text
+    AND (
+        $3::bigint[] IS NULL
+        OR "id" = ANY($3::bigint[])
+    )
+`
+
+type CountMessageQueueDeadLettersParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Queueid  string      `json:"queueid"`
+	Ids      []int64     `json:"ids"`
+}
+
+func (q *Queries) CountMessageQueueDeadLetters(ctx context.Context, db DBTX, arg CountMessageQueueDeadLettersParams) (int64, error) {
+	row := db.QueryRow(ctx, countMessageQueueDeadLetters, arg.Tenantid, arg.Queueid, arg.Ids)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const createMessageQueueDeadLetter = `-- name: CreateMessageQueueDeadLetter :exec
+INSERT INTO
+    "MessageQueueDeadLetter" (
+        "queueId",
+        "tenantId",
+        "messageId",
+        "payload",
+        "error",
+        "retryCount"
+    )
+VALUES (
+    $1::text,
+    $2::uuid,
+    $3::text,
+    $4::jsonb,
+    $5::text,
+    $6::integer
+)
+`
+
+type CreateMessageQueueDeadLetterParams struct {
+	Queueid    string      `json:"queueid"`
+	TenantId   pgtype.UUID `json:"tenantId"`
+	Messageid  string      `json:"messageid"`
+	Payload    []byte      `json:"payload"`
+	Error      pgtype.Text `json:"error"`
+	Retrycount int32       `json:"retrycount"`
+}
+
+func (q *Queries) CreateMessageQueueDeadLetter(ctx context.Context, db DBTX, arg CreateMessageQueueDeadLetterParams) error {
+	_, err := db.Exec(ctx, createMessageQueueDeadLetter,
+		arg.Queueid,
+		arg.TenantId,
+		arg.Messageid,
+		arg.Payload,
+		arg.Error,
+		arg.Retrycount,
+	)
+	return err
+}
+
 const deleteExpiredMessages = `-- name: DeleteExpiredMessages :exec
 DELETE FROM
     "MessageQueueItem"
@@ -74,6 +158,36 @@ func (q *Queries) DeleteExpiredMessages(ctx context.Context, db DBTX) error {
 	return err
 }
 
+const deleteMessageQueueDeadLetters = `-- name: DeleteMessageQueueDeadLetters :execrows
+DELETE FROM
+    "MessageQueueDeadLetter"
+WHERE
+    (
+        "tenantId" = $1::uuid
+        -- messages without a tenant are selected with a null tenant id
+        OR ($1::uuid IS NULL AND "tenantId" IS NULL)
+    )
+    AND "queueId" = $2::text
+    AND (
+        $3::bigint[] IS NULL
+        OR "id" = ANY($3::bigint[])
+    )
+`
+
+type DeleteMessageQueueDeadLettersParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Queueid  string      `json:"queueid"`
+	Ids      []int64     `json:"ids"`
+}
+
+func (q *Queries) DeleteMessageQueueDeadLetters(ctx context.Context, db DBTX, arg DeleteMessageQueueDeadLettersParams) (int64, error) {
+	result, err := db.Exec(ctx, deleteMessageQueueDeadLetters, arg.Tenantid, arg.Queueid, arg.Ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getMinMaxExpiredMessageQueueItems = `-- name: GetMinMaxExpiredMessageQueueItems :one
 SELECT
     COALESCE(MIN("id"), 0)::bigint AS "minId",
@@ -96,6 +210,73 @@ func (q *Queries) GetMinMaxExpiredMessageQueueItems(ctx context.Context, db DBTX
 	return &i, err
 }
 
+const listMessageQueueDeadLetters = `-- name: ListMessageQueueDeadLetters :many
+SELECT
+    id, "createdAt", "queueId", "tenantId", "messageId", payload, error, "retryCount"
+FROM
+    "MessageQueueDeadLetter"
+WHERE
+    (
+        "tenantId" = $1::uuid
+        -- messages without a tenant are selected with a null tenant id
+        OR ($1::uuid IS NULL AND "tenantId" IS NULL)
+    )
+    AND "queueId" = $2::text
+    AND (
+        $3::bigint[] IS NULL
+        OR "id" = ANY($3::bigint[])
+    )
+ORDER BY
+    "id" ASC
+OFFSET
+    COALESCE($4::integer, 0)
+LIMIT
+    COALESCE($5::integer, 50)
+`
+
+type ListMessageQueueDeadLettersParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Queueid  string      `json:"queueid"`
+	Ids      []int64     `json:"ids"`
+	Offset   pgtype.Int4 `json:"offset"`
+	Limit    pgtype.Int4 `json:"limit"`
+}
+
+func (q *Queries) ListMessageQueueDeadLetters(ctx context.Context, db DBTX, arg ListMessageQueueDeadLettersParams) ([]*MessageQueueDeadLetter, error) {
+	rows, err := db.Query(ctx, listMessageQueueDeadLetters,
+		arg.Tenantid,
+		arg.Queueid,
+		arg.Ids,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*MessageQueueDeadLetter
+	for rows.Next() {
+		var i MessageQueueDeadLetter
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.QueueId,
+			&i.TenantId,
+			&i.MessageId,
+			&i.Payload,
+			&i.Error,
+			&i.RetryCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const readMessages = `-- name: ReadMessages :many
 WITH messages AS (
     SELECT
@@ -165,6 +346,36 @@ func (q *Queries) ReadMessages(ctx context.Context, db DBTX, arg ReadMessagesPar
 	return items, nil
 }
 
+const retryMessage = `-- name: RetryMessage :exec
+UPDATE
+    "MessageQueueItem"
+SET
+    "payload" = $1::jsonb,
+    "status" = 'PENDING',
+    "readAfter" = $2::timestamp,
+    "expiresAt" = $3::timestamp
+WHERE
+    "id" = $4::bigint
+    AND "status" = 'ASSIGNED'
+`
+
+type RetryMessageParams struct {
+	Retrypayload []byte           `json:"retrypayload"`
+	Retryafter   pgtype.Timestamp `json:"retryafter"`
+	Expiresat    pgtype.Timestamp `json:"expiresat"`
+	ID           int64            `json:"id"`
+}
+
+func (q *Queries) RetryMessage(ctx context.Context, db DBTX, arg RetryMessageParams) error {
+	_, err := db.Exec(ctx, retryMessage,
+		arg.Retrypayload,
+		arg.Retryafter,
+		arg.Expiresat,
+		arg.ID,
+	)
+	return err
+}
+
 const sendMessage = `-- name: SendMessage :exec
 INSERT INTO
     "MessageQueueItem" (
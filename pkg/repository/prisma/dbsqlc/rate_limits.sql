@@ -4,17 +4,28 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "algorithm",
+    "burst"
 ) VALUES (
     @tenantId::uuid,
     @key::text,
     sqlc.arg('limit')::int,
-    sqlc.arg('limit')::int,
-    COALESCE(sqlc.narg('window')::text, '1 minute')
+    COALESCE(sqlc.narg('burst')::int, sqlc.arg('limit')::int),
+    COALESCE(sqlc.narg('window')::text, '1 minute'),
+    COALESCE(sqlc.narg('algorithm')::"RateLimitAlgorithm", 'FIXED_WINDOW'),
+    sqlc.narg('burst')::int
 ) ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = sqlc.arg('limit')::int,
     "window" = COALESCE(sqlc.narg('window')::text, '1 minute'),
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
+    "algorithm" = EXCLUDED."algorithm",
+    "burst" = EXCLUDED."burst",
+    -- the value is capped at the capacity of the updated rate limit, which is the burst for token buckets
+    "value" = LEAST("RateLimit"."value", EXCLUDED."value"),
+    -- the usage of the previous window is only tracked by sliding windows, so it's reset when the algorithm changes
+    "previousUsage" = CASE WHEN EXCLUDED."algorithm" = "RateLimit"."algorithm" THEN "RateLimit"."previousUsage" ELSE 0 END,
+    -- rate limits which are managed through the API are never garbage collected
+    "dynamic" = false
 RETURNING *;
 
 -- name: UpsertRateLimitsBulk :exec
@@ -74,22 +85,14 @@ FROM
 -- name: ListRateLimitsForTenantNoMutate :many
 -- Returns the same results as ListRateLimitsForTenantWithMutate but does not update the rate limit values
 SELECT
-    "tenantId",
-    "key",
-    "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
-    "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    rl."tenantId",
+    rl."key",
+    rl."limitValue",
+    -- refill_rate_limit and rate_limit_available are defined in the v2 schema, and apply the
+    -- rate limit's algorithm
+    rate_limit_available(refill_rate_limit(rl))::int AS "value",
+    rl."window",
+    (refill_rate_limit(rl))."lastRefill"::timestamp AS "lastRefill"
 FROM
     "RateLimit" rl
 WHERE
@@ -31,7 +31,7 @@ FROM
 WHERE
     rl."key" = input."key"
     AND rl."tenantId" = $1::uuid
-RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.dynamic, rl."lastUsedAt", rl.algorithm, rl.burst, rl."previousUsage"
 `
 
 type BulkUpdateRateLimitsParams struct {
@@ -56,6 +56,11 @@ func (q *Queries) BulkUpdateRateLimits(ctx context.Context, db DBTX, arg BulkUpd
 			&i.Value,
 			&i.Window,
 			&i.LastRefill,
+			&i.Dynamic,
+			&i.LastUsedAt,
+			&i.Algorithm,
+			&i.Burst,
+			&i.PreviousUsage,
 		); err != nil {
 			return nil, err
 		}
@@ -151,22 +156,14 @@ func (q *Queries) ListRateLimitsForSteps(ctx context.Context, db DBTX, arg ListR
 
 const listRateLimitsForTenantNoMutate = `-- name: ListRateLimitsForTenantNoMutate :many
 SELECT
-    "tenantId",
-    "key",
-    "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
-    "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    rl."tenantId",
+    rl."key",
+    rl."limitValue",
+    -- refill_rate_limit and rate_limit_available are defined in the v2 schema, and apply the
+    -- rate limit's algorithm
+    rate_limit_available(refill_rate_limit(rl))::int AS "value",
+    rl."window",
+    (refill_rate_limit(rl))."lastRefill"::timestamp AS "lastRefill"
 FROM
     "RateLimit" rl
 WHERE
@@ -259,10 +256,10 @@ WITH refill AS (
         END
     WHERE
         rl."tenantId" = $1::uuid
-    RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill"
+    RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill", dynamic, "lastUsedAt", algorithm, burst, "previousUsage"
 )
 SELECT
-    refill."tenantId", refill.key, refill."limitValue", refill.value, refill."window", refill."lastRefill",
+    refill."tenantId", refill.key, refill."limitValue", refill.value, refill."window", refill."lastRefill", refill.dynamic, refill."lastUsedAt", refill.algorithm, refill.burst, refill."previousUsage",
     -- return the next refill time
     (refill."lastRefill" + refill."window"::INTERVAL)::timestamp AS "nextRefillAt"
 FROM
@@ -270,13 +267,18 @@ FROM
 `
 
 type ListRateLimitsForTenantWithMutateRow struct {
-	TenantId     pgtype.UUID      `json:"tenantId"`
-	Key          string           `json:"key"`
-	LimitValue   int32            `json:"limitValue"`
-	Value        int32            `json:"value"`
-	Window       string           `json:"window"`
-	LastRefill   pgtype.Timestamp `json:"lastRefill"`
-	NextRefillAt pgtype.Timestamp `json:"nextRefillAt"`
+	TenantId      pgtype.UUID        `json:"tenantId"`
+	Key           string             `json:"key"`
+	LimitValue    int32              `json:"limitValue"`
+	Value         int32              `json:"value"`
+	Window        string             `json:"window"`
+	LastRefill    pgtype.Timestamp   `json:"lastRefill"`
+	Dynamic       bool               `json:"dynamic"`
+	LastUsedAt    pgtype.Timestamp   `json:"lastUsedAt"`
+	Algorithm     RateLimitAlgorithm `json:"algorithm"`
+	Burst         pgtype.Int4        `json:"burst"`
+	PreviousUsage int32              `json:"previousUsage"`
+	NextRefillAt  pgtype.Timestamp   `json:"nextRefillAt"`
 }
 
 func (q *Queries) ListRateLimitsForTenantWithMutate(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*ListRateLimitsForTenantWithMutateRow, error) {
@@ -295,6 +297,11 @@ func (q *Queries) ListRateLimitsForTenantWithMutate(ctx context.Context, db DBTX
 			&i.Value,
 			&i.Window,
 			&i.LastRefill,
+			&i.Dynamic,
+			&i.LastUsedAt,
+			&i.Algorithm,
+			&i.Burst,
+			&i.PreviousUsage,
 			&i.NextRefillAt,
 		); err != nil {
 			return nil, err
@@ -313,25 +320,38 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "algorithm",
+    "burst"
 ) VALUES (
     $1::uuid,
     $2::text,
     $3::int,
-    $3::int,
-    COALESCE($4::text, '1 minute')
+    COALESCE($4::int, $3::int),
+    COALESCE($5::text, '1 minute'),
+    COALESCE($6::"RateLimitAlgorithm", 'FIXED_WINDOW'),
+    $4::int
 ) ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = $3::int,
-    "window" = COALESCE($4::text, '1 minute'),
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
-RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill"
+    "window" = COALESCE($5::text, '1 minute'),
+    "algorithm" = EXCLUDED."algorithm",
+    "burst" = EXCLUDED."burst",
+    -- the value is capped at the capacity of the updated rate limit, which is the burst for token buckets
+    "value" = LEAST("RateLimit"."value", EXCLUDED."value"),
+    -- the usage of the previous window is only tracked by sliding windows, so it's reset when the algorithm changes
+    "previousUsage" = CASE WHEN EXCLUDED."algorithm" = "RateLimit"."algorithm" THEN "RateLimit"."previousUsage" ELSE 0 END,
+    -- rate limits which are managed through the API are never garbage collected
+    "dynamic" = false
+RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill", dynamic, "lastUsedAt", algorithm, burst, "previousUsage"
 `
 
 type UpsertRateLimitParams struct {
-	Tenantid pgtype.UUID `json:"tenantid"`
-	Key      string      `json:"key"`
-	Limit    int32       `json:"limit"`
-	Window   pgtype.Text `json:"window"`
+	Tenantid  pgtype.UUID            `json:"tenantid"`
+	Key       string                 `json:"key"`
+	Limit     int32                  `json:"limit"`
+	Burst     pgtype.Int4            `json:"burst"`
+	Window    pgtype.Text            `json:"window"`
+	Algorithm NullRateLimitAlgorithm `json:"algorithm"`
 }
 
 func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLimitParams) (*RateLimit, error) {
@@ -339,7 +359,9 @@ func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLi
 		arg.Tenantid,
 		arg.Key,
 		arg.Limit,
+		arg.Burst,
 		arg.Window,
+		arg.Algorithm,
 	)
 	var i RateLimit
 	err := row.Scan(
@@ -349,6 +371,11 @@ func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLi
 		&i.Value,
 		&i.Window,
 		&i.LastRefill,
+		&i.Dynamic,
+		&i.LastUsedAt,
+		&i.Algorithm,
+		&i.Burst,
+		&i.PreviousUsage,
 	)
 	return &i, err
 }
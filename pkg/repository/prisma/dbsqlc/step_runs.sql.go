@@ -862,7 +862,7 @@ func (q *Queries) GetStepDesiredWorkerLabels(ctx context.Context, db DBTX, stepi
 
 const getStepExpressions = `-- name: GetStepExpressions :many
 SELECT
-    key, "stepId", expression, kind
+    key, "stepId", expression, kind, "celLibraryVersion"
 FROM
     "StepExpression"
 WHERE
@@ -883,6 +883,7 @@ func (q *Queries) GetStepExpressions(ctx context.Context, db DBTX, stepid pgtype
 			&i.StepId,
 			&i.Expression,
 			&i.Kind,
+			&i.CelLibraryVersion,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,91 @@
+-- name: CreateTenantRole :one
+INSERT INTO "TenantRole" (
+    "id",
+    "createdAt",
+    "updatedAt",
+    "tenantId",
+    "name",
+    "description",
+    "permissions",
+    "workflowIds"
+) VALUES (
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    @name::text,
+    sqlc.narg('description')::text,
+    @permissions::text[],
+    sqlc.narg('workflowIds')::uuid[]
+) RETURNING *;
+
+-- name: GetTenantRoleById :one
+SELECT
+    *
+FROM
+    "TenantRole"
+WHERE
+    "id" = @id::uuid;
+
+-- name: ListTenantRoles :many
+SELECT
+    *
+FROM
+    "TenantRole"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "name" ASC;
+
+-- name: UpdateTenantRole :one
+UPDATE
+    "TenantRole"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "name" = COALESCE(sqlc.narg('name')::text, "name"),
+    "description" = COALESCE(sqlc.narg('description')::text, "description"),
+    "permissions" = COALESCE(sqlc.narg('permissions')::text[], "permissions"),
+    "workflowIds" = CASE
+        WHEN @setWorkflowIds::boolean THEN sqlc.narg('workflowIds')::uuid[]
+        ELSE "workflowIds"
+    END
+WHERE
+    "id" = @id::uuid
+RETURNING *;
+
+-- name: DeleteTenantRole :one
+DELETE FROM
+    "TenantRole"
+WHERE
+    "id" = @id::uuid
+RETURNING *;
+
+-- name: GetTenantRoleForMember :one
+SELECT
+    tr.*
+FROM
+    "TenantMember" tm
+JOIN
+    "TenantRole" tr ON tr."id" = tm."customRoleId"
+WHERE
+    tm."id" = @memberId::uuid;
+
+-- name: ListTenantMemberCustomRoles :many
+SELECT
+    tm."id" AS "memberId",
+    tm."customRoleId" AS "roleId"
+FROM
+    "TenantMember" tm
+WHERE
+    tm."tenantId" = @tenantId::uuid
+    AND tm."customRoleId" IS NOT NULL;
+
+-- name: SetTenantMemberCustomRole :one
+UPDATE
+    "TenantMember"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "customRoleId" = sqlc.narg('customRoleId')::uuid
+WHERE
+    "id" = @memberId::uuid
+RETURNING *;
@@ -227,24 +227,32 @@ FROM
     active_cron_schedules
 WHERE
     cronSchedules."parentId" = active_cron_schedules."parentId"
-RETURNING cronschedules."parentId", cronschedules.cron, cronschedules."tickerId", cronschedules.input, cronschedules.enabled, cronschedules."additionalMetadata", cronschedules."createdAt", cronschedules."deletedAt", cronschedules."updatedAt", cronschedules.name, cronschedules.id, cronschedules.method, active_cron_schedules."workflowVersionId", active_cron_schedules."tenantId"
+RETURNING cronschedules."parentId", cronschedules.cron, cronschedules."tickerId", cronschedules.input, cronschedules.enabled, cronschedules."additionalMetadata", cronschedules."createdAt", cronschedules."deletedAt", cronschedules."updatedAt", cronschedules.name, cronschedules.id, cronschedules.method, cronschedules."missedFirePolicy", cronschedules."catchUpLimit", cronschedules."nextFireAt", cronschedules.timezone, cronschedules."overlapPolicy", cronschedules."lastRunExternalId", cronschedules."lastFiredAt", cronschedules."queuedFireAt", active_cron_schedules."workflowVersionId", active_cron_schedules."tenantId"
 `
 
 type PollCronSchedulesRow struct {
-	ParentId           pgtype.UUID                   `json:"parentId"`
-	Cron               string                        `json:"cron"`
-	TickerId           pgtype.UUID                   `json:"tickerId"`
-	Input              []byte                        `json:"input"`
-	Enabled            bool                          `json:"enabled"`
-	AdditionalMetadata []byte                        `json:"additionalMetadata"`
-	CreatedAt          pgtype.Timestamp              `json:"createdAt"`
-	DeletedAt          pgtype.Timestamp              `json:"deletedAt"`
-	UpdatedAt          pgtype.Timestamp              `json:"updatedAt"`
-	Name               pgtype.Text                   `json:"name"`
-	ID                 pgtype.UUID                   `json:"id"`
-	Method             WorkflowTriggerCronRefMethods `json:"method"`
-	WorkflowVersionId  pgtype.UUID                   `json:"workflowVersionId"`
-	TenantId           pgtype.UUID                   `json:"tenantId"`
+	ParentId           pgtype.UUID                            `json:"parentId"`
+	Cron               string                                 `json:"cron"`
+	TickerId           pgtype.UUID                            `json:"tickerId"`
+	Input              []byte                                 `json:"input"`
+	Enabled            bool                                   `json:"enabled"`
+	AdditionalMetadata []byte                                 `json:"additionalMetadata"`
+	CreatedAt          pgtype.Timestamp                       `json:"createdAt"`
+	DeletedAt          pgtype.Timestamp                       `json:"deletedAt"`
+	UpdatedAt          pgtype.Timestamp                       `json:"updatedAt"`
+	Name               pgtype.Text                            `json:"name"`
+	ID                 pgtype.UUID                            `json:"id"`
+	Method             WorkflowTriggerCronRefMethods          `json:"method"`
+	MissedFirePolicy   WorkflowTriggerCronRefMissedFirePolicy `json:"missedFirePolicy"`
+	CatchUpLimit       int32                                  `json:"catchUpLimit"`
+	NextFireAt         pgtype.Timestamp                       `json:"nextFireAt"`
+	Timezone           pgtype.Text                            `json:"timezone"`
+	OverlapPolicy      WorkflowTriggerCronRefOverlapPolicy    `json:"overlapPolicy"`
+	LastRunExternalId  pgtype.UUID                            `json:"lastRunExternalId"`
+	LastFiredAt        pgtype.Timestamp                       `json:"lastFiredAt"`
+	QueuedFireAt       pgtype.Timestamp                       `json:"queuedFireAt"`
+	WorkflowVersionId  pgtype.UUID                            `json:"workflowVersionId"`
+	TenantId           pgtype.UUID                            `json:"tenantId"`
 }
 
 func (q *Queries) PollCronSchedules(ctx context.Context, db DBTX, tickerid pgtype.UUID) ([]*PollCronSchedulesRow, error) {
@@ -269,6 +277,14 @@ func (q *Queries) PollCronSchedules(ctx context.Context, db DBTX, tickerid pgtyp
 			&i.Name,
 			&i.ID,
 			&i.Method,
+			&i.MissedFirePolicy,
+			&i.CatchUpLimit,
+			&i.NextFireAt,
+			&i.Timezone,
+			&i.OverlapPolicy,
+			&i.LastRunExternalId,
+			&i.LastFiredAt,
+			&i.QueuedFireAt,
 			&i.WorkflowVersionId,
 			&i.TenantId,
 		); err != nil {
@@ -459,7 +475,7 @@ FROM
     active_scheduled_workflows
 WHERE
     scheduledWorkflows."id" = active_scheduled_workflows."id"
-RETURNING scheduledworkflows.id, scheduledworkflows."parentId", scheduledworkflows."triggerAt", scheduledworkflows."tickerId", scheduledworkflows.input, scheduledworkflows."childIndex", scheduledworkflows."childKey", scheduledworkflows."parentStepRunId", scheduledworkflows."parentWorkflowRunId", scheduledworkflows."additionalMetadata", scheduledworkflows."createdAt", scheduledworkflows."deletedAt", scheduledworkflows."updatedAt", scheduledworkflows.method, active_scheduled_workflows."workflowVersionId", active_scheduled_workflows."tenantId"
+RETURNING scheduledworkflows.id, scheduledworkflows."parentId", scheduledworkflows."triggerAt", scheduledworkflows."tickerId", scheduledworkflows.input, scheduledworkflows."childIndex", scheduledworkflows."childKey", scheduledworkflows."parentStepRunId", scheduledworkflows."parentWorkflowRunId", scheduledworkflows."additionalMetadata", scheduledworkflows."createdAt", scheduledworkflows."deletedAt", scheduledworkflows."updatedAt", scheduledworkflows.method, scheduledworkflows."triggeredAt", active_scheduled_workflows."workflowVersionId", active_scheduled_workflows."tenantId"
 `
 
 type PollScheduledWorkflowsRow struct {
@@ -477,6 +493,7 @@ type PollScheduledWorkflowsRow struct {
 	DeletedAt           pgtype.Timestamp                   `json:"deletedAt"`
 	UpdatedAt           pgtype.Timestamp                   `json:"updatedAt"`
 	Method              WorkflowTriggerScheduledRefMethods `json:"method"`
+	TriggeredAt         pgtype.Timestamp                   `json:"triggeredAt"`
 	WorkflowVersionId   pgtype.UUID                        `json:"workflowVersionId"`
 	TenantId            pgtype.UUID                        `json:"tenantId"`
 }
@@ -507,6 +524,7 @@ func (q *Queries) PollScheduledWorkflows(ctx context.Context, db DBTX, tickerid
 			&i.DeletedAt,
 			&i.UpdatedAt,
 			&i.Method,
+			&i.TriggeredAt,
 			&i.WorkflowVersionId,
 			&i.TenantId,
 		); err != nil {
@@ -0,0 +1,209 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO "WebhookSubscription" (
+    "id",
+    "createdAt",
+    "updatedAt",
+    "tenantId",
+    "name",
+    "url",
+    "secret",
+    "enabled",
+    "eventTypes",
+    "statuses",
+    "workflowIds"
+) VALUES (
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    @name::text,
+    @url::text,
+    @secret::text,
+    @enabled::boolean,
+    @eventTypes::text[],
+    @statuses::text[],
+    sqlc.narg('workflowIds')::uuid[]
+) RETURNING *;
+
+-- name: GetWebhookSubscriptionById :one
+SELECT
+    *
+FROM
+    "WebhookSubscription"
+WHERE
+    "id" = @id::uuid;
+
+-- name: ListWebhookSubscriptions :many
+SELECT
+    *
+FROM
+    "WebhookSubscription"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "createdAt" ASC;
+
+-- name: UpdateWebhookSubscription :one
+UPDATE
+    "WebhookSubscription"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "name" = COALESCE(sqlc.narg('name')::text, "name"),
+    "url" = COALESCE(sqlc.narg('url')::text, "url"),
+    "enabled" = COALESCE(sqlc.narg('enabled')::boolean, "enabled"),
+    "eventTypes" = COALESCE(sqlc.narg('eventTypes')::text[], "eventTypes"),
+    "statuses" = COALESCE(sqlc.narg('statuses')::text[], "statuses"),
+    "workflowIds" = CASE
+        WHEN @setWorkflowIds::boolean THEN sqlc.narg('workflowIds')::uuid[]
+        ELSE "workflowIds"
+    END
+WHERE
+    "id" = @id::uuid
+RETURNING *;
+
+-- name: DeleteWebhookSubscription :one
+DELETE FROM
+    "WebhookSubscription"
+WHERE
+    "id" = @id::uuid
+RETURNING *;
+
+-- name: CreateWebhookDeliveries :exec
+WITH delete_old AS (
+    -- Delete finished deliveries which are older than the retention period
+    DELETE FROM "WebhookDelivery"
+    WHERE
+        "tenantId" = @tenantId::uuid
+        AND "status" != 'PENDING'
+        AND "createdAt" < NOW() - INTERVAL '7 days'
+), input AS (
+    SELECT
+        unnest(@subscriptionIds::uuid[]) AS "subscriptionId",
+        unnest(@eventTypes::text[]) AS "eventType",
+        unnest(@payloads::jsonb[]) AS "payload"
+)
+INSERT INTO "WebhookDelivery" (
+    "id",
+    "createdAt",
+    "updatedAt",
+    "tenantId",
+    "subscriptionId",
+    "eventType",
+    "payload",
+    "status",
+    "attempts",
+    "nextAttemptAt"
+)
+SELECT
+    gen_random_uuid(),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    input."subscriptionId",
+    input."eventType",
+    input."payload",
+    'PENDING',
+    0,
+    CURRENT_TIMESTAMP
+FROM
+    input;
+
+-- name: PollWebhookDeliveries :many
+WITH due AS (
+    SELECT
+        "id"
+    FROM
+        "WebhookDelivery"
+    WHERE
+        "tenantId" = @tenantId::uuid
+        AND "status" = 'PENDING'
+        AND "nextAttemptAt" <= CURRENT_TIMESTAMP
+    ORDER BY
+        "nextAttemptAt" ASC
+    LIMIT
+        COALESCE(sqlc.narg('limit')::integer, 100)
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "WebhookDelivery" d
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    -- lease the deliveries, so they're retried if the engine exits before recording an attempt
+    "nextAttemptAt" = CURRENT_TIMESTAMP + (@leaseSeconds::integer * INTERVAL '1 second')
+FROM
+    due
+WHERE
+    d."id" = due."id"
+RETURNING d.*;
+
+-- name: CreateWebhookDeliveryAttempt :exec
+WITH attempt AS (
+    INSERT INTO "WebhookDeliveryAttempt" (
+        "id",
+        "createdAt",
+        "deliveryId",
+        "attempt",
+        "responseStatusCode",
+        "responseBody",
+        "error",
+        "durationMs"
+    ) VALUES (
+        gen_random_uuid(),
+        CURRENT_TIMESTAMP,
+        @deliveryId::uuid,
+        @attempt::integer,
+        sqlc.narg('responseStatusCode')::integer,
+        sqlc.narg('responseBody')::text,
+        sqlc.narg('error')::text,
+        @durationMs::integer
+    )
+)
+UPDATE
+    "WebhookDelivery"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "attempts" = @attempt::integer,
+    "status" = @status::"WebhookDeliveryStatus",
+    "nextAttemptAt" = sqlc.narg('nextAttemptAt')::timestamp
+WHERE
+    "id" = @deliveryId::uuid;
+
+-- name: ListWebhookDeliveries :many
+SELECT
+    *
+FROM
+    "WebhookDelivery"
+WHERE
+    "subscriptionId" = @subscriptionId::uuid
+    AND (
+        sqlc.narg('statuses')::text[] IS NULL OR
+        "status"::text = ANY(sqlc.narg('statuses')::text[])
+    )
+ORDER BY
+    "createdAt" DESC, "id" DESC
+OFFSET
+    COALESCE(sqlc.narg('offset'), 0)
+LIMIT
+    COALESCE(sqlc.narg('limit'), 50);
+
+-- name: CountWebhookDeliveries :one
+SELECT
+    count(*) AS total
+FROM
+    "WebhookDelivery"
+WHERE
+    "subscriptionId" = @subscriptionId::uuid
+    AND (
+        sqlc.narg('statuses')::text[] IS NULL OR
+        "status"::text = ANY(sqlc.narg('statuses')::text[])
+    );
+
+-- name: ListWebhookDeliveryAttempts :many
+SELECT
+    *
+FROM
+    "WebhookDeliveryAttempt"
+WHERE
+    "deliveryId" = ANY(@deliveryIds::uuid[])
+ORDER BY
+    "deliveryId", "attempt" ASC;
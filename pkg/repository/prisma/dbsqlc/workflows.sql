@@ -153,6 +153,30 @@ UPDATE "WorkflowTriggerCronRef"
 SET "parentId" = @newWorkflowTriggerId::uuid
 WHERE "id" IN (SELECT "id" FROM triggersToUpdate);
 
+-- name: CopyCronTriggerStateToNewWorkflowTriggers :exec
+-- Carries the missed fire policy and next fire time of default crons over to the matching crons
+-- on the new workflow triggers, so that re-registering a workflow doesn't reset its schedule
+UPDATE "WorkflowTriggerCronRef" as newCron
+SET
+    "missedFirePolicy" = oldCron."missedFirePolicy",
+    "catchUpLimit" = oldCron."catchUpLimit",
+    "nextFireAt" = oldCron."nextFireAt",
+    "timezone" = oldCron."timezone",
+    "overlapPolicy" = oldCron."overlapPolicy",
+    "lastRunExternalId" = oldCron."lastRunExternalId",
+    "lastFiredAt" = oldCron."lastFiredAt",
+    "queuedFireAt" = oldCron."queuedFireAt"
+FROM
+    "WorkflowTriggerCronRef" as oldCron
+JOIN
+    "WorkflowTriggers" as oldTriggers ON oldTriggers."id" = oldCron."parentId"
+WHERE
+    newCron."parentId" = @newWorkflowTriggerId::uuid
+    AND newCron."method" = 'DEFAULT'
+    AND oldTriggers."workflowVersionId" = @oldWorkflowVersionId::uuid
+    AND oldCron."method" = 'DEFAULT'
+    AND oldCron."cron" = newCron."cron";
+
 -- name: MoveScheduledTriggerToNewWorkflowTriggers :exec
 WITH triggersToUpdate AS (
     SELECT scheduledTrigger."id" FROM "WorkflowTriggerScheduledRef" scheduledTrigger
@@ -173,7 +197,9 @@ INSERT INTO "WorkflowConcurrency" (
     "getConcurrencyGroupId",
     "maxRuns",
     "limitStrategy",
-    "concurrencyGroupExpression"
+    "concurrencyGroupExpression",
+    "concurrencyGroupWeightExpression",
+    "celLibraryVersion"
 ) VALUES (
     gen_random_uuid(),
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -182,7 +208,9 @@ INSERT INTO "WorkflowConcurrency" (
     sqlc.narg('getConcurrencyGroupId')::uuid,
     coalesce(sqlc.narg('maxRuns')::integer, 1),
     coalesce(sqlc.narg('limitStrategy')::"ConcurrencyLimitStrategy", 'CANCEL_IN_PROGRESS'),
-    sqlc.narg('concurrencyGroupExpression')::text
+    sqlc.narg('concurrencyGroupExpression')::text,
+    sqlc.narg('concurrencyGroupWeightExpression')::text,
+    @celLibraryVersion::integer
 ) RETURNING *;
 
 -- name: CreateJob :one
@@ -279,15 +307,33 @@ INSERT INTO "StepExpression" (
     "key",
     "stepId",
     "expression",
-    "kind"
+    "kind",
+    "celLibraryVersion"
 ) VALUES (
     unnest(@keys::text[]),
     @stepId::uuid,
     unnest(@expressions::text[]),
-    unnest(cast(@kinds::text[] as"StepExpressionKind"[]))
+    unnest(cast(@kinds::text[] as"StepExpressionKind"[])),
+    @celLibraryVersion::integer
 ) ON CONFLICT ("key", "stepId", "kind") DO UPDATE
 SET
-    "expression" = EXCLUDED."expression";
+    "expression" = EXCLUDED."expression",
+    "celLibraryVersion" = EXCLUDED."celLibraryVersion";
+
+-- name: CreateStepMatchConditions :exec
+INSERT INTO v2_step_match_condition (
+    tenant_id,
+    step_id,
+    event_key,
+    expression,
+    cel_library_version
+)
+SELECT
+    @tenantId::uuid,
+    @stepId::uuid,
+    unnest(@eventKeys::text[]),
+    unnest(@expressions::text[]),
+    @celLibraryVersion::integer;
 
 -- name: UpsertAction :one
 INSERT INTO "Action" (
@@ -397,7 +443,11 @@ INSERT INTO "WorkflowTriggerCronRef" (
     "input",
     "additionalMetadata",
     "id",
-    "method"
+    "method",
+    "missedFirePolicy",
+    "catchUpLimit",
+    "timezone",
+    "overlapPolicy"
 ) VALUES (
     (SELECT "id" FROM latest_trigger),
     @cronTrigger::text,
@@ -405,7 +455,11 @@ INSERT INTO "WorkflowTriggerCronRef" (
     sqlc.narg('input')::jsonb,
     sqlc.narg('additionalMetadata')::jsonb,
     gen_random_uuid(),
-    COALESCE(sqlc.narg('method')::"WorkflowTriggerCronRefMethods", 'DEFAULT')
+    COALESCE(sqlc.narg('method')::"WorkflowTriggerCronRefMethods", 'DEFAULT'),
+    COALESCE(sqlc.narg('missedFirePolicy')::"WorkflowTriggerCronRefMissedFirePolicy", 'SKIP'),
+    COALESCE(sqlc.narg('catchUpLimit')::integer, 0),
+    NULLIF(sqlc.narg('timezone')::text, ''),
+    COALESCE(sqlc.narg('overlapPolicy')::"WorkflowTriggerCronRefOverlapPolicy", 'ALLOW')
 ) RETURNING *;
 
 -- name: CreateWorkflowTriggerScheduledRefForWorkflow :one
@@ -848,6 +902,21 @@ WHERE
     AND (sqlc.narg('additionalMetadata')::jsonb IS NULL OR
         c."additionalMetadata" @> sqlc.narg('additionalMetadata')::jsonb);
 
+-- name: UpdateWorkflowTriggerCronRef :one
+UPDATE "WorkflowTriggerCronRef"
+SET
+    "missedFirePolicy" = COALESCE(sqlc.narg('missedFirePolicy')::"WorkflowTriggerCronRefMissedFirePolicy", "missedFirePolicy"),
+    "catchUpLimit" = COALESCE(sqlc.narg('catchUpLimit')::integer, "catchUpLimit"),
+    -- an empty timezone resets the cron to UTC
+    "timezone" = NULLIF(COALESCE(sqlc.narg('timezone')::text, "timezone"), ''),
+    -- the next fire time is recomputed when the timezone changes
+    "nextFireAt" = CASE WHEN sqlc.narg('timezone')::text IS NULL THEN "nextFireAt" ELSE NULL END,
+    "overlapPolicy" = COALESCE(sqlc.narg('overlapPolicy')::"WorkflowTriggerCronRefOverlapPolicy", "overlapPolicy"),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid
+RETURNING *;
+
 -- name: DeleteWorkflowTriggerCronRef :exec
 DELETE FROM "WorkflowTriggerCronRef"
 WHERE
@@ -49,6 +49,41 @@ func (q *Queries) AddWorkflowTag(ctx context.Context, db DBTX, arg AddWorkflowTa
 	return err
 }
 
+const copyCronTriggerStateToNewWorkflowTriggers = `-- name: CopyCronTriggerStateToNewWorkflowTriggers :exec
+UPDATE "WorkflowTriggerCronRef" as newCron
+SET
+    "missedFirePolicy" = oldCron."missedFirePolicy",
+    "catchUpLimit" = oldCron."catchUpLimit",
+    "nextFireAt" = oldCron."nextFireAt",
+    "timezone" = oldCron."timezone",
+    "overlapPolicy" = oldCron."overlapPolicy",
+    "lastRunExternalId" = oldCron."lastRunExternalId",
+    "lastFiredAt" = oldCron."lastFiredAt",
+    "queuedFireAt" = oldCron."queuedFireAt"
+FROM
+    "WorkflowTriggerCronRef" as oldCron
+JOIN
+    "WorkflowTriggers" as oldTriggers ON oldTriggers."id" = oldCron."parentId"
+WHERE
+    newCron."parentId" = $1::uuid
+    AND newCron."method" = 'DEFAULT'
+    AND oldTriggers."workflowVersionId" = $2::uuid
+    AND oldCron."method" = 'DEFAULT'
+    AND oldCron."cron" = newCron."cron"
+`
+
+type CopyCronTriggerStateToNewWorkflowTriggersParams struct {
+	Newworkflowtriggerid pgtype.UUID `json:"newworkflowtriggerid"`
+	Oldworkflowversionid pgtype.UUID `json:"oldworkflowversionid"`
+}
+
+// Carries the missed fire policy and next fire time of default crons over to the matching crons
+// on the new workflow triggers, so that re-registering a workflow doesn't reset its schedule
+func (q *Queries) CopyCronTriggerStateToNewWorkflowTriggers(ctx context.Context, db DBTX, arg CopyCronTriggerStateToNewWorkflowTriggersParams) error {
+	_, err := db.Exec(ctx, copyCronTriggerStateToNewWorkflowTriggers, arg.Newworkflowtriggerid, arg.Oldworkflowversionid)
+	return err
+}
+
 const countCronWorkflows = `-- name: CountCronWorkflows :one
 WITH latest_versions AS (
     SELECT DISTINCT ON("workflowId")
@@ -303,7 +338,7 @@ INSERT INTO "WorkflowTriggerScheduledRef" (
     unnest($2::timestamp[]),
     $3::jsonb,
     $4::json
-) RETURNING id, "parentId", "triggerAt", "tickerId", input, "childIndex", "childKey", "parentStepRunId", "parentWorkflowRunId", "additionalMetadata", "createdAt", "deletedAt", "updatedAt", method
+) RETURNING id, "parentId", "triggerAt", "tickerId", input, "childIndex", "childKey", "parentStepRunId", "parentWorkflowRunId", "additionalMetadata", "createdAt", "deletedAt", "updatedAt", method, "triggeredAt"
 `
 
 type CreateSchedulesParams struct {
@@ -342,6 +377,7 @@ func (q *Queries) CreateSchedules(ctx context.Context, db DBTX, arg CreateSchedu
 			&i.DeletedAt,
 			&i.UpdatedAt,
 			&i.Method,
+			&i.TriggeredAt,
 		); err != nil {
 			return nil, err
 		}
@@ -446,22 +482,26 @@ INSERT INTO "StepExpression" (
     "key",
     "stepId",
     "expression",
-    "kind"
+    "kind",
+    "celLibraryVersion"
 ) VALUES (
     unnest($1::text[]),
     $2::uuid,
     unnest($3::text[]),
-    unnest(cast($4::text[] as"StepExpressionKind"[]))
+    unnest(cast($4::text[] as"StepExpressionKind"[])),
+    $5::integer
 ) ON CONFLICT ("key", "stepId", "kind") DO UPDATE
 SET
-    "expression" = EXCLUDED."expression"
+    "expression" = EXCLUDED."expression",
+    "celLibraryVersion" = EXCLUDED."celLibraryVersion"
 `
 
 type CreateStepExpressionsParams struct {
-	Keys        []string    `json:"keys"`
-	Stepid      pgtype.UUID `json:"stepid"`
-	Expressions []string    `json:"expressions"`
-	Kinds       []string    `json:"kinds"`
+	Keys              []string    `json:"keys"`
+	Stepid            pgtype.UUID `json:"stepid"`
+	Expressions       []string    `json:"expressions"`
+	Kinds             []string    `json:"kinds"`
+	Cellibraryversion int32       `json:"cellibraryversion"`
 }
 
 func (q *Queries) CreateStepExpressions(ctx context.Context, db DBTX, arg CreateStepExpressionsParams) error {
@@ -470,6 +510,42 @@ func (q *Queries) CreateStepExpressions(ctx context.Context, db DBTX, arg Create
 		arg.Stepid,
 		arg.Expressions,
 		arg.Kinds,
+		arg.Cellibraryversion,
+	)
+	return err
+}
+
+const createStepMatchConditions = `-- name: CreateStepMatchConditions :exec
+INSERT INTO v2_step_match_condition (
+    tenant_id,
+    step_id,
+    event_key,
+    expression,
+    cel_library_version
+)
+SELECT
+    $1::uuid,
+    $2::uuid,
+    unnest($3::text[]),
+    unnest($4::text[]),
+    $5::integer
+`
+
+type CreateStepMatchConditionsParams struct {
+	Tenantid          pgtype.UUID `json:"tenantid"`
+	Stepid            pgtype.UUID `json:"stepid"`
+	Eventkeys         []string    `json:"eventkeys"`
+	Expressions       []string    `json:"expressions"`
+	Cellibraryversion int32       `json:"cellibraryversion"`
+}
+
+func (q *Queries) CreateStepMatchConditions(ctx context.Context, db DBTX, arg CreateStepMatchConditionsParams) error {
+	_, err := db.Exec(ctx, createStepMatchConditions,
+		arg.Tenantid,
+		arg.Stepid,
+		arg.Eventkeys,
+		arg.Expressions,
+		arg.Cellibraryversion,
 	)
 	return err
 }
@@ -580,7 +656,9 @@ INSERT INTO "WorkflowConcurrency" (
     "getConcurrencyGroupId",
     "maxRuns",
     "limitStrategy",
-    "concurrencyGroupExpression"
+    "concurrencyGroupExpression",
+    "concurrencyGroupWeightExpression",
+    "celLibraryVersion"
 ) VALUES (
     gen_random_uuid(),
     coalesce($1::timestamp, CURRENT_TIMESTAMP),
@@ -589,18 +667,22 @@ INSERT INTO "WorkflowConcurrency" (
     $4::uuid,
     coalesce($5::integer, 1),
     coalesce($6::"ConcurrencyLimitStrategy", 'CANCEL_IN_PROGRESS'),
-    $7::text
-) RETURNING id, "createdAt", "updatedAt", "workflowVersionId", "getConcurrencyGroupId", "maxRuns", "limitStrategy", "concurrencyGroupExpression"
+    $7::text,
+    $8::text,
+    $9::integer
+) RETURNING id, "createdAt", "updatedAt", "workflowVersionId", "getConcurrencyGroupId", "maxRuns", "limitStrategy", "concurrencyGroupExpression", "concurrencyGroupWeightExpression", "celLibraryVersion"
 `
 
 type CreateWorkflowConcurrencyParams struct {
-	CreatedAt                  pgtype.Timestamp             `json:"createdAt"`
-	UpdatedAt                  pgtype.Timestamp             `json:"updatedAt"`
-	Workflowversionid          pgtype.UUID                  `json:"workflowversionid"`
-	GetConcurrencyGroupId      pgtype.UUID                  `json:"getConcurrencyGroupId"`
-	MaxRuns                    pgtype.Int4                  `json:"maxRuns"`
-	LimitStrategy              NullConcurrencyLimitStrategy `json:"limitStrategy"`
-	ConcurrencyGroupExpression pgtype.Text                  `json:"concurrencyGroupExpression"`
+	CreatedAt                        pgtype.Timestamp             `json:"createdAt"`
+	UpdatedAt                        pgtype.Timestamp             `json:"updatedAt"`
+	Workflowversionid                pgtype.UUID                  `json:"workflowversionid"`
+	GetConcurrencyGroupId            pgtype.UUID                  `json:"getConcurrencyGroupId"`
+	MaxRuns                          pgtype.Int4                  `json:"maxRuns"`
+	LimitStrategy                    NullConcurrencyLimitStrategy `json:"limitStrategy"`
+	ConcurrencyGroupExpression       pgtype.Text                  `json:"concurrencyGroupExpression"`
+	ConcurrencyGroupWeightExpression pgtype.Text                  `json:"concurrencyGroupWeightExpression"`
+	Cellibraryversion                int32                        `json:"cellibraryversion"`
 }
 
 func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg CreateWorkflowConcurrencyParams) (*WorkflowConcurrency, error) {
@@ -612,6 +694,8 @@ func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg Cr
 		arg.MaxRuns,
 		arg.LimitStrategy,
 		arg.ConcurrencyGroupExpression,
+		arg.ConcurrencyGroupWeightExpression,
+		arg.Cellibraryversion,
 	)
 	var i WorkflowConcurrency
 	err := row.Scan(
@@ -623,6 +707,8 @@ func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg Cr
 		&i.MaxRuns,
 		&i.LimitStrategy,
 		&i.ConcurrencyGroupExpression,
+		&i.ConcurrencyGroupWeightExpression,
+		&i.CelLibraryVersion,
 	)
 	return &i, err
 }
@@ -644,7 +730,7 @@ INSERT INTO "WorkflowTriggerCronRef" (
     $5::jsonb,
     gen_random_uuid(),
     COALESCE($6::"WorkflowTriggerCronRefMethods", 'DEFAULT')
-) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method
+) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method, "missedFirePolicy", "catchUpLimit", "nextFireAt", timezone, "overlapPolicy", "lastRunExternalId", "lastFiredAt", "queuedFireAt"
 `
 
 type CreateWorkflowTriggerCronRefParams struct {
@@ -679,6 +765,14 @@ func (q *Queries) CreateWorkflowTriggerCronRef(ctx context.Context, db DBTX, arg
 		&i.Name,
 		&i.ID,
 		&i.Method,
+		&i.MissedFirePolicy,
+		&i.CatchUpLimit,
+		&i.NextFireAt,
+		&i.Timezone,
+		&i.OverlapPolicy,
+		&i.LastRunExternalId,
+		&i.LastFiredAt,
+		&i.QueuedFireAt,
 	)
 	return &i, err
 }
@@ -686,7 +780,7 @@ func (q *Queries) CreateWorkflowTriggerCronRef(ctx context.Context, db DBTX, arg
 const createWorkflowTriggerCronRefForWorkflow = `-- name: CreateWorkflowTriggerCronRefForWorkflow :one
 WITH latest_version AS (
     SELECT "id" FROM "WorkflowVersion"
-    WHERE "workflowId" = $6::uuid
+    WHERE "workflowId" = $10::uuid
     ORDER BY "order" DESC
     LIMIT 1
 ),
@@ -703,7 +797,11 @@ INSERT INTO "WorkflowTriggerCronRef" (
     "input",
     "additionalMetadata",
     "id",
-    "method"
+    "method",
+    "missedFirePolicy",
+    "catchUpLimit",
+    "timezone",
+    "overlapPolicy"
 ) VALUES (
     (SELECT "id" FROM latest_trigger),
     $1::text,
@@ -711,17 +809,25 @@ INSERT INTO "WorkflowTriggerCronRef" (
     $3::jsonb,
     $4::jsonb,
     gen_random_uuid(),
-    COALESCE($5::"WorkflowTriggerCronRefMethods", 'DEFAULT')
-) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method
+    COALESCE($5::"WorkflowTriggerCronRefMethods", 'DEFAULT'),
+    COALESCE($6::"WorkflowTriggerCronRefMissedFirePolicy", 'SKIP'),
+    COALESCE($7::integer, 0),
+    NULLIF($8::text, ''),
+    COALESCE($9::"WorkflowTriggerCronRefOverlapPolicy", 'ALLOW')
+) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method, "missedFirePolicy", "catchUpLimit", "nextFireAt", timezone, "overlapPolicy", "lastRunExternalId", "lastFiredAt", "queuedFireAt"
 `
 
 type CreateWorkflowTriggerCronRefForWorkflowParams struct {
-	Crontrigger        string                            `json:"crontrigger"`
-	Name               pgtype.Text                       `json:"name"`
-	Input              []byte                            `json:"input"`
-	AdditionalMetadata []byte                            `json:"additionalMetadata"`
-	Method             NullWorkflowTriggerCronRefMethods `json:"method"`
-	Workflowid         pgtype.UUID                       `json:"workflowid"`
+	Crontrigger        string                                     `json:"crontrigger"`
+	Name               pgtype.Text                                `json:"name"`
+	Input              []byte                                     `json:"input"`
+	AdditionalMetadata []byte                                     `json:"additionalMetadata"`
+	Method             NullWorkflowTriggerCronRefMethods          `json:"method"`
+	MissedFirePolicy   NullWorkflowTriggerCronRefMissedFirePolicy `json:"missedFirePolicy"`
+	CatchUpLimit       pgtype.Int4                                `json:"catchUpLimit"`
+	Timezone           pgtype.Text                                `json:"timezone"`
+	OverlapPolicy      NullWorkflowTriggerCronRefOverlapPolicy    `json:"overlapPolicy"`
+	Workflowid         pgtype.UUID                                `json:"workflowid"`
 }
 
 func (q *Queries) CreateWorkflowTriggerCronRefForWorkflow(ctx context.Context, db DBTX, arg CreateWorkflowTriggerCronRefForWorkflowParams) (*WorkflowTriggerCronRef, error) {
@@ -731,6 +837,10 @@ func (q *Queries) CreateWorkflowTriggerCronRefForWorkflow(ctx context.Context, d
 		arg.Input,
 		arg.AdditionalMetadata,
 		arg.Method,
+		arg.MissedFirePolicy,
+		arg.CatchUpLimit,
+		arg.Timezone,
+		arg.OverlapPolicy,
 		arg.Workflowid,
 	)
 	var i WorkflowTriggerCronRef
@@ -747,6 +857,14 @@ func (q *Queries) CreateWorkflowTriggerCronRefForWorkflow(ctx context.Context, d
 		&i.Name,
 		&i.ID,
 		&i.Method,
+		&i.MissedFirePolicy,
+		&i.CatchUpLimit,
+		&i.NextFireAt,
+		&i.Timezone,
+		&i.OverlapPolicy,
+		&i.LastRunExternalId,
+		&i.LastFiredAt,
+		&i.QueuedFireAt,
 	)
 	return &i, err
 }
@@ -786,7 +904,7 @@ INSERT INTO "WorkflowTriggerScheduledRef" (
     $2::timestamp,
     $3::jsonb,
     $4::jsonb
-) RETURNING id, "parentId", "triggerAt", "tickerId", input, "childIndex", "childKey", "parentStepRunId", "parentWorkflowRunId", "additionalMetadata", "createdAt", "deletedAt", "updatedAt", method
+) RETURNING id, "parentId", "triggerAt", "tickerId", input, "childIndex", "childKey", "parentStepRunId", "parentWorkflowRunId", "additionalMetadata", "createdAt", "deletedAt", "updatedAt", method, "triggeredAt"
 `
 
 type CreateWorkflowTriggerScheduledRefParams struct {
@@ -819,6 +937,7 @@ func (q *Queries) CreateWorkflowTriggerScheduledRef(ctx context.Context, db DBTX
 		&i.DeletedAt,
 		&i.UpdatedAt,
 		&i.Method,
+		&i.TriggeredAt,
 	)
 	return &i, err
 }
@@ -850,7 +969,7 @@ INSERT INTO "WorkflowTriggerScheduledRef" (
     $2::jsonb,
     $3::jsonb,
     COALESCE($4::"WorkflowTriggerScheduledRefMethods", 'DEFAULT')
-) RETURNING id, "parentId", "triggerAt", "tickerId", input, "childIndex", "childKey", "parentStepRunId", "parentWorkflowRunId", "additionalMetadata", "createdAt", "deletedAt", "updatedAt", method
+) RETURNING id, "parentId", "triggerAt", "tickerId", input, "childIndex", "childKey", "parentStepRunId", "parentWorkflowRunId", "additionalMetadata", "createdAt", "deletedAt", "updatedAt", method, "triggeredAt"
 `
 
 type CreateWorkflowTriggerScheduledRefForWorkflowParams struct {
@@ -885,6 +1004,7 @@ func (q *Queries) CreateWorkflowTriggerScheduledRefForWorkflow(ctx context.Conte
 		&i.DeletedAt,
 		&i.UpdatedAt,
 		&i.Method,
+		&i.TriggeredAt,
 	)
 	return &i, err
 }
@@ -1219,7 +1339,7 @@ func (q *Queries) GetWorkflowVersionById(ctx context.Context, db DBTX, id pgtype
 
 const getWorkflowVersionCronTriggerRefs = `-- name: GetWorkflowVersionCronTriggerRefs :many
 SELECT
-    wtc."parentId", wtc.cron, wtc."tickerId", wtc.input, wtc.enabled, wtc."additionalMetadata", wtc."createdAt", wtc."deletedAt", wtc."updatedAt", wtc.name, wtc.id, wtc.method
+    wtc."parentId", wtc.cron, wtc."tickerId", wtc.input, wtc.enabled, wtc."additionalMetadata", wtc."createdAt", wtc."deletedAt", wtc."updatedAt", wtc.name, wtc.id, wtc.method, wtc."missedFirePolicy", wtc."catchUpLimit", wtc."nextFireAt", wtc.timezone, wtc."overlapPolicy", wtc."lastRunExternalId", wtc."lastFiredAt", wtc."queuedFireAt"
 FROM
     "WorkflowTriggerCronRef" as wtc
 JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
@@ -1249,6 +1369,14 @@ func (q *Queries) GetWorkflowVersionCronTriggerRefs(ctx context.Context, db DBTX
 			&i.Name,
 			&i.ID,
 			&i.Method,
+			&i.MissedFirePolicy,
+			&i.CatchUpLimit,
+			&i.NextFireAt,
+			&i.Timezone,
+			&i.OverlapPolicy,
+			&i.LastRunExternalId,
+			&i.LastFiredAt,
+			&i.QueuedFireAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1366,7 +1494,7 @@ func (q *Queries) GetWorkflowVersionForEngine(ctx context.Context, db DBTX, arg
 
 const getWorkflowVersionScheduleTriggerRefs = `-- name: GetWorkflowVersionScheduleTriggerRefs :many
 SELECT
-    wtc.id, wtc."parentId", wtc."triggerAt", wtc."tickerId", wtc.input, wtc."childIndex", wtc."childKey", wtc."parentStepRunId", wtc."parentWorkflowRunId", wtc."additionalMetadata", wtc."createdAt", wtc."deletedAt", wtc."updatedAt", wtc.method
+    wtc.id, wtc."parentId", wtc."triggerAt", wtc."tickerId", wtc.input, wtc."childIndex", wtc."childKey", wtc."parentStepRunId", wtc."parentWorkflowRunId", wtc."additionalMetadata", wtc."createdAt", wtc."deletedAt", wtc."updatedAt", wtc.method, wtc."triggeredAt"
 FROM
     "WorkflowTriggerScheduledRef" as wtc
 JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
@@ -1398,6 +1526,7 @@ func (q *Queries) GetWorkflowVersionScheduleTriggerRefs(ctx context.Context, db
 			&i.DeletedAt,
 			&i.UpdatedAt,
 			&i.Method,
+			&i.TriggeredAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1597,7 +1726,7 @@ SELECT
     t."id" as "triggerId",
     c."id" as "cronId",
     t.id, t."createdAt", t."updatedAt", t."deletedAt", t."workflowVersionId", t."tenantId",
-    c."parentId", c.cron, c."tickerId", c.input, c.enabled, c."additionalMetadata", c."createdAt", c."deletedAt", c."updatedAt", c.name, c.id, c.method
+    c."parentId", c.cron, c."tickerId", c.input, c.enabled, c."additionalMetadata", c."createdAt", c."deletedAt", c."updatedAt", c.name, c.id, c.method, c."missedFirePolicy", c."catchUpLimit", c."nextFireAt", c.timezone, c."overlapPolicy", c."lastRunExternalId", c."lastFiredAt", c."queuedFireAt"
 FROM
     latest_versions
 JOIN
@@ -1636,30 +1765,38 @@ type ListCronWorkflowsParams struct {
 }
 
 type ListCronWorkflowsRow struct {
-	WorkflowVersionId   pgtype.UUID                   `json:"workflowVersionId"`
-	WorkflowName        string                        `json:"workflowName"`
-	WorkflowId          pgtype.UUID                   `json:"workflowId"`
-	TenantId            pgtype.UUID                   `json:"tenantId"`
-	TriggerId           pgtype.UUID                   `json:"triggerId"`
-	CronId              pgtype.UUID                   `json:"cronId"`
-	ID                  pgtype.UUID                   `json:"id"`
-	CreatedAt           pgtype.Timestamp              `json:"createdAt"`
-	UpdatedAt           pgtype.Timestamp              `json:"updatedAt"`
-	DeletedAt           pgtype.Timestamp              `json:"deletedAt"`
-	WorkflowVersionId_2 pgtype.UUID                   `json:"workflowVersionId_2"`
-	TenantId_2          pgtype.UUID                   `json:"tenantId_2"`
-	ParentId            pgtype.UUID                   `json:"parentId"`
-	Cron                string                        `json:"cron"`
-	TickerId            pgtype.UUID                   `json:"tickerId"`
-	Input               []byte                        `json:"input"`
-	Enabled             bool                          `json:"enabled"`
-	AdditionalMetadata  []byte                        `json:"additionalMetadata"`
-	CreatedAt_2         pgtype.Timestamp              `json:"createdAt_2"`
-	DeletedAt_2         pgtype.Timestamp              `json:"deletedAt_2"`
-	UpdatedAt_2         pgtype.Timestamp              `json:"updatedAt_2"`
-	Name                pgtype.Text                   `json:"name"`
-	ID_2                pgtype.UUID                   `json:"id_2"`
-	Method              WorkflowTriggerCronRefMethods `json:"method"`
+	WorkflowVersionId   pgtype.UUID                            `json:"workflowVersionId"`
+	WorkflowName        string                                 `json:"workflowName"`
+	WorkflowId          pgtype.UUID                            `json:"workflowId"`
+	TenantId            pgtype.UUID                            `json:"tenantId"`
+	TriggerId           pgtype.UUID                            `json:"triggerId"`
+	CronId              pgtype.UUID                            `json:"cronId"`
+	ID                  pgtype.UUID                            `json:"id"`
+	CreatedAt           pgtype.Timestamp                       `json:"createdAt"`
+	UpdatedAt           pgtype.Timestamp                       `json:"updatedAt"`
+	DeletedAt           pgtype.Timestamp                       `json:"deletedAt"`
+	WorkflowVersionId_2 pgtype.UUID                            `json:"workflowVersionId_2"`
+	TenantId_2          pgtype.UUID                            `json:"tenantId_2"`
+	ParentId            pgtype.UUID                            `json:"parentId"`
+	Cron                string                                 `json:"cron"`
+	TickerId            pgtype.UUID                            `json:"tickerId"`
+	Input               []byte                                 `json:"input"`
+	Enabled             bool                                   `json:"enabled"`
+	AdditionalMetadata  []byte                                 `json:"additionalMetadata"`
+	CreatedAt_2         pgtype.Timestamp                       `json:"createdAt_2"`
+	DeletedAt_2         pgtype.Timestamp                       `json:"deletedAt_2"`
+	UpdatedAt_2         pgtype.Timestamp                       `json:"updatedAt_2"`
+	Name                pgtype.Text                            `json:"name"`
+	ID_2                pgtype.UUID                            `json:"id_2"`
+	Method              WorkflowTriggerCronRefMethods          `json:"method"`
+	MissedFirePolicy    WorkflowTriggerCronRefMissedFirePolicy `json:"missedFirePolicy"`
+	CatchUpLimit        int32                                  `json:"catchUpLimit"`
+	NextFireAt          pgtype.Timestamp                       `json:"nextFireAt"`
+	Timezone            pgtype.Text                            `json:"timezone"`
+	OverlapPolicy       WorkflowTriggerCronRefOverlapPolicy    `json:"overlapPolicy"`
+	LastRunExternalId   pgtype.UUID                            `json:"lastRunExternalId"`
+	LastFiredAt         pgtype.Timestamp                       `json:"lastFiredAt"`
+	QueuedFireAt        pgtype.Timestamp                       `json:"queuedFireAt"`
 }
 
 // Get all of the latest workflow versions for the tenant
@@ -1705,6 +1842,14 @@ func (q *Queries) ListCronWorkflows(ctx context.Context, db DBTX, arg ListCronWo
 			&i.Name,
 			&i.ID_2,
 			&i.Method,
+			&i.MissedFirePolicy,
+			&i.CatchUpLimit,
+			&i.NextFireAt,
+			&i.Timezone,
+			&i.OverlapPolicy,
+			&i.LastRunExternalId,
+			&i.LastFiredAt,
+			&i.QueuedFireAt,
 		); err != nil {
 			return nil, err
 		}
@@ -2068,6 +2213,64 @@ func (q *Queries) UpdateWorkflow(ctx context.Context, db DBTX, arg UpdateWorkflo
 	return &i, err
 }
 
+const updateWorkflowTriggerCronRef = `-- name: UpdateWorkflowTriggerCronRef :one
+UPDATE "WorkflowTriggerCronRef"
+SET
+    "missedFirePolicy" = COALESCE($1::"WorkflowTriggerCronRefMissedFirePolicy", "missedFirePolicy"),
+    "catchUpLimit" = COALESCE($2::integer, "catchUpLimit"),
+    -- an empty timezone resets the cron to UTC
+    "timezone" = NULLIF(COALESCE($3::text, "timezone"), ''),
+    -- the next fire time is recomputed when the timezone changes
+    "nextFireAt" = CASE WHEN $3::text IS NULL THEN "nextFireAt" ELSE NULL END,
+    "overlapPolicy" = COALESCE($4::"WorkflowTriggerCronRefOverlapPolicy", "overlapPolicy"),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = $5::uuid
+RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method, "missedFirePolicy", "catchUpLimit", "nextFireAt", timezone, "overlapPolicy", "lastRunExternalId", "lastFiredAt", "queuedFireAt"
+`
+
+type UpdateWorkflowTriggerCronRefParams struct {
+	MissedFirePolicy NullWorkflowTriggerCronRefMissedFirePolicy `json:"missedFirePolicy"`
+	CatchUpLimit     pgtype.Int4                                `json:"catchUpLimit"`
+	Timezone         pgtype.Text                                `json:"timezone"`
+	OverlapPolicy    NullWorkflowTriggerCronRefOverlapPolicy    `json:"overlapPolicy"`
+	ID               pgtype.UUID                                `json:"id"`
+}
+
+func (q *Queries) UpdateWorkflowTriggerCronRef(ctx context.Context, db DBTX, arg UpdateWorkflowTriggerCronRefParams) (*WorkflowTriggerCronRef, error) {
+	row := db.QueryRow(ctx, updateWorkflowTriggerCronRef,
+		arg.MissedFirePolicy,
+		arg.CatchUpLimit,
+		arg.Timezone,
+		arg.OverlapPolicy,
+		arg.ID,
+	)
+	var i WorkflowTriggerCronRef
+	err := row.Scan(
+		&i.ParentId,
+		&i.Cron,
+		&i.TickerId,
+		&i.Input,
+		&i.Enabled,
+		&i.AdditionalMetadata,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.ID,
+		&i.Method,
+		&i.MissedFirePolicy,
+		&i.CatchUpLimit,
+		&i.NextFireAt,
+		&i.Timezone,
+		&i.OverlapPolicy,
+		&i.LastRunExternalId,
+		&i.LastFiredAt,
+		&i.QueuedFireAt,
+	)
+	return &i, err
+}
+
 const upsertAction = `-- name: UpsertAction :one
 INSERT INTO "Action" (
     "id",
@@ -182,3 +182,134 @@ func (m *messageQueueRepository) CleanupMessageQueueItems(ctx context.Context) e
 
 	return nil
 }
+
+func (m *messageQueueRepository) RetryMessage(ctx context.Context, id int64, payload []byte, delay time.Duration) error {
+	retryAfter := time.Now().UTC().Add(delay)
+
+	return m.queries.RetryMessage(ctx, m.pool, dbsqlc.RetryMessageParams{
+		ID:           id,
+		Retrypayload: payload,
+		Retryafter:   sqlchelpers.TimestampFromTime(retryAfter),
+		Expiresat:    sqlchelpers.TimestampFromTime(retryAfter.Add(5 * time.Minute)),
+	})
+}
+
+func (m *messageQueueRepository) CreateDeadLetter(ctx context.Context, opts *repository.CreateDeadLetterOpts) error {
+	if err := m.v.Validate(opts); err != nil {
+		return err
+	}
+
+	params := dbsqlc.CreateMessageQueueDeadLetterParams{
+		Queueid:    opts.Queue,
+		Messageid:  opts.MessageId,
+		Payload:    opts.Payload,
+		Retrycount: int32(opts.RetryCount), // nolint: gosec
+	}
+
+	if opts.TenantId != nil {
+		params.TenantId = sqlchelpers.UUIDFromStr(*opts.TenantId)
+	}
+
+	if opts.Error != nil {
+		params.Error = sqlchelpers.TextFromStr(*opts.Error)
+	}
+
+	return m.queries.CreateMessageQueueDeadLetter(ctx, m.pool, params)
+}
+
+func (m *messageQueueRepository) ListDeadLetters(ctx context.Context, tenantId *string, queue string, opts *repository.ListDeadLettersOpts) (*repository.ListDeadLettersResult, error) {
+	if err := m.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	var pgTenantId pgtype.UUID
+
+	if tenantId != nil {
+		pgTenantId = sqlchelpers.UUIDFromStr(*tenantId)
+	}
+
+	queryParams := dbsqlc.ListMessageQueueDeadLettersParams{
+		Tenantid: pgTenantId,
+		Queueid:  queue,
+	}
+
+	countParams := dbsqlc.CountMessageQueueDeadLettersParams{
+		Tenantid: pgTenantId,
+		Queueid:  queue,
+	}
+
+	if len(opts.Ids) > 0 {
+		queryParams.Ids = opts.Ids
+		countParams.Ids = opts.Ids
+	}
+
+	if opts.Offset != nil {
+		queryParams.Offset = pgtype.Int4{Int32: int32(*opts.Offset), Valid: true} // nolint: gosec
+	}
+
+	if opts.Limit != nil {
+		queryParams.Limit = pgtype.Int4{Int32: int32(*opts.Limit), Valid: true} // nolint: gosec
+	}
+
+	tx, err := m.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer sqlchelpers.DeferRollback(context.Background(), m.l, tx.Rollback)
+
+	deadLetters, err := m.queries.ListMessageQueueDeadLetters(ctx, tx, queryParams)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			deadLetters = make([]*dbsqlc.MessageQueueDeadLetter, 0)
+		} else {
+			return nil, fmt.Errorf("could not list dead letters: %w", err)
+		}
+	}
+
+	count, err := m.queries.CountMessageQueueDeadLetters(ctx, tx, countParams)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			count = 0
+		} else {
+			return nil, fmt.Errorf("could not count dead letters: %w", err)
+		}
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return &repository.ListDeadLettersResult{
+		Rows:  deadLetters,
+		Count: int(count),
+	}, nil
+}
+
+func (m *messageQueueRepository) DeleteDeadLetters(ctx context.Context, tenantId *string, queue string, ids []int64) (int64, error) {
+	params := dbsqlc.DeleteMessageQueueDeadLettersParams{
+		Queueid: queue,
+	}
+
+	if tenantId != nil {
+		params.Tenantid = sqlchelpers.UUIDFromStr(*tenantId)
+	}
+
+	if len(ids) > 0 {
+		params.Ids = ids
+	}
+
+	return m.queries.DeleteMessageQueueDeadLetters(ctx, m.pool, params)
+}
+
+func (m *messageQueueRepository) CleanupDeadLetters(ctx context.Context) error {
+	ctx, span := telemetry.NewSpan(ctx, "cleanup-message-queue-dead-letters-database")
+	defer span.End()
+
+	return m.queries.CleanupMessageQueueDeadLetters(ctx, m.pool)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
@@ -132,6 +133,20 @@ func (r *rateLimitEngineRepository) UpsertRateLimit(ctx context.Context, tenantI
 		upsertParams.Window = sqlchelpers.TextFromStr(getWindowParamFromDurString(*opts.Duration))
 	}
 
+	if opts.Algorithm != nil {
+		upsertParams.Algorithm = dbsqlc.NullRateLimitAlgorithm{
+			RateLimitAlgorithm: dbsqlc.RateLimitAlgorithm(*opts.Algorithm),
+			Valid:              true,
+		}
+	}
+
+	if opts.Burst != nil {
+		upsertParams.Burst = pgtype.Int4{
+			Int32: int32(*opts.Burst), // nolint: gosec
+			Valid: true,
+		}
+	}
+
 	rateLimit, err := r.queries.UpsertRateLimit(ctx, r.pool, upsertParams)
 
 	if err != nil {
@@ -16,25 +16,28 @@ import (
 )
 
 type apiRepository struct {
-	apiToken       repository.APITokenRepository
-	event          repository.EventAPIRepository
-	log            repository.LogsAPIRepository
-	tenant         repository.TenantAPIRepository
-	tenantAlerting repository.TenantAlertingAPIRepository
-	tenantInvite   repository.TenantInviteRepository
-	workflow       repository.WorkflowAPIRepository
-	workflowRun    repository.WorkflowRunAPIRepository
-	jobRun         repository.JobRunAPIRepository
-	stepRun        repository.StepRunAPIRepository
-	step           repository.StepRepository
-	slack          repository.SlackRepository
-	sns            repository.SNSRepository
-	worker         repository.WorkerAPIRepository
-	userSession    repository.UserSessionRepository
-	user           repository.UserRepository
-	health         repository.HealthRepository
-	securityCheck  repository.SecurityCheckRepository
-	webhookWorker  repository.WebhookWorkerRepository
+	apiToken            repository.APITokenRepository
+	auditLog            repository.AuditLogRepository
+	event               repository.EventAPIRepository
+	log                 repository.LogsAPIRepository
+	tenant              repository.TenantAPIRepository
+	tenantAlerting      repository.TenantAlertingAPIRepository
+	tenantInvite        repository.TenantInviteRepository
+	tenantRole          repository.TenantRoleRepository
+	workflow            repository.WorkflowAPIRepository
+	workflowRun         repository.WorkflowRunAPIRepository
+	jobRun              repository.JobRunAPIRepository
+	stepRun             repository.StepRunAPIRepository
+	step                repository.StepRepository
+	slack               repository.SlackRepository
+	sns                 repository.SNSRepository
+	worker              repository.WorkerAPIRepository
+	userSession         repository.UserSessionRepository
+	user                repository.UserRepository
+	health              repository.HealthRepository
+	securityCheck       repository.SecurityCheckRepository
+	webhookWorker       repository.WebhookWorkerRepository
+	webhookSubscription repository.WebhookSubscriptionRepository
 }
 
 type PrismaRepositoryOpt func(*PrismaRepositoryOpts)
@@ -46,6 +49,7 @@ type PrismaRepositoryOpts struct {
 	metered              *metered.Metered
 	logsEngineRepository repository.LogsEngineRepository
 	logsAPIRepository    repository.LogsAPIRepository
+	olapEventRepository  repository.OLAPEventRepository
 }
 
 func defaultPrismaRepositoryOpts() *PrismaRepositoryOpts {
@@ -90,6 +94,12 @@ func WithLogsAPIRepository(newLogsAPI repository.LogsAPIRepository) PrismaReposi
 	}
 }
 
+func WithOLAPEventRepository(olapRepo repository.OLAPEventRepository) PrismaRepositoryOpt {
+	return func(opts *PrismaRepositoryOpts) {
+		opts.olapEventRepository = olapRepo
+	}
+}
+
 func NewAPIRepository(client *db.PrismaClient, pool *pgxpool.Pool, cf *server.ConfigFileRuntime, fs ...PrismaRepositoryOpt) (repository.APIRepository, func() error, error) {
 	opts := defaultPrismaRepositoryOpts()
 
@@ -118,25 +128,28 @@ func NewAPIRepository(client *db.PrismaClient, pool *pgxpool.Pool, cf *server.Co
 	}
 
 	return &apiRepository{
-		apiToken:       NewAPITokenRepository(client, opts.v, opts.cache),
-		event:          NewEventAPIRepository(client, pool, opts.v, opts.l),
-		log:            logsAPIRepo,
-		tenant:         NewTenantAPIRepository(pool, client, opts.v, opts.l, opts.cache),
-		tenantAlerting: NewTenantAlertingAPIRepository(client, opts.v, opts.cache),
-		tenantInvite:   NewTenantInviteRepository(client, opts.v, opts.l),
-		workflow:       NewWorkflowRepository(client, pool, opts.v, opts.l),
-		workflowRun:    NewWorkflowRunRepository(client, shared, opts.metered, cf),
-		jobRun:         NewJobRunAPIRepository(client, shared),
-		stepRun:        NewStepRunAPIRepository(client, pool, opts.v, opts.l),
-		step:           NewStepRepository(pool, opts.v, opts.l),
-		slack:          NewSlackRepository(client, opts.v),
-		sns:            NewSNSRepository(client, opts.v),
-		worker:         NewWorkerAPIRepository(client, pool, opts.v, opts.l, opts.metered),
-		userSession:    NewUserSessionRepository(client, opts.v),
-		user:           NewUserRepository(client, opts.l, opts.v),
-		health:         NewHealthAPIRepository(client, pool),
-		securityCheck:  NewSecurityCheckRepository(client, pool),
-		webhookWorker:  NewWebhookWorkerRepository(client, opts.v),
+		apiToken:            NewAPITokenRepository(client, opts.v, opts.cache),
+		auditLog:            NewAuditLogRepository(shared),
+		event:               NewEventAPIRepository(client, pool, opts.v, opts.l),
+		log:                 logsAPIRepo,
+		tenant:              NewTenantAPIRepository(pool, client, opts.v, opts.l, opts.cache),
+		tenantAlerting:      NewTenantAlertingAPIRepository(client, opts.v, opts.cache),
+		tenantInvite:        NewTenantInviteRepository(client, opts.v, opts.l),
+		tenantRole:          NewTenantRoleRepository(pool, opts.v, opts.l),
+		workflow:            NewWorkflowRepository(client, pool, opts.v, opts.l),
+		workflowRun:         NewWorkflowRunRepository(client, shared, opts.metered, cf),
+		jobRun:              NewJobRunAPIRepository(client, shared),
+		stepRun:             NewStepRunAPIRepository(client, pool, opts.v, opts.l),
+		step:                NewStepRepository(pool, opts.v, opts.l),
+		slack:               NewSlackRepository(client, opts.v),
+		sns:                 NewSNSRepository(client, opts.v),
+		worker:              NewWorkerAPIRepository(client, pool, opts.v, opts.l, opts.metered),
+		userSession:         NewUserSessionRepository(client, opts.v),
+		user:                NewUserRepository(client, opts.l, opts.v),
+		health:              NewHealthAPIRepository(client, pool),
+		securityCheck:       NewSecurityCheckRepository(client, pool),
+		webhookWorker:       NewWebhookWorkerRepository(client, opts.v),
+		webhookSubscription: NewWebhookSubscriptionRepository(pool, opts.v, opts.l),
 	}, cleanup, err
 }
 
@@ -148,6 +161,10 @@ func (r *apiRepository) APIToken() repository.APITokenRepository {
 	return r.apiToken
 }
 
+func (r *apiRepository) AuditLog() repository.AuditLogRepository {
+	return r.auditLog
+}
+
 func (r *apiRepository) Event() repository.EventAPIRepository {
 	return r.event
 }
@@ -168,6 +185,10 @@ func (r *apiRepository) TenantInvite() repository.TenantInviteRepository {
 	return r.tenantInvite
 }
 
+func (r *apiRepository) TenantRole() repository.TenantRoleRepository {
+	return r.tenantRole
+}
+
 func (r *apiRepository) Workflow() repository.WorkflowAPIRepository {
 	return r.workflow
 }
@@ -216,28 +237,34 @@ func (r *apiRepository) WebhookWorker() repository.WebhookWorkerRepository {
 	return r.webhookWorker
 }
 
+func (r *apiRepository) WebhookSubscription() repository.WebhookSubscriptionRepository {
+	return r.webhookSubscription
+}
+
 type engineRepository struct {
-	health         repository.HealthRepository
-	apiToken       repository.EngineTokenRepository
-	dispatcher     repository.DispatcherEngineRepository
-	event          repository.EventEngineRepository
-	getGroupKeyRun repository.GetGroupKeyRunEngineRepository
-	jobRun         repository.JobRunEngineRepository
-	step           repository.StepRepository
-	stepRun        repository.StepRunEngineRepository
-	tenant         repository.TenantEngineRepository
-	tenantAlerting repository.TenantAlertingEngineRepository
-	ticker         repository.TickerEngineRepository
-	worker         repository.WorkerEngineRepository
-	workflow       repository.WorkflowEngineRepository
-	workflowRun    repository.WorkflowRunEngineRepository
-	streamEvent    repository.StreamEventsEngineRepository
-	log            repository.LogsEngineRepository
-	rateLimit      repository.RateLimitEngineRepository
-	webhookWorker  repository.WebhookWorkerEngineRepository
-	scheduler      repository.SchedulerRepository
-	mq             repository.MessageQueueRepository
-	olap           repository.OLAPEventRepository
+	health              repository.HealthRepository
+	apiToken            repository.EngineTokenRepository
+	auditLog            repository.AuditLogRepository
+	dispatcher          repository.DispatcherEngineRepository
+	event               repository.EventEngineRepository
+	getGroupKeyRun      repository.GetGroupKeyRunEngineRepository
+	jobRun              repository.JobRunEngineRepository
+	step                repository.StepRepository
+	stepRun             repository.StepRunEngineRepository
+	tenant              repository.TenantEngineRepository
+	tenantAlerting      repository.TenantAlertingEngineRepository
+	ticker              repository.TickerEngineRepository
+	worker              repository.WorkerEngineRepository
+	workflow            repository.WorkflowEngineRepository
+	workflowRun         repository.WorkflowRunEngineRepository
+	streamEvent         repository.StreamEventsEngineRepository
+	log                 repository.LogsEngineRepository
+	rateLimit           repository.RateLimitEngineRepository
+	webhookWorker       repository.WebhookWorkerEngineRepository
+	webhookSubscription repository.WebhookSubscriptionRepository
+	scheduler           repository.SchedulerRepository
+	mq                  repository.MessageQueueRepository
+	olap                repository.OLAPEventRepository
 }
 
 func (r *engineRepository) Health() repository.HealthRepository {
@@ -248,6 +275,10 @@ func (r *engineRepository) APIToken() repository.EngineTokenRepository {
 	return r.apiToken
 }
 
+func (r *engineRepository) AuditLog() repository.AuditLogRepository {
+	return r.auditLog
+}
+
 func (r *engineRepository) Dispatcher() repository.DispatcherEngineRepository {
 	return r.dispatcher
 }
@@ -312,6 +343,10 @@ func (r *engineRepository) WebhookWorker() repository.WebhookWorkerEngineReposit
 	return r.webhookWorker
 }
 
+func (r *engineRepository) WebhookSubscription() repository.WebhookSubscriptionRepository {
+	return r.webhookSubscription
+}
+
 func (r *engineRepository) Scheduler() repository.SchedulerRepository {
 	return r.scheduler
 }
@@ -356,33 +391,41 @@ func NewEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool, cf *se
 		logRepo = opts.logsEngineRepository.WithAdditionalConfig(opts.v, opts.l)
 	}
 
+	olapRepo := opts.olapEventRepository
+
+	if olapRepo == nil {
+		olapRepo = repository.NewOLAPEventRepository(opts.l)
+	}
+
 	return func() error {
 			rlCache.Stop()
 			queueCache.Stop()
 
 			return cleanup()
 		}, &engineRepository{
-			health:         NewHealthEngineRepository(pool),
-			apiToken:       NewEngineTokenRepository(pool, opts.v, opts.l, opts.cache),
-			dispatcher:     NewDispatcherRepository(pool, essentialPool, opts.v, opts.l),
-			event:          NewEventEngineRepository(shared, opts.metered, cf.EventBuffer),
-			getGroupKeyRun: NewGetGroupKeyRunRepository(pool, opts.v, opts.l),
-			jobRun:         NewJobRunEngineRepository(shared),
-			stepRun:        NewStepRunEngineRepository(shared, cf, rlCache, queueCache),
-			step:           NewStepRepository(pool, opts.v, opts.l),
-			tenant:         NewTenantEngineRepository(pool, opts.v, opts.l, opts.cache),
-			tenantAlerting: NewTenantAlertingEngineRepository(pool, opts.v, opts.l, opts.cache),
-			ticker:         NewTickerRepository(pool, opts.v, opts.l),
-			worker:         NewWorkerEngineRepository(pool, essentialPool, opts.v, opts.l, opts.metered),
-			workflow:       NewWorkflowEngineRepository(shared, opts.metered, opts.cache),
-			workflowRun:    NewWorkflowRunEngineRepository(shared, opts.metered, cf),
-			streamEvent:    NewStreamEventsEngineRepository(pool, opts.v, opts.l),
-			log:            logRepo,
-			rateLimit:      NewRateLimitEngineRepository(pool, opts.v, opts.l),
-			webhookWorker:  NewWebhookWorkerEngineRepository(pool, opts.v, opts.l),
-			scheduler:      newSchedulerRepository(shared),
-			mq:             NewMessageQueueRepository(shared),
-			olap:           repository.NewOLAPEventRepository(opts.l),
+			health:              NewHealthEngineRepository(pool),
+			apiToken:            NewEngineTokenRepository(pool, opts.v, opts.l, opts.cache),
+			auditLog:            NewAuditLogRepository(shared),
+			dispatcher:          NewDispatcherRepository(pool, essentialPool, opts.v, opts.l),
+			event:               NewEventEngineRepository(shared, opts.metered, cf.EventBuffer),
+			getGroupKeyRun:      NewGetGroupKeyRunRepository(pool, opts.v, opts.l),
+			jobRun:              NewJobRunEngineRepository(shared),
+			stepRun:             NewStepRunEngineRepository(shared, cf, rlCache, queueCache),
+			step:                NewStepRepository(pool, opts.v, opts.l),
+			tenant:              NewTenantEngineRepository(pool, opts.v, opts.l, opts.cache),
+			tenantAlerting:      NewTenantAlertingEngineRepository(pool, opts.v, opts.l, opts.cache),
+			ticker:              NewTickerRepository(pool, opts.v, opts.l),
+			worker:              NewWorkerEngineRepository(pool, essentialPool, opts.v, opts.l, opts.metered),
+			workflow:            NewWorkflowEngineRepository(shared, opts.metered, opts.cache),
+			workflowRun:         NewWorkflowRunEngineRepository(shared, opts.metered, cf),
+			streamEvent:         NewStreamEventsEngineRepository(pool, opts.v, opts.l),
+			log:                 logRepo,
+			rateLimit:           NewRateLimitEngineRepository(pool, opts.v, opts.l),
+			webhookWorker:       NewWebhookWorkerEngineRepository(pool, opts.v, opts.l),
+			webhookSubscription: NewWebhookSubscriptionRepository(pool, opts.v, opts.l),
+			scheduler:           newSchedulerRepository(shared),
+			mq:                  NewMessageQueueRepository(shared),
+			olap:                olapRepo,
 		},
 		err
 }
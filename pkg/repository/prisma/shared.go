@@ -27,6 +27,7 @@ type sharedRepository struct {
 	bulkWorkflowRunBuffer *buffer.TenantBufferManager[*repository.CreateWorkflowRunOpts, dbsqlc.WorkflowRun]
 	bulkAckMQBuffer       *buffer.TenantBufferManager[int64, int]
 	bulkAddMQBuffer       *buffer.TenantBufferManager[SendMessage, int]
+	bulkAuditLogBuffer    *buffer.TenantBufferManager[*bufferedAuditLog, dbsqlc.TenantAuditLog]
 
 	wrRunningCallbacks []repository.TenantScopedCallback[pgtype.UUID]
 }
@@ -89,6 +90,12 @@ func newSharedRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.L
 		return nil, nil, err
 	}
 
+	auditLogBuffer, err := newAuditLogBuffer(s)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
 	s.bulkStatusBuffer = statusBuffer
 	s.bulkEventBuffer = eventBuffer
 	s.bulkSemaphoreReleaser = semaphoreReleaser
@@ -97,6 +104,7 @@ func newSharedRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.L
 	s.bulkWorkflowRunBuffer = workflowRunBuffer
 	s.bulkAckMQBuffer = ackMQBuffer
 	s.bulkAddMQBuffer = addMQBuffer
+	s.bulkAuditLogBuffer = auditLogBuffer
 
 	return s, func() error {
 		var multiErr error
@@ -149,6 +157,12 @@ func newSharedRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.L
 			multiErr = multierror.Append(multiErr, err)
 		}
 
+		err = auditLogBuffer.Cleanup()
+
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+
 		return multiErr
 	}, nil
 }
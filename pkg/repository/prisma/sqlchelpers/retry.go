@@ -14,6 +14,14 @@ var DeadlockRetry = func(l *zerolog.Logger, f func() error) error {
 	}, 50*time.Millisecond, 200*time.Millisecond)
 }
 
+// WriteRetry retries a write on any error, for writes which are made in the background and have no caller
+// to return the error to.
+var WriteRetry = func(l *zerolog.Logger, f func() error) error {
+	return genericRetry(l.Warn(), 5, f, "write", func(err error) (bool, error) {
+		return true, err
+	}, 100*time.Millisecond, 1*time.Second)
+}
+
 var genericRetry = func(l *zerolog.Event, maxRetries int, f func() error, msg string, condition func(err error) (bool, error), minSleep, maxSleep time.Duration) error {
 	retries := 0
 
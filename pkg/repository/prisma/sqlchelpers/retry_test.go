@@ -0,0 +1,59 @@
+package sqlchelpers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenericRetry(t *testing.T) {
+	l := zerolog.Nop()
+	errWrite := errors.New("write failed")
+
+	always := func(err error) (bool, error) {
+		return true, err
+	}
+
+	// succeeds once the condition resolves
+	calls := 0
+
+	err := genericRetry(l.Warn(), 3, func() error {
+		calls++
+
+		if calls < 3 {
+			return errWrite
+		}
+
+		return nil
+	}, "test", always, time.Millisecond, 2*time.Millisecond)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	// gives up after the maximum number of retries
+	calls = 0
+
+	err = genericRetry(l.Warn(), 3, func() error {
+		calls++
+		return errWrite
+	}, "test", always, time.Millisecond, 2*time.Millisecond)
+
+	assert.ErrorIs(t, err, errWrite)
+	assert.Equal(t, 4, calls)
+
+	// does not retry errors which don't meet the condition
+	calls = 0
+
+	err = genericRetry(l.Warn(), 3, func() error {
+		calls++
+		return errWrite
+	}, "test", func(err error) (bool, error) {
+		return false, nil
+	}, time.Millisecond, 2*time.Millisecond)
+
+	assert.ErrorIs(t, err, errWrite)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,141 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type tenantRoleRepository struct {
+	v       validator.Validator
+	pool    *pgxpool.Pool
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewTenantRoleRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.TenantRoleRepository {
+	queries := dbsqlc.New()
+
+	return &tenantRoleRepository{
+		pool:    pool,
+		v:       v,
+		l:       l,
+		queries: queries,
+	}
+}
+
+func (r *tenantRoleRepository) CreateTenantRole(ctx context.Context, tenantId string, opts *repository.CreateTenantRoleOpts) (*dbsqlc.TenantRole, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.CreateTenantRoleParams{
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+		Name:        opts.Name,
+		Permissions: opts.Permissions,
+	}
+
+	if opts.Description != nil {
+		params.Description = sqlchelpers.TextFromStr(*opts.Description)
+	}
+
+	if len(opts.WorkflowIds) > 0 {
+		params.WorkflowIds = uuidsFromStrs(opts.WorkflowIds)
+	}
+
+	return r.queries.CreateTenantRole(ctx, r.pool, params)
+}
+
+func (r *tenantRoleRepository) GetTenantRoleById(ctx context.Context, id string) (*dbsqlc.TenantRole, error) {
+	return r.queries.GetTenantRoleById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *tenantRoleRepository) ListTenantRoles(ctx context.Context, tenantId string) ([]*dbsqlc.TenantRole, error) {
+	return r.queries.ListTenantRoles(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *tenantRoleRepository) UpdateTenantRole(ctx context.Context, id string, opts *repository.UpdateTenantRoleOpts) (*dbsqlc.TenantRole, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.UpdateTenantRoleParams{
+		ID:          sqlchelpers.UUIDFromStr(id),
+		Permissions: opts.Permissions,
+	}
+
+	if opts.Name != nil {
+		params.Name = sqlchelpers.TextFromStr(*opts.Name)
+	}
+
+	if opts.Description != nil {
+		params.Description = sqlchelpers.TextFromStr(*opts.Description)
+	}
+
+	if opts.WorkflowIds != nil {
+		params.Setworkflowids = true
+
+		if len(*opts.WorkflowIds) > 0 {
+			params.WorkflowIds = uuidsFromStrs(*opts.WorkflowIds)
+		}
+	}
+
+	return r.queries.UpdateTenantRole(ctx, r.pool, params)
+}
+
+func (r *tenantRoleRepository) DeleteTenantRole(ctx context.Context, id string) error {
+	_, err := r.queries.DeleteTenantRole(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+
+	return err
+}
+
+func (r *tenantRoleRepository) GetTenantRoleForMember(ctx context.Context, memberId string) (*dbsqlc.TenantRole, error) {
+	role, err := r.queries.GetTenantRoleForMember(ctx, r.pool, sqlchelpers.UUIDFromStr(memberId))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return role, nil
+}
+
+func (r *tenantRoleRepository) ListTenantMemberCustomRoles(ctx context.Context, tenantId string) ([]*dbsqlc.ListTenantMemberCustomRolesRow, error) {
+	return r.queries.ListTenantMemberCustomRoles(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *tenantRoleRepository) SetTenantMemberCustomRole(ctx context.Context, memberId string, roleId *string) error {
+	params := dbsqlc.SetTenantMemberCustomRoleParams{
+		Memberid: sqlchelpers.UUIDFromStr(memberId),
+	}
+
+	if roleId != nil {
+		params.CustomRoleId = sqlchelpers.UUIDFromStr(*roleId)
+	}
+
+	_, err := r.queries.SetTenantMemberCustomRole(ctx, r.pool, params)
+
+	return err
+}
+
+func uuidsFromStrs(ids []string) []pgtype.UUID {
+	res := make([]pgtype.UUID, 0, len(ids))
+
+	for _, id := range ids {
+		res = append(res, sqlchelpers.UUIDFromStr(id))
+	}
+
+	return res
+}
@@ -0,0 +1,271 @@
+package prisma
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type webhookSubscriptionRepository struct {
+	v       validator.Validator
+	pool    *pgxpool.Pool
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewWebhookSubscriptionRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.WebhookSubscriptionRepository {
+	queries := dbsqlc.New()
+
+	return &webhookSubscriptionRepository{
+		pool:    pool,
+		v:       v,
+		l:       l,
+		queries: queries,
+	}
+}
+
+func (r *webhookSubscriptionRepository) CreateWebhookSubscription(ctx context.Context, tenantId string, opts *repository.CreateWebhookSubscriptionOpts) (*dbsqlc.WebhookSubscription, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.CreateWebhookSubscriptionParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Name:       opts.Name,
+		Url:        opts.URL,
+		Secret:     opts.Secret,
+		Enabled:    true,
+		Eventtypes: []string{},
+		Statuses:   []string{},
+	}
+
+	if opts.Enabled != nil {
+		params.Enabled = *opts.Enabled
+	}
+
+	if len(opts.EventTypes) > 0 {
+		params.Eventtypes = opts.EventTypes
+	}
+
+	if len(opts.Statuses) > 0 {
+		params.Statuses = opts.Statuses
+	}
+
+	if len(opts.WorkflowIds) > 0 {
+		params.WorkflowIds = uuidsFromStrs(opts.WorkflowIds)
+	}
+
+	subscription, err := r.queries.CreateWebhookSubscription(ctx, r.pool, params)
+
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			return nil, repository.ErrDuplicateKey
+		}
+
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (r *webhookSubscriptionRepository) GetWebhookSubscriptionById(ctx context.Context, id string) (*dbsqlc.WebhookSubscription, error) {
+	return r.queries.GetWebhookSubscriptionById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *webhookSubscriptionRepository) ListWebhookSubscriptions(ctx context.Context, tenantId string) ([]*dbsqlc.WebhookSubscription, error) {
+	return r.queries.ListWebhookSubscriptions(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *webhookSubscriptionRepository) UpdateWebhookSubscription(ctx context.Context, id string, opts *repository.UpdateWebhookSubscriptionOpts) (*dbsqlc.WebhookSubscription, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.UpdateWebhookSubscriptionParams{
+		ID: sqlchelpers.UUIDFromStr(id),
+	}
+
+	if opts.Name != nil {
+		params.Name = sqlchelpers.TextFromStr(*opts.Name)
+	}
+
+	if opts.URL != nil {
+		params.Url = sqlchelpers.TextFromStr(*opts.URL)
+	}
+
+	if opts.Enabled != nil {
+		params.Enabled = pgtype.Bool{Bool: *opts.Enabled, Valid: true}
+	}
+
+	// a non-nil, empty slice clears the filter, so we pass an empty array rather than NULL
+	if opts.EventTypes != nil {
+		params.EventTypes = append([]string{}, *opts.EventTypes...)
+	}
+
+	if opts.Statuses != nil {
+		params.Statuses = append([]string{}, *opts.Statuses...)
+	}
+
+	if opts.WorkflowIds != nil {
+		params.Setworkflowids = true
+
+		if len(*opts.WorkflowIds) > 0 {
+			params.WorkflowIds = uuidsFromStrs(*opts.WorkflowIds)
+		}
+	}
+
+	subscription, err := r.queries.UpdateWebhookSubscription(ctx, r.pool, params)
+
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			return nil, repository.ErrDuplicateKey
+		}
+
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (r *webhookSubscriptionRepository) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	_, err := r.queries.DeleteWebhookSubscription(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+
+	return err
+}
+
+func (r *webhookSubscriptionRepository) CreateWebhookDeliveries(ctx context.Context, tenantId string, opts []repository.CreateWebhookDeliveryOpts) error {
+	if len(opts) == 0 {
+		return nil
+	}
+
+	params := dbsqlc.CreateWebhookDeliveriesParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Subscriptionids: make([]pgtype.UUID, 0, len(opts)),
+		Eventtypes:      make([]string, 0, len(opts)),
+		Payloads:        make([][]byte, 0, len(opts)),
+	}
+
+	for i := range opts {
+		if err := r.v.Validate(&opts[i]); err != nil {
+			return err
+		}
+
+		params.Subscriptionids = append(params.Subscriptionids, sqlchelpers.UUIDFromStr(opts[i].SubscriptionId))
+		params.Eventtypes = append(params.Eventtypes, opts[i].EventType)
+		params.Payloads = append(params.Payloads, opts[i].Payload)
+	}
+
+	return r.queries.CreateWebhookDeliveries(ctx, r.pool, params)
+}
+
+func (r *webhookSubscriptionRepository) PollWebhookDeliveries(ctx context.Context, tenantId string, limit int, lease time.Duration) ([]*dbsqlc.WebhookDelivery, error) {
+	return r.queries.PollWebhookDeliveries(ctx, r.pool, dbsqlc.PollWebhookDeliveriesParams{
+		Tenantid:     sqlchelpers.UUIDFromStr(tenantId),
+		Limit:        pgtype.Int4{Int32: int32(limit), Valid: true}, // nolint: gosec
+		Leaseseconds: int32(lease.Seconds()),                        // nolint: gosec
+	})
+}
+
+func (r *webhookSubscriptionRepository) RecordWebhookDeliveryAttempt(ctx context.Context, deliveryId string, opts *repository.WebhookDeliveryAttemptOpts) error {
+	if err := r.v.Validate(opts); err != nil {
+		return err
+	}
+
+	params := dbsqlc.CreateWebhookDeliveryAttemptParams{
+		Deliveryid: sqlchelpers.UUIDFromStr(deliveryId),
+		Attempt:    opts.Attempt,
+		Durationms: int32(opts.Duration.Milliseconds()), // nolint: gosec
+		Status:     opts.Status,
+	}
+
+	if opts.ResponseStatusCode != nil {
+		params.ResponseStatusCode = pgtype.Int4{Int32: *opts.ResponseStatusCode, Valid: true}
+	}
+
+	if opts.ResponseBody != nil {
+		params.ResponseBody = sqlchelpers.TextFromStr(*opts.ResponseBody)
+	}
+
+	if opts.Error != nil {
+		params.Error = sqlchelpers.TextFromStr(*opts.Error)
+	}
+
+	if opts.NextAttemptAt != nil {
+		params.NextAttemptAt = pgtype.Timestamp{Time: opts.NextAttemptAt.UTC(), Valid: true}
+	}
+
+	return r.queries.CreateWebhookDeliveryAttempt(ctx, r.pool, params)
+}
+
+func (r *webhookSubscriptionRepository) ListWebhookDeliveries(ctx context.Context, subscriptionId string, opts *repository.ListWebhookDeliveriesOpts) (*repository.ListWebhookDeliveriesResult, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	queryParams := dbsqlc.ListWebhookDeliveriesParams{
+		Subscriptionid: sqlchelpers.UUIDFromStr(subscriptionId),
+	}
+
+	countParams := dbsqlc.CountWebhookDeliveriesParams{
+		Subscriptionid: sqlchelpers.UUIDFromStr(subscriptionId),
+	}
+
+	if len(opts.Statuses) > 0 {
+		queryParams.Statuses = opts.Statuses
+		countParams.Statuses = opts.Statuses
+	}
+
+	if opts.Offset != nil {
+		queryParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		queryParams.Limit = *opts.Limit
+	}
+
+	rows, err := r.queries.ListWebhookDeliveries(ctx, r.pool, queryParams)
+
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := r.queries.CountWebhookDeliveries(ctx, r.pool, countParams)
+
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryIds := make([]pgtype.UUID, len(rows))
+
+	for i, row := range rows {
+		deliveryIds[i] = row.ID
+	}
+
+	attempts, err := r.queries.ListWebhookDeliveryAttempts(ctx, r.pool, deliveryIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryIdsToAttempts := make(map[string][]*dbsqlc.WebhookDeliveryAttempt, len(rows))
+
+	for _, attempt := range attempts {
+		deliveryId := sqlchelpers.UUIDToStr(attempt.DeliveryId)
+		deliveryIdsToAttempts[deliveryId] = append(deliveryIdsToAttempts[deliveryId], attempt)
+	}
+
+	return &repository.ListWebhookDeliveriesResult{
+		Rows:     rows,
+		Count:    int(count),
+		Attempts: deliveryIdsToAttempts,
+	}, nil
+}
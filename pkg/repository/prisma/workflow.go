@@ -379,6 +379,49 @@ func (w *workflowAPIRepository) GetCronWorkflow(ctx context.Context, tenantId, c
 	return cronWorkflows[0], nil
 }
 
+func (w *workflowAPIRepository) UpdateCronWorkflow(ctx context.Context, tenantId, id string, opts *repository.UpdateCronWorkflowTriggerOpts) (*dbsqlc.ListCronWorkflowsRow, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	updateParams := dbsqlc.UpdateWorkflowTriggerCronRefParams{
+		ID: sqlchelpers.UUIDFromStr(id),
+	}
+
+	if opts.MissedFirePolicy != nil {
+		updateParams.MissedFirePolicy = dbsqlc.NullWorkflowTriggerCronRefMissedFirePolicy{
+			Valid:                                  true,
+			WorkflowTriggerCronRefMissedFirePolicy: dbsqlc.WorkflowTriggerCronRefMissedFirePolicy(*opts.MissedFirePolicy),
+		}
+	}
+
+	if opts.CatchUpLimit != nil {
+		updateParams.CatchUpLimit = pgtype.Int4{
+			Int32: *opts.CatchUpLimit,
+			Valid: true,
+		}
+	}
+
+	if opts.Timezone != nil {
+		updateParams.Timezone = sqlchelpers.TextFromStr(*opts.Timezone)
+	}
+
+	if opts.OverlapPolicy != nil {
+		updateParams.OverlapPolicy = dbsqlc.NullWorkflowTriggerCronRefOverlapPolicy{
+			Valid:                               true,
+			WorkflowTriggerCronRefOverlapPolicy: dbsqlc.WorkflowTriggerCronRefOverlapPolicy(*opts.OverlapPolicy),
+		}
+	}
+
+	_, err := w.queries.UpdateWorkflowTriggerCronRef(ctx, w.pool, updateParams)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return w.GetCronWorkflow(ctx, tenantId, id)
+}
+
 func (w *workflowAPIRepository) DeleteCronWorkflow(ctx context.Context, tenantId, id string) error {
 	return w.queries.DeleteWorkflowTriggerCronRef(ctx, w.pool, sqlchelpers.UUIDFromStr(id))
 }
@@ -416,6 +459,31 @@ func (w *workflowAPIRepository) CreateCronWorkflow(ctx context.Context, tenantId
 		},
 	}
 
+	if opts.MissedFirePolicy != nil {
+		createParams.MissedFirePolicy = dbsqlc.NullWorkflowTriggerCronRefMissedFirePolicy{
+			Valid:                                  true,
+			WorkflowTriggerCronRefMissedFirePolicy: dbsqlc.WorkflowTriggerCronRefMissedFirePolicy(*opts.MissedFirePolicy),
+		}
+	}
+
+	if opts.CatchUpLimit != nil {
+		createParams.CatchUpLimit = pgtype.Int4{
+			Int32: *opts.CatchUpLimit,
+			Valid: true,
+		}
+	}
+
+	if opts.Timezone != nil {
+		createParams.Timezone = sqlchelpers.TextFromStr(*opts.Timezone)
+	}
+
+	if opts.OverlapPolicy != nil {
+		createParams.OverlapPolicy = dbsqlc.NullWorkflowTriggerCronRefOverlapPolicy{
+			Valid:                               true,
+			WorkflowTriggerCronRefOverlapPolicy: dbsqlc.WorkflowTriggerCronRefOverlapPolicy(*opts.OverlapPolicy),
+		}
+	}
+
 	cronTrigger, err := w.queries.CreateWorkflowTriggerCronRefForWorkflow(ctx, w.pool, createParams)
 
 	if err != nil {
@@ -970,6 +1038,7 @@ func (r *workflowEngineRepository) createWorkflowVersionTxs(ctx context.Context,
 	if opts.Concurrency != nil {
 		params := dbsqlc.CreateWorkflowConcurrencyParams{
 			Workflowversionid: sqlcWorkflowVersion.ID,
+			Cellibraryversion: int32(cel.LibraryVersion),
 		}
 
 		// upsert the action
@@ -994,6 +1063,10 @@ func (r *workflowEngineRepository) createWorkflowVersionTxs(ctx context.Context,
 			params.ConcurrencyGroupExpression = sqlchelpers.TextFromStr(*opts.Concurrency.Expression)
 		}
 
+		if opts.Concurrency.WeightExpression != nil {
+			params.ConcurrencyGroupWeightExpression = sqlchelpers.TextFromStr(*opts.Concurrency.WeightExpression)
+		}
+
 		if opts.Concurrency.MaxRuns != nil {
 			params.MaxRuns = sqlchelpers.ToInt(*opts.Concurrency.MaxRuns)
 		}
@@ -1112,6 +1185,16 @@ func (r *workflowEngineRepository) createWorkflowVersionTxs(ctx context.Context,
 	}
 
 	if oldWorkflowVersion != nil {
+		// carry the state of default crons over to the new workflow version
+		err = r.queries.CopyCronTriggerStateToNewWorkflowTriggers(ctx, tx, dbsqlc.CopyCronTriggerStateToNewWorkflowTriggersParams{
+			Oldworkflowversionid: oldWorkflowVersion.WorkflowVersion.ID,
+			Newworkflowtriggerid: sqlcWorkflowTriggers.ID,
+		})
+
+		if err != nil {
+			return "", fmt.Errorf("could not copy cron trigger state to new workflow triggers: %w", err)
+		}
+
 		// move existing api crons to the new workflow version
 		err = r.queries.MoveCronTriggerToNewWorkflowTriggers(ctx, tx, dbsqlc.MoveCronTriggerToNewWorkflowTriggersParams{
 			Oldworkflowversionid: oldWorkflowVersion.WorkflowVersion.ID,
@@ -1310,9 +1393,39 @@ func (r *workflowEngineRepository) createJobTx(ctx context.Context, tx pgx.Tx, t
 			}
 		}
 
+		if len(stepOpts.UserEventConditions) > 0 {
+			createMatchConditionParams := dbsqlc.CreateStepMatchConditionsParams{
+				Tenantid:          tenantId,
+				Stepid:            sqlchelpers.UUIDFromStr(stepId),
+				Cellibraryversion: int32(cel.LibraryVersion),
+			}
+
+			for _, condition := range stepOpts.UserEventConditions {
+				expression := "true"
+
+				if condition.Expression != nil {
+					expression = *condition.Expression
+				}
+
+				createMatchConditionParams.Eventkeys = append(createMatchConditionParams.Eventkeys, condition.EventKey)
+				createMatchConditionParams.Expressions = append(createMatchConditionParams.Expressions, expression)
+			}
+
+			err := r.queries.CreateStepMatchConditions(
+				ctx,
+				tx,
+				createMatchConditionParams,
+			)
+
+			if err != nil {
+				return "", fmt.Errorf("could not create step match conditions: %w", err)
+			}
+		}
+
 		if len(stepOpts.RateLimits) > 0 {
 			createStepExprParams := dbsqlc.CreateStepExpressionsParams{
-				Stepid: sqlchelpers.UUIDFromStr(stepId),
+				Stepid:            sqlchelpers.UUIDFromStr(stepId),
+				Cellibraryversion: int32(cel.LibraryVersion),
 			}
 
 			for _, rateLimit := range stepOpts.RateLimits {
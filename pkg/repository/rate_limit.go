@@ -34,6 +34,12 @@ type UpsertRateLimitOpts struct {
 
 	// The rate limit duration
 	Duration *string `validate:"omitnil,oneof=SECOND MINUTE HOUR DAY WEEK MONTH YEAR"`
+
+	// (optional) the algorithm used to refill the rate limit, defaults to a fixed window
+	Algorithm *string `validate:"omitnil,oneof=FIXED_WINDOW TOKEN_BUCKET SLIDING_WINDOW"`
+
+	// (optional) the max number of units which can accumulate in a token bucket, defaults to the limit
+	Burst *int `validate:"omitnil,min=1"`
 }
 
 type RateLimitEngineRepository interface {
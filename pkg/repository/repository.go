@@ -7,11 +7,13 @@ import (
 type APIRepository interface {
 	Health() HealthRepository
 	APIToken() APITokenRepository
+	AuditLog() AuditLogRepository
 	Event() EventAPIRepository
 	Log() LogsAPIRepository
 	Tenant() TenantAPIRepository
 	TenantAlertingSettings() TenantAlertingAPIRepository
 	TenantInvite() TenantInviteRepository
+	TenantRole() TenantRoleRepository
 	Workflow() WorkflowAPIRepository
 	WorkflowRun() WorkflowRunAPIRepository
 	JobRun() JobRunAPIRepository
@@ -24,11 +26,13 @@ type APIRepository interface {
 	User() UserRepository
 	SecurityCheck() SecurityCheckRepository
 	WebhookWorker() WebhookWorkerRepository
+	WebhookSubscription() WebhookSubscriptionRepository
 }
 
 type EngineRepository interface {
 	Health() HealthRepository
 	APIToken() EngineTokenRepository
+	AuditLog() AuditLogRepository
 	Dispatcher() DispatcherEngineRepository
 	Event() EventEngineRepository
 	GetGroupKeyRun() GetGroupKeyRunEngineRepository
@@ -45,6 +49,7 @@ type EngineRepository interface {
 	Log() LogsEngineRepository
 	RateLimit() RateLimitEngineRepository
 	WebhookWorker() WebhookWorkerEngineRepository
+	WebhookSubscription() WebhookSubscriptionRepository
 	Scheduler() SchedulerRepository
 	MessageQueue() MessageQueueRepository
 	OLAP() OLAPEventRepository
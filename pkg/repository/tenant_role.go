@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type CreateTenantRoleOpts struct {
+	// (required) the name of the role, unique within the tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (optional) a description of the role
+	Description *string `validate:"omitempty,max=255"`
+
+	// (required) the permissions granted by the role
+	Permissions []string `validate:"required,min=1"`
+
+	// (optional) the workflows which workflow-scoped permissions are restricted to. If empty, the
+	// permissions apply to every workflow in the tenant.
+	WorkflowIds []string `validate:"omitempty,dive,uuid"`
+}
+
+type UpdateTenantRoleOpts struct {
+	// (optional) the name of the role
+	Name *string `validate:"omitnil,hatchetName"`
+
+	// (optional) a description of the role
+	Description *string `validate:"omitnil,max=255"`
+
+	// (optional) the permissions granted by the role
+	Permissions []string `validate:"omitempty,min=1"`
+
+	// (optional) the workflows which workflow-scoped permissions are restricted to. A non-nil, empty
+	// slice removes the restriction.
+	WorkflowIds *[]string `validate:"omitnil,dive,uuid"`
+}
+
+type TenantRoleRepository interface {
+	// CreateTenantRole creates a new custom role in the tenant
+	CreateTenantRole(ctx context.Context, tenantId string, opts *CreateTenantRoleOpts) (*dbsqlc.TenantRole, error)
+
+	// GetTenantRoleById returns the role with the given id
+	GetTenantRoleById(ctx context.Context, id string) (*dbsqlc.TenantRole, error)
+
+	// ListTenantRoles returns all custom roles in the tenant
+	ListTenantRoles(ctx context.Context, tenantId string) ([]*dbsqlc.TenantRole, error)
+
+	// UpdateTenantRole updates the role with the given id
+	UpdateTenantRole(ctx context.Context, id string, opts *UpdateTenantRoleOpts) (*dbsqlc.TenantRole, error)
+
+	// DeleteTenantRole deletes the role with the given id. Members assigned to the role fall back to
+	// their built-in role.
+	DeleteTenantRole(ctx context.Context, id string) error
+
+	// GetTenantRoleForMember returns the custom role assigned to the member, or nil if the member
+	// has no custom role
+	GetTenantRoleForMember(ctx context.Context, memberId string) (*dbsqlc.TenantRole, error)
+
+	// ListTenantMemberCustomRoles returns the custom role assigned to each member of the tenant
+	ListTenantMemberCustomRoles(ctx context.Context, tenantId string) ([]*dbsqlc.ListTenantMemberCustomRolesRow, error)
+
+	// SetTenantMemberCustomRole assigns a custom role to the member. A nil role id removes the
+	// assignment.
+	SetTenantMemberCustomRole(ctx context.Context, memberId string, roleId *string) error
+}
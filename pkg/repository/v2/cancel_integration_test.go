@@ -0,0 +1,93 @@
+//go:build integration
+
+package v2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+)
+
+func TestCancelTasksRemoveFromQueues(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+
+		require.NoError(t, conf.V2.Tasks().UpdateTablePartitions(ctx))
+
+		tenantId := uuid.NewString()
+		slugSuffix, err := random.Generate(8)
+		require.NoError(t, err)
+
+		_, err = conf.APIRepository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+			ID:   &tenantId,
+			Name: "test-tenant",
+			Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+		})
+		require.NoError(t, err)
+
+		_, err = conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+			Name: "standalone",
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name: "standalone",
+					Kind: "DEFAULT",
+					Steps: []repository.CreateWorkflowStepOpts{
+						{ReadableId: "step", Action: "standalone:step"},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		triggerOpts := make([]v2.WorkflowNameTriggerOpts, 2)
+
+		for i := range triggerOpts {
+			triggerOpts[i] = v2.WorkflowNameTriggerOpts{
+				WorkflowName:       "standalone",
+				ExternalId:         uuid.NewString(),
+				Data:               []byte(`{}`),
+				AdditionalMetadata: []byte(`{}`),
+			}
+		}
+
+		tasks, _, err := conf.V2.Triggers().TriggerFromWorkflowNames(ctx, tenantId, triggerOpts)
+		require.NoError(t, err)
+		require.Len(t, tasks, 2)
+
+		queueItemCount := func(taskId int64) int {
+			var count int
+			err := conf.Pool.QueryRow(ctx, `SELECT COUNT(*) FROM v2_queue_item WHERE task_id = $1`, taskId).Scan(&count)
+			require.NoError(t, err)
+			return count
+		}
+
+		kept, removed := tasks[0], tasks[1]
+
+		require.Equal(t, 1, queueItemCount(kept.ID))
+		require.Equal(t, 1, queueItemCount(removed.ID))
+
+		_, cancelled, err := conf.V2.Tasks().CancelTasks(ctx, tenantId, []v2.CancelTaskOpts{
+			{TaskIdRetryCount: &v2.TaskIdRetryCount{Id: kept.ID, RetryCount: kept.RetryCount}},
+			{TaskIdRetryCount: &v2.TaskIdRetryCount{Id: removed.ID, RetryCount: removed.RetryCount}, RemoveFromQueues: true},
+		})
+		require.NoError(t, err)
+		assert.Len(t, cancelled, 2)
+
+		// only the task which was cancelled with RemoveFromQueues is removed from the queue, the other is
+		// left for the scheduler
+		assert.Equal(t, 1, queueItemCount(kept.ID))
+		assert.Equal(t, 0, queueItemCount(removed.ID))
+
+		return nil
+	})
+}
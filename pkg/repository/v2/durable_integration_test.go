@@ -0,0 +1,127 @@
+//go:build integration
+
+package v2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+func TestDurableSleepSlotAccounting(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+
+		require.NoError(t, conf.V2.Tasks().UpdateTablePartitions(ctx))
+
+		tenantId := uuid.NewString()
+		slugSuffix, err := random.Generate(8)
+		require.NoError(t, err)
+
+		_, err = conf.APIRepository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+			ID:   &tenantId,
+			Name: "test-tenant",
+			Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+		})
+		require.NoError(t, err)
+
+		_, err = conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+			Name: "nap",
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name:  "nap",
+					Kind:  "DEFAULT",
+					Steps: []repository.CreateWorkflowStepOpts{{ReadableId: "nap", Action: "nap:nap"}},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		dispatcher, err := conf.EngineRepository.Dispatcher().CreateNewDispatcher(ctx, &repository.CreateDispatcherOpts{
+			ID: uuid.NewString(),
+		})
+		require.NoError(t, err)
+
+		maxRuns := 1
+
+		worker, err := conf.EngineRepository.Worker().CreateNewWorker(ctx, tenantId, &repository.CreateWorkerOpts{
+			DispatcherId: sqlchelpers.UUIDToStr(dispatcher.ID),
+			MaxRuns:      &maxRuns,
+			Name:         "sleepy-worker",
+			Actions:      []string{"nap:nap"},
+		})
+		require.NoError(t, err)
+
+		tasks, _, err := conf.V2.Triggers().TriggerFromWorkflowNames(ctx, tenantId, []v2.WorkflowNameTriggerOpts{
+			{
+				WorkflowName:       "nap",
+				ExternalId:         uuid.NewString(),
+				Data:               []byte(`{}`),
+				AdditionalMetadata: []byte(`{}`),
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+
+		task := tasks[0]
+
+		// the task was assigned to the worker
+		_, err = conf.Pool.Exec(ctx, `DELETE FROM v2_queue_item WHERE task_id = $1 AND retry_count = $2`, task.ID, task.RetryCount)
+		require.NoError(t, err)
+
+		_, err = conf.Pool.Exec(
+			ctx,
+			`INSERT INTO v2_task_runtime (task_id, retry_count, worker_id, tenant_id, timeout_at) VALUES ($1, $2, $3, $4, NOW() + INTERVAL '5 minutes')`,
+			task.ID, task.RetryCount, worker.ID, task.TenantID,
+		)
+		require.NoError(t, err)
+
+		availableSlots := func() int32 {
+			slots, err := conf.V2.Scheduler().Assignment().ListAvailableSlotsForWorkers(ctx, sqlchelpers.UUIDFromStr(tenantId), sqlcv2.ListAvailableSlotsForWorkersParams{
+				Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+				Workerids: []pgtype.UUID{worker.ID},
+			})
+			require.NoError(t, err)
+			require.Len(t, slots, 1)
+
+			return slots[0].AvailableSlots
+		}
+
+		assert.Equal(t, int32(0), availableSlots())
+
+		// the task gives up its slot while it sleeps
+		err = conf.V2.Matches().RegisterDurableEvent(ctx, tenantId, v2.RegisterDurableEventOpts{
+			TaskId:          task.ID,
+			RetryCount:      task.RetryCount,
+			SignalKey:       "nap",
+			SleepConditions: []v2.DurableSleepCondition{{SleepFor: "1s"}},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(1), availableSlots())
+
+		time.Sleep(1500 * time.Millisecond)
+
+		_, _, err = conf.V2.Matches().ProcessDurableSleeps(ctx, tenantId)
+		require.NoError(t, err)
+
+		// the task holds its slot again once it resumes
+		assert.Equal(t, int32(0), availableSlots())
+
+		return nil
+	})
+}
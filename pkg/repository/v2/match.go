@@ -3,14 +3,18 @@ package v2
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/google/cel-go/cel"
-	"github.com/google/cel-go/checker/decls"
 
+	celparser "github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
 )
@@ -42,6 +46,12 @@ type CreateMatchOpts struct {
 
 	TriggerStepId *string
 
+	// (optional) an existing task which is run again when the match is satisfied, instead of creating
+	// a new task
+	TriggerExistingTaskId *int64
+
+	TriggerExistingTaskInsertedAt pgtype.Timestamptz
+
 	SignalTaskId *int64
 
 	SignalKey *string
@@ -50,6 +60,9 @@ type CreateMatchOpts struct {
 type InternalEventMatchResults struct {
 	// The list of tasks which were created from the matches
 	CreatedTasks []*sqlcv2.V2Task
+
+	// The list of existing tasks which were run again from the matches
+	ReplayedTasks []*sqlcv2.V2Task
 }
 
 type GroupMatchCondition struct {
@@ -61,40 +74,310 @@ type GroupMatchCondition struct {
 
 	Expression string
 
+	// (optional) the version of the CEL function library which the expression was validated against,
+	// which defaults to the latest version
+	CelLibraryVersion *int32
+
 	Action sqlcv2.V2MatchConditionAction
+
+	// (optional) whether the condition is already satisfied when it's created, along with the data
+	// which satisfied it
+	IsSatisfied bool
+
+	Data []byte
+}
+
+type DurableSleepCondition struct {
+	// (required) how long to sleep for, for example "10s"
+	SleepFor string `validate:"required,duration"`
+}
+
+type DurableUserEventCondition struct {
+	// (required) the key of the user event to wait for
+	EventKey string `validate:"required"`
+
+	// (optional) a CEL expression which the event payload must satisfy
+	Expression string `validate:"omitempty,celmatchconditionstr"`
+}
+
+type RegisterDurableEventOpts struct {
+	// (required) the id of the running task
+	TaskId int64 `validate:"required"`
+
+	// (required) the retry count of the running task
+	RetryCount int32
+
+	// (required) a key which identifies the event for the task
+	SignalKey string `validate:"required"`
+
+	// (optional) resume the task after a duration has elapsed
+	SleepConditions []DurableSleepCondition `validate:"dive"`
+
+	// (optional) resume the task when a matching user event is pushed
+	UserEventConditions []DurableUserEventCondition `validate:"dive"`
 }
 
 type MatchRepository interface {
 	ProcessInternalEventMatches(ctx context.Context, tenantId string, events []CandidateEventMatch) (*InternalEventMatchResults, error)
+
+	// ProcessUserEventMatches satisfies any match conditions which are waiting on the given user events.
+	ProcessUserEventMatches(ctx context.Context, tenantId string, events []CandidateEventMatch) (*InternalEventMatchResults, error)
+
+	// RegisterDurableEvent releases the slot of a running task and registers a signal match for it, which
+	// is completed by the first condition to be satisfied. The task takes its slot back when the signal
+	// completes.
+	RegisterDurableEvent(ctx context.Context, tenantId string, opts RegisterDurableEventOpts) error
+
+	// ProcessDurableSleeps satisfies the match conditions for any durable sleeps which have expired.
+	ProcessDurableSleeps(ctx context.Context, tenantId string) (*InternalEventMatchResults, bool, error)
 }
 
 type MatchRepositoryImpl struct {
 	*sharedRepository
-
-	env *cel.Env
 }
 
 func newMatchRepository(s *sharedRepository) (MatchRepository, error) {
-	env, err := cel.NewEnv(
-		cel.Declarations(
-			decls.NewVar("input", decls.NewMapType(decls.String, decls.Dyn)),
-		),
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
 	return &MatchRepositoryImpl{
 		sharedRepository: s,
-		env:              env,
 	}, nil
 }
 
 // ProcessInternalEventMatches processes a list of internal events
 func (m *MatchRepositoryImpl) ProcessInternalEventMatches(ctx context.Context, tenantId string, events []CandidateEventMatch) (*InternalEventMatchResults, error) {
+	return m.processEventMatchesTx(ctx, tenantId, events, sqlcv2.V2EventTypeINTERNAL)
+}
+
+// ProcessUserEventMatches processes a list of user events
+func (m *MatchRepositoryImpl) ProcessUserEventMatches(ctx context.Context, tenantId string, events []CandidateEventMatch) (*InternalEventMatchResults, error) {
+	return m.processEventMatchesTx(ctx, tenantId, events, sqlcv2.V2EventTypeUSER)
+}
+
+func (m *MatchRepositoryImpl) processEventMatchesTx(ctx context.Context, tenantId string, events []CandidateEventMatch, eventType sqlcv2.V2EventType) (*InternalEventMatchResults, error) {
 	start := time.Now()
 
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, m.pool, m.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	res, err := m.processEventMatches(ctx, tx, tenantId, events, eventType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// commit
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	end := time.Now()
+
+	if end.Sub(start) > 100*time.Millisecond {
+		m.l.Warn().Msgf("processing %s event matches took %s", strings.ToLower(string(eventType)), end.Sub(start))
+	}
+
+	return res, nil
+}
+
+func (m *MatchRepositoryImpl) RegisterDurableEvent(ctx context.Context, tenantId string, opts RegisterDurableEventOpts) error {
+	if err := m.v.Validate(opts); err != nil {
+		return err
+	}
+
+	if len(opts.SleepConditions) == 0 && len(opts.UserEventConditions) == 0 {
+		return fmt.Errorf("at least one sleep or user event condition is required")
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, m.pool, m.l, 5000)
+
+	if err != nil {
+		return err
+	}
+
+	defer rollback()
+
+	// the task doesn't count against the worker's slots while it waits, and takes its slot back once the
+	// signal completes
+	_, err = m.queries.ReleaseTaskRuntimeSlot(ctx, tx, sqlcv2.ReleaseTaskRuntimeSlotParams{
+		Taskid:     opts.TaskId,
+		Retrycount: opts.RetryCount,
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("task %d is not running", opts.TaskId)
+		}
+
+		return fmt.Errorf("could not release task slot: %w", err)
+	}
+
+	signalKey := GetDurableSignalEventKey(opts.TaskId, opts.RetryCount, opts.SignalKey)
+
+	// if the event has already been registered, there's nothing left to do
+	existingEvents, err := m.queries.ListMatchingSignalEvents(
+		ctx,
+		tx,
+		sqlcv2.ListMatchingSignalEventsParams{
+			Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+			Taskids:    []int64{opts.TaskId},
+			Signalkeys: []string{signalKey},
+			Eventtype:  sqlcv2.V2TaskEventTypeSIGNALCREATED,
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if len(existingEvents) > 0 {
+		return commit(ctx)
+	}
+
+	// all conditions share a group, so the first condition to be satisfied completes the signal
+	groupId := uuid.NewString()
+	conditions := make([]GroupMatchCondition, 0, len(opts.SleepConditions)+len(opts.UserEventConditions))
+
+	if len(opts.SleepConditions) > 0 {
+		sleepDurations := make([]string, 0, len(opts.SleepConditions))
+
+		for _, condition := range opts.SleepConditions {
+			sleepDurations = append(sleepDurations, condition.SleepFor)
+		}
+
+		sleeps, err := m.queries.CreateDurableSleep(ctx, tx, sqlcv2.CreateDurableSleepParams{
+			Sleepdurations: sleepDurations,
+			Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+		})
+
+		if err != nil {
+			return fmt.Errorf("could not create durable sleeps: %w", err)
+		}
+
+		for _, sleep := range sleeps {
+			conditions = append(conditions, GroupMatchCondition{
+				GroupId:    groupId,
+				EventType:  sqlcv2.V2EventTypeINTERNAL,
+				EventKey:   getDurableSleepEventKey(sleep.ID),
+				Expression: "true",
+				Action:     sqlcv2.V2MatchConditionActionCREATE,
+			})
+		}
+	}
+
+	for _, condition := range opts.UserEventConditions {
+		expression := condition.Expression
+
+		if expression == "" {
+			expression = "true"
+		}
+
+		conditions = append(conditions, GroupMatchCondition{
+			GroupId:    groupId,
+			EventType:  sqlcv2.V2EventTypeUSER,
+			EventKey:   condition.EventKey,
+			Expression: expression,
+			Action:     sqlcv2.V2MatchConditionActionCREATE,
+		})
+	}
+
+	err = m.createEventMatches(ctx, tx, tenantId, []CreateMatchOpts{
+		{
+			Kind:         sqlcv2.V2MatchKindSIGNAL,
+			Conditions:   conditions,
+			SignalTaskId: &opts.TaskId,
+			SignalKey:    &signalKey,
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not create durable event match: %w", err)
+	}
+
+	err = m.createTaskEvents(
+		ctx,
+		tx,
+		tenantId,
+		[]TaskIdRetryCount{{Id: opts.TaskId, RetryCount: -1}},
+		[][]byte{{}},
+		sqlcv2.V2TaskEventTypeSIGNALCREATED,
+		[]string{signalKey},
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not create signal created event: %w", err)
+	}
+
+	return commit(ctx)
+}
+
+func (m *MatchRepositoryImpl) ProcessDurableSleeps(ctx context.Context, tenantId string) (*InternalEventMatchResults, bool, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, m.pool, m.l, 5000)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer rollback()
+
+	// TODO: make limit configurable
+	limit := 1000
+
+	sleeps, err := m.queries.PopDurableSleep(ctx, tx, sqlcv2.PopDurableSleepParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Limit: pgtype.Int4{
+			Int32: int32(limit),
+			Valid: true,
+		},
+	})
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	res := &InternalEventMatchResults{}
+
+	if len(sleeps) == 0 {
+		return res, false, nil
+	}
+
+	events := make([]CandidateEventMatch, 0, len(sleeps))
+
+	for _, sleep := range sleeps {
+		data, err := json.Marshal(map[string]interface{}{
+			"sleep_duration": sleep.SleepDuration,
+		})
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		events = append(events, CandidateEventMatch{
+			ID:             uuid.NewString(),
+			EventTimestamp: sleep.SleepUntil.Time,
+			Key:            getDurableSleepEventKey(sleep.ID),
+			Data:           data,
+		})
+	}
+
+	res, err = m.processEventMatches(ctx, tx, tenantId, events, sqlcv2.V2EventTypeINTERNAL)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, false, err
+	}
+
+	return res, len(sleeps) == limit, nil
+}
+
+func (m *MatchRepositoryImpl) processEventMatches(ctx context.Context, tx sqlcv2.DBTX, tenantId string, events []CandidateEventMatch, eventType sqlcv2.V2EventType) (*InternalEventMatchResults, error) {
 	res := &InternalEventMatchResults{}
 
 	eventKeys := make([]string, 0, len(events))
@@ -115,10 +398,10 @@ func (m *MatchRepositoryImpl) ProcessInternalEventMatches(ctx context.Context, t
 	// list all match conditions
 	matchConditions, err := m.queries.ListMatchConditionsForEvent(
 		ctx,
-		m.pool,
+		tx,
 		sqlcv2.ListMatchConditionsForEventParams{
 			Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
-			Eventtype: sqlcv2.V2EventTypeINTERNAL,
+			Eventtype: eventType,
 			Eventkeys: eventKeys,
 		},
 	)
@@ -157,14 +440,6 @@ func (m *MatchRepositoryImpl) ProcessInternalEventMatches(ctx context.Context, t
 		}
 	}
 
-	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, m.pool, m.l, 5000)
-
-	if err != nil {
-		return nil, err
-	}
-
-	defer rollback()
-
 	// update condition rows in the database
 	satisfiedMatchIds, err := m.queries.GetSatisfiedMatchConditions(
 		ctx,
@@ -233,6 +508,7 @@ func (m *MatchRepositoryImpl) ProcessInternalEventMatches(ctx context.Context, t
 
 		// determine which tasks to create based on step ids
 		taskOpts := make([]CreateTaskOpts, 0, len(satisfiedMatches))
+		replayOpts := make([]ReplayTaskOpts, 0)
 
 		for _, match := range satisfiedMatches {
 			if match.TriggerStepID.Valid && match.TriggerExternalID.Valid {
@@ -270,6 +546,17 @@ func (m *MatchRepositoryImpl) ProcessInternalEventMatches(ctx context.Context, t
 					opt.DagInsertedAt = match.TriggerDagInsertedAt
 				}
 
+				// if the match references an existing task, the task is run again in place
+				if match.TriggerExistingTaskID.Valid && match.TriggerExistingTaskInsertedAt.Valid {
+					replayOpts = append(replayOpts, ReplayTaskOpts{
+						CreateTaskOpts: opt,
+						TaskId:         match.TriggerExistingTaskID.Int64,
+						TaskInsertedAt: match.TriggerExistingTaskInsertedAt,
+					})
+
+					continue
+				}
+
 				taskOpts = append(taskOpts, opt)
 			}
 		}
@@ -284,11 +571,22 @@ func (m *MatchRepositoryImpl) ProcessInternalEventMatches(ctx context.Context, t
 		for _, task := range tasks {
 			res.CreatedTasks = append(res.CreatedTasks, task)
 		}
+
+		if len(replayOpts) > 0 {
+			replayedTasks, err := m.replayTasksWithInput(ctx, tx, tenantId, replayOpts)
+
+			if err != nil {
+				return nil, err
+			}
+
+			res.ReplayedTasks = append(res.ReplayedTasks, replayedTasks...)
+		}
 	}
 
 	if len(signalIds) > 0 {
 		// create a SIGNAL_COMPLETED event for any signal
 		taskIds := make([]TaskIdRetryCount, 0, len(satisfiedMatches))
+		signalTaskIds := make([]int64, 0, len(satisfiedMatches))
 		datas := make([][]byte, 0, len(satisfiedMatches))
 		eventKeys := make([]string, 0, len(satisfiedMatches))
 
@@ -298,52 +596,58 @@ func (m *MatchRepositoryImpl) ProcessInternalEventMatches(ctx context.Context, t
 					Id:         match.SignalTargetID.Int64,
 					RetryCount: -1,
 				})
+				signalTaskIds = append(signalTaskIds, match.SignalTargetID.Int64)
 				datas = append(datas, match.McAggregatedData)
 				eventKeys = append(eventKeys, match.SignalKey.String)
 			}
 		}
 
-		err = m.createTaskEvents(ctx, tx, tenantId, taskIds, datas, sqlcv2.V2TaskEventTypeSIGNALCOMPLETED, eventKeys)
+		// the tasks resume on their workers, so they count against the worker slots again. A worker whose
+		// slots were filled while the tasks waited is over its limit until enough tasks finish, since the
+		// scheduler doesn't assign tasks to workers without available slots.
+		err = m.queries.ReacquireTaskRuntimeSlots(ctx, tx, sqlcv2.ReacquireTaskRuntimeSlotsParams{
+			Taskids:  signalTaskIds,
+			Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		})
 
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("could not reacquire task slots: %w", err)
 		}
-	}
 
-	// commit
-	if err := commit(ctx); err != nil {
-		return nil, err
-	}
-
-	end := time.Now()
+		err = m.createTaskEvents(ctx, tx, tenantId, taskIds, datas, sqlcv2.V2TaskEventTypeSIGNALCOMPLETED, eventKeys)
 
-	if end.Sub(start) > 100*time.Millisecond {
-		m.l.Warn().Msgf("processing internal event matches took %s", end.Sub(start))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return res, nil
 }
 
 func (m *MatchRepositoryImpl) processCELExpressions(ctx context.Context, events []CandidateEventMatch, conditions []*sqlcv2.ListMatchConditionsForEventRow) (map[string][]*sqlcv2.ListMatchConditionsForEventRow, error) {
-	// parse CEL expressions
+	// parse CEL expressions, grouped by the event key of the condition
 	programs := make(map[int64]cel.Program)
-	conditionIdsToConditions := make(map[int64]*sqlcv2.ListMatchConditionsForEventRow)
+	eventKeysToConditions := make(map[string][]*sqlcv2.ListMatchConditionsForEventRow)
 
 	for _, condition := range conditions {
-		ast, issues := m.env.Compile(condition.Expression.String)
+		parser, err := m.celParser.AtVersion(uint32(condition.CelLibraryVersion)) // nolint: gosec
 
-		if issues != nil {
-			return nil, issues.Err()
+		if err != nil {
+			m.l.Error().Err(err).Msgf("failed to compile expression for match condition %d", condition.ID)
+			continue
 		}
 
-		program, err := m.env.Program(ast)
+		program, err := parser.ParseMatchCondition(condition.Expression.String)
 
 		if err != nil {
-			return nil, err
+			// expressions which were registered before they were validated may be invalid, in which case
+			// the condition can never be satisfied
+			m.l.Error().Err(err).Msgf("failed to compile expression for match condition %d", condition.ID)
+			continue
 		}
 
 		programs[condition.ID] = program
-		conditionIdsToConditions[condition.ID] = condition
+		eventKeysToConditions[condition.EventKey] = append(eventKeysToConditions[condition.EventKey], condition)
 	}
 
 	// map of event ids to matched conditions
@@ -356,22 +660,27 @@ func (m *MatchRepositoryImpl) processCELExpressions(ctx context.Context, events
 			err := json.Unmarshal(event.Data, &inputData)
 
 			if err != nil {
+				// events which aren't JSON objects can't satisfy any conditions
 				m.l.Error().Err(err).Msgf("failed to unmarshal event data %s", string(event.Data))
-				return nil, err
+				continue
 			}
 		}
 
-		for conditionId, program := range programs {
-			out, _, err := program.Eval(map[string]interface{}{
-				"input": inputData,
-			})
+		for _, condition := range eventKeysToConditions[event.Key] {
+			out, err := m.celParser.Eval(programs[condition.ID], celparser.NewInput(
+				celparser.WithInput(inputData),
+				celparser.WithEventTimestamp(event.EventTimestamp),
+			))
 
 			if err != nil {
-				return nil, err
+				// an expression which can't be evaluated against the payload (for example, because of a
+				// missing key) doesn't match the event
+				m.l.Debug().Err(err).Msgf("failed to evaluate expression for match condition %d", condition.ID)
+				continue
 			}
 
-			if out.Value().(bool) {
-				matches[event.ID] = append(matches[event.ID], conditionIdsToConditions[conditionId])
+			if matched, ok := out.Value().(bool); ok && matched {
+				matches[event.ID] = append(matches[event.ID], condition)
 			}
 		}
 	}
@@ -388,25 +697,49 @@ func (m *sharedRepository) createEventMatches(ctx context.Context, tx sqlcv2.DBT
 	triggerStepIds := make([]pgtype.UUID, 0, len(eventMatches))
 	triggerExternalIds := make([]pgtype.UUID, 0, len(eventMatches))
 
+	replayParams := sqlcv2.CreateMatchesForDAGReplaysParams{}
+
 	signalTenantIds := make([]pgtype.UUID, 0, len(eventMatches))
 	signalKinds := make([]string, 0, len(eventMatches))
 	signalTargetIds := make([]int64, 0, len(eventMatches))
 	signalKeys := make([]string, 0, len(eventMatches))
 
+	// the matches are created in groups, so we keep track of the options in the order that the
+	// matches are created
+	dagMatchOpts := make([]CreateMatchOpts, 0, len(eventMatches))
+	replayMatchOpts := make([]CreateMatchOpts, 0)
+	signalMatchOpts := make([]CreateMatchOpts, 0, len(eventMatches))
+
 	for _, match := range eventMatches {
 		// at the moment, we skip creating matches for things that don't have all fields set
 		if match.TriggerDAGId != nil && match.TriggerDAGInsertedAt.Valid && match.TriggerStepId != nil && match.TriggerExternalId != nil {
+			if match.TriggerExistingTaskId != nil && match.TriggerExistingTaskInsertedAt.Valid {
+				replayParams.Tenantids = append(replayParams.Tenantids, sqlchelpers.UUIDFromStr(tenantId))
+				replayParams.Kinds = append(replayParams.Kinds, string(match.Kind))
+				replayParams.Triggerdagids = append(replayParams.Triggerdagids, *match.TriggerDAGId)
+				replayParams.Triggerdaginsertedats = append(replayParams.Triggerdaginsertedats, match.TriggerDAGInsertedAt)
+				replayParams.Triggerstepids = append(replayParams.Triggerstepids, sqlchelpers.UUIDFromStr(*match.TriggerStepId))
+				replayParams.Triggerexternalids = append(replayParams.Triggerexternalids, sqlchelpers.UUIDFromStr(*match.TriggerExternalId))
+				replayParams.Triggerexistingtaskids = append(replayParams.Triggerexistingtaskids, *match.TriggerExistingTaskId)
+				replayParams.Triggerexistingtaskinsertedats = append(replayParams.Triggerexistingtaskinsertedats, match.TriggerExistingTaskInsertedAt)
+				replayMatchOpts = append(replayMatchOpts, match)
+
+				continue
+			}
+
 			dagTenantIds = append(dagTenantIds, sqlchelpers.UUIDFromStr(tenantId))
 			dagKinds = append(dagKinds, string(match.Kind))
 			triggerDagIds = append(triggerDagIds, *match.TriggerDAGId)
 			triggerDagInsertedAts = append(triggerDagInsertedAts, match.TriggerDAGInsertedAt)
 			triggerStepIds = append(triggerStepIds, sqlchelpers.UUIDFromStr(*match.TriggerStepId))
 			triggerExternalIds = append(triggerExternalIds, sqlchelpers.UUIDFromStr(*match.TriggerExternalId))
+			dagMatchOpts = append(dagMatchOpts, match)
 		} else if match.SignalTaskId != nil && match.SignalKey != nil {
 			signalTenantIds = append(signalTenantIds, sqlchelpers.UUIDFromStr(tenantId))
 			signalKinds = append(signalKinds, string(match.Kind))
 			signalTargetIds = append(signalTargetIds, *match.SignalTaskId)
 			signalKeys = append(signalKeys, *match.SignalKey)
+			signalMatchOpts = append(signalMatchOpts, match)
 		}
 	}
 
@@ -433,6 +766,20 @@ func (m *sharedRepository) createEventMatches(ctx context.Context, tx sqlcv2.DBT
 		createdMatches = append(createdMatches, dagCreatedMatches...)
 	}
 
+	if len(replayParams.Tenantids) > 0 {
+		replayCreatedMatches, err := m.queries.CreateMatchesForDAGReplays(
+			ctx,
+			tx,
+			replayParams,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		createdMatches = append(createdMatches, replayCreatedMatches...)
+	}
+
 	if len(signalTenantIds) > 0 {
 		signalCreatedMatches, err := m.queries.CreateMatchesForSignalTriggers(
 			ctx,
@@ -452,25 +799,36 @@ func (m *sharedRepository) createEventMatches(ctx context.Context, tx sqlcv2.DBT
 		createdMatches = append(createdMatches, signalCreatedMatches...)
 	}
 
-	if len(createdMatches) != len(eventMatches) {
-		return fmt.Errorf("expected %d matches to be created, but only %d were created", len(eventMatches), len(createdMatches))
+	orderedMatchOpts := append(append(dagMatchOpts, replayMatchOpts...), signalMatchOpts...)
+
+	if len(createdMatches) != len(orderedMatchOpts) {
+		return fmt.Errorf("expected %d matches to be created, but only %d were created", len(orderedMatchOpts), len(createdMatches))
 	}
 
 	// next, create the match conditions
 	params := make([]sqlcv2.CreateMatchConditionsParams, 0, len(eventMatches))
 
-	for i, match := range eventMatches {
+	for i, match := range orderedMatchOpts {
 		createdMatch := createdMatches[i]
 
 		for _, condition := range match.Conditions {
+			celLibraryVersion := int32(celparser.LibraryVersion)
+
+			if condition.CelLibraryVersion != nil {
+				celLibraryVersion = *condition.CelLibraryVersion
+			}
+
 			params = append(params, sqlcv2.CreateMatchConditionsParams{
-				V2MatchID:  createdMatch.ID,
-				TenantID:   sqlchelpers.UUIDFromStr(tenantId),
-				EventType:  condition.EventType,
-				EventKey:   condition.EventKey,
-				OrGroupID:  sqlchelpers.UUIDFromStr(condition.GroupId),
-				Expression: sqlchelpers.TextFromStr(condition.Expression),
-				Action:     condition.Action,
+				V2MatchID:         createdMatch.ID,
+				TenantID:          sqlchelpers.UUIDFromStr(tenantId),
+				EventType:         condition.EventType,
+				EventKey:          condition.EventKey,
+				OrGroupID:         sqlchelpers.UUIDFromStr(condition.GroupId),
+				Expression:        sqlchelpers.TextFromStr(condition.Expression),
+				Action:            condition.Action,
+				IsSatisfied:       condition.IsSatisfied,
+				Data:              condition.Data,
+				CelLibraryVersion: celLibraryVersion,
 			})
 		}
 	}
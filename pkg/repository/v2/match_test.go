@@ -0,0 +1,90 @@
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+func TestProcessCELExpressionsForUserEvents(t *testing.T) {
+	l := zerolog.Nop()
+
+	repo, err := newMatchRepository(&sharedRepository{
+		l:         &l,
+		celParser: cel.NewCELParser(),
+	})
+	require.NoError(t, err)
+
+	m := repo.(*MatchRepositoryImpl)
+
+	conditions := []*sqlcv2.ListMatchConditionsForEventRow{
+		{
+			ID:         1,
+			EventType:  sqlcv2.V2EventTypeUSER,
+			EventKey:   "order:created",
+			Expression: sqlchelpers.TextFromStr("input.amount > 10"),
+		},
+		{
+			// invalid expressions can never be satisfied, but don't prevent other conditions from matching
+			ID:         2,
+			EventType:  sqlcv2.V2EventTypeUSER,
+			EventKey:   "order:created",
+			Expression: sqlchelpers.TextFromStr("input.amount >"),
+		},
+		{
+			ID:         3,
+			EventType:  sqlcv2.V2EventTypeUSER,
+			EventKey:   "order:paid",
+			Expression: sqlchelpers.TextFromStr("true"),
+		},
+	}
+
+	events := []CandidateEventMatch{
+		{
+			ID:   "matches",
+			Key:  "order:created",
+			Data: []byte(`{"amount": 20}`),
+		},
+		{
+			ID:   "does-not-satisfy-expression",
+			Key:  "order:created",
+			Data: []byte(`{"amount": 5}`),
+		},
+		{
+			// the expression can't be evaluated without the key, so the event doesn't match
+			ID:   "missing-key",
+			Key:  "order:created",
+			Data: []byte(`{}`),
+		},
+		{
+			// events which aren't JSON objects can't satisfy any conditions
+			ID:   "not-json",
+			Key:  "order:paid",
+			Data: []byte(`not json`),
+		},
+		{
+			// conditions only match events with their own key
+			ID:   "other-key",
+			Key:  "order:paid",
+			Data: []byte(`{"amount": 20}`),
+		},
+	}
+
+	matches, err := m.processCELExpressions(context.Background(), events, conditions)
+	require.NoError(t, err)
+
+	assert.Len(t, matches, 2)
+
+	require.Len(t, matches["matches"], 1)
+	assert.Equal(t, int64(1), matches["matches"][0].ID)
+
+	require.Len(t, matches["other-key"], 1)
+	assert.Equal(t, int64(3), matches["other-key"][0].ID)
+}
@@ -34,6 +34,8 @@ const (
 	V2EventTypeOlapTIMEDOUT           V2EventTypeOlap = "TIMED_OUT"
 	V2EventTypeOlapRATELIMITERROR     V2EventTypeOlap = "RATE_LIMIT_ERROR"
 	V2EventTypeOlapSKIPPED            V2EventTypeOlap = "SKIPPED"
+	V2EventTypeOlapREPLAYED           V2EventTypeOlap = "REPLAYED"
+	V2EventTypeOlapRESETBYREPLAY      V2EventTypeOlap = "RESET_BY_REPLAY"
 )
 
 func (e *V2EventTypeOlap) Scan(src interface{}) error {
@@ -32,8 +32,50 @@ WHERE
             ) AS u ON kv.key = u.k AND kv.value = u.v
         )
     )
+    AND (
+        sqlc.narg('displayName')::text IS NULL
+        OR display_name ILIKE '%' || sqlc.narg('displayName')::text || '%'
+    )
+    AND (
+        sqlc.narg('inputContains')::jsonb IS NULL
+        OR input @> sqlc.narg('inputContains')::jsonb
+    )
+    AND (
+        sqlc.narg('errorContains')::text IS NULL
+        OR EXISTS (
+            SELECT 1 FROM v2_task_events_olap e
+            WHERE
+                e.task_id = v2_tasks_olap.id
+                AND e.task_inserted_at = v2_tasks_olap.inserted_at
+                AND e.error_message ILIKE '%' || sqlc.narg('errorContains')::text || '%'
+        )
+    )
+    AND (
+        sqlc.narg('errorRegex')::text IS NULL
+        OR EXISTS (
+            SELECT 1 FROM v2_task_events_olap e
+            WHERE
+                e.task_id = v2_tasks_olap.id
+                AND e.task_inserted_at = v2_tasks_olap.inserted_at
+                AND e.error_message ~ sqlc.narg('errorRegex')::text
+        )
+    )
+    AND (
+        sqlc.narg('outputContains')::jsonb IS NULL
+        OR EXISTS (
+            SELECT 1 FROM v2_task_events_olap e
+            WHERE
+                e.task_id = v2_tasks_olap.id
+                AND e.task_inserted_at = v2_tasks_olap.inserted_at
+                AND e.output @> sqlc.narg('outputContains')::jsonb
+        )
+    )
+    AND (
+        sqlc.narg('cursorInsertedAt')::timestamptz IS NULL
+        OR (inserted_at, id) < (sqlc.narg('cursorInsertedAt')::timestamptz, sqlc.narg('cursorId')::bigint)
+    )
 ORDER BY
-    inserted_at DESC
+    inserted_at DESC, id DESC
 LIMIT @taskLimit::integer
 OFFSET @taskOffset::integer;
 
@@ -68,6 +110,44 @@ WITH filtered AS (
                 ) AS u ON kv.key = u.k AND kv.value = u.v
             )
         )
+        AND (
+            sqlc.narg('displayName')::text IS NULL
+            OR display_name ILIKE '%' || sqlc.narg('displayName')::text || '%'
+        )
+        AND (
+            sqlc.narg('inputContains')::jsonb IS NULL
+            OR input @> sqlc.narg('inputContains')::jsonb
+        )
+        AND (
+            sqlc.narg('errorContains')::text IS NULL
+            OR EXISTS (
+                SELECT 1 FROM v2_task_events_olap e
+                WHERE
+                    e.task_id = v2_tasks_olap.id
+                    AND e.task_inserted_at = v2_tasks_olap.inserted_at
+                    AND e.error_message ILIKE '%' || sqlc.narg('errorContains')::text || '%'
+            )
+        )
+        AND (
+            sqlc.narg('errorRegex')::text IS NULL
+            OR EXISTS (
+                SELECT 1 FROM v2_task_events_olap e
+                WHERE
+                    e.task_id = v2_tasks_olap.id
+                    AND e.task_inserted_at = v2_tasks_olap.inserted_at
+                    AND e.error_message ~ sqlc.narg('errorRegex')::text
+            )
+        )
+        AND (
+            sqlc.narg('outputContains')::jsonb IS NULL
+            OR EXISTS (
+                SELECT 1 FROM v2_task_events_olap e
+                WHERE
+                    e.task_id = v2_tasks_olap.id
+                    AND e.task_inserted_at = v2_tasks_olap.inserted_at
+                    AND e.output @> sqlc.narg('outputContains')::jsonb
+            )
+        )
     ORDER BY
         inserted_at DESC
     LIMIT 20000
@@ -103,6 +183,10 @@ WHERE
             ) AS u ON kv.key = u.k AND kv.value = u.v
         )
     )
+    AND (
+        sqlc.narg('cursorInsertedAt')::timestamptz IS NULL
+        OR (inserted_at, id) < (sqlc.narg('cursorInsertedAt')::timestamptz, sqlc.narg('cursorId')::bigint)
+    )
 ORDER BY inserted_at DESC, id DESC
 LIMIT @listWorkflowRunsLimit::integer
 OFFSET @listWorkflowRunsOffset::integer
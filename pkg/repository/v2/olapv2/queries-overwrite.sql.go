@@ -37,6 +37,44 @@ WITH filtered AS (
                 ) AS u ON kv.key = u.k AND kv.value = u.v
             )
         )
+        AND (
+            $9::text IS NULL
+            OR display_name ILIKE '%' || $9::text || '%'
+        )
+        AND (
+            $10::jsonb IS NULL
+            OR input @> $10::jsonb
+        )
+        AND (
+            $11::text IS NULL
+            OR EXISTS (
+                SELECT 1 FROM v2_task_events_olap e
+                WHERE
+                    e.task_id = v2_tasks_olap.id
+                    AND e.task_inserted_at = v2_tasks_olap.inserted_at
+                    AND e.error_message ILIKE '%' || $11::text || '%'
+            )
+        )
+        AND (
+            $12::text IS NULL
+            OR EXISTS (
+                SELECT 1 FROM v2_task_events_olap e
+                WHERE
+                    e.task_id = v2_tasks_olap.id
+                    AND e.task_inserted_at = v2_tasks_olap.inserted_at
+                    AND e.error_message ~ $12::text
+            )
+        )
+        AND (
+            $13::jsonb IS NULL
+            OR EXISTS (
+                SELECT 1 FROM v2_task_events_olap e
+                WHERE
+                    e.task_id = v2_tasks_olap.id
+                    AND e.task_inserted_at = v2_tasks_olap.inserted_at
+                    AND e.output @> $13::jsonb
+            )
+        )
     ORDER BY
         inserted_at DESC
     LIMIT 20000
@@ -47,14 +85,19 @@ FROM filtered
 `
 
 type CountTasksParams struct {
-	Tenantid    pgtype.UUID        `json:"tenantid"`
-	Since       pgtype.Timestamptz `json:"since"`
-	Statuses    []string           `json:"statuses"`
-	Until       pgtype.Timestamptz `json:"until"`
-	WorkflowIds []pgtype.UUID      `json:"workflowIds"`
-	WorkerId    pgtype.UUID        `json:"workerId"`
-	Keys        []string           `json:"keys"`
-	Values      []string           `json:"values"`
+	Tenantid       pgtype.UUID        `json:"tenantid"`
+	Since          pgtype.Timestamptz `json:"since"`
+	Statuses       []string           `json:"statuses"`
+	Until          pgtype.Timestamptz `json:"until"`
+	WorkflowIds    []pgtype.UUID      `json:"workflowIds"`
+	WorkerId       pgtype.UUID        `json:"workerId"`
+	Keys           []string           `json:"keys"`
+	Values         []string           `json:"values"`
+	DisplayName    pgtype.Text        `json:"displayName"`
+	InputContains  []byte             `json:"inputContains"`
+	ErrorContains  pgtype.Text        `json:"errorContains"`
+	ErrorRegex     pgtype.Text        `json:"errorRegex"`
+	OutputContains []byte             `json:"outputContains"`
 }
 
 func (q *Queries) CountTasks(ctx context.Context, db DBTX, arg CountTasksParams) (int64, error) {
@@ -67,6 +110,11 @@ func (q *Queries) CountTasks(ctx context.Context, db DBTX, arg CountTasksParams)
 		arg.WorkerId,
 		arg.Keys,
 		arg.Values,
+		arg.DisplayName,
+		arg.InputContains,
+		arg.ErrorContains,
+		arg.ErrorRegex,
+		arg.OutputContains,
 	)
 	var count int64
 	err := row.Scan(&count)
@@ -158,9 +206,13 @@ WHERE
             ) AS u ON kv.key = u.k AND kv.value = u.v
         )
     )
+    AND (
+        $8::timestamptz IS NULL
+        OR (inserted_at, id) < ($8::timestamptz, $9::bigint)
+    )
 ORDER BY inserted_at DESC, id DESC
-LIMIT $9::integer
-OFFSET $8::integer
+LIMIT $11::integer
+OFFSET $10::integer
 `
 
 type FetchWorkflowRunIdsParams struct {
@@ -171,6 +223,8 @@ type FetchWorkflowRunIdsParams struct {
 	Until                  pgtype.Timestamptz `json:"until"`
 	Keys                   []string           `json:"keys"`
 	Values                 []string           `json:"values"`
+	CursorInsertedAt       pgtype.Timestamptz `json:"cursorInsertedAt"`
+	CursorId               pgtype.Int8        `json:"cursorId"`
 	Listworkflowrunsoffset int32              `json:"listworkflowrunsoffset"`
 	Listworkflowrunslimit  int32              `json:"listworkflowrunslimit"`
 }
@@ -191,6 +245,8 @@ func (q *Queries) FetchWorkflowRunIds(ctx context.Context, db DBTX, arg FetchWor
 		arg.Until,
 		arg.Keys,
 		arg.Values,
+		arg.CursorInsertedAt,
+		arg.CursorId,
 		arg.Listworkflowrunsoffset,
 		arg.Listworkflowrunslimit,
 	)
@@ -248,23 +304,72 @@ WHERE
             ) AS u ON kv.key = u.k AND kv.value = u.v
         )
     )
+    AND (
+        $9::text IS NULL
+        OR display_name ILIKE '%' || $9::text || '%'
+    )
+    AND (
+        $10::jsonb IS NULL
+        OR input @> $10::jsonb
+    )
+    AND (
+        $11::text IS NULL
+        OR EXISTS (
+            SELECT 1 FROM v2_task_events_olap e
+            WHERE
+                e.task_id = v2_tasks_olap.id
+                AND e.task_inserted_at = v2_tasks_olap.inserted_at
+                AND e.error_message ILIKE '%' || $11::text || '%'
+        )
+    )
+    AND (
+        $12::text IS NULL
+        OR EXISTS (
+            SELECT 1 FROM v2_task_events_olap e
+            WHERE
+                e.task_id = v2_tasks_olap.id
+                AND e.task_inserted_at = v2_tasks_olap.inserted_at
+                AND e.error_message ~ $12::text
+        )
+    )
+    AND (
+        $13::jsonb IS NULL
+        OR EXISTS (
+            SELECT 1 FROM v2_task_events_olap e
+            WHERE
+                e.task_id = v2_tasks_olap.id
+                AND e.task_inserted_at = v2_tasks_olap.inserted_at
+                AND e.output @> $13::jsonb
+        )
+    )
+    AND (
+        $14::timestamptz IS NULL
+        OR (inserted_at, id) < ($14::timestamptz, $15::bigint)
+    )
 ORDER BY
-    inserted_at DESC
-LIMIT $10::integer
-OFFSET $9::integer
+    inserted_at DESC, id DESC
+LIMIT $17::integer
+OFFSET $16::integer
 `
 
 type ListTasksParams struct {
-	Tenantid    pgtype.UUID        `json:"tenantid"`
-	Since       pgtype.Timestamptz `json:"since"`
-	Statuses    []string           `json:"statuses"`
-	Until       pgtype.Timestamptz `json:"until"`
-	WorkflowIds []pgtype.UUID      `json:"workflowIds"`
-	WorkerId    pgtype.UUID        `json:"workerId"`
-	Keys        []string           `json:"keys"`
-	Values      []string           `json:"values"`
-	Taskoffset  int32              `json:"taskoffset"`
-	Tasklimit   int32              `json:"tasklimit"`
+	Tenantid         pgtype.UUID        `json:"tenantid"`
+	Since            pgtype.Timestamptz `json:"since"`
+	Statuses         []string           `json:"statuses"`
+	Until            pgtype.Timestamptz `json:"until"`
+	WorkflowIds      []pgtype.UUID      `json:"workflowIds"`
+	WorkerId         pgtype.UUID        `json:"workerId"`
+	Keys             []string           `json:"keys"`
+	Values           []string           `json:"values"`
+	DisplayName      pgtype.Text        `json:"displayName"`
+	InputContains    []byte             `json:"inputContains"`
+	ErrorContains    pgtype.Text        `json:"errorContains"`
+	ErrorRegex       pgtype.Text        `json:"errorRegex"`
+	OutputContains   []byte             `json:"outputContains"`
+	CursorInsertedAt pgtype.Timestamptz `json:"cursorInsertedAt"`
+	CursorId         pgtype.Int8        `json:"cursorId"`
+	Taskoffset       int32              `json:"taskoffset"`
+	Tasklimit        int32              `json:"tasklimit"`
 }
 
 type ListTasksRow struct {
@@ -282,6 +387,13 @@ func (q *Queries) ListTasks(ctx context.Context, db DBTX, arg ListTasksParams) (
 		arg.WorkerId,
 		arg.Keys,
 		arg.Values,
+		arg.DisplayName,
+		arg.InputContains,
+		arg.ErrorContains,
+		arg.ErrorRegex,
+		arg.OutputContains,
+		arg.CursorInsertedAt,
+		arg.CursorId,
 		arg.Taskoffset,
 		arg.Tasklimit,
 	)
@@ -204,6 +204,22 @@ WHERE
     external_id = ANY(@externalIds::uuid[])
     AND tenant_id = @tenantId::uuid;
 
+-- name: ListWorkflowRunsByExternalIds :many
+SELECT
+    r.id,
+    r.inserted_at,
+    r.kind,
+    r.external_id
+FROM
+    v2_lookup_table lt
+JOIN
+    v2_runs_olap r ON (r.inserted_at, r.id) = (lt.inserted_at, COALESCE(lt.dag_id, lt.task_id))
+WHERE
+    lt.external_id = ANY(@externalIds::uuid[])
+    AND lt.tenant_id = @tenantId::uuid
+    AND r.tenant_id = lt.tenant_id
+    AND r.external_id = lt.external_id;
+
 -- name: ListTasksByDAGIds :many
 SELECT
     dt.*,
@@ -635,7 +651,16 @@ WITH locked_events AS (
                 )
             )
     RETURNING
-        t.tenant_id, t.id, t.inserted_at
+        t.tenant_id, t.id, t.inserted_at, t.readable_status, t.external_id, t.workflow_id, t.dag_id
+), finished_tasks AS (
+    -- Tasks which aren't part of a DAG and moved into a final status with this update
+    SELECT
+        *
+    FROM
+        updated_tasks
+    WHERE
+        dag_id IS NULL
+        AND readable_status IN ('COMPLETED', 'FAILED', 'CANCELLED')
 ), events_to_requeue AS (
     -- Get events which don't have a corresponding locked_task
     SELECT
@@ -687,9 +712,12 @@ WITH locked_events AS (
         *
 )
 SELECT
-    COUNT(*)
+    (SELECT COUNT(*) FROM locked_events) AS count,
+    COALESCE(ARRAY_AGG(ft.external_id), '{}')::uuid[] AS finished_task_external_ids,
+    COALESCE(ARRAY_AGG(ft.workflow_id), '{}')::uuid[] AS finished_task_workflow_ids,
+    COALESCE(ARRAY_AGG(ft.readable_status::text), '{}')::text[] AS finished_task_statuses
 FROM
-    locked_events;
+    finished_tasks ft;
 
 -- name: UpdateDAGStatuses :one
 WITH locked_events AS (
@@ -761,6 +789,19 @@ WITH locked_events AS (
         dag_task_counts dtc
     WHERE
         (d.id, d.inserted_at) = (dtc.id, dtc.inserted_at)
+    RETURNING
+        d.id, d.inserted_at, d.readable_status, d.external_id, d.workflow_id
+), finished_dags AS (
+    -- DAGs which moved into a final status with this update
+    SELECT
+        ud.*
+    FROM
+        updated_dags ud
+    JOIN
+        locked_dags ld ON (ud.id, ud.inserted_at) = (ld.id, ld.inserted_at)
+    WHERE
+        ud.readable_status IN ('COMPLETED', 'FAILED', 'CANCELLED')
+        AND ud.readable_status != ld.readable_status
 ), events_to_requeue AS (
     -- Get events which don't have a corresponding locked_task
     SELECT
@@ -803,9 +844,12 @@ WITH locked_events AS (
         *
 )
 SELECT
-    COUNT(*)
+    (SELECT COUNT(*) FROM locked_events) AS count,
+    COALESCE(ARRAY_AGG(fd.external_id), '{}')::uuid[] AS finished_dag_external_ids,
+    COALESCE(ARRAY_AGG(fd.workflow_id), '{}')::uuid[] AS finished_dag_workflow_ids,
+    COALESCE(ARRAY_AGG(fd.readable_status::text), '{}')::text[] AS finished_dag_statuses
 FROM
-    locked_events;
+    finished_dags fd;
 
 -- name: PopulateDAGMetadata :many
 WITH input AS (
@@ -676,6 +676,60 @@ func (q *Queries) ListTasksByExternalIds(ctx context.Context, db DBTX, arg ListT
 	return items, nil
 }
 
+const listWorkflowRunsByExternalIds = `-- name: ListWorkflowRunsByExternalIds :many
+SELECT
+    r.id,
+    r.inserted_at,
+    r.kind,
+    r.external_id
+FROM
+    v2_lookup_table lt
+JOIN
+    v2_runs_olap r ON (r.inserted_at, r.id) = (lt.inserted_at, COALESCE(lt.dag_id, lt.task_id))
+WHERE
+    lt.external_id = ANY($1::uuid[])
+    AND lt.tenant_id = $2::uuid
+    AND r.tenant_id = lt.tenant_id
+    AND r.external_id = lt.external_id
+`
+
+type ListWorkflowRunsByExternalIdsParams struct {
+	Externalids []pgtype.UUID `json:"externalids"`
+	Tenantid    pgtype.UUID   `json:"tenantid"`
+}
+
+type ListWorkflowRunsByExternalIdsRow struct {
+	ID         int64              `json:"id"`
+	InsertedAt pgtype.Timestamptz `json:"inserted_at"`
+	Kind       V2RunKind          `json:"kind"`
+	ExternalID pgtype.UUID        `json:"external_id"`
+}
+
+func (q *Queries) ListWorkflowRunsByExternalIds(ctx context.Context, db DBTX, arg ListWorkflowRunsByExternalIdsParams) ([]*ListWorkflowRunsByExternalIdsRow, error) {
+	rows, err := db.Query(ctx, listWorkflowRunsByExternalIds, arg.Externalids, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowRunsByExternalIdsRow
+	for rows.Next() {
+		var i ListWorkflowRunsByExternalIdsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.InsertedAt,
+			&i.Kind,
+			&i.ExternalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const populateDAGMetadata = `-- name: PopulateDAGMetadata :many
 WITH input AS (
     SELECT
@@ -1446,6 +1500,19 @@ WITH locked_events AS (
         dag_task_counts dtc
     WHERE
         (d.id, d.inserted_at) = (dtc.id, dtc.inserted_at)
+    RETURNING
+        d.id, d.inserted_at, d.readable_status, d.external_id, d.workflow_id
+), finished_dags AS (
+    -- DAGs which moved into a final status with this update
+    SELECT
+        ud.id, ud.inserted_at, ud.readable_status, ud.external_id, ud.workflow_id
+    FROM
+        updated_dags ud
+    JOIN
+        locked_dags ld ON (ud.id, ud.inserted_at) = (ld.id, ld.inserted_at)
+    WHERE
+        ud.readable_status IN ('COMPLETED', 'FAILED', 'CANCELLED')
+        AND ud.readable_status != ld.readable_status
 ), events_to_requeue AS (
     -- Get events which don't have a corresponding locked_task
     SELECT
@@ -1488,9 +1555,12 @@ WITH locked_events AS (
         tenant_id, requeue_after, requeue_retries, id, dag_id, dag_inserted_at
 )
 SELECT
-    COUNT(*)
+    (SELECT COUNT(*) FROM locked_events) AS count,
+    COALESCE(ARRAY_AGG(fd.external_id), '{}')::uuid[] AS finished_dag_external_ids,
+    COALESCE(ARRAY_AGG(fd.workflow_id), '{}')::uuid[] AS finished_dag_workflow_ids,
+    COALESCE(ARRAY_AGG(fd.readable_status::text), '{}')::text[] AS finished_dag_statuses
 FROM
-    locked_events
+    finished_dags fd
 `
 
 type UpdateDAGStatusesParams struct {
@@ -1499,11 +1569,23 @@ type UpdateDAGStatusesParams struct {
 	Eventlimit      int32       `json:"eventlimit"`
 }
 
-func (q *Queries) UpdateDAGStatuses(ctx context.Context, db DBTX, arg UpdateDAGStatusesParams) (int64, error) {
+type UpdateDAGStatusesRow struct {
+	Count                  int64         `json:"count"`
+	FinishedDagExternalIds []pgtype.UUID `json:"finished_dag_external_ids"`
+	FinishedDagWorkflowIds []pgtype.UUID `json:"finished_dag_workflow_ids"`
+	FinishedDagStatuses    []string      `json:"finished_dag_statuses"`
+}
+
+func (q *Queries) UpdateDAGStatuses(ctx context.Context, db DBTX, arg UpdateDAGStatusesParams) (*UpdateDAGStatusesRow, error) {
 	row := db.QueryRow(ctx, updateDAGStatuses, arg.Partitionnumber, arg.Tenantid, arg.Eventlimit)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
+	var i UpdateDAGStatusesRow
+	err := row.Scan(
+		&i.Count,
+		&i.FinishedDagExternalIds,
+		&i.FinishedDagWorkflowIds,
+		&i.FinishedDagStatuses,
+	)
+	return &i, err
 }
 
 const updateTaskStatuses = `-- name: UpdateTaskStatuses :one
@@ -1599,7 +1681,16 @@ WITH locked_events AS (
                 )
             )
     RETURNING
-        t.tenant_id, t.id, t.inserted_at
+        t.tenant_id, t.id, t.inserted_at, t.readable_status, t.external_id, t.workflow_id, t.dag_id
+), finished_tasks AS (
+    -- Tasks which aren't part of a DAG and moved into a final status with this update
+    SELECT
+        tenant_id, id, inserted_at, readable_status, external_id, workflow_id, dag_id
+    FROM
+        updated_tasks
+    WHERE
+        dag_id IS NULL
+        AND readable_status IN ('COMPLETED', 'FAILED', 'CANCELLED')
 ), events_to_requeue AS (
     -- Get events which don't have a corresponding locked_task
     SELECT
@@ -1651,9 +1742,12 @@ WITH locked_events AS (
         tenant_id, requeue_after, requeue_retries, id, task_id, task_inserted_at, event_type, readable_status, retry_count, worker_id
 )
 SELECT
-    COUNT(*)
+    (SELECT COUNT(*) FROM locked_events) AS count,
+    COALESCE(ARRAY_AGG(ft.external_id), '{}')::uuid[] AS finished_task_external_ids,
+    COALESCE(ARRAY_AGG(ft.workflow_id), '{}')::uuid[] AS finished_task_workflow_ids,
+    COALESCE(ARRAY_AGG(ft.readable_status::text), '{}')::text[] AS finished_task_statuses
 FROM
-    locked_events
+    finished_tasks ft
 `
 
 type UpdateTaskStatusesParams struct {
@@ -1662,9 +1756,21 @@ type UpdateTaskStatusesParams struct {
 	Eventlimit      int32       `json:"eventlimit"`
 }
 
-func (q *Queries) UpdateTaskStatuses(ctx context.Context, db DBTX, arg UpdateTaskStatusesParams) (int64, error) {
+type UpdateTaskStatusesRow struct {
+	Count                   int64         `json:"count"`
+	FinishedTaskExternalIds []pgtype.UUID `json:"finished_task_external_ids"`
+	FinishedTaskWorkflowIds []pgtype.UUID `json:"finished_task_workflow_ids"`
+	FinishedTaskStatuses    []string      `json:"finished_task_statuses"`
+}
+
+func (q *Queries) UpdateTaskStatuses(ctx context.Context, db DBTX, arg UpdateTaskStatusesParams) (*UpdateTaskStatusesRow, error) {
 	row := db.QueryRow(ctx, updateTaskStatuses, arg.Partitionnumber, arg.Tenantid, arg.Eventlimit)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
+	var i UpdateTaskStatusesRow
+	err := row.Scan(
+		&i.Count,
+		&i.FinishedTaskExternalIds,
+		&i.FinishedTaskWorkflowIds,
+		&i.FinishedTaskStatuses,
+	)
+	return &i, err
 }
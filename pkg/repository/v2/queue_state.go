@@ -0,0 +1,121 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+// TaskQueueState is the state of a task's latest retry in the queues which lead up to it being assigned
+// to a worker.
+type TaskQueueState struct {
+	Task *sqlcv2.V2Task
+
+	// set if the task is waiting to be assigned to a worker
+	QueueItem *sqlcv2.V2QueueItem
+
+	// the concurrency slots which the task is waiting for or holds
+	ConcurrencySlots []*sqlcv2.ListConcurrencySlotsForTaskRow
+
+	// set if the task failed and is waiting to be retried
+	RetryQueueItem *sqlcv2.V2RetryQueueItem
+
+	// set if the task has been assigned to a worker
+	Runtime *sqlcv2.V2TaskRuntime
+
+	RateLimits []*sqlcv2.V2TaskRateLimit
+
+	DesiredLabels []*sqlcv2.GetDesiredLabelsRow
+}
+
+func (r *TaskRepositoryImpl) GetTaskQueueState(ctx context.Context, tenantId string, taskId int64, taskInsertedAt pgtype.Timestamptz) (*TaskQueueState, error) {
+	tenantIdPg := sqlchelpers.UUIDFromStr(tenantId)
+
+	tasks, err := r.listTasks(ctx, r.pool, tenantId, []int64{taskId})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list tasks: %w", err)
+	}
+
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("task %d not found", taskId)
+	}
+
+	res := &TaskQueueState{
+		Task: tasks[0],
+	}
+
+	queueItems, err := r.queries.ListQueueItemsForTask(ctx, r.pool, sqlcv2.ListQueueItemsForTaskParams{
+		Tenantid:   tenantIdPg,
+		Taskid:     taskId,
+		Retrycount: res.Task.RetryCount,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list queue items: %w", err)
+	}
+
+	if len(queueItems) > 0 {
+		res.QueueItem = queueItems[0]
+	}
+
+	res.ConcurrencySlots, err = r.queries.ListConcurrencySlotsForTask(ctx, r.pool, sqlcv2.ListConcurrencySlotsForTaskParams{
+		Tenantid:       tenantIdPg,
+		Taskid:         taskId,
+		Taskinsertedat: taskInsertedAt,
+		Retrycount:     res.Task.RetryCount,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list concurrency slots: %w", err)
+	}
+
+	retryQueueItems, err := r.queries.ListRetryQueueItemsForTask(ctx, r.pool, sqlcv2.ListRetryQueueItemsForTaskParams{
+		Tenantid:       tenantIdPg,
+		Taskid:         taskId,
+		Taskinsertedat: taskInsertedAt,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list retry queue items: %w", err)
+	}
+
+	if len(retryQueueItems) > 0 {
+		res.RetryQueueItem = retryQueueItems[0]
+	}
+
+	runtimes, err := r.queries.ListRuntimesForTask(ctx, r.pool, sqlcv2.ListRuntimesForTaskParams{
+		Tenantid:   tenantIdPg,
+		Taskid:     taskId,
+		Retrycount: res.Task.RetryCount,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list task runtimes: %w", err)
+	}
+
+	if len(runtimes) > 0 {
+		res.Runtime = runtimes[0]
+	}
+
+	res.RateLimits, err = r.queries.ListTaskRateLimits(ctx, r.pool, sqlcv2.ListTaskRateLimitsParams{
+		Tenantid: tenantIdPg,
+		Taskids:  []int64{taskId},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list task rate limits: %w", err)
+	}
+
+	res.DesiredLabels, err = r.queries.GetDesiredLabels(ctx, r.pool, []pgtype.UUID{res.Task.StepID})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get desired labels: %w", err)
+	}
+
+	return res, nil
+}
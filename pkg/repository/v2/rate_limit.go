@@ -0,0 +1,559 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+// rateLimitDurations are the valid durations of a rate limit window, ordered from shortest to longest.
+var rateLimitDurations = []string{
+	"SECOND",
+	"MINUTE",
+	"HOUR",
+	"DAY",
+	"WEEK",
+	"MONTH",
+	"YEAR",
+}
+
+func rateLimitDurationIndex(duration string) int {
+	for i, d := range rateLimitDurations {
+		if d == duration {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// rateLimitWindow returns the interval of a rate limit window from its duration, for example "1 MINUTE".
+func rateLimitWindow(duration string) string {
+	return fmt.Sprintf("1 %s", duration)
+}
+
+// stepRateLimits are the rate limits of a step.
+type stepRateLimits struct {
+	static []*sqlcv2.StepRateLimit
+
+	// dynamic rate limits keyed by the key which was registered in the workflow definition
+	dynamic map[string]*dynamicRateLimitExprs
+}
+
+// dynamicRateLimitExprs are the CEL expressions of a dynamic rate limit. The limit expression is empty
+// if the rate limit doesn't set a limit, in which case the key must already exist.
+type dynamicRateLimitExprs struct {
+	key    string
+	units  string
+	limit  string
+	window string
+
+	// the version of the CEL function library which the expressions are compiled against
+	celLibraryVersion int32
+}
+
+// taskRateLimit is a rate limit which has been resolved for a task.
+type taskRateLimit struct {
+	key   string
+	units int32
+
+	// the limit value and duration are only set for dynamic rate limits which provision their key
+	limitValue int32
+	duration   string
+}
+
+// getStepRateLimits returns the static and dynamic rate limits of the steps which have rate limits,
+// keyed by the step id.
+func (r *sharedRepository) getStepRateLimits(
+	ctx context.Context,
+	tx sqlcv2.DBTX,
+	tenantId string,
+	stepIdsToConfig map[string]*sqlcv2.ListStepsByIdsRow,
+) (map[string]*stepRateLimits, error) {
+	stepIds := make([]pgtype.UUID, 0)
+
+	for _, step := range stepIdsToConfig {
+		if step.HasRateLimits {
+			stepIds = append(stepIds, step.ID)
+		}
+	}
+
+	if len(stepIds) == 0 {
+		return nil, nil
+	}
+
+	staticRateLimits, err := r.queries.ListRateLimitsForSteps(ctx, tx, sqlcv2.ListRateLimitsForStepsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Stepids:  stepIds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list rate limits for steps: %w", err)
+	}
+
+	expressions, err := r.queries.ListStepExpressions(ctx, tx, stepIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list step expressions: %w", err)
+	}
+
+	res := make(map[string]*stepRateLimits)
+
+	getOrCreate := func(stepId string) *stepRateLimits {
+		if _, ok := res[stepId]; !ok {
+			res[stepId] = &stepRateLimits{
+				dynamic: make(map[string]*dynamicRateLimitExprs),
+			}
+		}
+
+		return res[stepId]
+	}
+
+	for _, rl := range staticRateLimits {
+		limits := getOrCreate(sqlchelpers.UUIDToStr(rl.StepId))
+		limits.static = append(limits.static, rl)
+	}
+
+	for _, expr := range expressions {
+		limits := getOrCreate(sqlchelpers.UUIDToStr(expr.StepId))
+
+		if _, ok := limits.dynamic[expr.Key]; !ok {
+			limits.dynamic[expr.Key] = &dynamicRateLimitExprs{}
+		}
+
+		exprs := limits.dynamic[expr.Key]
+		exprs.celLibraryVersion = expr.CelLibraryVersion
+
+		switch expr.Kind {
+		case sqlcv2.StepExpressionKindDYNAMICRATELIMITKEY:
+			exprs.key = expr.Expression
+		case sqlcv2.StepExpressionKindDYNAMICRATELIMITUNITS:
+			exprs.units = expr.Expression
+		case sqlcv2.StepExpressionKindDYNAMICRATELIMITVALUE:
+			exprs.limit = expr.Expression
+		case sqlcv2.StepExpressionKindDYNAMICRATELIMITWINDOW:
+			exprs.window = expr.Expression
+		}
+	}
+
+	return res, nil
+}
+
+// evaluateRateLimits resolves the rate limit keys and units of a task. If a dynamic rate limit can't be
+// evaluated, an error is returned which should be used to place the task into a failed state.
+func (r *sharedRepository) evaluateRateLimits(
+	task CreateTaskOpts,
+	additionalMetadata []byte,
+	limits *stepRateLimits,
+) ([]taskRateLimit, error) {
+	keyToRateLimit := make(map[string]*taskRateLimit)
+	keys := make([]string, 0)
+
+	add := func(rl taskRateLimit) {
+		if existing, ok := keyToRateLimit[rl.key]; ok {
+			// a task which uses the same key more than once consumes the sum of the units
+			existing.units += rl.units
+
+			if existing.limitValue == 0 {
+				existing.limitValue = rl.limitValue
+				existing.duration = rl.duration
+			}
+
+			return
+		}
+
+		keyToRateLimit[rl.key] = &rl
+		keys = append(keys, rl.key)
+	}
+
+	for _, rl := range limits.static {
+		add(taskRateLimit{
+			key:   rl.RateLimitKey,
+			units: rl.Units,
+		})
+	}
+
+	if len(limits.dynamic) > 0 {
+		var additionalMeta map[string]interface{}
+
+		if len(additionalMetadata) > 0 {
+			if err := json.Unmarshal(additionalMetadata, &additionalMeta); err != nil {
+				return nil, fmt.Errorf("failed to process additional metadata: not a json object")
+			}
+		}
+
+		input := cel.NewInput(
+			cel.WithInput(task.Input.Input),
+			cel.WithAdditionalMetadata(additionalMeta),
+			cel.WithWorkflowRunID(task.ExternalId),
+			cel.WithParents(task.Input.TriggerData),
+		)
+
+		// evaluate the rate limits in a consistent order, so the same error is returned for the same input
+		registeredKeys := make([]string, 0, len(limits.dynamic))
+
+		for k := range limits.dynamic {
+			registeredKeys = append(registeredKeys, k)
+		}
+
+		sort.Strings(registeredKeys)
+
+		for _, k := range registeredKeys {
+			rl, err := r.evaluateDynamicRateLimit(limits.dynamic[k], input)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to evaluate rate limit %s: %w", k, err)
+			}
+
+			add(*rl)
+		}
+	}
+
+	res := make([]taskRateLimit, 0, len(keys))
+
+	for _, k := range keys {
+		res = append(res, *keyToRateLimit[k])
+	}
+
+	return res, nil
+}
+
+func (r *sharedRepository) evaluateDynamicRateLimit(exprs *dynamicRateLimitExprs, input cel.Input) (*taskRateLimit, error) {
+	parser, err := r.celParser.AtVersion(uint32(exprs.celLibraryVersion)) // nolint: gosec
+
+	if err != nil {
+		return nil, err
+	}
+
+	eval := func(expr string, kind dbsqlc.StepExpressionKind) (*cel.StepRunOut, error) {
+		out, err := parser.ParseAndEvalStepRun(expr, input)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse step expression (%s): %w", expr, err)
+		}
+
+		if err := parser.CheckStepRunOutAgainstKnown(out, kind); err != nil {
+			return nil, fmt.Errorf("failed to parse step expression (%s): %w", expr, err)
+		}
+
+		return out, nil
+	}
+
+	keyOut, err := eval(exprs.key, dbsqlc.StepExpressionKindDYNAMICRATELIMITKEY)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if *keyOut.String == "" {
+		return nil, fmt.Errorf("rate limit key must not be empty")
+	}
+
+	unitsOut, err := eval(exprs.units, dbsqlc.StepExpressionKindDYNAMICRATELIMITUNITS)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if *unitsOut.Int < 0 {
+		return nil, fmt.Errorf("rate limit units must not be negative, got %d", *unitsOut.Int)
+	}
+
+	res := &taskRateLimit{
+		key:   *keyOut.String,
+		units: int32(*unitsOut.Int), // nolint: gosec
+	}
+
+	// without a limit, the key is not provisioned and must be created through the API
+	if exprs.limit == "" {
+		return res, nil
+	}
+
+	limitOut, err := eval(exprs.limit, dbsqlc.StepExpressionKindDYNAMICRATELIMITVALUE)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if *limitOut.Int < 1 {
+		return nil, fmt.Errorf("rate limit value must be at least 1, got %d", *limitOut.Int)
+	}
+
+	res.limitValue = int32(*limitOut.Int) // nolint: gosec
+	res.duration = "MINUTE"
+
+	if exprs.window != "" {
+		windowOut, err := eval(exprs.window, dbsqlc.StepExpressionKindDYNAMICRATELIMITWINDOW)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if rateLimitDurationIndex(*windowOut.String) == -1 {
+			return nil, fmt.Errorf("invalid rate limit duration %s", *windowOut.String)
+		}
+
+		res.duration = *windowOut.String
+	}
+
+	return res, nil
+}
+
+// createTaskRateLimits stores the resolved rate limits of newly created tasks, keyed by the external id
+// of the task.
+func (r *sharedRepository) createTaskRateLimits(
+	ctx context.Context,
+	tx sqlcv2.DBTX,
+	tenantId string,
+	tasks []*sqlcv2.V2Task,
+	externalIdsToRateLimits map[string][]taskRateLimit,
+) error {
+	params := sqlcv2.CreateTaskRateLimitsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	for _, task := range tasks {
+		rls, ok := externalIdsToRateLimits[sqlchelpers.UUIDToStr(task.ExternalID)]
+
+		if !ok {
+			continue
+		}
+
+		for _, rl := range rls {
+			params.Taskids = append(params.Taskids, task.ID)
+			params.Taskinsertedats = append(params.Taskinsertedats, task.InsertedAt)
+			params.Keys = append(params.Keys, rl.key)
+			params.Units = append(params.Units, rl.units)
+			params.Limitvalues = append(params.Limitvalues, rl.limitValue)
+			params.Limitwindows = append(params.Limitwindows, rl.duration)
+		}
+	}
+
+	if len(params.Taskids) == 0 {
+		return nil
+	}
+
+	return r.queries.CreateTaskRateLimits(ctx, tx, params)
+}
+
+// mergeDynamicRateLimits combines the dynamic rate limits of a set of tasks into a single limit value and
+// window per key. If tasks disagree, the lower limit value and the longer window are used.
+func mergeDynamicRateLimits(rls []*sqlcv2.V2TaskRateLimit) sqlcv2.UpsertDynamicRateLimitsParams {
+	type limit struct {
+		value    int32
+		duration string
+	}
+
+	keyToLimit := make(map[string]*limit)
+
+	for _, rl := range rls {
+		if !rl.LimitValue.Valid || !rl.LimitWindow.Valid {
+			continue
+		}
+
+		existing, ok := keyToLimit[rl.Key]
+
+		if !ok {
+			keyToLimit[rl.Key] = &limit{
+				value:    rl.LimitValue.Int32,
+				duration: rl.LimitWindow.String,
+			}
+
+			continue
+		}
+
+		existing.value = min(existing.value, rl.LimitValue.Int32)
+
+		if rateLimitDurationIndex(rl.LimitWindow.String) > rateLimitDurationIndex(existing.duration) {
+			existing.duration = rl.LimitWindow.String
+		}
+	}
+
+	keys := make([]string, 0, len(keyToLimit))
+
+	for k := range keyToLimit {
+		keys = append(keys, k)
+	}
+
+	// sort the keys so that concurrent upserts lock rows in the same order
+	sort.Strings(keys)
+
+	params := sqlcv2.UpsertDynamicRateLimitsParams{
+		Keys:        keys,
+		Limitvalues: make([]int32, len(keys)),
+		Windows:     make([]string, len(keys)),
+	}
+
+	for i, k := range keys {
+		params.Limitvalues[i] = keyToLimit[k].value
+		params.Windows[i] = rateLimitWindow(keyToLimit[k].duration)
+	}
+
+	return params
+}
+
+// RateLimitState is the state of a rate limit as of its last refill. The scheduler uses it to refill
+// rate limits in memory between writes to the database, using the same algorithm as refill_rate_limit.
+type RateLimitState struct {
+	Algorithm sqlcv2.RateLimitAlgorithm
+
+	// the number of units which are added per window
+	LimitValue int
+
+	// the maximum number of units in a token bucket, which defaults to the limit value
+	Burst int
+
+	Window time.Duration
+
+	// the number of units remaining in the bucket or current window
+	Value int
+
+	// the number of units used in the previous window of a sliding window rate limit
+	PreviousUsage int
+
+	LastRefill time.Time
+}
+
+func newRateLimitState(rl *sqlcv2.RefillAndUseRateLimitsRow) *RateLimitState {
+	s := &RateLimitState{
+		Algorithm:     rl.Algorithm,
+		LimitValue:    int(rl.LimitValue),
+		Burst:         int(rl.LimitValue),
+		Window:        time.Duration(rl.WindowSeconds * float64(time.Second)),
+		Value:         int(rl.Value),
+		PreviousUsage: int(rl.PreviousUsage),
+		LastRefill:    rl.LastRefill.Time,
+	}
+
+	if rl.Burst.Valid {
+		s.Burst = int(rl.Burst.Int32)
+	}
+
+	return s
+}
+
+// Refill returns the state of the rate limit after refilling it at the given time.
+func (s RateLimitState) Refill(now time.Time) RateLimitState {
+	elapsed := now.Sub(s.LastRefill)
+
+	if s.Window <= 0 || elapsed < 0 {
+		return s
+	}
+
+	switch s.Algorithm {
+	case sqlcv2.RateLimitAlgorithmTOKENBUCKET:
+		tokens := int64(elapsed.Seconds() * float64(s.LimitValue) / s.Window.Seconds())
+
+		if tokens <= 0 {
+			break
+		}
+
+		if int64(s.Value)+tokens >= int64(s.Burst) {
+			s.Value = s.Burst
+			s.LastRefill = now
+		} else {
+			s.Value += int(tokens)
+			s.LastRefill = s.LastRefill.Add(time.Duration(float64(tokens) * float64(s.Window) / float64(s.LimitValue)))
+		}
+	case sqlcv2.RateLimitAlgorithmSLIDINGWINDOW:
+		windows := int64(elapsed / s.Window)
+
+		if windows == 1 {
+			s.PreviousUsage = max(s.LimitValue-s.Value, 0)
+		} else if windows > 1 {
+			s.PreviousUsage = 0
+		}
+
+		if windows > 0 {
+			s.Value = s.LimitValue
+			s.LastRefill = s.LastRefill.Add(time.Duration(windows) * s.Window)
+		}
+	default:
+		if elapsed >= s.Window {
+			s.Value = s.LimitValue
+			s.LastRefill = now
+		}
+	}
+
+	return s
+}
+
+// Available returns the number of units which can be used at the given time.
+func (s RateLimitState) Available(now time.Time) int {
+	s = s.Refill(now)
+
+	if s.Algorithm != sqlcv2.RateLimitAlgorithmSLIDINGWINDOW {
+		return s.Value
+	}
+
+	weight := max(1-now.Sub(s.LastRefill).Seconds()/s.Window.Seconds(), 0)
+
+	return s.Value - int(math.Ceil(float64(s.PreviousUsage)*weight))
+}
+
+// NextRefill returns the time at which units are next added to the rate limit, after refilling it at the
+// given time. It returns false if the rate limit is a full token bucket, which isn't refilled until units
+// are used.
+func (s RateLimitState) NextRefill(now time.Time) (time.Time, bool) {
+	s = s.Refill(now)
+
+	if s.Algorithm != sqlcv2.RateLimitAlgorithmTOKENBUCKET {
+		return s.LastRefill.Add(s.Window), true
+	}
+
+	if s.Value >= s.Burst || s.LimitValue <= 0 {
+		return time.Time{}, false
+	}
+
+	return s.LastRefill.Add(s.Window / time.Duration(s.LimitValue)), true
+}
+
+type ListRateLimitUsageOpts struct {
+	// (optional) a substring of the key to filter by
+	Search *string
+
+	// (optional) the keys to filter by
+	Keys []string
+
+	// (optional) number of rate limits to skip
+	Offset *int `validate:"omitnil,min=0"`
+
+	// (optional) number of rate limits to return
+	Limit *int `validate:"omitnil,min=1,max=1000"`
+}
+
+type RateLimitUsage struct {
+	Key string
+
+	// the window of the rate limit, as it's stored in the database (for example, "1 minute")
+	Window string
+
+	// whether the rate limit was created from a dynamic rate limit key
+	Dynamic bool
+
+	LastUsedAt time.Time
+
+	// the number of units which could be used when the rate limit was read
+	Available int
+
+	State *RateLimitState
+
+	// the next time that units are added to the rate limit, if it isn't full
+	NextRefillAt *time.Time
+}
+
+type ListRateLimitUsageResult struct {
+	Rows []*RateLimitUsage
+
+	Count int
+}
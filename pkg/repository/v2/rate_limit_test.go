@@ -0,0 +1,198 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+func TestEvaluateRateLimits(t *testing.T) {
+	r := &sharedRepository{
+		celParser: cel.NewCELParser(),
+	}
+
+	task := CreateTaskOpts{
+		ExternalId: "3f6b4c1e-7a3d-4d0c-9f7e-2b1a5c8d9e0f",
+		Input: &TaskInput{
+			Input: map[string]interface{}{
+				"customer_id": "acme",
+				"tier":        "pro",
+			},
+		},
+	}
+
+	limits := &stepRateLimits{
+		static: []*sqlcv2.StepRateLimit{
+			{RateLimitKey: "global", Units: 1},
+		},
+		dynamic: map[string]*dynamicRateLimitExprs{
+			"per-customer": {
+				key:    `"customer-" + input.customer_id`,
+				units:  `input.tier == "pro" ? 2 : 1`,
+				limit:  `10`,
+				window: `"HOUR"`,
+			},
+			"existing": {
+				key:   `"existing"`,
+				units: `3`,
+			},
+		},
+	}
+
+	rls, err := r.evaluateRateLimits(task, []byte(`{"source": "test"}`), limits)
+	require.NoError(t, err)
+
+	assert.Equal(t, []taskRateLimit{
+		{key: "global", units: 1},
+		{key: "existing", units: 3},
+		{key: "customer-acme", units: 2, limitValue: 10, duration: "HOUR"},
+	}, rls)
+
+	// the window defaults to a minute
+	limits.dynamic["per-customer"].window = ""
+
+	rls, err = r.evaluateRateLimits(task, nil, limits)
+	require.NoError(t, err)
+	assert.Equal(t, "MINUTE", rls[2].duration)
+
+	// invalid outputs fail the task
+	limits.dynamic["per-customer"].window = `"FORTNIGHT"`
+
+	_, err = r.evaluateRateLimits(task, nil, limits)
+	assert.ErrorContains(t, err, "invalid rate limit duration FORTNIGHT")
+
+	limits.dynamic["per-customer"].window = `"HOUR"`
+	limits.dynamic["per-customer"].limit = `0`
+
+	_, err = r.evaluateRateLimits(task, nil, limits)
+	assert.ErrorContains(t, err, "rate limit value must be at least 1")
+
+	limits.dynamic["per-customer"].limit = `10`
+	limits.dynamic["per-customer"].key = `input.missing`
+
+	_, err = r.evaluateRateLimits(task, nil, limits)
+	assert.ErrorContains(t, err, "failed to evaluate rate limit per-customer")
+}
+
+func TestMergeDynamicRateLimits(t *testing.T) {
+	dynamic := func(key string, limit int32, duration string) *sqlcv2.V2TaskRateLimit {
+		return &sqlcv2.V2TaskRateLimit{
+			Key:         key,
+			Units:       1,
+			LimitValue:  pgtype.Int4{Int32: limit, Valid: true},
+			LimitWindow: pgtype.Text{String: duration, Valid: true},
+		}
+	}
+
+	params := mergeDynamicRateLimits([]*sqlcv2.V2TaskRateLimit{
+		dynamic("b", 10, "MINUTE"),
+		dynamic("a", 5, "SECOND"),
+		dynamic("b", 20, "HOUR"),
+		{Key: "static", Units: 1},
+	})
+
+	assert.Equal(t, []string{"a", "b"}, params.Keys)
+	assert.Equal(t, []int32{5, 10}, params.Limitvalues)
+	assert.Equal(t, []string{"1 SECOND", "1 HOUR"}, params.Windows)
+}
+
+func TestRateLimitStateRefill(t *testing.T) {
+	start := time.Date(2025, 3, 29, 12, 0, 0, 0, time.UTC)
+
+	t.Run("fixed window", func(t *testing.T) {
+		s := RateLimitState{
+			Algorithm:  sqlcv2.RateLimitAlgorithmFIXEDWINDOW,
+			LimitValue: 10,
+			Burst:      10,
+			Window:     time.Minute,
+			Value:      2,
+			LastRefill: start,
+		}
+
+		assert.Equal(t, 2, s.Available(start.Add(59*time.Second)))
+		assert.Equal(t, 10, s.Available(start.Add(time.Minute)))
+	})
+
+	t.Run("token bucket", func(t *testing.T) {
+		s := RateLimitState{
+			Algorithm:  sqlcv2.RateLimitAlgorithmTOKENBUCKET,
+			LimitValue: 60,
+			Burst:      100,
+			Window:     time.Minute,
+			Value:      0,
+			LastRefill: start,
+		}
+
+		// one token is added every second, and partial tokens carry over to the next refill
+		refilled := s.Refill(start.Add(2500 * time.Millisecond))
+		assert.Equal(t, 2, refilled.Value)
+		assert.Equal(t, start.Add(2*time.Second), refilled.LastRefill)
+
+		refilled = refilled.Refill(start.Add(3 * time.Second))
+		assert.Equal(t, 3, refilled.Value)
+
+		// the bucket can accumulate up to the burst, which can exceed the limit value
+		assert.Equal(t, 100, s.Available(start.Add(time.Hour)))
+	})
+
+	t.Run("sliding window", func(t *testing.T) {
+		s := RateLimitState{
+			Algorithm:  sqlcv2.RateLimitAlgorithmSLIDINGWINDOW,
+			LimitValue: 10,
+			Burst:      10,
+			Window:     time.Minute,
+			Value:      2,
+			LastRefill: start,
+		}
+
+		assert.Equal(t, 2, s.Available(start.Add(30*time.Second)))
+
+		// the 8 units used in the previous window are weighted by how much of it overlaps the
+		// trailing window
+		refilled := s.Refill(start.Add(75 * time.Second))
+		assert.Equal(t, 10, refilled.Value)
+		assert.Equal(t, 8, refilled.PreviousUsage)
+		assert.Equal(t, start.Add(time.Minute), refilled.LastRefill)
+
+		assert.Equal(t, 4, s.Available(start.Add(75*time.Second)))
+		assert.Equal(t, 8, s.Available(start.Add(105*time.Second)))
+
+		// none of the previous usage counts once a full window has passed without any usage
+		assert.Equal(t, 10, s.Available(start.Add(150*time.Second)))
+	})
+}
+
+func TestRateLimitStateNextRefill(t *testing.T) {
+	start := time.Date(2025, 3, 29, 12, 0, 0, 0, time.UTC)
+
+	s := RateLimitState{
+		Algorithm:  sqlcv2.RateLimitAlgorithmFIXEDWINDOW,
+		LimitValue: 10,
+		Burst:      10,
+		Window:     time.Minute,
+		Value:      10,
+		LastRefill: start,
+	}
+
+	next, ok := s.NextRefill(start.Add(30 * time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, start.Add(time.Minute), next)
+
+	// a token is added every 6 seconds, and full buckets aren't refilled
+	s.Algorithm = sqlcv2.RateLimitAlgorithmTOKENBUCKET
+
+	_, ok = s.NextRefill(start.Add(30 * time.Second))
+	assert.False(t, ok)
+
+	s.Value = 0
+
+	next, ok = s.NextRefill(start.Add(13 * time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, start.Add(18*time.Second), next)
+}
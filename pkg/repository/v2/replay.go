@@ -0,0 +1,578 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+// ErrInvalidDAGReplay is returned when a DAG can't be replayed from a task, for example because part of the
+// DAG is still running.
+var ErrInvalidDAGReplay = fmt.Errorf("cannot replay DAG from task")
+
+type ReplayTaskOpts struct {
+	CreateTaskOpts
+
+	// (required) the id of the existing task
+	TaskId int64
+
+	// (required) the inserted at time of the existing task
+	TaskInsertedAt pgtype.Timestamptz
+}
+
+type ReplayDAGFromTaskResult struct {
+	// The task which the DAG was replayed from, which has been queued again
+	ReplayedTask *sqlcv2.V2Task
+
+	// The existing tasks downstream of the replayed task. These tasks run again once their parents complete,
+	// with a retry count one greater than their current retry count.
+	ResetTasks []*sqlcv2.ListDAGTasksForReplayRow
+}
+
+// ReplayDAGFromTask runs a task in a DAG again, along with every task downstream of it. The outputs of parent
+// tasks which aren't downstream of the task are reused, so every parent of a task which is run again must have
+// completed.
+//
+// Rather than creating new tasks, the existing tasks are run again in place with a new retry count, in the same
+// way as ReplayTasks. This keeps the external ids of the tasks and their rows in v2_dag_to_task stable, so
+// links to the tasks and the DAG keep working, and every attempt remains visible in the task's event history.
+// Steps downstream of the task which never created a task are created as usual once their parents complete.
+func (r *TaskRepositoryImpl) ReplayDAGFromTask(ctx context.Context, tenantId string, taskId int64) (*ReplayDAGFromTaskResult, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	dag, err := r.queries.LockDAGForTask(ctx, tx, sqlcv2.LockDAGForTaskParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Taskid:   taskId,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: task is not part of a DAG", ErrInvalidDAGReplay)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock DAG: %w", err)
+	}
+
+	dagTasks, err := r.queries.ListDAGTasksForReplay(ctx, tx, sqlcv2.ListDAGTasksForReplayParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Dagid:         dag.ID,
+		Daginsertedat: dag.InsertedAt,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DAG tasks: %w", err)
+	}
+
+	// matches for steps which don't have a task yet
+	pendingMatches, err := r.queries.ListMatchesForDAG(ctx, tx, sqlcv2.ListMatchesForDAGParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Dagid:         dag.ID,
+		Daginsertedat: dag.InsertedAt,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DAG matches: %w", err)
+	}
+
+	steps, err := r.queries.ListStepsByWorkflowVersionIds(ctx, tx, sqlcv2.ListStepsByWorkflowVersionIdsParams{
+		Ids:      []pgtype.UUID{dag.WorkflowVersionID},
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list steps: %w", err)
+	}
+
+	stepsToTasks := make(map[string]*sqlcv2.ListDAGTasksForReplayRow)
+	stepsToExternalIds := make(map[string]string)
+
+	for _, match := range pendingMatches {
+		stepsToExternalIds[sqlchelpers.UUIDToStr(match.TriggerStepID)] = sqlchelpers.UUIDToStr(match.TriggerExternalID)
+	}
+
+	var replayFrom *sqlcv2.ListDAGTasksForReplayRow
+
+	for _, task := range dagTasks {
+		stepId := sqlchelpers.UUIDToStr(task.StepID)
+
+		stepsToTasks[stepId] = task
+		stepsToExternalIds[stepId] = sqlchelpers.UUIDToStr(task.ExternalID)
+
+		if task.ID == taskId {
+			replayFrom = task
+		}
+	}
+
+	if replayFrom == nil {
+		return nil, fmt.Errorf("%w: task is not part of a DAG", ErrInvalidDAGReplay)
+	}
+
+	replayFromStepId := sqlchelpers.UUIDToStr(replayFrom.StepID)
+
+	replayStepIds, err := getReplayStepIds(steps, stepsToTasks, replayFromStepId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stepsById := make(map[string]*sqlcv2.ListStepsByWorkflowVersionIdsRow, len(steps))
+
+	for _, step := range steps {
+		stepsById[sqlchelpers.UUIDToStr(step.ID)] = step
+	}
+
+	completedData := make(map[string][]byte)
+
+	for stepId := range replayStepIds {
+		if _, ok := stepsToExternalIds[stepId]; !ok {
+			return nil, fmt.Errorf("%w: could not find a task for step %s", ErrInvalidDAGReplay, stepsById[stepId].ReadableId.String)
+		}
+
+		if task, ok := stepsToTasks[stepId]; ok && task.IsActive {
+			return nil, fmt.Errorf("%w: task %s is still running", ErrInvalidDAGReplay, task.StepReadableID)
+		}
+
+		// every parent which isn't run again must have completed, so its output can be reused
+		for _, parent := range stepsById[stepId].Parents {
+			parentId := sqlchelpers.UUIDToStr(parent)
+
+			if _, ok := replayStepIds[parentId]; ok {
+				continue
+			}
+
+			if _, ok := completedData[parentId]; ok {
+				continue
+			}
+
+			data, err := getCompletedParentData(stepsToTasks[parentId], stepsById[parentId].ReadableId.String)
+
+			if err != nil {
+				return nil, err
+			}
+
+			completedData[parentId] = data
+		}
+	}
+
+	dagData, err := r.queries.GetDAGData(ctx, tx, sqlcv2.GetDAGDataParams{
+		Dagids:         []int64{dag.ID},
+		Daginsertedats: []pgtype.Timestamptz{dag.InsertedAt},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DAG data: %w", err)
+	}
+
+	if len(dagData) == 0 {
+		return nil, fmt.Errorf("could not find data for DAG %d", dag.ID)
+	}
+
+	// get any user event conditions which gate steps that haven't run yet. steps which have already run don't
+	// wait on their user events again.
+	pendingStepIds := make([]pgtype.UUID, 0)
+
+	for stepId := range replayStepIds {
+		if _, ok := stepsToTasks[stepId]; !ok {
+			pendingStepIds = append(pendingStepIds, sqlchelpers.UUIDFromStr(stepId))
+		}
+	}
+
+	stepsToMatchConditions := make(map[string][]*sqlcv2.V2StepMatchCondition)
+
+	if len(pendingStepIds) > 0 {
+		stepMatchConditions, err := r.queries.ListStepMatchConditions(ctx, tx, sqlcv2.ListStepMatchConditionsParams{
+			Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+			Stepids:  pendingStepIds,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to list step match conditions: %w", err)
+		}
+
+		for _, condition := range stepMatchConditions {
+			stepId := sqlchelpers.UUIDToStr(condition.StepID)
+
+			stepsToMatchConditions[stepId] = append(stepsToMatchConditions[stepId], condition)
+		}
+	}
+
+	// remove the matches of any steps which haven't run yet, since they're replaced below
+	replayStepIdsList := make([]pgtype.UUID, 0, len(replayStepIds))
+
+	for stepId := range replayStepIds {
+		replayStepIdsList = append(replayStepIdsList, sqlchelpers.UUIDFromStr(stepId))
+	}
+
+	err = r.queries.DeleteMatchesForDAGSteps(ctx, tx, sqlcv2.DeleteMatchesForDAGStepsParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Dagid:         dag.ID,
+		Daginsertedat: dag.InsertedAt,
+		Stepids:       replayStepIdsList,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete DAG matches: %w", err)
+	}
+
+	res := &ReplayDAGFromTaskResult{}
+	createMatchOpts := make([]CreateMatchOpts, 0, len(replayStepIds))
+
+	for stepId := range replayStepIds {
+		if stepId == replayFromStepId {
+			continue
+		}
+
+		step := stepsById[stepId]
+		taskExternalId := stepsToExternalIds[stepId]
+		conditions := make([]GroupMatchCondition, 0)
+
+		if step.JobKind == sqlcv2.JobKindONFAILURE {
+			groupId := uuid.NewString()
+
+			for otherStepId := range replayStepIds {
+				if stepsById[otherStepId].JobKind == sqlcv2.JobKindONFAILURE {
+					continue
+				}
+
+				conditions = append(conditions, getParentOnFailureGroupMatches(groupId, stepsToExternalIds[otherStepId])...)
+			}
+		} else {
+			createGroupId := uuid.NewString()
+
+			for _, parent := range step.Parents {
+				parentId := sqlchelpers.UUIDToStr(parent)
+
+				if data, ok := completedData[parentId]; ok {
+					conditions = append(conditions, getCompletedParentGroupMatch(stepsToExternalIds[parentId], data))
+				} else {
+					conditions = append(conditions, getParentInDAGGroupMatch(createGroupId, stepsToExternalIds[parentId])...)
+				}
+			}
+
+			conditions = append(conditions, getUserEventGroupMatches(stepsToMatchConditions[stepId])...)
+		}
+
+		opt := CreateMatchOpts{
+			Kind:                 sqlcv2.V2MatchKindTRIGGER,
+			Conditions:           conditions,
+			TriggerDAGId:         &dag.ID,
+			TriggerDAGInsertedAt: dag.InsertedAt,
+			TriggerExternalId:    &taskExternalId,
+			TriggerStepId:        &stepId,
+		}
+
+		if task, ok := stepsToTasks[stepId]; ok {
+			opt.TriggerExistingTaskId = &task.ID
+			opt.TriggerExistingTaskInsertedAt = task.InsertedAt
+
+			res.ResetTasks = append(res.ResetTasks, task)
+		}
+
+		createMatchOpts = append(createMatchOpts, opt)
+	}
+
+	err = r.createEventMatches(ctx, tx, tenantId, createMatchOpts)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event matches: %w", err)
+	}
+
+	// queue the task we're replaying from, with the outputs of its parents
+	var triggerData map[string][]map[string]interface{}
+
+	for _, parent := range stepsById[replayFromStepId].Parents {
+		parentId := sqlchelpers.UUIDToStr(parent)
+
+		var data map[string]interface{}
+
+		if err := json.Unmarshal(completedData[parentId], &data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal completed data: %w", err)
+		}
+
+		if triggerData == nil {
+			triggerData = make(map[string][]map[string]interface{})
+		}
+
+		key := GetTaskCompletedEventKey(stepsToExternalIds[parentId])
+		triggerData[key] = append(triggerData[key], data)
+	}
+
+	replayedTasks, err := r.replayTasksWithInput(ctx, tx, tenantId, []ReplayTaskOpts{
+		{
+			CreateTaskOpts: CreateTaskOpts{
+				ExternalId:         sqlchelpers.UUIDToStr(replayFrom.ExternalID),
+				StepId:             replayFromStepId,
+				Input:              r.newTaskInput(dagData[0].Input, triggerData),
+				AdditionalMetadata: dagData[0].AdditionalMetadata,
+				DagId:              &dag.ID,
+				DagInsertedAt:      dag.InsertedAt,
+				InitialState:       sqlcv2.V2TaskInitialStateQUEUED,
+			},
+			TaskId:         replayFrom.ID,
+			TaskInsertedAt: replayFrom.InsertedAt,
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay task: %w", err)
+	}
+
+	if len(replayedTasks) != 1 {
+		return nil, fmt.Errorf("expected 1 replayed task, got %d", len(replayedTasks))
+	}
+
+	res.ReplayedTask = replayedTasks[0]
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// getReplayStepIds returns the steps which run again when a DAG is replayed from a step: the step itself, every
+// step downstream of it, and the on failure step if the rest of the DAG completed.
+func getReplayStepIds(
+	steps []*sqlcv2.ListStepsByWorkflowVersionIdsRow,
+	stepsToTasks map[string]*sqlcv2.ListDAGTasksForReplayRow,
+	replayFromStepId string,
+) (map[string]struct{}, error) {
+	// find every step downstream of the step we're replaying from
+	stepsById := make(map[string]*sqlcv2.ListStepsByWorkflowVersionIdsRow)
+	stepsToChildren := make(map[string][]string)
+	onFailureStepIds := make([]string, 0)
+
+	for _, step := range steps {
+		stepId := sqlchelpers.UUIDToStr(step.ID)
+		stepsById[stepId] = step
+
+		if step.JobKind == sqlcv2.JobKindONFAILURE {
+			onFailureStepIds = append(onFailureStepIds, stepId)
+			continue
+		}
+
+		for _, parent := range step.Parents {
+			parentId := sqlchelpers.UUIDToStr(parent)
+			stepsToChildren[parentId] = append(stepsToChildren[parentId], stepId)
+		}
+	}
+
+	if _, ok := stepsById[replayFromStepId]; !ok {
+		return nil, fmt.Errorf("%w: the workflow version of the DAG no longer exists", ErrInvalidDAGReplay)
+	}
+
+	if stepsById[replayFromStepId].JobKind == sqlcv2.JobKindONFAILURE {
+		return nil, fmt.Errorf("%w: on failure tasks can't be replayed from", ErrInvalidDAGReplay)
+	}
+
+	replayStepIds := map[string]struct{}{
+		replayFromStepId: {},
+	}
+
+	toVisit := []string{replayFromStepId}
+
+	for len(toVisit) > 0 {
+		stepId := toVisit[0]
+		toVisit = toVisit[1:]
+
+		for _, childId := range stepsToChildren[stepId] {
+			if _, ok := replayStepIds[childId]; !ok {
+				replayStepIds[childId] = struct{}{}
+				toVisit = append(toVisit, childId)
+			}
+		}
+	}
+
+	// the on failure step is only run again if the rest of the DAG completed, since otherwise it has already run
+	// for the failure which is still in place
+	if len(onFailureStepIds) > 0 && otherTasksCompleted(steps, stepsToTasks, replayStepIds) {
+		for _, stepId := range onFailureStepIds {
+			replayStepIds[stepId] = struct{}{}
+		}
+	}
+
+	return replayStepIds, nil
+}
+
+// otherTasksCompleted returns true if every task which isn't run again as part of a replay has completed.
+func otherTasksCompleted(
+	steps []*sqlcv2.ListStepsByWorkflowVersionIdsRow,
+	stepsToTasks map[string]*sqlcv2.ListDAGTasksForReplayRow,
+	replayStepIds map[string]struct{},
+) bool {
+	for _, step := range steps {
+		stepId := sqlchelpers.UUIDToStr(step.ID)
+
+		if _, ok := replayStepIds[stepId]; ok || step.JobKind == sqlcv2.JobKindONFAILURE {
+			continue
+		}
+
+		task, ok := stepsToTasks[stepId]
+
+		if !ok || (!task.IsCompleted && task.InitialState != sqlcv2.V2TaskInitialStateSKIPPED) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getCompletedParentData returns the data of a completed event for a parent task, in the same format as the
+// internal event which is sent when the task completes.
+func getCompletedParentData(task *sqlcv2.ListDAGTasksForReplayRow, stepReadableId string) ([]byte, error) {
+	if task == nil {
+		return nil, fmt.Errorf("%w: parent task %s has not run", ErrInvalidDAGReplay, stepReadableId)
+	}
+
+	var output []byte
+
+	switch {
+	case task.InitialState == sqlcv2.V2TaskInitialStateSKIPPED:
+		output, _ = json.Marshal(map[string]bool{
+			"skipped": true,
+		})
+	case task.IsCompleted && len(task.Output) > 0:
+		output = task.Output
+	case task.IsCompleted:
+		return nil, fmt.Errorf("%w: the output of parent task %s was not stored", ErrInvalidDAGReplay, stepReadableId)
+	default:
+		return nil, fmt.Errorf("%w: parent task %s has not completed", ErrInvalidDAGReplay, stepReadableId)
+	}
+
+	return json.Marshal(CompletedData{
+		StepReadableId: task.StepReadableID,
+		Output:         output,
+	})
+}
+
+// replayTasksWithInput runs existing tasks again in place with a new input. Concurrency keys are evaluated
+// against the new input, and the tasks keep their external ids.
+func (r *sharedRepository) replayTasksWithInput(
+	ctx context.Context,
+	tx sqlcv2.DBTX,
+	tenantId string,
+	tasks []ReplayTaskOpts,
+) ([]*sqlcv2.V2Task, error) {
+	uniqueStepIds := make(map[string]struct{})
+	stepIds := make([]pgtype.UUID, 0)
+
+	for _, task := range tasks {
+		if _, ok := uniqueStepIds[task.StepId]; !ok {
+			uniqueStepIds[task.StepId] = struct{}{}
+			stepIds = append(stepIds, sqlchelpers.UUIDFromStr(task.StepId))
+		}
+	}
+
+	steps, err := r.queries.ListStepsByIds(ctx, tx, sqlcv2.ListStepsByIdsParams{
+		Ids:      stepIds,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	stepIdsToConfig := make(map[string]*sqlcv2.ListStepsByIdsRow)
+
+	for _, step := range steps {
+		stepIdsToConfig[sqlchelpers.UUIDToStr(step.ID)] = step
+	}
+
+	concurrencyStrats, err := r.getConcurrencyExpressions(ctx, tx, tenantId, stepIdsToConfig)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get step expressions: %w", err)
+	}
+
+	queues := make([]string, 0, len(tasks))
+	keyWeights := make(map[concurrencyKeyWeightKey]int32)
+	params := make([]sqlcv2.ReplayTaskWithInputParams, 0, len(tasks))
+
+	for _, task := range tasks {
+		stepConfig, ok := stepIdsToConfig[task.StepId]
+
+		if !ok {
+			return nil, fmt.Errorf("could not find step %s", task.StepId)
+		}
+
+		param := sqlcv2.ReplayTaskWithInputParams{
+			Tenantid:               sqlchelpers.UUIDFromStr(tenantId),
+			Taskid:                 task.TaskId,
+			Taskinsertedat:         task.TaskInsertedAt,
+			Input:                  r.ToV1StepRunData(task.Input).Bytes(),
+			Initialstate:           task.InitialState,
+			Concurrencystrategyids: []int64{},
+			Concurrencykeys:        []string{},
+		}
+
+		if task.InitialState == sqlcv2.V2TaskInitialStateQUEUED {
+			queues = append(queues, stepConfig.ActionId)
+
+			if strats, ok := concurrencyStrats[task.StepId]; ok {
+				taskConcurrencyKeys, taskStrategyIds, taskKeyWeights, failTaskError := r.evaluateConcurrencyKeys(task.CreateTaskOpts, task.AdditionalMetadata, strats)
+
+				if failTaskError != nil {
+					// place the task into a failed state
+					param.Initialstate = sqlcv2.V2TaskInitialStateFAILED
+					param.InitialStateReason = pgtype.Text{
+						String: failTaskError.Error(),
+						Valid:  true,
+					}
+				} else {
+					param.Concurrencykeys = taskConcurrencyKeys
+					param.Concurrencystrategyids = taskStrategyIds
+
+					for k, weight := range taskKeyWeights {
+						keyWeights[k] = weight
+					}
+				}
+			}
+		}
+
+		params = append(params, param)
+	}
+
+	saveQueueCache, err := r.upsertQueues(ctx, tx, tenantId, queues)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert queues: %w", err)
+	}
+
+	res := make([]*sqlcv2.V2Task, 0, len(params))
+
+	for _, param := range params {
+		task, err := r.queries.ReplayTaskWithInput(ctx, tx, param)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay task %d: %w", param.Taskid, err)
+		}
+
+		res = append(res, task)
+	}
+
+	if len(keyWeights) > 0 {
+		err = r.upsertConcurrencyKeyWeights(ctx, tx, tenantId, keyWeights)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to upsert concurrency key weights: %w", err)
+		}
+	}
+
+	saveQueueCache()
+
+	return res, nil
+}
@@ -0,0 +1,150 @@
+//go:build integration
+
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+func TestReplayDAGFromTask(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+
+		require.NoError(t, conf.V2.Tasks().UpdateTablePartitions(ctx))
+
+		tenantId := uuid.NewString()
+		slugSuffix, err := random.Generate(8)
+		require.NoError(t, err)
+
+		_, err = conf.APIRepository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+			ID:   &tenantId,
+			Name: "test-tenant",
+			Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+		})
+		require.NoError(t, err)
+
+		// a linear DAG: extract -> transform -> load
+		_, err = conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+			Name: "ingest",
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name: "ingest",
+					Kind: "DEFAULT",
+					Steps: []repository.CreateWorkflowStepOpts{
+						{ReadableId: "extract", Action: "ingest:extract"},
+						{ReadableId: "transform", Action: "ingest:transform", Parents: []string{"extract"}},
+						{ReadableId: "load", Action: "ingest:load", Parents: []string{"transform"}},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		tasks, dags, err := conf.V2.Triggers().TriggerFromWorkflowNames(ctx, tenantId, []v2.WorkflowNameTriggerOpts{
+			{
+				WorkflowName:       "ingest",
+				ExternalId:         uuid.NewString(),
+				Data:               []byte(`{"source":"s3"}`),
+				AdditionalMetadata: []byte(`{}`),
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, dags, 1)
+		require.Len(t, tasks, 1)
+
+		extract := tasks[0]
+
+		// finish runs a task to completion or failure in the same way as the task controller, and returns the
+		// tasks which were created or run again as a result
+		finish := func(task *sqlcv2.V2Task, output []byte) *v2.InternalEventMatchResults {
+			// the task was picked up by a worker
+			_, err := conf.Pool.Exec(ctx, `DELETE FROM v2_queue_item WHERE task_id = $1 AND retry_count = $2`, task.ID, task.RetryCount)
+			require.NoError(t, err)
+
+			idRetryCount := &v2.TaskIdRetryCount{Id: task.ID, RetryCount: task.RetryCount}
+			key := v2.GetTaskCompletedEventKey(sqlchelpers.UUIDToStr(task.ExternalID))
+			data, _ := json.Marshal(v2.CompletedData{StepReadableId: task.StepReadableID, Output: output})
+
+			if output == nil {
+				_, _, err = conf.V2.Tasks().FailTasks(ctx, tenantId, []v2.FailTaskOpts{
+					{TaskIdRetryCount: idRetryCount, IsAppError: true},
+				})
+				require.NoError(t, err)
+
+				key = v2.GetTaskFailedEventKey(sqlchelpers.UUIDToStr(task.ExternalID))
+				data, _ = json.Marshal(v2.FailedData{StepReadableId: task.StepReadableID, Error: "flaked"})
+			} else {
+				_, err = conf.V2.Tasks().CompleteTasks(ctx, tenantId, []v2.CompleteTaskOpts{
+					{TaskIdRetryCount: idRetryCount, Output: output},
+				})
+				require.NoError(t, err)
+			}
+
+			res, err := conf.V2.Matches().ProcessInternalEventMatches(ctx, tenantId, []v2.CandidateEventMatch{
+				{ID: uuid.NewString(), EventTimestamp: time.Now(), Key: key, Data: data},
+			})
+			require.NoError(t, err)
+
+			return res
+		}
+
+		res := finish(extract, []byte(`{"rows":10}`))
+		require.Len(t, res.CreatedTasks, 1)
+		transform := res.CreatedTasks[0]
+
+		res = finish(transform, []byte(`{"rows":8}`))
+		require.Len(t, res.CreatedTasks, 1)
+		load := res.CreatedTasks[0]
+
+		finish(load, nil)
+
+		_, err = conf.V2.Tasks().ReplayDAGFromTask(ctx, tenantId, 0)
+		require.ErrorIs(t, err, v2.ErrInvalidDAGReplay)
+
+		replay, err := conf.V2.Tasks().ReplayDAGFromTask(ctx, tenantId, transform.ID)
+		require.NoError(t, err)
+
+		// the task is run again in place with a new retry count
+		assert.Equal(t, transform.ID, replay.ReplayedTask.ID)
+		assert.Equal(t, transform.ExternalID, replay.ReplayedTask.ExternalID)
+		assert.Equal(t, transform.RetryCount+1, replay.ReplayedTask.RetryCount)
+
+		// the output of the parent which isn't run again is reused
+		var input v2.V1StepRunData
+		require.NoError(t, json.Unmarshal(replay.ReplayedTask.Input, &input))
+		assert.Equal(t, map[string]interface{}{"rows": float64(10)}, input.Parents["extract"])
+		assert.Equal(t, map[string]interface{}{"source": "s3"}, input.Input)
+
+		require.Len(t, replay.ResetTasks, 1)
+		assert.Equal(t, load.ID, replay.ResetTasks[0].ID)
+
+		// the DAG can't be replayed again while the replayed task is queued
+		_, err = conf.V2.Tasks().ReplayDAGFromTask(ctx, tenantId, transform.ID)
+		require.ErrorIs(t, err, v2.ErrInvalidDAGReplay)
+
+		// the downstream task runs again once the replayed task completes, rather than being created
+		res = finish(replay.ReplayedTask, []byte(`{"rows":9}`))
+		assert.Empty(t, res.CreatedTasks)
+		require.Len(t, res.ReplayedTasks, 1)
+		assert.Equal(t, load.ID, res.ReplayedTasks[0].ID)
+		assert.Equal(t, load.RetryCount+1, res.ReplayedTasks[0].RetryCount)
+
+		return nil
+	})
+}
@@ -0,0 +1,145 @@
+package v2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+// testDAG is a DAG with the shape:
+//
+//	     b - d
+//	   /       \
+//	a            e
+//	   \
+//	     c
+//
+// along with an on failure step.
+type testDAG struct {
+	steps   []*sqlcv2.ListStepsByWorkflowVersionIdsRow
+	stepIds map[string]string
+}
+
+func newTestDAG() *testDAG {
+	d := &testDAG{
+		stepIds: make(map[string]string),
+	}
+
+	for _, name := range []string{"a", "b", "c", "d", "e", "on-failure"} {
+		d.stepIds[name] = uuid.NewString()
+	}
+
+	addStep := func(name string, kind sqlcv2.JobKind, parents ...string) {
+		parentIds := make([]pgtype.UUID, len(parents))
+
+		for i, parent := range parents {
+			parentIds[i] = sqlchelpers.UUIDFromStr(d.stepIds[parent])
+		}
+
+		d.steps = append(d.steps, &sqlcv2.ListStepsByWorkflowVersionIdsRow{
+			ID:         sqlchelpers.UUIDFromStr(d.stepIds[name]),
+			ReadableId: sqlchelpers.TextFromStr(name),
+			JobKind:    kind,
+			Parents:    parentIds,
+		})
+	}
+
+	addStep("a", sqlcv2.JobKindDEFAULT)
+	addStep("b", sqlcv2.JobKindDEFAULT, "a")
+	addStep("c", sqlcv2.JobKindDEFAULT, "a")
+	addStep("d", sqlcv2.JobKindDEFAULT, "b")
+	addStep("e", sqlcv2.JobKindDEFAULT, "d")
+	addStep("on-failure", sqlcv2.JobKindONFAILURE)
+
+	return d
+}
+
+func (d *testDAG) names(stepIds map[string]struct{}) []string {
+	res := make([]string, 0, len(stepIds))
+
+	for name, id := range d.stepIds {
+		if _, ok := stepIds[id]; ok {
+			res = append(res, name)
+		}
+	}
+
+	return res
+}
+
+func (d *testDAG) completedTasks(names ...string) map[string]*sqlcv2.ListDAGTasksForReplayRow {
+	res := make(map[string]*sqlcv2.ListDAGTasksForReplayRow)
+
+	for _, name := range names {
+		res[d.stepIds[name]] = &sqlcv2.ListDAGTasksForReplayRow{
+			StepReadableID: name,
+			IsCompleted:    true,
+			Output:         []byte(`{}`),
+		}
+	}
+
+	return res
+}
+
+func TestGetReplayStepIds(t *testing.T) {
+	d := newTestDAG()
+
+	// c is a sibling of b, so it isn't run again. c hasn't completed, so the on failure step has already run
+	// for it and isn't run again either.
+	stepIds, err := getReplayStepIds(d.steps, d.completedTasks("a"), d.stepIds["b"])
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"b", "d", "e"}, d.names(stepIds))
+
+	// the on failure step is run again when every other task completed, since it hasn't run yet
+	stepIds, err = getReplayStepIds(d.steps, d.completedTasks("a", "b", "c", "d", "e"), d.stepIds["d"])
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"d", "e", "on-failure"}, d.names(stepIds))
+
+	stepIds, err = getReplayStepIds(d.steps, d.completedTasks(), d.stepIds["a"])
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a", "b", "c", "d", "e", "on-failure"}, d.names(stepIds))
+
+	_, err = getReplayStepIds(d.steps, d.completedTasks(), d.stepIds["on-failure"])
+	assert.ErrorIs(t, err, ErrInvalidDAGReplay)
+
+	_, err = getReplayStepIds(d.steps, d.completedTasks(), uuid.NewString())
+	assert.ErrorIs(t, err, ErrInvalidDAGReplay)
+}
+
+func TestGetCompletedParentData(t *testing.T) {
+	data, err := getCompletedParentData(&sqlcv2.ListDAGTasksForReplayRow{
+		StepReadableID: "a",
+		IsCompleted:    true,
+		Output:         []byte(`{"rows":10}`),
+	}, "a")
+	require.NoError(t, err)
+
+	var completed CompletedData
+	require.NoError(t, json.Unmarshal(data, &completed))
+	assert.Equal(t, "a", completed.StepReadableId)
+	assert.JSONEq(t, `{"rows":10}`, string(completed.Output))
+
+	// skipped parents are passed to their children in the same way as when the DAG first ran
+	data, err = getCompletedParentData(&sqlcv2.ListDAGTasksForReplayRow{
+		StepReadableID: "a",
+		InitialState:   sqlcv2.V2TaskInitialStateSKIPPED,
+	}, "a")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &completed))
+	assert.JSONEq(t, `{"skipped":true}`, string(completed.Output))
+
+	for _, task := range []*sqlcv2.ListDAGTasksForReplayRow{
+		nil,
+		{StepReadableID: "a"},
+		{StepReadableID: "a", IsCompleted: true},
+	} {
+		_, err = getCompletedParentData(task, "a")
+		assert.ErrorIs(t, err, ErrInvalidDAGReplay)
+	}
+}
@@ -1,9 +1,11 @@
 package v2
 
 import (
-	"github.com/hatchet-dev/hatchet/pkg/validator"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
 )
 
 type Repository interface {
@@ -11,6 +13,11 @@ type Repository interface {
 	Tasks() TaskRepository
 	Scheduler() SchedulerRepository
 	Matches() MatchRepository
+	Ticker() TickerRepository
+
+	// CELProgramCacheStats returns the statistics of the cache of compiled CEL programs which are used to
+	// evaluate concurrency keys and match conditions.
+	CELProgramCacheStats() cel.ProgramCacheStats
 }
 
 type repositoryImpl struct {
@@ -18,12 +25,37 @@ type repositoryImpl struct {
 	tasks     TaskRepository
 	scheduler SchedulerRepository
 	matches   MatchRepository
+	ticker    TickerRepository
+
+	shared *sharedRepository
 }
 
-func NewRepository(pool *pgxpool.Pool, l *zerolog.Logger) Repository {
+type RepositoryOpt func(*repositoryOpts)
+
+type repositoryOpts struct {
+	celProgramCacheSize int
+}
+
+// WithCELProgramCacheSize sets the number of compiled CEL programs which are cached by the repository. A
+// size of 0 disables the cache.
+func WithCELProgramCacheSize(size int) RepositoryOpt {
+	return func(opts *repositoryOpts) {
+		opts.celProgramCacheSize = size
+	}
+}
+
+func NewRepository(pool *pgxpool.Pool, l *zerolog.Logger, fs ...RepositoryOpt) Repository {
+	opts := &repositoryOpts{
+		celProgramCacheSize: cel.DefaultProgramCacheSize,
+	}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
 	v := validator.NewDefaultValidator()
 
-	shared := newSharedRepository(pool, v, l)
+	shared := newSharedRepository(pool, v, l, cel.NewCELParser(cel.WithProgramCacheSize(opts.celProgramCacheSize)))
 
 	matchRepo, err := newMatchRepository(shared)
 
@@ -36,6 +68,8 @@ func NewRepository(pool *pgxpool.Pool, l *zerolog.Logger) Repository {
 		tasks:     newTaskRepository(shared),
 		scheduler: newSchedulerRepository(shared),
 		matches:   matchRepo,
+		ticker:    newTickerRepository(shared),
+		shared:    shared,
 	}
 
 	return impl
@@ -56,3 +90,11 @@ func (r *repositoryImpl) Scheduler() SchedulerRepository {
 func (r *repositoryImpl) Matches() MatchRepository {
 	return r.matches
 }
+
+func (r *repositoryImpl) Ticker() TickerRepository {
+	return r.ticker
+}
+
+func (r *repositoryImpl) CELProgramCacheStats() cel.ProgramCacheStats {
+	return r.shared.celParser.ProgramCacheStats()
+}
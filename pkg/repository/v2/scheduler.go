@@ -2,6 +2,7 @@ package v2
 
 import (
 	"context"
+	"time"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -32,15 +33,24 @@ type QueueRepository interface {
 	ListQueueItems(ctx context.Context, limit int) ([]*sqlcv2.V2QueueItem, error)
 	MarkQueueItemsProcessed(ctx context.Context, r *AssignResults) (succeeded []*AssignedItem, failed []*AssignedItem, err error)
 
-	// TODO: ADD THIS
-	// GetStepRunRateLimits(ctx context.Context, queueItems []*sqlcv2.V2QueueItem) (map[string]map[string]int32, error)
+	GetTaskRateLimits(ctx context.Context, queueItems []*sqlcv2.V2QueueItem) (map[int64]map[string]int32, error)
 	GetDesiredLabels(ctx context.Context, stepIds []pgtype.UUID) (map[string][]*sqlcv2.GetDesiredLabelsRow, error)
 	Cleanup()
 }
 
 type RateLimitRepository interface {
 	ListCandidateRateLimits(ctx context.Context, tenantId pgtype.UUID) ([]string, error)
-	UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]int, error)
+
+	// UpdateRateLimits refills the rate limits of the tenant and subtracts the units which were used,
+	// returning the new state of each rate limit.
+	UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]*RateLimitState, error)
+
+	// DeleteIdleDynamicRateLimits deletes dynamic rate limits which haven't been used since idleSince,
+	// returning the number of deleted rate limits.
+	DeleteIdleDynamicRateLimits(ctx context.Context, tenantId pgtype.UUID, idleSince time.Time) (int64, error)
+
+	// ListRateLimitUsage returns the current usage of the tenant's rate limits, without updating them.
+	ListRateLimitUsage(ctx context.Context, tenantId pgtype.UUID, opts *ListRateLimitUsageOpts) (*ListRateLimitUsageResult, error)
 }
 
 type AssignmentRepository interface {
@@ -2,6 +2,7 @@ package v2
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
@@ -36,6 +37,17 @@ type ConcurrencyRepository interface {
 	UpdateConcurrencyStrategyIsActive(ctx context.Context, tenantId pgtype.UUID, strategy *sqlcv2.V2StepConcurrency) error
 
 	RunConcurrencyStrategy(ctx context.Context, tenantId pgtype.UUID, strategy *sqlcv2.V2StepConcurrency) (*RunConcurrencyResult, error)
+
+	// ListConcurrencyUsage returns the active concurrency strategies of the tenant, along with the number of
+	// running and queued tasks for up to keyLimit keys of each strategy.
+	ListConcurrencyUsage(ctx context.Context, tenantId pgtype.UUID, keyLimit int) ([]*ConcurrencyStrategyUsage, error)
+}
+
+type ConcurrencyStrategyUsage struct {
+	*sqlcv2.ListConcurrencyStrategyUsageRow
+
+	// The keys with the most queued tasks, ordered by the number of queued tasks
+	Keys []*sqlcv2.ListConcurrencyKeyUsageRow
 }
 
 type ConcurrencyRepositoryImpl struct {
@@ -86,6 +98,15 @@ func (c *ConcurrencyRepositoryImpl) UpdateConcurrencyStrategyIsActive(
 			Strategyid:        strategy.ID,
 		})
 
+		if err != nil {
+			return err
+		}
+	} else if strategy.Strategy == sqlcv2.V2ConcurrencyStrategyWEIGHTEDROUNDROBIN {
+		err = c.queries.DeleteStaleConcurrencyKeyWeights(ctx, tx, sqlcv2.DeleteStaleConcurrencyKeyWeightsParams{
+			Tenantid:   tenantId,
+			Strategyid: strategy.ID,
+		})
+
 		if err != nil {
 			return err
 		}
@@ -106,6 +127,8 @@ func (c *ConcurrencyRepositoryImpl) RunConcurrencyStrategy(
 		return c.runCancelInProgress(ctx, tenantId, strategy)
 	case sqlcv2.V2ConcurrencyStrategyCANCELNEWEST:
 		return c.runCancelNewest(ctx, tenantId, strategy)
+	case sqlcv2.V2ConcurrencyStrategyWEIGHTEDROUNDROBIN:
+		return c.runWeightedRoundRobin(ctx, tenantId, strategy)
 	}
 
 	return nil, nil
@@ -179,6 +202,71 @@ func (c *ConcurrencyRepositoryImpl) runGroupRoundRobin(
 	}, nil
 }
 
+func (c *ConcurrencyRepositoryImpl) runWeightedRoundRobin(
+	ctx context.Context,
+	tenantId pgtype.UUID,
+	strategy *sqlcv2.V2StepConcurrency,
+) (res *RunConcurrencyResult, err error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, c.pool, c.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	err = c.queries.ConcurrencyAdvisoryLock(ctx, tx, strategy.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	poppedResults, err := c.queries.RunWeightedRoundRobin(ctx, tx, sqlcv2.RunWeightedRoundRobinParams{
+		Tenantid:   tenantId,
+		Strategyid: strategy.ID,
+		Maxruns:    strategy.MaxConcurrency,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = commit(ctx); err != nil {
+		return nil, err
+	}
+
+	queued := make([]TaskWithQueue, 0, len(poppedResults))
+	cancelled := make([]TaskWithCancelledReason, 0, len(poppedResults))
+	nextConcurrencyStrategies := make([]int64, 0, len(poppedResults))
+
+	for _, r := range poppedResults {
+		idRetryCount := &TaskIdRetryCount{
+			Id:         r.TaskID,
+			RetryCount: r.TaskRetryCount,
+		}
+
+		if len(r.NextStrategyIds) > 0 {
+			nextConcurrencyStrategies = append(nextConcurrencyStrategies, r.NextStrategyIds[0])
+		} else if r.Operation == "SCHEDULING_TIMED_OUT" {
+			cancelled = append(cancelled, TaskWithCancelledReason{
+				TaskIdRetryCount: idRetryCount,
+				CancelledReason:  "SCHEDULING_TIMED_OUT",
+			})
+		} else {
+			queued = append(queued, TaskWithQueue{
+				TaskIdRetryCount: idRetryCount,
+				Queue:            r.QueueToNotify,
+			})
+		}
+	}
+
+	return &RunConcurrencyResult{
+		Queued:                    queued,
+		Cancelled:                 cancelled,
+		NextConcurrencyStrategies: nextConcurrencyStrategies,
+	}, nil
+}
+
 func (c *ConcurrencyRepositoryImpl) runCancelInProgress(
 	ctx context.Context,
 	tenantId pgtype.UUID,
@@ -378,3 +466,48 @@ func (c *ConcurrencyRepositoryImpl) runCancelNewest(
 		NextConcurrencyStrategies: nextConcurrencyStrategies,
 	}, nil
 }
+
+func (c *ConcurrencyRepositoryImpl) ListConcurrencyUsage(ctx context.Context, tenantId pgtype.UUID, keyLimit int) ([]*ConcurrencyStrategyUsage, error) {
+	strategies, err := c.queries.ListConcurrencyStrategyUsage(ctx, c.pool, tenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list concurrency strategies: %w", err)
+	}
+
+	res := make([]*ConcurrencyStrategyUsage, 0, len(strategies))
+	strategyIds := make([]int64, 0, len(strategies))
+	strategyIdsToUsage := make(map[int64]*ConcurrencyStrategyUsage, len(strategies))
+
+	for _, strategy := range strategies {
+		usage := &ConcurrencyStrategyUsage{
+			ListConcurrencyStrategyUsageRow: strategy,
+			Keys:                            make([]*sqlcv2.ListConcurrencyKeyUsageRow, 0),
+		}
+
+		res = append(res, usage)
+		strategyIds = append(strategyIds, strategy.ID)
+		strategyIdsToUsage[strategy.ID] = usage
+	}
+
+	if len(strategyIds) == 0 || keyLimit <= 0 {
+		return res, nil
+	}
+
+	keys, err := c.queries.ListConcurrencyKeyUsage(ctx, c.pool, sqlcv2.ListConcurrencyKeyUsageParams{
+		Tenantid:    tenantId,
+		Strategyids: strategyIds,
+		Keylimit:    int32(keyLimit), // nolint: gosec
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list concurrency keys: %w", err)
+	}
+
+	for _, key := range keys {
+		if usage, ok := strategyIdsToUsage[key.StrategyID]; ok {
+			usage.Keys = append(usage.Keys, key)
+		}
+	}
+
+	return res, nil
+}
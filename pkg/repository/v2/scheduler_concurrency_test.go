@@ -0,0 +1,107 @@
+//go:build integration
+
+package v2_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+func TestRunWeightedRoundRobin(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+
+		require.NoError(t, conf.V2.Tasks().UpdateTablePartitions(ctx))
+
+		tenantId := uuid.NewString()
+		workflowId := uuid.NewString()
+
+		strategy := &sqlcv2.V2StepConcurrency{
+			WorkflowID:        sqlchelpers.UUIDFromStr(workflowId),
+			WorkflowVersionID: sqlchelpers.UUIDFromStr(uuid.NewString()),
+			StepID:            sqlchelpers.UUIDFromStr(uuid.NewString()),
+			IsActive:          true,
+			Strategy:          sqlcv2.V2ConcurrencyStrategyWEIGHTEDROUNDROBIN,
+			Expression:        `input.customer_id`,
+			TenantID:          sqlchelpers.UUIDFromStr(tenantId),
+			MaxConcurrency:    2,
+		}
+
+		err := conf.Pool.QueryRow(
+			ctx,
+			`INSERT INTO v2_step_concurrency (workflow_id, workflow_version_id, step_id, strategy, expression, tenant_id, max_concurrency)
+			VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`,
+			strategy.WorkflowID, strategy.WorkflowVersionID, strategy.StepID, strategy.Strategy, strategy.Expression, strategy.TenantID, strategy.MaxConcurrency,
+		).Scan(&strategy.ID)
+		require.NoError(t, err)
+
+		// "paid" has 3 times the weight of "free", which uses the default weight of 1
+		_, err = conf.Pool.Exec(
+			ctx,
+			`INSERT INTO v2_concurrency_key_weight (tenant_id, strategy_id, key, weight) VALUES ($1, $2, 'paid', 3)`,
+			strategy.TenantID, strategy.ID,
+		)
+		require.NoError(t, err)
+
+		insertedAt := time.Now().UTC()
+		taskIdToKey := make(map[int64]string)
+
+		insertSlot := func(taskId int64, key string) {
+			_, err := conf.Pool.Exec(
+				ctx,
+				`INSERT INTO v2_concurrency_slot (task_id, task_inserted_at, task_retry_count, tenant_id, workflow_id, strategy_id, key, queue_to_notify, schedule_timeout_at)
+				VALUES ($1, $2, 0, $3, $4, $5, $6, 'default', NOW() + INTERVAL '5 minutes')`,
+				taskId, insertedAt, strategy.TenantID, strategy.WorkflowID, strategy.ID, key,
+			)
+			require.NoError(t, err)
+
+			taskIdToKey[taskId] = key
+		}
+
+		for i := int64(1); i <= 10; i++ {
+			insertSlot(i, "free")
+			insertSlot(100+i, "paid")
+		}
+
+		runCounts := func() map[string]int {
+			res, err := conf.V2.Scheduler().Concurrency().RunConcurrencyStrategy(ctx, strategy.TenantID, strategy)
+			require.NoError(t, err)
+
+			counts := make(map[string]int)
+
+			for _, task := range res.Queued {
+				counts[taskIdToKey[task.Id]]++
+			}
+
+			return counts
+		}
+
+		// each key fills up to max_concurrency * weight slots
+		assert.Equal(t, map[string]int{"free": 2, "paid": 6}, runCounts())
+
+		// filled slots count towards the limit, so nothing else is released
+		assert.Empty(t, runCounts())
+
+		// releasing a filled slot frees up a single slot for the same key
+		_, err = conf.Pool.Exec(
+			ctx,
+			`DELETE FROM v2_concurrency_slot WHERE task_id = ANY($1::bigint[]) AND strategy_id = $2`,
+			[]int64{1, 101}, strategy.ID,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]int{"free": 1, "paid": 1}, runCounts())
+
+		return nil
+	})
+}
@@ -2,6 +2,7 @@ package v2
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -285,238 +286,74 @@ func (d *queueRepository) MarkQueueItemsProcessed(ctx context.Context, r *Assign
 	return succeeded, failed, nil
 }
 
-// TODO: ADD RATE LIMITS
-// func (d *queueRepository) GetStepRunRateLimits(ctx context.Context, queueItems []*sqlcv2.QueueItem) (map[string]map[string]int32, error) {
-// 	ctx, span := telemetry.NewSpan(ctx, "get-step-run-rate-limits")
-// 	defer span.End()
+// GetTaskRateLimits returns the rate limit keys and units of the queue items, keyed by the task id. Keys
+// of dynamic rate limits are provisioned if they don't exist yet.
+func (d *queueRepository) GetTaskRateLimits(ctx context.Context, queueItems []*sqlcv2.V2QueueItem) (map[int64]map[string]int32, error) {
+	ctx, span := telemetry.NewSpan(ctx, "get-task-rate-limits")
+	defer span.End()
+
+	taskIds := make([]int64, 0, len(queueItems))
+	taskIdToStepId := make(map[int64]string)
+	stepsWithRateLimits := make(map[string]bool)
+
+	for _, item := range queueItems {
+		stepId := sqlchelpers.UUIDToStr(item.StepID)
+
+		// skip steps which we know don't have rate limits
+		if hasRateLimit, ok := d.cachedStepIdHasRateLimit.Get(stepId); ok && !hasRateLimit.(bool) {
+			continue
+		}
+
+		taskIds = append(taskIds, item.TaskID)
+		taskIdToStepId[item.TaskID] = stepId
+		stepsWithRateLimits[stepId] = false
+	}
+
+	if len(taskIds) == 0 {
+		return nil, nil
+	}
+
+	rls, err := d.queries.ListTaskRateLimits(ctx, d.pool, sqlcv2.ListTaskRateLimitsParams{
+		Tenantid: d.tenantId,
+		Taskids:  taskIds,
+	})
 
-// 	stepRunIds := make([]pgtype.UUID, 0, len(queueItems))
-// 	stepIds := make([]pgtype.UUID, 0, len(queueItems))
-// 	stepsWithRateLimits := make(map[string]bool)
+	if err != nil {
+		return nil, fmt.Errorf("could not list task rate limits: %w", err)
+	}
 
-// 	for _, item := range queueItems {
-// 		stepRunIds = append(stepRunIds, item.StepRunId)
-// 		stepIds = append(stepIds, item.StepId)
-// 	}
+	taskIdToKeyToUnits := make(map[int64]map[string]int32)
 
-// 	stepIdToStepRuns := make(map[string][]string)
-// 	stepRunIdToStepId := make(map[string]string)
+	for _, rl := range rls {
+		if _, ok := taskIdToKeyToUnits[rl.TaskID]; !ok {
+			taskIdToKeyToUnits[rl.TaskID] = make(map[string]int32)
+		}
 
-// 	for i, stepRunId := range stepRunIds {
-// 		stepId := sqlchelpers.UUIDToStr(stepIds[i])
-// 		stepRunIdStr := sqlchelpers.UUIDToStr(stepRunId)
+		taskIdToKeyToUnits[rl.TaskID][rl.Key] = rl.Units
+		stepsWithRateLimits[taskIdToStepId[rl.TaskID]] = true
+	}
 
-// 		if _, ok := stepIdToStepRuns[stepId]; !ok {
-// 			stepIdToStepRuns[stepId] = make([]string, 0)
-// 		}
+	// upsert the dynamic rate limits, which creates keys that don't exist yet and marks existing keys as
+	// used, so they aren't garbage collected while tasks are waiting on them
+	upsertParams := mergeDynamicRateLimits(rls)
 
-// 		stepIdToStepRuns[stepId] = append(stepIdToStepRuns[stepId], stepRunIdStr)
-// 		stepRunIdToStepId[stepRunIdStr] = stepId
-// 	}
+	if len(upsertParams.Keys) > 0 {
+		upsertParams.Tenantid = d.tenantId
 
-// 	// check if we have any rate limits for these step ids
-// 	skipRateLimiting := true
+		err = d.queries.UpsertDynamicRateLimits(ctx, d.pool, upsertParams)
 
-// 	for stepIdStr := range stepIdToStepRuns {
-// 		if hasRateLimit, ok := d.cachedStepIdHasRateLimit.Get(stepIdStr); !ok || hasRateLimit.(bool) {
-// 			skipRateLimiting = false
-// 			break
-// 		}
-// 	}
+		if err != nil {
+			return nil, fmt.Errorf("could not bulk upsert dynamic rate limits: %w", err)
+		}
+	}
 
-// 	if skipRateLimiting {
-// 		return nil, nil
-// 	}
-
-// 	// get all step run expression evals which correspond to rate limits, grouped by step run id
-// 	expressionEvals, err := d.queries.ListStepRunExpressionEvals(ctx, d.pool, stepRunIds)
-
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	stepRunAndGlobalKeyToKey := make(map[string]string)
-// 	stepRunToKeys := make(map[string][]string)
-
-// 	for _, eval := range expressionEvals {
-// 		stepRunId := sqlchelpers.UUIDToStr(eval.StepRunId)
-// 		globalKey := eval.Key
-
-// 		// Only append if this is a key expression. Note that we have a uniqueness constraint on
-// 		// the stepRunId, kind, and key, so we will not insert duplicate values into the array.
-// 		if eval.Kind == sqlcv2.StepExpressionKindDYNAMICRATELIMITKEY {
-// 			stepsWithRateLimits[stepRunIdToStepId[stepRunId]] = true
-
-// 			k := eval.ValueStr.String
-
-// 			if _, ok := stepRunToKeys[stepRunId]; !ok {
-// 				stepRunToKeys[stepRunId] = make([]string, 0)
-// 			}
-
-// 			stepRunToKeys[stepRunId] = append(stepRunToKeys[stepRunId], k)
-
-// 			stepRunAndGlobalKey := fmt.Sprintf("%s-%s", stepRunId, globalKey)
-
-// 			stepRunAndGlobalKeyToKey[stepRunAndGlobalKey] = k
-// 		}
-// 	}
-
-// 	rateLimitKeyToEvals := make(map[string][]*sqlcv2.StepRunExpressionEval)
-
-// 	for _, eval := range expressionEvals {
-// 		k := stepRunAndGlobalKeyToKey[fmt.Sprintf("%s-%s", sqlchelpers.UUIDToStr(eval.StepRunId), eval.Key)]
-
-// 		if _, ok := rateLimitKeyToEvals[k]; !ok {
-// 			rateLimitKeyToEvals[k] = make([]*sqlcv2.StepRunExpressionEval, 0)
-// 		}
-
-// 		rateLimitKeyToEvals[k] = append(rateLimitKeyToEvals[k], eval)
-// 	}
-
-// 	upsertRateLimitBulkParams := sqlcv2.UpsertRateLimitsBulkParams{
-// 		Tenantid: d.tenantId,
-// 	}
-
-// 	stepRunToKeyToUnits := make(map[string]map[string]int32)
-
-// 	for key, evals := range rateLimitKeyToEvals {
-// 		var duration string
-// 		var limitValue int
-// 		var skip bool
-
-// 		for _, eval := range evals {
-// 			// add to stepRunToKeyToUnits
-// 			stepRunId := sqlchelpers.UUIDToStr(eval.StepRunId)
-
-// 			// throw an error if there are multiple rate limits with the same keys, but different limit values or durations
-// 			if eval.Kind == sqlcv2.StepExpressionKindDYNAMICRATELIMITWINDOW {
-// 				if duration == "" {
-// 					duration = eval.ValueStr.String
-// 				} else if duration != eval.ValueStr.String {
-// 					largerDuration, err := getLargerDuration(duration, eval.ValueStr.String)
-
-// 					if err != nil {
-// 						skip = true
-// 						break
-// 					}
-
-// 					message := fmt.Sprintf("Multiple rate limits with key %s have different durations: %s vs %s. Using longer window %s.", key, duration, eval.ValueStr.String, largerDuration)
-// 					timeSeen := time.Now().UTC()
-// 					reason := sqlcv2.StepRunEventReasonRATELIMITERROR
-// 					severity := sqlcv2.StepRunEventSeverityWARNING
-// 					data := map[string]interface{}{}
-
-// 					buffErr := d.bulkEventBuffer.FireForget(sqlchelpers.UUIDToStr(d.tenantId), &repository.CreateStepRunEventOpts{
-// 						StepRunId:     sqlchelpers.UUIDToStr(eval.StepRunId),
-// 						EventMessage:  &message,
-// 						EventReason:   &reason,
-// 						EventSeverity: &severity,
-// 						Timestamp:     &timeSeen,
-// 						EventData:     data,
-// 					})
-
-// 					if buffErr != nil {
-// 						d.l.Err(buffErr).Msg("could not buffer step run event")
-// 					}
-
-// 					duration = largerDuration
-// 				}
-// 			}
-
-// 			if eval.Kind == sqlcv2.StepExpressionKindDYNAMICRATELIMITVALUE {
-// 				if limitValue == 0 {
-// 					limitValue = int(eval.ValueInt.Int32)
-// 				} else if limitValue != int(eval.ValueInt.Int32) {
-// 					message := fmt.Sprintf("Multiple rate limits with key %s have different limit values: %d vs %d. Using lower value %d.", key, limitValue, eval.ValueInt.Int32, min(limitValue, int(eval.ValueInt.Int32)))
-// 					timeSeen := time.Now().UTC()
-// 					reason := sqlcv2.StepRunEventReasonRATELIMITERROR
-// 					severity := sqlcv2.StepRunEventSeverityWARNING
-// 					data := map[string]interface{}{}
-
-// 					buffErr := d.bulkEventBuffer.FireForget(sqlchelpers.UUIDToStr(d.tenantId), &repository.CreateStepRunEventOpts{
-// 						StepRunId:     sqlchelpers.UUIDToStr(eval.StepRunId),
-// 						EventMessage:  &message,
-// 						EventReason:   &reason,
-// 						EventSeverity: &severity,
-// 						Timestamp:     &timeSeen,
-// 						EventData:     data,
-// 					})
-
-// 					if buffErr != nil {
-// 						d.l.Err(buffErr).Msg("could not buffer step run event")
-// 					}
-
-// 					limitValue = min(limitValue, int(eval.ValueInt.Int32))
-// 				}
-// 			}
-
-// 			if eval.Kind == sqlcv2.StepExpressionKindDYNAMICRATELIMITUNITS {
-// 				if _, ok := stepRunToKeyToUnits[stepRunId]; !ok {
-// 					stepRunToKeyToUnits[stepRunId] = make(map[string]int32)
-// 				}
-
-// 				stepRunToKeyToUnits[stepRunId][key] = eval.ValueInt.Int32
-// 			}
-// 		}
-
-// 		if skip {
-// 			continue
-// 		}
-
-// 		upsertRateLimitBulkParams.Keys = append(upsertRateLimitBulkParams.Keys, key)
-// 		upsertRateLimitBulkParams.Windows = append(upsertRateLimitBulkParams.Windows, getWindowParamFromDurString(duration))
-// 		upsertRateLimitBulkParams.Limitvalues = append(upsertRateLimitBulkParams.Limitvalues, int32(limitValue)) // nolint: gosec
-// 	}
-
-// 	var stepRateLimits []*sqlcv2.StepRateLimit
-
-// 	if len(upsertRateLimitBulkParams.Keys) > 0 {
-// 		// upsert all rate limits based on the keys, limit values, and durations
-// 		err = d.queries.UpsertRateLimitsBulk(ctx, d.pool, upsertRateLimitBulkParams)
-
-// 		if err != nil {
-// 			return nil, fmt.Errorf("could not bulk upsert dynamic rate limits: %w", err)
-// 		}
-// 	}
-
-// 	// get all existing static rate limits for steps to the mapping, mapping back from step ids to step run ids
-// 	uniqueStepIds := make([]pgtype.UUID, 0, len(stepIdToStepRuns))
-
-// 	for stepId := range stepIdToStepRuns {
-// 		uniqueStepIds = append(uniqueStepIds, sqlchelpers.UUIDFromStr(stepId))
-// 	}
-
-// 	stepRateLimits, err = d.queries.ListRateLimitsForSteps(ctx, d.pool, sqlcv2.ListRateLimitsForStepsParams{
-// 		Tenantid: d.tenantId,
-// 		Stepids:  uniqueStepIds,
-// 	})
-
-// 	if err != nil {
-// 		return nil, fmt.Errorf("could not list rate limits for steps: %w", err)
-// 	}
-
-// 	for _, row := range stepRateLimits {
-// 		stepsWithRateLimits[sqlchelpers.UUIDToStr(row.StepId)] = true
-// 		stepId := sqlchelpers.UUIDToStr(row.StepId)
-// 		stepRuns := stepIdToStepRuns[stepId]
-
-// 		for _, stepRunId := range stepRuns {
-// 			if _, ok := stepRunToKeyToUnits[stepRunId]; !ok {
-// 				stepRunToKeyToUnits[stepRunId] = make(map[string]int32)
-// 			}
-
-// 			stepRunToKeyToUnits[stepRunId][row.RateLimitKey] = row.Units
-// 		}
-// 	}
-
-// 	// store all step ids in the cache, so we can skip rate limiting for steps without rate limits
-// 	for stepId := range stepIdToStepRuns {
-// 		hasRateLimit := stepsWithRateLimits[stepId]
-// 		d.cachedStepIdHasRateLimit.Set(stepId, hasRateLimit)
-// 	}
-
-// 	return stepRunToKeyToUnits, nil
-// }
+	// store all step ids in the cache, so we can skip rate limiting for steps without rate limits
+	for stepId, hasRateLimit := range stepsWithRateLimits {
+		d.cachedStepIdHasRateLimit.Set(stepId, hasRateLimit)
+	}
+
+	return taskIdToKeyToUnits, nil
+}
 
 func (d *queueRepository) GetDesiredLabels(ctx context.Context, stepIds []pgtype.UUID) (map[string][]*sqlcv2.GetDesiredLabelsRow, error) {
 	ctx, span := telemetry.NewSpan(ctx, "get-desired-labels")
@@ -2,8 +2,13 @@ package v2
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
 )
 
 type rateLimitRepository struct {
@@ -17,76 +22,117 @@ func newRateLimitRepository(shared *sharedRepository) *rateLimitRepository {
 }
 
 func (d *rateLimitRepository) ListCandidateRateLimits(ctx context.Context, tenantId pgtype.UUID) ([]string, error) {
-	// TODO: FIX ME
-	return []string{}, nil
+	return d.queries.ListRateLimitKeysForTenant(ctx, d.pool, tenantId)
+}
+
+func (d *rateLimitRepository) UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]*RateLimitState, error) {
+	params := sqlcv2.RefillAndUseRateLimitsParams{
+		Tenantid: tenantId,
+		Keys:     make([]string, 0, len(updates)),
+		Units:    make([]int32, 0, len(updates)),
+	}
+
+	for k, v := range updates {
+		params.Keys = append(params.Keys, k)
+		params.Units = append(params.Units, int32(v)) // nolint: gosec
+	}
+
+	newRls, err := d.queries.RefillAndUseRateLimits(ctx, d.pool, params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]*RateLimitState, len(newRls))
+
+	for _, rl := range newRls {
+		res[rl.Key] = newRateLimitState(rl)
+	}
+
+	return res, nil
 }
 
-func (d *rateLimitRepository) UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]int, error) {
-	// TODO: FIX ME
-	return map[string]int{}, nil
+func (d *rateLimitRepository) DeleteIdleDynamicRateLimits(ctx context.Context, tenantId pgtype.UUID, idleSince time.Time) (int64, error) {
+	return d.queries.DeleteIdleDynamicRateLimits(ctx, d.pool, sqlcv2.DeleteIdleDynamicRateLimitsParams{
+		Tenantid: tenantId,
+		Idlesince: pgtype.Timestamp{
+			Time:  idleSince.UTC(),
+			Valid: true,
+		},
+	})
 }
 
-// TODO: ADD RATE LIMITS
-// func (d *rateLimitRepository) ListCandidateRateLimits(ctx context.Context, tenantId pgtype.UUID) ([]string, error) {
-// 	rls, err := d.queries.ListRateLimitsForTenantNoMutate(ctx, d.pool, dbsqlc.ListRateLimitsForTenantNoMutateParams{
-// 		Tenantid: tenantId,
-// 		Limit:    10000,
-// 	})
+func (d *rateLimitRepository) ListRateLimitUsage(ctx context.Context, tenantId pgtype.UUID, opts *ListRateLimitUsageOpts) (*ListRateLimitUsageResult, error) {
+	if err := d.v.Validate(opts); err != nil {
+		return nil, err
+	}
 
-// 	if err != nil {
-// 		return nil, err
-// 	}
+	params := sqlcv2.ListRateLimitsForTenantWithRefillParams{
+		Tenantid: tenantId,
+	}
 
-// 	ids := make([]string, len(rls))
+	countParams := sqlcv2.CountRateLimitsForTenantParams{
+		Tenantid: tenantId,
+	}
 
-// 	for i, rl := range rls {
-// 		ids[i] = rl.Key
-// 	}
+	if opts.Search != nil {
+		params.Search = sqlchelpers.TextFromStr(*opts.Search)
+		countParams.Search = params.Search
+	}
 
-// 	return ids, nil
-// }
+	if opts.Keys != nil {
+		params.Keys = opts.Keys
+		countParams.Keys = opts.Keys
+	}
 
-// func (d *rateLimitRepository) UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]int, error) {
-// 	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, d.pool, d.l, 5000)
+	if opts.Offset != nil {
+		params.Offset = pgtype.Int4{Int32: int32(*opts.Offset), Valid: true} // nolint: gosec
+	}
 
-// 	if err != nil {
-// 		return nil, err
-// 	}
+	if opts.Limit != nil {
+		params.Limit = pgtype.Int4{Int32: int32(*opts.Limit), Valid: true} // nolint: gosec
+	}
 
-// 	defer rollback()
+	rows, err := d.queries.ListRateLimitsForTenantWithRefill(ctx, d.pool, params)
 
-// 	params := dbsqlc.BulkUpdateRateLimitsParams{
-// 		Tenantid: tenantId,
-// 		Keys:     make([]string, 0, len(updates)),
-// 		Units:    make([]int32, 0, len(updates)),
-// 	}
+	if err != nil {
+		return nil, fmt.Errorf("could not list rate limits: %w", err)
+	}
 
-// 	for k, v := range updates {
-// 		params.Keys = append(params.Keys, k)
-// 		params.Units = append(params.Units, int32(v)) // nolint: gosec
-// 	}
+	count, err := d.queries.CountRateLimitsForTenant(ctx, d.pool, countParams)
 
-// 	_, err = d.queries.BulkUpdateRateLimits(ctx, tx, params)
+	if err != nil {
+		return nil, fmt.Errorf("could not count rate limits: %w", err)
+	}
 
-// 	if err != nil {
-// 		return nil, err
-// 	}
+	now := time.Now().UTC()
+	res := make([]*RateLimitUsage, 0, len(rows))
 
-// 	newRls, err := d.queries.ListRateLimitsForTenantWithMutate(ctx, tx, tenantId)
+	for _, row := range rows {
+		// the rows have the same columns as the rows which are returned to the scheduler
+		state := newRateLimitState((*sqlcv2.RefillAndUseRateLimitsRow)(row))
 
-// 	if err != nil {
-// 		return nil, err
-// 	}
+		usage := &RateLimitUsage{
+			Key:       row.Key,
+			Window:    row.Window,
+			Dynamic:   row.Dynamic,
+			Available: int(row.Available),
+			State:     state,
+		}
 
-// 	if err := commit(ctx); err != nil {
-// 		return nil, err
-// 	}
+		if row.LastUsedAt.Valid {
+			usage.LastUsedAt = row.LastUsedAt.Time
+		}
 
-// 	res := make(map[string]int, len(newRls))
+		if nextRefill, ok := state.NextRefill(now); ok {
+			usage.NextRefillAt = &nextRefill
+		}
 
-// 	for _, rl := range newRls {
-// 		res[rl.Key] = int(rl.Value)
-// 	}
+		res = append(res, usage)
+	}
 
-// 	return res, err
-// }
+	return &ListRateLimitUsageResult{
+		Rows:  res,
+		Count: int(count),
+	}, nil
+}
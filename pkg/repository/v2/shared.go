@@ -21,12 +21,10 @@ type sharedRepository struct {
 	celParser  *cel.CELParser
 }
 
-func newSharedRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) *sharedRepository {
+func newSharedRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, celParser *cel.CELParser) *sharedRepository {
 	queries := sqlcv2.New()
 	cache := cache.New(5 * time.Minute)
 
-	celParser := cel.NewCELParser()
-
 	return &sharedRepository{
 		pool:       pool,
 		v:          v,
@@ -503,4 +503,241 @@ SELECT
     *,
     'RUNNING' AS "operation"
 FROM
-    updated_slots;
\ No newline at end of file
+    updated_slots;
+
+-- name: RunWeightedRoundRobin :many
+-- Like RunGroupRoundRobin, but each key may fill up to maxRuns * weight slots, so the share of running
+-- tasks given to a key is proportional to its weight.
+WITH slots AS (
+    SELECT 
+        cs.task_id,
+        cs.task_inserted_at,
+        cs.task_retry_count,
+        cs.key,
+        cs.strategy_id,
+        cs.tenant_id,
+        cs.is_filled,
+        COALESCE((
+            SELECT
+                w.weight
+            FROM
+                v2_concurrency_key_weight w
+            WHERE
+                w.tenant_id = cs.tenant_id AND
+                w.strategy_id = cs.strategy_id AND
+                w.key = cs.key
+        ), 1) AS weight,
+        row_number() OVER (PARTITION BY cs.key ORDER BY cs.priority DESC, cs.task_id ASC, cs.task_inserted_at ASC) AS rn
+    FROM    
+        v2_concurrency_slot cs
+    WHERE
+        cs.tenant_id = @tenantId::uuid AND
+        cs.strategy_id = @strategyId::bigint AND
+        (
+            cs.schedule_timeout_at >= NOW() OR
+            cs.is_filled = TRUE
+        )
+), schedule_timeout_slots AS (
+    SELECT
+        *
+    FROM
+        v2_concurrency_slot
+    WHERE
+        tenant_id = @tenantId::uuid AND
+        strategy_id = @strategyId::bigint AND
+        schedule_timeout_at < NOW() AND
+        is_filled = FALSE
+    ORDER BY
+        task_id, task_inserted_at
+    FOR UPDATE
+), eligible_slots_per_group AS (
+    SELECT
+        task_id,
+        task_inserted_at,
+        task_retry_count,
+        tenant_id,
+        strategy_id,
+        key,
+        is_filled,
+        rn
+    FROM
+        slots
+    WHERE
+        rn <= weight::bigint * @maxRuns::int
+), eligible_slots AS (
+    SELECT
+        *
+    FROM
+        v2_concurrency_slot
+    WHERE 
+        (task_inserted_at, task_id, task_retry_count, tenant_id, strategy_id) IN (
+            SELECT
+                es.task_inserted_at,
+                es.task_id,
+                es.task_retry_count,
+                es.tenant_id,
+                es.strategy_id
+            FROM
+                eligible_slots_per_group es
+        )
+        AND is_filled = FALSE
+    ORDER BY
+        task_id, task_inserted_at
+    FOR UPDATE
+), updated_slots AS (
+    UPDATE
+        v2_concurrency_slot
+    SET
+        is_filled = TRUE
+    FROM
+        eligible_slots
+    WHERE
+        v2_concurrency_slot.task_id = eligible_slots.task_id AND
+        v2_concurrency_slot.task_inserted_at = eligible_slots.task_inserted_at AND
+        v2_concurrency_slot.task_retry_count = eligible_slots.task_retry_count AND
+        v2_concurrency_slot.tenant_id = eligible_slots.tenant_id AND
+        v2_concurrency_slot.strategy_id = eligible_slots.strategy_id AND
+        v2_concurrency_slot.key = eligible_slots.key
+    RETURNING
+        v2_concurrency_slot.*
+), deleted_slots AS (
+    DELETE FROM
+        v2_concurrency_slot
+    WHERE
+        (task_inserted_at, task_id, task_retry_count) IN (
+            SELECT
+                c.task_inserted_at,
+                c.task_id,
+                c.task_retry_count
+            FROM
+                schedule_timeout_slots c
+        )
+)
+SELECT
+    *,
+    'SCHEDULING_TIMED_OUT' AS "operation"
+FROM
+    schedule_timeout_slots
+UNION ALL
+SELECT
+    *,
+    'RUNNING' AS "operation"
+FROM
+    updated_slots;
+
+-- name: UpsertConcurrencyKeyWeights :exec
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@strategyIds::bigint[]) AS strategy_id,
+                unnest(@keys::text[]) AS key,
+                unnest(@weights::int[]) AS weight
+        ) AS subquery
+    ORDER BY
+        strategy_id, key
+)
+INSERT INTO v2_concurrency_key_weight (
+    tenant_id,
+    strategy_id,
+    key,
+    weight
+)
+SELECT
+    @tenantId::uuid,
+    i.strategy_id,
+    i.key,
+    i.weight
+FROM
+    input i
+ON CONFLICT (tenant_id, strategy_id, key) DO UPDATE
+SET
+    weight = EXCLUDED.weight,
+    updated_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteStaleConcurrencyKeyWeights :exec
+-- Deletes weights which haven't been updated recently and don't have any remaining slots.
+DELETE FROM
+    v2_concurrency_key_weight w
+WHERE
+    w.tenant_id = @tenantId::uuid AND
+    w.strategy_id = @strategyId::bigint AND
+    w.updated_at < NOW() - INTERVAL '5 minutes' AND
+    NOT EXISTS (
+        SELECT 1
+        FROM v2_concurrency_slot cs
+        WHERE
+            cs.tenant_id = w.tenant_id AND
+            cs.strategy_id = w.strategy_id AND
+            cs.key = w.key
+    );
+
+-- name: ListConcurrencyStrategyUsage :many
+-- Returns the active concurrency strategies of a tenant, along with the number of filled and unfilled
+-- concurrency slots and the number of distinct keys for each strategy.
+SELECT
+    sc.*,
+    w."name" AS "workflowName",
+    s."readableId" AS "stepReadableId",
+    COALESCE(slots."running", 0)::int AS "running",
+    COALESCE(slots."queued", 0)::int AS "queued",
+    COALESCE(slots."keys", 0)::int AS "keyCount"
+FROM
+    v2_step_concurrency sc
+JOIN
+    "Workflow" w ON w."id" = sc.workflow_id
+JOIN
+    "Step" s ON s."id" = sc.step_id
+LEFT JOIN LATERAL (
+    SELECT
+        COUNT(*) FILTER (WHERE cs.is_filled) AS "running",
+        COUNT(*) FILTER (WHERE NOT cs.is_filled) AS "queued",
+        COUNT(DISTINCT cs.key) AS "keys"
+    FROM
+        v2_concurrency_slot cs
+    WHERE
+        cs.tenant_id = sc.tenant_id
+        AND cs.strategy_id = sc.id
+) slots ON TRUE
+WHERE
+    sc.tenant_id = @tenantId::uuid
+    AND sc.is_active = TRUE
+ORDER BY
+    sc.id ASC;
+
+-- name: ListConcurrencyKeyUsage :many
+-- Returns the number of filled and unfilled concurrency slots for each key of the given strategies. Keys
+-- with the most unfilled slots are returned first, and at most @keyLimit keys are returned per strategy.
+WITH key_usage AS (
+    SELECT
+        cs.strategy_id,
+        cs.key,
+        COUNT(*) FILTER (WHERE cs.is_filled) AS "running",
+        COUNT(*) FILTER (WHERE NOT cs.is_filled) AS "queued"
+    FROM
+        v2_concurrency_slot cs
+    WHERE
+        cs.tenant_id = @tenantId::uuid
+        AND cs.strategy_id = ANY(@strategyIds::bigint[])
+    GROUP BY
+        cs.strategy_id, cs.key
+), ranked_keys AS (
+    SELECT
+        *,
+        ROW_NUMBER() OVER (PARTITION BY strategy_id ORDER BY "queued" DESC, "running" DESC, key ASC) AS rn
+    FROM
+        key_usage
+)
+SELECT
+    strategy_id,
+    key,
+    "running"::int AS "running",
+    "queued"::int AS "queued"
+FROM
+    ranked_keys
+WHERE
+    rn <= @keyLimit::int
+ORDER BY
+    strategy_id ASC, rn ASC;
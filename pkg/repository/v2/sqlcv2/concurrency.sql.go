@@ -94,6 +94,34 @@ func (q *Queries) ConcurrencyAdvisoryLock(ctx context.Context, db DBTX, key int6
 	return err
 }
 
+const deleteStaleConcurrencyKeyWeights = `-- name: DeleteStaleConcurrencyKeyWeights :exec
+DELETE FROM
+    v2_concurrency_key_weight w
+WHERE
+    w.tenant_id = $1::uuid AND
+    w.strategy_id = $2::bigint AND
+    w.updated_at < NOW() - INTERVAL '5 minutes' AND
+    NOT EXISTS (
+        SELECT 1
+        FROM v2_concurrency_slot cs
+        WHERE
+            cs.tenant_id = w.tenant_id AND
+            cs.strategy_id = w.strategy_id AND
+            cs.key = w.key
+    )
+`
+
+type DeleteStaleConcurrencyKeyWeightsParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Strategyid int64       `json:"strategyid"`
+}
+
+// Deletes weights which haven't been updated recently and don't have any remaining slots.
+func (q *Queries) DeleteStaleConcurrencyKeyWeights(ctx context.Context, db DBTX, arg DeleteStaleConcurrencyKeyWeightsParams) error {
+	_, err := db.Exec(ctx, deleteStaleConcurrencyKeyWeights, arg.Tenantid, arg.Strategyid)
+	return err
+}
+
 const listActiveConcurrencyStrategies = `-- name: ListActiveConcurrencyStrategies :many
 WITH earliest_workflow_versions AS (
     -- We select the earliest workflow versions with an active concurrency queue. The reason
@@ -113,7 +141,7 @@ WITH earliest_workflow_versions AS (
         "workflowId", wv."order" ASC
 )
 SELECT
-    sc.id, sc.workflow_id, sc.workflow_version_id, sc.step_id, sc.is_active, sc.strategy, sc.expression, sc.tenant_id, sc.max_concurrency
+    sc.id, sc.workflow_id, sc.workflow_version_id, sc.step_id, sc.is_active, sc.strategy, sc.expression, sc.tenant_id, sc.max_concurrency, sc.weight_expression, sc.cel_library_version
 FROM
     v2_step_concurrency sc
 JOIN
@@ -144,6 +172,82 @@ func (q *Queries) ListActiveConcurrencyStrategies(ctx context.Context, db DBTX,
 			&i.Expression,
 			&i.TenantID,
 			&i.MaxConcurrency,
+			&i.WeightExpression,
+			&i.CelLibraryVersion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConcurrencyKeyUsage = `-- name: ListConcurrencyKeyUsage :many
+WITH key_usage AS (
+    SELECT
+        cs.strategy_id,
+        cs.key,
+        COUNT(*) FILTER (WHERE cs.is_filled) AS "running",
+        COUNT(*) FILTER (WHERE NOT cs.is_filled) AS "queued"
+    FROM
+        v2_concurrency_slot cs
+    WHERE
+        cs.tenant_id = $2::uuid
+        AND cs.strategy_id = ANY($3::bigint[])
+    GROUP BY
+        cs.strategy_id, cs.key
+), ranked_keys AS (
+    SELECT
+        strategy_id, key, running, queued,
+        ROW_NUMBER() OVER (PARTITION BY strategy_id ORDER BY "queued" DESC, "running" DESC, key ASC) AS rn
+    FROM
+        key_usage
+)
+SELECT
+    strategy_id,
+    key,
+    "running"::int AS "running",
+    "queued"::int AS "queued"
+FROM
+    ranked_keys
+WHERE
+    rn <= $1::int
+ORDER BY
+    strategy_id ASC, rn ASC
+`
+
+type ListConcurrencyKeyUsageParams struct {
+	Keylimit    int32       `json:"keylimit"`
+	Tenantid    pgtype.UUID `json:"tenantid"`
+	Strategyids []int64     `json:"strategyids"`
+}
+
+type ListConcurrencyKeyUsageRow struct {
+	StrategyID int64  `json:"strategy_id"`
+	Key        string `json:"key"`
+	Running    int32  `json:"running"`
+	Queued     int32  `json:"queued"`
+}
+
+// Returns the number of filled and unfilled concurrency slots for each key of the given strategies. Keys
+// with the most unfilled slots are returned first, and at most @keyLimit keys are returned per strategy.
+func (q *Queries) ListConcurrencyKeyUsage(ctx context.Context, db DBTX, arg ListConcurrencyKeyUsageParams) ([]*ListConcurrencyKeyUsageRow, error) {
+	rows, err := db.Query(ctx, listConcurrencyKeyUsage, arg.Keylimit, arg.Tenantid, arg.Strategyids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListConcurrencyKeyUsageRow
+	for rows.Next() {
+		var i ListConcurrencyKeyUsageRow
+		if err := rows.Scan(
+			&i.StrategyID,
+			&i.Key,
+			&i.Running,
+			&i.Queued,
 		); err != nil {
 			return nil, err
 		}
@@ -157,7 +261,7 @@ func (q *Queries) ListActiveConcurrencyStrategies(ctx context.Context, db DBTX,
 
 const listConcurrencyStrategiesByStepId = `-- name: ListConcurrencyStrategiesByStepId :many
 SELECT
-    id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency
+    id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency, weight_expression, cel_library_version
 FROM
     v2_step_concurrency
 WHERE
@@ -191,6 +295,98 @@ func (q *Queries) ListConcurrencyStrategiesByStepId(ctx context.Context, db DBTX
 			&i.Expression,
 			&i.TenantID,
 			&i.MaxConcurrency,
+			&i.WeightExpression,
+			&i.CelLibraryVersion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConcurrencyStrategyUsage = `-- name: ListConcurrencyStrategyUsage :many
+SELECT
+    sc.id, sc.workflow_id, sc.workflow_version_id, sc.step_id, sc.is_active, sc.strategy, sc.expression, sc.tenant_id, sc.max_concurrency, sc.weight_expression, sc.cel_library_version,
+    w."name" AS "workflowName",
+    s."readableId" AS "stepReadableId",
+    COALESCE(slots."running", 0)::int AS "running",
+    COALESCE(slots."queued", 0)::int AS "queued",
+    COALESCE(slots."keys", 0)::int AS "keyCount"
+FROM
+    v2_step_concurrency sc
+JOIN
+    "Workflow" w ON w."id" = sc.workflow_id
+JOIN
+    "Step" s ON s."id" = sc.step_id
+LEFT JOIN LATERAL (
+    SELECT
+        COUNT(*) FILTER (WHERE cs.is_filled) AS "running",
+        COUNT(*) FILTER (WHERE NOT cs.is_filled) AS "queued",
+        COUNT(DISTINCT cs.key) AS "keys"
+    FROM
+        v2_concurrency_slot cs
+    WHERE
+        cs.tenant_id = sc.tenant_id
+        AND cs.strategy_id = sc.id
+) slots ON TRUE
+WHERE
+    sc.tenant_id = $1::uuid
+    AND sc.is_active = TRUE
+ORDER BY
+    sc.id ASC
+`
+
+type ListConcurrencyStrategyUsageRow struct {
+	ID                int64                 `json:"id"`
+	WorkflowID        pgtype.UUID           `json:"workflow_id"`
+	WorkflowVersionID pgtype.UUID           `json:"workflow_version_id"`
+	StepID            pgtype.UUID           `json:"step_id"`
+	IsActive          bool                  `json:"is_active"`
+	Strategy          V2ConcurrencyStrategy `json:"strategy"`
+	Expression        string                `json:"expression"`
+	TenantID          pgtype.UUID           `json:"tenant_id"`
+	MaxConcurrency    int32                 `json:"max_concurrency"`
+	WeightExpression  pgtype.Text           `json:"weight_expression"`
+	CelLibraryVersion int32                 `json:"cel_library_version"`
+	WorkflowName      string                `json:"workflowName"`
+	StepReadableId    pgtype.Text           `json:"stepReadableId"`
+	Running           int32                 `json:"running"`
+	Queued            int32                 `json:"queued"`
+	KeyCount          int32                 `json:"keyCount"`
+}
+
+// Returns the active concurrency strategies of a tenant, along with the number of filled and unfilled
+// concurrency slots and the number of distinct keys for each strategy.
+func (q *Queries) ListConcurrencyStrategyUsage(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*ListConcurrencyStrategyUsageRow, error) {
+	rows, err := db.Query(ctx, listConcurrencyStrategyUsage, tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListConcurrencyStrategyUsageRow
+	for rows.Next() {
+		var i ListConcurrencyStrategyUsageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkflowID,
+			&i.WorkflowVersionID,
+			&i.StepID,
+			&i.IsActive,
+			&i.Strategy,
+			&i.Expression,
+			&i.TenantID,
+			&i.MaxConcurrency,
+			&i.WeightExpression,
+			&i.CelLibraryVersion,
+			&i.WorkflowName,
+			&i.StepReadableId,
+			&i.Running,
+			&i.Queued,
+			&i.KeyCount,
 		); err != nil {
 			return nil, err
 		}
@@ -777,6 +973,185 @@ func (q *Queries) RunGroupRoundRobin(ctx context.Context, db DBTX, arg RunGroupR
 	return items, nil
 }
 
+const runWeightedRoundRobin = `-- name: RunWeightedRoundRobin :many
+WITH slots AS (
+    SELECT 
+        cs.task_id,
+        cs.task_inserted_at,
+        cs.task_retry_count,
+        cs.key,
+        cs.strategy_id,
+        cs.tenant_id,
+        cs.is_filled,
+        COALESCE((
+            SELECT
+                w.weight
+            FROM
+                v2_concurrency_key_weight w
+            WHERE
+                w.tenant_id = cs.tenant_id AND
+                w.strategy_id = cs.strategy_id AND
+                w.key = cs.key
+        ), 1) AS weight,
+        row_number() OVER (PARTITION BY cs.key ORDER BY cs.priority DESC, cs.task_id ASC, cs.task_inserted_at ASC) AS rn
+    FROM    
+        v2_concurrency_slot cs
+    WHERE
+        cs.tenant_id = $1::uuid AND
+        cs.strategy_id = $2::bigint AND
+        (
+            cs.schedule_timeout_at >= NOW() OR
+            cs.is_filled = TRUE
+        )
+), schedule_timeout_slots AS (
+    SELECT
+        task_id, task_inserted_at, task_retry_count, tenant_id, workflow_id, strategy_id, priority, key, is_filled, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at
+    FROM
+        v2_concurrency_slot
+    WHERE
+        tenant_id = $1::uuid AND
+        strategy_id = $2::bigint AND
+        schedule_timeout_at < NOW() AND
+        is_filled = FALSE
+    ORDER BY
+        task_id, task_inserted_at
+    FOR UPDATE
+), eligible_slots_per_group AS (
+    SELECT
+        task_id,
+        task_inserted_at,
+        task_retry_count,
+        tenant_id,
+        strategy_id,
+        key,
+        is_filled,
+        rn
+    FROM
+        slots
+    WHERE
+        rn <= weight::bigint * $3::int
+), eligible_slots AS (
+    SELECT
+        task_id, task_inserted_at, task_retry_count, tenant_id, workflow_id, strategy_id, priority, key, is_filled, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at
+    FROM
+        v2_concurrency_slot
+    WHERE 
+        (task_inserted_at, task_id, task_retry_count, tenant_id, strategy_id) IN (
+            SELECT
+                es.task_inserted_at,
+                es.task_id,
+                es.task_retry_count,
+                es.tenant_id,
+                es.strategy_id
+            FROM
+                eligible_slots_per_group es
+        )
+        AND is_filled = FALSE
+    ORDER BY
+        task_id, task_inserted_at
+    FOR UPDATE
+), updated_slots AS (
+    UPDATE
+        v2_concurrency_slot
+    SET
+        is_filled = TRUE
+    FROM
+        eligible_slots
+    WHERE
+        v2_concurrency_slot.task_id = eligible_slots.task_id AND
+        v2_concurrency_slot.task_inserted_at = eligible_slots.task_inserted_at AND
+        v2_concurrency_slot.task_retry_count = eligible_slots.task_retry_count AND
+        v2_concurrency_slot.tenant_id = eligible_slots.tenant_id AND
+        v2_concurrency_slot.strategy_id = eligible_slots.strategy_id AND
+        v2_concurrency_slot.key = eligible_slots.key
+    RETURNING
+        v2_concurrency_slot.task_id, v2_concurrency_slot.task_inserted_at, v2_concurrency_slot.task_retry_count, v2_concurrency_slot.tenant_id, v2_concurrency_slot.workflow_id, v2_concurrency_slot.strategy_id, v2_concurrency_slot.priority, v2_concurrency_slot.key, v2_concurrency_slot.is_filled, v2_concurrency_slot.next_strategy_ids, v2_concurrency_slot.next_keys, v2_concurrency_slot.queue_to_notify, v2_concurrency_slot.schedule_timeout_at
+), deleted_slots AS (
+    DELETE FROM
+        v2_concurrency_slot
+    WHERE
+        (task_inserted_at, task_id, task_retry_count) IN (
+            SELECT
+                c.task_inserted_at,
+                c.task_id,
+                c.task_retry_count
+            FROM
+                schedule_timeout_slots c
+        )
+)
+SELECT
+    task_id, task_inserted_at, task_retry_count, tenant_id, workflow_id, strategy_id, priority, key, is_filled, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at,
+    'SCHEDULING_TIMED_OUT' AS "operation"
+FROM
+    schedule_timeout_slots
+UNION ALL
+SELECT
+    task_id, task_inserted_at, task_retry_count, tenant_id, workflow_id, strategy_id, priority, key, is_filled, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at,
+    'RUNNING' AS "operation"
+FROM
+    updated_slots
+`
+
+type RunWeightedRoundRobinParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Strategyid int64       `json:"strategyid"`
+	Maxruns    int32       `json:"maxruns"`
+}
+
+type RunWeightedRoundRobinRow struct {
+	TaskID            int64              `json:"task_id"`
+	TaskInsertedAt    pgtype.Timestamptz `json:"task_inserted_at"`
+	TaskRetryCount    int32              `json:"task_retry_count"`
+	TenantID          pgtype.UUID        `json:"tenant_id"`
+	WorkflowID        pgtype.UUID        `json:"workflow_id"`
+	StrategyID        int64              `json:"strategy_id"`
+	Priority          int32              `json:"priority"`
+	Key               string             `json:"key"`
+	IsFilled          bool               `json:"is_filled"`
+	NextStrategyIds   []int64            `json:"next_strategy_ids"`
+	NextKeys          []string           `json:"next_keys"`
+	QueueToNotify     string             `json:"queue_to_notify"`
+	ScheduleTimeoutAt pgtype.Timestamp   `json:"schedule_timeout_at"`
+	Operation         string             `json:"operation"`
+}
+
+// Like RunGroupRoundRobin, but each key may fill up to maxRuns * weight slots, so the share of running
+// tasks given to a key is proportional to its weight.
+func (q *Queries) RunWeightedRoundRobin(ctx context.Context, db DBTX, arg RunWeightedRoundRobinParams) ([]*RunWeightedRoundRobinRow, error) {
+	rows, err := db.Query(ctx, runWeightedRoundRobin, arg.Tenantid, arg.Strategyid, arg.Maxruns)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*RunWeightedRoundRobinRow
+	for rows.Next() {
+		var i RunWeightedRoundRobinRow
+		if err := rows.Scan(
+			&i.TaskID,
+			&i.TaskInsertedAt,
+			&i.TaskRetryCount,
+			&i.TenantID,
+			&i.WorkflowID,
+			&i.StrategyID,
+			&i.Priority,
+			&i.Key,
+			&i.IsFilled,
+			&i.NextStrategyIds,
+			&i.NextKeys,
+			&i.QueueToNotify,
+			&i.ScheduleTimeoutAt,
+			&i.Operation,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setConcurrencyStrategyInactive = `-- name: SetConcurrencyStrategyInactive :exec
 UPDATE
     v2_step_concurrency
@@ -805,3 +1180,53 @@ func (q *Queries) SetConcurrencyStrategyInactive(ctx context.Context, db DBTX, a
 	)
 	return err
 }
+
+const upsertConcurrencyKeyWeights = `-- name: UpsertConcurrencyKeyWeights :exec
+WITH input AS (
+    SELECT
+        strategy_id, key, weight
+    FROM
+        (
+            SELECT
+                unnest($2::bigint[]) AS strategy_id,
+                unnest($3::text[]) AS key,
+                unnest($4::int[]) AS weight
+        ) AS subquery
+    ORDER BY
+        strategy_id, key
+)
+INSERT INTO v2_concurrency_key_weight (
+    tenant_id,
+    strategy_id,
+    key,
+    weight
+)
+SELECT
+    $1::uuid,
+    i.strategy_id,
+    i.key,
+    i.weight
+FROM
+    input i
+ON CONFLICT (tenant_id, strategy_id, key) DO UPDATE
+SET
+    weight = EXCLUDED.weight,
+    updated_at = CURRENT_TIMESTAMP
+`
+
+type UpsertConcurrencyKeyWeightsParams struct {
+	Tenantid    pgtype.UUID `json:"tenantid"`
+	Strategyids []int64     `json:"strategyids"`
+	Keys        []string    `json:"keys"`
+	Weights     []int32     `json:"weights"`
+}
+
+func (q *Queries) UpsertConcurrencyKeyWeights(ctx context.Context, db DBTX, arg UpsertConcurrencyKeyWeightsParams) error {
+	_, err := db.Exec(ctx, upsertConcurrencyKeyWeights,
+		arg.Tenantid,
+		arg.Strategyids,
+		arg.Keys,
+		arg.Weights,
+	)
+	return err
+}
@@ -71,6 +71,9 @@ func (r iteratorForCreateMatchConditions) Values() ([]interface{}, error) {
 		r.rows[0].OrGroupID,
 		r.rows[0].Expression,
 		r.rows[0].Action,
+		r.rows[0].IsSatisfied,
+		r.rows[0].Data,
+		r.rows[0].CelLibraryVersion,
 	}, nil
 }
 
@@ -79,5 +82,5 @@ func (r iteratorForCreateMatchConditions) Err() error {
 }
 
 func (q *Queries) CreateMatchConditions(ctx context.Context, db DBTX, arg []CreateMatchConditionsParams) (int64, error) {
-	return db.CopyFrom(ctx, []string{"v2_match_condition"}, []string{"v2_match_id", "tenant_id", "event_type", "event_key", "or_group_id", "expression", "action"}, &iteratorForCreateMatchConditions{rows: arg})
+	return db.CopyFrom(ctx, []string{"v2_match_condition"}, []string{"v2_match_id", "tenant_id", "event_type", "event_key", "or_group_id", "expression", "action", "is_satisfied", "data", "cel_library_version"}, &iteratorForCreateMatchConditions{rows: arg})
 }
@@ -33,6 +33,47 @@ FROM
 RETURNING
     *;
 
+-- name: CreateMatchesForDAGReplays :many
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@tenantIds::uuid[]) AS tenant_id,
+                unnest(cast(@kinds::text[] as v2_match_kind[])) AS kind,
+                unnest(@triggerDagIds::bigint[]) AS trigger_dag_id,
+                unnest(@triggerDagInsertedAts::timestamptz[]) AS trigger_dag_inserted_at,
+                unnest(@triggerStepIds::uuid[]) AS trigger_step_id,
+                unnest(@triggerExternalIds::uuid[]) AS trigger_external_id,
+                unnest(@triggerExistingTaskIds::bigint[]) AS trigger_existing_task_id,
+                unnest(@triggerExistingTaskInsertedAts::timestamptz[]) AS trigger_existing_task_inserted_at
+        ) AS subquery
+)
+INSERT INTO v2_match (
+    tenant_id,
+    kind,
+    trigger_dag_id,
+    trigger_dag_inserted_at,
+    trigger_step_id,
+    trigger_external_id,
+    trigger_existing_task_id,
+    trigger_existing_task_inserted_at
+)
+SELECT
+    i.tenant_id,
+    i.kind,
+    i.trigger_dag_id,
+    i.trigger_dag_inserted_at,
+    i.trigger_step_id,
+    i.trigger_external_id,
+    i.trigger_existing_task_id,
+    i.trigger_existing_task_inserted_at
+FROM
+    input i
+RETURNING
+    *;
+
 -- name: CreateMatchesForSignalTriggers :many
 WITH input AS (
     SELECT
@@ -70,7 +111,10 @@ INSERT INTO v2_match_condition (
     event_key,
     or_group_id,
     expression,
-    action
+    action,
+    is_satisfied,
+    data,
+    cel_library_version
 ) VALUES (
     $1,
     $2,
@@ -78,9 +122,39 @@ INSERT INTO v2_match_condition (
     $4,
     $5,
     $6, 
-    $7
+    $7,
+    $8,
+    $9,
+    $10
 );
 
+-- name: ListMatchesForDAG :many
+SELECT
+    *
+FROM
+    v2_match
+WHERE
+    tenant_id = @tenantId::uuid
+    AND trigger_dag_id = @dagId::bigint
+    AND trigger_dag_inserted_at = @dagInsertedAt::timestamptz;
+
+-- name: DeleteMatchesForDAGSteps :exec
+WITH deleted_matches AS (
+    DELETE FROM
+        v2_match
+    WHERE
+        tenant_id = @tenantId::uuid
+        AND trigger_dag_id = @dagId::bigint
+        AND trigger_dag_inserted_at = @dagInsertedAt::timestamptz
+        AND trigger_step_id = ANY(@stepIds::uuid[])
+    RETURNING
+        id
+)
+DELETE FROM
+    v2_match_condition
+WHERE
+    v2_match_id IN (SELECT id FROM deleted_matches);
+
 -- name: ListMatchConditionsForEvent :many
 SELECT
     v2_match_id,
@@ -88,7 +162,8 @@ SELECT
     registered_at,
     event_type,
     event_key,
-    expression
+    expression,
+    cel_library_version
 FROM
     v2_match_condition m
 WHERE
@@ -220,3 +295,49 @@ SELECT
     *
 FROM
     result_matches;
+
+-- name: CreateDurableSleep :many
+WITH input AS (
+    SELECT
+        sleep_duration
+    FROM
+        (
+            SELECT
+                unnest(@sleepDurations::text[]) as sleep_duration
+        ) as subquery
+)
+INSERT INTO v2_durable_sleep (
+    tenant_id,
+    sleep_until,
+    sleep_duration
+)
+SELECT
+    @tenantId::uuid,
+    CURRENT_TIMESTAMP + convert_duration_to_interval(sleep_duration),
+    sleep_duration
+FROM
+    input
+RETURNING
+    *;
+
+-- name: PopDurableSleep :many
+WITH to_delete AS (
+    SELECT
+        *
+    FROM
+        v2_durable_sleep
+    WHERE
+        tenant_id = @tenantId::uuid
+        AND sleep_until <= CURRENT_TIMESTAMP
+    ORDER BY
+        id ASC
+    LIMIT
+        COALESCE(sqlc.narg('limit')::integer, 1000)
+    FOR UPDATE
+)
+DELETE FROM
+    v2_durable_sleep
+WHERE
+    (tenant_id, sleep_until, id) IN (SELECT tenant_id, sleep_until, id FROM to_delete)
+RETURNING
+    *;
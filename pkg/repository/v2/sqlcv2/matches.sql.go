@@ -11,14 +11,167 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const createDurableSleep = `-- name: CreateDurableSleep :many
+WITH input AS (
+    SELECT
+        sleep_duration
+    FROM
+        (
+            SELECT
+                unnest($2::text[]) as sleep_duration
+        ) as subquery
+)
+INSERT INTO v2_durable_sleep (
+    tenant_id,
+    sleep_until,
+    sleep_duration
+)
+SELECT
+    $1::uuid,
+    CURRENT_TIMESTAMP + convert_duration_to_interval(sleep_duration),
+    sleep_duration
+FROM
+    input
+RETURNING
+    id, tenant_id, sleep_until, sleep_duration
+`
+
+type CreateDurableSleepParams struct {
+	Tenantid       pgtype.UUID `json:"tenantid"`
+	Sleepdurations []string    `json:"sleepdurations"`
+}
+
+func (q *Queries) CreateDurableSleep(ctx context.Context, db DBTX, arg CreateDurableSleepParams) ([]*V2DurableSleep, error) {
+	rows, err := db.Query(ctx, createDurableSleep, arg.Tenantid, arg.Sleepdurations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V2DurableSleep
+	for rows.Next() {
+		var i V2DurableSleep
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.SleepUntil,
+			&i.SleepDuration,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 type CreateMatchConditionsParams struct {
-	V2MatchID  int64                  `json:"v2_match_id"`
-	TenantID   pgtype.UUID            `json:"tenant_id"`
-	EventType  V2EventType            `json:"event_type"`
-	EventKey   string                 `json:"event_key"`
-	OrGroupID  pgtype.UUID            `json:"or_group_id"`
-	Expression pgtype.Text            `json:"expression"`
-	Action     V2MatchConditionAction `json:"action"`
+	V2MatchID         int64                  `json:"v2_match_id"`
+	TenantID          pgtype.UUID            `json:"tenant_id"`
+	EventType         V2EventType            `json:"event_type"`
+	EventKey          string                 `json:"event_key"`
+	OrGroupID         pgtype.UUID            `json:"or_group_id"`
+	Expression        pgtype.Text            `json:"expression"`
+	Action            V2MatchConditionAction `json:"action"`
+	IsSatisfied       bool                   `json:"is_satisfied"`
+	Data              []byte                 `json:"data"`
+	CelLibraryVersion int32                  `json:"cel_library_version"`
+}
+
+const createMatchesForDAGReplays = `-- name: CreateMatchesForDAGReplays :many
+WITH input AS (
+    SELECT
+        tenant_id, kind, trigger_dag_id, trigger_dag_inserted_at, trigger_step_id, trigger_external_id, trigger_existing_task_id, trigger_existing_task_inserted_at
+    FROM
+        (
+            SELECT
+                unnest($1::uuid[]) AS tenant_id,
+                unnest(cast($2::text[] as v2_match_kind[])) AS kind,
+                unnest($3::bigint[]) AS trigger_dag_id,
+                unnest($4::timestamptz[]) AS trigger_dag_inserted_at,
+                unnest($5::uuid[]) AS trigger_step_id,
+                unnest($6::uuid[]) AS trigger_external_id,
+                unnest($7::bigint[]) AS trigger_existing_task_id,
+                unnest($8::timestamptz[]) AS trigger_existing_task_inserted_at
+        ) AS subquery
+)
+INSERT INTO v2_match (
+    tenant_id,
+    kind,
+    trigger_dag_id,
+    trigger_dag_inserted_at,
+    trigger_step_id,
+    trigger_external_id,
+    trigger_existing_task_id,
+    trigger_existing_task_inserted_at
+)
+SELECT
+    i.tenant_id,
+    i.kind,
+    i.trigger_dag_id,
+    i.trigger_dag_inserted_at,
+    i.trigger_step_id,
+    i.trigger_external_id,
+    i.trigger_existing_task_id,
+    i.trigger_existing_task_inserted_at
+FROM
+    input i
+RETURNING
+    id, tenant_id, kind, is_satisfied, signal_target_id, signal_key, trigger_dag_id, trigger_dag_inserted_at, trigger_step_id, trigger_external_id, trigger_existing_task_id, trigger_existing_task_inserted_at
+`
+
+type CreateMatchesForDAGReplaysParams struct {
+	Tenantids                      []pgtype.UUID        `json:"tenantids"`
+	Kinds                          []string             `json:"kinds"`
+	Triggerdagids                  []int64              `json:"triggerdagids"`
+	Triggerdaginsertedats          []pgtype.Timestamptz `json:"triggerdaginsertedats"`
+	Triggerstepids                 []pgtype.UUID        `json:"triggerstepids"`
+	Triggerexternalids             []pgtype.UUID        `json:"triggerexternalids"`
+	Triggerexistingtaskids         []int64              `json:"triggerexistingtaskids"`
+	Triggerexistingtaskinsertedats []pgtype.Timestamptz `json:"triggerexistingtaskinsertedats"`
+}
+
+func (q *Queries) CreateMatchesForDAGReplays(ctx context.Context, db DBTX, arg CreateMatchesForDAGReplaysParams) ([]*V2Match, error) {
+	rows, err := db.Query(ctx, createMatchesForDAGReplays,
+		arg.Tenantids,
+		arg.Kinds,
+		arg.Triggerdagids,
+		arg.Triggerdaginsertedats,
+		arg.Triggerstepids,
+		arg.Triggerexternalids,
+		arg.Triggerexistingtaskids,
+		arg.Triggerexistingtaskinsertedats,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V2Match
+	for rows.Next() {
+		var i V2Match
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Kind,
+			&i.IsSatisfied,
+			&i.SignalTargetID,
+			&i.SignalKey,
+			&i.TriggerDagID,
+			&i.TriggerDagInsertedAt,
+			&i.TriggerStepID,
+			&i.TriggerExternalID,
+			&i.TriggerExistingTaskID,
+			&i.TriggerExistingTaskInsertedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const createMatchesForDAGTriggers = `-- name: CreateMatchesForDAGTriggers :many
@@ -54,7 +207,7 @@ SELECT
 FROM
     input i
 RETURNING
-    id, tenant_id, kind, is_satisfied, signal_target_id, signal_key, trigger_dag_id, trigger_dag_inserted_at, trigger_step_id, trigger_external_id
+    id, tenant_id, kind, is_satisfied, signal_target_id, signal_key, trigger_dag_id, trigger_dag_inserted_at, trigger_step_id, trigger_external_id, trigger_existing_task_id, trigger_existing_task_inserted_at
 `
 
 type CreateMatchesForDAGTriggersParams struct {
@@ -93,6 +246,8 @@ func (q *Queries) CreateMatchesForDAGTriggers(ctx context.Context, db DBTX, arg
 			&i.TriggerDagInsertedAt,
 			&i.TriggerStepID,
 			&i.TriggerExternalID,
+			&i.TriggerExistingTaskID,
+			&i.TriggerExistingTaskInsertedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -131,7 +286,7 @@ SELECT
 FROM
     input i
 RETURNING
-    id, tenant_id, kind, is_satisfied, signal_target_id, signal_key, trigger_dag_id, trigger_dag_inserted_at, trigger_step_id, trigger_external_id
+    id, tenant_id, kind, is_satisfied, signal_target_id, signal_key, trigger_dag_id, trigger_dag_inserted_at, trigger_step_id, trigger_external_id, trigger_existing_task_id, trigger_existing_task_inserted_at
 `
 
 type CreateMatchesForSignalTriggersParams struct {
@@ -166,6 +321,8 @@ func (q *Queries) CreateMatchesForSignalTriggers(ctx context.Context, db DBTX, a
 			&i.TriggerDagInsertedAt,
 			&i.TriggerStepID,
 			&i.TriggerExternalID,
+			&i.TriggerExistingTaskID,
+			&i.TriggerExistingTaskInsertedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -177,6 +334,41 @@ func (q *Queries) CreateMatchesForSignalTriggers(ctx context.Context, db DBTX, a
 	return items, nil
 }
 
+const deleteMatchesForDAGSteps = `-- name: DeleteMatchesForDAGSteps :exec
+WITH deleted_matches AS (
+    DELETE FROM
+        v2_match
+    WHERE
+        tenant_id = $1::uuid
+        AND trigger_dag_id = $2::bigint
+        AND trigger_dag_inserted_at = $3::timestamptz
+        AND trigger_step_id = ANY($4::uuid[])
+    RETURNING
+        id
+)
+DELETE FROM
+    v2_match_condition
+WHERE
+    v2_match_id IN (SELECT id FROM deleted_matches)
+`
+
+type DeleteMatchesForDAGStepsParams struct {
+	Tenantid      pgtype.UUID        `json:"tenantid"`
+	Dagid         int64              `json:"dagid"`
+	Daginsertedat pgtype.Timestamptz `json:"daginsertedat"`
+	Stepids       []pgtype.UUID      `json:"stepids"`
+}
+
+func (q *Queries) DeleteMatchesForDAGSteps(ctx context.Context, db DBTX, arg DeleteMatchesForDAGStepsParams) error {
+	_, err := db.Exec(ctx, deleteMatchesForDAGSteps,
+		arg.Tenantid,
+		arg.Dagid,
+		arg.Daginsertedat,
+		arg.Stepids,
+	)
+	return err
+}
+
 const getSatisfiedMatchConditions = `-- name: GetSatisfiedMatchConditions :many
 WITH input AS (
     SELECT
@@ -266,7 +458,8 @@ SELECT
     registered_at,
     event_type,
     event_key,
-    expression
+    expression,
+    cel_library_version
 FROM
     v2_match_condition m
 WHERE
@@ -283,12 +476,13 @@ type ListMatchConditionsForEventParams struct {
 }
 
 type ListMatchConditionsForEventRow struct {
-	V2MatchID    int64              `json:"v2_match_id"`
-	ID           int64              `json:"id"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	EventType    V2EventType        `json:"event_type"`
-	EventKey     string             `json:"event_key"`
-	Expression   pgtype.Text        `json:"expression"`
+	V2MatchID         int64              `json:"v2_match_id"`
+	ID                int64              `json:"id"`
+	RegisteredAt      pgtype.Timestamptz `json:"registered_at"`
+	EventType         V2EventType        `json:"event_type"`
+	EventKey          string             `json:"event_key"`
+	Expression        pgtype.Text        `json:"expression"`
+	CelLibraryVersion int32              `json:"cel_library_version"`
 }
 
 func (q *Queries) ListMatchConditionsForEvent(ctx context.Context, db DBTX, arg ListMatchConditionsForEventParams) ([]*ListMatchConditionsForEventRow, error) {
@@ -307,6 +501,110 @@ func (q *Queries) ListMatchConditionsForEvent(ctx context.Context, db DBTX, arg
 			&i.EventType,
 			&i.EventKey,
 			&i.Expression,
+			&i.CelLibraryVersion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMatchesForDAG = `-- name: ListMatchesForDAG :many
+SELECT
+    id, tenant_id, kind, is_satisfied, signal_target_id, signal_key, trigger_dag_id, trigger_dag_inserted_at, trigger_step_id, trigger_external_id, trigger_existing_task_id, trigger_existing_task_inserted_at
+FROM
+    v2_match
+WHERE
+    tenant_id = $1::uuid
+    AND trigger_dag_id = $2::bigint
+    AND trigger_dag_inserted_at = $3::timestamptz
+`
+
+type ListMatchesForDAGParams struct {
+	Tenantid      pgtype.UUID        `json:"tenantid"`
+	Dagid         int64              `json:"dagid"`
+	Daginsertedat pgtype.Timestamptz `json:"daginsertedat"`
+}
+
+func (q *Queries) ListMatchesForDAG(ctx context.Context, db DBTX, arg ListMatchesForDAGParams) ([]*V2Match, error) {
+	rows, err := db.Query(ctx, listMatchesForDAG, arg.Tenantid, arg.Dagid, arg.Daginsertedat)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V2Match
+	for rows.Next() {
+		var i V2Match
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Kind,
+			&i.IsSatisfied,
+			&i.SignalTargetID,
+			&i.SignalKey,
+			&i.TriggerDagID,
+			&i.TriggerDagInsertedAt,
+			&i.TriggerStepID,
+			&i.TriggerExternalID,
+			&i.TriggerExistingTaskID,
+			&i.TriggerExistingTaskInsertedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const popDurableSleep = `-- name: PopDurableSleep :many
+WITH to_delete AS (
+    SELECT
+        id, tenant_id, sleep_until, sleep_duration
+    FROM
+        v2_durable_sleep
+    WHERE
+        tenant_id = $1::uuid
+        AND sleep_until <= CURRENT_TIMESTAMP
+    ORDER BY
+        id ASC
+    LIMIT
+        COALESCE($2::integer, 1000)
+    FOR UPDATE
+)
+DELETE FROM
+    v2_durable_sleep
+WHERE
+    (tenant_id, sleep_until, id) IN (SELECT tenant_id, sleep_until, id FROM to_delete)
+RETURNING
+    id, tenant_id, sleep_until, sleep_duration
+`
+
+type PopDurableSleepParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Limit    pgtype.Int4 `json:"limit"`
+}
+
+func (q *Queries) PopDurableSleep(ctx context.Context, db DBTX, arg PopDurableSleepParams) ([]*V2DurableSleep, error) {
+	rows, err := db.Query(ctx, popDurableSleep, arg.Tenantid, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V2DurableSleep
+	for rows.Next() {
+		var i V2DurableSleep
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.SleepUntil,
+			&i.SleepDuration,
 		); err != nil {
 			return nil, err
 		}
@@ -345,7 +643,7 @@ WITH match_counts AS (
     GROUP BY v2_match_id
 ), result_matches AS (
     SELECT
-        m.id, m.tenant_id, m.kind, m.is_satisfied, m.signal_target_id, m.signal_key, m.trigger_dag_id, m.trigger_dag_inserted_at, m.trigger_step_id, m.trigger_external_id,
+        m.id, m.tenant_id, m.kind, m.is_satisfied, m.signal_target_id, m.signal_key, m.trigger_dag_id, m.trigger_dag_inserted_at, m.trigger_step_id, m.trigger_external_id, m.trigger_existing_task_id, m.trigger_existing_task_inserted_at,
         mc.aggregated_data::jsonb as mc_aggregated_data
     FROM
         v2_match m
@@ -379,23 +677,25 @@ WITH match_counts AS (
         (v2_match_id, id) IN (SELECT v2_match_id, id FROM locked_conditions)
 )
 SELECT
-    id, tenant_id, kind, is_satisfied, signal_target_id, signal_key, trigger_dag_id, trigger_dag_inserted_at, trigger_step_id, trigger_external_id, mc_aggregated_data
+    id, tenant_id, kind, is_satisfied, signal_target_id, signal_key, trigger_dag_id, trigger_dag_inserted_at, trigger_step_id, trigger_external_id, trigger_existing_task_id, trigger_existing_task_inserted_at, mc_aggregated_data
 FROM
     result_matches
 `
 
 type SaveSatisfiedMatchConditionsRow struct {
-	ID                   int64              `json:"id"`
-	TenantID             pgtype.UUID        `json:"tenant_id"`
-	Kind                 V2MatchKind        `json:"kind"`
-	IsSatisfied          bool               `json:"is_satisfied"`
-	SignalTargetID       pgtype.Int8        `json:"signal_target_id"`
-	SignalKey            pgtype.Text        `json:"signal_key"`
-	TriggerDagID         pgtype.Int8        `json:"trigger_dag_id"`
-	TriggerDagInsertedAt pgtype.Timestamptz `json:"trigger_dag_inserted_at"`
-	TriggerStepID        pgtype.UUID        `json:"trigger_step_id"`
-	TriggerExternalID    pgtype.UUID        `json:"trigger_external_id"`
-	McAggregatedData     []byte             `json:"mc_aggregated_data"`
+	ID                            int64              `json:"id"`
+	TenantID                      pgtype.UUID        `json:"tenant_id"`
+	Kind                          V2MatchKind        `json:"kind"`
+	IsSatisfied                   bool               `json:"is_satisfied"`
+	SignalTargetID                pgtype.Int8        `json:"signal_target_id"`
+	SignalKey                     pgtype.Text        `json:"signal_key"`
+	TriggerDagID                  pgtype.Int8        `json:"trigger_dag_id"`
+	TriggerDagInsertedAt          pgtype.Timestamptz `json:"trigger_dag_inserted_at"`
+	TriggerStepID                 pgtype.UUID        `json:"trigger_step_id"`
+	TriggerExternalID             pgtype.UUID        `json:"trigger_external_id"`
+	TriggerExistingTaskID         pgtype.Int8        `json:"trigger_existing_task_id"`
+	TriggerExistingTaskInsertedAt pgtype.Timestamptz `json:"trigger_existing_task_inserted_at"`
+	McAggregatedData              []byte             `json:"mc_aggregated_data"`
 }
 
 // NOTE: we have to break this into a separate query because CTEs can't see modified rows
@@ -422,6 +722,8 @@ func (q *Queries) SaveSatisfiedMatchConditions(ctx context.Context, db DBTX, mat
 			&i.TriggerDagInsertedAt,
 			&i.TriggerStepID,
 			&i.TriggerExternalID,
+			&i.TriggerExistingTaskID,
+			&i.TriggerExistingTaskInsertedAt,
 			&i.McAggregatedData,
 		); err != nil {
 			return nil, err
@@ -55,6 +55,7 @@ WITH worker_max_runs AS (
     WHERE
         tenant_id = @tenantId::uuid
         AND worker_id = ANY(@workerIds::uuid[])
+        AND NOT slot_released
     GROUP BY
         worker_id
 )
@@ -279,4 +280,69 @@ WITH input AS (
 DELETE FROM
     v2_queue_item
 WHERE
-    id = ANY(SELECT id FROM locked_qis);
\ No newline at end of file
+    id = ANY(SELECT id FROM locked_qis);
+-- name: ListQueueItemsForTask :many
+SELECT
+    *
+FROM
+    v2_queue_item
+WHERE
+    tenant_id = @tenantId::uuid
+    AND task_id = @taskId::bigint
+    AND retry_count = @retryCount::int;
+
+-- name: ListConcurrencySlotsForTask :many
+-- Returns the concurrency slots of a task, along with the strategy of each slot and the number of filled
+-- slots for the slot's key.
+SELECT
+    cs.strategy_id,
+    cs.key,
+    cs.is_filled,
+    cs.schedule_timeout_at,
+    sc.strategy,
+    sc.expression,
+    sc.max_concurrency,
+    (
+        SELECT
+            COUNT(*)
+        FROM
+            v2_concurrency_slot cs2
+        WHERE
+            cs2.tenant_id = cs.tenant_id
+            AND cs2.strategy_id = cs.strategy_id
+            AND cs2.key = cs.key
+            AND cs2.is_filled
+    )::int AS "filledSlots"
+FROM
+    v2_concurrency_slot cs
+JOIN
+    v2_step_concurrency sc ON sc.workflow_id = cs.workflow_id AND sc.id = cs.strategy_id
+WHERE
+    cs.tenant_id = @tenantId::uuid
+    AND cs.task_id = @taskId::bigint
+    AND cs.task_inserted_at = @taskInsertedAt::timestamptz
+    AND cs.task_retry_count = @retryCount::int
+ORDER BY
+    cs.strategy_id ASC;
+
+-- name: ListRetryQueueItemsForTask :many
+SELECT
+    *
+FROM
+    v2_retry_queue_item
+WHERE
+    tenant_id = @tenantId::uuid
+    AND task_id = @taskId::bigint
+    AND task_inserted_at = @taskInsertedAt::timestamptz
+ORDER BY
+    task_retry_count DESC;
+
+-- name: ListRuntimesForTask :many
+SELECT
+    *
+FROM
+    v2_task_runtime
+WHERE
+    tenant_id = @tenantId::uuid
+    AND task_id = @taskId::bigint
+    AND retry_count = @retryCount::int;
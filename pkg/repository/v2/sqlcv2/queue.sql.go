@@ -324,6 +324,7 @@ WITH worker_max_runs AS (
     WHERE
         tenant_id = $1::uuid
         AND worker_id = ANY($2::uuid[])
+        AND NOT slot_released
     GROUP BY
         worker_id
 )
@@ -367,6 +368,93 @@ func (q *Queries) ListAvailableSlotsForWorkers(ctx context.Context, db DBTX, arg
 	return items, nil
 }
 
+const listConcurrencySlotsForTask = `-- name: ListConcurrencySlotsForTask :many
+SELECT
+    cs.strategy_id,
+    cs.key,
+    cs.is_filled,
+    cs.schedule_timeout_at,
+    sc.strategy,
+    sc.expression,
+    sc.max_concurrency,
+    (
+        SELECT
+            COUNT(*)
+        FROM
+            v2_concurrency_slot cs2
+        WHERE
+            cs2.tenant_id = cs.tenant_id
+            AND cs2.strategy_id = cs.strategy_id
+            AND cs2.key = cs.key
+            AND cs2.is_filled
+    )::int AS "filledSlots"
+FROM
+    v2_concurrency_slot cs
+JOIN
+    v2_step_concurrency sc ON sc.workflow_id = cs.workflow_id AND sc.id = cs.strategy_id
+WHERE
+    cs.tenant_id = $1::uuid
+    AND cs.task_id = $2::bigint
+    AND cs.task_inserted_at = $3::timestamptz
+    AND cs.task_retry_count = $4::int
+ORDER BY
+    cs.strategy_id ASC
+`
+
+type ListConcurrencySlotsForTaskParams struct {
+	Tenantid       pgtype.UUID        `json:"tenantid"`
+	Taskid         int64              `json:"taskid"`
+	Taskinsertedat pgtype.Timestamptz `json:"taskinsertedat"`
+	Retrycount     int32              `json:"retrycount"`
+}
+
+type ListConcurrencySlotsForTaskRow struct {
+	StrategyID        int64                 `json:"strategy_id"`
+	Key               string                `json:"key"`
+	IsFilled          bool                  `json:"is_filled"`
+	ScheduleTimeoutAt pgtype.Timestamp      `json:"schedule_timeout_at"`
+	Strategy          V2ConcurrencyStrategy `json:"strategy"`
+	Expression        string                `json:"expression"`
+	MaxConcurrency    int32                 `json:"max_concurrency"`
+	FilledSlots       int32                 `json:"filledSlots"`
+}
+
+// Returns the concurrency slots of a task, along with the strategy of each slot and the number of filled
+// slots for the slot's key.
+func (q *Queries) ListConcurrencySlotsForTask(ctx context.Context, db DBTX, arg ListConcurrencySlotsForTaskParams) ([]*ListConcurrencySlotsForTaskRow, error) {
+	rows, err := db.Query(ctx, listConcurrencySlotsForTask,
+		arg.Tenantid,
+		arg.Taskid,
+		arg.Taskinsertedat,
+		arg.Retrycount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListConcurrencySlotsForTaskRow
+	for rows.Next() {
+		var i ListConcurrencySlotsForTaskRow
+		if err := rows.Scan(
+			&i.StrategyID,
+			&i.Key,
+			&i.IsFilled,
+			&i.ScheduleTimeoutAt,
+			&i.Strategy,
+			&i.Expression,
+			&i.MaxConcurrency,
+			&i.FilledSlots,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listQueueItemsForQueue = `-- name: ListQueueItemsForQueue :many
 SELECT
     id, tenant_id, queue, task_id, action_id, step_id, workflow_id, schedule_timeout_at, step_timeout, priority, sticky, desired_worker_id, retry_count
@@ -434,6 +522,57 @@ func (q *Queries) ListQueueItemsForQueue(ctx context.Context, db DBTX, arg ListQ
 	return items, nil
 }
 
+const listQueueItemsForTask = `-- name: ListQueueItemsForTask :many
+SELECT
+    id, tenant_id, queue, task_id, action_id, step_id, workflow_id, schedule_timeout_at, step_timeout, priority, sticky, desired_worker_id, retry_count
+FROM
+    v2_queue_item
+WHERE
+    tenant_id = $1::uuid
+    AND task_id = $2::bigint
+    AND retry_count = $3::int
+`
+
+type ListQueueItemsForTaskParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Taskid     int64       `json:"taskid"`
+	Retrycount int32       `json:"retrycount"`
+}
+
+func (q *Queries) ListQueueItemsForTask(ctx context.Context, db DBTX, arg ListQueueItemsForTaskParams) ([]*V2QueueItem, error) {
+	rows, err := db.Query(ctx, listQueueItemsForTask, arg.Tenantid, arg.Taskid, arg.Retrycount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V2QueueItem
+	for rows.Next() {
+		var i V2QueueItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Queue,
+			&i.TaskID,
+			&i.ActionID,
+			&i.StepID,
+			&i.WorkflowID,
+			&i.ScheduleTimeoutAt,
+			&i.StepTimeout,
+			&i.Priority,
+			&i.Sticky,
+			&i.DesiredWorkerID,
+			&i.RetryCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listQueues = `-- name: ListQueues :many
 SELECT
     tenant_id, name, last_active
@@ -464,6 +603,95 @@ func (q *Queries) ListQueues(ctx context.Context, db DBTX, tenantid pgtype.UUID)
 	return items, nil
 }
 
+const listRetryQueueItemsForTask = `-- name: ListRetryQueueItemsForTask :many
+SELECT
+    task_id, task_inserted_at, task_retry_count, retry_after, tenant_id
+FROM
+    v2_retry_queue_item
+WHERE
+    tenant_id = $1::uuid
+    AND task_id = $2::bigint
+    AND task_inserted_at = $3::timestamptz
+ORDER BY
+    task_retry_count DESC
+`
+
+type ListRetryQueueItemsForTaskParams struct {
+	Tenantid       pgtype.UUID        `json:"tenantid"`
+	Taskid         int64              `json:"taskid"`
+	Taskinsertedat pgtype.Timestamptz `json:"taskinsertedat"`
+}
+
+func (q *Queries) ListRetryQueueItemsForTask(ctx context.Context, db DBTX, arg ListRetryQueueItemsForTaskParams) ([]*V2RetryQueueItem, error) {
+	rows, err := db.Query(ctx, listRetryQueueItemsForTask, arg.Tenantid, arg.Taskid, arg.Taskinsertedat)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V2RetryQueueItem
+	for rows.Next() {
+		var i V2RetryQueueItem
+		if err := rows.Scan(
+			&i.TaskID,
+			&i.TaskInsertedAt,
+			&i.TaskRetryCount,
+			&i.RetryAfter,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRuntimesForTask = `-- name: ListRuntimesForTask :many
+SELECT
+    task_id, retry_count, worker_id, tenant_id, timeout_at, slot_released
+FROM
+    v2_task_runtime
+WHERE
+    tenant_id = $1::uuid
+    AND task_id = $2::bigint
+    AND retry_count = $3::int
+`
+
+type ListRuntimesForTaskParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Taskid     int64       `json:"taskid"`
+	Retrycount int32       `json:"retrycount"`
+}
+
+func (q *Queries) ListRuntimesForTask(ctx context.Context, db DBTX, arg ListRuntimesForTaskParams) ([]*V2TaskRuntime, error) {
+	rows, err := db.Query(ctx, listRuntimesForTask, arg.Tenantid, arg.Taskid, arg.Retrycount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V2TaskRuntime
+	for rows.Next() {
+		var i V2TaskRuntime
+		if err := rows.Scan(
+			&i.TaskID,
+			&i.RetryCount,
+			&i.WorkerID,
+			&i.TenantID,
+			&i.TimeoutAt,
+			&i.SlotReleased,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateTasksToAssigned = `-- name: UpdateTasksToAssigned :many
 WITH input AS (
     SELECT
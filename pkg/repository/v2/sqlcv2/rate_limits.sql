@@ -0,0 +1,188 @@
+-- name: ListRateLimitsForSteps :many
+SELECT
+    *
+FROM
+    "StepRateLimit" srl
+WHERE
+    srl."stepId" = ANY(@stepIds::uuid[])
+    AND srl."tenantId" = @tenantId::uuid;
+
+-- name: CreateTaskRateLimits :exec
+INSERT INTO v2_task_rate_limit (
+    task_id,
+    task_inserted_at,
+    tenant_id,
+    key,
+    units,
+    limit_value,
+    limit_window
+)
+SELECT
+    i.task_id,
+    i.task_inserted_at,
+    @tenantId::uuid,
+    i.key,
+    i.units,
+    NULLIF(i.limit_value, 0),
+    NULLIF(i.limit_window, '')
+FROM
+    (
+        SELECT
+            unnest(@taskIds::bigint[]) AS task_id,
+            unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at,
+            unnest(@keys::text[]) AS key,
+            unnest(@units::integer[]) AS units,
+            -- NOTE: a limit value of 0 or an empty window are stored as NULL
+            unnest(@limitValues::integer[]) AS limit_value,
+            unnest(@limitWindows::text[]) AS limit_window
+    ) AS i
+ON CONFLICT (task_id, task_inserted_at, key) DO NOTHING;
+
+-- name: ListTaskRateLimits :many
+SELECT
+    *
+FROM
+    v2_task_rate_limit
+WHERE
+    tenant_id = @tenantId::uuid
+    AND task_id = ANY(@taskIds::bigint[]);
+
+-- name: UpsertDynamicRateLimits :exec
+WITH input_values AS (
+    SELECT
+        unnest(@keys::text[]) AS "key",
+        unnest(@limitValues::int[]) AS "limitValue",
+        unnest(@windows::text[]) AS "window"
+)
+INSERT INTO "RateLimit" (
+    "tenantId",
+    "key",
+    "limitValue",
+    "value",
+    "window",
+    "dynamic",
+    "lastUsedAt"
+)
+SELECT
+    @tenantId::uuid,
+    iv."key",
+    iv."limitValue",
+    iv."limitValue",
+    iv."window",
+    true,
+    CURRENT_TIMESTAMP
+FROM
+    input_values iv
+ORDER BY
+    iv."key"
+ON CONFLICT ("tenantId", "key") DO UPDATE SET
+    "limitValue" = EXCLUDED."limitValue",
+    "window" = EXCLUDED."window",
+    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END,
+    "lastUsedAt" = CURRENT_TIMESTAMP
+-- rate limits which were created through the API take precedence over dynamic rate limits
+WHERE
+    "RateLimit"."dynamic";
+
+-- name: ListRateLimitKeysForTenant :many
+SELECT
+    "key"
+FROM
+    "RateLimit"
+WHERE
+    "tenantId" = @tenantId::uuid;
+
+-- name: RefillAndUseRateLimits :many
+-- Refills all rate limits for the tenant using their algorithm and subtracts the used units, returning
+-- the new state of each rate limit.
+WITH input AS (
+    SELECT
+        unnest(@keys::text[]) AS "key",
+        unnest(@units::int[]) AS "units"
+), refilled AS (
+    SELECT
+        r.*
+    FROM
+        "RateLimit" rl,
+        LATERAL refill_rate_limit(rl) r
+    WHERE
+        rl."tenantId" = @tenantId::uuid
+    ORDER BY
+        rl."key"
+    FOR UPDATE OF rl
+)
+UPDATE
+    "RateLimit" rl
+SET
+    "value" = refilled."value" - COALESCE(input."units", 0),
+    "lastRefill" = refilled."lastRefill",
+    "previousUsage" = refilled."previousUsage",
+    "lastUsedAt" = CASE WHEN input."key" IS NULL THEN rl."lastUsedAt" ELSE CURRENT_TIMESTAMP END
+FROM
+    refilled
+LEFT JOIN
+    input ON input."key" = refilled."key"
+WHERE
+    rl."tenantId" = refilled."tenantId"
+    AND rl."key" = refilled."key"
+RETURNING
+    rl.*,
+    rate_limit_available(rl) AS "available",
+    EXTRACT(EPOCH FROM rl."window"::INTERVAL)::float8 AS "windowSeconds";
+
+-- name: DeleteIdleDynamicRateLimits :execrows
+DELETE FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = @tenantId::uuid
+    AND rl."dynamic"
+    AND rl."lastUsedAt" < @idleSince::timestamp
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRateLimit" srl
+        WHERE
+            srl."tenantId" = rl."tenantId"
+            AND srl."rateLimitKey" = rl."key"
+    );
+
+-- name: ListRateLimitsForTenantWithRefill :many
+-- Returns the state of the tenant's rate limits as if they were refilled now, without updating them.
+SELECT
+    r.*,
+    rate_limit_available(r) AS "available",
+    EXTRACT(EPOCH FROM r."window"::INTERVAL)::float8 AS "windowSeconds"
+FROM
+    "RateLimit" rl,
+    LATERAL refill_rate_limit(rl) r
+WHERE
+    rl."tenantId" = @tenantId::uuid
+    AND (
+        sqlc.narg('search')::text IS NULL OR
+        rl."key" LIKE concat('%', sqlc.narg('search')::text, '%')
+    )
+    AND (
+        sqlc.narg('keys')::text[] IS NULL OR
+        rl."key" = ANY(sqlc.narg('keys')::text[])
+    )
+ORDER BY
+    rl."key" ASC
+OFFSET
+    COALESCE(sqlc.narg('offset')::int, 0)
+LIMIT
+    COALESCE(sqlc.narg('limit')::int, 50);
+
+-- name: CountRateLimitsForTenant :one
+SELECT
+    COUNT(*) AS total
+FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = @tenantId::uuid
+    AND (
+        sqlc.narg('search')::text IS NULL OR
+        rl."key" LIKE concat('%', sqlc.narg('search')::text, '%')
+    )
+    AND (
+        sqlc.narg('keys')::text[] IS NULL OR
+        rl."key" = ANY(sqlc.narg('keys')::text[])
+    );
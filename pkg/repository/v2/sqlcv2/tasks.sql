@@ -28,6 +28,21 @@ FROM
         @date::date
     ) AS p;
 
+-- name: CreateTaskRateLimitPartition :exec
+SELECT create_v2_range_partition(
+    'v2_task_rate_limit',
+    @date::date
+);
+
+-- name: ListTaskRateLimitPartitionsBeforeDate :many
+SELECT
+    p::text AS partition_name
+FROM
+    get_v2_partitions_before_date(
+        'v2_task_rate_limit',
+        @date::date
+    ) AS p;
+
 -- name: ListTasks :many
 SELECT
     *
@@ -39,16 +54,19 @@ WHERE
 
 -- name: ListTaskMetas :many
 SELECT
-    id,
-    inserted_at,
-    external_id,
-    retry_count,
-    workflow_id
+    t.id,
+    t.inserted_at,
+    t.external_id,
+    t.retry_count,
+    t.workflow_id,
+    d.external_id AS dag_external_id
 FROM
-    v2_task
+    v2_task t
+LEFT JOIN
+    v2_dag d ON (d.id, d.inserted_at) = (t.dag_id, t.dag_inserted_at)
 WHERE
-    tenant_id = $1
-    AND id = ANY(@ids::bigint[]);
+    t.tenant_id = $1
+    AND t.id = ANY(@ids::bigint[]);
 
 -- name: ReleaseTasks :many
 WITH input AS (
@@ -92,6 +110,30 @@ FROM
 JOIN
     runtimes_to_delete r ON r.task_id = t.id AND r.retry_count = t.retry_count;
 
+-- name: ReleaseTaskRuntimeSlot :one
+-- Frees the worker slot held by a running task without removing its runtime
+UPDATE
+    v2_task_runtime
+SET
+    slot_released = TRUE
+WHERE
+    task_id = @taskId::bigint
+    AND retry_count = @retryCount::integer
+    AND tenant_id = @tenantId::uuid
+RETURNING
+    *;
+
+-- name: ReacquireTaskRuntimeSlots :exec
+-- Takes back the worker slots of running tasks which released them while waiting on a durable event
+UPDATE
+    v2_task_runtime
+SET
+    slot_released = FALSE
+WHERE
+    task_id = ANY(@taskIds::bigint[])
+    AND tenant_id = @tenantId::uuid
+    AND slot_released;
+
 -- name: FailTaskAppFailure :many
 -- Fails a task due to an application-level error
 WITH locked_tasks AS (
@@ -160,6 +202,166 @@ RETURNING
     v2_task.id,
     v2_task.retry_count;
 
+-- name: DeleteTaskQueueItems :exec
+-- Removes tasks from the queue, the retry queue and any concurrency slots for the given retry counts
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@taskIds::bigint[]) AS task_id,
+                unnest(@retryCounts::integer[]) AS retry_count
+        ) AS subquery
+), deleted_qis AS (
+    DELETE FROM
+        v2_queue_item
+    WHERE
+        (task_id, retry_count) IN (SELECT task_id, retry_count FROM input)
+), deleted_retry_qis AS (
+    DELETE FROM
+        v2_retry_queue_item
+    WHERE
+        (task_id, task_retry_count) IN (SELECT task_id, retry_count FROM input)
+)
+DELETE FROM
+    v2_concurrency_slot
+WHERE
+    (task_id, task_retry_count) IN (SELECT task_id, retry_count FROM input);
+
+-- name: ReplayTasks :many
+-- Queues tasks again with a new retry count and a fresh set of retries. Tasks which are still queued
+-- or running, or which were never queued, are not replayed.
+WITH locked_tasks AS (
+    SELECT
+        id,
+        inserted_at,
+        retry_count
+    FROM
+        v2_task
+    WHERE
+        id = ANY(@taskIds::bigint[])
+        AND tenant_id = @tenantId::uuid
+        AND initial_state = 'QUEUED'
+    -- order by the task id to get a stable lock order
+    ORDER BY
+        id
+    FOR UPDATE
+), active_tasks AS (
+    SELECT
+        t.id
+    FROM
+        locked_tasks t
+    WHERE
+        EXISTS (
+            SELECT 1 FROM v2_queue_item qi WHERE qi.task_id = t.id AND qi.retry_count = t.retry_count
+        )
+        OR EXISTS (
+            SELECT 1 FROM v2_task_runtime r WHERE r.task_id = t.id AND r.retry_count = t.retry_count
+        )
+        OR EXISTS (
+            SELECT 1 FROM v2_retry_queue_item rqi WHERE rqi.task_id = t.id AND rqi.task_retry_count = t.retry_count
+        )
+        OR EXISTS (
+            SELECT 1 FROM v2_concurrency_slot cs WHERE cs.task_id = t.id AND cs.task_inserted_at = t.inserted_at AND cs.task_retry_count = t.retry_count
+        )
+)
+UPDATE
+    v2_task
+SET
+    retry_count = v2_task.retry_count + 1,
+    app_retry_count = 0,
+    internal_retry_count = 0
+FROM
+    locked_tasks
+WHERE
+    v2_task.id = locked_tasks.id
+    AND v2_task.inserted_at = locked_tasks.inserted_at
+    AND locked_tasks.id NOT IN (SELECT id FROM active_tasks)
+RETURNING
+    v2_task.*;
+
+-- name: LockDAGForTask :one
+-- Locks the DAG which a task belongs to, so that concurrent replays of the same DAG are serialized.
+SELECT
+    d.*
+FROM
+    v2_task t
+JOIN
+    v2_dag d ON d.id = t.dag_id AND d.inserted_at = t.dag_inserted_at
+WHERE
+    t.tenant_id = @tenantId::uuid
+    AND t.id = @taskId::bigint
+FOR UPDATE OF d;
+
+-- name: ListDAGTasksForReplay :many
+-- Lists the tasks in a DAG, along with whether the latest attempt of each task is still queued or running,
+-- and the output of the latest attempt if it completed.
+SELECT
+    t.*,
+    (
+        EXISTS (
+            SELECT 1 FROM v2_queue_item qi WHERE qi.task_id = t.id AND qi.retry_count = t.retry_count
+        )
+        OR EXISTS (
+            SELECT 1 FROM v2_task_runtime r WHERE r.task_id = t.id AND r.retry_count = t.retry_count
+        )
+        OR EXISTS (
+            SELECT 1 FROM v2_retry_queue_item rqi WHERE rqi.task_id = t.id AND rqi.task_retry_count = t.retry_count
+        )
+        OR EXISTS (
+            SELECT 1 FROM v2_concurrency_slot cs WHERE cs.task_id = t.id AND cs.task_inserted_at = t.inserted_at AND cs.task_retry_count = t.retry_count
+        )
+    )::boolean AS is_active,
+    (ce.id IS NOT NULL)::boolean AS is_completed,
+    ce.data AS output
+FROM
+    v2_dag_to_task dt
+JOIN
+    v2_task t ON t.id = dt.task_id AND t.inserted_at = dt.task_inserted_at
+LEFT JOIN LATERAL (
+    SELECT
+        e.id,
+        e.data
+    FROM
+        v2_task_event e
+    WHERE
+        e.tenant_id = t.tenant_id
+        AND e.task_id = t.id
+        AND e.retry_count = t.retry_count
+        AND e.event_type = 'COMPLETED'
+    ORDER BY
+        e.id DESC
+    LIMIT 1
+) ce ON TRUE
+WHERE
+    dt.dag_id = @dagId::bigint
+    AND dt.dag_inserted_at = @dagInsertedAt::timestamptz
+    AND t.tenant_id = @tenantId::uuid
+ORDER BY
+    t.id;
+
+-- name: ReplayTaskWithInput :one
+-- Runs a finished task again with a new input and a fresh set of retries. The retry count is incremented,
+-- so the task is queued by the update trigger if its initial state is QUEUED.
+UPDATE
+    v2_task
+SET
+    input = @input::jsonb,
+    initial_state = @initialState::v2_task_initial_state,
+    initial_state_reason = sqlc.narg('initialStateReason')::text,
+    concurrency_strategy_ids = @concurrencyStrategyIds::bigint[],
+    concurrency_keys = @concurrencyKeys::text[],
+    retry_count = retry_count + 1,
+    app_retry_count = 0,
+    internal_retry_count = 0
+WHERE
+    tenant_id = @tenantId::uuid
+    AND id = @taskId::bigint
+    AND inserted_at = @taskInsertedAt::timestamptz
+RETURNING
+    *;
+
 -- name: ProcessTaskTimeouts :many
 WITH expired_runtimes AS (
     SELECT
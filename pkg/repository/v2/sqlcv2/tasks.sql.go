@@ -35,6 +35,56 @@ func (q *Queries) CreateTaskPartition(ctx context.Context, db DBTX, date pgtype.
 	return err
 }
 
+const createTaskRateLimitPartition = `-- name: CreateTaskRateLimitPartition :exec
+SELECT create_v2_range_partition(
+    'v2_task_rate_limit',
+    $1::date
+)
+`
+
+func (q *Queries) CreateTaskRateLimitPartition(ctx context.Context, db DBTX, date pgtype.Date) error {
+	_, err := db.Exec(ctx, createTaskRateLimitPartition, date)
+	return err
+}
+
+const deleteTaskQueueItems = `-- name: DeleteTaskQueueItems :exec
+WITH input AS (
+    SELECT
+        task_id, retry_count
+    FROM
+        (
+            SELECT
+                unnest($1::bigint[]) AS task_id,
+                unnest($2::integer[]) AS retry_count
+        ) AS subquery
+), deleted_qis AS (
+    DELETE FROM
+        v2_queue_item
+    WHERE
+        (task_id, retry_count) IN (SELECT task_id, retry_count FROM input)
+), deleted_retry_qis AS (
+    DELETE FROM
+        v2_retry_queue_item
+    WHERE
+        (task_id, task_retry_count) IN (SELECT task_id, retry_count FROM input)
+)
+DELETE FROM
+    v2_concurrency_slot
+WHERE
+    (task_id, task_retry_count) IN (SELECT task_id, retry_count FROM input)
+`
+
+type DeleteTaskQueueItemsParams struct {
+	Taskids     []int64 `json:"taskids"`
+	Retrycounts []int32 `json:"retrycounts"`
+}
+
+// Removes tasks from the queue, the retry queue and any concurrency slots for the given retry counts
+func (q *Queries) DeleteTaskQueueItems(ctx context.Context, db DBTX, arg DeleteTaskQueueItemsParams) error {
+	_, err := db.Exec(ctx, deleteTaskQueueItems, arg.Taskids, arg.Retrycounts)
+	return err
+}
+
 const failTaskAppFailure = `-- name: FailTaskAppFailure :many
 WITH locked_tasks AS (
     SELECT
@@ -196,6 +246,152 @@ func (q *Queries) ListConcurrencyPartitionsBeforeDate(ctx context.Context, db DB
 	return items, nil
 }
 
+const listDAGTasksForReplay = `-- name: ListDAGTasksForReplay :many
+SELECT
+    t.id, t.inserted_at, t.tenant_id, t.queue, t.action_id, t.step_id, t.step_readable_id, t.workflow_id, t.schedule_timeout, t.step_timeout, t.priority, t.sticky, t.desired_worker_id, t.external_id, t.display_name, t.input, t.retry_count, t.internal_retry_count, t.app_retry_count, t.additional_metadata, t.dag_id, t.dag_inserted_at, t.parent_external_id, t.child_index, t.child_key, t.initial_state, t.initial_state_reason, t.concurrency_strategy_ids, t.concurrency_keys, t.retry_backoff_factor, t.retry_max_backoff,
+    (
+        EXISTS (
+            SELECT 1 FROM v2_queue_item qi WHERE qi.task_id = t.id AND qi.retry_count = t.retry_count
+        )
+        OR EXISTS (
+            SELECT 1 FROM v2_task_runtime r WHERE r.task_id = t.id AND r.retry_count = t.retry_count
+        )
+        OR EXISTS (
+            SELECT 1 FROM v2_retry_queue_item rqi WHERE rqi.task_id = t.id AND rqi.task_retry_count = t.retry_count
+        )
+        OR EXISTS (
+            SELECT 1 FROM v2_concurrency_slot cs WHERE cs.task_id = t.id AND cs.task_inserted_at = t.inserted_at AND cs.task_retry_count = t.retry_count
+        )
+    )::boolean AS is_active,
+    (ce.id IS NOT NULL)::boolean AS is_completed,
+    ce.data AS output
+FROM
+    v2_dag_to_task dt
+JOIN
+    v2_task t ON t.id = dt.task_id AND t.inserted_at = dt.task_inserted_at
+LEFT JOIN LATERAL (
+    SELECT
+        e.id,
+        e.data
+    FROM
+        v2_task_event e
+    WHERE
+        e.tenant_id = t.tenant_id
+        AND e.task_id = t.id
+        AND e.retry_count = t.retry_count
+        AND e.event_type = 'COMPLETED'
+    ORDER BY
+        e.id DESC
+    LIMIT 1
+) ce ON TRUE
+WHERE
+    dt.dag_id = $1::bigint
+    AND dt.dag_inserted_at = $2::timestamptz
+    AND t.tenant_id = $3::uuid
+ORDER BY
+    t.id
+`
+
+type ListDAGTasksForReplayParams struct {
+	Dagid         int64              `json:"dagid"`
+	Daginsertedat pgtype.Timestamptz `json:"daginsertedat"`
+	Tenantid      pgtype.UUID        `json:"tenantid"`
+}
+
+type ListDAGTasksForReplayRow struct {
+	ID                     int64              `json:"id"`
+	InsertedAt             pgtype.Timestamptz `json:"inserted_at"`
+	TenantID               pgtype.UUID        `json:"tenant_id"`
+	Queue                  string             `json:"queue"`
+	ActionID               string             `json:"action_id"`
+	StepID                 pgtype.UUID        `json:"step_id"`
+	StepReadableID         string             `json:"step_readable_id"`
+	WorkflowID             pgtype.UUID        `json:"workflow_id"`
+	ScheduleTimeout        string             `json:"schedule_timeout"`
+	StepTimeout            pgtype.Text        `json:"step_timeout"`
+	Priority               pgtype.Int4        `json:"priority"`
+	Sticky                 V2StickyStrategy   `json:"sticky"`
+	DesiredWorkerID        pgtype.UUID        `json:"desired_worker_id"`
+	ExternalID             pgtype.UUID        `json:"external_id"`
+	DisplayName            string             `json:"display_name"`
+	Input                  []byte             `json:"input"`
+	RetryCount             int32              `json:"retry_count"`
+	InternalRetryCount     int32              `json:"internal_retry_count"`
+	AppRetryCount          int32              `json:"app_retry_count"`
+	AdditionalMetadata     []byte             `json:"additional_metadata"`
+	DagID                  pgtype.Int8        `json:"dag_id"`
+	DagInsertedAt          pgtype.Timestamptz `json:"dag_inserted_at"`
+	ParentExternalID       pgtype.UUID        `json:"parent_external_id"`
+	ChildIndex             pgtype.Int4        `json:"child_index"`
+	ChildKey               pgtype.Text        `json:"child_key"`
+	InitialState           V2TaskInitialState `json:"initial_state"`
+	InitialStateReason     pgtype.Text        `json:"initial_state_reason"`
+	ConcurrencyStrategyIds []int64            `json:"concurrency_strategy_ids"`
+	ConcurrencyKeys        []string           `json:"concurrency_keys"`
+	RetryBackoffFactor     pgtype.Float8      `json:"retry_backoff_factor"`
+	RetryMaxBackoff        pgtype.Int4        `json:"retry_max_backoff"`
+	IsActive               bool               `json:"is_active"`
+	IsCompleted            bool               `json:"is_completed"`
+	Output                 []byte             `json:"output"`
+}
+
+// Lists the tasks in a DAG, along with whether the latest attempt of each task is still queued or running,
+// and the output of the latest attempt if it completed.
+func (q *Queries) ListDAGTasksForReplay(ctx context.Context, db DBTX, arg ListDAGTasksForReplayParams) ([]*ListDAGTasksForReplayRow, error) {
+	rows, err := db.Query(ctx, listDAGTasksForReplay, arg.Dagid, arg.Daginsertedat, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListDAGTasksForReplayRow
+	for rows.Next() {
+		var i ListDAGTasksForReplayRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.InsertedAt,
+			&i.TenantID,
+			&i.Queue,
+			&i.ActionID,
+			&i.StepID,
+			&i.StepReadableID,
+			&i.WorkflowID,
+			&i.ScheduleTimeout,
+			&i.StepTimeout,
+			&i.Priority,
+			&i.Sticky,
+			&i.DesiredWorkerID,
+			&i.ExternalID,
+			&i.DisplayName,
+			&i.Input,
+			&i.RetryCount,
+			&i.InternalRetryCount,
+			&i.AppRetryCount,
+			&i.AdditionalMetadata,
+			&i.DagID,
+			&i.DagInsertedAt,
+			&i.ParentExternalID,
+			&i.ChildIndex,
+			&i.ChildKey,
+			&i.InitialState,
+			&i.InitialStateReason,
+			&i.ConcurrencyStrategyIds,
+			&i.ConcurrencyKeys,
+			&i.RetryBackoffFactor,
+			&i.RetryMaxBackoff,
+			&i.IsActive,
+			&i.IsCompleted,
+			&i.Output,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMatchingSignalEvents = `-- name: ListMatchingSignalEvents :many
 WITH input AS (
     SELECT
@@ -261,16 +457,19 @@ func (q *Queries) ListMatchingSignalEvents(ctx context.Context, db DBTX, arg Lis
 
 const listTaskMetas = `-- name: ListTaskMetas :many
 SELECT
-    id,
-    inserted_at,
-    external_id,
-    retry_count,
-    workflow_id
+    t.id,
+    t.inserted_at,
+    t.external_id,
+    t.retry_count,
+    t.workflow_id,
+    d.external_id AS dag_external_id
 FROM
-    v2_task
+    v2_task t
+LEFT JOIN
+    v2_dag d ON (d.id, d.inserted_at) = (t.dag_id, t.dag_inserted_at)
 WHERE
-    tenant_id = $1
-    AND id = ANY($2::bigint[])
+    t.tenant_id = $1
+    AND t.id = ANY($2::bigint[])
 `
 
 type ListTaskMetasParams struct {
@@ -279,11 +478,12 @@ type ListTaskMetasParams struct {
 }
 
 type ListTaskMetasRow struct {
-	ID         int64              `json:"id"`
-	InsertedAt pgtype.Timestamptz `json:"inserted_at"`
-	ExternalID pgtype.UUID        `json:"external_id"`
-	RetryCount int32              `json:"retry_count"`
-	WorkflowID pgtype.UUID        `json:"workflow_id"`
+	ID            int64              `json:"id"`
+	InsertedAt    pgtype.Timestamptz `json:"inserted_at"`
+	ExternalID    pgtype.UUID        `json:"external_id"`
+	RetryCount    int32              `json:"retry_count"`
+	WorkflowID    pgtype.UUID        `json:"workflow_id"`
+	DagExternalID pgtype.UUID        `json:"dag_external_id"`
 }
 
 func (q *Queries) ListTaskMetas(ctx context.Context, db DBTX, arg ListTaskMetasParams) ([]*ListTaskMetasRow, error) {
@@ -301,6 +501,7 @@ func (q *Queries) ListTaskMetas(ctx context.Context, db DBTX, arg ListTaskMetasP
 			&i.ExternalID,
 			&i.RetryCount,
 			&i.WorkflowID,
+			&i.DagExternalID,
 		); err != nil {
 			return nil, err
 		}
@@ -342,6 +543,36 @@ func (q *Queries) ListTaskPartitionsBeforeDate(ctx context.Context, db DBTX, dat
 	return items, nil
 }
 
+const listTaskRateLimitPartitionsBeforeDate = `-- name: ListTaskRateLimitPartitionsBeforeDate :many
+SELECT
+    p::text AS partition_name
+FROM
+    get_v2_partitions_before_date(
+        'v2_task_rate_limit',
+        $1::date
+    ) AS p
+`
+
+func (q *Queries) ListTaskRateLimitPartitionsBeforeDate(ctx context.Context, db DBTX, date pgtype.Date) ([]string, error) {
+	rows, err := db.Query(ctx, listTaskRateLimitPartitionsBeforeDate, date)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var partition_name string
+		if err := rows.Scan(&partition_name); err != nil {
+			return nil, err
+		}
+		items = append(items, partition_name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTasks = `-- name: ListTasks :many
 SELECT
     id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, additional_metadata, dag_id, dag_inserted_at, parent_external_id, child_index, child_key, initial_state, initial_state_reason, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff
@@ -409,6 +640,40 @@ func (q *Queries) ListTasks(ctx context.Context, db DBTX, arg ListTasksParams) (
 	return items, nil
 }
 
+const lockDAGForTask = `-- name: LockDAGForTask :one
+SELECT
+    d.id, d.inserted_at, d.tenant_id, d.external_id, d.display_name, d.workflow_id, d.workflow_version_id
+FROM
+    v2_task t
+JOIN
+    v2_dag d ON d.id = t.dag_id AND d.inserted_at = t.dag_inserted_at
+WHERE
+    t.tenant_id = $1::uuid
+    AND t.id = $2::bigint
+FOR UPDATE OF d
+`
+
+type LockDAGForTaskParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Taskid   int64       `json:"taskid"`
+}
+
+// Locks the DAG which a task belongs to, so that concurrent replays of the same DAG are serialized.
+func (q *Queries) LockDAGForTask(ctx context.Context, db DBTX, arg LockDAGForTaskParams) (*V2Dag, error) {
+	row := db.QueryRow(ctx, lockDAGForTask, arg.Tenantid, arg.Taskid)
+	var i V2Dag
+	err := row.Scan(
+		&i.ID,
+		&i.InsertedAt,
+		&i.TenantID,
+		&i.ExternalID,
+		&i.DisplayName,
+		&i.WorkflowID,
+		&i.WorkflowVersionID,
+	)
+	return &i, err
+}
+
 const processTaskReassignments = `-- name: ProcessTaskReassignments :many
 WITH tasks_on_inactive_workers AS (
     SELECT
@@ -640,6 +905,62 @@ func (q *Queries) ProcessTaskTimeouts(ctx context.Context, db DBTX, arg ProcessT
 	return items, nil
 }
 
+const reacquireTaskRuntimeSlots = `-- name: ReacquireTaskRuntimeSlots :exec
+UPDATE
+    v2_task_runtime
+SET
+    slot_released = FALSE
+WHERE
+    task_id = ANY($1::bigint[])
+    AND tenant_id = $2::uuid
+    AND slot_released
+`
+
+type ReacquireTaskRuntimeSlotsParams struct {
+	Taskids  []int64     `json:"taskids"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+}
+
+// Takes back the worker slots of running tasks which released them while waiting on a durable event
+func (q *Queries) ReacquireTaskRuntimeSlots(ctx context.Context, db DBTX, arg ReacquireTaskRuntimeSlotsParams) error {
+	_, err := db.Exec(ctx, reacquireTaskRuntimeSlots, arg.Taskids, arg.Tenantid)
+	return err
+}
+
+const releaseTaskRuntimeSlot = `-- name: ReleaseTaskRuntimeSlot :one
+UPDATE
+    v2_task_runtime
+SET
+    slot_released = TRUE
+WHERE
+    task_id = $1::bigint
+    AND retry_count = $2::integer
+    AND tenant_id = $3::uuid
+RETURNING
+    task_id, retry_count, worker_id, tenant_id, timeout_at, slot_released
+`
+
+type ReleaseTaskRuntimeSlotParams struct {
+	Taskid     int64       `json:"taskid"`
+	Retrycount int32       `json:"retrycount"`
+	Tenantid   pgtype.UUID `json:"tenantid"`
+}
+
+// Frees the worker slot held by a running task without removing its runtime
+func (q *Queries) ReleaseTaskRuntimeSlot(ctx context.Context, db DBTX, arg ReleaseTaskRuntimeSlotParams) (*V2TaskRuntime, error) {
+	row := db.QueryRow(ctx, releaseTaskRuntimeSlot, arg.Taskid, arg.Retrycount, arg.Tenantid)
+	var i V2TaskRuntime
+	err := row.Scan(
+		&i.TaskID,
+		&i.RetryCount,
+		&i.WorkerID,
+		&i.TenantID,
+		&i.TimeoutAt,
+		&i.SlotReleased,
+	)
+	return &i, err
+}
+
 const releaseTasks = `-- name: ReleaseTasks :many
 WITH input AS (
     SELECT
@@ -727,3 +1048,194 @@ func (q *Queries) ReleaseTasks(ctx context.Context, db DBTX, arg ReleaseTasksPar
 	}
 	return items, nil
 }
+
+const replayTaskWithInput = `-- name: ReplayTaskWithInput :one
+UPDATE
+    v2_task
+SET
+    input = $1::jsonb,
+    initial_state = $2::v2_task_initial_state,
+    initial_state_reason = $3::text,
+    concurrency_strategy_ids = $4::bigint[],
+    concurrency_keys = $5::text[],
+    retry_count = retry_count + 1,
+    app_retry_count = 0,
+    internal_retry_count = 0
+WHERE
+    tenant_id = $6::uuid
+    AND id = $7::bigint
+    AND inserted_at = $8::timestamptz
+RETURNING
+    id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, additional_metadata, dag_id, dag_inserted_at, parent_external_id, child_index, child_key, initial_state, initial_state_reason, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff
+`
+
+type ReplayTaskWithInputParams struct {
+	Input                  []byte             `json:"input"`
+	Initialstate           V2TaskInitialState `json:"initialstate"`
+	InitialStateReason     pgtype.Text        `json:"initialStateReason"`
+	Concurrencystrategyids []int64            `json:"concurrencystrategyids"`
+	Concurrencykeys        []string           `json:"concurrencykeys"`
+	Tenantid               pgtype.UUID        `json:"tenantid"`
+	Taskid                 int64              `json:"taskid"`
+	Taskinsertedat         pgtype.Timestamptz `json:"taskinsertedat"`
+}
+
+// Runs a finished task again with a new input and a fresh set of retries. The retry count is incremented,
+// so the task is queued by the update trigger if its initial state is QUEUED.
+func (q *Queries) ReplayTaskWithInput(ctx context.Context, db DBTX, arg ReplayTaskWithInputParams) (*V2Task, error) {
+	row := db.QueryRow(ctx, replayTaskWithInput,
+		arg.Input,
+		arg.Initialstate,
+		arg.InitialStateReason,
+		arg.Concurrencystrategyids,
+		arg.Concurrencykeys,
+		arg.Tenantid,
+		arg.Taskid,
+		arg.Taskinsertedat,
+	)
+	var i V2Task
+	err := row.Scan(
+		&i.ID,
+		&i.InsertedAt,
+		&i.TenantID,
+		&i.Queue,
+		&i.ActionID,
+		&i.StepID,
+		&i.StepReadableID,
+		&i.WorkflowID,
+		&i.ScheduleTimeout,
+		&i.StepTimeout,
+		&i.Priority,
+		&i.Sticky,
+		&i.DesiredWorkerID,
+		&i.ExternalID,
+		&i.DisplayName,
+		&i.Input,
+		&i.RetryCount,
+		&i.InternalRetryCount,
+		&i.AppRetryCount,
+		&i.AdditionalMetadata,
+		&i.DagID,
+		&i.DagInsertedAt,
+		&i.ParentExternalID,
+		&i.ChildIndex,
+		&i.ChildKey,
+		&i.InitialState,
+		&i.InitialStateReason,
+		&i.ConcurrencyStrategyIds,
+		&i.ConcurrencyKeys,
+		&i.RetryBackoffFactor,
+		&i.RetryMaxBackoff,
+	)
+	return &i, err
+}
+
+const replayTasks = `-- name: ReplayTasks :many
+WITH locked_tasks AS (
+    SELECT
+        id,
+        inserted_at,
+        retry_count
+    FROM
+        v2_task
+    WHERE
+        id = ANY($1::bigint[])
+        AND tenant_id = $2::uuid
+        AND initial_state = 'QUEUED'
+    -- order by the task id to get a stable lock order
+    ORDER BY
+        id
+    FOR UPDATE
+), active_tasks AS (
+    SELECT
+        t.id
+    FROM
+        locked_tasks t
+    WHERE
+        EXISTS (
+            SELECT 1 FROM v2_queue_item qi WHERE qi.task_id = t.id AND qi.retry_count = t.retry_count
+        )
+        OR EXISTS (
+            SELECT 1 FROM v2_task_runtime r WHERE r.task_id = t.id AND r.retry_count = t.retry_count
+        )
+        OR EXISTS (
+            SELECT 1 FROM v2_retry_queue_item rqi WHERE rqi.task_id = t.id AND rqi.task_retry_count = t.retry_count
+        )
+        OR EXISTS (
+            SELECT 1 FROM v2_concurrency_slot cs WHERE cs.task_id = t.id AND cs.task_inserted_at = t.inserted_at AND cs.task_retry_count = t.retry_count
+        )
+)
+UPDATE
+    v2_task
+SET
+    retry_count = v2_task.retry_count + 1,
+    app_retry_count = 0,
+    internal_retry_count = 0
+FROM
+    locked_tasks
+WHERE
+    v2_task.id = locked_tasks.id
+    AND v2_task.inserted_at = locked_tasks.inserted_at
+    AND locked_tasks.id NOT IN (SELECT id FROM active_tasks)
+RETURNING
+    v2_task.id, v2_task.inserted_at, v2_task.tenant_id, v2_task.queue, v2_task.action_id, v2_task.step_id, v2_task.step_readable_id, v2_task.workflow_id, v2_task.schedule_timeout, v2_task.step_timeout, v2_task.priority, v2_task.sticky, v2_task.desired_worker_id, v2_task.external_id, v2_task.display_name, v2_task.input, v2_task.retry_count, v2_task.internal_retry_count, v2_task.app_retry_count, v2_task.additional_metadata, v2_task.dag_id, v2_task.dag_inserted_at, v2_task.parent_external_id, v2_task.child_index, v2_task.child_key, v2_task.initial_state, v2_task.initial_state_reason, v2_task.concurrency_strategy_ids, v2_task.concurrency_keys, v2_task.retry_backoff_factor, v2_task.retry_max_backoff
+`
+
+type ReplayTasksParams struct {
+	Taskids  []int64     `json:"taskids"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+}
+
+// Queues tasks again with a new retry count and a fresh set of retries. Tasks which are still queued
+// or running, or which were never queued, are not replayed.
+func (q *Queries) ReplayTasks(ctx context.Context, db DBTX, arg ReplayTasksParams) ([]*V2Task, error) {
+	rows, err := db.Query(ctx, replayTasks, arg.Taskids, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V2Task
+	for rows.Next() {
+		var i V2Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.InsertedAt,
+			&i.TenantID,
+			&i.Queue,
+			&i.ActionID,
+			&i.StepID,
+			&i.StepReadableID,
+			&i.WorkflowID,
+			&i.ScheduleTimeout,
+			&i.StepTimeout,
+			&i.Priority,
+			&i.Sticky,
+			&i.DesiredWorkerID,
+			&i.ExternalID,
+			&i.DisplayName,
+			&i.Input,
+			&i.RetryCount,
+			&i.InternalRetryCount,
+			&i.AppRetryCount,
+			&i.AdditionalMetadata,
+			&i.DagID,
+			&i.DagInsertedAt,
+			&i.ParentExternalID,
+			&i.ChildIndex,
+			&i.ChildKey,
+			&i.InitialState,
+			&i.InitialStateReason,
+			&i.ConcurrencyStrategyIds,
+			&i.ConcurrencyKeys,
+			&i.RetryBackoffFactor,
+			&i.RetryMaxBackoff,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
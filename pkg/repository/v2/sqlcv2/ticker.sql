@@ -0,0 +1,140 @@
+-- name: ListDueCronSchedules :many
+WITH latest_versions AS (
+    SELECT DISTINCT ON("workflowId")
+        wv."id" AS "workflowVersionId",
+        wv."workflowId"
+    FROM
+        "WorkflowVersion" as wv
+    JOIN
+        "Workflow" as w ON w."id" = wv."workflowId"
+    WHERE
+        w."tenantId" = @tenantId::uuid
+        AND w."deletedAt" IS NULL
+        AND wv."deletedAt" IS NULL
+    ORDER BY "workflowId", "order" DESC
+)
+SELECT
+    c.*,
+    w."name" AS "workflowName"
+FROM
+    latest_versions
+JOIN
+    "WorkflowTriggers" as t ON t."workflowVersionId" = latest_versions."workflowVersionId"
+JOIN
+    "WorkflowTriggerCronRef" as c ON c."parentId" = t."id"
+JOIN
+    "Workflow" as w ON w."id" = latest_versions."workflowId"
+WHERE
+    t."deletedAt" IS NULL
+    AND c."deletedAt" IS NULL
+    AND c."enabled" = TRUE
+    AND (
+        c."nextFireAt" IS NULL
+        OR c."nextFireAt" <= @now::timestamp
+        OR c."queuedFireAt" IS NOT NULL
+    )
+ORDER BY
+    c."nextFireAt" ASC NULLS FIRST,
+    c."id" ASC
+LIMIT
+    COALESCE(sqlc.narg('limit')::integer, 100)
+FOR UPDATE OF c SKIP LOCKED;
+
+-- name: ListCronLastRuns :many
+-- Lists the last runs of due crons which have an overlap policy, so that the status of each run can be
+-- checked before the cron fires again
+WITH latest_versions AS (
+    SELECT DISTINCT ON("workflowId")
+        wv."id" AS "workflowVersionId",
+        wv."workflowId"
+    FROM
+        "WorkflowVersion" as wv
+    JOIN
+        "Workflow" as w ON w."id" = wv."workflowId"
+    WHERE
+        w."tenantId" = @tenantId::uuid
+        AND w."deletedAt" IS NULL
+        AND wv."deletedAt" IS NULL
+    ORDER BY "workflowId", "order" DESC
+)
+SELECT
+    c."id",
+    c."lastRunExternalId"::uuid AS "lastRunExternalId",
+    c."lastFiredAt"
+FROM
+    latest_versions
+JOIN
+    "WorkflowTriggers" as t ON t."workflowVersionId" = latest_versions."workflowVersionId"
+JOIN
+    "WorkflowTriggerCronRef" as c ON c."parentId" = t."id"
+WHERE
+    t."deletedAt" IS NULL
+    AND c."deletedAt" IS NULL
+    AND c."enabled" = TRUE
+    AND c."overlapPolicy" != 'ALLOW'
+    AND c."lastRunExternalId" IS NOT NULL
+    AND (
+        c."nextFireAt" IS NULL
+        OR c."nextFireAt" <= @now::timestamp
+        OR c."queuedFireAt" IS NOT NULL
+    );
+
+-- name: UpdateCronSchedules :exec
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@ids::uuid[]) AS id,
+                unnest(@nextFireAts::timestamp[]) AS next_fire_at,
+                unnest(@lastRunExternalIds::uuid[]) AS last_run_external_id,
+                unnest(@lastFiredAts::timestamp[]) AS last_fired_at,
+                unnest(@queuedFireAts::timestamp[]) AS queued_fire_at
+        ) AS subquery
+)
+UPDATE
+    "WorkflowTriggerCronRef" as c
+SET
+    "nextFireAt" = input.next_fire_at,
+    "lastRunExternalId" = COALESCE(input.last_run_external_id, c."lastRunExternalId"),
+    "lastFiredAt" = COALESCE(input.last_fired_at, c."lastFiredAt"),
+    "queuedFireAt" = input.queued_fire_at,
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM
+    input
+WHERE
+    c."id" = input.id;
+
+-- name: ListDueScheduledWorkflows :many
+SELECT
+    s.*,
+    w."name" AS "workflowName"
+FROM
+    "WorkflowTriggerScheduledRef" as s
+JOIN
+    "WorkflowVersion" as wv ON wv."id" = s."parentId"
+JOIN
+    "Workflow" as w ON w."id" = wv."workflowId"
+WHERE
+    w."tenantId" = @tenantId::uuid
+    AND s."triggerAt" <= @now::timestamp
+    AND s."triggeredAt" IS NULL
+    AND s."deletedAt" IS NULL
+    AND wv."deletedAt" IS NULL
+    AND w."deletedAt" IS NULL
+ORDER BY
+    s."triggerAt" ASC,
+    s."id" ASC
+LIMIT
+    COALESCE(sqlc.narg('limit')::integer, 100)
+FOR UPDATE OF s SKIP LOCKED;
+
+-- name: MarkScheduledWorkflowsTriggered :exec
+UPDATE
+    "WorkflowTriggerScheduledRef"
+SET
+    "triggeredAt" = @triggeredAt::timestamp,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = ANY(@ids::uuid[]);
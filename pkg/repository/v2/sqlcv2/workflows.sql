@@ -44,7 +44,11 @@ SELECT
     wv."id" as "workflowVersionId",
     w."name" as "workflowName",
     w."id" as "workflowId",
-    COUNT(sc.id) as "concurrencyCount"
+    COUNT(sc.id) as "concurrencyCount",
+    (
+        EXISTS (SELECT 1 FROM "StepRateLimit" srl WHERE srl."stepId" = s."id")
+        OR EXISTS (SELECT 1 FROM "StepExpression" se WHERE se."stepId" = s."id")
+    )::boolean as "hasRateLimits"
 FROM
     "Step" s
 JOIN
@@ -69,4 +73,15 @@ SELECT
 FROM
     "StepExpression"
 WHERE
-    "stepId" = ANY(@stepIds::uuid[]);
\ No newline at end of file
+    "stepId" = ANY(@stepIds::uuid[]);
+
+-- name: ListStepMatchConditions :many
+SELECT
+    *
+FROM
+    v2_step_match_condition
+WHERE
+    tenant_id = @tenantId::uuid
+    AND step_id = ANY(@stepIds::uuid[])
+ORDER BY
+    step_id, id;
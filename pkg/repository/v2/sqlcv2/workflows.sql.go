@@ -13,7 +13,7 @@ import (
 
 const listStepExpressions = `-- name: ListStepExpressions :many
 SELECT
-    key, "stepId", expression, kind
+    key, "stepId", expression, kind, "celLibraryVersion"
 FROM
     "StepExpression"
 WHERE
@@ -34,6 +34,51 @@ func (q *Queries) ListStepExpressions(ctx context.Context, db DBTX, stepids []pg
 			&i.StepId,
 			&i.Expression,
 			&i.Kind,
+			&i.CelLibraryVersion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStepMatchConditions = `-- name: ListStepMatchConditions :many
+SELECT
+    id, tenant_id, step_id, event_key, expression, cel_library_version
+FROM
+    v2_step_match_condition
+WHERE
+    tenant_id = $1::uuid
+    AND step_id = ANY($2::uuid[])
+ORDER BY
+    step_id, id
+`
+
+type ListStepMatchConditionsParams struct {
+	Tenantid pgtype.UUID   `json:"tenantid"`
+	Stepids  []pgtype.UUID `json:"stepids"`
+}
+
+func (q *Queries) ListStepMatchConditions(ctx context.Context, db DBTX, arg ListStepMatchConditionsParams) ([]*V2StepMatchCondition, error) {
+	rows, err := db.Query(ctx, listStepMatchConditions, arg.Tenantid, arg.Stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V2StepMatchCondition
+	for rows.Next() {
+		var i V2StepMatchCondition
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.StepID,
+			&i.EventKey,
+			&i.Expression,
+			&i.CelLibraryVersion,
 		); err != nil {
 			return nil, err
 		}
@@ -51,7 +96,11 @@ SELECT
     wv."id" as "workflowVersionId",
     w."name" as "workflowName",
     w."id" as "workflowId",
-    COUNT(sc.id) as "concurrencyCount"
+    COUNT(sc.id) as "concurrencyCount",
+    (
+        EXISTS (SELECT 1 FROM "StepRateLimit" srl WHERE srl."stepId" = s."id")
+        OR EXISTS (SELECT 1 FROM "StepExpression" se WHERE se."stepId" = s."id")
+    )::boolean as "hasRateLimits"
 FROM
     "Step" s
 JOIN
@@ -95,6 +144,7 @@ type ListStepsByIdsRow struct {
 	WorkflowName       string           `json:"workflowName"`
 	WorkflowId         pgtype.UUID      `json:"workflowId"`
 	ConcurrencyCount   int64            `json:"concurrencyCount"`
+	HasRateLimits      bool             `json:"hasRateLimits"`
 }
 
 func (q *Queries) ListStepsByIds(ctx context.Context, db DBTX, arg ListStepsByIdsParams) ([]*ListStepsByIdsRow, error) {
@@ -125,6 +175,7 @@ func (q *Queries) ListStepsByIds(ctx context.Context, db DBTX, arg ListStepsById
 			&i.WorkflowName,
 			&i.WorkflowId,
 			&i.ConcurrencyCount,
+			&i.HasRateLimits,
 		); err != nil {
 			return nil, err
 		}
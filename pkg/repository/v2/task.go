@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -17,6 +18,9 @@ import (
 
 const MAX_INTERNAL_RETRIES = 3
 
+// MAX_CONCURRENCY_WEIGHT is the largest weight which a weighted round robin weight expression may return.
+const MAX_CONCURRENCY_WEIGHT = 10000
+
 type CreateTaskOpts struct {
 	// (required) the external id
 	ExternalId string `validate:"required,uuid"`
@@ -64,6 +68,23 @@ type FailTaskOpts struct {
 	IsAppError bool
 }
 
+type CancelTaskOpts struct {
+	*TaskIdRetryCount
+
+	// (optional) whether to remove the task from the queues, retry queues and concurrency slots if it hasn't
+	// been assigned yet. Only set for bulk cancellations, since the other cancellations are for tasks which
+	// the scheduler or the concurrency strategies have already removed from the queues.
+	RemoveFromQueues bool
+}
+
+type CompleteTaskOpts struct {
+	*TaskIdRetryCount
+
+	// (optional) the output of the task. This is stored on the completed event, so it can be passed to
+	// child tasks when a DAG is replayed.
+	Output []byte
+}
+
 type TaskIdEventKeyTuple struct {
 	Id int64 `validate:"required"`
 
@@ -73,11 +94,20 @@ type TaskIdEventKeyTuple struct {
 type TaskRepository interface {
 	UpdateTablePartitions(ctx context.Context) error
 
-	CompleteTasks(ctx context.Context, tenantId string, tasks []TaskIdRetryCount) ([]*sqlcv2.ReleaseTasksRow, error)
+	CompleteTasks(ctx context.Context, tenantId string, tasks []CompleteTaskOpts) ([]*sqlcv2.ReleaseTasksRow, error)
 
 	FailTasks(ctx context.Context, tenantId string, tasks []FailTaskOpts) (retriedTasks []TaskIdRetryCount, queues []*sqlcv2.ReleaseTasksRow, err error)
 
-	CancelTasks(ctx context.Context, tenantId string, tasks []TaskIdRetryCount) ([]*sqlcv2.ReleaseTasksRow, []*sqlcv2.V2Task, error)
+	CancelTasks(ctx context.Context, tenantId string, tasks []CancelTaskOpts) ([]*sqlcv2.ReleaseTasksRow, []*sqlcv2.V2Task, error)
+
+	// ReplayTasks queues finished tasks again with a new retry count. Tasks which are still queued or running
+	// are skipped. Returns the tasks which were replayed.
+	ReplayTasks(ctx context.Context, tenantId string, taskIds []int64) ([]*sqlcv2.V2Task, error)
+
+	// ReplayDAGFromTask queues a task in a DAG again, and resets every task downstream of it so that they run
+	// again once their parents complete. The outputs of upstream tasks which don't run again are reused. Tasks
+	// are run again in place with a new retry count, rather than being re-created.
+	ReplayDAGFromTask(ctx context.Context, tenantId string, taskId int64) (*ReplayDAGFromTaskResult, error)
 
 	ListTasks(ctx context.Context, tenantId string, tasks []int64) ([]*sqlcv2.V2Task, error)
 
@@ -85,11 +115,17 @@ type TaskRepository interface {
 
 	ListTaskMetas(ctx context.Context, tenantId string, tasks []int64) ([]*sqlcv2.ListTaskMetasRow, error)
 
+	// ReleaseSlot frees the worker slot held by a running task for the remainder of its run.
+	ReleaseSlot(ctx context.Context, tenantId string, task TaskIdRetryCount) (*sqlcv2.V2TaskRuntime, error)
+
 	ProcessTaskTimeouts(ctx context.Context, tenantId string) ([]*sqlcv2.ProcessTaskTimeoutsRow, bool, error)
 
 	ProcessTaskReassignments(ctx context.Context, tenantId string) ([]*sqlcv2.ProcessTaskReassignmentsRow, bool, error)
 
 	GetQueueCounts(ctx context.Context, tenantId string) (map[string]int, error)
+
+	// GetTaskQueueState returns the state which determines whether a task can be assigned to a worker.
+	GetTaskQueueState(ctx context.Context, tenantId string, taskId int64, taskInsertedAt pgtype.Timestamptz) (*TaskQueueState, error)
 }
 
 type TaskRepositoryImpl struct {
@@ -248,10 +284,57 @@ func (r *TaskRepositoryImpl) UpdateTablePartitions(ctx context.Context) error {
 		}
 	}
 
+	err = r.queries.CreateTaskRateLimitPartition(ctx, r.pool, pgtype.Date{
+		Time:  today,
+		Valid: true,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	err = r.queries.CreateTaskRateLimitPartition(ctx, r.pool, pgtype.Date{
+		Time:  tomorrow,
+		Valid: true,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	rateLimitPartitions, err := r.queries.ListTaskRateLimitPartitionsBeforeDate(ctx, r.pool, pgtype.Date{
+		Time:  sevenDaysAgo,
+		Valid: true,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, partition := range rateLimitPartitions {
+		_, err := r.pool.Exec(
+			ctx,
+			fmt.Sprintf("ALTER TABLE v2_task_rate_limit DETACH PARTITION %s CONCURRENTLY", partition),
+		)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = r.pool.Exec(
+			ctx,
+			fmt.Sprintf("DROP TABLE %s", partition),
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (r *TaskRepositoryImpl) CompleteTasks(ctx context.Context, tenantId string, tasks []TaskIdRetryCount) ([]*sqlcv2.ReleaseTasksRow, error) {
+func (r *TaskRepositoryImpl) CompleteTasks(ctx context.Context, tenantId string, completeOpts []CompleteTaskOpts) ([]*sqlcv2.ReleaseTasksRow, error) {
 	// TODO: ADD BACK VALIDATION
 	// if err := r.v.Validate(tasks); err != nil {
 	// 	fmt.Println("FAILED VALIDATION HERE!!!")
@@ -259,10 +342,17 @@ func (r *TaskRepositoryImpl) CompleteTasks(ctx context.Context, tenantId string,
 	// 	return err
 	// }
 
-	datas := make([][]byte, len(tasks))
+	tasks := make([]TaskIdRetryCount, len(completeOpts))
+	datas := make([][]byte, len(completeOpts))
 
-	for i := range tasks {
+	for i, completeOpt := range completeOpts {
+		tasks[i] = *completeOpt.TaskIdRetryCount
 		datas[i] = nil
+
+		// the event data is stored as JSON, so outputs which aren't valid JSON are dropped
+		if len(completeOpt.Output) > 0 && json.Valid(completeOpt.Output) {
+			datas[i] = completeOpt.Output
+		}
 	}
 
 	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
@@ -405,7 +495,7 @@ func (r *TaskRepositoryImpl) FailTasks(ctx context.Context, tenantId string, fai
 	return retriedTasks, releasedTasks, nil
 }
 
-func (r *TaskRepositoryImpl) CancelTasks(ctx context.Context, tenantId string, tasks []TaskIdRetryCount) ([]*sqlcv2.ReleaseTasksRow, []*sqlcv2.V2Task, error) {
+func (r *TaskRepositoryImpl) CancelTasks(ctx context.Context, tenantId string, tasks []CancelTaskOpts) ([]*sqlcv2.ReleaseTasksRow, []*sqlcv2.V2Task, error) {
 	// TODO: ADD BACK VALIDATION
 	// if err := r.v.Validate(tasks); err != nil {
 	// 	fmt.Println("FAILED VALIDATION HERE!!!")
@@ -448,20 +538,46 @@ func (r *TaskRepositoryImpl) CancelTasks(ctx context.Context, tenantId string, t
 	return releasedTasks, resTasks, nil
 }
 
-func (r *sharedRepository) cancelTasks(ctx context.Context, dbtx sqlcv2.DBTX, tenantId string, tasks []TaskIdRetryCount) ([]*sqlcv2.ReleaseTasksRow, error) {
+func (r *sharedRepository) cancelTasks(ctx context.Context, dbtx sqlcv2.DBTX, tenantId string, tasks []CancelTaskOpts) ([]*sqlcv2.ReleaseTasksRow, error) {
+	idRetryCounts := make([]TaskIdRetryCount, len(tasks))
+
+	// remove tasks which haven't been assigned yet from the queues
+	queuedTaskIds := make([]int64, 0)
+	queuedRetryCounts := make([]int32, 0)
+
+	for i, task := range tasks {
+		idRetryCounts[i] = *task.TaskIdRetryCount
+
+		if task.RemoveFromQueues {
+			queuedTaskIds = append(queuedTaskIds, task.Id)
+			queuedRetryCounts = append(queuedRetryCounts, task.RetryCount)
+		}
+	}
+
 	// release queue items
-	releasedTasks, err := r.releaseTasks(ctx, dbtx, tenantId, tasks)
+	releasedTasks, err := r.releaseTasks(ctx, dbtx, tenantId, idRetryCounts)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if len(queuedTaskIds) > 0 {
+		err = r.queries.DeleteTaskQueueItems(ctx, dbtx, sqlcv2.DeleteTaskQueueItemsParams{
+			Taskids:     queuedTaskIds,
+			Retrycounts: queuedRetryCounts,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not delete queue items: %w", err)
+		}
+	}
+
 	// write task events
 	err = r.createTaskEvents(
 		ctx,
 		dbtx,
 		tenantId,
-		tasks,
+		idRetryCounts,
 		make([][]byte, len(tasks)),
 		sqlcv2.V2TaskEventTypeCANCELLED,
 		make([]string, len(tasks)),
@@ -474,6 +590,32 @@ func (r *sharedRepository) cancelTasks(ctx context.Context, dbtx sqlcv2.DBTX, te
 	return releasedTasks, nil
 }
 
+func (r *TaskRepositoryImpl) ReplayTasks(ctx context.Context, tenantId string, taskIds []int64) ([]*sqlcv2.V2Task, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	// updating the retry count places the tasks back on the queue
+	tasks, err := r.queries.ReplayTasks(ctx, tx, sqlcv2.ReplayTasksParams{
+		Taskids:  taskIds,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not replay tasks: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
 func (r *TaskRepositoryImpl) ListTasks(ctx context.Context, tenantId string, tasks []int64) ([]*sqlcv2.V2Task, error) {
 	return r.listTasks(ctx, r.pool, tenantId, tasks)
 }
@@ -509,6 +651,24 @@ func (r *TaskRepositoryImpl) ListTaskMetas(ctx context.Context, tenantId string,
 	})
 }
 
+func (r *TaskRepositoryImpl) ReleaseSlot(ctx context.Context, tenantId string, task TaskIdRetryCount) (*sqlcv2.V2TaskRuntime, error) {
+	runtime, err := r.queries.ReleaseTaskRuntimeSlot(ctx, r.pool, sqlcv2.ReleaseTaskRuntimeSlotParams{
+		Taskid:     task.Id,
+		Retrycount: task.RetryCount,
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("task %d is not running", task.Id)
+		}
+
+		return nil, err
+	}
+
+	return runtime, nil
+}
+
 func (r *TaskRepositoryImpl) ProcessTaskTimeouts(ctx context.Context, tenantId string) ([]*sqlcv2.ProcessTaskTimeoutsRow, bool, error) {
 	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
 
@@ -738,6 +898,12 @@ func (r *sharedRepository) insertTasks(
 		return nil, fmt.Errorf("failed to get step expressions: %w", err)
 	}
 
+	stepIdsToRateLimits, err := r.getStepRateLimits(ctx, tx, tenantId, stepIdsToConfig)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get step rate limits: %w", err)
+	}
+
 	tenantIds := make([]pgtype.UUID, len(tasks))
 	queues := make([]string, len(tasks))
 	actionIds := make([]string, len(tasks))
@@ -760,6 +926,8 @@ func (r *sharedRepository) insertTasks(
 	dagInsertedAts := make([]pgtype.Timestamptz, len(tasks))
 	strategyIds := make([][]int64, len(tasks))
 	concurrencyKeys := make([][]string, len(tasks))
+	keyWeights := make(map[concurrencyKeyWeightKey]int32)
+	externalIdsToRateLimits := make(map[string][]taskRateLimit)
 	unix := time.Now().UnixMilli()
 
 	for i, task := range tasks {
@@ -805,59 +973,40 @@ func (r *sharedRepository) insertTasks(
 		if task.InitialState == sqlcv2.V2TaskInitialStateQUEUED {
 			// if we have a step expression, evaluate the expression
 			if strats, ok := concurrencyStrats[task.StepId]; ok {
-				taskConcurrencyKeys := make([]string, 0)
-				taskStrategyIds := make([]int64, 0)
-				var failTaskError error
-
-				for _, strat := range strats {
-					var additionalMeta map[string]interface{}
-
-					if len(additionalMetadatas[i]) > 0 {
-						if err := json.Unmarshal(additionalMetadatas[i], &additionalMeta); err != nil {
-							failTaskError = fmt.Errorf("failed to process additional metadata: not a json object")
-							break
-						}
-					}
+				taskConcurrencyKeys, taskStrategyIds, taskKeyWeights, failTaskError := r.evaluateConcurrencyKeys(task, additionalMetadatas[i], strats)
 
-					res, err := r.celParser.ParseAndEvalStepRun(strat.Expression, cel.NewInput(
-						cel.WithInput(task.Input.Input),
-						cel.WithAdditionalMetadata(additionalMeta),
-						cel.WithWorkflowRunID(task.ExternalId),
-						cel.WithParents(task.Input.TriggerData),
-					))
+				if failTaskError != nil {
+					// place the task into a failed state
+					initialStates[i] = string(sqlcv2.V2TaskInitialStateFAILED)
 
-					if err != nil {
-						failTaskError = fmt.Errorf("failed to parse step expression (%s): %w", strat.Expression, err)
-						break
+					initialStateReasons[i] = pgtype.Text{
+						String: failTaskError.Error(),
+						Valid:  true,
 					}
+				} else {
+					concurrencyKeys[i] = taskConcurrencyKeys
+					strategyIds[i] = taskStrategyIds
 
-					if res.String == nil {
-						prefix := "expected string output for concurrency key"
-
-						if res.Int != nil {
-							failTaskError = fmt.Errorf("failed to parse step expression (%s): %s, got int", strat.Expression, prefix)
-							break
-						}
-
-						failTaskError = fmt.Errorf("failed to parse step expression (%s): %s, got unknown type", strat.Expression, prefix)
-						break
+					for k, weight := range taskKeyWeights {
+						keyWeights[k] = weight
 					}
-
-					taskConcurrencyKeys = append(taskConcurrencyKeys, *res.String)
-					taskStrategyIds = append(taskStrategyIds, strat.ID)
 				}
+			}
+
+			// resolve the rate limit keys and units of the task, so the scheduler doesn't need to
+			// evaluate any expressions
+			if limits, ok := stepIdsToRateLimits[task.StepId]; ok && initialStates[i] == string(sqlcv2.V2TaskInitialStateQUEUED) {
+				taskRateLimits, failTaskError := r.evaluateRateLimits(task, additionalMetadatas[i], limits)
 
 				if failTaskError != nil {
-					// place the task into a failed state
 					initialStates[i] = string(sqlcv2.V2TaskInitialStateFAILED)
 
 					initialStateReasons[i] = pgtype.Text{
 						String: failTaskError.Error(),
 						Valid:  true,
 					}
-				} else {
-					concurrencyKeys[i] = taskConcurrencyKeys
-					strategyIds[i] = taskStrategyIds
+				} else if len(taskRateLimits) > 0 {
+					externalIdsToRateLimits[task.ExternalId] = taskRateLimits
 				}
 			}
 		}
@@ -898,12 +1047,153 @@ func (r *sharedRepository) insertTasks(
 		return nil, fmt.Errorf("failed to create tasks: %w", err)
 	}
 
+	if len(externalIdsToRateLimits) > 0 {
+		err = r.createTaskRateLimits(ctx, tx, tenantId, res, externalIdsToRateLimits)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create task rate limits: %w", err)
+		}
+	}
+
+	if len(keyWeights) > 0 {
+		err = r.upsertConcurrencyKeyWeights(ctx, tx, tenantId, keyWeights)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to upsert concurrency key weights: %w", err)
+		}
+	}
+
 	// TODO: this should be moved to after the transaction commits
 	saveQueueCache()
 
 	return res, nil
 }
 
+// evaluateConcurrencyKeys evaluates the concurrency expressions of a step for a task. If an expression can't be
+// evaluated, an error is returned which should be used to place the task into a failed state.
+func (r *sharedRepository) evaluateConcurrencyKeys(
+	task CreateTaskOpts,
+	additionalMetadata []byte,
+	strats []*sqlcv2.V2StepConcurrency,
+) ([]string, []int64, map[concurrencyKeyWeightKey]int32, error) {
+	taskConcurrencyKeys := make([]string, 0)
+	taskStrategyIds := make([]int64, 0)
+	taskKeyWeights := make(map[concurrencyKeyWeightKey]int32)
+
+	for _, strat := range strats {
+		var additionalMeta map[string]interface{}
+
+		if len(additionalMetadata) > 0 {
+			if err := json.Unmarshal(additionalMetadata, &additionalMeta); err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to process additional metadata: not a json object")
+			}
+		}
+
+		parser, err := r.celParser.AtVersion(uint32(strat.CelLibraryVersion)) // nolint: gosec
+
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to parse step expression (%s): %w", strat.Expression, err)
+		}
+
+		res, err := parser.ParseAndEvalStepRun(strat.Expression, cel.NewInput(
+			cel.WithInput(task.Input.Input),
+			cel.WithAdditionalMetadata(additionalMeta),
+			cel.WithWorkflowRunID(task.ExternalId),
+			cel.WithParents(task.Input.TriggerData),
+		))
+
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to parse step expression (%s): %w", strat.Expression, err)
+		}
+
+		if res.String == nil {
+			prefix := "expected string output for concurrency key"
+
+			if res.Int != nil {
+				return nil, nil, nil, fmt.Errorf("failed to parse step expression (%s): %s, got int", strat.Expression, prefix)
+			}
+
+			return nil, nil, nil, fmt.Errorf("failed to parse step expression (%s): %s, got unknown type", strat.Expression, prefix)
+		}
+
+		if strat.Strategy == sqlcv2.V2ConcurrencyStrategyWEIGHTEDROUNDROBIN && strat.WeightExpression.Valid {
+			weightRes, err := parser.ParseAndEvalStepRun(strat.WeightExpression.String, cel.NewInput(
+				cel.WithInput(task.Input.Input),
+				cel.WithAdditionalMetadata(additionalMeta),
+				cel.WithWorkflowRunID(task.ExternalId),
+				cel.WithParents(task.Input.TriggerData),
+			))
+
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to parse weight expression (%s): %w", strat.WeightExpression.String, err)
+			}
+
+			if weightRes.Int == nil {
+				return nil, nil, nil, fmt.Errorf("failed to parse weight expression (%s): expected int output for concurrency weight", strat.WeightExpression.String)
+			}
+
+			if *weightRes.Int < 1 || *weightRes.Int > MAX_CONCURRENCY_WEIGHT {
+				return nil, nil, nil, fmt.Errorf("failed to parse weight expression (%s): concurrency weight must be between 1 and %d, got %d", strat.WeightExpression.String, MAX_CONCURRENCY_WEIGHT, *weightRes.Int)
+			}
+
+			taskKeyWeights[concurrencyKeyWeightKey{
+				strategyId: strat.ID,
+				key:        *res.String,
+			}] = int32(*weightRes.Int) // nolint: gosec
+		}
+
+		taskConcurrencyKeys = append(taskConcurrencyKeys, *res.String)
+		taskStrategyIds = append(taskStrategyIds, strat.ID)
+	}
+
+	return taskConcurrencyKeys, taskStrategyIds, taskKeyWeights, nil
+}
+
+type concurrencyKeyWeightKey struct {
+	strategyId int64
+	key        string
+}
+
+// upsertConcurrencyKeyWeights writes the latest weight for each concurrency key of a weighted round robin
+// strategy. Keys are written in a stable order to prevent deadlocks between concurrent inserts.
+func (r *sharedRepository) upsertConcurrencyKeyWeights(
+	ctx context.Context,
+	tx sqlcv2.DBTX,
+	tenantId string,
+	keyWeights map[concurrencyKeyWeightKey]int32,
+) error {
+	keys := make([]concurrencyKeyWeightKey, 0, len(keyWeights))
+
+	for k := range keyWeights {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].strategyId != keys[j].strategyId {
+			return keys[i].strategyId < keys[j].strategyId
+		}
+
+		return keys[i].key < keys[j].key
+	})
+
+	strategyIds := make([]int64, len(keys))
+	concurrencyKeys := make([]string, len(keys))
+	weights := make([]int32, len(keys))
+
+	for i, k := range keys {
+		strategyIds[i] = k.strategyId
+		concurrencyKeys[i] = k.key
+		weights[i] = keyWeights[k]
+	}
+
+	return r.queries.UpsertConcurrencyKeyWeights(ctx, tx, sqlcv2.UpsertConcurrencyKeyWeightsParams{
+		Strategyids: strategyIds,
+		Keys:        concurrencyKeys,
+		Weights:     weights,
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+	})
+}
+
 func (r *sharedRepository) getConcurrencyExpressions(
 	ctx context.Context,
 	tx sqlcv2.DBTX,
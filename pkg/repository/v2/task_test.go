@@ -0,0 +1,98 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+func TestEvaluateConcurrencyKeyWeights(t *testing.T) {
+	r := &sharedRepository{
+		celParser: cel.NewCELParser(),
+	}
+
+	task := CreateTaskOpts{
+		ExternalId: "3f6b4c1e-7a3d-4d0c-9f7e-2b1a5c8d9e0f",
+		Input: &TaskInput{
+			Input: map[string]interface{}{
+				"customer_id": "acme",
+				"tier":        "paid",
+			},
+		},
+	}
+
+	strats := []*sqlcv2.V2StepConcurrency{
+		{
+			ID:               1,
+			Strategy:         sqlcv2.V2ConcurrencyStrategyWEIGHTEDROUNDROBIN,
+			Expression:       `input.customer_id`,
+			WeightExpression: sqlchelpers.TextFromStr(`input.tier == "paid" ? 4 : 1`),
+		},
+		{
+			// weight expressions are ignored outside of the weighted round robin strategy
+			ID:               2,
+			Strategy:         sqlcv2.V2ConcurrencyStrategyGROUPROUNDROBIN,
+			Expression:       `input.tier`,
+			WeightExpression: sqlchelpers.TextFromStr(`2`),
+		},
+	}
+
+	keys, strategyIds, weights, err := r.evaluateConcurrencyKeys(task, nil, strats)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"acme", "paid"}, keys)
+	assert.Equal(t, []int64{1, 2}, strategyIds)
+	assert.Equal(t, map[concurrencyKeyWeightKey]int32{
+		{strategyId: 1, key: "acme"}: 4,
+	}, weights)
+
+	for _, expr := range []string{`0`, `10001`, `4294967297`, `"heavy"`} {
+		strats[0].WeightExpression = sqlchelpers.TextFromStr(expr)
+
+		_, _, _, err = r.evaluateConcurrencyKeys(task, nil, strats)
+		assert.ErrorContains(t, err, "failed to parse weight expression", expr)
+	}
+}
+
+func TestEvaluateConcurrencyKeysLibraryVersion(t *testing.T) {
+	r := &sharedRepository{
+		celParser: cel.NewCELParser(),
+	}
+
+	task := CreateTaskOpts{
+		ExternalId: "3f6b4c1e-7a3d-4d0c-9f7e-2b1a5c8d9e0f",
+		Input: &TaskInput{
+			Input: map[string]interface{}{
+				"customer_id": "acme",
+			},
+		},
+	}
+
+	strats := []*sqlcv2.V2StepConcurrency{
+		{
+			ID:                1,
+			Strategy:          sqlcv2.V2ConcurrencyStrategyGROUPROUNDROBIN,
+			Expression:        `string(bucket(input.customer_id, 4))`,
+			CelLibraryVersion: int32(cel.LibraryVersion),
+		},
+	}
+
+	_, _, _, err := r.evaluateConcurrencyKeys(task, nil, strats)
+	require.NoError(t, err)
+
+	// expressions are compiled against the version of the library which they were stored with
+	strats[0].CelLibraryVersion = 0
+
+	_, _, _, err = r.evaluateConcurrencyKeys(task, nil, strats)
+	assert.ErrorContains(t, err, "undeclared reference to 'bucket'")
+
+	strats[0].CelLibraryVersion = int32(cel.LibraryVersion) + 1
+
+	_, _, _, err = r.evaluateConcurrencyKeys(task, nil, strats)
+	assert.ErrorContains(t, err, "newer than the latest supported version")
+}
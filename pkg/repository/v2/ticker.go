@@ -0,0 +1,416 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	// embed the IANA timezone database, so that cron timezones don't depend on the host
+	_ "time/tzdata"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/robfig/cron/v3"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+const (
+	// the number of crons or scheduled workflows which are processed in a single transaction
+	tickerBatchSize = 100
+
+	// fires which are due within this window of the current time are considered on time, anything older
+	// is treated as a missed fire and handled according to the cron's missed fire policy
+	cronFireGracePeriod = time.Minute
+
+	// crons with expressions that can't be parsed are retried after this interval
+	invalidCronRetryInterval = time.Hour
+)
+
+type WorkflowFire struct {
+	// The external id of the workflow run which is triggered by the fire
+	ExternalId string
+
+	// The name of the workflow to trigger
+	WorkflowName string
+
+	// The time at which the workflow was due to fire
+	FireAt time.Time
+
+	Input []byte
+
+	AdditionalMetadata []byte
+}
+
+type CronLastRun struct {
+	CronId string
+
+	// The external id of the last workflow run triggered by the cron
+	ExternalId string
+
+	// The time at which the last workflow run was triggered
+	FiredAt time.Time
+}
+
+type ProcessCronSchedulesResult struct {
+	// The fires which should be triggered
+	Fires []*WorkflowFire
+
+	// The external ids of previous runs which should be cancelled before the fires are triggered, for crons
+	// with the CANCEL_PREVIOUS overlap policy
+	CancelRunExternalIds []string
+}
+
+// CronSchedulesHandler cancels the previous runs and triggers the fires of processed crons. It is called
+// before the crons are advanced to their next fire times, so if it returns an error the crons are left as
+// they were and processed again on the next poll. Fires may be triggered more than once if committing fails
+// after the handler succeeds.
+type CronSchedulesHandler func(ctx context.Context, res *ProcessCronSchedulesResult) error
+
+// ScheduledWorkflowsHandler triggers the fires of processed scheduled workflows. It is called before the
+// scheduled workflows are marked as triggered, in the same way as CronSchedulesHandler.
+type ScheduledWorkflowsHandler func(ctx context.Context, fires []*WorkflowFire) error
+
+type TickerRepository interface {
+	// ListCronLastRuns lists the last runs of the crons for a tenant which are due to fire and have an
+	// overlap policy, so that the caller can check whether the runs are still running.
+	ListCronLastRuns(ctx context.Context, tenantId string) ([]*CronLastRun, error)
+
+	// ProcessCronSchedules finds the crons for a tenant which are due to fire, applies their overlap policies
+	// given the set of cron ids whose last run is still running, passes the fires which should be triggered
+	// to the handler and advances the crons to their next fire time. Returns true if there may be more crons
+	// to process.
+	ProcessCronSchedules(ctx context.Context, tenantId string, runningCronIds map[string]bool, handler CronSchedulesHandler) (bool, error)
+
+	// ProcessScheduledWorkflows finds the one-off scheduled workflows for a tenant which are due to fire,
+	// passes the fires to the handler and marks them as triggered. Returns true if there may be more
+	// scheduled workflows to process.
+	ProcessScheduledWorkflows(ctx context.Context, tenantId string, handler ScheduledWorkflowsHandler) (bool, error)
+}
+
+type TickerRepositoryImpl struct {
+	*sharedRepository
+}
+
+func newTickerRepository(s *sharedRepository) TickerRepository {
+	return &TickerRepositoryImpl{
+		sharedRepository: s,
+	}
+}
+
+func (r *TickerRepositoryImpl) ListCronLastRuns(ctx context.Context, tenantId string) ([]*CronLastRun, error) {
+	rows, err := r.queries.ListCronLastRuns(ctx, r.pool, sqlcv2.ListCronLastRunsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Now:      sqlchelpers.TimestampFromTime(time.Now().UTC()),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list cron last runs: %w", err)
+	}
+
+	res := make([]*CronLastRun, 0, len(rows))
+
+	for _, row := range rows {
+		res = append(res, &CronLastRun{
+			CronId:     sqlchelpers.UUIDToStr(row.ID),
+			ExternalId: sqlchelpers.UUIDToStr(row.LastRunExternalId),
+			FiredAt:    row.LastFiredAt.Time,
+		})
+	}
+
+	return res, nil
+}
+
+func (r *TickerRepositoryImpl) ProcessCronSchedules(ctx context.Context, tenantId string, runningCronIds map[string]bool, handler CronSchedulesHandler) (bool, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer rollback()
+
+	now := time.Now().UTC()
+
+	crons, err := r.queries.ListDueCronSchedules(ctx, tx, sqlcv2.ListDueCronSchedulesParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Now:      sqlchelpers.TimestampFromTime(now),
+		Limit:    pgtype.Int4{Int32: tickerBatchSize, Valid: true},
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not list due cron schedules: %w", err)
+	}
+
+	res := &ProcessCronSchedulesResult{
+		Fires:                make([]*WorkflowFire, 0, len(crons)),
+		CancelRunExternalIds: make([]string, 0),
+	}
+
+	if len(crons) == 0 {
+		return false, nil
+	}
+
+	params := sqlcv2.UpdateCronSchedulesParams{
+		Ids:                make([]pgtype.UUID, 0, len(crons)),
+		Nextfireats:        make([]pgtype.Timestamp, 0, len(crons)),
+		Lastrunexternalids: make([]pgtype.UUID, 0, len(crons)),
+		Lastfiredats:       make([]pgtype.Timestamp, 0, len(crons)),
+		Queuedfireats:      make([]pgtype.Timestamp, 0, len(crons)),
+	}
+
+	for _, c := range crons {
+		cronId := sqlchelpers.UUIDToStr(c.ID)
+
+		sched, err := parseCronSchedule(c.Cron, c.Timezone.String)
+
+		if err != nil {
+			r.l.Error().Err(err).Msgf("could not parse cron expression %s for cron %s", c.Cron, cronId)
+
+			params.Ids = append(params.Ids, c.ID)
+			params.Nextfireats = append(params.Nextfireats, sqlchelpers.TimestampFromTime(now.Add(invalidCronRetryInterval)))
+			params.Lastrunexternalids = append(params.Lastrunexternalids, pgtype.UUID{})
+			params.Lastfiredats = append(params.Lastfiredats, pgtype.Timestamp{})
+			params.Queuedfireats = append(params.Queuedfireats, pgtype.Timestamp{})
+			continue
+		}
+
+		nextFireAt := c.NextFireAt.Time
+
+		if !c.NextFireAt.Valid {
+			// crons which have never been scheduled only fire for times within the grace period, so
+			// that new crons (and crons created before next fire times were tracked) don't fire for
+			// times before they existed
+			since := now.Add(-cronFireGracePeriod)
+
+			if c.CreatedAt.Valid && c.CreatedAt.Time.After(since) {
+				since = c.CreatedAt.Time
+			}
+
+			nextFireAt = sched.Next(since)
+		}
+
+		fires, next := cronFireTimes(sched, nextFireAt, now, c.MissedFirePolicy, int(c.CatchUpLimit))
+
+		fires, queuedFireAt, cancelPrevious := applyOverlapPolicy(
+			c.OverlapPolicy,
+			fires,
+			c.QueuedFireAt.Time,
+			runningCronIds[cronId],
+		)
+
+		if cancelPrevious && c.LastRunExternalId.Valid {
+			res.CancelRunExternalIds = append(res.CancelRunExternalIds, sqlchelpers.UUIDToStr(c.LastRunExternalId))
+		}
+
+		var lastRunExternalId pgtype.UUID
+		var lastFiredAt pgtype.Timestamp
+
+		for _, fireAt := range fires {
+			externalId := uuid.New().String()
+
+			res.Fires = append(res.Fires, &WorkflowFire{
+				ExternalId:         externalId,
+				WorkflowName:       c.WorkflowName,
+				FireAt:             fireAt,
+				Input:              c.Input,
+				AdditionalMetadata: c.AdditionalMetadata,
+			})
+
+			lastRunExternalId = sqlchelpers.UUIDFromStr(externalId)
+			lastFiredAt = sqlchelpers.TimestampFromTime(now)
+		}
+
+		params.Ids = append(params.Ids, c.ID)
+		// a zero next fire time means the schedule never fires again, which leaves the next fire time unset
+		params.Nextfireats = append(params.Nextfireats, sqlchelpers.TimestampFromTime(next.UTC()))
+		params.Lastrunexternalids = append(params.Lastrunexternalids, lastRunExternalId)
+		params.Lastfiredats = append(params.Lastfiredats, lastFiredAt)
+		params.Queuedfireats = append(params.Queuedfireats, sqlchelpers.TimestampFromTime(queuedFireAt.UTC()))
+	}
+
+	err = r.queries.UpdateCronSchedules(ctx, tx, params)
+
+	if err != nil {
+		return false, fmt.Errorf("could not update cron schedules: %w", err)
+	}
+
+	// the crons are locked until the transaction commits, so they can't be processed again while the fires
+	// are being triggered
+	if err := handler(ctx, res); err != nil {
+		return false, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return false, err
+	}
+
+	return len(crons) == tickerBatchSize, nil
+}
+
+func (r *TickerRepositoryImpl) ProcessScheduledWorkflows(ctx context.Context, tenantId string, handler ScheduledWorkflowsHandler) (bool, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer rollback()
+
+	now := time.Now().UTC()
+
+	scheduled, err := r.queries.ListDueScheduledWorkflows(ctx, tx, sqlcv2.ListDueScheduledWorkflowsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Now:      sqlchelpers.TimestampFromTime(now),
+		Limit:    pgtype.Int4{Int32: tickerBatchSize, Valid: true},
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not list due scheduled workflows: %w", err)
+	}
+
+	if len(scheduled) == 0 {
+		return false, nil
+	}
+
+	res := make([]*WorkflowFire, 0, len(scheduled))
+	ids := make([]pgtype.UUID, 0, len(scheduled))
+
+	for _, s := range scheduled {
+		ids = append(ids, s.ID)
+
+		res = append(res, &WorkflowFire{
+			ExternalId:         uuid.New().String(),
+			WorkflowName:       s.WorkflowName,
+			FireAt:             s.TriggerAt.Time,
+			Input:              s.Input,
+			AdditionalMetadata: s.AdditionalMetadata,
+		})
+	}
+
+	err = r.queries.MarkScheduledWorkflowsTriggered(ctx, tx, sqlcv2.MarkScheduledWorkflowsTriggeredParams{
+		Ids:         ids,
+		Triggeredat: sqlchelpers.TimestampFromTime(now),
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not mark scheduled workflows as triggered: %w", err)
+	}
+
+	if err := handler(ctx, res); err != nil {
+		return false, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return false, err
+	}
+
+	return len(scheduled) == tickerBatchSize, nil
+}
+
+// parseCronSchedule parses a standard cron expression, which is evaluated in the given IANA timezone if one
+// is set. Expressions can also set their own timezone with a CRON_TZ= prefix, which takes precedence.
+func parseCronSchedule(expr, timezone string) (cron.Schedule, error) {
+	if timezone != "" && !strings.HasPrefix(expr, "CRON_TZ=") && !strings.HasPrefix(expr, "TZ=") {
+		expr = fmt.Sprintf("CRON_TZ=%s %s", timezone, expr)
+	}
+
+	return cron.ParseStandard(expr)
+}
+
+// cronFireTimes returns the times at which a cron should fire, given the time it was previously due to
+// fire and its missed fire policy, along with the next time that the cron is due.
+func cronFireTimes(
+	sched cron.Schedule,
+	nextFireAt time.Time,
+	now time.Time,
+	policy sqlcv2.WorkflowTriggerCronRefMissedFirePolicy,
+	catchUpLimit int,
+) ([]time.Time, time.Time) {
+	// only the most recent missed fires are kept, since no policy fires more than catchUpLimit of them
+	keepMissed := 1
+
+	if policy == sqlcv2.WorkflowTriggerCronRefMissedFirePolicyCATCHUP && catchUpLimit > keepMissed {
+		keepMissed = catchUpLimit
+	}
+
+	onTime := make([]time.Time, 0)
+	missed := make([]time.Time, 0)
+
+	next := nextFireAt
+
+	// schedules which never fire again return a zero time
+	for !next.IsZero() && !next.After(now) {
+		if now.Sub(next) <= cronFireGracePeriod {
+			onTime = append(onTime, next)
+		} else {
+			missed = append(missed, next)
+
+			if len(missed) > keepMissed {
+				missed = missed[1:]
+			}
+		}
+
+		next = sched.Next(next)
+	}
+
+	switch policy {
+	case sqlcv2.WorkflowTriggerCronRefMissedFirePolicyFIREONCE:
+		if len(onTime) == 0 && len(missed) > 0 {
+			return missed[len(missed)-1:], next
+		}
+	case sqlcv2.WorkflowTriggerCronRefMissedFirePolicyCATCHUP:
+		if catchUpLimit > 0 {
+			return append(missed, onTime...), next
+		}
+	}
+
+	return onTime, next
+}
+
+// applyOverlapPolicy decides which of a cron's due fires are triggered, given whether the last run of the
+// cron is still running. It returns the fires to trigger, the time of a fire which waits for the last run to
+// finish (zero if there is none) and whether the last run should be cancelled. Crons with an overlap
+// policy other than ALLOW fire at most once, for their most recent due time, since earlier fires would
+// overlap with it.
+func applyOverlapPolicy(
+	policy sqlcv2.WorkflowTriggerCronRefOverlapPolicy,
+	fires []time.Time,
+	queuedFireAt time.Time,
+	running bool,
+) ([]time.Time, time.Time, bool) {
+	if policy == sqlcv2.WorkflowTriggerCronRefOverlapPolicyALLOW {
+		// a fire may have been queued before the policy was changed
+		if !queuedFireAt.IsZero() {
+			fires = append([]time.Time{queuedFireAt}, fires...)
+		}
+
+		return fires, time.Time{}, false
+	}
+
+	latest := queuedFireAt
+
+	if len(fires) > 0 {
+		latest = fires[len(fires)-1]
+	}
+
+	if latest.IsZero() {
+		return nil, time.Time{}, false
+	}
+
+	if !running {
+		return []time.Time{latest}, time.Time{}, false
+	}
+
+	switch policy {
+	case sqlcv2.WorkflowTriggerCronRefOverlapPolicyCANCELPREVIOUS:
+		return []time.Time{latest}, time.Time{}, true
+	case sqlcv2.WorkflowTriggerCronRefOverlapPolicyQUEUEONE:
+		// only a single fire is queued, so a newer fire replaces the one which is already waiting
+		return nil, latest, false
+	default:
+		return nil, time.Time{}, false
+	}
+}
@@ -0,0 +1,108 @@
+//go:build integration
+
+package v2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+)
+
+func TestProcessSchedulesHandlerError(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+
+		tenantId := uuid.NewString()
+		slugSuffix, err := random.Generate(8)
+		require.NoError(t, err)
+
+		_, err = conf.APIRepository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+			ID:   &tenantId,
+			Name: "test-tenant",
+			Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+		})
+		require.NoError(t, err)
+
+		workflowVersion, err := conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+			Name:              "nightly",
+			CronTriggers:      []string{"* * * * *"},
+			ScheduledTriggers: []time.Time{time.Now().UTC().Add(-time.Second)},
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name: "nightly",
+					Kind: "DEFAULT",
+					Steps: []repository.CreateWorkflowStepOpts{
+						{ReadableId: "step", Action: "nightly:step"},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		// the cron was due to fire within the grace period
+		_, err = conf.Pool.Exec(
+			ctx,
+			`UPDATE "WorkflowTriggerCronRef" SET "nextFireAt" = $1 WHERE "parentId" IN (
+				SELECT "id" FROM "WorkflowTriggers" WHERE "workflowVersionId" = $2
+			)`,
+			time.Now().UTC().Add(-30*time.Second), workflowVersion.WorkflowVersion.ID,
+		)
+		require.NoError(t, err)
+
+		errSend := errors.New("message queue unavailable")
+		fires := 0
+
+		processCrons := func(handlerErr error) error {
+			_, err := conf.V2.Ticker().ProcessCronSchedules(ctx, tenantId, nil, func(ctx context.Context, res *v2.ProcessCronSchedulesResult) error {
+				fires += len(res.Fires)
+				return handlerErr
+			})
+
+			return err
+		}
+
+		processScheduled := func(handlerErr error) error {
+			_, err := conf.V2.Ticker().ProcessScheduledWorkflows(ctx, tenantId, func(ctx context.Context, scheduled []*v2.WorkflowFire) error {
+				fires += len(scheduled)
+				return handlerErr
+			})
+
+			return err
+		}
+
+		// fires which couldn't be triggered are left as they were, so they're processed again
+		require.ErrorIs(t, processCrons(errSend), errSend)
+		require.Equal(t, 1, fires)
+
+		require.NoError(t, processCrons(nil))
+		require.Equal(t, 2, fires)
+
+		require.NoError(t, processCrons(nil))
+		assert.Equal(t, 2, fires)
+
+		fires = 0
+
+		require.ErrorIs(t, processScheduled(errSend), errSend)
+		require.Equal(t, 1, fires)
+
+		require.NoError(t, processScheduled(nil))
+		require.Equal(t, 2, fires)
+
+		require.NoError(t, processScheduled(nil))
+		assert.Equal(t, 2, fires)
+
+		return nil
+	})
+}
@@ -0,0 +1,204 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+func TestCronFireTimes(t *testing.T) {
+	hourly, err := cron.ParseStandard("0 * * * *")
+	require.NoError(t, err)
+
+	at := func(hour, minute, second int) time.Time {
+		return time.Date(2025, 3, 25, hour, minute, second, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name         string
+		nextFireAt   time.Time
+		now          time.Time
+		policy       sqlcv2.WorkflowTriggerCronRefMissedFirePolicy
+		catchUpLimit int
+		wantFires    []time.Time
+	}{
+		{
+			name:       "not due",
+			nextFireAt: at(10, 0, 0),
+			now:        at(9, 59, 0),
+			policy:     sqlcv2.WorkflowTriggerCronRefMissedFirePolicySKIP,
+			wantFires:  []time.Time{},
+		},
+		{
+			name:       "on time",
+			nextFireAt: at(10, 0, 0),
+			now:        at(10, 0, 5),
+			policy:     sqlcv2.WorkflowTriggerCronRefMissedFirePolicySKIP,
+			wantFires:  []time.Time{at(10, 0, 0)},
+		},
+		{
+			name:       "skip missed",
+			nextFireAt: at(6, 0, 0),
+			now:        at(10, 30, 0),
+			policy:     sqlcv2.WorkflowTriggerCronRefMissedFirePolicySKIP,
+			wantFires:  []time.Time{},
+		},
+		{
+			name:       "skip missed but fire on time",
+			nextFireAt: at(6, 0, 0),
+			now:        at(10, 0, 30),
+			policy:     sqlcv2.WorkflowTriggerCronRefMissedFirePolicySKIP,
+			wantFires:  []time.Time{at(10, 0, 0)},
+		},
+		{
+			name:       "fire once for missed",
+			nextFireAt: at(6, 0, 0),
+			now:        at(10, 30, 0),
+			policy:     sqlcv2.WorkflowTriggerCronRefMissedFirePolicyFIREONCE,
+			wantFires:  []time.Time{at(10, 0, 0)},
+		},
+		{
+			name:       "fire once prefers on time",
+			nextFireAt: at(6, 0, 0),
+			now:        at(10, 0, 30),
+			policy:     sqlcv2.WorkflowTriggerCronRefMissedFirePolicyFIREONCE,
+			wantFires:  []time.Time{at(10, 0, 0)},
+		},
+		{
+			name:         "catch up to limit",
+			nextFireAt:   at(6, 0, 0),
+			now:          at(10, 30, 0),
+			policy:       sqlcv2.WorkflowTriggerCronRefMissedFirePolicyCATCHUP,
+			catchUpLimit: 3,
+			wantFires:    []time.Time{at(8, 0, 0), at(9, 0, 0), at(10, 0, 0)},
+		},
+		{
+			name:         "catch up with on time fire",
+			nextFireAt:   at(8, 0, 0),
+			now:          at(10, 0, 30),
+			policy:       sqlcv2.WorkflowTriggerCronRefMissedFirePolicyCATCHUP,
+			catchUpLimit: 5,
+			wantFires:    []time.Time{at(8, 0, 0), at(9, 0, 0), at(10, 0, 0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fires, next := cronFireTimes(hourly, tt.nextFireAt, tt.now, tt.policy, tt.catchUpLimit)
+
+			assert.Equal(t, tt.wantFires, fires)
+			assert.True(t, next.After(tt.now))
+			assert.Equal(t, hourly.Next(tt.now), next)
+		})
+	}
+}
+
+func TestParseCronScheduleTimezone(t *testing.T) {
+	sched, err := parseCronSchedule("0 9 * * *", "America/New_York")
+	require.NoError(t, err)
+
+	// daylight saving time starts on March 9, 2025 in New York
+	next := sched.Next(time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2025, 3, 8, 14, 0, 0, 0, time.UTC), next.UTC())
+
+	next = sched.Next(next)
+	assert.Equal(t, time.Date(2025, 3, 9, 13, 0, 0, 0, time.UTC), next.UTC())
+
+	// an explicit timezone in the expression takes precedence
+	sched, err = parseCronSchedule("CRON_TZ=UTC 0 9 * * *", "America/New_York")
+	require.NoError(t, err)
+
+	next = sched.Next(time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2025, 3, 8, 9, 0, 0, 0, time.UTC), next.UTC())
+
+	_, err = parseCronSchedule("0 9 * * *", "Not/AZone")
+	assert.Error(t, err)
+}
+
+func TestApplyOverlapPolicy(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2025, 3, 25, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name       string
+		policy     sqlcv2.WorkflowTriggerCronRefOverlapPolicy
+		fires      []time.Time
+		queued     time.Time
+		running    bool
+		wantFires  []time.Time
+		wantQueued time.Time
+		wantCancel bool
+	}{
+		{
+			name:      "allow while running",
+			policy:    sqlcv2.WorkflowTriggerCronRefOverlapPolicyALLOW,
+			fires:     []time.Time{at(9), at(10)},
+			running:   true,
+			wantFires: []time.Time{at(9), at(10)},
+		},
+		{
+			name:      "allow fires a previously queued fire",
+			policy:    sqlcv2.WorkflowTriggerCronRefOverlapPolicyALLOW,
+			fires:     []time.Time{at(10)},
+			queued:    at(9),
+			wantFires: []time.Time{at(9), at(10)},
+		},
+		{
+			name:      "skip if running when idle",
+			policy:    sqlcv2.WorkflowTriggerCronRefOverlapPolicySKIPIFRUNNING,
+			fires:     []time.Time{at(9), at(10)},
+			wantFires: []time.Time{at(10)},
+		},
+		{
+			name:    "skip if running",
+			policy:  sqlcv2.WorkflowTriggerCronRefOverlapPolicySKIPIFRUNNING,
+			fires:   []time.Time{at(10)},
+			running: true,
+		},
+		{
+			name:       "cancel previous",
+			policy:     sqlcv2.WorkflowTriggerCronRefOverlapPolicyCANCELPREVIOUS,
+			fires:      []time.Time{at(10)},
+			running:    true,
+			wantFires:  []time.Time{at(10)},
+			wantCancel: true,
+		},
+		{
+			name:       "queue one while running",
+			policy:     sqlcv2.WorkflowTriggerCronRefOverlapPolicyQUEUEONE,
+			fires:      []time.Time{at(10)},
+			queued:     at(9),
+			running:    true,
+			wantQueued: at(10),
+		},
+		{
+			name:       "queue one keeps waiting fire",
+			policy:     sqlcv2.WorkflowTriggerCronRefOverlapPolicyQUEUEONE,
+			queued:     at(9),
+			running:    true,
+			wantQueued: at(9),
+		},
+		{
+			name:      "queue one fires once idle",
+			policy:    sqlcv2.WorkflowTriggerCronRefOverlapPolicyQUEUEONE,
+			queued:    at(9),
+			wantFires: []time.Time{at(9)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fires, queued, cancel := applyOverlapPolicy(tt.policy, tt.fires, tt.queued, tt.running)
+
+			assert.ElementsMatch(t, tt.wantFires, fires)
+			assert.Equal(t, tt.wantQueued, queued)
+			assert.Equal(t, tt.wantCancel, cancel)
+		})
+	}
+}
@@ -231,11 +231,31 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 
 	// group steps by workflow version ids
 	workflowVersionToSteps := make(map[string][]*sqlcv2.ListStepsByWorkflowVersionIdsRow)
+	stepIds := make([]pgtype.UUID, 0, len(steps))
 
 	for _, step := range steps {
 		workflowVersionId := sqlchelpers.UUIDToStr(step.WorkflowVersionId)
 
 		workflowVersionToSteps[workflowVersionId] = append(workflowVersionToSteps[workflowVersionId], step)
+		stepIds = append(stepIds, step.ID)
+	}
+
+	// get any user event conditions which gate steps
+	stepMatchConditions, err := r.queries.ListStepMatchConditions(ctx, r.pool, sqlcv2.ListStepMatchConditionsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Stepids:  stepIds,
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list step match conditions: %w", err)
+	}
+
+	stepsToMatchConditions := make(map[string][]*sqlcv2.V2StepMatchCondition)
+
+	for _, condition := range stepMatchConditions {
+		stepId := sqlchelpers.UUIDToStr(condition.StepID)
+
+		stepsToMatchConditions[stepId] = append(stepsToMatchConditions[stepId], condition)
 	}
 
 	// start constructing options for creating tasks, DAGs, and triggers. logic is as follows:
@@ -282,11 +302,8 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 			continue
 		}
 
-		isDag := false
-
-		if len(steps) > 1 {
-			isDag = true
-		}
+		// steps which are gated on user events are created from a match, which requires a DAG
+		isDag := len(steps) > 1 || hasUserEventConditions(steps, stepsToMatchConditions)
 
 		for _, step := range steps {
 			if !isDag {
@@ -334,11 +351,7 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 			continue
 		}
 
-		isDag := false
-
-		if len(steps) > 1 {
-			isDag = true
-		}
+		isDag := len(steps) > 1 || hasUserEventConditions(steps, stepsToMatchConditions)
 
 		for _, step := range steps {
 			stepId := sqlchelpers.UUIDToStr(step.ID)
@@ -366,7 +379,7 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 					TriggerExternalId: &taskExternalId,
 					TriggerStepId:     &stepId,
 				})
-			case len(step.Parents) == 0:
+			case len(step.Parents) == 0 && len(stepsToMatchConditions[stepId]) == 0:
 				opt := CreateTaskOpts{
 					ExternalId:         taskExternalId,
 					StepId:             sqlchelpers.UUIDToStr(step.ID),
@@ -391,6 +404,8 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 					conditions = append(conditions, getParentInDAGGroupMatch(createGroupId, parentExternalId)...)
 				}
 
+				conditions = append(conditions, getUserEventGroupMatches(stepsToMatchConditions[stepId])...)
+
 				// create an event match
 				eventMatches[tuple.externalId] = append(eventMatches[tuple.externalId], CreateMatchOpts{
 					Kind:              sqlcv2.V2MatchKindTRIGGER,
@@ -428,7 +443,11 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 		opts, ok := dagTaskOpts[sqlchelpers.UUIDToStr(dag.ExternalID)]
 
 		if !ok {
-			r.l.Error().Msgf("could not find task opts for DAG with external id: %s", sqlchelpers.UUIDToStr(dag.ExternalID))
+			// if every root step is gated on a user event, the DAG won't have any tasks yet
+			if _, hasMatches := eventMatches[sqlchelpers.UUIDToStr(dag.ExternalID)]; !hasMatches {
+				r.l.Error().Msgf("could not find task opts for DAG with external id: %s", sqlchelpers.UUIDToStr(dag.ExternalID))
+			}
+
 			continue
 		}
 
@@ -735,6 +754,56 @@ func getParentInDAGGroupMatch(cancelGroupId, parentExternalId string) []GroupMat
 	}
 }
 
+// getCompletedParentGroupMatch returns a create condition for a parent which has already completed, which is
+// marked as satisfied with the parent's completed data. This is used when replaying part of a DAG, where the
+// outputs of parents which don't run again are reused.
+func getCompletedParentGroupMatch(parentExternalId string, completedData []byte) GroupMatchCondition {
+	return GroupMatchCondition{
+		GroupId:     uuid.NewString(),
+		EventType:   sqlcv2.V2EventTypeINTERNAL,
+		EventKey:    GetTaskCompletedEventKey(parentExternalId),
+		Expression:  "true",
+		Action:      sqlcv2.V2MatchConditionActionCREATE,
+		IsSatisfied: true,
+		Data:        completedData,
+	}
+}
+
+// getUserEventGroupMatches returns a group for each user event condition on a step, so that every
+// user event must be pushed before the step is created.
+func getUserEventGroupMatches(stepConditions []*sqlcv2.V2StepMatchCondition) []GroupMatchCondition {
+	res := make([]GroupMatchCondition, 0, len(stepConditions))
+
+	for _, condition := range stepConditions {
+		expression := "true"
+
+		if condition.Expression.Valid && condition.Expression.String != "" {
+			expression = condition.Expression.String
+		}
+
+		res = append(res, GroupMatchCondition{
+			GroupId:           uuid.NewString(),
+			EventType:         sqlcv2.V2EventTypeUSER,
+			EventKey:          condition.EventKey,
+			Expression:        expression,
+			CelLibraryVersion: &condition.CelLibraryVersion,
+			Action:            sqlcv2.V2MatchConditionActionCREATE,
+		})
+	}
+
+	return res
+}
+
+func hasUserEventConditions(steps []*sqlcv2.ListStepsByWorkflowVersionIdsRow, stepsToMatchConditions map[string][]*sqlcv2.V2StepMatchCondition) bool {
+	for _, step := range steps {
+		if len(stepsToMatchConditions[sqlchelpers.UUIDToStr(step.ID)]) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getChildWorkflowGroupMatches(parentExternalId string) []GroupMatchCondition {
 	groupId := uuid.NewString()
 
@@ -806,3 +875,13 @@ func GetTaskCancelledEventKey(externalId string) string {
 func getChildSignalEventKey(parentTaskId int64, childKey string) string {
 	return fmt.Sprintf("%d.%s", parentTaskId, childKey)
 }
+
+// GetDurableSignalEventKey returns the signal key for a durable event registered by a running task.
+// The retry count is part of the key so that a retried task doesn't pick up events from a previous attempt.
+func GetDurableSignalEventKey(taskId int64, retryCount int32, signalKey string) string {
+	return fmt.Sprintf("%d.durable.%d.%s", taskId, retryCount, signalKey)
+}
+
+func getDurableSleepEventKey(sleepId int64) string {
+	return fmt.Sprintf("sleep.%d", sleepId)
+}
@@ -0,0 +1,146 @@
+//go:build integration
+
+package v2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+)
+
+func TestTriggerWithUserEventConditions(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+
+		require.NoError(t, conf.V2.Tasks().UpdateTablePartitions(ctx))
+
+		tenantId := uuid.NewString()
+		slugSuffix, err := random.Generate(8)
+		require.NoError(t, err)
+
+		_, err = conf.APIRepository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+			ID:   &tenantId,
+			Name: "test-tenant",
+			Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+		})
+		require.NoError(t, err)
+
+		largePayment := "input.amount > 100"
+
+		// a DAG whose root step waits for a large payment to be approved: charge -> ship
+		_, err = conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+			Name: "checkout",
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name: "checkout",
+					Kind: "DEFAULT",
+					Steps: []repository.CreateWorkflowStepOpts{
+						{
+							ReadableId: "charge",
+							Action:     "checkout:charge",
+							UserEventConditions: []repository.CreateWorkflowStepUserEventConditionOpts{
+								{EventKey: "payment:approved", Expression: &largePayment},
+							},
+						},
+						{ReadableId: "ship", Action: "checkout:ship", Parents: []string{"charge"}},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		// a single step which waits for two events
+		_, err = conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+			Name: "reconcile",
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name: "reconcile",
+					Kind: "DEFAULT",
+					Steps: []repository.CreateWorkflowStepOpts{
+						{
+							ReadableId: "reconcile",
+							Action:     "reconcile:reconcile",
+							UserEventConditions: []repository.CreateWorkflowStepUserEventConditionOpts{
+								{EventKey: "ledger:closed"},
+								{EventKey: "bank:settled"},
+							},
+						},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		push := func(key string, data string) *v2.InternalEventMatchResults {
+			res, err := conf.V2.Matches().ProcessUserEventMatches(ctx, tenantId, []v2.CandidateEventMatch{
+				{ID: uuid.NewString(), EventTimestamp: time.Now(), Key: key, Data: []byte(data)},
+			})
+			require.NoError(t, err)
+
+			return res
+		}
+
+		trigger := func(workflowName string) *v2.DAGWithData {
+			tasks, dags, err := conf.V2.Triggers().TriggerFromWorkflowNames(ctx, tenantId, []v2.WorkflowNameTriggerOpts{
+				{
+					WorkflowName:       workflowName,
+					ExternalId:         uuid.NewString(),
+					Data:               []byte(`{}`),
+					AdditionalMetadata: []byte(`{}`),
+				},
+			})
+			require.NoError(t, err)
+
+			// gated steps are created from a match on the DAG, so nothing is created until the events are pushed
+			assert.Empty(t, tasks)
+			require.Len(t, dags, 1)
+
+			return dags[0]
+		}
+
+		t.Run("root step is created once a matching event is pushed", func(t *testing.T) {
+			dag := trigger("checkout")
+
+			assert.Empty(t, push("payment:declined", `{"amount":500}`).CreatedTasks)
+			assert.Empty(t, push("payment:approved", `{"amount":50}`).CreatedTasks)
+			assert.Empty(t, push("payment:approved", `{"currency":"usd"}`).CreatedTasks)
+
+			res := push("payment:approved", `{"amount":500}`)
+			require.Len(t, res.CreatedTasks, 1)
+
+			charge := res.CreatedTasks[0]
+			assert.Equal(t, "charge", charge.StepReadableID)
+			assert.Equal(t, dag.ID, charge.DagID.Int64)
+
+			// the condition is only satisfied once
+			assert.Empty(t, push("payment:approved", `{"amount":500}`).CreatedTasks)
+		})
+
+		t.Run("single step workflow waits for every condition", func(t *testing.T) {
+			dag := trigger("reconcile")
+
+			assert.Empty(t, push("ledger:closed", `{}`).CreatedTasks)
+
+			res := push("bank:settled", `{}`)
+			require.Len(t, res.CreatedTasks, 1)
+
+			reconcile := res.CreatedTasks[0]
+			assert.Equal(t, "reconcile", reconcile.StepReadableID)
+			assert.True(t, reconcile.DagID.Valid)
+			assert.Equal(t, dag.ID, reconcile.DagID.Int64)
+		})
+
+		return nil
+	})
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type CreateWebhookSubscriptionOpts struct {
+	// (required) the name of the subscription, unique within the tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (required) the https url which events are delivered to
+	URL string `validate:"required,url,startswith=https://"`
+
+	// (required) the encrypted secret which deliveries are signed with
+	Secret string `validate:"required"`
+
+	// (optional) whether events are delivered to the subscription, defaults to true
+	Enabled *bool
+
+	// (optional) the event types to deliver. If empty, every event type is delivered.
+	EventTypes []string `validate:"omitempty,dive,oneof=WORKFLOW_RUN_COMPLETED WORKFLOW_RUN_FAILED WORKFLOW_RUN_CANCELLED TASK_COMPLETED TASK_FAILED TASK_CANCELLED TASK_TIMED_OUT"`
+
+	// (optional) the run statuses to deliver. If empty, events for every status are delivered.
+	Statuses []string `validate:"omitempty,dive,oneof=COMPLETED FAILED CANCELLED"`
+
+	// (optional) the workflows to deliver events for. If empty, events for every workflow are delivered.
+	WorkflowIds []string `validate:"omitempty,dive,uuid"`
+}
+
+type UpdateWebhookSubscriptionOpts struct {
+	// (optional) the name of the subscription
+	Name *string `validate:"omitnil,hatchetName"`
+
+	// (optional) the https url which events are delivered to
+	URL *string `validate:"omitnil,url,startswith=https://"`
+
+	// (optional) whether events are delivered to the subscription
+	Enabled *bool
+
+	// (optional) the event types to deliver. A non-nil, empty slice delivers every event type.
+	EventTypes *[]string `validate:"omitnil,dive,oneof=WORKFLOW_RUN_COMPLETED WORKFLOW_RUN_FAILED WORKFLOW_RUN_CANCELLED TASK_COMPLETED TASK_FAILED TASK_CANCELLED TASK_TIMED_OUT"`
+
+	// (optional) the run statuses to deliver. A non-nil, empty slice delivers every status.
+	Statuses *[]string `validate:"omitnil,dive,oneof=COMPLETED FAILED CANCELLED"`
+
+	// (optional) the workflows to deliver events for. A non-nil, empty slice removes the restriction.
+	WorkflowIds *[]string `validate:"omitnil,dive,uuid"`
+}
+
+type CreateWebhookDeliveryOpts struct {
+	// (required) the subscription which the event is delivered to
+	SubscriptionId string `validate:"required,uuid"`
+
+	// (required) the type of the event
+	EventType string `validate:"required"`
+
+	// (required) the JSON body of the delivery
+	Payload []byte `validate:"required"`
+}
+
+type WebhookDeliveryAttemptOpts struct {
+	// (required) the number of the attempt, starting at 1
+	Attempt int32 `validate:"required,min=1"`
+
+	// (optional) the status code of the response, if a response was received
+	ResponseStatusCode *int32
+
+	// (optional) the start of the response body
+	ResponseBody *string
+
+	// (optional) the error which caused the attempt to fail
+	Error *string
+
+	// (required) how long the request took
+	Duration time.Duration
+
+	// (required) the status of the delivery after the attempt
+	Status dbsqlc.WebhookDeliveryStatus `validate:"required,oneof=PENDING SUCCEEDED FAILED"`
+
+	// (optional) when to retry the delivery, if the status is pending
+	NextAttemptAt *time.Time
+}
+
+type ListWebhookDeliveriesOpts struct {
+	// (optional) the delivery statuses to filter by
+	Statuses []string `validate:"omitempty,dive,oneof=PENDING SUCCEEDED FAILED"`
+
+	// (optional) number of deliveries to skip
+	Offset *int
+
+	// (optional) number of deliveries to return
+	Limit *int `validate:"omitnil,min=1,max=1000"`
+}
+
+type ListWebhookDeliveriesResult struct {
+	Rows  []*dbsqlc.WebhookDelivery
+	Count int
+
+	// Attempts are the attempts of each delivery in the result, keyed by delivery id
+	Attempts map[string][]*dbsqlc.WebhookDeliveryAttempt
+}
+
+// WebhookSubscriptionRepository stores the outbound webhook subscriptions of a tenant, and the deliveries
+// of run lifecycle events to those subscriptions.
+type WebhookSubscriptionRepository interface {
+	// CreateWebhookSubscription creates a new webhook subscription in the tenant
+	CreateWebhookSubscription(ctx context.Context, tenantId string, opts *CreateWebhookSubscriptionOpts) (*dbsqlc.WebhookSubscription, error)
+
+	// GetWebhookSubscriptionById returns the webhook subscription with the given id
+	GetWebhookSubscriptionById(ctx context.Context, id string) (*dbsqlc.WebhookSubscription, error)
+
+	// ListWebhookSubscriptions returns all webhook subscriptions in the tenant
+	ListWebhookSubscriptions(ctx context.Context, tenantId string) ([]*dbsqlc.WebhookSubscription, error)
+
+	// UpdateWebhookSubscription updates the webhook subscription with the given id
+	UpdateWebhookSubscription(ctx context.Context, id string, opts *UpdateWebhookSubscriptionOpts) (*dbsqlc.WebhookSubscription, error)
+
+	// DeleteWebhookSubscription deletes the webhook subscription with the given id, along with its deliveries
+	DeleteWebhookSubscription(ctx context.Context, id string) error
+
+	// CreateWebhookDeliveries enqueues events for delivery. Finished deliveries which are older than 7 days
+	// are removed from the tenant.
+	CreateWebhookDeliveries(ctx context.Context, tenantId string, opts []CreateWebhookDeliveryOpts) error
+
+	// PollWebhookDeliveries returns pending deliveries in the tenant which are due, and leases them so they
+	// aren't returned again until the lease expires
+	PollWebhookDeliveries(ctx context.Context, tenantId string, limit int, lease time.Duration) ([]*dbsqlc.WebhookDelivery, error)
+
+	// RecordWebhookDeliveryAttempt stores an attempt to deliver an event, and updates the status of the delivery
+	RecordWebhookDeliveryAttempt(ctx context.Context, deliveryId string, opts *WebhookDeliveryAttemptOpts) error
+
+	// ListWebhookDeliveries lists the deliveries of a subscription along with their attempts, most recent first
+	ListWebhookDeliveries(ctx context.Context, subscriptionId string, opts *ListWebhookDeliveriesOpts) (*ListWebhookDeliveriesResult, error)
+}
@@ -69,6 +69,32 @@ type CreateCronWorkflowTriggerOpts struct {
 
 	Input              map[string]interface{}
 	AdditionalMetadata map[string]interface{}
+
+	// (optional) how fires which were missed while the engine was unavailable are handled, default SKIP
+	MissedFirePolicy *string `validate:"omitnil,oneof=SKIP FIRE_ONCE CATCH_UP"`
+
+	// (optional) the maximum number of missed fires which are run with the CATCH_UP policy
+	CatchUpLimit *int32 `validate:"omitnil,min=0,max=100"`
+
+	// (optional) the IANA timezone which the cron expression is evaluated in, default UTC
+	Timezone *string
+
+	// (optional) how a fire is handled while the previous run of the cron is still running, default ALLOW
+	OverlapPolicy *string `validate:"omitnil,oneof=ALLOW SKIP_IF_RUNNING CANCEL_PREVIOUS QUEUE_ONE"`
+}
+
+type UpdateCronWorkflowTriggerOpts struct {
+	// (optional) how fires which were missed while the engine was unavailable are handled
+	MissedFirePolicy *string `validate:"omitnil,oneof=SKIP FIRE_ONCE CATCH_UP"`
+
+	// (optional) the maximum number of missed fires which are run with the CATCH_UP policy
+	CatchUpLimit *int32 `validate:"omitnil,min=0,max=100"`
+
+	// (optional) the IANA timezone which the cron expression is evaluated in, default UTC
+	Timezone *string
+
+	// (optional) how a fire is handled while the previous run of the cron is still running, default ALLOW
+	OverlapPolicy *string `validate:"omitnil,oneof=ALLOW SKIP_IF_RUNNING CANCEL_PREVIOUS QUEUE_ONE"`
 }
 
 type CreateWorkflowConcurrencyOpts struct {
@@ -79,10 +105,14 @@ type CreateWorkflowConcurrencyOpts struct {
 	MaxRuns *int32
 
 	// (optional) the strategy to use when the concurrency limit is reached, default CANCEL_IN_PROGRESS
-	LimitStrategy *string `validate:"omitnil,oneof=CANCEL_IN_PROGRESS GROUP_ROUND_ROBIN CANCEL_NEWEST"`
+	LimitStrategy *string `validate:"omitnil,oneof=CANCEL_IN_PROGRESS GROUP_ROUND_ROBIN CANCEL_NEWEST WEIGHTED_ROUND_ROBIN"`
 
 	// (optional) a concurrency expression for evaluating the concurrency key
 	Expression *string `validate:"omitempty,celworkflowrunstr"`
+
+	// (optional) an expression which evaluates to the integer weight of the concurrency key, only used by
+	// the WEIGHTED_ROUND_ROBIN strategy. Keys without a weight default to 1.
+	WeightExpression *string `validate:"omitempty,celsteprunstr"`
 }
 
 func (o *CreateWorkflowVersionOpts) Checksum() (string, error) {
@@ -169,6 +199,17 @@ type CreateWorkflowStepOpts struct {
 
 	// (optional) the step retry backoff max seconds (can't be greater than 86400)
 	RetryBackoffMaxSeconds *int `validate:"omitnil,min=1,max=86400"`
+
+	// (optional) user events which must be pushed before the step is run
+	UserEventConditions []CreateWorkflowStepUserEventConditionOpts `validate:"dive"`
+}
+
+type CreateWorkflowStepUserEventConditionOpts struct {
+	// (required) the key of the user event
+	EventKey string `validate:"required"`
+
+	// (optional) a CEL expression which the event payload must satisfy
+	Expression *string `validate:"omitnil,celmatchconditionstr"`
 }
 
 type DesiredWorkerLabelOpts struct {
@@ -306,6 +347,9 @@ type WorkflowAPIRepository interface {
 	// GetCronWorkflow gets a cron workflow run
 	GetCronWorkflow(ctx context.Context, tenantId, cronWorkflowId string) (*dbsqlc.ListCronWorkflowsRow, error)
 
+	// UpdateCronWorkflow updates the missed fire, timezone and overlap settings of a cron workflow
+	UpdateCronWorkflow(ctx context.Context, tenantId, id string, opts *UpdateCronWorkflowTriggerOpts) (*dbsqlc.ListCronWorkflowsRow, error)
+
 	// DeleteCronWorkflow deletes a cron workflow run
 	DeleteCronWorkflow(ctx context.Context, tenantId, id string) error
 
@@ -0,0 +1,410 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+type QueuedTaskReason string
+
+const (
+	// the task isn't waiting in any queue, for example because it's already been assigned to a worker
+	QueuedTaskReasonNotQueued QueuedTaskReason = "NOT_QUEUED"
+
+	// the task has failed and is waiting to be retried
+	QueuedTaskReasonWaitingForRetry QueuedTaskReason = "WAITING_FOR_RETRY"
+
+	// the task is waiting for a concurrency slot to be released
+	QueuedTaskReasonConcurrencyLimited QueuedTaskReason = "CONCURRENCY_LIMITED"
+
+	// the task has exceeded its schedule timeout, and will be cancelled by the scheduler
+	QueuedTaskReasonScheduleTimedOut QueuedTaskReason = "SCHEDULE_TIMED_OUT"
+
+	// no active worker has registered the task's action
+	QueuedTaskReasonNoWorkerForAction QueuedTaskReason = "NO_WORKER_FOR_ACTION"
+
+	// the task has a HARD sticky strategy, and the desired worker can't run it
+	QueuedTaskReasonStickyWorkerUnavailable QueuedTaskReason = "STICKY_WORKER_UNAVAILABLE"
+
+	// every worker which has registered the task's action is missing a required affinity label
+	QueuedTaskReasonLabelsNotMatched QueuedTaskReason = "LABELS_NOT_MATCHED"
+
+	// every worker which can run the task is at capacity
+	QueuedTaskReasonNoAvailableSlots QueuedTaskReason = "NO_AVAILABLE_SLOTS"
+
+	// one or more of the task's rate limits doesn't have enough units available
+	QueuedTaskReasonRateLimited QueuedTaskReason = "RATE_LIMITED"
+
+	// nothing is currently blocking the task, so it should be assigned on the next scheduling loop
+	QueuedTaskReasonAssignable QueuedTaskReason = "ASSIGNABLE"
+)
+
+type QueuedTaskDiagnosisReason struct {
+	Reason  QueuedTaskReason
+	Message string
+}
+
+type QueuedTaskWorkerDiagnosis struct {
+	WorkerId string
+
+	// whether the worker has registered the task's action
+	HasAction bool
+
+	AvailableSlots int
+
+	// the required labels which the worker doesn't match
+	UnmatchedLabels []string
+
+	// set if the worker can't be assigned the task
+	ExcludedReason *QueuedTaskReason
+}
+
+type QueuedTaskRateLimitDiagnosis struct {
+	Key string
+
+	// the units that the task consumes
+	Units int
+
+	// the units which are currently available, or nil if the rate limit doesn't exist
+	Available *int
+
+	NextRefillAt *time.Time
+
+	Exceeded bool
+}
+
+type QueuedTaskDiagnosis struct {
+	Reasons []*QueuedTaskDiagnosisReason
+
+	Workers []*QueuedTaskWorkerDiagnosis
+
+	RateLimits []*QueuedTaskRateLimitDiagnosis
+
+	ConcurrencySlots []*sqlcv2.ListConcurrencySlotsForTaskRow
+}
+
+// QueuedTaskDiagnosisInput is the data which the scheduler uses to decide whether a task can be
+// assigned.
+type QueuedTaskDiagnosisInput struct {
+	State *v2.TaskQueueState
+
+	Workers []*v2.ListActiveWorkersResult
+
+	// the actions registered by each worker, keyed by worker id
+	WorkerActions map[string][]string
+
+	// the number of free slots on each worker, keyed by worker id
+	WorkerSlots map[string]int
+
+	// the current usage of the task's rate limits, keyed by rate limit key
+	RateLimits map[string]*v2.RateLimitUsage
+
+	Now time.Time
+}
+
+// LoadQueuedTaskDiagnosisInput reads the state of a task and the tenant's workers and rate limits,
+// in the same form that the scheduler reads them when replenishing slots.
+func LoadQueuedTaskDiagnosisInput(ctx context.Context, repo v2.Repository, tenantId string, taskId int64, taskInsertedAt pgtype.Timestamptz) (*QueuedTaskDiagnosisInput, error) {
+	tenantIdPg := sqlchelpers.UUIDFromStr(tenantId)
+
+	state, err := repo.Tasks().GetTaskQueueState(ctx, tenantId, taskId, taskInsertedAt)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get task queue state: %w", err)
+	}
+
+	res := &QueuedTaskDiagnosisInput{
+		State:         state,
+		WorkerActions: make(map[string][]string),
+		WorkerSlots:   make(map[string]int),
+		RateLimits:    make(map[string]*v2.RateLimitUsage),
+		Now:           time.Now().UTC(),
+	}
+
+	res.Workers, err = repo.Scheduler().Lease().ListActiveWorkers(ctx, tenantIdPg)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list active workers: %w", err)
+	}
+
+	if len(res.Workers) > 0 {
+		workerIds := make([]pgtype.UUID, 0, len(res.Workers))
+
+		for _, w := range res.Workers {
+			workerIds = append(workerIds, w.ID)
+		}
+
+		actions, err := repo.Scheduler().Assignment().ListActionsForWorkers(ctx, tenantIdPg, workerIds)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list actions for workers: %w", err)
+		}
+
+		for _, row := range actions {
+			if !row.ActionId.Valid {
+				continue
+			}
+
+			workerId := sqlchelpers.UUIDToStr(row.WorkerId)
+			res.WorkerActions[workerId] = append(res.WorkerActions[workerId], row.ActionId.String)
+		}
+
+		slots, err := repo.Scheduler().Assignment().ListAvailableSlotsForWorkers(ctx, tenantIdPg, sqlcv2.ListAvailableSlotsForWorkersParams{
+			Tenantid:  tenantIdPg,
+			Workerids: workerIds,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list available slots for workers: %w", err)
+		}
+
+		for _, row := range slots {
+			res.WorkerSlots[sqlchelpers.UUIDToStr(row.ID)] = int(row.AvailableSlots)
+		}
+	}
+
+	if len(state.RateLimits) > 0 {
+		keys := make([]string, 0, len(state.RateLimits))
+
+		for _, rl := range state.RateLimits {
+			keys = append(keys, rl.Key)
+		}
+
+		limit := len(keys)
+
+		usage, err := repo.Scheduler().RateLimit().ListRateLimitUsage(ctx, tenantIdPg, &v2.ListRateLimitUsageOpts{
+			Keys:  keys,
+			Limit: &limit,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list rate limit usage: %w", err)
+		}
+
+		for _, row := range usage.Rows {
+			res.RateLimits[row.Key] = row
+		}
+	}
+
+	return res, nil
+}
+
+// DiagnoseQueuedTask explains why a task hasn't been assigned to a worker, by applying the same checks
+// that the scheduler applies when assigning queue items.
+func DiagnoseQueuedTask(in *QueuedTaskDiagnosisInput) *QueuedTaskDiagnosis {
+	state := in.State
+	res := &QueuedTaskDiagnosis{
+		Reasons:          make([]*QueuedTaskDiagnosisReason, 0),
+		Workers:          make([]*QueuedTaskWorkerDiagnosis, 0),
+		RateLimits:       make([]*QueuedTaskRateLimitDiagnosis, 0),
+		ConcurrencySlots: state.ConcurrencySlots,
+	}
+
+	addReason := func(reason QueuedTaskReason, format string, args ...any) {
+		res.Reasons = append(res.Reasons, &QueuedTaskDiagnosisReason{
+			Reason:  reason,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	if state.Runtime != nil {
+		addReason(QueuedTaskReasonNotQueued, "task has been assigned to worker %s", sqlchelpers.UUIDToStr(state.Runtime.WorkerID))
+		return res
+	}
+
+	waitingForConcurrency := false
+
+	for _, cs := range state.ConcurrencySlots {
+		if cs.IsFilled {
+			continue
+		}
+
+		waitingForConcurrency = true
+
+		addReason(
+			QueuedTaskReasonConcurrencyLimited,
+			"waiting for a slot for key %q of concurrency strategy %d (%d/%d slots filled)",
+			cs.Key, cs.StrategyID, cs.FilledSlots, cs.MaxConcurrency,
+		)
+
+		if isTimedOutAt(cs.ScheduleTimeoutAt, in.Now) {
+			addReason(QueuedTaskReasonScheduleTimedOut, "schedule timeout was reached at %s", cs.ScheduleTimeoutAt.Time.Format(time.RFC3339))
+		}
+	}
+
+	if state.QueueItem == nil && !waitingForConcurrency {
+		if state.RetryQueueItem != nil {
+			addReason(QueuedTaskReasonWaitingForRetry, "task will be retried at %s", state.RetryQueueItem.RetryAfter.Time.Format(time.RFC3339))
+		} else {
+			addReason(QueuedTaskReasonNotQueued, "task is not waiting to be assigned")
+		}
+
+		return res
+	}
+
+	actionId := state.Task.ActionID
+	sticky := state.Task.Sticky
+	desiredWorkerId := state.Task.DesiredWorkerID
+
+	if qi := state.QueueItem; qi != nil {
+		actionId = qi.ActionID
+		sticky = qi.Sticky
+		desiredWorkerId = qi.DesiredWorkerID
+
+		if isTimedOutAt(qi.ScheduleTimeoutAt, in.Now) {
+			addReason(QueuedTaskReasonScheduleTimedOut, "schedule timeout was reached at %s", qi.ScheduleTimeoutAt.Time.Format(time.RFC3339))
+		}
+	}
+
+	res.Workers = diagnoseWorkers(in, actionId, sticky, desiredWorkerId)
+
+	withAction, eligible, availableSlots := 0, 0, 0
+
+	for _, w := range res.Workers {
+		if w.HasAction {
+			withAction++
+		}
+
+		// workers which are only excluded because they're at capacity can still run the task
+		if w.ExcludedReason == nil || *w.ExcludedReason == QueuedTaskReasonNoAvailableSlots {
+			eligible++
+			availableSlots += max(w.AvailableSlots, 0)
+		}
+	}
+
+	switch {
+	case withAction == 0:
+		addReason(QueuedTaskReasonNoWorkerForAction, "no active worker has registered action %s", actionId)
+	case eligible == 0 && sticky == sqlcv2.V2StickyStrategyHARD:
+		addReason(
+			QueuedTaskReasonStickyWorkerUnavailable,
+			"task must run on worker %s, which is not active or has not registered action %s",
+			sqlchelpers.UUIDToStr(desiredWorkerId), actionId,
+		)
+	case eligible == 0:
+		addReason(QueuedTaskReasonLabelsNotMatched, "none of the %d workers with action %s match the required labels", withAction, actionId)
+	case availableSlots == 0:
+		addReason(QueuedTaskReasonNoAvailableSlots, "all %d workers which can run the task are at capacity", eligible)
+	}
+
+	res.RateLimits = diagnoseRateLimits(in)
+
+	for _, rl := range res.RateLimits {
+		if !rl.Exceeded {
+			continue
+		}
+
+		if rl.Available == nil {
+			addReason(QueuedTaskReasonRateLimited, "rate limit %q does not exist", rl.Key)
+		} else {
+			addReason(QueuedTaskReasonRateLimited, "rate limit %q has %d units available, but the task uses %d", rl.Key, *rl.Available, rl.Units)
+		}
+	}
+
+	if len(res.Reasons) == 0 {
+		addReason(QueuedTaskReasonAssignable, "task can be assigned, and should be picked up by the scheduler shortly")
+	}
+
+	return res
+}
+
+func diagnoseWorkers(in *QueuedTaskDiagnosisInput, actionId string, sticky sqlcv2.V2StickyStrategy, desiredWorkerId pgtype.UUID) []*QueuedTaskWorkerDiagnosis {
+	res := make([]*QueuedTaskWorkerDiagnosis, 0, len(in.Workers))
+
+	for _, w := range in.Workers {
+		workerId := sqlchelpers.UUIDToStr(w.ID)
+
+		d := &QueuedTaskWorkerDiagnosis{
+			WorkerId:        workerId,
+			AvailableSlots:  in.WorkerSlots[workerId],
+			UnmatchedLabels: make([]string, 0),
+		}
+
+		for _, action := range in.WorkerActions[workerId] {
+			if action == actionId {
+				d.HasAction = true
+				break
+			}
+		}
+
+		res = append(res, d)
+
+		if !d.HasAction {
+			reason := QueuedTaskReasonNoWorkerForAction
+			d.ExcludedReason = &reason
+			continue
+		}
+
+		// this mirrors getRankedSlots: sticky strategies take precedence over affinity labels
+		switch sticky {
+		case sqlcv2.V2StickyStrategyHARD:
+			if desiredWorkerId.Valid && workerId != sqlchelpers.UUIDToStr(desiredWorkerId) {
+				reason := QueuedTaskReasonStickyWorkerUnavailable
+				d.ExcludedReason = &reason
+			}
+		case sqlcv2.V2StickyStrategySOFT:
+		default:
+			ww := &worker{ListActiveWorkersResult: w}
+
+			for _, label := range in.State.DesiredLabels {
+				if ww.computeWeight([]*sqlcv2.GetDesiredLabelsRow{label}) < 0 {
+					d.UnmatchedLabels = append(d.UnmatchedLabels, label.Key)
+				}
+			}
+
+			if len(d.UnmatchedLabels) > 0 {
+				reason := QueuedTaskReasonLabelsNotMatched
+				d.ExcludedReason = &reason
+			}
+		}
+
+		if d.ExcludedReason == nil && d.AvailableSlots <= 0 {
+			reason := QueuedTaskReasonNoAvailableSlots
+			d.ExcludedReason = &reason
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return strings.Compare(res[i].WorkerId, res[j].WorkerId) < 0
+	})
+
+	return res
+}
+
+func diagnoseRateLimits(in *QueuedTaskDiagnosisInput) []*QueuedTaskRateLimitDiagnosis {
+	res := make([]*QueuedTaskRateLimitDiagnosis, 0, len(in.State.RateLimits))
+
+	for _, taskRl := range in.State.RateLimits {
+		d := &QueuedTaskRateLimitDiagnosis{
+			Key:   taskRl.Key,
+			Units: int(taskRl.Units),
+		}
+
+		// like the rate limiter, a rate limit which doesn't exist can never be used
+		if usage, ok := in.RateLimits[taskRl.Key]; ok {
+			available := usage.Available
+			d.Available = &available
+			d.NextRefillAt = usage.NextRefillAt
+			d.Exceeded = available < d.Units
+		} else {
+			d.Exceeded = true
+		}
+
+		res = append(res, d)
+	}
+
+	return res
+}
+
+func isTimedOutAt(scheduleTimeoutAt pgtype.Timestamp, now time.Time) bool {
+	return scheduleTimeoutAt.Valid && !scheduleTimeoutAt.Time.IsZero() && scheduleTimeoutAt.Time.Before(now)
+}
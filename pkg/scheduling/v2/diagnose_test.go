@@ -0,0 +1,161 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+func TestDiagnoseQueuedTask(t *testing.T) {
+	workerId1 := uuid.New().String()
+	workerId2 := uuid.New().String()
+
+	gpuLabel := &sqlcv2.GetDesiredLabelsRow{
+		Key:        "gpu",
+		StrValue:   pgtype.Text{String: "true", Valid: true},
+		Required:   true,
+		Comparator: sqlcv2.WorkerLabelComparatorEQUAL,
+	}
+
+	newInput := func(qi *sqlcv2.V2QueueItem) *QueuedTaskDiagnosisInput {
+		return &QueuedTaskDiagnosisInput{
+			State: &v2.TaskQueueState{
+				Task:      &sqlcv2.V2Task{ActionID: "step:run"},
+				QueueItem: qi,
+			},
+			Workers: []*v2.ListActiveWorkersResult{
+				{ID: sqlchelpers.UUIDFromStr(workerId1)},
+				{ID: sqlchelpers.UUIDFromStr(workerId2)},
+			},
+			WorkerActions: map[string][]string{
+				workerId1: {"step:run"},
+				workerId2: {"step:run"},
+			},
+			WorkerSlots: map[string]int{
+				workerId1: 1,
+				workerId2: 1,
+			},
+			RateLimits: map[string]*v2.RateLimitUsage{},
+			Now:        time.Now().UTC(),
+		}
+	}
+
+	reasons := func(d *QueuedTaskDiagnosis) []QueuedTaskReason {
+		res := make([]QueuedTaskReason, 0, len(d.Reasons))
+
+		for _, r := range d.Reasons {
+			res = append(res, r.Reason)
+		}
+
+		return res
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(in *QueuedTaskDiagnosisInput)
+		expected []QueuedTaskReason
+	}{
+		{
+			name:     "assignable",
+			modify:   func(in *QueuedTaskDiagnosisInput) {},
+			expected: []QueuedTaskReason{QueuedTaskReasonAssignable},
+		},
+		{
+			name: "no worker for action",
+			modify: func(in *QueuedTaskDiagnosisInput) {
+				in.WorkerActions = map[string][]string{}
+			},
+			expected: []QueuedTaskReason{QueuedTaskReasonNoWorkerForAction},
+		},
+		{
+			name: "labels not matched",
+			modify: func(in *QueuedTaskDiagnosisInput) {
+				in.State.DesiredLabels = []*sqlcv2.GetDesiredLabelsRow{gpuLabel}
+			},
+			expected: []QueuedTaskReason{QueuedTaskReasonLabelsNotMatched},
+		},
+		{
+			name: "labels matched by one worker",
+			modify: func(in *QueuedTaskDiagnosisInput) {
+				in.State.DesiredLabels = []*sqlcv2.GetDesiredLabelsRow{gpuLabel}
+				in.Workers[1].Labels = []*sqlcv2.ListManyWorkerLabelsRow{
+					{Key: "gpu", StrValue: pgtype.Text{String: "true", Valid: true}},
+				}
+			},
+			expected: []QueuedTaskReason{QueuedTaskReasonAssignable},
+		},
+		{
+			name: "HARD sticky worker unavailable",
+			modify: func(in *QueuedTaskDiagnosisInput) {
+				in.State.QueueItem.Sticky = sqlcv2.V2StickyStrategyHARD
+				in.State.QueueItem.DesiredWorkerID = sqlchelpers.UUIDFromStr(uuid.New().String())
+			},
+			expected: []QueuedTaskReason{QueuedTaskReasonStickyWorkerUnavailable},
+		},
+		{
+			name: "no available slots",
+			modify: func(in *QueuedTaskDiagnosisInput) {
+				in.WorkerSlots = map[string]int{}
+			},
+			expected: []QueuedTaskReason{QueuedTaskReasonNoAvailableSlots},
+		},
+		{
+			name: "rate limited and timed out",
+			modify: func(in *QueuedTaskDiagnosisInput) {
+				in.State.QueueItem.ScheduleTimeoutAt = pgtype.Timestamp{Time: in.Now.Add(-time.Minute), Valid: true}
+				in.State.RateLimits = []*sqlcv2.V2TaskRateLimit{
+					{Key: "exceeded", Units: 2},
+					{Key: "missing", Units: 1},
+				}
+				in.RateLimits["exceeded"] = &v2.RateLimitUsage{Key: "exceeded", Available: 1}
+			},
+			expected: []QueuedTaskReason{
+				QueuedTaskReasonScheduleTimedOut,
+				QueuedTaskReasonRateLimited,
+				QueuedTaskReasonRateLimited,
+			},
+		},
+		{
+			name: "waiting for concurrency slot",
+			modify: func(in *QueuedTaskDiagnosisInput) {
+				in.State.QueueItem = nil
+				in.State.ConcurrencySlots = []*sqlcv2.ListConcurrencySlotsForTaskRow{
+					{Key: "user-1", FilledSlots: 1, MaxConcurrency: 1},
+				}
+			},
+			expected: []QueuedTaskReason{QueuedTaskReasonConcurrencyLimited},
+		},
+		{
+			name: "waiting for retry",
+			modify: func(in *QueuedTaskDiagnosisInput) {
+				in.State.QueueItem = nil
+				in.State.RetryQueueItem = &sqlcv2.V2RetryQueueItem{}
+			},
+			expected: []QueuedTaskReason{QueuedTaskReasonWaitingForRetry},
+		},
+		{
+			name: "assigned",
+			modify: func(in *QueuedTaskDiagnosisInput) {
+				in.State.QueueItem = nil
+				in.State.Runtime = &sqlcv2.V2TaskRuntime{WorkerID: sqlchelpers.UUIDFromStr(workerId1)}
+			},
+			expected: []QueuedTaskReason{QueuedTaskReasonNotQueued},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := newInput(&sqlcv2.V2QueueItem{ActionID: "step:run"})
+			tt.modify(in)
+
+			assert.Equal(t, tt.expected, reasons(DiagnoseQueuedTask(in)))
+		})
+	}
+}
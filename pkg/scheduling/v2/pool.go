@@ -17,6 +17,29 @@ type sharedConfig struct {
 	l *zerolog.Logger
 
 	singleQueueLimit int
+
+	rankers *slotRankers
+}
+
+type SchedulingPoolOpt func(*SchedulingPoolOpts)
+
+type SchedulingPoolOpts struct {
+	defaultRanker SlotRanker
+	rankResolver  SlotRankerResolver
+}
+
+// WithDefaultSlotRanker sets the ranker used for any tenant or step without an explicit ranker.
+func WithDefaultSlotRanker(ranker SlotRanker) SchedulingPoolOpt {
+	return func(opts *SchedulingPoolOpts) {
+		opts.defaultRanker = ranker
+	}
+}
+
+// WithSlotRankerResolver sets a resolver which can choose a ranker per tenant and step.
+func WithSlotRankerResolver(resolver SlotRankerResolver) SchedulingPoolOpt {
+	return func(opts *SchedulingPoolOpts) {
+		opts.rankResolver = resolver
+	}
 }
 
 // SchedulingPool is responsible for managing a pool of tenantManagers.
@@ -33,7 +56,13 @@ type SchedulingPool struct {
 	concurrencyResultsCh chan *ConcurrencyResults
 }
 
-func NewSchedulingPool(repo v2.SchedulerRepository, l *zerolog.Logger, singleQueueLimit int) (*SchedulingPool, func() error, error) {
+func NewSchedulingPool(repo v2.SchedulerRepository, l *zerolog.Logger, singleQueueLimit int, fs ...SchedulingPoolOpt) (*SchedulingPool, func() error, error) {
+	opts := &SchedulingPoolOpts{}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
 	resultsCh := make(chan *QueueResults, 1000)
 	concurrencyResultsCh := make(chan *ConcurrencyResults, 1000)
 
@@ -43,6 +72,7 @@ func NewSchedulingPool(repo v2.SchedulerRepository, l *zerolog.Logger, singleQue
 			repo:             repo,
 			l:                l,
 			singleQueueLimit: singleQueueLimit,
+			rankers:          newSlotRankers(opts.defaultRanker, opts.rankResolver),
 		},
 		resultsCh:            resultsCh,
 		concurrencyResultsCh: concurrencyResultsCh,
@@ -149,17 +149,15 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 		refillTime := time.Since(checkpoint)
 		checkpoint = time.Now()
 
-		// TODO: REVERT
-		rls := make(map[string]map[string]int32)
-
-		// rls, err := q.repo.GetStepRunRateLimits(ctx, qis)
+		rls, err := q.repo.GetTaskRateLimits(ctx, qis)
 
-		// if err != nil {
-		// 	q.l.Error().Err(err).Msg("error getting rate limits")
+		if err != nil {
+			q.l.Error().Err(err).Msg("error getting rate limits")
 
-		// 	q.unackedToUnassigned(qis)
-		// 	continue
-		// }
+			q.unackedToUnassigned(qis)
+			span.End()
+			continue
+		}
 
 		rateLimitTime := time.Since(checkpoint)
 		checkpoint = time.Now()
@@ -0,0 +1,207 @@
+package v2
+
+import (
+	"fmt"
+	"strings"
+)
+
+type SlotRankerPolicy string
+
+const (
+	// SlotRankerPolicyRandomSpread spreads assignments randomly across all available slots. This is the
+	// default policy.
+	SlotRankerPolicyRandomSpread SlotRankerPolicy = "RANDOM_SPREAD"
+
+	// SlotRankerPolicyLeastLoaded prefers the worker with the largest fraction of free slots.
+	SlotRankerPolicyLeastLoaded SlotRankerPolicy = "LEAST_LOADED"
+
+	// SlotRankerPolicyBinPacking fills up one worker before assigning to the next one.
+	SlotRankerPolicyBinPacking SlotRankerPolicy = "BIN_PACKING"
+)
+
+// WorkerLoad is a snapshot of a worker's capacity at the time a queue item is being assigned.
+type WorkerLoad struct {
+	WorkerId string
+
+	// MaxRuns is the total number of slots the worker was registered with.
+	MaxRuns int
+
+	// AvailableSlots is the number of slots on the worker which can currently be assigned.
+	AvailableSlots int
+}
+
+// SlotRanker determines how the scheduler spreads work across workers which are equally preferred
+// by the sticky and affinity rules of a step. Rankers never override sticky or affinity ranks.
+type SlotRanker interface {
+	Policy() SlotRankerPolicy
+
+	// Score returns the preference for assigning to the given worker. Higher scores are preferred.
+	Score(w *WorkerLoad) int
+
+	// Rotate returns true if the scheduler should rotate through the candidate slots between
+	// assignments instead of always starting from the highest-scored slot.
+	Rotate() bool
+}
+
+// SlotRankerResolver returns the ranker for a step in a tenant. Returning nil falls back to the
+// default ranker of the scheduling pool.
+type SlotRankerResolver func(tenantId, stepId string) SlotRanker
+
+func NewSlotRanker(policy SlotRankerPolicy) (SlotRanker, error) {
+	switch SlotRankerPolicy(strings.ToUpper(string(policy))) {
+	case "", SlotRankerPolicyRandomSpread:
+		return randomSpreadRanker{}, nil
+	case SlotRankerPolicyLeastLoaded:
+		return leastLoadedRanker{}, nil
+	case SlotRankerPolicyBinPacking:
+		return binPackingRanker{}, nil
+	default:
+		return nil, fmt.Errorf("unknown slot ranker policy %s", policy)
+	}
+}
+
+// randomSpreadRanker relies on the random shuffle of slots during replenish and the ring offset to spread
+// assignments across workers.
+type randomSpreadRanker struct{}
+
+func (randomSpreadRanker) Policy() SlotRankerPolicy {
+	return SlotRankerPolicyRandomSpread
+}
+
+func (randomSpreadRanker) Score(w *WorkerLoad) int {
+	return 0
+}
+
+func (randomSpreadRanker) Rotate() bool {
+	return true
+}
+
+type leastLoadedRanker struct{}
+
+func (leastLoadedRanker) Policy() SlotRankerPolicy {
+	return SlotRankerPolicyLeastLoaded
+}
+
+func (leastLoadedRanker) Score(w *WorkerLoad) int {
+	return freeSlotsPerMille(w)
+}
+
+func (leastLoadedRanker) Rotate() bool {
+	return false
+}
+
+type binPackingRanker struct{}
+
+func (binPackingRanker) Policy() SlotRankerPolicy {
+	return SlotRankerPolicyBinPacking
+}
+
+func (binPackingRanker) Score(w *WorkerLoad) int {
+	return -freeSlotsPerMille(w)
+}
+
+func (binPackingRanker) Rotate() bool {
+	return false
+}
+
+// freeSlotsPerMille returns the fraction of free slots on the worker, scaled to [0, 1000], so workers
+// with different slot counts can be compared.
+func freeSlotsPerMille(w *WorkerLoad) int {
+	if w == nil {
+		return 0
+	}
+
+	if w.MaxRuns <= 0 {
+		return w.AvailableSlots
+	}
+
+	return (w.AvailableSlots * 1000) / w.MaxRuns
+}
+
+// getWorkerLoads counts the active slots for each worker in the candidate slots.
+func getWorkerLoads(slots []*slot) map[string]*WorkerLoad {
+	loads := make(map[string]*WorkerLoad)
+
+	for _, slot := range slots {
+		workerId := slot.getWorkerId()
+
+		load, ok := loads[workerId]
+
+		if !ok {
+			load = &WorkerLoad{
+				WorkerId: workerId,
+				MaxRuns:  slot.worker.MaxRuns,
+			}
+
+			loads[workerId] = load
+		}
+
+		if slot.active() {
+			load.AvailableSlots++
+		}
+	}
+
+	return loads
+}
+
+// NewTenantSlotRankerResolver returns a resolver which overrides the ranker for tenants, from a list of
+// tenantId=POLICY pairs. It returns nil if there are no overrides.
+func NewTenantSlotRankerResolver(overrides []string) (SlotRankerResolver, error) {
+	if len(overrides) == 0 {
+		return nil, nil
+	}
+
+	tenantRankers := make(map[string]SlotRanker, len(overrides))
+
+	for _, override := range overrides {
+		tenantId, policy, ok := strings.Cut(override, "=")
+
+		if !ok || tenantId == "" {
+			return nil, fmt.Errorf("invalid slot ranker override %q, expected tenantId=POLICY", override)
+		}
+
+		ranker, err := NewSlotRanker(SlotRankerPolicy(strings.TrimSpace(policy)))
+
+		if err != nil {
+			return nil, err
+		}
+
+		tenantRankers[strings.TrimSpace(tenantId)] = ranker
+	}
+
+	return func(tenantId, stepId string) SlotRanker {
+		return tenantRankers[tenantId]
+	}, nil
+}
+
+// slotRankers stores the default ranker and the resolver for a scheduling pool.
+type slotRankers struct {
+	defaultRanker SlotRanker
+	resolver      SlotRankerResolver
+}
+
+func newSlotRankers(defaultRanker SlotRanker, resolver SlotRankerResolver) *slotRankers {
+	if defaultRanker == nil {
+		defaultRanker = randomSpreadRanker{}
+	}
+
+	return &slotRankers{
+		defaultRanker: defaultRanker,
+		resolver:      resolver,
+	}
+}
+
+// get returns the ranker for a step, preferring the resolver over the default ranker.
+func (r *slotRankers) get(tenantId, stepId string) SlotRanker {
+	if r == nil {
+		return randomSpreadRanker{}
+	}
+
+	if r.resolver != nil {
+		if ranker := r.resolver(tenantId, stepId); ranker != nil {
+			return ranker
+		}
+	}
+
+	return r.defaultRanker
+}
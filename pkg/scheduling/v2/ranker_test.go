@@ -0,0 +1,119 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
+)
+
+func newTestSlots(w *worker, total, used int) []*slot {
+	slots := make([]*slot, 0, total)
+
+	for i := 0; i < total; i++ {
+		s := newSlot(w, []string{})
+
+		if i < used {
+			s.use(nil, nil)
+		}
+
+		slots = append(slots, s)
+	}
+
+	return slots
+}
+
+func TestGetRankedSlotsWithRanker(t *testing.T) {
+	smallWorker := &worker{ListActiveWorkersResult: &v2.ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(stableWorkerId1), MaxRuns: 2}}
+	bigWorker := &worker{ListActiveWorkersResult: &v2.ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(stableWorkerId2), MaxRuns: 8}}
+
+	// the small worker has 1/2 slots free, the big worker has 6/8 slots free
+	slots := append(newTestSlots(smallWorker, 2, 1), newTestSlots(bigWorker, 8, 2)...)
+
+	tests := []struct {
+		name        string
+		policy      SlotRankerPolicy
+		firstWorker string
+	}{
+		{
+			name:        "least loaded prefers the worker with the most free capacity",
+			policy:      SlotRankerPolicyLeastLoaded,
+			firstWorker: stableWorkerId2,
+		},
+		{
+			name:        "bin packing prefers the worker with the least free capacity",
+			policy:      SlotRankerPolicyBinPacking,
+			firstWorker: stableWorkerId1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranker, err := NewSlotRanker(tt.policy)
+			assert.NoError(t, err)
+
+			ranked := getRankedSlots(&sqlcv2.V2QueueItem{}, nil, slots, ranker)
+
+			assert.Len(t, ranked, len(slots))
+
+			assigned := findSlot(ranked, nil, nil)
+
+			assert.NotNil(t, assigned)
+			assert.Equal(t, tt.firstWorker, assigned.getWorkerId())
+
+			assigned.nack()
+		})
+	}
+}
+
+func TestGetRankedSlotsRankerRespectsSticky(t *testing.T) {
+	desiredWorker := &worker{ListActiveWorkersResult: &v2.ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(stableWorkerId1), MaxRuns: 4}}
+	otherWorker := &worker{ListActiveWorkersResult: &v2.ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(stableWorkerId2), MaxRuns: 4}}
+
+	// the desired worker is more loaded, but SOFT sticky should still take precedence
+	slots := append(newTestSlots(otherWorker, 4, 0), newTestSlots(desiredWorker, 4, 3)...)
+
+	ranker, err := NewSlotRanker(SlotRankerPolicyLeastLoaded)
+	assert.NoError(t, err)
+
+	ranked := getRankedSlots(&sqlcv2.V2QueueItem{
+		Sticky:          sqlcv2.V2StickyStrategySOFT,
+		DesiredWorkerID: sqlchelpers.UUIDFromStr(stableWorkerId1),
+	}, nil, slots, ranker)
+
+	assigned := findSlot(ranked, nil, nil)
+
+	assert.NotNil(t, assigned)
+	assert.Equal(t, stableWorkerId1, assigned.getWorkerId())
+}
+
+func TestNewSlotRankerUnknownPolicy(t *testing.T) {
+	_, err := NewSlotRanker("ROUND_ROBIN")
+	assert.Error(t, err)
+}
+
+func TestTenantSlotRankerResolver(t *testing.T) {
+	resolver, err := NewTenantSlotRankerResolver([]string{"tenant-a=BIN_PACKING", "tenant-b=least_loaded"})
+	assert.NoError(t, err)
+
+	rankers := newSlotRankers(randomSpreadRanker{}, resolver)
+
+	assert.Equal(t, SlotRankerPolicyBinPacking, rankers.get("tenant-a", "step").Policy())
+	assert.Equal(t, SlotRankerPolicyLeastLoaded, rankers.get("tenant-b", "step").Policy())
+
+	// tenants without an override use the default ranker
+	assert.Equal(t, SlotRankerPolicyRandomSpread, rankers.get("tenant-c", "step").Policy())
+
+	resolver, err = NewTenantSlotRankerResolver(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, resolver)
+
+	for _, overrides := range [][]string{{"BIN_PACKING"}, {"=BIN_PACKING"}, {"tenant-a=ROUND_ROBIN"}} {
+		_, err = NewTenantSlotRankerResolver(overrides)
+		assert.Error(t, err, overrides)
+	}
+}
@@ -8,18 +8,22 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog"
 
-	"github.com/hatchet-dev/hatchet/pkg/repository"
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
 )
 
 type rateLimit struct {
 	key string
 	val int
+
+	// the state of the rate limit in the database, which is used to refill the rate limit between
+	// flushes. only set on rate limits which were read from the database.
+	state *v2.RateLimitState
 }
 
 type rateLimitSet map[string]*rateLimit
 
 type rateLimiter struct {
-	rateLimitRepo repository.RateLimitRepository
+	rateLimitRepo v2.RateLimitRepository
 
 	tenantId pgtype.UUID
 
@@ -157,11 +161,20 @@ func (r *rateLimiter) copyDbRateLimits() rateLimitSet {
 	defer r.dbRateLimitsMu.RUnlock()
 
 	rls := make(rateLimitSet)
+	now := time.Now().UTC()
 
 	for k, v := range r.dbRateLimits {
+		val := v.val
+
+		// refill the rate limit using its algorithm, so that token buckets and sliding windows free up
+		// units between flushes
+		if v.state != nil {
+			val = v.state.Available(now)
+		}
+
 		rls[k] = &rateLimit{
 			key: k,
-			val: v.val,
+			val: val,
 		}
 	}
 
@@ -268,12 +281,14 @@ func (r *rateLimiter) flushToDatabase(ctx context.Context) error {
 	}
 
 	r.dbRateLimits = make(rateLimitSet)
+	now := time.Now().UTC()
 
 	// update the db rate limits
-	for key, newVal := range newRateLimits {
+	for key, state := range newRateLimits {
 		r.dbRateLimits[key] = &rateLimit{
-			key: key,
-			val: newVal,
+			key:   key,
+			val:   state.Available(now),
+			state: state,
 		}
 	}
 
@@ -7,12 +7,16 @@ import (
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+
+	v2 "github.com/hatchet-dev/hatchet/pkg/repository/v2"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v2/sqlcv2"
 )
 
 type mockRateLimitRepo struct {
@@ -24,16 +28,44 @@ func (m *mockRateLimitRepo) ListCandidateRateLimits(ctx context.Context, tenantI
 	return args.Get(0).([]string), args.Error(1)
 }
 
-func (m *mockRateLimitRepo) UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]int, error) {
+func (m *mockRateLimitRepo) UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]*v2.RateLimitState, error) {
 	args := m.Called(ctx, tenantId, updates)
-	return args.Get(0).(map[string]int), args.Error(1)
+	return args.Get(0).(map[string]*v2.RateLimitState), args.Error(1)
+}
+
+func (m *mockRateLimitRepo) DeleteIdleDynamicRateLimits(ctx context.Context, tenantId pgtype.UUID, idleSince time.Time) (int64, error) {
+	args := m.Called(ctx, tenantId, idleSince)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockRateLimitRepo) ListRateLimitUsage(ctx context.Context, tenantId pgtype.UUID, opts *v2.ListRateLimitUsageOpts) (*v2.ListRateLimitUsageResult, error) {
+	args := m.Called(ctx, tenantId, opts)
+	return args.Get(0).(*v2.ListRateLimitUsageResult), args.Error(1)
+}
+
+// fixedWindowStates returns the states of fixed window rate limits which were just refilled
+func fixedWindowStates(values map[string]int) map[string]*v2.RateLimitState {
+	res := make(map[string]*v2.RateLimitState, len(values))
+
+	for k, v := range values {
+		res[k] = &v2.RateLimitState{
+			Algorithm:  sqlcv2.RateLimitAlgorithmFIXEDWINDOW,
+			LimitValue: v,
+			Burst:      v,
+			Window:     time.Minute,
+			Value:      v,
+			LastRefill: time.Now().UTC(),
+		}
+	}
+
+	return res
 }
 
 func TestRateLimiter_Use(t *testing.T) {
 	l := zerolog.Nop()
 
 	mockRateLimitRepo := &mockRateLimitRepo{}
-	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]int{"key1": 10, "key2": 5, "key3": 7}, nil)
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(fixedWindowStates(map[string]int{"key1": 10, "key2": 5, "key3": 7}), nil)
 
 	rateLimiter := &rateLimiter{
 		dbRateLimits: rateLimitSet{
@@ -64,7 +96,7 @@ func TestRateLimiter_Ack(t *testing.T) {
 	l := zerolog.Nop()
 
 	mockRateLimitRepo := &mockRateLimitRepo{}
-	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]int{"key1": 10, "key2": 5}, nil)
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(fixedWindowStates(map[string]int{"key1": 10, "key2": 5}), nil)
 
 	rateLimiter := &rateLimiter{
 		dbRateLimits: rateLimitSet{
@@ -89,7 +121,7 @@ func TestRateLimiter_Nack(t *testing.T) {
 	l := zerolog.Nop()
 
 	mockRateLimitRepo := &mockRateLimitRepo{}
-	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]int{"key1": 10, "key2": 5}, nil)
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(fixedWindowStates(map[string]int{"key1": 10, "key2": 5}), nil)
 
 	rateLimiter := &rateLimiter{
 		dbRateLimits: rateLimitSet{
@@ -114,7 +146,7 @@ func TestRateLimiter_Concurrency(t *testing.T) {
 	l := zerolog.Nop()
 
 	mockRateLimitRepo := &mockRateLimitRepo{}
-	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]int{"key1": 100, "key2": 100}, nil)
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(fixedWindowStates(map[string]int{"key1": 100, "key2": 100}), nil)
 
 	rateLimiter := &rateLimiter{
 		dbRateLimits: rateLimitSet{
@@ -153,7 +185,7 @@ func TestRateLimiter_FlushToDatabase(t *testing.T) {
 	l := zerolog.Nop()
 
 	mockRateLimitRepo := &mockRateLimitRepo{} // Mock implementation of rateLimitRepo
-	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]int{"key1": 10, "key2": 5}, nil)
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(fixedWindowStates(map[string]int{"key1": 10, "key2": 5}), nil)
 
 	rateLimiter := &rateLimiter{
 		dbRateLimits: rateLimitSet{
@@ -186,7 +218,7 @@ func BenchmarkRateLimiter(b *testing.B) {
 	l := zerolog.Nop()
 
 	mockRateLimitRepo := &mockRateLimitRepo{}
-	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]int{"key1": 1000, "key2": 1000}, nil)
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(fixedWindowStates(map[string]int{"key1": 1000, "key2": 1000}), nil)
 
 	r := rateLimiter{
 		unacked:       make(map[string]rateLimitSet),
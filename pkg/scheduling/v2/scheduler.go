@@ -3,6 +3,7 @@ package v2
 import (
 	"context"
 	"math/rand"
+	"strconv"
 	"sync"
 	"time"
 
@@ -39,8 +40,9 @@ type Scheduler struct {
 	unackedSlots map[int]*slot
 	unackedMu    mutex
 
-	rl   *rateLimiter
-	exts *Extensions
+	rl      *rateLimiter
+	exts    *Extensions
+	rankers *slotRankers
 }
 
 func newScheduler(cf *sharedConfig, tenantId pgtype.UUID, rl *rateLimiter, exts *Extensions) *Scheduler {
@@ -59,6 +61,7 @@ func newScheduler(cf *sharedConfig, tenantId pgtype.UUID, rl *rateLimiter, exts
 		assignedCountMu: newMu(cf.l),
 		unackedMu:       newMu(cf.l),
 		exts:            exts,
+		rankers:         cf.rankers,
 	}
 }
 
@@ -418,7 +421,7 @@ func (s *Scheduler) tryAssignBatch(
 	// slots concurrently.
 	ringOffset int,
 	stepIdsToLabels map[string][]*sqlcv2.GetDesiredLabelsRow,
-	stepRunIdsToRateLimits map[string]map[string]int32,
+	taskIdsToRateLimits map[int64]map[string]int32,
 ) (
 	res []*assignSingleResult, newRingOffset int, err error,
 ) {
@@ -443,36 +446,27 @@ func (s *Scheduler) tryAssignBatch(
 	noop := func() {}
 
 	// first, check rate limits for each of the queue items
-	// TODO: REVERT
 	for i := range res {
-		// r := res[i]
-		// qi := qis[i]
+		r := res[i]
+		qi := qis[i]
 
 		rateLimitAck := noop
 		rateLimitNack := noop
 
-		// rls := make(map[string]int32)
-
-		// if stepRunIdsToRateLimits != nil {
-		// 	if _, ok := stepRunIdsToRateLimits[sqlchelpers.UUIDToStr(qi.StepRunId)]; ok {
-		// 		rls = stepRunIdsToRateLimits[sqlchelpers.UUIDToStr(qi.StepRunId)]
-		// 	}
-		// }
-
-		// // check rate limits
-		// if len(rls) > 0 {
-		// 	rlResult := s.rl.use(ctx, sqlchelpers.UUIDToStr(qi.StepRunId), rls)
-
-		// 	if !rlResult.succeeded {
-		// 		r.rateLimitResult = &scheduleRateLimitResult{
-		// 			rateLimitResult: &rlResult,
-		// 			qi:              qi,
-		// 		}
-		// 	} else {
-		// 		rateLimitAck = rlResult.ack
-		// 		rateLimitNack = rlResult.nack
-		// 	}
-		// }
+		// check rate limits
+		if rls := taskIdsToRateLimits[qi.TaskID]; len(rls) > 0 {
+			rlResult := s.rl.use(ctx, strconv.FormatInt(qi.TaskID, 10), rls)
+
+			if !rlResult.succeeded {
+				r.rateLimitResult = &scheduleRateLimitResult{
+					rateLimitResult: &rlResult,
+					qi:              qi,
+				}
+			} else {
+				rateLimitAck = rlResult.ack
+				rateLimitNack = rlResult.nack
+			}
+		}
 
 		rlAcks[i] = rateLimitAck
 		rlNacks[i] = rateLimitNack
@@ -523,13 +517,15 @@ func (s *Scheduler) tryAssignBatch(
 		childRingOffset := newRingOffset % denom
 
 		qi := qis[i]
+		stepId := sqlchelpers.UUIDToStr(qi.StepID)
 
 		singleRes, err := s.tryAssignSingleton(
 			ctx,
 			qi,
 			candidateSlots,
 			childRingOffset,
-			stepIdsToLabels[sqlchelpers.UUIDToStr(qi.StepID)],
+			stepIdsToLabels[stepId],
+			s.rankers.get(sqlchelpers.UUIDToStr(s.tenantId), stepId),
 			rlAcks[i],
 			rlNacks[i],
 		)
@@ -581,6 +577,7 @@ func (s *Scheduler) tryAssignSingleton(
 	candidateSlots []*slot,
 	ringOffset int,
 	labels []*sqlcv2.GetDesiredLabelsRow,
+	ranker SlotRanker,
 	rateLimitAck func(),
 	rateLimitNack func(),
 ) (
@@ -590,8 +587,15 @@ func (s *Scheduler) tryAssignSingleton(
 	ctx, span := telemetry.NewSpan(ctx, "try-assign-singleton") // nolint: ineffassign
 	defer span.End()
 
-	if (qi.Sticky != sqlcv2.V2StickyStrategyNONE) || len(labels) > 0 {
-		candidateSlots = getRankedSlots(qi, labels, candidateSlots)
+	rotate := ranker == nil || ranker.Rotate()
+
+	if (qi.Sticky != sqlcv2.V2StickyStrategyNONE) || len(labels) > 0 || !rotate {
+		candidateSlots = getRankedSlots(qi, labels, candidateSlots, ranker)
+	}
+
+	// if the ranker does not rotate, we always start the search from the most preferred slot
+	if !rotate || ringOffset > len(candidateSlots) {
+		ringOffset = 0
 	}
 
 	assignedSlot := findSlot(candidateSlots[ringOffset:], rateLimitAck, rateLimitNack)
@@ -638,7 +642,7 @@ func (s *Scheduler) tryAssign(
 	ctx context.Context,
 	qis []*sqlcv2.V2QueueItem,
 	stepIdsToLabels map[string][]*sqlcv2.GetDesiredLabelsRow,
-	stepRunIdsToRateLimits map[string]map[string]int32,
+	taskIdsToRateLimits map[int64]map[string]int32,
 ) <-chan *assignResults {
 	ctx, span := telemetry.NewSpan(ctx, "try-assign")
 
@@ -700,7 +704,7 @@ func (s *Scheduler) tryAssign(
 
 					batchStart := time.Now()
 
-					results, newRingOffset, err := s.tryAssignBatch(ctx, actionId, batchQis, ringOffset, stepIdsToLabels, stepRunIdsToRateLimits)
+					results, newRingOffset, err := s.tryAssignBatch(ctx, actionId, batchQis, ringOffset, stepIdsToLabels, taskIdsToRateLimits)
 
 					if err != nil {
 						return err
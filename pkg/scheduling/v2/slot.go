@@ -2,6 +2,7 @@ package v2
 
 import (
 	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -170,12 +171,68 @@ func (r *rankedValidSlots) order() []*slot {
 	return nonNegativeSlots
 }
 
+// orderBy returns the slots sorted by rank, and then by the ranker's score for each slot's worker. Slots
+// with equal scores are grouped by worker, so a ranker can fill one worker before moving on to the next.
+func (r *rankedValidSlots) orderBy(ranker SlotRanker, loads map[string]*WorkerLoad) []*slot {
+	type scoredSlot struct {
+		*slot
+		workerId string
+		score    int
+	}
+
+	res := r.order()
+	scored := make([]scoredSlot, len(res))
+
+	for i, s := range res {
+		workerId := s.getWorkerId()
+
+		scored[i] = scoredSlot{
+			slot:     s,
+			workerId: workerId,
+			score:    ranker.Score(loads[workerId]),
+		}
+	}
+
+	sortedRanks := make([]int, 0, len(r.ranksToSlots))
+
+	for rank := range r.ranksToSlots {
+		sortedRanks = append(sortedRanks, rank)
+	}
+
+	slices.Sort(sortedRanks)
+
+	// sort within each rank, which appear in reverse rank order in the ordered slots
+	start := 0
+
+	for i := len(sortedRanks) - 1; i >= 0; i-- {
+		end := start + len(r.ranksToSlots[sortedRanks[i]])
+
+		slices.SortStableFunc(scored[start:end], func(a, b scoredSlot) int {
+			if a.score != b.score {
+				return b.score - a.score
+			}
+
+			return strings.Compare(a.workerId, b.workerId)
+		})
+
+		start = end
+	}
+
+	for i := range scored {
+		res[i] = scored[i].slot
+	}
+
+	return res
+}
+
 // getRankedSlots returns a list of valid slots sorted by preference, discarding any slots that cannot
-// match the affinity conditions.
+// match the affinity conditions. If the ranker does not rotate through slots, slots of equal rank are
+// further sorted by the ranker's score.
 func getRankedSlots(
 	qi *sqlcv2.V2QueueItem,
 	labels []*sqlcv2.GetDesiredLabelsRow,
 	slots []*slot,
+	ranker SlotRanker,
 ) []*slot {
 	validSlots := newRankedValidSlots()
 
@@ -222,5 +279,9 @@ func getRankedSlots(
 		validSlots.addSlot(slot, 0)
 	}
 
-	return validSlots.order()
+	if ranker == nil || ranker.Rotate() {
+		return validSlots.order()
+	}
+
+	return validSlots.orderBy(ranker, getWorkerLoads(slots))
 }
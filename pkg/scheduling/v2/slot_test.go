@@ -99,7 +99,7 @@ func TestGetRankedSlots(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			actualSlots := getRankedSlots(tt.qi, tt.labels, tt.slots)
+			actualSlots := getRankedSlots(tt.qi, tt.labels, tt.slots, nil)
 			actualWorkerIds := make([]string, len(actualSlots))
 			for i, s := range actualSlots {
 				actualWorkerIds[i] = s.getWorkerId()
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/pkg/errors"
 
 	goerrors "errors"
@@ -135,9 +136,14 @@ func getErrorStr(errObj *ValidationErrObject) string {
 		return errObj.SafeExternalError(CronErr)
 	case "duration":
 		return errObj.SafeExternalError(DurationErr)
-	case "celworkflowrunstr":
-		return errObj.SafeExternalError(CELExprErr)
-	case "celsteprunstr":
+	case "celworkflowrunstr", "celsteprunstr", "celmatchconditionstr":
+		if err := celCompileErr(errObj.Condition, errObj.ActualValue); err != nil {
+			// safe external errors can't contain commas, see SafeExternalError
+			msg := strings.ReplaceAll(cel.CompileErrorMessage(err), ",", "")
+
+			return errObj.SafeExternalError(fmt.Sprintf("%s: %s", CELExprErr, msg))
+		}
+
 		return errObj.SafeExternalError(CELExprErr)
 	default:
 		return errObj.SafeExternalError("")
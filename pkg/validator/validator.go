@@ -18,11 +18,11 @@ var NameRegex = regexp.MustCompile("^[a-zA-Z0-9\\.\\-_]+$") //nolint:gosimple
 
 var CronRegex = regexp.MustCompile(`(@(annually|yearly|monthly|weekly|daily|hourly|reboot))|(@every (\d+(ns|us|µs|ms|s|m|h))+)|((((\d+,)+\d+|(\d+(\/|-)\d+)|\d+|\*) ?){5,7})`) //nolint:gosimple
 
+var celParser = cel.NewCELParser()
+
 func newValidator() *validator.Validate {
 	validate := validator.New()
 
-	celParser := cel.NewCELParser()
-
 	_ = validate.RegisterValidation("hatchetName", func(fl validator.FieldLevel) bool {
 		return NameRegex.MatchString(fl.Field().String())
 	})
@@ -77,6 +77,12 @@ func newValidator() *validator.Validate {
 		return err == nil
 	})
 
+	_ = validate.RegisterValidation("celmatchconditionstr", func(fl validator.FieldLevel) bool {
+		_, err := celParser.ParseMatchCondition(fl.Field().String())
+
+		return err == nil
+	})
+
 	_ = validate.RegisterValidation("future", func(fl validator.FieldLevel) bool {
 		if t, ok := fl.Field().Interface().(time.Time); ok {
 			return t.After(time.Now())
@@ -87,6 +93,38 @@ func newValidator() *validator.Validate {
 	return validate
 }
 
+// celCompileErr returns the error from compiling an expression which failed one of the CEL validation
+// conditions, so the reason that the expression is invalid can be returned to the user.
+func celCompileErr(condition string, value interface{}) error {
+	var expr string
+
+	switch v := value.(type) {
+	case string:
+		expr = v
+	case *string:
+		if v == nil {
+			return nil
+		}
+
+		expr = *v
+	default:
+		return nil
+	}
+
+	var err error
+
+	switch condition {
+	case "celworkflowrunstr":
+		_, err = celParser.ParseWorkflowString(expr)
+	case "celsteprunstr":
+		_, err = celParser.ParseStepRun(expr)
+	case "celmatchconditionstr":
+		_, err = celParser.ParseMatchCondition(expr)
+	}
+
+	return err
+}
+
 func passwordValidation(pw string) bool {
 	pwLen := len(pw)
 	var hasNumber, hasUpper, hasLower bool
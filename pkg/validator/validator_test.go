@@ -77,3 +77,21 @@ func TestValidatorInvalidDuration(t *testing.T) {
 
 	assert.ErrorContains(t, err, "validation for 'Duration' failed on the 'duration' tag", "should throw error on invalid duration")
 }
+
+func TestValidatorInvalidCELExpression(t *testing.T) {
+	v := NewDefaultValidator()
+
+	expr := "input.user_id + missing"
+
+	apiErrors, err := v.ValidateAPI(&struct {
+		Expression *string `validate:"omitnil,celworkflowrunstr"`
+	}{
+		Expression: &expr,
+	})
+
+	assert.NoError(t, err)
+
+	if assert.NotNil(t, apiErrors) && assert.Len(t, apiErrors.Errors, 1) {
+		assert.Contains(t, apiErrors.Errors[0].Description, "Invalid CEL expression: 1:17: undeclared reference to 'missing'")
+	}
+}
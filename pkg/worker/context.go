@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
 
@@ -69,6 +70,15 @@ type HatchetContext interface {
 
 	RetryCount() int
 
+	// SleepFor durably pauses the step run for the given duration. The step run gives up its worker
+	// slot while it sleeps, but the step timeout still applies.
+	SleepFor(duration time.Duration) error
+
+	// WaitForEvent durably pauses the step run until a user event with the given key is pushed which
+	// matches the (optional) CEL expression, and unmarshals the event payload into target. The step run
+	// gives up its worker slot while it waits, but the step timeout still applies.
+	WaitForEvent(eventKey string, expression string, target interface{}) error
+
 	client() client.Client
 
 	action() *client.Action
@@ -115,6 +125,7 @@ type hatchetContext struct {
 
 	i          int
 	indexMu    sync.Mutex
+	durableI   int
 	listener   *client.WorkflowRunsListener
 	listenerMu sync.Mutex
 }
@@ -271,6 +282,84 @@ func (h *hatchetContext) RetryCount() int {
 	return int(h.a.RetryCount)
 }
 
+func (h *hatchetContext) SleepFor(duration time.Duration) error {
+	signalKey := h.nextDurableSignalKey("sleep")
+
+	err := h.c.Dispatcher().RegisterDurableEvent(h, &client.RegisterDurableEventRequest{
+		StepRunId: h.a.StepRunId,
+		SignalKey: signalKey,
+		SleepConditions: []client.SleepCondition{
+			{
+				Duration: duration,
+			},
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to register sleep: %w", err)
+	}
+
+	_, err = h.c.Dispatcher().WaitForDurableEvent(h, h.a.StepRunId, signalKey)
+
+	if err != nil {
+		return fmt.Errorf("failed to wait for sleep: %w", err)
+	}
+
+	return nil
+}
+
+func (h *hatchetContext) WaitForEvent(eventKey string, expression string, target interface{}) error {
+	signalKey := h.nextDurableSignalKey("event")
+
+	err := h.c.Dispatcher().RegisterDurableEvent(h, &client.RegisterDurableEventRequest{
+		StepRunId: h.a.StepRunId,
+		SignalKey: signalKey,
+		UserEventConditions: []client.UserEventCondition{
+			{
+				EventKey:   eventKey,
+				Expression: expression,
+			},
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to register event listener: %w", err)
+	}
+
+	data, err := h.c.Dispatcher().WaitForDurableEvent(h, h.a.StepRunId, signalKey)
+
+	if err != nil {
+		return fmt.Errorf("failed to wait for event: %w", err)
+	}
+
+	events := make(map[string][]json.RawMessage)
+
+	if err := json.Unmarshal(data, &events); err != nil {
+		return fmt.Errorf("failed to unmarshal event data: %w", err)
+	}
+
+	if len(events[eventKey]) == 0 {
+		return fmt.Errorf("no payload received for event %s", eventKey)
+	}
+
+	if target == nil {
+		return nil
+	}
+
+	return json.Unmarshal(events[eventKey][0], target)
+}
+
+// nextDurableSignalKey returns a signal key which is unique within the step run.
+func (h *hatchetContext) nextDurableSignalKey(prefix string) string {
+	h.indexMu.Lock()
+	defer h.indexMu.Unlock()
+
+	key := fmt.Sprintf("%s-%d", prefix, h.durableI)
+	h.durableI++
+
+	return key
+}
+
 func (h *hatchetContext) index() int {
 	return h.i
 }
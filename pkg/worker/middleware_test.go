@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/hatchet-dev/hatchet/pkg/client"
 )
@@ -87,6 +88,14 @@ func (c *testHatchetContext) RetryCount() int {
 	panic("not implemented")
 }
 
+func (c *testHatchetContext) SleepFor(duration time.Duration) error {
+	panic("not implemented")
+}
+
+func (c *testHatchetContext) WaitForEvent(eventKey string, expression string, target interface{}) error {
+	panic("not implemented")
+}
+
 func (c *testHatchetContext) action() *client.Action {
 	panic("not implemented")
 }
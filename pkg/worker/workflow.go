@@ -43,6 +43,18 @@ func (c cronArr) ToWorkflowTriggers(wt *types.WorkflowTriggers, namespace string
 	wt.Cron = append(wt.Cron, c...)
 }
 
+// CronInTimezone returns cron triggers which are evaluated in the given IANA timezone, for example
+// "Europe/Berlin", instead of UTC. Fire times follow the timezone's daylight saving transitions.
+func CronInTimezone(timezone string, c ...string) cronArr {
+	res := make(cronArr, 0, len(c))
+
+	for _, expr := range c {
+		res = append(res, fmt.Sprintf("CRON_TZ=%s %s", timezone, expr))
+	}
+
+	return res
+}
+
 type noTrigger struct{}
 
 func NoTrigger() noTrigger {
@@ -161,6 +173,7 @@ type WorkflowConcurrency struct {
 	expr          *string
 	maxRuns       *int32
 	limitStrategy *types.WorkflowConcurrencyLimitStrategy
+	weightExpr    *string
 }
 
 func Expression(expr string) *WorkflowConcurrency {
@@ -185,6 +198,14 @@ func (c *WorkflowConcurrency) LimitStrategy(limitStrategy types.WorkflowConcurre
 	return c
 }
 
+// WeightExpression sets a CEL expression which evaluates to the weight of each concurrency key. Keys with a
+// higher weight are given a proportionally larger share of the slots. Weights must be between 1 and 10000.
+// Only used with types.WeightedRoundRobin.
+func (c *WorkflowConcurrency) WeightExpression(expr string) *WorkflowConcurrency {
+	c.weightExpr = &expr
+	return c
+}
+
 func (j *WorkflowJob) ToWorkflow(svcName string, namespace string) types.Workflow {
 	apiJob, err := j.ToWorkflowJob(svcName, namespace)
 
@@ -232,6 +253,10 @@ func (j *WorkflowJob) ToWorkflow(svcName string, namespace string) types.Workflo
 		if j.Concurrency.limitStrategy != nil {
 			w.Concurrency.LimitStrategy = *j.Concurrency.limitStrategy
 		}
+
+		if j.Concurrency.weightExpr != nil {
+			w.Concurrency.WeightExpression = j.Concurrency.weightExpr
+		}
 	}
 
 	if j.StickyStrategy != nil {
@@ -325,6 +350,9 @@ type WorkflowStep struct {
 
 	DesiredLabels map[string]*types.DesiredWorkerLabel
 
+	// User events which must be pushed before the step is run
+	UserEventConditions []types.UserEventCondition
+
 	Compute *compute.Compute
 }
 
@@ -392,6 +420,22 @@ func (w *WorkflowStep) AddParents(parents ...string) *WorkflowStep {
 	return w
 }
 
+// AddUserEventCondition gates the step on a user event with the given key being pushed after the workflow
+// run is created. If expression is non-empty, it's a CEL expression which the event payload (`input`) must
+// satisfy. The step runs once all of its parents have completed and all of its user event conditions are met.
+func (w *WorkflowStep) AddUserEventCondition(eventKey string, expression string) *WorkflowStep {
+	condition := types.UserEventCondition{
+		EventKey: eventKey,
+	}
+
+	if expression != "" {
+		condition.Expression = &expression
+	}
+
+	w.UserEventConditions = append(w.UserEventConditions, condition)
+	return w
+}
+
 func (w *WorkflowStep) ToWorkflowTrigger() triggerConverter {
 	return NoTrigger()
 }
@@ -452,6 +496,7 @@ func (w *WorkflowStep) ToWorkflowStep(svcName string, index int, namespace strin
 		DesiredLabels:          w.DesiredLabels,
 		RetryBackoffFactor:     w.RetryBackoffFactor,
 		RetryMaxBackoffSeconds: w.RetryMaxBackoffSeconds,
+		UserEventConditions:    w.UserEventConditions,
 	}
 
 	for _, rateLimit := range w.RateLimit {
@@ -0,0 +1,4 @@
+-- Add value to enum type: "ConcurrencyLimitStrategy"
+ALTER TYPE "ConcurrencyLimitStrategy" ADD VALUE 'WEIGHTED_ROUND_ROBIN';
+-- Modify "WorkflowConcurrency" table
+ALTER TABLE "WorkflowConcurrency" ADD COLUMN "concurrencyGroupWeightExpression" text NULL;
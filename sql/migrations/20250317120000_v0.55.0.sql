@@ -0,0 +1,6 @@
+-- Create "MessageQueueDeadLetter" table
+CREATE TABLE "MessageQueueDeadLetter" ("id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "queueId" text NOT NULL, "tenantId" uuid NULL, "messageId" text NOT NULL, "payload" jsonb NOT NULL, "error" text NULL, "retryCount" integer NOT NULL DEFAULT 0, PRIMARY KEY ("id"));
+-- Create index "MessageQueueDeadLetter_createdAt_idx" to table: "MessageQueueDeadLetter"
+CREATE INDEX "MessageQueueDeadLetter_createdAt_idx" ON "MessageQueueDeadLetter" ("createdAt");
+-- Create index "MessageQueueDeadLetter_tenantId_queueId_id_idx" to table: "MessageQueueDeadLetter"
+CREATE INDEX "MessageQueueDeadLetter_tenantId_queueId_id_idx" ON "MessageQueueDeadLetter" ("tenantId", "queueId", "id");
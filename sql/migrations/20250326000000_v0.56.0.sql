@@ -0,0 +1,10 @@
+-- Create enum type "WorkflowTriggerCronRefMissedFirePolicy"
+CREATE TYPE "WorkflowTriggerCronRefMissedFirePolicy" AS ENUM ('SKIP', 'FIRE_ONCE', 'CATCH_UP');
+-- Modify "WorkflowTriggerCronRef" table
+ALTER TABLE "WorkflowTriggerCronRef" ADD COLUMN "missedFirePolicy" "WorkflowTriggerCronRefMissedFirePolicy" NOT NULL DEFAULT 'SKIP', ADD COLUMN "catchUpLimit" integer NOT NULL DEFAULT 0, ADD COLUMN "nextFireAt" timestamp(3) NULL;
+-- Modify "WorkflowTriggerScheduledRef" table
+ALTER TABLE "WorkflowTriggerScheduledRef" ADD COLUMN "triggeredAt" timestamp(3) NULL;
+-- Mark scheduled workflows which have already been triggered
+UPDATE "WorkflowTriggerScheduledRef" s SET "triggeredAt" = s."triggerAt" WHERE EXISTS (SELECT 1 FROM "WorkflowRunTriggeredBy" tb WHERE tb."scheduledId" = s."id");
+-- Create index "WorkflowTriggerScheduledRef_triggerAt_idx" to table: "WorkflowTriggerScheduledRef"
+CREATE INDEX "WorkflowTriggerScheduledRef_triggerAt_idx" ON "WorkflowTriggerScheduledRef" ("triggerAt") WHERE ("triggeredAt" IS NULL);
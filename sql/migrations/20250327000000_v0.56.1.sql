@@ -0,0 +1,4 @@
+-- Create enum type "WorkflowTriggerCronRefOverlapPolicy"
+CREATE TYPE "WorkflowTriggerCronRefOverlapPolicy" AS ENUM ('ALLOW', 'SKIP_IF_RUNNING', 'CANCEL_PREVIOUS', 'QUEUE_ONE');
+-- Modify "WorkflowTriggerCronRef" table
+ALTER TABLE "WorkflowTriggerCronRef" ADD COLUMN "timezone" text NULL, ADD COLUMN "overlapPolicy" "WorkflowTriggerCronRefOverlapPolicy" NOT NULL DEFAULT 'ALLOW', ADD COLUMN "lastRunExternalId" uuid NULL, ADD COLUMN "lastFiredAt" timestamp(3) NULL, ADD COLUMN "queuedFireAt" timestamp(3) NULL;
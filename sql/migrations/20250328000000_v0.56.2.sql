@@ -0,0 +1,2 @@
+-- Modify "RateLimit" table
+ALTER TABLE "RateLimit" ADD COLUMN "dynamic" boolean NOT NULL DEFAULT false, ADD COLUMN "lastUsedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP;
@@ -0,0 +1,4 @@
+-- Create enum type "RateLimitAlgorithm"
+CREATE TYPE "RateLimitAlgorithm" AS ENUM ('FIXED_WINDOW', 'TOKEN_BUCKET', 'SLIDING_WINDOW');
+-- Modify "RateLimit" table
+ALTER TABLE "RateLimit" ADD COLUMN "algorithm" "RateLimitAlgorithm" NOT NULL DEFAULT 'FIXED_WINDOW', ADD COLUMN "burst" integer NULL, ADD COLUMN "previousUsage" integer NOT NULL DEFAULT 0;
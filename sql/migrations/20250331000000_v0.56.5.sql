@@ -0,0 +1,6 @@
+-- Create "TenantRole" table
+CREATE TABLE "TenantRole" ("id" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "name" text NOT NULL, "description" text NULL, "permissions" text[] NOT NULL DEFAULT ARRAY[]::text[], "workflowIds" uuid[] NULL, PRIMARY KEY ("id"), CONSTRAINT "TenantRole_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "TenantRole_tenantId_name_key" to table: "TenantRole"
+CREATE UNIQUE INDEX "TenantRole_tenantId_name_key" ON "TenantRole" ("tenantId", "name");
+-- Modify "TenantMember" table
+ALTER TABLE "TenantMember" ADD COLUMN "customRoleId" uuid NULL, ADD CONSTRAINT "TenantMember_customRoleId_fkey" FOREIGN KEY ("customRoleId") REFERENCES "TenantRole" ("id") ON UPDATE CASCADE ON DELETE SET NULL;
@@ -0,0 +1,8 @@
+-- Create enum type "AuditLogActorKind"
+CREATE TYPE "AuditLogActorKind" AS ENUM ('USER', 'API_TOKEN');
+-- Create enum type "AuditLogSource"
+CREATE TYPE "AuditLogSource" AS ENUM ('API', 'GRPC');
+-- Create "TenantAuditLog" table
+CREATE TABLE "TenantAuditLog" ("id" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "actorKind" "AuditLogActorKind" NOT NULL, "actorId" uuid NOT NULL, "actorName" text NULL, "source" "AuditLogSource" NOT NULL, "action" text NOT NULL, "resourceType" text NOT NULL, "resourceId" text NULL, "ipAddress" text NULL, "userAgent" text NULL, "metadata" jsonb NOT NULL DEFAULT '{}', PRIMARY KEY ("id"), CONSTRAINT "TenantAuditLog_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "TenantAuditLog_tenantId_createdAt_idx" to table: "TenantAuditLog"
+CREATE INDEX "TenantAuditLog_tenantId_createdAt_idx" ON "TenantAuditLog" ("tenantId", "createdAt" DESC);
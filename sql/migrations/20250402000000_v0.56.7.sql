@@ -0,0 +1,16 @@
+-- Create enum type "WebhookDeliveryStatus"
+CREATE TYPE "WebhookDeliveryStatus" AS ENUM ('PENDING', 'SUCCEEDED', 'FAILED');
+-- Create "WebhookSubscription" table
+CREATE TABLE "WebhookSubscription" ("id" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "name" text NOT NULL, "url" text NOT NULL, "secret" text NOT NULL, "enabled" boolean NOT NULL DEFAULT true, "eventTypes" text[] NOT NULL DEFAULT ARRAY[]::text[], "statuses" text[] NOT NULL DEFAULT ARRAY[]::text[], "workflowIds" uuid[] NULL, PRIMARY KEY ("id"), CONSTRAINT "WebhookSubscription_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "WebhookSubscription_tenantId_name_key" to table: "WebhookSubscription"
+CREATE UNIQUE INDEX "WebhookSubscription_tenantId_name_key" ON "WebhookSubscription" ("tenantId", "name");
+-- Create "WebhookDelivery" table
+CREATE TABLE "WebhookDelivery" ("id" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "subscriptionId" uuid NOT NULL, "eventType" text NOT NULL, "payload" jsonb NOT NULL, "status" "WebhookDeliveryStatus" NOT NULL DEFAULT 'PENDING', "attempts" integer NOT NULL DEFAULT 0, "nextAttemptAt" timestamp(3) NULL, PRIMARY KEY ("id"), CONSTRAINT "WebhookDelivery_subscriptionId_fkey" FOREIGN KEY ("subscriptionId") REFERENCES "WebhookSubscription" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "WebhookDelivery_subscriptionId_createdAt_idx" to table: "WebhookDelivery"
+CREATE INDEX "WebhookDelivery_subscriptionId_createdAt_idx" ON "WebhookDelivery" ("subscriptionId", "createdAt" DESC);
+-- Create index "WebhookDelivery_tenantId_status_nextAttemptAt_idx" to table: "WebhookDelivery"
+CREATE INDEX "WebhookDelivery_tenantId_status_nextAttemptAt_idx" ON "WebhookDelivery" ("tenantId", "status", "nextAttemptAt");
+-- Create "WebhookDeliveryAttempt" table
+CREATE TABLE "WebhookDeliveryAttempt" ("id" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "deliveryId" uuid NOT NULL, "attempt" integer NOT NULL, "responseStatusCode" integer NULL, "responseBody" text NULL, "error" text NULL, "durationMs" integer NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "WebhookDeliveryAttempt_deliveryId_fkey" FOREIGN KEY ("deliveryId") REFERENCES "WebhookDelivery" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "WebhookDeliveryAttempt_deliveryId_idx" to table: "WebhookDeliveryAttempt"
+CREATE INDEX "WebhookDeliveryAttempt_deliveryId_idx" ON "WebhookDeliveryAttempt" ("deliveryId");
@@ -0,0 +1,4 @@
+-- Modify "StepExpression" table
+ALTER TABLE "StepExpression" ADD COLUMN "celLibraryVersion" integer NOT NULL DEFAULT 1;
+-- Modify "WorkflowConcurrency" table
+ALTER TABLE "WorkflowConcurrency" ADD COLUMN "celLibraryVersion" integer NOT NULL DEFAULT 1;
@@ -1,3 +1,6 @@
+-- used for substring and regex search over task display names and error messages
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
 CREATE TYPE v2_sticky_strategy_olap AS ENUM ('NONE', 'SOFT', 'HARD');
 
 CREATE TYPE v2_readable_status_olap AS ENUM (
@@ -165,6 +168,10 @@ CREATE INDEX v2_tasks_olap_workflow_id_idx ON v2_tasks_olap (tenant_id, workflow
 
 CREATE INDEX v2_tasks_olap_worker_id_idx ON v2_tasks_olap (tenant_id, latest_worker_id) WHERE latest_worker_id IS NOT NULL;
 
+CREATE INDEX v2_tasks_olap_display_name_idx ON v2_tasks_olap USING GIN (display_name gin_trgm_ops);
+
+CREATE INDEX v2_tasks_olap_input_idx ON v2_tasks_olap USING GIN (input jsonb_path_ops);
+
 SELECT create_v2_olap_partition_with_date_and_status('v2_tasks_olap', CURRENT_DATE);
 
 -- DAG DEFINITIONS --
@@ -261,7 +268,9 @@ CREATE TYPE v2_event_type_olap AS ENUM (
     'CANCELLED',
     'TIMED_OUT',
     'RATE_LIMIT_ERROR',
-    'SKIPPED'
+    'SKIPPED',
+    'REPLAYED',
+    'RESET_BY_REPLAY'
 );
 
 -- this is a hash-partitioned table on the task_id, so that we can process batches of events in parallel
@@ -327,6 +336,10 @@ CREATE TABLE v2_task_events_olap (
 
 CREATE INDEX v2_task_events_olap_task_id_idx ON v2_task_events_olap (task_id);
 
+CREATE INDEX v2_task_events_olap_error_message_idx ON v2_task_events_olap USING GIN (error_message gin_trgm_ops) WHERE error_message IS NOT NULL;
+
+CREATE INDEX v2_task_events_olap_output_idx ON v2_task_events_olap USING GIN (output jsonb_path_ops) WHERE output IS NOT NULL;
+
 -- this is a hash-partitioned table on the dag_id, so that we can process batches of events in parallel
 -- without needing to place conflicting locks on dags.
 CREATE TABLE v2_task_status_updates_tmp (
@@ -1,10 +1,17 @@
+-- CreateEnum
+CREATE TYPE "AuditLogActorKind" AS ENUM ('USER', 'API_TOKEN');
+
+-- CreateEnum
+CREATE TYPE "AuditLogSource" AS ENUM ('API', 'GRPC');
+
 -- CreateEnum
 CREATE TYPE "ConcurrencyLimitStrategy" AS ENUM (
     'CANCEL_IN_PROGRESS',
     'DROP_NEWEST', -- DEPRECATED
     'QUEUE_NEWEST', -- DEPRECATED
     'GROUP_ROUND_ROBIN',
-    'CANCEL_NEWEST'
+    'CANCEL_NEWEST',
+    'WEIGHTED_ROUND_ROBIN'
 );
 
 
@@ -47,6 +54,9 @@ CREATE TYPE "LimitResource" AS ENUM (
 -- CreateEnum
 CREATE TYPE "LogLineLevel" AS ENUM ('DEBUG', 'INFO', 'WARN', 'ERROR');
 
+-- CreateEnum
+CREATE TYPE "RateLimitAlgorithm" AS ENUM ('FIXED_WINDOW', 'TOKEN_BUCKET', 'SLIDING_WINDOW');
+
 -- CreateEnum
 CREATE TYPE "StepExpressionKind" AS ENUM (
     'DYNAMIC_RATE_LIMIT_KEY',
@@ -108,6 +118,9 @@ CREATE TYPE "TenantResourceLimitAlertType" AS ENUM ('Alarm', 'Exhausted');
 -- CreateEnum
 CREATE TYPE "VcsProvider" AS ENUM ('GITHUB');
 
+-- CreateEnum
+CREATE TYPE "WebhookDeliveryStatus" AS ENUM ('PENDING', 'SUCCEEDED', 'FAILED');
+
 -- CreateEnum
 CREATE TYPE "WebhookWorkerRequestMethod" AS ENUM ('GET', 'POST', 'PUT');
 
@@ -144,6 +157,7 @@ CREATE TABLE "APIToken" (
     "tenantId" UUID,
     "nextAlertAt" TIMESTAMP(3),
     "internal" BOOLEAN NOT NULL DEFAULT false,
+    "scopes" TEXT[] NOT NULL DEFAULT ARRAY['admin']::TEXT[],
 
     CONSTRAINT "APIToken_pkey" PRIMARY KEY ("id")
 );
@@ -358,7 +372,12 @@ CREATE TABLE "RateLimit" (
     "limitValue" INTEGER NOT NULL,
     "value" INTEGER NOT NULL,
     "window" TEXT NOT NULL,
-    "lastRefill" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+    "lastRefill" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "dynamic" BOOLEAN NOT NULL DEFAULT false,
+    "lastUsedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "algorithm" "RateLimitAlgorithm" NOT NULL DEFAULT 'FIXED_WINDOW',
+    "burst" INTEGER,
+    "previousUsage" INTEGER NOT NULL DEFAULT 0
 );
 
 -- CreateTable
@@ -472,6 +491,7 @@ CREATE TABLE "StepExpression" (
     "stepId" UUID NOT NULL,
     "expression" TEXT NOT NULL,
     "kind" "StepExpressionKind" NOT NULL,
+    "celLibraryVersion" INTEGER NOT NULL DEFAULT 1,
 
     CONSTRAINT "StepExpression_pkey" PRIMARY KEY ("key","stepId","kind")
 );
@@ -600,6 +620,25 @@ CREATE TABLE "Tenant" (
     CONSTRAINT "Tenant_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "TenantAuditLog" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "actorKind" "AuditLogActorKind" NOT NULL,
+    "actorId" UUID NOT NULL,
+    "actorName" TEXT,
+    "source" "AuditLogSource" NOT NULL,
+    "action" TEXT NOT NULL,
+    "resourceType" TEXT NOT NULL,
+    "resourceId" TEXT,
+    "ipAddress" TEXT,
+    "userAgent" TEXT,
+    "metadata" JSONB NOT NULL DEFAULT '{}'::JSONB,
+
+    CONSTRAINT "TenantAuditLog_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "TenantAlertEmailGroup" (
     "id" UUID NOT NULL,
@@ -652,6 +691,7 @@ CREATE TABLE "TenantMember" (
     "tenantId" UUID NOT NULL,
     "userId" UUID NOT NULL,
     "role" "TenantMemberRole" NOT NULL,
+    "customRoleId" UUID,
 
     CONSTRAINT "TenantMember_pkey" PRIMARY KEY ("id")
 );
@@ -688,6 +728,21 @@ CREATE TABLE "TenantResourceLimitAlert" (
     CONSTRAINT "TenantResourceLimitAlert_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "TenantRole" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "description" TEXT,
+    "permissions" TEXT[] NOT NULL DEFAULT ARRAY[]::TEXT[],
+    -- if set, permissions on workflows and their runs only apply to these workflows
+    "workflowIds" UUID[],
+
+    CONSTRAINT "TenantRole_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "TenantVcsProvider" (
     "id" UUID NOT NULL,
@@ -781,6 +836,55 @@ CREATE TABLE "UserSession" (
     CONSTRAINT "UserSession_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "WebhookDelivery" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "subscriptionId" UUID NOT NULL,
+    "eventType" TEXT NOT NULL,
+    "payload" JSONB NOT NULL,
+    "status" "WebhookDeliveryStatus" NOT NULL DEFAULT 'PENDING',
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    -- the time of the next attempt, which is only set while the delivery is pending
+    "nextAttemptAt" TIMESTAMP(3),
+
+    CONSTRAINT "WebhookDelivery_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "WebhookDeliveryAttempt" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deliveryId" UUID NOT NULL,
+    "attempt" INTEGER NOT NULL,
+    "responseStatusCode" INTEGER,
+    "responseBody" TEXT,
+    "error" TEXT,
+    "durationMs" INTEGER NOT NULL,
+
+    CONSTRAINT "WebhookDeliveryAttempt_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "WebhookSubscription" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "url" TEXT NOT NULL,
+    "secret" TEXT NOT NULL,
+    "enabled" BOOLEAN NOT NULL DEFAULT true,
+    -- empty filters match every event
+    "eventTypes" TEXT[] NOT NULL DEFAULT ARRAY[]::TEXT[],
+    "statuses" TEXT[] NOT NULL DEFAULT ARRAY[]::TEXT[],
+    "workflowIds" UUID[],
+
+    CONSTRAINT "WebhookSubscription_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "WebhookWorker" (
     "id" UUID NOT NULL,
@@ -888,6 +992,8 @@ CREATE TABLE "WorkflowConcurrency" (
     "maxRuns" INTEGER NOT NULL DEFAULT 1,
     "limitStrategy" "ConcurrencyLimitStrategy" NOT NULL DEFAULT 'CANCEL_IN_PROGRESS',
     "concurrencyGroupExpression" TEXT,
+    "concurrencyGroupWeightExpression" TEXT,
+    "celLibraryVersion" INTEGER NOT NULL DEFAULT 1,
 
     CONSTRAINT "WorkflowConcurrency_pkey" PRIMARY KEY ("id")
 );
@@ -978,6 +1084,20 @@ CREATE TYPE "WorkflowTriggerCronRefMethods" AS ENUM (
     'API'
 );
 
+-- CreateEnum
+CREATE TYPE "WorkflowTriggerCronRefMissedFirePolicy" AS ENUM (
+    'SKIP',
+    'FIRE_ONCE',
+    'CATCH_UP'
+);
+
+-- CreateEnum
+CREATE TYPE "WorkflowTriggerCronRefOverlapPolicy" AS ENUM (
+    'ALLOW',
+    'SKIP_IF_RUNNING',
+    'CANCEL_PREVIOUS',
+    'QUEUE_ONE'
+);
 
 -- CreateTable
 CREATE TABLE "WorkflowTriggerCronRef" (
@@ -992,7 +1112,15 @@ CREATE TABLE "WorkflowTriggerCronRef" (
     "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
     "name" TEXT,
     "id" UUID NOT NULL,
-    "method" "WorkflowTriggerCronRefMethods" NOT NULL DEFAULT 'DEFAULT'
+    "method" "WorkflowTriggerCronRefMethods" NOT NULL DEFAULT 'DEFAULT',
+    "missedFirePolicy" "WorkflowTriggerCronRefMissedFirePolicy" NOT NULL DEFAULT 'SKIP',
+    "catchUpLimit" INTEGER NOT NULL DEFAULT 0,
+    "nextFireAt" TIMESTAMP(3),
+    "timezone" TEXT,
+    "overlapPolicy" "WorkflowTriggerCronRefOverlapPolicy" NOT NULL DEFAULT 'ALLOW',
+    "lastRunExternalId" UUID,
+    "lastFiredAt" TIMESTAMP(3),
+    "queuedFireAt" TIMESTAMP(3)
 );
 
 -- CreateTable
@@ -1023,6 +1151,7 @@ CREATE TABLE "WorkflowTriggerScheduledRef" (
     "deletedAt" TIMESTAMP(3),
     "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
     "method" "WorkflowTriggerScheduledRefMethods" NOT NULL DEFAULT 'DEFAULT',
+    "triggeredAt" TIMESTAMP(3),
     CONSTRAINT "WorkflowTriggerScheduledRef_pkey" PRIMARY KEY ("id")
 );
 
@@ -1109,6 +1238,25 @@ CREATE TABLE "MessageQueueItem" (
 -- Create an index for message queue item
 CREATE INDEX "MessageQueueItem_queueId_expiresAt_readAfter_status_id_idx" ON "MessageQueueItem" ("expiresAt", "queueId", "readAfter", "status", "id");
 
+-- CreateTable
+CREATE TABLE "MessageQueueDeadLetter" (
+    "id" bigint GENERATED ALWAYS AS IDENTITY,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "queueId" TEXT NOT NULL,
+    "tenantId" UUID,
+    "messageId" TEXT NOT NULL,
+    "payload" JSONB NOT NULL,
+    "error" TEXT,
+    "retryCount" INTEGER NOT NULL DEFAULT 0,
+    CONSTRAINT "MessageQueueDeadLetter_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "MessageQueueDeadLetter_tenantId_queueId_id_idx" ON "MessageQueueDeadLetter" ("tenantId", "queueId", "id");
+
+-- CreateIndex
+CREATE INDEX "MessageQueueDeadLetter_createdAt_idx" ON "MessageQueueDeadLetter" ("createdAt");
+
 -- CreateIndex
 CREATE UNIQUE INDEX "APIToken_id_key" ON "APIToken" ("id" ASC);
 
@@ -1328,6 +1476,9 @@ CREATE UNIQUE INDEX "TenantAlertingSettings_id_key" ON "TenantAlertingSettings"
 -- CreateIndex
 CREATE UNIQUE INDEX "TenantAlertingSettings_tenantId_key" ON "TenantAlertingSettings" ("tenantId" ASC);
 
+-- CreateIndex
+CREATE INDEX "TenantAuditLog_tenantId_createdAt_idx" ON "TenantAuditLog" ("tenantId" ASC, "createdAt" DESC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "TenantInviteLink_id_key" ON "TenantInviteLink" ("id" ASC);
 
@@ -1346,6 +1497,9 @@ CREATE UNIQUE INDEX "TenantResourceLimit_tenantId_resource_key" ON "TenantResour
 -- CreateIndex
 CREATE UNIQUE INDEX "TenantResourceLimitAlert_id_key" ON "TenantResourceLimitAlert" ("id" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantRole_tenantId_name_key" ON "TenantRole" ("tenantId" ASC, "name" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "TenantVcsProvider_id_key" ON "TenantVcsProvider" ("id" ASC);
 
@@ -1385,6 +1539,18 @@ CREATE UNIQUE INDEX "UserPassword_userId_key" ON "UserPassword" ("userId" ASC);
 -- CreateIndex
 CREATE UNIQUE INDEX "UserSession_id_key" ON "UserSession" ("id" ASC);
 
+-- CreateIndex
+CREATE INDEX "WebhookDelivery_subscriptionId_createdAt_idx" ON "WebhookDelivery" ("subscriptionId" ASC, "createdAt" DESC);
+
+-- CreateIndex
+CREATE INDEX "WebhookDelivery_tenantId_status_nextAttemptAt_idx" ON "WebhookDelivery" ("tenantId" ASC, "status" ASC, "nextAttemptAt" ASC);
+
+-- CreateIndex
+CREATE INDEX "WebhookDeliveryAttempt_deliveryId_idx" ON "WebhookDeliveryAttempt" ("deliveryId" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WebhookSubscription_tenantId_name_key" ON "WebhookSubscription" ("tenantId" ASC, "name" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "WebhookWorker_id_key" ON "WebhookWorker" ("id" ASC);
 
@@ -1507,6 +1673,9 @@ CREATE UNIQUE INDEX "WorkflowTriggerEventRef_parentId_eventKey_key" ON "Workflow
 -- CreateIndex
 CREATE UNIQUE INDEX "WorkflowTriggerScheduledRef_id_key" ON "WorkflowTriggerScheduledRef" ("id" ASC);
 
+-- CreateIndex
+CREATE INDEX "WorkflowTriggerScheduledRef_triggerAt_idx" ON "WorkflowTriggerScheduledRef" ("triggerAt" ASC) WHERE "triggeredAt" IS NULL;
+
 -- CreateIndex
 CREATE UNIQUE INDEX "WorkflowTriggerScheduledRef_parentId_parentStepRunId_childK_key" ON "WorkflowTriggerScheduledRef" (
     "parentId" ASC,
@@ -1625,6 +1794,9 @@ ALTER TABLE "TenantAlertingSettings" ADD CONSTRAINT "TenantAlertingSettings_tena
 -- AddForeignKey
 ALTER TABLE "TenantAlertingSettings" ADD CONSTRAINT "TenantAlertingSettings_tickerId_fkey" FOREIGN KEY ("tickerId") REFERENCES "Ticker" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "TenantAuditLog" ADD CONSTRAINT "TenantAuditLog_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "TenantInviteLink" ADD CONSTRAINT "TenantInviteLink_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -1634,6 +1806,9 @@ ALTER TABLE "TenantMember" ADD CONSTRAINT "TenantMember_tenantId_fkey" FOREIGN K
 -- AddForeignKey
 ALTER TABLE "TenantMember" ADD CONSTRAINT "TenantMember_userId_fkey" FOREIGN KEY ("userId") REFERENCES "User" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "TenantMember" ADD CONSTRAINT "TenantMember_customRoleId_fkey" FOREIGN KEY ("customRoleId") REFERENCES "TenantRole" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "TenantResourceLimit" ADD CONSTRAINT "TenantResourceLimit_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -1643,6 +1818,9 @@ ALTER TABLE "TenantResourceLimitAlert" ADD CONSTRAINT "TenantResourceLimitAlert_
 -- AddForeignKey
 ALTER TABLE "TenantResourceLimitAlert" ADD CONSTRAINT "TenantResourceLimitAlert_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "TenantRole" ADD CONSTRAINT "TenantRole_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "TenantVcsProvider" ADD CONSTRAINT "TenantVcsProvider_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -1655,6 +1833,15 @@ ALTER TABLE "UserPassword" ADD CONSTRAINT "UserPassword_userId_fkey" FOREIGN KEY
 -- AddForeignKey
 ALTER TABLE "UserSession" ADD CONSTRAINT "UserSession_userId_fkey" FOREIGN KEY ("userId") REFERENCES "User" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "WebhookDelivery" ADD CONSTRAINT "WebhookDelivery_subscriptionId_fkey" FOREIGN KEY ("subscriptionId") REFERENCES "WebhookSubscription" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WebhookDeliveryAttempt" ADD CONSTRAINT "WebhookDeliveryAttempt_deliveryId_fkey" FOREIGN KEY ("deliveryId") REFERENCES "WebhookDelivery" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WebhookSubscription" ADD CONSTRAINT "WebhookSubscription_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "WebhookWorker" ADD CONSTRAINT "WebhookWorker_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
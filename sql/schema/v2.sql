@@ -86,7 +86,7 @@ CREATE TYPE v2_task_initial_state AS ENUM ('QUEUED', 'CANCELLED', 'SKIPPED', 'FA
 
 -- We need a NONE strategy to allow for tasks which were previously using a concurrency strategy to
 -- enqueue if the strategy is removed.
-CREATE TYPE v2_concurrency_strategy AS ENUM ('NONE', 'GROUP_ROUND_ROBIN', 'CANCEL_IN_PROGRESS', 'CANCEL_NEWEST');
+CREATE TYPE v2_concurrency_strategy AS ENUM ('NONE', 'GROUP_ROUND_ROBIN', 'CANCEL_IN_PROGRESS', 'CANCEL_NEWEST', 'WEIGHTED_ROUND_ROBIN');
 
 CREATE TABLE v2_step_concurrency (
     -- We need an id used for stable ordering to prevent deadlocks. We must process all concurrency
@@ -101,6 +101,11 @@ CREATE TABLE v2_step_concurrency (
     expression TEXT NOT NULL,
     tenant_id UUID NOT NULL,
     max_concurrency INTEGER NOT NULL,
+    -- An optional expression which evaluates to the weight of a concurrency key, used by the
+    -- WEIGHTED_ROUND_ROBIN strategy.
+    weight_expression TEXT,
+    -- The version of the CEL function library which the expressions are compiled against
+    cel_library_version INTEGER NOT NULL DEFAULT 1,
     CONSTRAINT v2_step_concurrency_pkey PRIMARY KEY (workflow_id, workflow_version_id, step_id, id)
 );
 
@@ -130,7 +135,9 @@ BEGIN
       strategy, 
       expression, 
       tenant_id, 
-      max_concurrency
+      max_concurrency,
+      weight_expression,
+      cel_library_version
     )
     SELECT 
       s."workflowId",
@@ -139,7 +146,9 @@ BEGIN
       NEW."limitStrategy"::VARCHAR::v2_concurrency_strategy,
       NEW."concurrencyGroupExpression",
       s."tenantId",
-      NEW."maxRuns"
+      NEW."maxRuns",
+      NEW."concurrencyGroupWeightExpression",
+      NEW."celLibraryVersion"
     FROM steps s;
 
   END IF;
@@ -265,6 +274,9 @@ CREATE TABLE v2_task_runtime (
     worker_id UUID NOT NULL,
     tenant_id UUID NOT NULL,
     timeout_at TIMESTAMP(3) NOT NULL,
+    -- set when the task gives up its worker slot before finishing, for example while it waits on a
+    -- durable event. The runtime is kept so the task can still be completed, failed or timed out.
+    slot_released BOOLEAN NOT NULL DEFAULT FALSE,
 
     CONSTRAINT v2_task_runtime_pkey PRIMARY KEY (task_id, retry_count)
 );
@@ -298,9 +310,19 @@ CREATE TABLE v2_match (
     trigger_step_id UUID,
     -- references the external id for the new task
     trigger_external_id UUID,
+    -- references an existing task which is run again instead of creating a new task. This is set when
+    -- a DAG is replayed from a task, and the existing task is downstream of the replayed task.
+    trigger_existing_task_id bigint,
+    trigger_existing_task_inserted_at timestamptz,
     CONSTRAINT v2_match_pkey PRIMARY KEY (id)
 );
 
+CREATE INDEX v2_match_trigger_dag_idx ON v2_match (
+    tenant_id ASC,
+    trigger_dag_id ASC,
+    trigger_dag_inserted_at ASC
+) WHERE trigger_dag_id IS NOT NULL;
+
 CREATE TYPE v2_event_type AS ENUM ('USER', 'INTERNAL');
 
 -- Provides information to the caller about the action to take. This is used to differentiate
@@ -321,6 +343,8 @@ CREATE TABLE v2_match_condition (
     or_group_id UUID NOT NULL,
     expression TEXT,
     data JSONB,
+    -- The version of the CEL function library which the expression is compiled against
+    cel_library_version INTEGER NOT NULL DEFAULT 1,
     CONSTRAINT v2_match_condition_pkey PRIMARY KEY (v2_match_id, id)
 );
 
@@ -331,6 +355,19 @@ CREATE INDEX v2_match_condition_filter_idx ON v2_match_condition (
     is_satisfied ASC
 );
 
+-- Conditions on user events which must be satisfied before a step in a DAG is run, in addition to
+-- the step's parents completing. Each condition is copied to a v2_match_condition when the DAG is created.
+CREATE TABLE v2_step_match_condition (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    step_id UUID NOT NULL,
+    event_key TEXT NOT NULL,
+    expression TEXT,
+    -- The version of the CEL function library which the expression is compiled against
+    cel_library_version INTEGER NOT NULL DEFAULT 1,
+    CONSTRAINT v2_step_match_condition_pkey PRIMARY KEY (step_id, id)
+);
+
 CREATE TABLE v2_dag (
     id bigint GENERATED ALWAYS AS IDENTITY,
     inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -412,6 +449,29 @@ REFERENCING OLD TABLE AS deleted_rows
 FOR EACH STATEMENT
 EXECUTE FUNCTION delete_concurrency_slots_on_v2_task_runtime_delete();
 
+-- CreateTable
+-- Stores the last evaluated weight of each concurrency key for WEIGHTED_ROUND_ROBIN strategies. Keys
+-- without a row are treated as having a weight of 1.
+CREATE TABLE v2_concurrency_key_weight (
+    tenant_id UUID NOT NULL,
+    strategy_id BIGINT NOT NULL,
+    key TEXT NOT NULL,
+    weight INTEGER NOT NULL DEFAULT 1 CHECK (weight > 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v2_concurrency_key_weight_pkey PRIMARY KEY (tenant_id, strategy_id, key)
+);
+
+-- CreateTable
+-- Stores sleeps registered by running tasks. Once sleep_until has passed, the row is removed and
+-- an internal event is emitted with the sleep's event key.
+CREATE TABLE v2_durable_sleep (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    sleep_until TIMESTAMPTZ NOT NULL,
+    sleep_duration TEXT NOT NULL,
+    CONSTRAINT v2_durable_sleep_pkey PRIMARY KEY (tenant_id, sleep_until, id)
+);
+
 CREATE TABLE v2_retry_queue_item (
     task_id BIGINT NOT NULL,
     task_inserted_at TIMESTAMPTZ NOT NULL,
@@ -426,6 +486,23 @@ CREATE INDEX v2_retry_queue_item_tenant_id_retry_after_idx ON v2_retry_queue_ite
 
 SELECT create_v2_range_partition('v2_concurrency_slot', DATE 'today');
 
+-- CreateTable
+-- Stores the rate limit keys and units of a task, which are resolved when the task is created. Dynamic
+-- rate limits also store the limit value and window which are used to provision the key, and these are
+-- NULL for static rate limits.
+CREATE TABLE v2_task_rate_limit (
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    units INTEGER NOT NULL,
+    limit_value INTEGER,
+    limit_window TEXT,
+    CONSTRAINT v2_task_rate_limit_pkey PRIMARY KEY (task_id, task_inserted_at, key)
+) PARTITION BY RANGE(task_inserted_at);
+
+SELECT create_v2_range_partition('v2_task_rate_limit', DATE 'today');
+
 CREATE OR REPLACE FUNCTION v2_task_insert_function()
 RETURNS TRIGGER AS
 $$
@@ -753,4 +830,73 @@ CREATE TRIGGER v2_concurrency_slot_update_trigger
 AFTER UPDATE ON v2_concurrency_slot
 REFERENCING NEW TABLE AS new_table OLD TABLE AS old_table
 FOR EACH STATEMENT
-EXECUTE PROCEDURE v2_concurrency_slot_update_function();
\ No newline at end of file
+EXECUTE PROCEDURE v2_concurrency_slot_update_function();
+
+-- refill_rate_limit returns a rate limit after refilling it at the current time. This is mirrored by
+-- RateLimitState.Refill in pkg/repository/v2, which is used by the scheduler between flushes.
+--
+-- FIXED_WINDOW: the value is reset to the limit once the window has passed.
+-- TOKEN_BUCKET: tokens are added continuously at a rate of limitValue per window, up to the burst
+--     capacity (which defaults to the limit). lastRefill is advanced by the time it takes to add the
+--     tokens, so partial tokens aren't lost.
+-- SLIDING_WINDOW: value is the remainder of the current window, and previousUsage is the number of units
+--     used in the previous window, which is weighted by the overlap with the sliding window when
+--     determining the available units (see rate_limit_available).
+CREATE OR REPLACE FUNCTION refill_rate_limit(rate_limit "RateLimit")
+RETURNS "RateLimit" AS $$
+DECLARE
+    window_seconds DOUBLE PRECISION := EXTRACT(EPOCH FROM rate_limit."window"::INTERVAL);
+    elapsed_seconds DOUBLE PRECISION := EXTRACT(EPOCH FROM NOW() - rate_limit."lastRefill");
+    capacity INTEGER := COALESCE(rate_limit."burst", rate_limit."limitValue");
+    tokens BIGINT;
+    windows BIGINT;
+BEGIN
+    IF rate_limit."algorithm" = 'TOKEN_BUCKET' THEN
+        tokens := FLOOR(elapsed_seconds * rate_limit."limitValue" / window_seconds);
+
+        IF tokens > 0 THEN
+            IF rate_limit."value" + tokens >= capacity THEN
+                rate_limit."value" := capacity;
+                rate_limit."lastRefill" := CURRENT_TIMESTAMP;
+            ELSE
+                rate_limit."value" := rate_limit."value" + tokens;
+                rate_limit."lastRefill" := rate_limit."lastRefill" + make_interval(secs => tokens * window_seconds / rate_limit."limitValue");
+            END IF;
+        END IF;
+    ELSIF rate_limit."algorithm" = 'SLIDING_WINDOW' THEN
+        windows := FLOOR(elapsed_seconds / window_seconds);
+
+        IF windows = 1 THEN
+            rate_limit."previousUsage" := GREATEST(rate_limit."limitValue" - rate_limit."value", 0);
+        ELSIF windows > 1 THEN
+            rate_limit."previousUsage" := 0;
+        END IF;
+
+        IF windows > 0 THEN
+            rate_limit."value" := rate_limit."limitValue";
+            rate_limit."lastRefill" := rate_limit."lastRefill" + make_interval(secs => windows * window_seconds);
+        END IF;
+    ELSIF NOW() - rate_limit."lastRefill" >= rate_limit."window"::INTERVAL THEN
+        rate_limit."value" := rate_limit."limitValue";
+        rate_limit."lastRefill" := CURRENT_TIMESTAMP;
+    END IF;
+
+    RETURN rate_limit;
+END;
+$$ LANGUAGE plpgsql STABLE;
+
+-- rate_limit_available returns the number of units which can be used from a rate limit which was
+-- refilled with refill_rate_limit.
+CREATE OR REPLACE FUNCTION rate_limit_available(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+DECLARE
+    window_seconds DOUBLE PRECISION := EXTRACT(EPOCH FROM rate_limit."window"::INTERVAL);
+    elapsed_seconds DOUBLE PRECISION := EXTRACT(EPOCH FROM NOW() - rate_limit."lastRefill");
+BEGIN
+    IF rate_limit."algorithm" = 'SLIDING_WINDOW' THEN
+        RETURN rate_limit."value" - CEIL(rate_limit."previousUsage" * GREATEST(1 - elapsed_seconds / window_seconds, 0))::INTEGER;
+    END IF;
+
+    RETURN rate_limit."value";
+END;
+$$ LANGUAGE plpgsql STABLE;